@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	config "cube-castle/internal/config"
+	health "cube-castle/internal/monitoring/health"
+	"cube-castle/pkg/database"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// metricAlerting 声明式告警规则引擎及其邮件渠道的生命周期
+type metricAlerting struct {
+	engine *health.MetricRuleEngine
+	email  *health.EmailChannel
+}
+
+// newMetricAlerting 加载 config/alert_rules.yaml（或 ALERT_RULES_FILE），文件缺失或无效时使用内置规则；
+// 规则文件修改后由引擎热加载。
+func newMetricAlerting(logger pkglogger.Logger, db *sql.DB, sources ...health.MetricSource) *metricAlerting {
+	engine := health.NewMetricRuleEngine("command", nil, logger)
+	if path := config.ResolveAlertRulesFile(); path != "" {
+		if err := engine.LoadFile(path); err != nil {
+			logger.WithFields(pkglogger.Fields{
+				"path":  path,
+				"error": err,
+			}).Error("告警规则加载失败，使用内置规则")
+		} else {
+			logger.WithFields(pkglogger.Fields{"path": path}).Info("✅ 告警规则已加载")
+		}
+	}
+
+	for _, source := range sources {
+		if source != nil {
+			engine.AddSource(source)
+		}
+	}
+	if db != nil {
+		engine.AddSource(health.MetricSourceFunc(func(ctx context.Context) (map[string]float64, error) {
+			return database.OutboxBacklogMetrics(ctx, db)
+		}))
+	}
+
+	a := &metricAlerting{engine: engine}
+	if emailCfg, ok := health.LoadEmailConfigFromEnv(); ok {
+		var outbox health.EmailOutbox
+		if db != nil {
+			outbox = health.NewSQLEmailOutbox(db)
+		}
+		channel, err := health.NewEmailChannel(emailCfg, outbox)
+		if err != nil {
+			logger.WithFields(pkglogger.Fields{"error": err}).Warn("告警邮件渠道配置无效，已跳过")
+		} else {
+			engine.AddChannel(channel)
+			a.email = channel
+		}
+	}
+	return a
+}
+
+// Start 启动规则评估与邮件重试循环
+func (a *metricAlerting) Start(ctx context.Context) {
+	if a.email != nil {
+		a.email.Start(ctx)
+	}
+	a.engine.Start(ctx)
+}
+
+// Stop 停止评估循环并发送暂存的邮件批次
+func (a *metricAlerting) Stop(ctx context.Context) error {
+	a.engine.Stop()
+	if a.email != nil {
+		return a.email.Stop(ctx)
+	}
+	return nil
+}
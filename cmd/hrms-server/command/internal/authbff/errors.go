@@ -9,4 +9,7 @@ const (
 	ErrSessionExpired    = "SESSION_EXPIRED"
 	ErrCSRFCheckFailed   = "CSRF_CHECK_FAILED"
 	ErrRefreshFailed     = "REFRESH_FAILED"
+	ErrSessionNotFound   = "SESSION_NOT_FOUND"
+	ErrIdPNotFound       = "IDP_NOT_FOUND"
+	ErrUserDeprovisioned = "USER_DEPROVISIONED"
 )
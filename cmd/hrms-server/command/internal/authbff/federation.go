@@ -0,0 +1,338 @@
+package authbff
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cube-castle/internal/auth"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/lib/pq"
+)
+
+const defaultProviderID = "default"
+
+// ClaimMapping 指定 ID Token 中承载角色/组/租户的 claim（支持 a.b.c 嵌套路径）
+type ClaimMapping struct {
+	RolesClaim  string
+	GroupsClaim string
+	TenantClaim string
+}
+
+// ProviderSource 租户级 IdP 配置来源
+type ProviderSource interface {
+	ListIdentityProviders(ctx context.Context) ([]OIDCConfig, error)
+}
+
+// SQLProviderSource 从 tenant_identity_providers 表读取提供方配置
+type SQLProviderSource struct {
+	db *sql.DB
+}
+
+func NewSQLProviderSource(db *sql.DB) *SQLProviderSource {
+	return &SQLProviderSource{db: db}
+}
+
+func (s *SQLProviderSource) ListIdentityProviders(ctx context.Context) ([]OIDCConfig, error) {
+	rows, err := s.db.QueryContext(ctx, `
+        SELECT id, tenant_id::text, issuer, client_id, COALESCE(client_secret, ''),
+               redirect_uri, COALESCE(post_logout_redirect_uri, ''), scopes, email_domains,
+               COALESCE(roles_claim, ''), COALESCE(groups_claim, ''), COALESCE(tenant_claim, ''),
+               group_role_mappings
+          FROM tenant_identity_providers
+         WHERE enabled = TRUE
+         ORDER BY tenant_id, id`)
+	if err != nil {
+		return nil, fmt.Errorf("query identity providers: %w", err)
+	}
+	defer rows.Close()
+
+	var providers []OIDCConfig
+	for rows.Next() {
+		var (
+			cfg         OIDCConfig
+			scopes      string
+			domains     pq.StringArray
+			mappingJSON []byte
+		)
+		if err := rows.Scan(&cfg.ID, &cfg.TenantID, &cfg.Issuer, &cfg.ClientID, &cfg.ClientSecret,
+			&cfg.RedirectURI, &cfg.PostLogoutURI, &scopes, &domains,
+			&cfg.Claims.RolesClaim, &cfg.Claims.GroupsClaim, &cfg.Claims.TenantClaim, &mappingJSON); err != nil {
+			return nil, fmt.Errorf("scan identity provider: %w", err)
+		}
+		cfg.Scopes = strings.Fields(scopes)
+		cfg.EmailDomains = []string(domains)
+		if len(mappingJSON) > 0 {
+			if err := json.Unmarshal(mappingJSON, &cfg.GroupRoles); err != nil {
+				return nil, fmt.Errorf("decode group_role_mappings for %s: %w", cfg.ID, err)
+			}
+		}
+		providers = append(providers, cfg)
+	}
+	return providers, rows.Err()
+}
+
+// ProviderRegistry 管理多 IdP 客户端：按租户提示或邮箱域名选择提供方，配置定期从来源刷新
+type ProviderRegistry struct {
+	source   ProviderSource
+	fallback *OIDCClient
+	logger   pkglogger.Logger
+	ttl      time.Duration
+
+	mu       sync.RWMutex
+	clients  map[string]*OIDCClient
+	ordered  []*OIDCClient
+	loadedAt time.Time
+}
+
+func NewProviderRegistry(source ProviderSource, fallback *OIDCClient, logger pkglogger.Logger) *ProviderRegistry {
+	if logger == nil {
+		logger = pkglogger.NewNoopLogger()
+	}
+	return &ProviderRegistry{
+		source:   source,
+		fallback: fallback,
+		logger:   logger,
+		ttl:      time.Minute,
+		clients:  map[string]*OIDCClient{},
+	}
+}
+
+// Reload 重新加载提供方；issuer/client 未变化时沿用原发现文档与 JWKS 缓存
+func (p *ProviderRegistry) Reload(ctx context.Context) error {
+	if p.source == nil {
+		return nil
+	}
+	configs, err := p.source.ListIdentityProviders(ctx)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clients := make(map[string]*OIDCClient, len(configs))
+	ordered := make([]*OIDCClient, 0, len(configs))
+	for _, cfg := range configs {
+		cfg.GroupRoles = p.sanitizeGroupRoles(cfg.ID, cfg.GroupRoles)
+		client := NewOIDCClient(cfg)
+		if prev := p.clients[cfg.ID]; prev != nil && sameEndpoint(prev.cfg, cfg) {
+			client.inheritDiscovery(prev)
+		}
+		if !client.IsConfigured() {
+			p.logger.WithFields(pkglogger.Fields{"providerId": cfg.ID}).Warn("identity provider incomplete; skipped")
+			continue
+		}
+		clients[cfg.ID] = client
+		ordered = append(ordered, client)
+	}
+	p.clients = clients
+	p.ordered = ordered
+	p.loadedAt = time.Now()
+	return nil
+}
+
+func (p *ProviderRegistry) ensureFresh(ctx context.Context) {
+	p.mu.RLock()
+	stale := time.Since(p.loadedAt) > p.ttl
+	p.mu.RUnlock()
+	if !stale {
+		return
+	}
+	if err := p.Reload(ctx); err != nil {
+		p.logger.WithFields(pkglogger.Fields{"error": err}).Warn("reload identity providers failed; using cached providers")
+		p.mu.Lock()
+		p.loadedAt = time.Now()
+		p.mu.Unlock()
+	}
+}
+
+// HasProviders 是否存在任意可用提供方（含环境变量默认提供方）
+func (p *ProviderRegistry) HasProviders(ctx context.Context) bool {
+	if p.fallback != nil && p.fallback.IsConfigured() {
+		return true
+	}
+	p.ensureFresh(ctx)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.ordered) > 0
+}
+
+// Get 按提供方标识取客户端（回调/刷新/退出沿用登录时的提供方）
+func (p *ProviderRegistry) Get(ctx context.Context, id string) (*OIDCClient, bool) {
+	if id == "" || id == defaultProviderID {
+		return p.fallback, p.fallback != nil && p.fallback.IsConfigured()
+	}
+	p.ensureFresh(ctx)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	c, ok := p.clients[id]
+	return c, ok
+}
+
+// Resolve 选择登录提供方：租户提示优先，其次邮箱域名，最后环境变量默认提供方
+func (p *ProviderRegistry) Resolve(ctx context.Context, tenantHint, email string) (*OIDCClient, bool) {
+	p.ensureFresh(ctx)
+	tenantHint = strings.TrimSpace(tenantHint)
+	domain := emailDomain(email)
+
+	p.mu.RLock()
+	var match *OIDCClient
+	if tenantHint != "" {
+		for _, c := range p.ordered {
+			if strings.EqualFold(c.cfg.TenantID, tenantHint) && (domain == "" || matchesDomain(c.cfg.EmailDomains, domain)) {
+				match = c
+				break
+			}
+		}
+	}
+	if match == nil && domain != "" {
+		for _, c := range p.ordered {
+			if matchesDomain(c.cfg.EmailDomains, domain) {
+				match = c
+				break
+			}
+		}
+	}
+	p.mu.RUnlock()
+
+	if match != nil {
+		return match, true
+	}
+	if p.fallback != nil && p.fallback.IsConfigured() {
+		return p.fallback, true
+	}
+	return nil, false
+}
+
+func (p *ProviderRegistry) sanitizeGroupRoles(providerID string, mapping map[string]string) map[string]string {
+	out := make(map[string]string, len(mapping))
+	for group, role := range mapping {
+		normalized := strings.ToUpper(strings.TrimSpace(role))
+		if !auth.IsKnownRole(normalized) {
+			p.logger.WithFields(pkglogger.Fields{"providerId": providerID, "group": group, "role": role}).Warn("group mapped to unknown role; ignored")
+			continue
+		}
+		out[group] = normalized
+	}
+	return out
+}
+
+// IdentityFromClaims 依据提供方的 claim 映射解析租户与角色
+func (c *OIDCClient) IdentityFromClaims(claims map[string]any) (tenantID string, roles []string) {
+	mapping := c.cfg.Claims
+	// 绑定了租户的提供方只能登录该租户，避免 IdP 侧声明越权
+	if c.cfg.TenantID != "" {
+		tenantID = c.cfg.TenantID
+	} else if mapping.TenantClaim != "" {
+		tenantID = firstString(lookupClaim(claims, mapping.TenantClaim))
+	} else {
+		tenantID = getStringClaim(claims, "tenantId")
+		if tenantID == "" {
+			tenantID = getStringClaim(claims, "tenant_id")
+		}
+	}
+
+	set := map[string]struct{}{}
+	if mapping.RolesClaim != "" {
+		for _, role := range claimStrings(lookupClaim(claims, mapping.RolesClaim)) {
+			normalized := strings.ToUpper(role)
+			if auth.IsKnownRole(normalized) {
+				set[normalized] = struct{}{}
+			}
+		}
+	}
+	groupsClaim := mapping.GroupsClaim
+	if groupsClaim == "" && len(c.cfg.GroupRoles) > 0 {
+		groupsClaim = "groups"
+	}
+	if groupsClaim != "" {
+		for _, group := range claimStrings(lookupClaim(claims, groupsClaim)) {
+			if role, ok := c.cfg.GroupRoles[group]; ok {
+				set[role] = struct{}{}
+			}
+		}
+	}
+	roles = make([]string, 0, len(set))
+	for role := range set {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return tenantID, roles
+}
+
+// IsPlatformOperator 判断登录用户是否属于平台运营组；仅平台自有的默认提供方可授予，租户 IdP 的声明一律忽略
+func (c *OIDCClient) IsPlatformOperator(claims map[string]any) bool {
+	group := strings.TrimSpace(c.cfg.PlatformOperatorGroup)
+	if c.cfg.ID != defaultProviderID || group == "" {
+		return false
+	}
+	groupsClaim := c.cfg.Claims.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	return slices.Contains(claimStrings(lookupClaim(claims, groupsClaim)), group)
+}
+
+func lookupClaim(claims map[string]any, path string) any {
+	var current any = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[part]
+	}
+	return current
+}
+
+func claimStrings(v any) []string {
+	switch val := v.(type) {
+	case string:
+		return strings.Fields(strings.ReplaceAll(val, ",", " "))
+	case []any:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok && s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	case []string:
+		return val
+	}
+	return nil
+}
+
+func firstString(v any) string {
+	if list := claimStrings(v); len(list) > 0 {
+		return list[0]
+	}
+	return ""
+}
+
+func emailDomain(email string) string {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+func matchesDomain(domains []string, domain string) bool {
+	for _, d := range domains {
+		if strings.EqualFold(strings.TrimSpace(d), domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameEndpoint(a, b OIDCConfig) bool {
+	return a.Issuer == b.Issuer && a.ClientID == b.ClientID
+}
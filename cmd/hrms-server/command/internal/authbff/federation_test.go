@@ -0,0 +1,264 @@
+package authbff
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"cube-castle/internal/config"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockOIDCServer 本地 IdP：发现文档 + JWKS + token 端点（签发带 nonce 的 ID Token）
+type mockOIDCServer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	mu     sync.Mutex
+	nonce  string
+	claims jwt.MapClaims
+}
+
+func newMockOIDCServer(t *testing.T, clientID string) *mockOIDCServer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate idp key: %v", err)
+	}
+	m := &mockOIDCServer{t: t, key: key, kid: "idp-key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"end_session_endpoint":   m.server.URL + "/logout",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks{Keys: []jwk{rsaPublicJWK(&key.PublicKey, m.kid)}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("client_id") != clientID || r.Form.Get("code_verifier") == "" {
+			http.Error(w, "invalid_request", http.StatusBadRequest)
+			return
+		}
+		m.mu.Lock()
+		claims := jwt.MapClaims{
+			"iss":   m.server.URL,
+			"aud":   clientID,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": m.nonce,
+		}
+		for k, v := range m.claims {
+			claims[k] = v
+		}
+		m.mu.Unlock()
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = m.kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "idp-access",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": "idp-refresh",
+			"id_token":      signed,
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockOIDCServer) expect(nonce string, claims jwt.MapClaims) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nonce = nonce
+	m.claims = claims
+}
+
+type staticProviderSource []OIDCConfig
+
+func (s staticProviderSource) ListIdentityProviders(context.Context) ([]OIDCConfig, error) {
+	return s, nil
+}
+
+func newTestBFFHandler(t *testing.T) *BFFHandler {
+	t.Helper()
+	for _, env := range []string{"OIDC_ISSUER", "OIDC_CLIENT_ID", "OIDC_REDIRECT_URI", "REDIS_ADDR", "OIDC_SIMULATE"} {
+		t.Setenv(env, "")
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate bff key: %v", err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "bff.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyPath, pemBytes, 0o600); err != nil {
+		t.Fatalf("write bff key: %v", err)
+	}
+	return NewBFFHandler(nil, false, nil, &config.JWTConfig{
+		Issuer:         "cube-castle",
+		Audience:       "cube-castle-users",
+		Algorithm:      "RS256",
+		PrivateKeyPath: keyPath,
+	})
+}
+
+func TestProviderRegistryResolve(t *testing.T) {
+	fallback := NewOIDCClient(OIDCConfig{ID: defaultProviderID, Issuer: "https://default", ClientID: "c", RedirectURI: "https://app/cb"})
+	registry := NewProviderRegistry(staticProviderSource{
+		{ID: "acme", TenantID: "tenant-a", Issuer: "https://acme", ClientID: "a", RedirectURI: "https://app/cb", EmailDomains: []string{"acme.com"}},
+		{ID: "globex", TenantID: "tenant-b", Issuer: "https://globex", ClientID: "b", RedirectURI: "https://app/cb", EmailDomains: []string{"globex.io"}},
+	}, fallback, nil)
+	ctx := context.Background()
+
+	if c, ok := registry.Resolve(ctx, "tenant-b", ""); !ok || c.ProviderID() != "globex" {
+		t.Fatalf("expected tenant hint to select globex, got %v", c.ProviderID())
+	}
+	if c, ok := registry.Resolve(ctx, "", "Jane@ACME.com"); !ok || c.ProviderID() != "acme" {
+		t.Fatalf("expected email domain to select acme, got %v", c.ProviderID())
+	}
+	if c, ok := registry.Resolve(ctx, "unknown", "someone@example.com"); !ok || c.ProviderID() != defaultProviderID {
+		t.Fatalf("expected fallback provider, got %v", c.ProviderID())
+	}
+	if c, ok := registry.Get(ctx, "acme"); !ok || c.cfg.TenantID != "tenant-a" {
+		t.Fatalf("expected lookup by id to return acme")
+	}
+}
+
+func TestIdentityFromClaimsMapping(t *testing.T) {
+	client := NewOIDCClient(OIDCConfig{
+		ID:         "kc",
+		Claims:     ClaimMapping{RolesClaim: "realm_access.roles", GroupsClaim: "groups", TenantClaim: "org"},
+		GroupRoles: map[string]string{"hr-team": "HR_STAFF", "line-managers": "MANAGER"},
+	})
+	tenant, roles := client.IdentityFromClaims(map[string]any{
+		"org":          "tenant-x",
+		"realm_access": map[string]any{"roles": []any{"admin", "offline_access"}},
+		"groups":       []any{"hr-team", "unmapped"},
+	})
+	if tenant != "tenant-x" {
+		t.Fatalf("unexpected tenant: %s", tenant)
+	}
+	if len(roles) != 2 || roles[0] != "ADMIN" || roles[1] != "HR_STAFF" {
+		t.Fatalf("unexpected roles: %v", roles)
+	}
+
+	bound := NewOIDCClient(OIDCConfig{ID: "bound", TenantID: "tenant-bound", Claims: ClaimMapping{TenantClaim: "org"}})
+	if tenant, _ := bound.IdentityFromClaims(map[string]any{"org": "other"}); tenant != "tenant-bound" {
+		t.Fatalf("tenant-bound provider must ignore tenant claim, got %s", tenant)
+	}
+}
+
+func TestFederatedLoginWithMockIdP(t *testing.T) {
+	idp := newMockOIDCServer(t, "acme-client")
+	h := newTestBFFHandler(t)
+	const tenantID = "7f5c1f0e-3b4a-4e0a-9f7e-1b2c3d4e5f60"
+	h.EnableFederation(staticProviderSource{{
+		ID:           "acme",
+		TenantID:     tenantID,
+		Issuer:       idp.server.URL,
+		ClientID:     "acme-client",
+		RedirectURI:  "http://bff.local/auth/callback",
+		Scopes:       []string{"openid", "profile"},
+		EmailDomains: []string{"acme.com"},
+		Claims:       ClaimMapping{GroupsClaim: "groups"},
+		GroupRoles:   map[string]string{"hr": "HR_STAFF", "ignored": "NOT_A_ROLE"},
+	}})
+	r := chi.NewRouter()
+	h.SetupRoutes(r)
+
+	loginRec := httptest.NewRecorder()
+	r.ServeHTTP(loginRec, httptest.NewRequest(http.MethodGet, "/auth/login?login_hint=jane@acme.com&redirect=/home", nil))
+	if loginRec.Code != http.StatusFound {
+		t.Fatalf("expected redirect to IdP, got %d: %s", loginRec.Code, loginRec.Body.String())
+	}
+	authURL, err := url.Parse(loginRec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("parse auth url: %v", err)
+	}
+	if got := authURL.Scheme + "://" + authURL.Host + authURL.Path; got != idp.server.URL+"/authorize" {
+		t.Fatalf("unexpected authorization endpoint: %s", got)
+	}
+	q := authURL.Query()
+	if q.Get("client_id") != "acme-client" {
+		t.Fatalf("unexpected client_id: %s", q.Get("client_id"))
+	}
+
+	idp.expect(q.Get("nonce"), jwt.MapClaims{"sub": "jane", "email": "jane@acme.com", "groups": []string{"hr", "ignored", "platform-ops"}, "tenant_id": "spoofed",
+		"scope": "job-catalog:clone SYSTEM_OPS_WRITE"})
+	cbRec := httptest.NewRecorder()
+	r.ServeHTTP(cbRec, httptest.NewRequest(http.MethodGet, "/auth/callback?code=abc&state="+url.QueryEscape(q.Get("state")), nil))
+	if cbRec.Code != http.StatusFound || cbRec.Header().Get("Location") != "/home" {
+		t.Fatalf("expected callback redirect to /home, got %d %s: %s", cbRec.Code, cbRec.Header().Get("Location"), cbRec.Body.String())
+	}
+
+	var sid string
+	for _, c := range cbRec.Result().Cookies() {
+		if c.Name == "sid" {
+			sid = c.Value
+		}
+	}
+	sess, ok := h.store.Get(sid)
+	if !ok {
+		t.Fatalf("session not stored")
+	}
+	if sess.TenantID != tenantID || sess.ProviderID != "acme" || sess.UserID != "jane" {
+		t.Fatalf("unexpected session identity: %+v", sess)
+	}
+	if len(sess.Roles) != 1 || sess.Roles[0] != "HR_STAFF" {
+		t.Fatalf("unexpected mapped roles: %v", sess.Roles)
+	}
+	if len(sess.Scopes) != 0 || sess.PlatformOperator {
+		t.Fatalf("tenant IdP scopes must not reach the session: scopes=%v platformOperator=%v", sess.Scopes, sess.PlatformOperator)
+	}
+}
+
+func TestIsPlatformOperatorOnlyFromPlatformProvider(t *testing.T) {
+	claims := map[string]any{"groups": []any{"platform-ops"}}
+	platform := NewOIDCClient(OIDCConfig{ID: defaultProviderID, PlatformOperatorGroup: "platform-ops"})
+	if !platform.IsPlatformOperator(claims) {
+		t.Fatal("expected platform IdP operator group to grant platform operator")
+	}
+	tenant := NewOIDCClient(OIDCConfig{ID: "acme", TenantID: "tenant-a", PlatformOperatorGroup: "platform-ops"})
+	if tenant.IsPlatformOperator(claims) {
+		t.Fatal("tenant IdP must not grant platform operator")
+	}
+	if NewOIDCClient(OIDCConfig{ID: defaultProviderID}).IsPlatformOperator(claims) {
+		t.Fatal("platform operator requires a configured operator group")
+	}
+}
+
+func TestFederatedLoginWithoutMatchingProvider(t *testing.T) {
+	h := newTestBFFHandler(t)
+	h.EnableFederation(staticProviderSource{{ID: "acme", TenantID: "t", Issuer: "https://acme", ClientID: "a", RedirectURI: "https://app/cb", EmailDomains: []string{"acme.com"}}})
+	r := chi.NewRouter()
+	h.SetupRoutes(r)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/login?login_hint=bob@other.org", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 when no provider matches, got %d", rec.Code)
+	}
+}
@@ -11,6 +11,7 @@ type AuthFlowState struct {
 	Nonce        string
 	CodeVerifier string
 	RedirectPath string
+	ProviderID   string // 发起登录的 IdP（多租户联邦）
 	CreatedAt    time.Time
 	ExpiresAt    time.Time
 }
@@ -1,6 +1,7 @@
 package authbff
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"cube-castle/internal/auth"
 	"cube-castle/internal/config"
 	"cube-castle/internal/organization/audit"
 	reqmw "cube-castle/internal/organization/middleware"
@@ -33,8 +35,11 @@ type BFFHandler struct {
 	accessTTL    time.Duration
 	authMode     string // dev|oidc
 	oidc         *OIDCClient
+	providers    *ProviderRegistry
 	flows        *AuthFlowStore
 	auditor      *audit.AuditLogger
+	denylist     auth.TokenDenylist
+	provisioner  RoleProvisioner
 }
 
 func scopedLogger(base pkglogger.Logger, component string, extra pkglogger.Fields) pkglogger.Logger {
@@ -114,6 +119,7 @@ func NewBFFHandler(baseLogger pkglogger.Logger, devMode bool, auditor *audit.Aud
 		authMode:     os.Getenv("AUTH_MODE"),
 		flows:        NewAuthFlowStore(),
 		auditor:      auditor,
+		denylist:     auth.NewInMemoryTokenDenylist(),
 	}
 	// 可选：加载RS256私钥用于对外签名（BFF自签短期token）
 	if h.jwtCfg.Alg == "RS256" {
@@ -150,6 +156,7 @@ func NewBFFHandler(baseLogger pkglogger.Logger, devMode bool, auditor *audit.Aud
 	if addr := os.Getenv("REDIS_ADDR"); addr != "" {
 		if rs, err := NewRedisStore(addr, ttl); err == nil {
 			h.store = rs
+			h.denylist = auth.NewRedisTokenDenylist(rs.Client(), h.logger)
 			h.logger.WithFields(pkglogger.Fields{"redisAddr": addr}).Info("BFF session store initialized")
 		} else {
 			h.logger.WithFields(pkglogger.Fields{"error": err}).Warn("Redis init failed, fallback to in-memory store")
@@ -168,9 +175,20 @@ func NewBFFHandler(baseLogger pkglogger.Logger, devMode bool, auditor *audit.Aud
 	} else {
 		h.logger.Info("OIDC not fully configured; simulation mode may be used")
 	}
+	h.providers = NewProviderRegistry(nil, h.oidc, h.logger)
 	return h
 }
 
+// EnableFederation 启用租户级 IdP（tenant_identity_providers），环境变量提供方作为兜底
+func (h *BFFHandler) EnableFederation(source ProviderSource) {
+	h.providers = NewProviderRegistry(source, h.oidc, h.logger)
+	if err := h.providers.Reload(context.Background()); err != nil {
+		h.logger.WithFields(pkglogger.Fields{"error": err}).Warn("load tenant identity providers failed")
+		return
+	}
+	h.logger.Info("tenant identity provider federation enabled")
+}
+
 func (h *BFFHandler) SetupRoutes(r chi.Router) {
 	r.Get("/auth/login", h.handleLogin)
 	r.Get("/auth/callback", h.handleCallback)
@@ -183,6 +201,11 @@ func (h *BFFHandler) SetupRoutes(r chi.Router) {
 	r.Get("/auth/logout", h.handleLogoutRedirect)
 }
 
+// Denylist 返回会话吊销使用的令牌名单，供 JWT 中间件共享
+func (h *BFFHandler) Denylist() auth.TokenDenylist {
+	return h.denylist
+}
+
 func (h *BFFHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 	redirect := r.URL.Query().Get("redirect")
 	if redirect == "" {
@@ -197,6 +220,7 @@ func (h *BFFHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 	// TODO-TEMPORARY: OIDC集成前的模拟登录，DEV 或 OIDC_SIMULATE 下启用，后续替换为真实IdP跳转。截止：2025-10-17
 	if (h.devMode || os.Getenv("OIDC_SIMULATE") == "true") && !h.isOIDCEnabled() {
 		sess := h.newSimulatedSession()
+		h.attachClientInfo(sess, r)
 		h.store.Set(sess)
 		h.setSessionCookies(w, sess)
 		logger.WithFields(pkglogger.Fields{"sessionId": sess.ID, "tenantId": sess.TenantID}).Info("simulated login session established")
@@ -210,8 +234,18 @@ func (h *BFFHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 		logger.Warn("OIDC not configured; login aborted")
 		return
 	}
+	// 选择 IdP：租户提示 > 邮箱域名 > 默认提供方
+	tenantHint := firstNonEmpty(r.URL.Query().Get("tenant"), r.URL.Query().Get("tenantId"))
+	emailHint := firstNonEmpty(r.URL.Query().Get("login_hint"), r.URL.Query().Get("email"))
+	client, ok := h.providers.Resolve(r.Context(), tenantHint, emailHint)
+	if !ok {
+		logger.WithFields(pkglogger.Fields{"tenantHint": tenantHint}).Warn("no identity provider matched login hints")
+		_ = utils.WriteError(w, http.StatusBadRequest, ErrIdPNotFound, "未找到匹配的身份提供方", reqmw.GetRequestID(r.Context()), map[string]string{"tenant": tenantHint})
+		return
+	}
+	logger = logger.WithFields(pkglogger.Fields{"providerId": client.ProviderID()})
 	// 构建授权请求
-	doc, err := h.oidc.Discover()
+	doc, err := client.Discover()
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("OIDC discovery failed during login")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), "OIDC discovery failed: "+err.Error())
@@ -222,8 +256,8 @@ func (h *BFFHandler) handleLogin(w http.ResponseWriter, r *http.Request) {
 	codeVerifier := randomString(64)
 	challenge := BuildCodeChallenge(codeVerifier)
 	// 保存flow（10分钟）
-	h.flows.Set(&AuthFlowState{State: state, Nonce: nonce, CodeVerifier: codeVerifier, RedirectPath: redirect, CreatedAt: time.Now(), ExpiresAt: time.Now().Add(10 * time.Minute)})
-	authURL, err := h.oidc.BuildAuthURL(doc.AuthorizationEndpoint, state, nonce, challenge, redirect)
+	h.flows.Set(&AuthFlowState{State: state, Nonce: nonce, CodeVerifier: codeVerifier, RedirectPath: redirect, ProviderID: client.ProviderID(), CreatedAt: time.Now(), ExpiresAt: time.Now().Add(10 * time.Minute)})
+	authURL, err := client.BuildAuthURL(doc.AuthorizationEndpoint, state, nonce, challenge, redirect)
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to build OIDC authorization URL")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), "build auth url failed: "+err.Error())
@@ -243,6 +277,7 @@ func (h *BFFHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 	})
 	if (h.devMode || os.Getenv("OIDC_SIMULATE") == "true") && !h.isOIDCEnabled() {
 		sess := h.newSimulatedSession()
+		h.attachClientInfo(sess, r)
 		h.store.Set(sess)
 		h.setSessionCookies(w, sess)
 		logger.WithFields(pkglogger.Fields{"sessionId": sess.ID}).Info("completed simulated callback")
@@ -273,14 +308,21 @@ func (h *BFFHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 		_ = utils.WriteError(w, http.StatusUnauthorized, "STATE_EXPIRED", "state已过期或无效", reqmw.GetRequestID(r.Context()), nil)
 		return
 	}
+	client, ok := h.providers.Get(r.Context(), flow.ProviderID)
+	if !ok {
+		logger.WithFields(pkglogger.Fields{"providerId": flow.ProviderID}).Warn("identity provider of auth flow no longer available")
+		_ = utils.WriteError(w, http.StatusBadRequest, ErrIdPNotFound, "身份提供方不可用", reqmw.GetRequestID(r.Context()), nil)
+		return
+	}
+	logger = logger.WithFields(pkglogger.Fields{"providerId": client.ProviderID()})
 	// 令牌交换
-	doc, err := h.oidc.Discover()
+	doc, err := client.Discover()
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("OIDC discovery failed during callback")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), "discovery failed: "+err.Error())
 		return
 	}
-	tr, err := h.oidc.ExchangeCode(doc.TokenEndpoint, code, flow.CodeVerifier)
+	tr, err := client.ExchangeCode(doc.TokenEndpoint, code, flow.CodeVerifier)
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("OIDC token exchange failed")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), "token exchange failed: "+err.Error())
@@ -288,18 +330,15 @@ func (h *BFFHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// 校验并解析ID Token（开发阶段弱校验）
-	claims, err := h.oidc.ValidateIDToken(tr.IDToken, flow.Nonce)
+	claims, err := client.ValidateIDToken(tr.IDToken, flow.Nonce)
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Warn("OIDC id token invalid")
 		_ = utils.WriteError(w, http.StatusUnauthorized, "ID_TOKEN_INVALID", err.Error(), reqmw.GetRequestID(r.Context()), nil)
 		h.logAuthError(r, "OIDC_CALLBACK", "ID_TOKEN_INVALID", err.Error(), map[string]any{"state": state})
 		return
 	}
-	// 构建会话
-	tenant := getStringClaim(claims, "tenantId")
-	if tenant == "" {
-		tenant = getStringClaim(claims, "tenant_id")
-	}
+	// 构建会话（租户与角色按提供方 claim 映射解析）
+	tenant, roles := client.IdentityFromClaims(claims)
 	if tenant == "" {
 		tenant = os.Getenv("DEFAULT_TENANT_ID")
 	}
@@ -309,34 +348,40 @@ func (h *BFFHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 	userID := getStringClaim(claims, "sub")
 	userName := getStringClaim(claims, "name")
 	userEmail := getStringClaim(claims, "email")
-	// 角色/作用域（可选）
-	var scopes []string
-	if s := getStringClaim(claims, "scope"); s != "" {
-		scopes = strings.Fields(s)
-	}
+	// IdP 声明的 scope 不进入会话（租户可自行控制其 IdP）；平台级权限仅由平台 IdP 的运营组授予
+	platformOperator := client.IsPlatformOperator(claims)
 	sess := &Session{
-		ID:         uuid.NewString(),
-		UserID:     userID,
-		UserName:   userName,
-		UserEmail:  userEmail,
-		TenantID:   tenant,
-		Roles:      []string{},
-		Scopes:     scopes,
-		RefreshTok: tr.RefreshToken,
-		IDToken:    tr.IDToken,
-		CreatedAt:  time.Now().UTC(),
-		LastUsedAt: time.Now().UTC(),
-		ExpiresAt:  time.Now().UTC().Add(h.sessionTTL),
+		ID:               uuid.NewString(),
+		UserID:           userID,
+		UserName:         userName,
+		UserEmail:        userEmail,
+		TenantID:         tenant,
+		Roles:            roles,
+		PlatformOperator: platformOperator,
+		RefreshTok:       tr.RefreshToken,
+		IDToken:          tr.IDToken,
+		ProviderID:       client.ProviderID(),
+		CreatedAt:        time.Now().UTC(),
+		LastUsedAt:       time.Now().UTC(),
+		ExpiresAt:        time.Now().UTC().Add(h.sessionTTL),
 	}
-	h.store.Set(sess)
-	h.setSessionCookies(w, sess)
 	// 清理flow
 	h.flows.Delete(state)
-	h.logAuthSuccess(r, tenant, userID, "LOGIN", map[string]any{"scopes": scopes})
+	if _, err := h.tokenSession(r, sess); err != nil {
+		logger.WithFields(pkglogger.Fields{"userId": userID, "tenantId": tenant}).Warn("login rejected for deprovisioned user")
+		h.logAuthError(r, "OIDC_CALLBACK", ErrUserDeprovisioned, err.Error(), map[string]any{"userId": userID})
+		_ = utils.WriteError(w, http.StatusForbidden, ErrUserDeprovisioned, "用户已被停用", reqmw.GetRequestID(r.Context()), nil)
+		return
+	}
+	h.attachClientInfo(sess, r)
+	h.store.Set(sess)
+	h.setSessionCookies(w, sess)
+	h.logAuthSuccess(r, tenant, userID, "LOGIN", map[string]any{"platformOperator": platformOperator, "roles": roles, "providerId": client.ProviderID()})
 	logger.WithFields(pkglogger.Fields{
-		"userId":   userID,
-		"tenantId": tenant,
-		"scopes":   scopes,
+		"userId":           userID,
+		"tenantId":         tenant,
+		"roles":            roles,
+		"platformOperator": platformOperator,
 	}).Info("OIDC login success")
 	// 回跳到发起页
 	target := redirect
@@ -361,7 +406,15 @@ func (h *BFFHandler) handleSession(w http.ResponseWriter, r *http.Request) {
 		_ = utils.WriteError(w, http.StatusUnauthorized, "SESSION_EXPIRED", "会话已过期", reqmw.GetRequestID(r.Context()), nil)
 		return
 	}
-	token, exp, err := MintAccessToken(h.jwtCfg, sess, h.accessTTL)
+	mintSess, err := h.tokenSession(r, sess)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"userId": sess.UserID}).Warn("deprovisioned user session rejected")
+		h.rejectDeprovisioned(w, r, sess)
+		return
+	}
+	sess.LastUsedAt = time.Now().UTC()
+	h.store.Set(sess)
+	token, exp, err := MintAccessToken(h.jwtCfg, mintSess, h.accessTTL)
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to mint access token in session handler")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), err.Error())
@@ -398,10 +451,10 @@ func (h *BFFHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 若启用 OIDC，优先使用 refresh token 调用 IdP 轮换（若失败，按 419 处理）
-	if h.isOIDCEnabled() && sess.RefreshTok != "" {
-		doc, err := h.oidc.Discover()
+	if client, ok := h.sessionProvider(r, sess); ok && sess.RefreshTok != "" {
+		doc, err := client.Discover()
 		if err == nil {
-			if tr, err2 := h.oidc.RefreshWithToken(doc.TokenEndpoint, sess.RefreshTok); err2 == nil {
+			if tr, err2 := client.RefreshWithToken(doc.TokenEndpoint, sess.RefreshTok); err2 == nil {
 				// 刷新成功：更新服务端 refresh token（rotation）
 				if tr.RefreshToken != "" {
 					logger.Info("OIDC refresh token rotated")
@@ -421,7 +474,13 @@ func (h *BFFHandler) handleRefresh(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	token, exp, err := MintAccessToken(h.jwtCfg, sess, h.accessTTL)
+	mintSess, err := h.tokenSession(r, sess)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"userId": sess.UserID}).Warn("deprovisioned user refresh rejected")
+		h.rejectDeprovisioned(w, r, sess)
+		return
+	}
+	token, exp, err := MintAccessToken(h.jwtCfg, mintSess, h.accessTTL)
 	if err != nil {
 		logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to mint access token during refresh")
 		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), err.Error())
@@ -449,6 +508,7 @@ func (h *BFFHandler) handleLogout(w http.ResponseWriter, r *http.Request) {
 			sess = s
 		}
 		h.store.Delete(sid.Value)
+		h.revokeSessionTokens(r, sid.Value)
 	}
 	// 清除Cookie
 	h.clearCookie(w, "sid")
@@ -473,11 +533,13 @@ func (h *BFFHandler) handleLogoutRedirect(w http.ResponseWriter, r *http.Request
 	// 清理本地会话与Cookie
 	if sid != nil && sid.Value != "" {
 		h.store.Delete(sid.Value)
+		h.revokeSessionTokens(r, sid.Value)
 	}
 	h.clearCookie(w, "sid")
 	h.clearCookie(w, "csrf")
 
-	if !h.isOIDCEnabled() || sess == nil || sess.IDToken == "" {
+	client, hasClient := h.sessionProvider(r, sess)
+	if !hasClient || sess.IDToken == "" {
 		// 无法联动IdP，回到首页或redirect
 		redirect := r.URL.Query().Get("redirect")
 		if redirect == "" {
@@ -488,7 +550,7 @@ func (h *BFFHandler) handleLogoutRedirect(w http.ResponseWriter, r *http.Request
 		return
 	}
 	// 发现文档
-	doc, err := h.oidc.Discover()
+	doc, err := client.Discover()
 	if err != nil || doc.EndSessionEndpoint == "" {
 		redirect := r.URL.Query().Get("redirect")
 		if redirect == "" {
@@ -502,7 +564,7 @@ func (h *BFFHandler) handleLogoutRedirect(w http.ResponseWriter, r *http.Request
 	u, _ := url.Parse(doc.EndSessionEndpoint)
 	q := u.Query()
 	q.Set("id_token_hint", sess.IDToken)
-	postLogout := h.oidc.cfg.PostLogoutURI
+	postLogout := client.cfg.PostLogoutURI
 	if v := r.URL.Query().Get("redirect"); v != "" {
 		postLogout = v
 	}
@@ -568,7 +630,7 @@ func getCookieValue(cookie *http.Cookie) string {
 }
 
 func (h *BFFHandler) handleWellKnown(w http.ResponseWriter, r *http.Request) {
-	if !h.isOIDCEnabled() {
+	if h.oidc == nil || !h.oidc.IsConfigured() {
 		_ = utils.WriteError(w, http.StatusNotImplemented, "OIDC_NOT_CONFIGURED", "OIDC未配置", reqmw.GetRequestID(r.Context()), nil)
 		return
 	}
@@ -602,7 +664,24 @@ func (h *BFFHandler) handleJWKS(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *BFFHandler) isOIDCEnabled() bool {
-	return h.oidc != nil && h.oidc.IsConfigured()
+	return h.providers != nil && h.providers.HasProviders(context.Background())
+}
+
+// sessionProvider 返回会话登录时使用的 IdP 客户端
+func (h *BFFHandler) sessionProvider(r *http.Request, sess *Session) (*OIDCClient, bool) {
+	if sess == nil || h.providers == nil {
+		return nil, false
+	}
+	return h.providers.Get(r.Context(), sess.ProviderID)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
 }
 
 func getStringClaim(m map[string]any, key string) string {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type JWTMintConfig struct {
@@ -42,13 +43,20 @@ func MintAccessToken(cfg JWTMintConfig, sess *Session, ttl time.Duration) (strin
 		"exp":       exp,
 		"sub":       sess.UserID,
 		"tenant_id": sess.TenantID,
+		// jti/sid 用于会话吊销后拒绝已签发的访问令牌
+		"jti": uuid.NewString(),
+		"sid": sess.ID,
 	}
 	if len(sess.Roles) > 0 {
 		claims["roles"] = sess.Roles
 	}
-	if len(sess.Scopes) > 0 {
+	// 仅 BFF 自建的模拟会话携带 scope；IdP 会话（含修复前已存储的会话）的 scope 不写入令牌
+	if sess.ProviderID == "" && len(sess.Scopes) > 0 {
 		claims["scope"] = strings.Join(sess.Scopes, " ")
 	}
+	if sess.PlatformOperator {
+		claims["platform_operator"] = true
+	}
 
 	if cfg.PrivateKey == nil {
 		return "", 0, fmt.Errorf("RS256 private key not configured")
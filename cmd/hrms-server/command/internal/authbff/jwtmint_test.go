@@ -0,0 +1,40 @@
+package authbff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func mintedClaims(t *testing.T, h *BFFHandler, sess *Session) jwt.MapClaims {
+	t.Helper()
+	token, _, err := MintAccessToken(h.jwtCfg, sess, time.Minute)
+	if err != nil {
+		t.Fatalf("mint access token: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return &h.jwtCfg.PrivateKey.PublicKey, nil }); err != nil {
+		t.Fatalf("parse access token: %v", err)
+	}
+	return claims
+}
+
+func TestMintAccessTokenDropsIdPScopes(t *testing.T) {
+	h := newTestBFFHandler(t)
+
+	// 修复前存储的 IdP 会话可能仍带有租户 IdP 声明的 scope
+	idpSess := &Session{ID: "s1", UserID: "jane", TenantID: adminTenantA, ProviderID: "acme", Scopes: []string{"job-catalog:clone", "SYSTEM_OPS_WRITE"}}
+	claims := mintedClaims(t, h, idpSess)
+	if _, ok := claims["scope"]; ok {
+		t.Fatalf("IdP session scopes must not be minted: %v", claims["scope"])
+	}
+	if _, ok := claims["platform_operator"]; ok {
+		t.Fatal("non-operator session must not carry platform_operator")
+	}
+
+	operator := &Session{ID: "s2", UserID: "ops", TenantID: adminTenantA, ProviderID: defaultProviderID, PlatformOperator: true}
+	if op, _ := mintedClaims(t, h, operator)["platform_operator"].(bool); !op {
+		t.Fatal("expected platform operator claim for operator session")
+	}
+}
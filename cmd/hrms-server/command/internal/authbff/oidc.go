@@ -17,13 +17,21 @@ import (
 	jwt "github.com/golang-jwt/jwt/v5"
 )
 
-// OIDCConfig 基础配置（从环境变量读取）
+// OIDCConfig 基础配置（环境变量默认提供方，或 tenant_identity_providers 中的租户提供方）
 type OIDCConfig struct {
+	ID            string // 提供方标识（环境变量提供方为 default）
+	TenantID      string // 绑定租户（为空表示从 claim/默认租户解析）
 	Issuer        string
 	ClientID      string
+	ClientSecret  string
 	RedirectURI   string
 	PostLogoutURI string
 	Scopes        []string
+	EmailDomains  []string
+	Claims        ClaimMapping
+	GroupRoles    map[string]string // IdP 组 → 角色（RolePermissions）
+	// PlatformOperatorGroup 平台运营组（仅环境变量默认提供方可配置，租户提供方不读取）
+	PlatformOperatorGroup string
 }
 
 // DiscoveryDoc OIDC 发现文档关键字段
@@ -51,23 +59,53 @@ func NewOIDCClientFromEnv() *OIDCClient {
 	if strings.TrimSpace(scopes) == "" {
 		scopes = "openid profile email"
 	}
-	return &OIDCClient{
-		cfg: OIDCConfig{
-			Issuer:        strings.TrimSpace(os.Getenv("OIDC_ISSUER")),
-			ClientID:      os.Getenv("OIDC_CLIENT_ID"),
-			RedirectURI:   os.Getenv("OIDC_REDIRECT_URI"),
-			PostLogoutURI: os.Getenv("OIDC_POST_LOGOUT_REDIRECT_URI"),
-			Scopes:        strings.Fields(scopes),
+	return NewOIDCClient(OIDCConfig{
+		ID:            defaultProviderID,
+		Issuer:        strings.TrimSpace(os.Getenv("OIDC_ISSUER")),
+		ClientID:      os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret:  os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURI:   os.Getenv("OIDC_REDIRECT_URI"),
+		PostLogoutURI: os.Getenv("OIDC_POST_LOGOUT_REDIRECT_URI"),
+		Scopes:        strings.Fields(scopes),
+		Claims: ClaimMapping{
+			RolesClaim:  strings.TrimSpace(os.Getenv("OIDC_ROLES_CLAIM")),
+			GroupsClaim: strings.TrimSpace(os.Getenv("OIDC_GROUPS_CLAIM")),
+			TenantClaim: strings.TrimSpace(os.Getenv("OIDC_TENANT_CLAIM")),
 		},
+		PlatformOperatorGroup: strings.TrimSpace(os.Getenv("OIDC_PLATFORM_OPERATOR_GROUP")),
+	})
+}
+
+// NewOIDCClient 按配置创建客户端（每个提供方独立的发现文档与 JWKS 缓存）
+func NewOIDCClient(cfg OIDCConfig) *OIDCClient {
+	return &OIDCClient{
+		cfg:      cfg,
 		httpCli:  &http.Client{Timeout: 10 * time.Second},
 		cacheTTL: 10 * time.Minute,
 	}
 }
 
+// ProviderID 返回提供方标识
+func (c *OIDCClient) ProviderID() string {
+	if c == nil {
+		return ""
+	}
+	return c.cfg.ID
+}
+
 func (c *OIDCClient) IsConfigured() bool {
 	return c != nil && c.cfg.Issuer != "" && c.cfg.ClientID != "" && c.cfg.RedirectURI != ""
 }
 
+// inheritDiscovery 复用同一 issuer/client 的发现文档与 JWKS 缓存（配置热加载时避免重复发现）
+func (c *OIDCClient) inheritDiscovery(prev *OIDCClient) {
+	prev.mu.RLock()
+	defer prev.mu.RUnlock()
+	c.cachedDoc = prev.cachedDoc
+	c.cachedAt = prev.cachedAt
+	c.jwks = prev.jwks
+}
+
 // Discover 拉取并缓存发现文档
 func (c *OIDCClient) Discover() (*DiscoveryDoc, error) {
 	c.mu.RLock()
@@ -131,6 +169,9 @@ func (c *OIDCClient) ExchangeCode(tokenEndpoint, code, codeVerifier string) (*To
 	form.Set("client_id", c.cfg.ClientID)
 	form.Set("redirect_uri", c.cfg.RedirectURI)
 	form.Set("code_verifier", codeVerifier)
+	if c.cfg.ClientSecret != "" {
+		form.Set("client_secret", c.cfg.ClientSecret)
+	}
 	req, _ := http.NewRequest("POST", tokenEndpoint, strings.NewReader(form.Encode()))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	resp, err := c.httpCli.Do(req)
@@ -265,6 +306,9 @@ func (c *OIDCClient) RefreshWithToken(tokenEndpoint, refreshToken string) (*Toke
 	form.Set("grant_type", "refresh_token")
 	form.Set("refresh_token", refreshToken)
 	form.Set("client_id", c.cfg.ClientID)
+	if c.cfg.ClientSecret != "" {
+		form.Set("client_secret", c.cfg.ClientSecret)
+	}
 	req, _ := http.NewRequest("POST", tokenEndpoint, strings.NewReader(form.Encode()))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	resp, err := c.httpCli.Do(req)
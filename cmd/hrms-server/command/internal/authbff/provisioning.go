@@ -0,0 +1,78 @@
+package authbff
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	reqmw "cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// errUserDeprovisioned 用户已在 SCIM 中停用/删除供应
+var errUserDeprovisioned = errors.New("user deprovisioned")
+
+// RoleProvisioner 外部供应（SCIM）的角色来源
+//
+// found=false 表示用户未经供应，仅使用 IdP 角色；found=true 且 active=false 时拒绝签发令牌。
+type RoleProvisioner interface {
+	ProvisionedRoles(ctx context.Context, tenantID, subject, email string) (roles []string, active bool, found bool, err error)
+}
+
+// SetRoleProvisioner 启用令牌签发时的供应角色合并
+func (h *BFFHandler) SetRoleProvisioner(p RoleProvisioner) {
+	h.provisioner = p
+}
+
+// tokenSession 返回用于签发访问令牌的会话副本：角色为会话角色与供应角色的并集。
+// 供应来源不可用时降级为会话角色（仅记录告警）。
+func (h *BFFHandler) tokenSession(r *http.Request, sess *Session) (*Session, error) {
+	if h.provisioner == nil || sess == nil {
+		return sess, nil
+	}
+	roles, active, found, err := h.provisioner.ProvisionedRoles(r.Context(), sess.TenantID, sess.UserID, sess.UserEmail)
+	if err != nil {
+		h.requestLogger(r, "tokenSession", pkglogger.Fields{"userId": sess.UserID, "error": err}).Warn("provisioned roles unavailable, falling back to session roles")
+		return sess, nil
+	}
+	if !found {
+		return sess, nil
+	}
+	if !active {
+		return nil, errUserDeprovisioned
+	}
+	merged := *sess
+	merged.Roles = mergeRoles(sess.Roles, roles)
+	return &merged, nil
+}
+
+// rejectDeprovisioned 删除已停用用户的会话并吊销其令牌
+func (h *BFFHandler) rejectDeprovisioned(w http.ResponseWriter, r *http.Request, sess *Session) {
+	h.store.Delete(sess.ID)
+	h.revokeSessionTokens(r, sess.ID)
+	h.clearCookie(w, "sid")
+	h.clearCookie(w, "csrf")
+	h.logAuthError(r, "TOKEN_MINT", ErrUserDeprovisioned, errUserDeprovisioned.Error(), map[string]any{"userId": sess.UserID})
+	_ = utils.WriteError(w, http.StatusForbidden, ErrUserDeprovisioned, "用户已被停用", reqmw.GetRequestID(r.Context()), nil)
+}
+
+func mergeRoles(base, extra []string) []string {
+	seen := make(map[string]struct{}, len(base)+len(extra))
+	out := make([]string, 0, len(base)+len(extra))
+	for _, list := range [][]string{base, extra} {
+		for _, role := range list {
+			role = strings.ToUpper(strings.TrimSpace(role))
+			if role == "" {
+				continue
+			}
+			if _, dup := seen[role]; dup {
+				continue
+			}
+			seen[role] = struct{}{}
+			out = append(out, role)
+		}
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+package authbff
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cube-castle/internal/auth"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type stubProvisioner struct {
+	roles  []string
+	active bool
+	found  bool
+}
+
+func (s stubProvisioner) ProvisionedRoles(context.Context, string, string, string) ([]string, bool, bool, error) {
+	return s.roles, s.active, s.found, nil
+}
+
+func sessionRequest(h *BFFHandler, sessID string) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	h.SetupRoutes(r)
+	req := httptest.NewRequest(http.MethodGet, "/auth/session", nil)
+	req.AddCookie(&http.Cookie{Name: "sid", Value: sessID})
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func storeTestSession(h *BFFHandler, roles []string) *Session {
+	sess := &Session{
+		ID:        "sess-1",
+		UserID:    "jane",
+		UserEmail: "jane@acme.com",
+		TenantID:  "7f5c1f0e-3b4a-4e0a-9f7e-1b2c3d4e5f60",
+		Roles:     roles,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+	}
+	h.store.Set(sess)
+	return sess
+}
+
+func TestSessionTokenMergesProvisionedRoles(t *testing.T) {
+	h := newTestBFFHandler(t)
+	h.SetRoleProvisioner(stubProvisioner{roles: []string{"MANAGER", "EMPLOYEE"}, active: true, found: true})
+	sess := storeTestSession(h, []string{"employee"})
+
+	rec := sessionRequest(h, sess.ID)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected session ok, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Data struct {
+			AccessToken string `json:"accessToken"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode session response: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(body.Data.AccessToken, claims); err != nil {
+		t.Fatalf("parse minted token: %v", err)
+	}
+	roles, _ := claims["roles"].([]any)
+	if len(roles) != 2 || roles[0] != "EMPLOYEE" || roles[1] != "MANAGER" {
+		t.Fatalf("unexpected minted roles: %v", claims["roles"])
+	}
+	if stored, _ := h.store.Get(sess.ID); len(stored.Roles) != 1 {
+		t.Fatalf("provisioned roles must not be persisted into the session: %v", stored.Roles)
+	}
+}
+
+func TestSessionRejectedForDeprovisionedUser(t *testing.T) {
+	h := newTestBFFHandler(t)
+	h.SetRoleProvisioner(stubProvisioner{active: false, found: true})
+	sess := storeTestSession(h, []string{"EMPLOYEE"})
+
+	rec := sessionRequest(h, sess.ID)
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for deprovisioned user, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if _, ok := h.store.Get(sess.ID); ok {
+		t.Fatalf("session of deprovisioned user should be deleted")
+	}
+	if !h.denylist.IsRevoked(context.Background(), &auth.Claims{SessionID: sess.ID}) {
+		t.Fatalf("session tokens should be denylisted")
+	}
+}
@@ -1,24 +1,30 @@
 package authbff
 
 import (
+	"sort"
 	"sync"
 	"time"
 )
 
 // Session 服务器侧会话（仅保存刷新令牌/用户与租户信息）
 type Session struct {
-	ID         string
-	UserID     string
-	UserName   string
-	UserEmail  string
-	TenantID   string
-	Roles      []string
-	Scopes     []string
-	RefreshTok string
-	IDToken    string
-	CreatedAt  time.Time
-	LastUsedAt time.Time
-	ExpiresAt  time.Time // 会话总体过期（如30天）
+	ID        string
+	UserID    string
+	UserName  string
+	UserEmail string
+	TenantID  string
+	Roles     []string
+	Scopes    []string
+	// PlatformOperator 平台运营方（由平台 IdP 的运营组授予，写入访问令牌 platform_operator claim）
+	PlatformOperator bool
+	RefreshTok       string
+	IDToken          string
+	ProviderID       string // 登录所用 IdP（刷新/退出沿用）
+	IPAddress        string
+	UserAgent        string
+	CreatedAt        time.Time
+	LastUsedAt       time.Time
+	ExpiresAt        time.Time // 会话总体过期（如30天）
 }
 
 // Store 会话存储接口（默认内存实现，后续可切换 Redis）
@@ -26,6 +32,10 @@ type Store interface {
 	Set(*Session)
 	Get(id string) (*Session, bool)
 	Delete(id string)
+	// ListByUser 返回指定用户的有效会话（按创建时间倒序）
+	ListByUser(tenantID, userID string) []*Session
+	// ListByTenant 返回指定租户的有效会话（按创建时间倒序）
+	ListByTenant(tenantID string) []*Session
 }
 
 // InMemoryStore 简单内存实现（单实例）
@@ -56,3 +66,36 @@ func (s *InMemoryStore) Delete(id string) {
 	defer s.mu.Unlock()
 	delete(s.data, id)
 }
+
+func (s *InMemoryStore) ListByUser(tenantID, userID string) []*Session {
+	return s.filter(func(sess *Session) bool {
+		return sess.TenantID == tenantID && sess.UserID == userID
+	})
+}
+
+func (s *InMemoryStore) ListByTenant(tenantID string) []*Session {
+	return s.filter(func(sess *Session) bool {
+		return sess.TenantID == tenantID
+	})
+}
+
+func (s *InMemoryStore) filter(match func(*Session) bool) []*Session {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := time.Now()
+	out := make([]*Session, 0)
+	for _, sess := range s.data {
+		if now.After(sess.ExpiresAt) || !match(sess) {
+			continue
+		}
+		out = append(out, sess)
+	}
+	sortSessions(out)
+	return out
+}
+
+func sortSessions(list []*Session) {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.After(list[j].CreatedAt)
+	})
+}
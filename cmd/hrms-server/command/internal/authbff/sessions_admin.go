@@ -0,0 +1,207 @@
+package authbff
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"cube-castle/internal/auth"
+	"cube-castle/internal/organization/audit"
+	reqmw "cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// SessionView 会话对外视图（不暴露刷新令牌/ID Token）
+type SessionView struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"userId"`
+	UserName   string    `json:"userName,omitempty"`
+	UserEmail  string    `json:"userEmail,omitempty"`
+	TenantID   string    `json:"tenantId"`
+	IPAddress  string    `json:"ipAddress,omitempty"`
+	Device     string    `json:"device,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+type revokeSessionsRequest struct {
+	Reason string `json:"reason"`
+}
+
+// SetupAdminRoutes 会话管理端点（需挂载在 JWT/PBAC 认证分组内，权限 SESSION_ADMIN）
+func (h *BFFHandler) SetupAdminRoutes(r chi.Router) {
+	r.Get("/api/v1/auth/sessions", h.handleListSessions)
+	r.Delete("/api/v1/auth/sessions/{sessionId}", h.handleRevokeSession)
+	r.Post("/api/v1/auth/sessions/revoke-all", h.handleRevokeTenantSessions)
+	r.Post("/api/v1/auth/users/{userId}/sessions/revoke", h.handleRevokeUserSessions)
+}
+
+func (h *BFFHandler) handleListSessions(w http.ResponseWriter, r *http.Request) {
+	tenantID := auth.GetTenantID(r.Context())
+	userID := strings.TrimSpace(r.URL.Query().Get("userId"))
+	logger := h.requestLogger(r, "handleListSessions", pkglogger.Fields{"tenantId": tenantID, "userId": userID})
+
+	var sessions []*Session
+	if userID != "" {
+		sessions = h.store.ListByUser(tenantID, userID)
+	} else {
+		sessions = h.store.ListByTenant(tenantID)
+	}
+	views := make([]SessionView, 0, len(sessions))
+	for _, sess := range sessions {
+		views = append(views, toSessionView(sess))
+	}
+	_ = utils.WriteSuccess(w, map[string]any{"sessions": views, "total": len(views)}, "Active sessions", reqmw.GetRequestID(r.Context()))
+	logger.WithFields(pkglogger.Fields{"count": len(views)}).Info("listed active sessions")
+}
+
+func (h *BFFHandler) handleRevokeSession(w http.ResponseWriter, r *http.Request) {
+	tenantID := auth.GetTenantID(r.Context())
+	sessionID := chi.URLParam(r, "sessionId")
+	logger := h.requestLogger(r, "handleRevokeSession", pkglogger.Fields{"tenantId": tenantID, "sessionId": sessionID})
+
+	sess, ok := h.store.Get(sessionID)
+	if !ok || sess.TenantID != tenantID {
+		_ = utils.WriteError(w, http.StatusNotFound, ErrSessionNotFound, "会话不存在或已过期", reqmw.GetRequestID(r.Context()), nil)
+		return
+	}
+	reason := decodeRevokeReason(r)
+	h.store.Delete(sess.ID)
+	h.revokeSessionTokens(r, sess.ID)
+	h.logSessionRevocation(r, tenantID, sess.UserID, "REVOKE_SESSION", reason, []string{sess.ID})
+	logger.WithFields(pkglogger.Fields{"userId": sess.UserID}).Info("session revoked")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BFFHandler) handleRevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	tenantID := auth.GetTenantID(r.Context())
+	userID := chi.URLParam(r, "userId")
+	logger := h.requestLogger(r, "handleRevokeUserSessions", pkglogger.Fields{"tenantId": tenantID, "userId": userID})
+
+	reason := decodeRevokeReason(r)
+	revoked := h.deleteSessions(r, h.store.ListByUser(tenantID, userID))
+	if err := h.denylist.RevokeUser(r.Context(), tenantID, userID, time.Now().UTC(), h.accessTTL); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to denylist user tokens")
+		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), err.Error())
+		return
+	}
+	h.logSessionRevocation(r, tenantID, userID, "REVOKE_USER_SESSIONS", reason, revoked)
+	_ = utils.WriteSuccess(w, map[string]any{"userId": userID, "revokedSessions": revoked}, "User sessions revoked", reqmw.GetRequestID(r.Context()))
+	logger.WithFields(pkglogger.Fields{"count": len(revoked)}).Info("user sessions revoked")
+}
+
+func (h *BFFHandler) handleRevokeTenantSessions(w http.ResponseWriter, r *http.Request) {
+	tenantID := auth.GetTenantID(r.Context())
+	logger := h.requestLogger(r, "handleRevokeTenantSessions", pkglogger.Fields{"tenantId": tenantID})
+
+	reason := decodeRevokeReason(r)
+	revoked := h.deleteSessions(r, h.store.ListByTenant(tenantID))
+	if err := h.denylist.RevokeTenant(r.Context(), tenantID, time.Now().UTC(), h.accessTTL); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to denylist tenant tokens")
+		_ = utils.WriteInternalError(w, reqmw.GetRequestID(r.Context()), err.Error())
+		return
+	}
+	h.logSessionRevocation(r, tenantID, tenantID, "REVOKE_TENANT_SESSIONS", reason, revoked)
+	_ = utils.WriteSuccess(w, map[string]any{"tenantId": tenantID, "revokedSessions": revoked}, "Tenant sessions revoked", reqmw.GetRequestID(r.Context()))
+	logger.WithFields(pkglogger.Fields{"count": len(revoked)}).Warn("tenant sessions revoked")
+}
+
+// deleteSessions 删除会话并逐个吊销其 sid：用户/租户吊销只拒绝 cutoff 所在秒之前签发的令牌，
+// 同一秒内由这些会话签发的令牌依赖 sid 吊销拒绝
+func (h *BFFHandler) deleteSessions(r *http.Request, sessions []*Session) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, sess := range sessions {
+		h.store.Delete(sess.ID)
+		h.revokeSessionTokens(r, sess.ID)
+		ids = append(ids, sess.ID)
+	}
+	return ids
+}
+
+// revokeSessionTokens 将会话派生的访问令牌加入吊销名单（名单条目在访问令牌最长有效期后自动失效）
+func (h *BFFHandler) revokeSessionTokens(r *http.Request, sessionID string) {
+	if h.denylist == nil || sessionID == "" {
+		return
+	}
+	ctx := context.Background()
+	if r != nil {
+		ctx = r.Context()
+	}
+	if err := h.denylist.RevokeSession(ctx, sessionID, h.accessTTL); err != nil {
+		h.requestLogger(r, "revokeSessionTokens", pkglogger.Fields{"sessionId": sessionID, "error": err}).Warn("failed to denylist session tokens")
+	}
+}
+
+func (h *BFFHandler) logSessionRevocation(r *http.Request, tenantID, resourceID, action, reason string, sessionIDs []string) {
+	if h.auditor == nil {
+		return
+	}
+	tid, _ := uuid.Parse(tenantID)
+	_ = h.auditor.LogEvent(r.Context(), &audit.AuditEvent{
+		TenantID:        tid,
+		EventType:       audit.EventTypeAuth,
+		ResourceType:    audit.ResourceTypeUser,
+		ResourceID:      resourceID,
+		ActorID:         auth.GetUserID(r.Context()),
+		ActorType:       audit.ActorTypeUser,
+		ActionName:      action,
+		RequestID:       reqmw.GetRequestID(r.Context()),
+		OperationReason: reason,
+		Success:         true,
+		AfterData:       map[string]any{"revokedSessions": sessionIDs},
+	})
+}
+
+func decodeRevokeReason(r *http.Request) string {
+	if r.Body == nil || r.ContentLength == 0 {
+		return ""
+	}
+	var req revokeSessionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(req.Reason)
+}
+
+func (h *BFFHandler) attachClientInfo(sess *Session, r *http.Request) {
+	if sess == nil || r == nil {
+		return
+	}
+	sess.IPAddress = clientIP(r)
+	sess.UserAgent = r.UserAgent()
+}
+
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+func toSessionView(sess *Session) SessionView {
+	return SessionView{
+		ID:         sess.ID,
+		UserID:     sess.UserID,
+		UserName:   sess.UserName,
+		UserEmail:  sess.UserEmail,
+		TenantID:   sess.TenantID,
+		IPAddress:  sess.IPAddress,
+		Device:     sess.UserAgent,
+		CreatedAt:  sess.CreatedAt,
+		LastSeenAt: sess.LastUsedAt,
+		ExpiresAt:  sess.ExpiresAt,
+	}
+}
@@ -23,10 +23,20 @@ func NewRedisStore(addr string, ttl time.Duration) (*RedisStore, error) {
 	return &RedisStore{cli: cli, ttl: ttl}, nil
 }
 
+// Client 暴露底层连接，供令牌吊销名单复用
+func (s *RedisStore) Client() *redis.Client { return s.cli }
+
 func (s *RedisStore) Set(sess *Session) {
 	b, _ := json.Marshal(sess)
 	ctx := context.Background()
-	s.cli.Set(ctx, s.key(sess.ID), b, time.Until(sess.ExpiresAt))
+	pipe := s.cli.TxPipeline()
+	pipe.Set(ctx, s.key(sess.ID), b, time.Until(sess.ExpiresAt))
+	// 维护按用户/租户的会话索引（集合TTL取会话总体TTL，成员在读取时惰性清理）
+	pipe.SAdd(ctx, s.userKey(sess.TenantID, sess.UserID), sess.ID)
+	pipe.Expire(ctx, s.userKey(sess.TenantID, sess.UserID), s.ttl)
+	pipe.SAdd(ctx, s.tenantKey(sess.TenantID), sess.ID)
+	pipe.Expire(ctx, s.tenantKey(sess.TenantID), s.ttl)
+	_, _ = pipe.Exec(ctx)
 }
 
 func (s *RedisStore) Get(id string) (*Session, bool) {
@@ -44,7 +54,47 @@ func (s *RedisStore) Get(id string) (*Session, bool) {
 
 func (s *RedisStore) Delete(id string) {
 	ctx := context.Background()
+	if sess, ok := s.Get(id); ok {
+		_ = s.cli.SRem(ctx, s.userKey(sess.TenantID, sess.UserID), id).Err()
+		_ = s.cli.SRem(ctx, s.tenantKey(sess.TenantID), id).Err()
+	}
 	_ = s.cli.Del(ctx, s.key(id)).Err()
 }
 
+func (s *RedisStore) ListByUser(tenantID, userID string) []*Session {
+	return s.listIndex(s.userKey(tenantID, userID))
+}
+
+func (s *RedisStore) ListByTenant(tenantID string) []*Session {
+	return s.listIndex(s.tenantKey(tenantID))
+}
+
+func (s *RedisStore) listIndex(indexKey string) []*Session {
+	ctx := context.Background()
+	out := make([]*Session, 0)
+	ids, err := s.cli.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return out
+	}
+	for _, id := range ids {
+		sess, ok := s.Get(id)
+		if !ok {
+			// 会话已过期：从索引中移除
+			_ = s.cli.SRem(ctx, indexKey, id).Err()
+			continue
+		}
+		out = append(out, sess)
+	}
+	sortSessions(out)
+	return out
+}
+
 func (s *RedisStore) key(id string) string { return "auth:sid:" + id }
+
+func (s *RedisStore) userKey(tenantID, userID string) string {
+	return "auth:sessions:user:" + tenantID + ":" + userID
+}
+
+func (s *RedisStore) tenantKey(tenantID string) string {
+	return "auth:sessions:tenant:" + tenantID
+}
@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	orgutils "cube-castle/internal/organization/utils"
 	"cube-castle/pkg/database"
 	"cube-castle/pkg/eventbus"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Dispatcher 轮询 outbox 表并通过 eventbus 发布事件。
@@ -29,6 +33,8 @@ type Dispatcher struct {
 	mu     sync.Mutex
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
+	// paused 运行时开关：暂停时轮询循环保持运行但不派发事件
+	paused atomic.Bool
 }
 
 const (
@@ -85,6 +91,18 @@ func (d *Dispatcher) Stop() error {
 	return nil
 }
 
+// SetPaused 暂停或恢复事件派发（不影响 Start/Stop 生命周期）。
+func (d *Dispatcher) SetPaused(paused bool) {
+	if d.paused.Swap(paused) != paused {
+		d.logger.WithFields(pkglogger.Fields{"paused": paused}).Info("outbox dispatcher pause state changed")
+	}
+}
+
+// Paused 返回派发器是否处于暂停状态。
+func (d *Dispatcher) Paused() bool {
+	return d.paused.Load()
+}
+
 func (d *Dispatcher) loop(ctx context.Context) {
 	defer d.wg.Done()
 	ticker := time.NewTicker(d.cfg.PollInterval)
@@ -95,6 +113,9 @@ func (d *Dispatcher) loop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			if d.paused.Load() {
+				continue
+			}
 			d.metrics.activeGauge.Set(1)
 			d.dispatchBatch(ctx)
 			d.metrics.activeGauge.Set(0)
@@ -137,13 +158,25 @@ func (d *Dispatcher) dispatchBatch(ctx context.Context) {
 	}
 }
 
-func (d *Dispatcher) publishOne(ctx context.Context, evt *database.OutboxEvent) error {
+func (d *Dispatcher) publishOne(ctx context.Context, evt *database.OutboxEvent) (err error) {
 	if evt == nil {
 		return nil
 	}
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
+	// 从载荷 headers 恢复写入事件时的调用链，发布 span 作为其子节点
+	ctx = tracing.ContextFromPayload(ctx, []byte(evt.Payload))
+	ctx, span := tracing.Tracer("outbox").Start(ctx, "outbox.publish "+evt.EventType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "outbox"),
+			attribute.String("messaging.destination.name", evt.EventType),
+			attribute.String("messaging.message.id", evt.EventID),
+			attribute.Int("outbox.retry_count", evt.RetryCount),
+		),
+	)
+	defer func() { tracing.End(span, err) }()
 	if err := d.bus.Publish(ctx, d.asEvent(evt)); err != nil {
 		if agg, ok := err.(*eventbus.AggregatePublishError); ok {
 			for _, failure := range agg.Failures() {
@@ -195,7 +228,7 @@ func (d *Dispatcher) nextBackoff(retryCount int) time.Duration {
 
 func (d *Dispatcher) asEvent(evt *database.OutboxEvent) eventbus.Event {
 	payload := json.RawMessage(evt.Payload)
-	return eventbus.NewGenericJSONEvent(evt.EventType, evt.AggregateID, evt.AggregateType, payload)
+	return eventbus.NewGenericJSONEvent(evt.EventType, evt.AggregateID, evt.AggregateType, payload).WithEventID(evt.EventID)
 }
 
 // AssignmentCacheRefresher 刷新职位缓存以反映最新任命。
@@ -13,6 +13,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type fakeRepo struct {
@@ -40,10 +45,14 @@ func (r *fakeRepo) IncrementRetryCount(_ context.Context, _ string, _ time.Time)
 }
 
 type fakeBus struct {
-	fail bool
+	fail      bool
+	lastCtx   context.Context
+	lastEvent eventbus.Event
 }
 
-func (b *fakeBus) Publish(_ context.Context, _ eventbus.Event) error {
+func (b *fakeBus) Publish(ctx context.Context, event eventbus.Event) error {
+	b.lastCtx = ctx
+	b.lastEvent = event
 	if b.fail {
 		return errors.New("fail")
 	}
@@ -96,6 +105,9 @@ func TestDispatcherSuccess(t *testing.T) {
 	require.Equal(t, int32(1), repo.markPublishedCall)
 	require.Equal(t, int32(1), cache.calls)
 	require.Equal(t, "P1001", cache.lastPosition)
+	published, ok := bus.lastEvent.(eventbus.GenericJSONEvent)
+	require.True(t, ok)
+	require.Equal(t, "evt-1", published.EventID(), "subscribers dedupe on the outbox event id")
 }
 
 func TestDispatcherRetry(t *testing.T) {
@@ -124,3 +136,34 @@ func TestDispatcherRetry(t *testing.T) {
 	require.Equal(t, int32(0), repo.markPublishedCall)
 	require.Greater(t, repo.retryCalls, int32(0))
 }
+
+func TestDispatcherContinuesTraceFromPayloadHeaders(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	cfg := Config{PollInterval: time.Second, BatchSize: 1, BackoffBase: time.Second, MaxRetry: 3, MetricNamespace: "test"}
+	bus := &fakeBus{}
+	d := NewDispatcher(cfg, &fakeRepo{}, bus, pkglogger.NewNoopLogger(), prometheus.NewRegistry(), nil, nil)
+
+	evt := &database.OutboxEvent{
+		EventID:     "evt-trace",
+		AggregateID: "P1001",
+		EventType:   "position.updated",
+		Payload:     `{"headers":{"traceparent":"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}}`,
+	}
+	require.NoError(t, d.publishOne(context.Background(), evt))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "outbox.publish position.updated", spans[0].Name())
+	require.Equal(t, trace.SpanKindProducer, spans[0].SpanKind())
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+	require.Equal(t, spans[0].SpanContext().SpanID(), trace.SpanContextFromContext(bus.lastCtx).SpanID())
+}
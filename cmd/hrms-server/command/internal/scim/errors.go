@@ -0,0 +1,52 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+var (
+	// ErrNotFound 资源不存在。
+	ErrNotFound = errors.New("scim resource not found")
+	// ErrUniqueness 唯一性冲突（userName / displayName）。
+	ErrUniqueness = errors.New("scim resource already exists")
+	// ErrVersionMismatch If-Match 版本不匹配。
+	ErrVersionMismatch = errors.New("scim resource version mismatch")
+)
+
+// scimType 取值（RFC 7644 §3.12）
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeMutability    = "mutability"
+)
+
+// Error SCIM 错误响应体
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	code     int
+}
+
+func (e *Error) Error() string { return e.Detail }
+
+func newError(status int, scimType, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+		code:     status,
+	}
+}
+
+func badRequest(scimType, detail string) *Error {
+	return newError(http.StatusBadRequest, scimType, detail)
+}
@@ -0,0 +1,416 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// filterExpr SCIM 过滤表达式（RFC 7644 §3.4.2.2），在资源的 JSON 映射上求值
+type filterExpr interface {
+	match(resource map[string]any) bool
+}
+
+type logicalExpr struct {
+	op          string // and | or
+	left, right filterExpr
+}
+
+func (e *logicalExpr) match(r map[string]any) bool {
+	if e.op == "and" {
+		return e.left.match(r) && e.right.match(r)
+	}
+	return e.left.match(r) || e.right.match(r)
+}
+
+type notExpr struct{ inner filterExpr }
+
+func (e *notExpr) match(r map[string]any) bool { return !e.inner.match(r) }
+
+type compareExpr struct {
+	path  string
+	op    string
+	value any
+}
+
+func (e *compareExpr) match(r map[string]any) bool {
+	values := resolvePath(r, e.path)
+	if e.op == "pr" {
+		for _, v := range values {
+			if !isEmptyValue(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if e.op == "ne" {
+		for _, v := range values {
+			if compareValue(v, "eq", e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compareValue(v, e.op, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuePathExpr emails[type eq "work"] 形式：任一元素满足子表达式即匹配
+type valuePathExpr struct {
+	path   string
+	filter filterExpr
+}
+
+func (e *valuePathExpr) match(r map[string]any) bool {
+	for _, v := range resolveRaw(r, e.path) {
+		if m, ok := v.(map[string]any); ok && e.filter.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFilter 解析过滤表达式；空字符串返回 nil（匹配全部）
+func parseFilter(input string) (filterExpr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenizeFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(input string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					sb.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string literal")
+			}
+			tokens = append(tokens, filterToken{text: sb.String(), quoted: true})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()[]\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	if p.pos >= len(p.tokens) {
+		return false
+	}
+	t := p.tokens[p.pos]
+	return !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseFactor() (filterExpr, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		inner, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{inner: inner}, nil
+	}
+	if p.peekKeyword("(") {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t.text != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return inner, nil
+	}
+	attr, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if attr.quoted {
+		return nil, fmt.Errorf("expected attribute path, got string %q", attr.text)
+	}
+	if p.peekKeyword("[") {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t.text != "]" {
+			return nil, fmt.Errorf("missing closing bracket")
+		}
+		return &valuePathExpr{path: attr.text, filter: inner}, nil
+	}
+	opTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op := strings.ToLower(opTok.text)
+	switch op {
+	case "pr":
+		return &compareExpr{path: attr.text, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", opTok.text)
+	}
+	valTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	return &compareExpr{path: attr.text, op: op, value: literalValue(valTok)}, nil
+}
+
+func literalValue(t filterToken) any {
+	if t.quoted {
+		return t.text
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if f, err := strconv.ParseFloat(t.text, 64); err == nil {
+		return f
+	}
+	return t.text
+}
+
+var extensionSchemas = []string{SchemaEnterpriseUser, SchemaCubeCastleUser, SchemaCubeCastleGroup}
+
+// splitAttrPath 拆分属性路径：扩展 URN 作为顶层键，核心 URN 前缀被剥离
+func splitAttrPath(path string) []string {
+	for _, schema := range extensionSchemas {
+		if strings.EqualFold(path, schema) {
+			return []string{schema}
+		}
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			return append([]string{schema}, strings.Split(path[len(schema)+1:], ".")...)
+		}
+	}
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			path = path[len(schema)+1:]
+			break
+		}
+	}
+	return strings.Split(path, ".")
+}
+
+// resolvePath 沿路径取值；多值属性展开为多个候选值
+func resolvePath(resource map[string]any, path string) []any {
+	current := resolveRaw(resource, path)
+	// 复杂多值属性直接比较时取其 value 子属性
+	out := make([]any, 0, len(current))
+	for _, v := range current {
+		if m, ok := v.(map[string]any); ok {
+			if inner, ok := lookupKey(m, "value"); ok {
+				out = append(out, inner)
+				continue
+			}
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func resolveRaw(resource map[string]any, path string) []any {
+	current := []any{resource}
+	for _, part := range splitAttrPath(path) {
+		var next []any
+		for _, node := range current {
+			m, ok := node.(map[string]any)
+			if !ok {
+				continue
+			}
+			v, ok := lookupKey(m, part)
+			if !ok {
+				continue
+			}
+			if list, ok := v.([]any); ok {
+				next = append(next, list...)
+			} else {
+				next = append(next, v)
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+func lookupKey(m map[string]any, key string) (any, bool) {
+	if v, ok := m[key]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func isEmptyValue(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []any:
+		return len(val) == 0
+	case map[string]any:
+		return len(val) == 0
+	}
+	return false
+}
+
+func compareValue(actual any, op string, expected any) bool {
+	switch exp := expected.(type) {
+	case bool:
+		act, ok := actual.(bool)
+		return ok && op == "eq" && act == exp
+	case float64:
+		act, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return act == exp
+		case "gt":
+			return act > exp
+		case "ge":
+			return act >= exp
+		case "lt":
+			return act < exp
+		case "le":
+			return act <= exp
+		}
+		return false
+	case nil:
+		return op == "eq" && isEmptyValue(actual)
+	case string:
+		act, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		// 属性默认 caseExact=false
+		a, e := strings.ToLower(act), strings.ToLower(exp)
+		switch op {
+		case "eq":
+			return a == e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	}
+	return false
+}
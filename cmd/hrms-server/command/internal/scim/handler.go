@@ -0,0 +1,878 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"cube-castle/internal/auth"
+	"cube-castle/internal/organization/audit"
+	reqmw "cube-castle/internal/organization/middleware"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const basePath = "/scim/v2"
+
+// Handler SCIM 2.0 服务端（Users / Groups），需挂载在 JWT/PBAC 认证分组内（权限 SCIM_PROVISION）
+type Handler struct {
+	store   Store
+	auditor *audit.AuditLogger
+	logger  pkglogger.Logger
+}
+
+func NewHandler(store Store, auditor *audit.AuditLogger, baseLogger pkglogger.Logger) *Handler {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Handler{
+		store:   store,
+		auditor: auditor,
+		logger:  baseLogger.WithFields(pkglogger.Fields{"component": "scim", "module": "scim"}),
+	}
+}
+
+// SetupRoutes 注册 SCIM 端点
+func (h *Handler) SetupRoutes(r chi.Router) {
+	r.Route(basePath, func(r chi.Router) {
+		r.Get("/ServiceProviderConfig", h.handleServiceProviderConfig)
+		r.Get("/ResourceTypes", h.handleResourceTypes)
+
+		r.Get("/Users", h.handleListUsers)
+		r.Post("/Users", h.handleCreateUser)
+		r.Get("/Users/{id}", h.handleGetUser)
+		r.Put("/Users/{id}", h.handleReplaceUser)
+		r.Patch("/Users/{id}", h.handlePatchUser)
+		r.Delete("/Users/{id}", h.handleDeleteUser)
+
+		r.Get("/Groups", h.handleListGroups)
+		r.Post("/Groups", h.handleCreateGroup)
+		r.Get("/Groups/{id}", h.handleGetGroup)
+		r.Put("/Groups/{id}", h.handleReplaceGroup)
+		r.Patch("/Groups/{id}", h.handlePatchGroup)
+		r.Delete("/Groups/{id}", h.handleDeleteGroup)
+	})
+}
+
+func (h *Handler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	fields := pkglogger.Fields{"action": action}
+	for k, v := range extra {
+		fields[k] = v
+	}
+	if r != nil {
+		if requestID := reqmw.GetRequestID(r.Context()); requestID != "" {
+			fields["requestId"] = requestID
+		}
+		fields["tenantId"] = auth.GetTenantID(r.Context())
+	}
+	return h.logger.WithFields(fields)
+}
+
+// ---- Users ----
+
+func (h *Handler) handleListUsers(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	filter, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		h.writeError(w, r, badRequest(scimTypeInvalidFilter, err.Error()))
+		return
+	}
+	startIndex, count := pagination(r)
+
+	users, err := h.store.ListUsers(r.Context(), tenantID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	groups, err := h.store.ListGroups(r.Context(), tenantID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	membership := groupsByMember(groups, r)
+
+	matched := make([]interface{}, 0)
+	for _, rec := range users {
+		res := h.userView(r, rec, membership[rec.ID.String()])
+		if filter != nil {
+			doc, err := toMap(res)
+			if err != nil {
+				h.writeError(w, r, err)
+				return
+			}
+			if !filter.match(doc) {
+				continue
+			}
+		}
+		matched = append(matched, res)
+	}
+	writeJSON(w, http.StatusOK, paginate(matched, startIndex, count))
+}
+
+func (h *Handler) handleGetUser(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	rec, ok := h.loadUser(w, r, tenantID)
+	if !ok {
+		return
+	}
+	if ifNoneMatch(r, rec.Version) {
+		w.Header().Set("ETag", etag(rec.Version))
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	groups, err := h.store.GroupsForUser(r.Context(), tenantID, rec.ID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeResource(w, http.StatusOK, rec.Version, h.userView(r, rec, groupRefs(groups, r)))
+}
+
+func (h *Handler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	var res UserResource
+	if err := decodeBody(r, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if err := validateUser(&res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	now := nowUTC()
+	rec := &UserRecord{ID: uuid.New(), TenantID: tenantID, Version: 1, Created: now, LastModified: now}
+	rec.Resource = sanitizeUser(res)
+	if err := h.store.CreateUser(r.Context(), rec); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, tenantID, audit.EventTypeCreate, "SCIM_CREATE_USER", rec.ID.String(), nil, rec.Resource)
+	h.requestLogger(r, "handleCreateUser", pkglogger.Fields{"userId": rec.ID.String(), "userName": rec.Resource.UserName}).Info("scim user provisioned")
+
+	view := h.userView(r, rec, nil)
+	w.Header().Set("Location", view.Meta.Location)
+	h.writeResource(w, http.StatusCreated, rec.Version, view)
+}
+
+func (h *Handler) handleReplaceUser(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadUser(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	var res UserResource
+	if err := decodeBody(r, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if res.ID != "" && res.ID != current.ID.String() {
+		h.writeError(w, r, badRequest(scimTypeMutability, "id is immutable"))
+		return
+	}
+	h.saveUser(w, r, current, res, expected, "SCIM_REPLACE_USER")
+}
+
+func (h *Handler) handlePatchUser(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadUser(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	var req PatchRequest
+	if err := decodeBody(r, &req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	doc, err := toMap(current.Resource)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if err := applyPatch(doc, req.Operations); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	var res UserResource
+	if err := fromMap(doc, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.saveUser(w, r, current, res, expected, "SCIM_PATCH_USER")
+}
+
+func (h *Handler) saveUser(w http.ResponseWriter, r *http.Request, current *UserRecord, res UserResource, expected int, action string) {
+	if err := validateUser(&res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	before := current.Resource
+	rec := *current
+	rec.Resource = sanitizeUser(res)
+	rec.Version = current.Version + 1
+	rec.LastModified = nowUTC()
+	if err := h.store.UpdateUser(r.Context(), &rec, expected); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, rec.TenantID, audit.EventTypeUpdate, action, rec.ID.String(), before, rec.Resource)
+	h.requestLogger(r, action, pkglogger.Fields{"userId": rec.ID.String(), "active": rec.Resource.IsActive()}).Info("scim user updated")
+
+	groups, err := h.store.GroupsForUser(r.Context(), rec.TenantID, rec.ID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeResource(w, http.StatusOK, rec.Version, h.userView(r, &rec, groupRefs(groups, r)))
+}
+
+func (h *Handler) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadUser(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	if err := h.store.DeleteUser(r.Context(), tenantID, current.ID, expected); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, tenantID, audit.EventTypeDelete, "SCIM_DELETE_USER", current.ID.String(), current.Resource, nil)
+	h.requestLogger(r, "handleDeleteUser", pkglogger.Fields{"userId": current.ID.String()}).Info("scim user deprovisioned")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) loadUser(w http.ResponseWriter, r *http.Request, tenantID uuid.UUID) (*UserRecord, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, r, ErrNotFound)
+		return nil, false
+	}
+	rec, err := h.store.GetUser(r.Context(), tenantID, id)
+	if err != nil {
+		h.writeError(w, r, err)
+		return nil, false
+	}
+	return rec, true
+}
+
+// ---- Groups ----
+
+func (h *Handler) handleListGroups(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	filter, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		h.writeError(w, r, badRequest(scimTypeInvalidFilter, err.Error()))
+		return
+	}
+	startIndex, count := pagination(r)
+	// Azure AD 等 IdP 常以 excludedAttributes=members 探测组是否存在
+	excludeMembers := strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+
+	groups, err := h.store.ListGroups(r.Context(), tenantID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	matched := make([]interface{}, 0)
+	for _, rec := range groups {
+		res := h.groupView(r, rec)
+		if filter != nil {
+			doc, err := toMap(res)
+			if err != nil {
+				h.writeError(w, r, err)
+				return
+			}
+			if !filter.match(doc) {
+				continue
+			}
+		}
+		if excludeMembers {
+			res.Members = nil
+		}
+		matched = append(matched, res)
+	}
+	writeJSON(w, http.StatusOK, paginate(matched, startIndex, count))
+}
+
+func (h *Handler) handleGetGroup(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	rec, ok := h.loadGroup(w, r, tenantID)
+	if !ok {
+		return
+	}
+	if ifNoneMatch(r, rec.Version) {
+		w.Header().Set("ETag", etag(rec.Version))
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	h.writeResource(w, http.StatusOK, rec.Version, h.groupView(r, rec))
+}
+
+func (h *Handler) handleCreateGroup(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	var res GroupResource
+	if err := decodeBody(r, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	sanitized, err := h.validateGroup(r, tenantID, res)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	now := nowUTC()
+	rec := &GroupRecord{ID: uuid.New(), TenantID: tenantID, Resource: sanitized, Version: 1, Created: now, LastModified: now}
+	if err := h.store.CreateGroup(r.Context(), rec); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, tenantID, audit.EventTypeCreate, "SCIM_CREATE_GROUP", rec.ID.String(), nil, rec.Resource)
+	h.requestLogger(r, "handleCreateGroup", pkglogger.Fields{"groupId": rec.ID.String(), "members": len(rec.Resource.Members)}).Info("scim group provisioned")
+
+	view := h.groupView(r, rec)
+	w.Header().Set("Location", view.Meta.Location)
+	h.writeResource(w, http.StatusCreated, rec.Version, view)
+}
+
+func (h *Handler) handleReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadGroup(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	var res GroupResource
+	if err := decodeBody(r, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if res.ID != "" && res.ID != current.ID.String() {
+		h.writeError(w, r, badRequest(scimTypeMutability, "id is immutable"))
+		return
+	}
+	h.saveGroup(w, r, current, res, expected, "SCIM_REPLACE_GROUP")
+}
+
+func (h *Handler) handlePatchGroup(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadGroup(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	var req PatchRequest
+	if err := decodeBody(r, &req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	doc, err := toMap(current.Resource)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if err := applyPatch(doc, req.Operations); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	var res GroupResource
+	if err := fromMap(doc, &res); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.saveGroup(w, r, current, res, expected, "SCIM_PATCH_GROUP")
+}
+
+func (h *Handler) saveGroup(w http.ResponseWriter, r *http.Request, current *GroupRecord, res GroupResource, expected int, action string) {
+	sanitized, err := h.validateGroup(r, current.TenantID, res)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	before := current.Resource
+	rec := *current
+	rec.Resource = sanitized
+	rec.Version = current.Version + 1
+	rec.LastModified = nowUTC()
+	if err := h.store.UpdateGroup(r.Context(), &rec, expected); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, rec.TenantID, audit.EventTypeUpdate, action, rec.ID.String(), before, rec.Resource)
+	h.requestLogger(r, action, pkglogger.Fields{"groupId": rec.ID.String(), "members": len(rec.Resource.Members)}).Info("scim group updated")
+	h.writeResource(w, http.StatusOK, rec.Version, h.groupView(r, &rec))
+}
+
+func (h *Handler) handleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenant(w, r)
+	if !ok {
+		return
+	}
+	current, ok := h.loadGroup(w, r, tenantID)
+	if !ok {
+		return
+	}
+	expected, ok := h.checkIfMatch(w, r, current.Version)
+	if !ok {
+		return
+	}
+	if err := h.store.DeleteGroup(r.Context(), tenantID, current.ID, expected); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.logProvisioning(r, tenantID, audit.EventTypeDelete, "SCIM_DELETE_GROUP", current.ID.String(), current.Resource, nil)
+	h.requestLogger(r, "handleDeleteGroup", pkglogger.Fields{"groupId": current.ID.String()}).Info("scim group deleted")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) loadGroup(w http.ResponseWriter, r *http.Request, tenantID uuid.UUID) (*GroupRecord, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, r, ErrNotFound)
+		return nil, false
+	}
+	rec, err := h.store.GetGroup(r.Context(), tenantID, id)
+	if err != nil {
+		h.writeError(w, r, err)
+		return nil, false
+	}
+	return rec, true
+}
+
+// validateGroup 校验组名、继承角色与成员引用（成员须为本租户已供应用户）
+func (h *Handler) validateGroup(r *http.Request, tenantID uuid.UUID, res GroupResource) (GroupResource, error) {
+	res.DisplayName = strings.TrimSpace(res.DisplayName)
+	if res.DisplayName == "" {
+		return res, badRequest(scimTypeInvalidValue, "displayName is required")
+	}
+	if res.CubeCastle != nil {
+		for _, role := range res.RoleValues() {
+			if !auth.IsKnownRole(role) {
+				return res, badRequest(scimTypeInvalidValue, "unknown role: "+role)
+			}
+		}
+		res.CubeCastle.Roles = res.RoleValues()
+	}
+	members := make([]MemberRef, 0, len(res.Members))
+	seen := map[string]struct{}{}
+	for _, m := range res.Members {
+		id, err := uuid.Parse(strings.TrimSpace(m.Value))
+		if err != nil {
+			return res, badRequest(scimTypeInvalidValue, "invalid member value: "+m.Value)
+		}
+		if _, dup := seen[id.String()]; dup {
+			continue
+		}
+		seen[id.String()] = struct{}{}
+		user, err := h.store.GetUser(r.Context(), tenantID, id)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return res, badRequest(scimTypeInvalidValue, "member user not found: "+m.Value)
+			}
+			return res, err
+		}
+		members = append(members, MemberRef{Value: id.String(), Display: displayNameOf(&user.Resource), Type: resourceTypeUser})
+	}
+	res.Members = members
+	res.Schemas = []string{SchemaGroup}
+	if res.CubeCastle != nil {
+		res.Schemas = append(res.Schemas, SchemaCubeCastleGroup)
+	}
+	res.ID = ""
+	res.Meta = nil
+	return res, nil
+}
+
+// ---- Discovery ----
+
+func (h *Handler) handleServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProvider},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxListCount},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": true},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication via OAuth 2.0 bearer token (scope scim:provision)",
+			"primary":     true,
+		}},
+	})
+}
+
+func (h *Handler) handleResourceTypes(w http.ResponseWriter, r *http.Request) {
+	types := []interface{}{
+		map[string]any{
+			"schemas":  []string{SchemaResourceType},
+			"id":       resourceTypeUser,
+			"name":     resourceTypeUser,
+			"endpoint": "/Users",
+			"schema":   SchemaUser,
+			"schemaExtensions": []map[string]any{
+				{"schema": SchemaEnterpriseUser, "required": false},
+				{"schema": SchemaCubeCastleUser, "required": false},
+			},
+		},
+		map[string]any{
+			"schemas":          []string{SchemaResourceType},
+			"id":               resourceTypeGroup,
+			"name":             resourceTypeGroup,
+			"endpoint":         "/Groups",
+			"schema":           SchemaGroup,
+			"schemaExtensions": []map[string]any{{"schema": SchemaCubeCastleGroup, "required": false}},
+		},
+	}
+	writeJSON(w, http.StatusOK, paginate(types, 1, len(types)))
+}
+
+// ---- helpers ----
+
+func (h *Handler) tenant(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	tenantID, err := uuid.Parse(auth.GetTenantID(r.Context()))
+	if err != nil {
+		writeJSON(w, http.StatusUnauthorized, newError(http.StatusUnauthorized, "", "tenant context required"))
+		return uuid.Nil, false
+	}
+	return tenantID, true
+}
+
+// checkIfMatch 解析 If-Match：缺省或 * 不校验；版本不一致返回 412
+func (h *Handler) checkIfMatch(w http.ResponseWriter, r *http.Request, current int) (int, bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return 0, true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		if v, ok := parseETag(candidate); ok && v == current {
+			return current, true
+		}
+	}
+	h.writeError(w, r, ErrVersionMismatch)
+	return 0, false
+}
+
+func ifNoneMatch(r *http.Request, current int) bool {
+	header := strings.TrimSpace(r.Header.Get("If-None-Match"))
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		if v, ok := parseETag(candidate); ok && v == current {
+			return true
+		}
+	}
+	return false
+}
+
+func parseETag(value string) (int, bool) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "W/")
+	value = strings.Trim(value, `"`)
+	v, err := strconv.Atoi(value)
+	return v, err == nil
+}
+
+func (h *Handler) userView(r *http.Request, rec *UserRecord, groups []MemberRef) UserResource {
+	res := rec.Resource
+	res.ID = rec.ID.String()
+	res.Groups = groups
+	res.Meta = &Meta{
+		ResourceType: resourceTypeUser,
+		Created:      rec.Created,
+		LastModified: rec.LastModified,
+		Location:     location(r, "Users", rec.ID),
+		Version:      etag(rec.Version),
+	}
+	return res
+}
+
+func (h *Handler) groupView(r *http.Request, rec *GroupRecord) GroupResource {
+	res := rec.Resource
+	res.ID = rec.ID.String()
+	members := make([]MemberRef, 0, len(res.Members))
+	for _, m := range res.Members {
+		m.Ref = locationString(r, "Users", m.Value)
+		members = append(members, m)
+	}
+	res.Members = members
+	res.Meta = &Meta{
+		ResourceType: resourceTypeGroup,
+		Created:      rec.Created,
+		LastModified: rec.LastModified,
+		Location:     location(r, "Groups", rec.ID),
+		Version:      etag(rec.Version),
+	}
+	return res
+}
+
+func groupRefs(groups []*GroupRecord, r *http.Request) []MemberRef {
+	if len(groups) == 0 {
+		return nil
+	}
+	refs := make([]MemberRef, 0, len(groups))
+	for _, g := range groups {
+		refs = append(refs, MemberRef{
+			Value:   g.ID.String(),
+			Display: g.Resource.DisplayName,
+			Ref:     location(r, "Groups", g.ID),
+			Type:    "direct",
+		})
+	}
+	return refs
+}
+
+func groupsByMember(groups []*GroupRecord, r *http.Request) map[string][]MemberRef {
+	index := map[string][]MemberRef{}
+	for _, g := range groups {
+		for _, m := range g.Resource.Members {
+			index[m.Value] = append(index[m.Value], groupRefs([]*GroupRecord{g}, r)...)
+		}
+	}
+	return index
+}
+
+func location(r *http.Request, resource string, id uuid.UUID) string {
+	return locationString(r, resource, id.String())
+}
+
+func locationString(r *http.Request, resource, id string) string {
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + basePath + "/" + resource + "/" + id
+}
+
+func pagination(r *http.Request) (int, int) {
+	startIndex := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	count := defaultListCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 {
+		count = v
+	}
+	if count > maxListCount {
+		count = maxListCount
+	}
+	return startIndex, count
+}
+
+func paginate(items []interface{}, startIndex, count int) ListResponse {
+	from := startIndex - 1
+	if from > len(items) {
+		from = len(items)
+	}
+	to := from + count
+	if to > len(items) {
+		to = len(items)
+	}
+	page := items[from:to]
+	return ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(items),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// validateUser 校验 userName、角色与员工关联
+func validateUser(res *UserResource) error {
+	if strings.TrimSpace(res.UserName) == "" {
+		return badRequest(scimTypeInvalidValue, "userName is required")
+	}
+	for _, role := range res.RoleValues() {
+		if !auth.IsKnownRole(role) {
+			return badRequest(scimTypeInvalidValue, "unknown role: "+role)
+		}
+	}
+	if employeeID := strings.TrimSpace(res.EmployeeID()); employeeID != "" {
+		if _, err := uuid.Parse(employeeID); err != nil {
+			return badRequest(scimTypeInvalidValue, "employeeId must be a UUID")
+		}
+	}
+	return nil
+}
+
+// sanitizeUser 规范化持久化内容：剥离只读属性，角色统一大写
+func sanitizeUser(res UserResource) UserResource {
+	res.UserName = strings.TrimSpace(res.UserName)
+	res.ID = ""
+	res.Groups = nil
+	res.Meta = nil
+	roles := make([]MultiValued, 0, len(res.Roles))
+	for _, role := range res.Roles {
+		role.Value = strings.ToUpper(strings.TrimSpace(role.Value))
+		if role.Value != "" {
+			roles = append(roles, role)
+		}
+	}
+	res.Roles = roles
+	if res.CubeCastle != nil {
+		res.CubeCastle.EmployeeID = strings.ToLower(strings.TrimSpace(res.CubeCastle.EmployeeID))
+		if res.CubeCastle.EmployeeID == "" {
+			res.CubeCastle = nil
+		}
+	}
+	res.Schemas = []string{SchemaUser}
+	if res.Enterprise != nil {
+		res.Schemas = append(res.Schemas, SchemaEnterpriseUser)
+	}
+	if res.CubeCastle != nil {
+		res.Schemas = append(res.Schemas, SchemaCubeCastleUser)
+	}
+	return res
+}
+
+func displayNameOf(u *UserResource) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.UserName
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	if r.Body == nil {
+		return badRequest(scimTypeInvalidSyntax, "request body is required")
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest(scimTypeInvalidSyntax, "invalid JSON body: "+err.Error())
+	}
+	return nil
+}
+
+func toMap(v interface{}) (map[string]any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func fromMap(doc map[string]any, v interface{}) error {
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return badRequest(scimTypeInvalidValue, "patched resource is invalid: "+err.Error())
+	}
+	return nil
+}
+
+func (h *Handler) writeResource(w http.ResponseWriter, status, version int, v interface{}) {
+	w.Header().Set("ETag", etag(version))
+	writeJSON(w, status, v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var scimErr *Error
+	switch {
+	case errors.As(err, &scimErr):
+	case errors.Is(err, ErrNotFound):
+		scimErr = newError(http.StatusNotFound, "", "resource not found")
+	case errors.Is(err, ErrUniqueness):
+		scimErr = newError(http.StatusConflict, scimTypeUniqueness, err.Error())
+	case errors.Is(err, ErrVersionMismatch):
+		scimErr = newError(http.StatusPreconditionFailed, "", "resource version does not match If-Match")
+	default:
+		h.requestLogger(r, "writeError", pkglogger.Fields{"error": err}).Error("scim request failed")
+		scimErr = newError(http.StatusInternalServerError, "", "internal server error")
+	}
+	writeJSON(w, scimErr.code, scimErr)
+}
+
+func (h *Handler) logProvisioning(r *http.Request, tenantID uuid.UUID, eventType, action, resourceID string, before, after interface{}) {
+	if h.auditor == nil {
+		return
+	}
+	event := &audit.AuditEvent{
+		TenantID:     tenantID,
+		EventType:    eventType,
+		ResourceType: audit.ResourceTypeUser,
+		ResourceID:   resourceID,
+		ActorID:      auth.GetUserID(r.Context()),
+		ActorType:    audit.ActorTypeService,
+		ActionName:   action,
+		RequestID:    reqmw.GetRequestID(r.Context()),
+		Success:      true,
+	}
+	if before != nil {
+		event.BeforeData, _ = toMap(before)
+	}
+	if after != nil {
+		event.AfterData, _ = toMap(after)
+	}
+	if err := h.auditor.LogEvent(r.Context(), event); err != nil {
+		h.requestLogger(r, action, pkglogger.Fields{"error": err}).Warn("failed to record scim audit event")
+	}
+}
@@ -0,0 +1,235 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"cube-castle/internal/auth"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testTenant = "3b99930c-4dc6-4cc9-8e4d-7d960a931cb9"
+
+type scimTestServer struct {
+	t      *testing.T
+	store  *MemoryStore
+	router chi.Router
+}
+
+func newSCIMTestServer(t *testing.T) *scimTestServer {
+	t.Helper()
+	store := NewMemoryStore()
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := auth.SetUserContext(req.Context(), &auth.Claims{UserID: "idp-sync", TenantID: testTenant, Roles: []string{"ADMIN"}})
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	})
+	NewHandler(store, nil, nil).SetupRoutes(r)
+	return &scimTestServer{t: t, store: store, router: r}
+}
+
+func (s *scimTestServer) do(method, path string, body any, headers map[string]string) *httptest.ResponseRecorder {
+	s.t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(s.t, json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", contentTypeSCIM)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *scimTestServer) createUser(userName string, extra map[string]any) map[string]any {
+	s.t.Helper()
+	body := map[string]any{
+		"schemas":  []string{SchemaUser},
+		"userName": userName,
+		"emails":   []map[string]any{{"value": userName, "type": "work", "primary": true}},
+	}
+	for k, v := range extra {
+		body[k] = v
+	}
+	rec := s.do(http.MethodPost, "/scim/v2/Users", body, nil)
+	require.Equal(s.t, http.StatusCreated, rec.Code, rec.Body.String())
+	return decode(s.t, rec)
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+	return out
+}
+
+func TestSCIMUserLifecycleWithETag(t *testing.T) {
+	srv := newSCIMTestServer(t)
+	created := srv.createUser("alice@example.com", map[string]any{
+		SchemaCubeCastleUser: map[string]any{"employeeId": "6F1C5D2A-2B3C-4D5E-8F90-A1B2C3D4E5F6"},
+	})
+	id := created["id"].(string)
+	require.Equal(t, `W/"1"`, created["meta"].(map[string]any)["version"])
+	require.Equal(t, "6f1c5d2a-2b3c-4d5e-8f90-a1b2c3d4e5f6", created[SchemaCubeCastleUser].(map[string]any)["employeeId"])
+
+	rec := srv.do(http.MethodGet, "/scim/v2/Users/"+id, nil, map[string]string{"If-None-Match": `W/"1"`})
+	require.Equal(t, http.StatusNotModified, rec.Code)
+
+	patch := map[string]any{
+		"schemas": []string{SchemaPatchOp},
+		"Operations": []map[string]any{
+			{"op": "replace", "path": "active", "value": "False"},
+			{"op": "add", "path": "roles", "value": []map[string]any{{"value": "manager"}}},
+			{"op": "replace", "path": `emails[type eq "work"].value`, "value": "alice@corp.example.com"},
+		},
+	}
+	rec = srv.do(http.MethodPatch, "/scim/v2/Users/"+id, patch, map[string]string{"If-Match": `W/"1"`})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, `W/"2"`, rec.Header().Get("ETag"))
+	patched := decode(t, rec)
+	require.Equal(t, false, patched["active"])
+	require.Equal(t, "MANAGER", patched["roles"].([]any)[0].(map[string]any)["value"])
+	require.Equal(t, "alice@corp.example.com", patched["emails"].([]any)[0].(map[string]any)["value"])
+
+	// 过期版本被拒绝
+	rec = srv.do(http.MethodPatch, "/scim/v2/Users/"+id, patch, map[string]string{"If-Match": `W/"1"`})
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec = srv.do(http.MethodDelete, "/scim/v2/Users/"+id, nil, map[string]string{"If-Match": `W/"2"`})
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	rec = srv.do(http.MethodGet, "/scim/v2/Users/"+id, nil, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, SchemaError, decode(t, rec)["schemas"].([]any)[0])
+}
+
+func TestSCIMUserValidation(t *testing.T) {
+	srv := newSCIMTestServer(t)
+	srv.createUser("bob@example.com", nil)
+
+	rec := srv.do(http.MethodPost, "/scim/v2/Users", map[string]any{"schemas": []string{SchemaUser}, "userName": "BOB@example.com"}, nil)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.Equal(t, scimTypeUniqueness, decode(t, rec)["scimType"])
+
+	rec = srv.do(http.MethodPost, "/scim/v2/Users", map[string]any{
+		"schemas":  []string{SchemaUser},
+		"userName": "carol@example.com",
+		"roles":    []map[string]any{{"value": "SUPERUSER"}},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = srv.do(http.MethodPost, "/scim/v2/Users", map[string]any{
+		"schemas":            []string{SchemaUser},
+		"userName":           "dave@example.com",
+		SchemaCubeCastleUser: map[string]any{"employeeId": "E-1001"},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSCIMListUsersFilterAndPagination(t *testing.T) {
+	srv := newSCIMTestServer(t)
+	srv.createUser("alice@example.com", map[string]any{"externalId": "okta-1", "name": map[string]any{"givenName": "Alice"}})
+	srv.createUser("bob@example.com", map[string]any{"externalId": "okta-2", "active": false})
+	srv.createUser("carol@example.org", nil)
+
+	cases := []struct {
+		filter string
+		want   int
+	}{
+		{`userName eq "ALICE@example.com"`, 1},
+		{`userName ew "example.com" and not (active eq false)`, 1},
+		{`emails[value co "example"] and externalId pr`, 2},
+		{`name.givenName sw "al" or userName eq "carol@example.org"`, 2},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:externalId eq "okta-2"`, 1},
+	}
+	for _, tc := range cases {
+		rec := srv.do(http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(tc.filter), nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code, tc.filter)
+		require.EqualValues(t, tc.want, decode(t, rec)["totalResults"], tc.filter)
+	}
+
+	rec := srv.do(http.MethodGet, "/scim/v2/Users?startIndex=2&count=1", nil, nil)
+	list := decode(t, rec)
+	require.EqualValues(t, 3, list["totalResults"])
+	require.EqualValues(t, 1, list["itemsPerPage"])
+	require.Equal(t, "bob@example.com", list["Resources"].([]any)[0].(map[string]any)["userName"])
+
+	rec = srv.do(http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(`userName zz "x"`), nil, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, scimTypeInvalidFilter, decode(t, rec)["scimType"])
+}
+
+func TestSCIMGroupMembershipFeedsRoleSource(t *testing.T) {
+	srv := newSCIMTestServer(t)
+	alice := srv.createUser("alice@example.com", map[string]any{"externalId": "okta-1", "roles": []map[string]any{{"value": "EMPLOYEE"}}})
+	bob := srv.createUser("bob@example.com", nil)
+
+	rec := srv.do(http.MethodPost, "/scim/v2/Groups", map[string]any{
+		"schemas":             []string{SchemaGroup, SchemaCubeCastleGroup},
+		"displayName":         "HR Managers",
+		"members":             []map[string]any{{"value": alice["id"]}},
+		SchemaCubeCastleGroup: map[string]any{"roles": []string{"manager"}},
+	}, nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	group := decode(t, rec)
+	groupID := group["id"].(string)
+
+	rec = srv.do(http.MethodPatch, "/scim/v2/Groups/"+groupID, map[string]any{
+		"schemas":    []string{SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": bob["id"]}}}},
+	}, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Len(t, decode(t, rec)["members"], 2)
+
+	rec = srv.do(http.MethodGet, "/scim/v2/Users/"+alice["id"].(string), nil, nil)
+	require.Equal(t, groupID, decode(t, rec)["groups"].([]any)[0].(map[string]any)["value"])
+
+	source := NewRoleSource(srv.store)
+	roles, active, found, err := source.ProvisionedRoles(context.Background(), testTenant, "okta-1", "")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, active)
+	require.Equal(t, []string{"EMPLOYEE", "MANAGER"}, roles)
+
+	// Azure AD 风格移除成员
+	rec = srv.do(http.MethodPatch, "/scim/v2/Groups/"+groupID, map[string]any{
+		"schemas":    []string{SchemaPatchOp},
+		"Operations": []map[string]any{{"op": "remove", "path": "members", "value": []map[string]any{{"value": alice["id"]}}}},
+	}, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	roles, _, _, err = source.ProvisionedRoles(context.Background(), testTenant, "", "ALICE@example.com")
+	require.NoError(t, err)
+	require.Equal(t, []string{"EMPLOYEE"}, roles)
+
+	rec = srv.do(http.MethodPost, "/scim/v2/Groups", map[string]any{
+		"schemas":     []string{SchemaGroup},
+		"displayName": "Ghosts",
+		"members":     []map[string]any{{"value": "00000000-0000-0000-0000-000000000001"}},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	_, _, found, err = source.ProvisionedRoles(context.Background(), testTenant, "unknown", "nobody@example.com")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSCIMDiscoveryEndpoints(t *testing.T) {
+	srv := newSCIMTestServer(t)
+	rec := srv.do(http.MethodGet, "/scim/v2/ServiceProviderConfig", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, contentTypeSCIM, rec.Header().Get("Content-Type"))
+	require.Equal(t, true, decode(t, rec)["etag"].(map[string]any)["supported"])
+
+	rec = srv.do(http.MethodGet, "/scim/v2/ResourceTypes", nil, nil)
+	require.EqualValues(t, 2, decode(t, rec)["totalResults"])
+}
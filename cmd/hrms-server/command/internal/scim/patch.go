@@ -0,0 +1,257 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+)
+
+// applyPatch 在资源 JSON 映射上执行 PATCH 操作（RFC 7644 §3.5.2）
+func applyPatch(doc map[string]any, ops []PatchOperation) error {
+	if len(ops) == 0 {
+		return badRequest(scimTypeInvalidValue, "PATCH request must contain Operations")
+	}
+	for _, op := range ops {
+		kind := strings.ToLower(strings.TrimSpace(op.Op))
+		switch kind {
+		case "add", "replace":
+			if strings.TrimSpace(op.Path) == "" {
+				values, ok := op.Value.(map[string]any)
+				if !ok {
+					return badRequest(scimTypeInvalidValue, "operation without path requires an object value")
+				}
+				for key, value := range values {
+					if err := patchSet(doc, key, value, kind); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := patchSet(doc, op.Path, op.Value, kind); err != nil {
+				return err
+			}
+		case "remove":
+			if strings.TrimSpace(op.Path) == "" {
+				return badRequest(scimTypeNoTarget, "remove operation requires a path")
+			}
+			if err := patchRemove(doc, op.Path, op.Value); err != nil {
+				return err
+			}
+		default:
+			return badRequest(scimTypeInvalidSyntax, "unsupported PATCH op: "+op.Op)
+		}
+	}
+	return nil
+}
+
+type patchPath struct {
+	parts   []string
+	filter  filterExpr
+	subAttr string
+}
+
+func parsePatchPath(path string) (patchPath, error) {
+	path = strings.TrimSpace(path)
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return patchPath{parts: splitAttrPath(path)}, nil
+	}
+	closeIdx := strings.LastIndex(path, "]")
+	if closeIdx < open {
+		return patchPath{}, badRequest(scimTypeInvalidPath, "invalid path: "+path)
+	}
+	filter, err := parseFilter(path[open+1 : closeIdx])
+	if err != nil || filter == nil {
+		return patchPath{}, badRequest(scimTypeInvalidPath, "invalid value filter in path: "+path)
+	}
+	pp := patchPath{parts: splitAttrPath(path[:open]), filter: filter}
+	if rest := path[closeIdx+1:]; rest != "" {
+		pp.subAttr = strings.TrimPrefix(rest, ".")
+	}
+	return pp, nil
+}
+
+func patchSet(doc map[string]any, path string, value any, kind string) error {
+	pp, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+	parent, key := navigate(doc, pp.parts, true)
+	if parent == nil {
+		return badRequest(scimTypeInvalidPath, "invalid path: "+path)
+	}
+	value = normalizePatchValue(key, value)
+
+	if pp.filter != nil {
+		list, _ := parent[key].([]any)
+		matched := false
+		for i, item := range list {
+			m, ok := item.(map[string]any)
+			if !ok || !pp.filter.match(m) {
+				continue
+			}
+			matched = true
+			if pp.subAttr != "" {
+				m[pp.subAttr] = value
+			} else if vm, ok := value.(map[string]any); ok {
+				for k, v := range vm {
+					m[k] = v
+				}
+			}
+			list[i] = m
+		}
+		if !matched {
+			return badRequest(scimTypeNoTarget, "no values matched path: "+path)
+		}
+		return nil
+	}
+
+	existing, exists := parent[key]
+	existingList, isList := existing.([]any)
+	if kind == "add" && exists && isList {
+		incoming, ok := value.([]any)
+		if !ok {
+			incoming = []any{value}
+		}
+		parent[key] = appendUnique(existingList, incoming)
+		return nil
+	}
+	if vm, ok := value.(map[string]any); ok && kind == "add" {
+		// 复杂属性 add 为合并语义
+		if em, ok := existing.(map[string]any); ok {
+			for k, v := range vm {
+				em[k] = v
+			}
+			return nil
+		}
+	}
+	parent[key] = value
+	return nil
+}
+
+func patchRemove(doc map[string]any, path string, value any) error {
+	pp, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+	parent, key := navigate(doc, pp.parts, false)
+	if parent == nil {
+		return nil
+	}
+	if pp.filter == nil {
+		// Azure AD 风格：remove members 并在 value 中给出待删除元素
+		if items, ok := value.([]any); ok && len(items) > 0 {
+			if list, ok := parent[key].([]any); ok {
+				parent[key] = removeByValue(list, items)
+				return nil
+			}
+		}
+		delete(parent, key)
+		return nil
+	}
+	list, _ := parent[key].([]any)
+	kept := make([]any, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok || !pp.filter.match(m) {
+			kept = append(kept, item)
+			continue
+		}
+		if pp.subAttr != "" {
+			delete(m, pp.subAttr)
+			kept = append(kept, m)
+		}
+	}
+	parent[key] = kept
+	return nil
+}
+
+// navigate 返回路径最后一段所在的父对象与键名（沿用已有键的大小写）
+func navigate(doc map[string]any, parts []string, create bool) (map[string]any, string) {
+	current := doc
+	for i, part := range parts {
+		key := part
+		for k := range current {
+			if strings.EqualFold(k, part) {
+				key = k
+				break
+			}
+		}
+		if i == len(parts)-1 {
+			return current, key
+		}
+		next, ok := current[key].(map[string]any)
+		if !ok {
+			if !create {
+				return nil, ""
+			}
+			next = map[string]any{}
+			current[key] = next
+		}
+		current = next
+	}
+	return nil, ""
+}
+
+func normalizePatchValue(key string, value any) any {
+	// 部分 IdP 以字符串形式发送布尔值（"True"/"False"）
+	if strings.EqualFold(key, "active") {
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+				return b
+			}
+		}
+	}
+	return value
+}
+
+func valueKey(item any) string {
+	if m, ok := item.(map[string]any); ok {
+		if v, ok := lookupKey(m, "value"); ok {
+			if s, ok := v.(string); ok {
+				return strings.ToLower(s)
+			}
+		}
+		return ""
+	}
+	if s, ok := item.(string); ok {
+		return strings.ToLower(s)
+	}
+	return ""
+}
+
+func appendUnique(list []any, incoming []any) []any {
+	seen := map[string]struct{}{}
+	for _, item := range list {
+		if k := valueKey(item); k != "" {
+			seen[k] = struct{}{}
+		}
+	}
+	for _, item := range incoming {
+		k := valueKey(item)
+		if k != "" {
+			if _, dup := seen[k]; dup {
+				continue
+			}
+			seen[k] = struct{}{}
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+func removeByValue(list []any, items []any) []any {
+	drop := map[string]struct{}{}
+	for _, item := range items {
+		if k := valueKey(item); k != "" {
+			drop[k] = struct{}{}
+		}
+	}
+	kept := make([]any, 0, len(list))
+	for _, item := range list {
+		if _, ok := drop[valueKey(item)]; ok {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
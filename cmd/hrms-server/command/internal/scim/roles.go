@@ -0,0 +1,55 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// RoleSource 为 BFF 令牌签发提供 SCIM 供应的角色（用户直接角色 ∪ 所属组继承角色）
+type RoleSource struct {
+	store Store
+}
+
+func NewRoleSource(store Store) *RoleSource {
+	return &RoleSource{store: store}
+}
+
+// ProvisionedRoles 按 IdP subject/邮箱匹配 SCIM 用户；found=false 表示该用户未经 SCIM 供应
+func (s *RoleSource) ProvisionedRoles(ctx context.Context, tenantID, subject, email string) ([]string, bool, bool, error) {
+	tid, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, false, false, nil
+	}
+	user, err := s.store.FindUser(ctx, tid, subject, email)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, false, false, nil
+		}
+		return nil, false, false, err
+	}
+	if !user.Resource.IsActive() {
+		return nil, false, true, nil
+	}
+	set := map[string]struct{}{}
+	for _, role := range user.Resource.RoleValues() {
+		set[role] = struct{}{}
+	}
+	groups, err := s.store.GroupsForUser(ctx, tid, user.ID)
+	if err != nil {
+		return nil, false, true, err
+	}
+	for _, g := range groups {
+		for _, role := range g.Resource.RoleValues() {
+			set[role] = struct{}{}
+		}
+	}
+	roles := make([]string, 0, len(set))
+	for role := range set {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles, true, true, nil
+}
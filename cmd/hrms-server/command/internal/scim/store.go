@@ -0,0 +1,288 @@
+package scim
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Store SCIM 资源持久化接口（按租户隔离）
+//
+// Update*/Delete* 的 expectedVersion 为 0 时不做版本校验，否则与当前版本不一致返回 ErrVersionMismatch。
+type Store interface {
+	ListUsers(ctx context.Context, tenantID uuid.UUID) ([]*UserRecord, error)
+	GetUser(ctx context.Context, tenantID, id uuid.UUID) (*UserRecord, error)
+	FindUser(ctx context.Context, tenantID uuid.UUID, subject, email string) (*UserRecord, error)
+	CreateUser(ctx context.Context, rec *UserRecord) error
+	UpdateUser(ctx context.Context, rec *UserRecord, expectedVersion int) error
+	DeleteUser(ctx context.Context, tenantID, id uuid.UUID, expectedVersion int) error
+
+	ListGroups(ctx context.Context, tenantID uuid.UUID) ([]*GroupRecord, error)
+	GetGroup(ctx context.Context, tenantID, id uuid.UUID) (*GroupRecord, error)
+	GroupsForUser(ctx context.Context, tenantID, userID uuid.UUID) ([]*GroupRecord, error)
+	CreateGroup(ctx context.Context, rec *GroupRecord) error
+	UpdateGroup(ctx context.Context, rec *GroupRecord, expectedVersion int) error
+	DeleteGroup(ctx context.Context, tenantID, id uuid.UUID, expectedVersion int) error
+}
+
+// MemoryStore 内存实现（测试/开发使用）
+type MemoryStore struct {
+	mu     sync.RWMutex
+	users  map[uuid.UUID]*UserRecord
+	groups map[uuid.UUID]*GroupRecord
+	seq    map[uuid.UUID]int64 // 插入顺序，保证列表分页稳定
+	next   int64
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{users: map[uuid.UUID]*UserRecord{}, groups: map[uuid.UUID]*GroupRecord{}, seq: map[uuid.UUID]int64{}}
+}
+
+func (s *MemoryStore) ListUsers(_ context.Context, tenantID uuid.UUID) ([]*UserRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*UserRecord, 0)
+	for _, u := range s.users {
+		if u.TenantID == tenantID {
+			out = append(out, cloneUser(u))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return s.seq[out[i].ID] < s.seq[out[j].ID] })
+	return out, nil
+}
+
+func (s *MemoryStore) GetUser(_ context.Context, tenantID, id uuid.UUID) (*UserRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.users[id]
+	if !ok || u.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+	return cloneUser(u), nil
+}
+
+func (s *MemoryStore) FindUser(_ context.Context, tenantID uuid.UUID, subject, email string) (*UserRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, u := range s.users {
+		if u.TenantID != tenantID {
+			continue
+		}
+		if matchesIdentity(&u.Resource, subject, email) {
+			return cloneUser(u), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *MemoryStore) CreateUser(_ context.Context, rec *UserRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if u.TenantID == rec.TenantID && strings.EqualFold(u.Resource.UserName, rec.Resource.UserName) {
+			return ErrUniqueness
+		}
+	}
+	s.users[rec.ID] = cloneUser(rec)
+	s.track(rec.ID)
+	return nil
+}
+
+func (s *MemoryStore) UpdateUser(_ context.Context, rec *UserRecord, expectedVersion int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.users[rec.ID]
+	if !ok || cur.TenantID != rec.TenantID {
+		return ErrNotFound
+	}
+	if expectedVersion != 0 && cur.Version != expectedVersion {
+		return ErrVersionMismatch
+	}
+	for id, u := range s.users {
+		if id != rec.ID && u.TenantID == rec.TenantID && strings.EqualFold(u.Resource.UserName, rec.Resource.UserName) {
+			return ErrUniqueness
+		}
+	}
+	s.users[rec.ID] = cloneUser(rec)
+	return nil
+}
+
+func (s *MemoryStore) DeleteUser(_ context.Context, tenantID, id uuid.UUID, expectedVersion int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.users[id]
+	if !ok || cur.TenantID != tenantID {
+		return ErrNotFound
+	}
+	if expectedVersion != 0 && cur.Version != expectedVersion {
+		return ErrVersionMismatch
+	}
+	delete(s.users, id)
+	delete(s.seq, id)
+	// 级联移除组成员关系
+	for _, g := range s.groups {
+		if g.TenantID != tenantID {
+			continue
+		}
+		kept := g.Resource.Members[:0]
+		for _, m := range g.Resource.Members {
+			if m.Value != id.String() {
+				kept = append(kept, m)
+			}
+		}
+		g.Resource.Members = kept
+	}
+	return nil
+}
+
+func (s *MemoryStore) ListGroups(_ context.Context, tenantID uuid.UUID) ([]*GroupRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*GroupRecord, 0)
+	for _, g := range s.groups {
+		if g.TenantID == tenantID {
+			out = append(out, cloneGroup(g))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return s.seq[out[i].ID] < s.seq[out[j].ID] })
+	return out, nil
+}
+
+func (s *MemoryStore) GetGroup(_ context.Context, tenantID, id uuid.UUID) (*GroupRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	g, ok := s.groups[id]
+	if !ok || g.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+	return cloneGroup(g), nil
+}
+
+func (s *MemoryStore) GroupsForUser(_ context.Context, tenantID, userID uuid.UUID) ([]*GroupRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*GroupRecord, 0)
+	for _, g := range s.groups {
+		if g.TenantID != tenantID {
+			continue
+		}
+		for _, m := range g.Resource.Members {
+			if m.Value == userID.String() {
+				out = append(out, cloneGroup(g))
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) CreateGroup(_ context.Context, rec *GroupRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, g := range s.groups {
+		if g.TenantID == rec.TenantID && strings.EqualFold(g.Resource.DisplayName, rec.Resource.DisplayName) {
+			return ErrUniqueness
+		}
+	}
+	s.groups[rec.ID] = cloneGroup(rec)
+	s.track(rec.ID)
+	return nil
+}
+
+func (s *MemoryStore) UpdateGroup(_ context.Context, rec *GroupRecord, expectedVersion int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.groups[rec.ID]
+	if !ok || cur.TenantID != rec.TenantID {
+		return ErrNotFound
+	}
+	if expectedVersion != 0 && cur.Version != expectedVersion {
+		return ErrVersionMismatch
+	}
+	for id, g := range s.groups {
+		if id != rec.ID && g.TenantID == rec.TenantID && strings.EqualFold(g.Resource.DisplayName, rec.Resource.DisplayName) {
+			return ErrUniqueness
+		}
+	}
+	s.groups[rec.ID] = cloneGroup(rec)
+	return nil
+}
+
+func (s *MemoryStore) DeleteGroup(_ context.Context, tenantID, id uuid.UUID, expectedVersion int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.groups[id]
+	if !ok || cur.TenantID != tenantID {
+		return ErrNotFound
+	}
+	if expectedVersion != 0 && cur.Version != expectedVersion {
+		return ErrVersionMismatch
+	}
+	delete(s.groups, id)
+	delete(s.seq, id)
+	return nil
+}
+
+func (s *MemoryStore) track(id uuid.UUID) {
+	s.next++
+	s.seq[id] = s.next
+}
+
+// matchesIdentity 以 IdP subject（externalId/userName）或邮箱匹配 SCIM 用户
+func matchesIdentity(u *UserResource, subject, email string) bool {
+	if subject != "" && (u.ExternalID == subject || strings.EqualFold(u.UserName, subject)) {
+		return true
+	}
+	if email == "" {
+		return false
+	}
+	if strings.EqualFold(u.UserName, email) {
+		return true
+	}
+	for _, e := range u.Emails {
+		if strings.EqualFold(e.Value, email) {
+			return true
+		}
+	}
+	return false
+}
+
+func cloneUser(u *UserRecord) *UserRecord {
+	c := *u
+	c.Resource.Emails = append([]MultiValued(nil), u.Resource.Emails...)
+	c.Resource.Roles = append([]MultiValued(nil), u.Resource.Roles...)
+	c.Resource.Groups = nil
+	if u.Resource.Name != nil {
+		n := *u.Resource.Name
+		c.Resource.Name = &n
+	}
+	if u.Resource.Active != nil {
+		a := *u.Resource.Active
+		c.Resource.Active = &a
+	}
+	if u.Resource.Enterprise != nil {
+		e := *u.Resource.Enterprise
+		c.Resource.Enterprise = &e
+	}
+	if u.Resource.CubeCastle != nil {
+		x := *u.Resource.CubeCastle
+		c.Resource.CubeCastle = &x
+	}
+	return &c
+}
+
+func cloneGroup(g *GroupRecord) *GroupRecord {
+	c := *g
+	c.Resource.Members = append([]MemberRef(nil), g.Resource.Members...)
+	if g.Resource.CubeCastle != nil {
+		x := CubeCastleGroup{Roles: append([]string(nil), g.Resource.CubeCastle.Roles...)}
+		c.Resource.CubeCastle = &x
+	}
+	return &c
+}
+
+func nowUTC() time.Time { return time.Now().UTC().Truncate(time.Millisecond) }
@@ -0,0 +1,345 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 scim_users / scim_groups / scim_group_members 的持久化实现
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const userColumns = `id, tenant_id, resource, version, created_at, updated_at`
+
+func (s *SQLStore) ListUsers(ctx context.Context, tenantID uuid.UUID) ([]*UserRecord, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+userColumns+` FROM scim_users WHERE tenant_id = $1 ORDER BY created_at, id`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query scim users: %w", err)
+	}
+	defer rows.Close()
+	out := make([]*UserRecord, 0)
+	for rows.Next() {
+		rec, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+	return out, rows.Err()
+}
+
+func (s *SQLStore) GetUser(ctx context.Context, tenantID, id uuid.UUID) (*UserRecord, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+userColumns+` FROM scim_users WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	return scanUser(row)
+}
+
+func (s *SQLStore) FindUser(ctx context.Context, tenantID uuid.UUID, subject, email string) (*UserRecord, error) {
+	row := s.db.QueryRowContext(ctx, `
+        SELECT `+userColumns+`
+          FROM scim_users
+         WHERE tenant_id = $1
+           AND (($2 <> '' AND (external_id = $2 OR lower(user_name) = lower($2)))
+             OR ($3 <> '' AND (lower(user_name) = lower($3) OR lower(email) = lower($3))))
+         ORDER BY CASE WHEN external_id = $2 THEN 0 ELSE 1 END
+         LIMIT 1`, tenantID, subject, email)
+	return scanUser(row)
+}
+
+func (s *SQLStore) CreateUser(ctx context.Context, rec *UserRecord) error {
+	payload, err := json.Marshal(rec.Resource)
+	if err != nil {
+		return fmt.Errorf("marshal scim user: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx, `
+        INSERT INTO scim_users (id, tenant_id, user_name, external_id, email, active, employee_id, roles, resource, version, created_at, updated_at)
+        VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, NULLIF($7, '')::uuid, $8, $9, $10, $11, $12)`,
+		rec.ID, rec.TenantID, rec.Resource.UserName, rec.Resource.ExternalID, rec.Resource.PrimaryEmail(),
+		rec.Resource.IsActive(), rec.Resource.EmployeeID(), pq.StringArray(rec.Resource.RoleValues()),
+		payload, rec.Version, rec.Created, rec.LastModified)
+	return mapWriteError(err)
+}
+
+func (s *SQLStore) UpdateUser(ctx context.Context, rec *UserRecord, expectedVersion int) error {
+	payload, err := json.Marshal(rec.Resource)
+	if err != nil {
+		return fmt.Errorf("marshal scim user: %w", err)
+	}
+	res, err := s.db.ExecContext(ctx, `
+        UPDATE scim_users
+           SET user_name = $3, external_id = NULLIF($4, ''), email = NULLIF($5, ''), active = $6,
+               employee_id = NULLIF($7, '')::uuid, roles = $8, resource = $9, version = $10, updated_at = $11
+         WHERE tenant_id = $1 AND id = $2 AND ($12 = 0 OR version = $12)`,
+		rec.TenantID, rec.ID, rec.Resource.UserName, rec.Resource.ExternalID, rec.Resource.PrimaryEmail(),
+		rec.Resource.IsActive(), rec.Resource.EmployeeID(), pq.StringArray(rec.Resource.RoleValues()),
+		payload, rec.Version, rec.LastModified, expectedVersion)
+	if err != nil {
+		return mapWriteError(err)
+	}
+	return s.checkAffected(ctx, res, "scim_users", rec.TenantID, rec.ID)
+}
+
+func (s *SQLStore) DeleteUser(ctx context.Context, tenantID, id uuid.UUID, expectedVersion int) error {
+	// scim_group_members 通过外键级联删除
+	res, err := s.db.ExecContext(ctx, `DELETE FROM scim_users WHERE tenant_id = $1 AND id = $2 AND ($3 = 0 OR version = $3)`, tenantID, id, expectedVersion)
+	if err != nil {
+		return fmt.Errorf("delete scim user: %w", err)
+	}
+	return s.checkAffected(ctx, res, "scim_users", tenantID, id)
+}
+
+const groupColumns = `id, tenant_id, resource, version, created_at, updated_at`
+
+func (s *SQLStore) ListGroups(ctx context.Context, tenantID uuid.UUID) ([]*GroupRecord, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+groupColumns+` FROM scim_groups WHERE tenant_id = $1 ORDER BY created_at, id`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query scim groups: %w", err)
+	}
+	groups, err := scanGroups(rows)
+	if err != nil {
+		return nil, err
+	}
+	return groups, s.loadMembers(ctx, tenantID, groups)
+}
+
+func (s *SQLStore) GetGroup(ctx context.Context, tenantID, id uuid.UUID) (*GroupRecord, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+groupColumns+` FROM scim_groups WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		return nil, fmt.Errorf("query scim group: %w", err)
+	}
+	groups, err := scanGroups(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, ErrNotFound
+	}
+	return groups[0], s.loadMembers(ctx, tenantID, groups)
+}
+
+func (s *SQLStore) GroupsForUser(ctx context.Context, tenantID, userID uuid.UUID) ([]*GroupRecord, error) {
+	rows, err := s.db.QueryContext(ctx, `
+        SELECT g.id, g.tenant_id, g.resource, g.version, g.created_at, g.updated_at
+          FROM scim_groups g
+          JOIN scim_group_members m ON m.group_id = g.id
+         WHERE g.tenant_id = $1 AND m.user_id = $2
+         ORDER BY g.created_at, g.id`, tenantID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query scim user groups: %w", err)
+	}
+	return scanGroups(rows)
+}
+
+func (s *SQLStore) CreateGroup(ctx context.Context, rec *GroupRecord) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		payload, err := marshalGroup(rec)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+            INSERT INTO scim_groups (id, tenant_id, display_name, external_id, roles, resource, version, created_at, updated_at)
+            VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, $9)`,
+			rec.ID, rec.TenantID, rec.Resource.DisplayName, rec.Resource.ExternalID,
+			pq.StringArray(rec.Resource.RoleValues()), payload, rec.Version, rec.Created, rec.LastModified)
+		if err != nil {
+			return mapWriteError(err)
+		}
+		return replaceMembers(ctx, tx, rec)
+	})
+}
+
+func (s *SQLStore) UpdateGroup(ctx context.Context, rec *GroupRecord, expectedVersion int) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		payload, err := marshalGroup(rec)
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, `
+            UPDATE scim_groups
+               SET display_name = $3, external_id = NULLIF($4, ''), roles = $5, resource = $6, version = $7, updated_at = $8
+             WHERE tenant_id = $1 AND id = $2 AND ($9 = 0 OR version = $9)`,
+			rec.TenantID, rec.ID, rec.Resource.DisplayName, rec.Resource.ExternalID,
+			pq.StringArray(rec.Resource.RoleValues()), payload, rec.Version, rec.LastModified, expectedVersion)
+		if err != nil {
+			return mapWriteError(err)
+		}
+		if err := s.checkAffected(ctx, res, "scim_groups", rec.TenantID, rec.ID); err != nil {
+			return err
+		}
+		return replaceMembers(ctx, tx, rec)
+	})
+}
+
+func (s *SQLStore) DeleteGroup(ctx context.Context, tenantID, id uuid.UUID, expectedVersion int) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM scim_groups WHERE tenant_id = $1 AND id = $2 AND ($3 = 0 OR version = $3)`, tenantID, id, expectedVersion)
+	if err != nil {
+		return fmt.Errorf("delete scim group: %w", err)
+	}
+	return s.checkAffected(ctx, res, "scim_groups", tenantID, id)
+}
+
+func (s *SQLStore) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin scim transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// checkAffected 区分“不存在”与“版本不匹配”
+func (s *SQLStore) checkAffected(ctx context.Context, res sql.Result, table string, tenantID, id uuid.UUID) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM `+table+` WHERE tenant_id = $1 AND id = $2)`, tenantID, id).Scan(&exists); err != nil {
+		return fmt.Errorf("check %s existence: %w", table, err)
+	}
+	if exists {
+		return ErrVersionMismatch
+	}
+	return ErrNotFound
+}
+
+func (s *SQLStore) loadMembers(ctx context.Context, tenantID uuid.UUID, groups []*GroupRecord) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	index := make(map[uuid.UUID]*GroupRecord, len(groups))
+	ids := make([]string, 0, len(groups))
+	for _, g := range groups {
+		index[g.ID] = g
+		ids = append(ids, g.ID.String())
+	}
+	rows, err := s.db.QueryContext(ctx, `
+        SELECT m.group_id, m.user_id, COALESCE(u.resource->>'displayName', u.user_name)
+          FROM scim_group_members m
+          JOIN scim_users u ON u.id = m.user_id
+         WHERE m.tenant_id = $1 AND m.group_id = ANY($2::uuid[])
+         ORDER BY m.created_at`, tenantID, pq.StringArray(ids))
+	if err != nil {
+		return fmt.Errorf("query scim group members: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var groupID, userID uuid.UUID
+		var display string
+		if err := rows.Scan(&groupID, &userID, &display); err != nil {
+			return fmt.Errorf("scan scim group member: %w", err)
+		}
+		if g := index[groupID]; g != nil {
+			g.Resource.Members = append(g.Resource.Members, MemberRef{Value: userID.String(), Display: display, Type: resourceTypeUser})
+		}
+	}
+	return rows.Err()
+}
+
+func replaceMembers(ctx context.Context, tx *sql.Tx, rec *GroupRecord) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM scim_group_members WHERE group_id = $1`, rec.ID); err != nil {
+		return fmt.Errorf("clear scim group members: %w", err)
+	}
+	for _, m := range rec.Resource.Members {
+		userID, err := uuid.Parse(m.Value)
+		if err != nil {
+			return badRequest(scimTypeInvalidValue, "invalid member value: "+m.Value)
+		}
+		res, err := tx.ExecContext(ctx, `
+            INSERT INTO scim_group_members (group_id, tenant_id, user_id)
+            SELECT $1, $2, id FROM scim_users WHERE tenant_id = $2 AND id = $3
+            ON CONFLICT DO NOTHING`, rec.ID, rec.TenantID, userID)
+		if err != nil {
+			return fmt.Errorf("insert scim group member: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 && !memberExists(ctx, tx, rec.ID, userID) {
+			return badRequest(scimTypeInvalidValue, "member user not found: "+m.Value)
+		}
+	}
+	return nil
+}
+
+func memberExists(ctx context.Context, tx *sql.Tx, groupID, userID uuid.UUID) bool {
+	var exists bool
+	_ = tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM scim_group_members WHERE group_id = $1 AND user_id = $2)`, groupID, userID).Scan(&exists)
+	return exists
+}
+
+// marshalGroup 成员关系单独存表，resource 列不重复保存
+func marshalGroup(rec *GroupRecord) ([]byte, error) {
+	res := rec.Resource
+	res.Members = nil
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("marshal scim group: %w", err)
+	}
+	return payload, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (*UserRecord, error) {
+	var (
+		rec     UserRecord
+		payload []byte
+	)
+	if err := row.Scan(&rec.ID, &rec.TenantID, &payload, &rec.Version, &rec.Created, &rec.LastModified); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("scan scim user: %w", err)
+	}
+	if err := json.Unmarshal(payload, &rec.Resource); err != nil {
+		return nil, fmt.Errorf("decode scim user: %w", err)
+	}
+	return &rec, nil
+}
+
+func scanGroups(rows *sql.Rows) ([]*GroupRecord, error) {
+	defer rows.Close()
+	out := make([]*GroupRecord, 0)
+	for rows.Next() {
+		var (
+			rec     GroupRecord
+			payload []byte
+		)
+		if err := rows.Scan(&rec.ID, &rec.TenantID, &payload, &rec.Version, &rec.Created, &rec.LastModified); err != nil {
+			return nil, fmt.Errorf("scan scim group: %w", err)
+		}
+		if err := json.Unmarshal(payload, &rec.Resource); err != nil {
+			return nil, fmt.Errorf("decode scim group: %w", err)
+		}
+		out = append(out, &rec)
+	}
+	return out, rows.Err()
+}
+
+func mapWriteError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && strings.Contains(pqErr.Constraint, "uq_scim_") {
+		return ErrUniqueness
+	}
+	return fmt.Errorf("write scim resource: %w", err)
+}
@@ -0,0 +1,202 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIM 2.0 schema URN（RFC 7643/7644）
+const (
+	SchemaUser               = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup              = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaEnterpriseUser     = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaCubeCastleUser     = "urn:cube-castle:params:scim:schemas:extension:2.0:User"
+	SchemaCubeCastleGroup    = "urn:cube-castle:params:scim:schemas:extension:2.0:Group"
+	SchemaListResponse       = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp            = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError              = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProvider    = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType       = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	resourceTypeUser         = "User"
+	resourceTypeGroup        = "Group"
+	contentTypeSCIM          = "application/scim+json"
+	defaultListCount         = 100
+	maxListCount             = 1000
+	cubeCastleUserAttrPrefix = SchemaCubeCastleUser + ":"
+)
+
+// Name 用户姓名（core User.name）
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValued 多值属性元素（emails/roles 等）
+type MultiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// MemberRef 组成员/用户所属组引用
+type MemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// Meta 资源元数据
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location,omitempty"`
+	Version      string    `json:"version,omitempty"`
+}
+
+// EnterpriseUser 企业扩展（仅保留 HR 相关字段）
+type EnterpriseUser struct {
+	EmployeeNumber string `json:"employeeNumber,omitempty"`
+	Department     string `json:"department,omitempty"`
+}
+
+// CubeCastleUser 平台扩展：关联任职记录的员工ID
+type CubeCastleUser struct {
+	EmployeeID string `json:"employeeId,omitempty"`
+}
+
+// CubeCastleGroup 平台扩展：组成员继承的角色（对应 RolePermissions）
+type CubeCastleGroup struct {
+	Roles []string `json:"roles,omitempty"`
+}
+
+// UserResource SCIM User 资源（请求体/响应体/持久化共用）
+type UserResource struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	UserName    string          `json:"userName"`
+	Name        *Name           `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Emails      []MultiValued   `json:"emails,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Roles       []MultiValued   `json:"roles,omitempty"`
+	Groups      []MemberRef     `json:"groups,omitempty"`
+	Enterprise  *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	CubeCastle  *CubeCastleUser `json:"urn:cube-castle:params:scim:schemas:extension:2.0:User,omitempty"`
+	Meta        *Meta           `json:"meta,omitempty"`
+}
+
+// GroupResource SCIM Group 资源
+type GroupResource struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []MemberRef      `json:"members,omitempty"`
+	CubeCastle  *CubeCastleGroup `json:"urn:cube-castle:params:scim:schemas:extension:2.0:Group,omitempty"`
+	Meta        *Meta            `json:"meta,omitempty"`
+}
+
+// UserRecord 持久化的用户
+type UserRecord struct {
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	Resource     UserResource
+	Version      int
+	Created      time.Time
+	LastModified time.Time
+}
+
+// GroupRecord 持久化的组（Resource.Members 为成员列表）
+type GroupRecord struct {
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	Resource     GroupResource
+	Version      int
+	Created      time.Time
+	LastModified time.Time
+}
+
+// ListResponse 列表响应
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// PatchRequest PATCH 请求体
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation 单个 PATCH 操作
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// IsActive 未显式设置 active 时视为启用
+func (u *UserResource) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// RoleValues 返回直接分配的角色值（大写）
+func (u *UserResource) RoleValues() []string {
+	out := make([]string, 0, len(u.Roles))
+	for _, r := range u.Roles {
+		if v := strings.ToUpper(strings.TrimSpace(r.Value)); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// PrimaryEmail 返回主邮箱（无主邮箱时取第一个）
+func (u *UserResource) PrimaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// EmployeeID 返回关联的员工ID
+func (u *UserResource) EmployeeID() string {
+	if u.CubeCastle == nil {
+		return ""
+	}
+	return u.CubeCastle.EmployeeID
+}
+
+// RoleValues 返回组继承的角色值（大写）
+func (g *GroupResource) RoleValues() []string {
+	if g.CubeCastle == nil {
+		return nil
+	}
+	out := make([]string, 0, len(g.CubeCastle.Roles))
+	for _, r := range g.CubeCastle.Roles {
+		if v := strings.ToUpper(strings.TrimSpace(r)); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func etag(version int) string {
+	return `W/"` + strconv.Itoa(version) + `"`
+}
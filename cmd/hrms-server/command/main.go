@@ -14,14 +14,18 @@ import (
 
 	authbff "cube-castle/cmd/hrms-server/command/internal/authbff"
 	outbox "cube-castle/cmd/hrms-server/command/internal/outbox"
+	scim "cube-castle/cmd/hrms-server/command/internal/scim"
 	publicgraphql "cube-castle/cmd/hrms-server/query/publicgraphql"
 	auth "cube-castle/internal/auth"
 	config "cube-castle/internal/config"
+	requestMiddleware "cube-castle/internal/middleware"
 	health "cube-castle/internal/monitoring/health"
 	organization "cube-castle/internal/organization"
+	"cube-castle/internal/runtimeconfig"
 	"cube-castle/pkg/database"
 	"cube-castle/pkg/eventbus"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/go-chi/chi/v5"
 	chi_middleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -63,11 +67,19 @@ func (c *v9RedisChecker) Check(ctx context.Context) health.HealthCheck {
 }
 
 func main() {
-	baseLogger := pkglogger.NewLogger(
+	// 按组件的日志级别可通过 PATCH /api/v1/operational/runtime-config 在运行时调整
+	logLevels := pkglogger.NewLevelController()
+	baseLogger, closeLogSinks, err := pkglogger.NewFromConfig(
+		pkglogger.LoadConfigFromEnv("command-service"),
 		pkglogger.WithWriter(os.Stdout),
 		pkglogger.WithLevelString(os.Getenv("COMMAND_LOG_LEVEL")),
 		pkglogger.WithCallerSkip(1),
+		pkglogger.WithLevelController(logLevels),
 	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "日志初始化失败: %v\n", err)
+		os.Exit(1)
+	}
 	commandLogger := baseLogger.WithFields(pkglogger.Fields{
 		"service":   "command",
 		"component": "bootstrap",
@@ -75,6 +87,14 @@ func main() {
 	commandLogger.Info("🚀 启动组织命令服务...")
 	authOnlyMode := os.Getenv("AUTH_ONLY_MODE") == "true"
 
+	tracingCfg := tracing.LoadConfigFromEnv("command-service")
+	shutdownTracing, err := tracing.Init(context.Background(), tracingCfg)
+	if err != nil {
+		commandLogger.Errorf("分布式追踪初始化失败: %v", err)
+		os.Exit(1)
+	}
+	commandLogger.Infof("✅ 分布式追踪已初始化 (exporter=%s sampleRatio=%.2f)", tracingCfg.Exporter, tracingCfg.SampleRatio)
+
 	var (
 		dbClient    *database.Database
 		sqlDB       *sql.DB
@@ -195,6 +215,14 @@ func main() {
 		}
 
 		orgModule.Services.Cascade.Start()
+		if err := orgModule.Services.Notifications.Subscribe(eventBus); err != nil {
+			commandLogger.Errorf("[FATAL] 业务通知事件订阅失败: %v", err)
+			os.Exit(1)
+		}
+		if err := orgModule.Services.Webhooks.Subscribe(eventBus); err != nil {
+			commandLogger.Errorf("[FATAL] webhook 事件订阅失败: %v", err)
+			os.Exit(1)
+		}
 		auditLogger = orgModule.AuditLogger
 		commandLogger.Info("✅ 级联更新服务已启动")
 		commandLogger.Info("✅ 结构化审计日志系统已初始化")
@@ -258,10 +286,21 @@ func main() {
 
 	// 初始化时态服务
 	var (
-		orgHandler         *organization.OrganizationHandler
-		positionHandler    *organization.PositionHandler
-		jobCatalogHandler  *organization.JobCatalogHandler
-		operationalHandler *organization.OperationalHandler
+		orgHandler          *organization.OrganizationHandler
+		positionHandler     *organization.PositionHandler
+		jobCatalogHandler   *organization.JobCatalogHandler
+		operationalHandler  *organization.OperationalHandler
+		auditChainHandler   *organization.AuditChainHandler
+		auditArchiveHandler *organization.AuditArchiveHandler
+		notificationHandler *organization.NotificationHandler
+		webhookHandler      *organization.WebhookHandler
+		customFieldHandler  *organization.CustomFieldHandler
+		unitTypeHandler     *organization.UnitTypeHandler
+		gradeHandler        *organization.CompensationGradeHandler
+		costCenterHandler   *organization.CostCenterHandler
+		locationHandler     *organization.LocationHandler
+		lifecycleHandler    *organization.PositionLifecycleHandler
+		runtimeConfig       *runtimeconfig.Manager
 	)
 	if !authOnlyMode {
 		commandHandlers = orgModule.NewHandlers(organization.CommandHandlerDeps{
@@ -274,7 +313,30 @@ func main() {
 		positionHandler = commandHandlers.Position
 		jobCatalogHandler = commandHandlers.JobCatalog
 		operationalHandler = commandHandlers.Operational
+		auditChainHandler = commandHandlers.AuditChain
+		auditArchiveHandler = commandHandlers.AuditArchive
+		notificationHandler = commandHandlers.Notification
+		webhookHandler = commandHandlers.Webhook
+		customFieldHandler = commandHandlers.CustomField
+		unitTypeHandler = commandHandlers.UnitType
+		gradeHandler = commandHandlers.CompensationGrade
+		costCenterHandler = commandHandlers.CostCenter
+		locationHandler = commandHandlers.Location
+		lifecycleHandler = commandHandlers.PositionLifecycle
 		devToolsHandler = commandHandlers.DevTools
+
+		runtimeConfig = newRuntimeConfig(runtimeConfigDeps{
+			LogLevels:  logLevels,
+			Redis:      redisClient,
+			Logger:     commandLogger,
+			Module:     orgModule,
+			Dispatcher: dispatcher,
+			RateLimit:  rateLimitMiddleware,
+			DevTools:   devToolsHandler,
+			DevMode:    devMode,
+			Assignment: assignmentCache,
+		})
+		operationalHandler.SetRuntimeConfig(runtimeConfig, auditLogger)
 	} else {
 		devToolsHandler = organization.NewDevToolsHandler(sqlDB, jwtMiddleware, commandLogger, devMode)
 	}
@@ -283,9 +345,11 @@ func main() {
 	r := chi.NewRouter()
 
 	// 基础中间件链 (无认证要求的中间件)
-	r.Use(organization.RequestIDMiddleware)   // 请求追踪中间件
-	r.Use(rateLimitMiddleware.Middleware())   // 限流中间件 - 最先执行
-	r.Use(performanceMiddleware.Middleware()) // 性能监控中间件
+	r.Use(organization.RequestIDMiddleware)       // 请求追踪中间件
+	r.Use(requestMiddleware.WriteTokenMiddleware) // 写入令牌，供查询服务读到自己的写入
+	r.Use(tracing.HTTPMiddleware("command-http")) // OpenTelemetry 服务端 span
+	r.Use(rateLimitMiddleware.Middleware())       // 限流中间件 - 最先执行
+	r.Use(performanceMiddleware.Middleware())     // 性能监控中间件
 	r.Use(chi_middleware.Logger)
 	r.Use(chi_middleware.Recoverer)
 	r.Use(chi_middleware.Timeout(30 * time.Second))
@@ -295,7 +359,7 @@ func main() {
 		AllowedOrigins:   config.ResolveAllowedOrigins("COMMAND_ALLOWED_ORIGINS", "", nil),
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Tenant-ID"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", requestMiddleware.WriteTokenHeader},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
@@ -358,11 +422,24 @@ func main() {
 
 	// 📎 BFF 认证路由（生产态登录/会话管理） - 不要求已有Authorization
 	bffHandler := authbff.NewBFFHandler(commandLogger, devMode, auditLogger, jwtConfig)
+	if sqlDB != nil {
+		// 租户级 IdP 联邦（tenant_identity_providers），环境变量 OIDC 配置作为兜底
+		bffHandler.EnableFederation(authbff.NewSQLProviderSource(sqlDB))
+	}
+	// SCIM 2.0 供应：IdP 推送的用户/组角色在令牌签发时合并
+	var scimHandler *scim.Handler
+	if sqlDB != nil && !authOnlyMode {
+		scimStore := scim.NewSQLStore(sqlDB)
+		scimHandler = scim.NewHandler(scimStore, auditLogger, commandLogger)
+		bffHandler.SetRoleProvisioner(scim.NewRoleSource(scimStore))
+	}
 	bffHandler.SetupRoutes(r)
+	// 会话吊销后拒绝已签发的访问令牌（REST 与 GraphQL 共用同一名单）
+	jwtMiddleware.SetDenylist(bffHandler.Denylist())
 
 	// GraphQL 查询路由（单体合流挂载）
 	if !authOnlyMode {
-		gqlHandler, graphiqlHandler, err := publicgraphql.BuildHandlers(sqlDB, queryRepo, assignmentCache, commandLogger, devMode)
+		gqlHandler, graphiqlHandler, err := publicgraphql.BuildHandlers(sqlDB, queryRepo, assignmentCache, commandLogger, devMode, bffHandler.Denylist())
 		if err != nil {
 			commandLogger.Errorf("[FATAL] 构建 GraphQL 处理器失败: %v", err)
 			os.Exit(1)
@@ -420,6 +497,32 @@ func main() {
 			orgHandler.SetupRoutes(r)
 			// 设置运维管理路由 (需要认证)
 			operationalHandler.SetupRoutes(r)
+			// 审计哈希链校验与检查点
+			auditChainHandler.SetupRoutes(r)
+			// 审计导出与保留策略/归档
+			auditArchiveHandler.SetupRoutes(r)
+			// 业务通知订阅、投递记录与站内收件箱
+			notificationHandler.SetupRoutes(r)
+			// 租户出站 webhook 端点、投递日志与手动重投
+			webhookHandler.SetupRoutes(r)
+			// 租户自定义字段定义
+			customFieldHandler.SetupRoutes(r)
+			// 租户组织单元类型
+			unitTypeHandler.SetupRoutes(r)
+			// 职等与薪酬区间
+			gradeHandler.SetupRoutes(r)
+			// 成本中心与职位成本分摊
+			costCenterHandler.SetupRoutes(r)
+			// 工作地点与组织/职位地点分配
+			locationHandler.SetupRoutes(r)
+			// 职位/任职生命周期迁移规则
+			lifecycleHandler.SetupRoutes(r)
+			// 会话管理（列出/吊销用户会话）
+			bffHandler.SetupAdminRoutes(r)
+			// SCIM 用户/组供应（/scim/v2）
+			if scimHandler != nil {
+				scimHandler.SetupRoutes(r)
+			}
 		})
 	}
 
@@ -454,6 +557,13 @@ func main() {
 		}
 		commandLogger.Info("✅ Outbox dispatcher 已启动")
 	}
+	if !authOnlyMode {
+		if err := orgModule.Services.Webhooks.Start(ctx); err != nil {
+			commandLogger.Errorf("[FATAL] webhook 投递循环启动失败: %v", err)
+			os.Exit(1)
+		}
+		commandLogger.Info("✅ Webhook 投递循环已启动")
+	}
 	if !authOnlyMode {
 		commandLogger.Infof("📊 Prometheus metrics 端点: %s/metrics", externalCommandBaseURL(port))
 	}
@@ -464,6 +574,22 @@ func main() {
 	if !authOnlyMode {
 		orgModule.Services.Scheduler.Start(ctx)
 		commandLogger.Info("✅ 运维任务调度器已启动")
+		orgModule.Services.SLO.Start(ctx)
+		commandLogger.Info("✅ SLO 跟踪已启动")
+		runtimeConfig.Start(ctx)
+		commandLogger.Info("✅ 运行时配置已加载并订阅变更广播")
+	}
+
+	// 声明式指标告警（config/alert_rules.yaml，支持热加载）
+	var alerting *metricAlerting
+	if !authOnlyMode {
+		sources := []health.MetricSource{orgModule.Services.Scheduler.Monitor(), rateLimitMiddleware}
+		if src, ok := assignmentCache.(health.MetricSource); ok {
+			sources = append(sources, src)
+		}
+		alerting = newMetricAlerting(commandLogger, sqlDB, sources...)
+		alerting.Start(ctx)
+		commandLogger.Info("✅ 指标告警规则引擎已启动")
 	}
 
 	// 优雅关闭
@@ -492,6 +618,9 @@ func main() {
 		orgModule.Services.Scheduler.Stop()
 		commandLogger.Info("✅ 运维任务调度器已停止")
 
+		orgModule.Services.SLO.Stop()
+		runtimeConfig.Stop()
+
 		if dispatcher != nil {
 			if err := dispatcher.Stop(); err != nil {
 				commandLogger.Errorf("outbox dispatcher 停止失败: %v", err)
@@ -499,16 +628,38 @@ func main() {
 				commandLogger.Info("✅ Outbox dispatcher 已停止")
 			}
 		}
+
+		if err := orgModule.Services.Webhooks.Stop(); err != nil {
+			commandLogger.Errorf("webhook 投递循环停止失败: %v", err)
+		} else {
+			commandLogger.Info("✅ Webhook 投递循环已停止")
+		}
 	}
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
 
+	if alerting != nil {
+		if err := alerting.Stop(shutdownCtx); err != nil {
+			commandLogger.Errorf("指标告警停止失败: %v", err)
+		} else {
+			commandLogger.Info("✅ 指标告警规则引擎已停止")
+		}
+	}
+
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		commandLogger.Errorf("服务关闭错误: %v", err)
 	} else {
 		commandLogger.Info("✅ 服务已安全关闭")
 	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		commandLogger.Warnf("追踪数据刷新失败: %v", err)
+	}
+
+	if err := closeLogSinks(); err != nil {
+		fmt.Fprintf(os.Stderr, "日志输出关闭失败: %v\n", err)
+	}
 }
 
 func openRedis(logger pkglogger.Logger) *redis.Client {
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"time"
+
+	"cube-castle/cmd/hrms-server/command/internal/outbox"
+	organization "cube-castle/internal/organization"
+	"cube-castle/internal/runtimeconfig"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// 运行时功能开关名称
+const (
+	featureOutboxDispatcher = "outbox-dispatcher"
+	featureScheduler        = "scheduler"
+	featureCascadeWorkers   = "cascade-workers"
+	featureDevTools         = "devtools"
+
+	cacheAssignmentStats = "assignment-stats"
+)
+
+type cacheTTLAdjuster interface {
+	CacheTTL() time.Duration
+	SetCacheTTL(ttl time.Duration)
+}
+
+// runtimeConfigDeps 可在运行时调整的组件
+type runtimeConfigDeps struct {
+	LogLevels  *pkglogger.LevelController
+	Redis      *redis.Client
+	Logger     pkglogger.Logger
+	Module     *organization.CommandModule
+	Dispatcher *outbox.Dispatcher
+	RateLimit  runtimeconfig.RateLimitTarget
+	DevTools   *organization.DevToolsHandler
+	DevMode    bool
+	Assignment organization.AssignmentFacade
+}
+
+// newRuntimeConfig 注册日志级别、功能开关、限流与缓存 TTL；关闭功能即暂停对应后台循环，
+// 重新开启后恢复，无需重启进程。
+func newRuntimeConfig(deps runtimeConfigDeps) *runtimeconfig.Manager {
+	mgr := runtimeconfig.NewManager(deps.LogLevels, deps.Redis, deps.Logger)
+
+	if d := deps.Dispatcher; d != nil {
+		mgr.RegisterFeature(featureOutboxDispatcher, "Outbox 事件派发", true, func(enabled bool) {
+			d.SetPaused(!enabled)
+		})
+	}
+	if m := deps.Module; m != nil {
+		if m.Services.Scheduler != nil {
+			operational := m.Services.Scheduler.Operational()
+			mgr.RegisterFeature(featureScheduler, "运维定时任务派发（手动触发不受影响）", true, func(enabled bool) {
+				operational.SetPaused(!enabled)
+			})
+		}
+		if cascade := m.Services.Cascade; cascade != nil {
+			mgr.RegisterFeature(featureCascadeWorkers, "组织层级级联更新工作协程", true, func(enabled bool) {
+				cascade.SetPaused(!enabled)
+			})
+		}
+	}
+	if h := deps.DevTools; h != nil && deps.DevMode {
+		mgr.RegisterFeature(featureDevTools, "开发工具端点（/auth/dev-token、/dev/*）", true, h.SetEnabled)
+	}
+
+	mgr.RegisterRateLimit(deps.RateLimit)
+	if c, ok := deps.Assignment.(cacheTTLAdjuster); ok {
+		mgr.RegisterCacheTTL(cacheAssignmentStats, c.CacheTTL(), c.SetCacheTTL)
+	}
+	return mgr
+}
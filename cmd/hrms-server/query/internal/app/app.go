@@ -23,6 +23,7 @@ import (
 	organization "cube-castle/internal/organization"
 	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -76,11 +77,20 @@ func init() {
 }
 
 func Run() error {
-	baseLogger := pkglogger.NewLogger(
+	rootLogger, closeLogSinks, err := pkglogger.NewFromConfig(
+		pkglogger.LoadConfigFromEnv("query-service"),
 		pkglogger.WithWriter(os.Stdout),
-		pkglogger.WithLevel(pkglogger.LevelInfo),
 		pkglogger.WithCallerSkip(1),
-	).WithFields(pkglogger.Fields{
+	)
+	if err != nil {
+		return fmt.Errorf("logger init: %w", err)
+	}
+	defer func() {
+		if err := closeLogSinks(); err != nil {
+			fmt.Fprintf(os.Stderr, "日志输出关闭失败: %v\n", err)
+		}
+	}()
+	baseLogger := rootLogger.WithFields(pkglogger.Fields{
 		"service":   "query",
 		"component": "query-app",
 	})
@@ -91,7 +101,18 @@ func Run() error {
 func (a *Application) run() error {
 	a.log("startup", nil).Info("🚀 启动PostgreSQL原生GraphQL服务")
 
-	var err error
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.LoadConfigFromEnv("query-service"))
+	if err != nil {
+		return fmt.Errorf("tracing init: %w", err)
+	}
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			a.log("shutdown", pkglogger.Fields{"error": err}).Warn("追踪数据刷新失败")
+		}
+	}()
+
 	a.dbClient, err = a.openDatabase()
 	if err != nil {
 		return fmt.Errorf("database init: %w", err)
@@ -101,7 +122,8 @@ func (a *Application) run() error {
 	a.redisClient = a.openRedis()
 
 	auditConfig := loadAuditHistoryConfig()
-	repo := organization.NewQueryRepository(a.db, a.redisClient, a.logger, auditConfig)
+	// GraphQL 只读查询走读写分离路由；权限校验与健康检查仍使用主库
+	repo := organization.NewQueryRepository(a.dbClient.Reads(), a.redisClient, a.logger, auditConfig)
 	assignmentFacade := organization.NewAssignmentFacade(repo, a.redisClient, a.logger, time.Minute)
 	a.log("audit.config", pkglogger.Fields{
 		"strictValidation": auditConfig.StrictValidation,
@@ -156,9 +178,13 @@ func (a *Application) openDatabase() (*database.Database, error) {
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		dbHost, dbPort, dbUser, dbPassword, dbName)
 
+	replicas := loadReplicaConfig()
 	db, err := database.NewDatabaseWithConfig(database.ConnectionConfig{
-		DSN:         dsn,
-		ServiceName: "query-service",
+		DSN:                  dsn,
+		ServiceName:          "query-service",
+		ReplicaDSNs:          replicas.DSNs,
+		MaxReplicaLag:        replicas.MaxLag,
+		ReplicaCheckInterval: replicas.CheckInterval,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("open postgres: %w", err)
@@ -180,7 +206,16 @@ func (a *Application) openDatabase() (*database.Database, error) {
 		"host":     dbHost,
 		"port":     dbPort,
 		"database": dbName,
+		"replicas": len(replicas.DSNs),
 	}).Info("✅ PostgreSQL连接成功")
+	for _, status := range db.ReplicaStatuses() {
+		a.log("database.replica", pkglogger.Fields{
+			"replica": status.Name,
+			"healthy": status.Healthy,
+			"lag":     status.Lag.String(),
+			"error":   status.LastError,
+		}).Info("只读副本状态")
+	}
 	return db, nil
 }
 
@@ -242,6 +277,7 @@ func (a *Application) buildServer(repo *organization.QueryRepository, assignment
 		Resolvers: gqlgenResolver,
 	})
 	graphqlServer := handler.NewDefaultServer(executableSchema)
+	graphqlServer.Use(graphqlruntime.TracingExtension{})
 	schemaPath := schemaLoader.GetDefaultSchemaPath()
 	a.log("graphql.schema", pkglogger.Fields{"path": schemaPath}).Info("✅ GraphQL Schema compiled from single source via gqlgen")
 
@@ -261,6 +297,9 @@ func (a *Application) buildServer(repo *organization.QueryRepository, assignment
 func (a *Application) buildRouter(graphqlServer http.Handler, permission *auth.GraphQLPermissionMiddleware, devMode bool, port string) http.Handler {
 	r := chi.NewRouter()
 	r.Use(requestMiddleware.RequestIDMiddleware)
+	r.Use(requestMiddleware.ReadYourWritesMiddleware)
+	r.Use(requestMiddleware.AcceptLanguageMiddleware)
+	r.Use(tracing.HTTPMiddleware("query-http"))
 	r.Use(chiMiddleware.Logger)
 	r.Use(chiMiddleware.Recoverer)
 	r.Use(cors.Handler(cors.Options{
@@ -296,6 +335,9 @@ func (a *Application) buildRouter(graphqlServer http.Handler, permission *auth.G
 		if a.db != nil {
 			hm.AddChecker(&health.PostgreSQLChecker{Name: "postgres", DB: a.db})
 		}
+		if len(a.dbClient.ReplicaStatuses()) > 0 {
+			hm.AddChecker(&replicaChecker{Name: "postgres-replicas", DB: a.dbClient})
+		}
 		if a.redisClient != nil {
 			hm.AddChecker(&v9RedisChecker{Name: "redis", Client: a.redisClient})
 		}
@@ -371,6 +413,42 @@ func (c *v9RedisChecker) Check(ctx context.Context) health.HealthCheck {
 	return check
 }
 
+// replicaChecker 汇总只读副本状态；副本不可用时读请求回落主库，因此仅降级不判定为不健康
+type replicaChecker struct {
+	Name string
+	DB   *database.Database
+}
+
+func (c *replicaChecker) Check(_ context.Context) health.HealthCheck {
+	start := time.Now()
+	statuses := c.DB.ReplicaStatuses()
+	healthy := 0
+	replicas := make([]map[string]interface{}, 0, len(statuses))
+	for _, s := range statuses {
+		if s.Healthy {
+			healthy++
+		}
+		replicas = append(replicas, map[string]interface{}{
+			"name":      s.Name,
+			"healthy":   s.Healthy,
+			"lagMs":     s.Lag.Milliseconds(),
+			"checkedAt": s.CheckedAt,
+			"lastError": s.LastError,
+		})
+	}
+	check := health.HealthCheck{
+		Name:     c.Name,
+		Status:   health.StatusHealthy,
+		Message:  fmt.Sprintf("%d/%d replicas healthy", healthy, len(statuses)),
+		Duration: time.Since(start),
+		Details:  map[string]interface{}{"replicas": replicas},
+	}
+	if healthy < len(statuses) {
+		check.Status = health.StatusDegraded
+	}
+	return check
+}
+
 func graphiqlPage() string {
 	return `<!DOCTYPE html>
 <html>
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"cube-castle/internal/organization/repository"
 )
@@ -21,6 +22,27 @@ func loadAuditHistoryConfig() repository.AuditHistoryConfig {
 	}
 }
 
+// replicaConfig 只读副本配置：DB_REPLICA_DSNS 以逗号分隔多个连接串
+type replicaConfig struct {
+	DSNs          []string
+	MaxLag        time.Duration
+	CheckInterval time.Duration
+}
+
+func loadReplicaConfig() replicaConfig {
+	var dsns []string
+	for _, dsn := range strings.Split(os.Getenv("DB_REPLICA_DSNS"), ",") {
+		if dsn = strings.TrimSpace(dsn); dsn != "" {
+			dsns = append(dsns, dsn)
+		}
+	}
+	return replicaConfig{
+		DSNs:          dsns,
+		MaxLag:        getEnvAsDuration("DB_REPLICA_MAX_LAG", 0),
+		CheckInterval: getEnvAsDuration("DB_REPLICA_CHECK_INTERVAL", 0),
+	}
+}
+
 func getEnvAsBool(key string, defaultValue bool) bool {
 	value := strings.TrimSpace(os.Getenv(key))
 	if value == "" {
@@ -47,6 +69,17 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultValue
+	}
+	if v, err := time.ParseDuration(value); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
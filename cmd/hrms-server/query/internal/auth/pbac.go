@@ -71,6 +71,18 @@ var defaultGraphQLQueryPermissions = map[string]string{
 	"jobFamilies":     "job-catalog:read",
 	"jobRoles":        "job-catalog:read",
 	"jobLevels":       "job-catalog:read",
+	// 薪酬职等
+	"compensationGrades": "compensation:read",
+	// 成本中心
+	"costCenters":              "cost-center:read",
+	"costCenterHeadcountStats": "cost-center:read",
+	// 工作地点
+	"locations":              "location:read",
+	"locationHeadcountStats": "location:read",
+	// 职位生命周期
+	"positionAllowedTransitions": "position:read",
+	// 员工跨职位任职约束
+	"employeeAssignmentViolations": "position:read:stats",
 }
 
 // NewPBACPermissionChecker 返回 PBAC 检查器实例。
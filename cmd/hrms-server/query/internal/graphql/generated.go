@@ -39,6 +39,9 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	JobLevel() JobLevelResolver
+	Organization() OrganizationResolver
+	Position() PositionResolver
 	Query() QueryResolver
 }
 
@@ -46,6 +49,14 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Address struct {
+		City          func(childComplexity int) int
+		Line1         func(childComplexity int) int
+		Line2         func(childComplexity int) int
+		PostalCode    func(childComplexity int) int
+		StateProvince func(childComplexity int) int
+	}
+
 	AssignmentStats struct {
 		ActingAssignments    func(childComplexity int) int
 		ActiveAssignments    func(childComplexity int) int
@@ -85,6 +96,19 @@ type ComplexityRoot struct {
 		Severity      func(childComplexity int) int
 	}
 
+	CompensationGrade struct {
+		Code          func(childComplexity int) int
+		Description   func(childComplexity int) int
+		EffectiveDate func(childComplexity int) int
+		EndDate       func(childComplexity int) int
+		IsCurrent     func(childComplexity int) int
+		JobLevelCodes func(childComplexity int) int
+		Name          func(childComplexity int) int
+		PayRanges     func(childComplexity int) int
+		RecordID      func(childComplexity int) int
+		Status        func(childComplexity int) int
+	}
+
 	ConsistencyFindings struct {
 		CacheInconsistencies func(childComplexity int) int
 		CircularReferences   func(childComplexity int) int
@@ -94,6 +118,46 @@ type ComplexityRoot struct {
 		PathMismatches       func(childComplexity int) int
 	}
 
+	CostAllocation struct {
+		CostCenterCode func(childComplexity int) int
+		CostCenterName func(childComplexity int) int
+		EffectiveDate  func(childComplexity int) int
+		Percentage     func(childComplexity int) int
+	}
+
+	CostCenter struct {
+		Code            func(childComplexity int) int
+		Description     func(childComplexity int) int
+		EffectiveDate   func(childComplexity int) int
+		EndDate         func(childComplexity int) int
+		IsCurrent       func(childComplexity int) int
+		LegalEntityCode func(childComplexity int) int
+		Name            func(childComplexity int) int
+		ParentCode      func(childComplexity int) int
+		RecordID        func(childComplexity int) int
+		Status          func(childComplexity int) int
+	}
+
+	CostCenterHeadcount struct {
+		AssignedFte        func(childComplexity int) int
+		AvailableHeadcount func(childComplexity int) int
+		CostCenterCode     func(childComplexity int) int
+		CostCenterName     func(childComplexity int) int
+		HeadcountCapacity  func(childComplexity int) int
+		HeadcountInUse     func(childComplexity int) int
+		LegalEntityCode    func(childComplexity int) int
+		PositionCount      func(childComplexity int) int
+	}
+
+	CustomFieldValue struct {
+		BooleanValue func(childComplexity int) int
+		DateValue    func(childComplexity int) int
+		Key          func(childComplexity int) int
+		NumberValue  func(childComplexity int) int
+		StringValue  func(childComplexity int) int
+		Type         func(childComplexity int) int
+	}
+
 	DataChanges struct {
 		AfterData      func(childComplexity int) int
 		BeforeData     func(childComplexity int) int
@@ -117,6 +181,24 @@ type ComplexityRoot struct {
 		ParentChain     func(childComplexity int) int
 	}
 
+	EffectiveLocation struct {
+		EffectiveDate func(childComplexity int) int
+		Location      func(childComplexity int) int
+		Source        func(childComplexity int) int
+	}
+
+	EmployeeAssignmentViolation struct {
+		AsOfDate         func(childComplexity int) int
+		AssignmentIds    func(childComplexity int) int
+		EmployeeID       func(childComplexity int) int
+		EmployeeName     func(childComplexity int) int
+		MaxFte           func(childComplexity int) int
+		OrganizationCode func(childComplexity int) int
+		PositionCodes    func(childComplexity int) int
+		Rule             func(childComplexity int) int
+		TotalFte         func(childComplexity int) int
+	}
+
 	FamilyHeadcount struct {
 		Available     func(childComplexity int) int
 		Capacity      func(childComplexity int) int
@@ -183,6 +265,7 @@ type ComplexityRoot struct {
 		EndDate       func(childComplexity int) int
 		GroupCode     func(childComplexity int) int
 		Name          func(childComplexity int) int
+		NameI18n      func(childComplexity int) int
 		RecordID      func(childComplexity int) int
 		Status        func(childComplexity int) int
 	}
@@ -193,20 +276,23 @@ type ComplexityRoot struct {
 		EffectiveDate func(childComplexity int) int
 		EndDate       func(childComplexity int) int
 		Name          func(childComplexity int) int
+		NameI18n      func(childComplexity int) int
 		RecordID      func(childComplexity int) int
 		Status        func(childComplexity int) int
 	}
 
 	JobLevel struct {
-		Code          func(childComplexity int) int
-		Description   func(childComplexity int) int
-		EffectiveDate func(childComplexity int) int
-		EndDate       func(childComplexity int) int
-		LevelRank     func(childComplexity int) int
-		Name          func(childComplexity int) int
-		RecordID      func(childComplexity int) int
-		RoleCode      func(childComplexity int) int
-		Status        func(childComplexity int) int
+		Code               func(childComplexity int) int
+		CompensationGrades func(childComplexity int, asOfDate *dto.Date, includeInactive *bool) int
+		Description        func(childComplexity int) int
+		EffectiveDate      func(childComplexity int) int
+		EndDate            func(childComplexity int) int
+		LevelRank          func(childComplexity int) int
+		Name               func(childComplexity int) int
+		NameI18n           func(childComplexity int) int
+		RecordID           func(childComplexity int) int
+		RoleCode           func(childComplexity int) int
+		Status             func(childComplexity int) int
 	}
 
 	JobRole struct {
@@ -216,6 +302,7 @@ type ComplexityRoot struct {
 		EndDate       func(childComplexity int) int
 		FamilyCode    func(childComplexity int) int
 		Name          func(childComplexity int) int
+		NameI18n      func(childComplexity int) int
 		RecordID      func(childComplexity int) int
 		Status        func(childComplexity int) int
 	}
@@ -240,6 +327,38 @@ type ComplexityRoot struct {
 		Level func(childComplexity int) int
 	}
 
+	LocalizedText struct {
+		Locale func(childComplexity int) int
+		Value  func(childComplexity int) int
+	}
+
+	Location struct {
+		Address       func(childComplexity int) int
+		Code          func(childComplexity int) int
+		CountryCode   func(childComplexity int) int
+		EffectiveDate func(childComplexity int) int
+		EndDate       func(childComplexity int) int
+		IsCurrent     func(childComplexity int) int
+		Name          func(childComplexity int) int
+		ParentCode    func(childComplexity int) int
+		RecordID      func(childComplexity int) int
+		Status        func(childComplexity int) int
+		TimeZone      func(childComplexity int) int
+		Type          func(childComplexity int) int
+	}
+
+	LocationHeadcount struct {
+		AssignedFte        func(childComplexity int) int
+		AvailableHeadcount func(childComplexity int) int
+		CountryCode        func(childComplexity int) int
+		HeadcountCapacity  func(childComplexity int) int
+		HeadcountInUse     func(childComplexity int) int
+		LocationCode       func(childComplexity int) int
+		LocationName       func(childComplexity int) int
+		PositionCount      func(childComplexity int) int
+		TimeZone           func(childComplexity int) int
+	}
+
 	OperatedBy struct {
 		ID   func(childComplexity int) int
 		Name func(childComplexity int) int
@@ -259,6 +378,7 @@ type ComplexityRoot struct {
 		Code             func(childComplexity int) int
 		CodePath         func(childComplexity int) int
 		CreatedAt        func(childComplexity int) int
+		CustomFields     func(childComplexity int) int
 		DeletedAt        func(childComplexity int) int
 		DeletedBy        func(childComplexity int) int
 		DeletionReason   func(childComplexity int) int
@@ -270,8 +390,11 @@ type ComplexityRoot struct {
 		IsFuture         func(childComplexity int) int
 		IsTemporal       func(childComplexity int) int
 		Level            func(childComplexity int) int
+		Location         func(childComplexity int, asOfDate *dto.Date) int
 		Name             func(childComplexity int) int
+		NameI18n         func(childComplexity int) int
 		NamePath         func(childComplexity int) int
+		NamePathI18n     func(childComplexity int) int
 		ParentCode       func(childComplexity int) int
 		Path             func(childComplexity int) int
 		Profile          func(childComplexity int) int
@@ -342,12 +465,23 @@ type ComplexityRoot struct {
 		Severity         func(childComplexity int) int
 	}
 
+	PayRange struct {
+		Currency func(childComplexity int) int
+		Max      func(childComplexity int) int
+		Mid      func(childComplexity int) int
+		Min      func(childComplexity int) int
+		Region   func(childComplexity int) int
+	}
+
 	Position struct {
 		AssignmentHistory     func(childComplexity int) int
 		AvailableHeadcount    func(childComplexity int) int
 		Code                  func(childComplexity int) int
+		CompensationGrade     func(childComplexity int, asOfDate *dto.Date) int
+		CostAllocations       func(childComplexity int, asOfDate *dto.Date) int
 		CreatedAt             func(childComplexity int) int
 		CurrentAssignment     func(childComplexity int) int
+		CustomFields          func(childComplexity int) int
 		EffectiveDate         func(childComplexity int) int
 		EmploymentType        func(childComplexity int) int
 		EndDate               func(childComplexity int) int
@@ -362,6 +496,7 @@ type ComplexityRoot struct {
 		JobProfileCode        func(childComplexity int) int
 		JobProfileName        func(childComplexity int) int
 		JobRoleCode           func(childComplexity int) int
+		Location              func(childComplexity int, asOfDate *dto.Date) int
 		OrganizationCode      func(childComplexity int) int
 		OrganizationName      func(childComplexity int) int
 		PositionType          func(childComplexity int) int
@@ -370,6 +505,7 @@ type ComplexityRoot struct {
 		Status                func(childComplexity int) int
 		TenantID              func(childComplexity int) int
 		Title                 func(childComplexity int) int
+		TitleI18n             func(childComplexity int) int
 		UpdatedAt             func(childComplexity int) int
 	}
 
@@ -470,32 +606,50 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	PositionTransition struct {
+		Allowed      func(childComplexity int) int
+		BuiltIn      func(childComplexity int) int
+		Event        func(childComplexity int) int
+		FailedGuards func(childComplexity int) int
+		FromStatus   func(childComplexity int) int
+		Guards       func(childComplexity int) int
+		SideEffects  func(childComplexity int) int
+		ToStatus     func(childComplexity int) int
+	}
+
 	Query struct {
-		AssignmentHistory       func(childComplexity int, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
-		AssignmentStats         func(childComplexity int, organizationCode *string, positionCode *dto.PositionCode) int
-		Assignments             func(childComplexity int, organizationCode *string, positionCode *dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
-		AuditHistory            func(childComplexity int, recordID string, startDate *string, endDate *string, operation *model.OperationType, userID *string, limit *int) int
-		AuditLog                func(childComplexity int, auditID string) int
-		HierarchyStatistics     func(childComplexity int, tenantID string, includeIntegrityCheck *bool) int
-		JobFamilies             func(childComplexity int, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date) int
-		JobFamilyGroups         func(childComplexity int, includeInactive *bool, asOfDate *dto.Date) int
-		JobLevels               func(childComplexity int, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date) int
-		JobRoles                func(childComplexity int, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date) int
-		Organization            func(childComplexity int, code string, asOfDate *string) int
-		OrganizationHierarchy   func(childComplexity int, code string, tenantID string) int
-		OrganizationStats       func(childComplexity int, asOfDate *string, includeHistorical *bool) int
-		OrganizationSubtree     func(childComplexity int, code string, tenantID string, maxDepth *int, includeInactive *bool) int
-		OrganizationVersions    func(childComplexity int, code string, includeDeleted *bool) int
-		Organizations           func(childComplexity int, filter *model.OrganizationFilter, pagination *model.PaginationInput) int
-		Position                func(childComplexity int, code dto.PositionCode, asOfDate *dto.Date) int
-		PositionAssignmentAudit func(childComplexity int, positionCode dto.PositionCode, assignmentID *dto.UUID, dateRange *model.DateRangeInput, pagination *model.PaginationInput) int
-		PositionAssignments     func(childComplexity int, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
-		PositionHeadcountStats  func(childComplexity int, organizationCode string, includeSubordinates *bool) int
-		PositionTimeline        func(childComplexity int, code dto.PositionCode, startDate *dto.Date, endDate *dto.Date) int
-		PositionTransfers       func(childComplexity int, positionCode *dto.PositionCode, organizationCode *string, pagination *model.PaginationInput) int
-		PositionVersions        func(childComplexity int, code dto.PositionCode, includeDeleted *bool) int
-		Positions               func(childComplexity int, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput) int
-		VacantPositions         func(childComplexity int, filter *model.VacantPositionFilterInput, pagination *model.PaginationInput, sorting []model.VacantPositionSortInput) int
+		AssignmentHistory            func(childComplexity int, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
+		AssignmentStats              func(childComplexity int, organizationCode *string, positionCode *dto.PositionCode) int
+		Assignments                  func(childComplexity int, organizationCode *string, positionCode *dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
+		AuditHistory                 func(childComplexity int, recordID string, startDate *string, endDate *string, operation *model.OperationType, userID *string, limit *int) int
+		AuditLog                     func(childComplexity int, auditID string) int
+		CompensationGrades           func(childComplexity int, jobLevelCode *dto.JobLevelCode, includeInactive *bool, asOfDate *dto.Date) int
+		CostCenterHeadcountStats     func(childComplexity int, asOfDate *dto.Date, legalEntityCode *string) int
+		CostCenters                  func(childComplexity int, legalEntityCode *string, parentCode *string, includeInactive *bool, asOfDate *dto.Date) int
+		EmployeeAssignmentViolations func(childComplexity int, asOfDate *dto.Date) int
+		HierarchyStatistics          func(childComplexity int, tenantID string, includeIntegrityCheck *bool) int
+		JobFamilies                  func(childComplexity int, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date, locale *string) int
+		JobFamilyGroups              func(childComplexity int, includeInactive *bool, asOfDate *dto.Date, locale *string) int
+		JobLevels                    func(childComplexity int, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date, locale *string) int
+		JobRoles                     func(childComplexity int, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date, locale *string) int
+		LocationHeadcountStats       func(childComplexity int, asOfDate *dto.Date, locationCode *string) int
+		Locations                    func(childComplexity int, typeArg *model.LocationType, parentCode *string, countryCode *string, includeInactive *bool, asOfDate *dto.Date) int
+		Organization                 func(childComplexity int, code string, asOfDate *string, locale *string) int
+		OrganizationHierarchy        func(childComplexity int, code string, tenantID string) int
+		OrganizationStats            func(childComplexity int, asOfDate *string, includeHistorical *bool) int
+		OrganizationSubtree          func(childComplexity int, code string, tenantID string, maxDepth *int, includeInactive *bool) int
+		OrganizationVersions         func(childComplexity int, code string, includeDeleted *bool, locale *string) int
+		Organizations                func(childComplexity int, filter *model.OrganizationFilter, pagination *model.PaginationInput, locale *string) int
+		Position                     func(childComplexity int, code dto.PositionCode, asOfDate *dto.Date, locale *string) int
+		PositionAllowedTransitions   func(childComplexity int, code dto.PositionCode) int
+		PositionAssignmentAudit      func(childComplexity int, positionCode dto.PositionCode, assignmentID *dto.UUID, dateRange *model.DateRangeInput, pagination *model.PaginationInput) int
+		PositionAssignments          func(childComplexity int, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) int
+		PositionHeadcountStats       func(childComplexity int, organizationCode string, includeSubordinates *bool) int
+		PositionTimeline             func(childComplexity int, code dto.PositionCode, startDate *dto.Date, endDate *dto.Date) int
+		PositionTransfers            func(childComplexity int, positionCode *dto.PositionCode, organizationCode *string, pagination *model.PaginationInput) int
+		PositionVersions             func(childComplexity int, code dto.PositionCode, includeDeleted *bool, locale *string) int
+		Positions                    func(childComplexity int, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput, locale *string) int
+		VacantPositions              func(childComplexity int, filter *model.VacantPositionFilterInput, pagination *model.PaginationInput, sorting []model.VacantPositionSortInput) int
 	}
 
 	RepairSuggestion struct {
@@ -569,32 +723,50 @@ type ComplexityRoot struct {
 	}
 }
 
+type JobLevelResolver interface {
+	CompensationGrades(ctx context.Context, obj *model.JobLevel, asOfDate *dto.Date, includeInactive *bool) ([]model.CompensationGrade, error)
+}
+type OrganizationResolver interface {
+	Location(ctx context.Context, obj *model.Organization, asOfDate *dto.Date) (*model.EffectiveLocation, error)
+}
+type PositionResolver interface {
+	CompensationGrade(ctx context.Context, obj *model.Position, asOfDate *dto.Date) (*model.CompensationGrade, error)
+	CostAllocations(ctx context.Context, obj *model.Position, asOfDate *dto.Date) ([]model.CostAllocation, error)
+	Location(ctx context.Context, obj *model.Position, asOfDate *dto.Date) (*model.EffectiveLocation, error)
+}
 type QueryResolver interface {
-	Organizations(ctx context.Context, filter *model.OrganizationFilter, pagination *model.PaginationInput) (*model.OrganizationConnection, error)
-	Organization(ctx context.Context, code string, asOfDate *string) (*model.Organization, error)
+	Organizations(ctx context.Context, filter *model.OrganizationFilter, pagination *model.PaginationInput, locale *string) (*model.OrganizationConnection, error)
+	Organization(ctx context.Context, code string, asOfDate *string, locale *string) (*model.Organization, error)
 	OrganizationStats(ctx context.Context, asOfDate *string, includeHistorical *bool) (*model.OrganizationStats, error)
 	OrganizationHierarchy(ctx context.Context, code string, tenantID string) (*model.OrganizationHierarchy, error)
 	OrganizationSubtree(ctx context.Context, code string, tenantID string, maxDepth *int, includeInactive *bool) ([]model.OrganizationHierarchy, error)
 	HierarchyStatistics(ctx context.Context, tenantID string, includeIntegrityCheck *bool) (*model.HierarchyStatistics, error)
-	Positions(ctx context.Context, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput) (*model.PositionConnection, error)
-	Position(ctx context.Context, code dto.PositionCode, asOfDate *dto.Date) (*model.Position, error)
+	Positions(ctx context.Context, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput, locale *string) (*model.PositionConnection, error)
+	Position(ctx context.Context, code dto.PositionCode, asOfDate *dto.Date, locale *string) (*model.Position, error)
 	PositionTimeline(ctx context.Context, code dto.PositionCode, startDate *dto.Date, endDate *dto.Date) ([]model.PositionTimelineEntry, error)
-	PositionVersions(ctx context.Context, code dto.PositionCode, includeDeleted *bool) ([]model.Position, error)
+	PositionVersions(ctx context.Context, code dto.PositionCode, includeDeleted *bool, locale *string) ([]model.Position, error)
 	PositionAssignments(ctx context.Context, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) (*model.PositionAssignmentConnection, error)
 	PositionAssignmentAudit(ctx context.Context, positionCode dto.PositionCode, assignmentID *dto.UUID, dateRange *model.DateRangeInput, pagination *model.PaginationInput) (*model.PositionAssignmentAuditConnection, error)
 	Assignments(ctx context.Context, organizationCode *string, positionCode *dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) (*model.PositionAssignmentConnection, error)
 	AssignmentHistory(ctx context.Context, positionCode dto.PositionCode, filter *model.PositionAssignmentFilterInput, pagination *model.PaginationInput, sorting []model.PositionAssignmentSortInput) (*model.PositionAssignmentConnection, error)
 	AssignmentStats(ctx context.Context, organizationCode *string, positionCode *dto.PositionCode) (*model.AssignmentStats, error)
+	EmployeeAssignmentViolations(ctx context.Context, asOfDate *dto.Date) ([]model.EmployeeAssignmentViolation, error)
 	VacantPositions(ctx context.Context, filter *model.VacantPositionFilterInput, pagination *model.PaginationInput, sorting []model.VacantPositionSortInput) (*model.VacantPositionConnection, error)
 	PositionTransfers(ctx context.Context, positionCode *dto.PositionCode, organizationCode *string, pagination *model.PaginationInput) (*model.PositionTransferConnection, error)
 	PositionHeadcountStats(ctx context.Context, organizationCode string, includeSubordinates *bool) (*model.HeadcountStats, error)
+	PositionAllowedTransitions(ctx context.Context, code dto.PositionCode) ([]model.PositionTransition, error)
 	AuditHistory(ctx context.Context, recordID string, startDate *string, endDate *string, operation *model.OperationType, userID *string, limit *int) ([]model.AuditLogDetail, error)
 	AuditLog(ctx context.Context, auditID string) (*model.AuditLogDetail, error)
-	OrganizationVersions(ctx context.Context, code string, includeDeleted *bool) ([]model.Organization, error)
-	JobFamilyGroups(ctx context.Context, includeInactive *bool, asOfDate *dto.Date) ([]model.JobFamilyGroup, error)
-	JobFamilies(ctx context.Context, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobFamily, error)
-	JobRoles(ctx context.Context, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobRole, error)
-	JobLevels(ctx context.Context, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobLevel, error)
+	OrganizationVersions(ctx context.Context, code string, includeDeleted *bool, locale *string) ([]model.Organization, error)
+	JobFamilyGroups(ctx context.Context, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobFamilyGroup, error)
+	JobFamilies(ctx context.Context, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobFamily, error)
+	JobRoles(ctx context.Context, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobRole, error)
+	JobLevels(ctx context.Context, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobLevel, error)
+	CompensationGrades(ctx context.Context, jobLevelCode *dto.JobLevelCode, includeInactive *bool, asOfDate *dto.Date) ([]model.CompensationGrade, error)
+	CostCenters(ctx context.Context, legalEntityCode *string, parentCode *string, includeInactive *bool, asOfDate *dto.Date) ([]model.CostCenter, error)
+	CostCenterHeadcountStats(ctx context.Context, asOfDate *dto.Date, legalEntityCode *string) ([]model.CostCenterHeadcount, error)
+	Locations(ctx context.Context, typeArg *model.LocationType, parentCode *string, countryCode *string, includeInactive *bool, asOfDate *dto.Date) ([]model.Location, error)
+	LocationHeadcountStats(ctx context.Context, asOfDate *dto.Date, locationCode *string) ([]model.LocationHeadcount, error)
 }
 
 type executableSchema struct {
@@ -616,6 +788,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Address.city":
+		if e.complexity.Address.City == nil {
+			break
+		}
+
+		return e.complexity.Address.City(childComplexity), true
+
+	case "Address.line1":
+		if e.complexity.Address.Line1 == nil {
+			break
+		}
+
+		return e.complexity.Address.Line1(childComplexity), true
+
+	case "Address.line2":
+		if e.complexity.Address.Line2 == nil {
+			break
+		}
+
+		return e.complexity.Address.Line2(childComplexity), true
+
+	case "Address.postalCode":
+		if e.complexity.Address.PostalCode == nil {
+			break
+		}
+
+		return e.complexity.Address.PostalCode(childComplexity), true
+
+	case "Address.stateProvince":
+		if e.complexity.Address.StateProvince == nil {
+			break
+		}
+
+		return e.complexity.Address.StateProvince(childComplexity), true
+
 	case "AssignmentStats.actingAssignments":
 		if e.complexity.AssignmentStats.ActingAssignments == nil {
 			break
@@ -805,6 +1012,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CircularReference.Severity(childComplexity), true
 
+	case "CompensationGrade.code":
+		if e.complexity.CompensationGrade.Code == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.Code(childComplexity), true
+
+	case "CompensationGrade.description":
+		if e.complexity.CompensationGrade.Description == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.Description(childComplexity), true
+
+	case "CompensationGrade.effectiveDate":
+		if e.complexity.CompensationGrade.EffectiveDate == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.EffectiveDate(childComplexity), true
+
+	case "CompensationGrade.endDate":
+		if e.complexity.CompensationGrade.EndDate == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.EndDate(childComplexity), true
+
+	case "CompensationGrade.isCurrent":
+		if e.complexity.CompensationGrade.IsCurrent == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.IsCurrent(childComplexity), true
+
+	case "CompensationGrade.jobLevelCodes":
+		if e.complexity.CompensationGrade.JobLevelCodes == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.JobLevelCodes(childComplexity), true
+
+	case "CompensationGrade.name":
+		if e.complexity.CompensationGrade.Name == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.Name(childComplexity), true
+
+	case "CompensationGrade.payRanges":
+		if e.complexity.CompensationGrade.PayRanges == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.PayRanges(childComplexity), true
+
+	case "CompensationGrade.recordId":
+		if e.complexity.CompensationGrade.RecordID == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.RecordID(childComplexity), true
+
+	case "CompensationGrade.status":
+		if e.complexity.CompensationGrade.Status == nil {
+			break
+		}
+
+		return e.complexity.CompensationGrade.Status(childComplexity), true
+
 	case "ConsistencyFindings.cacheInconsistencies":
 		if e.complexity.ConsistencyFindings.CacheInconsistencies == nil {
 			break
@@ -847,6 +1124,202 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ConsistencyFindings.PathMismatches(childComplexity), true
 
+	case "CostAllocation.costCenterCode":
+		if e.complexity.CostAllocation.CostCenterCode == nil {
+			break
+		}
+
+		return e.complexity.CostAllocation.CostCenterCode(childComplexity), true
+
+	case "CostAllocation.costCenterName":
+		if e.complexity.CostAllocation.CostCenterName == nil {
+			break
+		}
+
+		return e.complexity.CostAllocation.CostCenterName(childComplexity), true
+
+	case "CostAllocation.effectiveDate":
+		if e.complexity.CostAllocation.EffectiveDate == nil {
+			break
+		}
+
+		return e.complexity.CostAllocation.EffectiveDate(childComplexity), true
+
+	case "CostAllocation.percentage":
+		if e.complexity.CostAllocation.Percentage == nil {
+			break
+		}
+
+		return e.complexity.CostAllocation.Percentage(childComplexity), true
+
+	case "CostCenter.code":
+		if e.complexity.CostCenter.Code == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.Code(childComplexity), true
+
+	case "CostCenter.description":
+		if e.complexity.CostCenter.Description == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.Description(childComplexity), true
+
+	case "CostCenter.effectiveDate":
+		if e.complexity.CostCenter.EffectiveDate == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.EffectiveDate(childComplexity), true
+
+	case "CostCenter.endDate":
+		if e.complexity.CostCenter.EndDate == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.EndDate(childComplexity), true
+
+	case "CostCenter.isCurrent":
+		if e.complexity.CostCenter.IsCurrent == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.IsCurrent(childComplexity), true
+
+	case "CostCenter.legalEntityCode":
+		if e.complexity.CostCenter.LegalEntityCode == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.LegalEntityCode(childComplexity), true
+
+	case "CostCenter.name":
+		if e.complexity.CostCenter.Name == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.Name(childComplexity), true
+
+	case "CostCenter.parentCode":
+		if e.complexity.CostCenter.ParentCode == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.ParentCode(childComplexity), true
+
+	case "CostCenter.recordId":
+		if e.complexity.CostCenter.RecordID == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.RecordID(childComplexity), true
+
+	case "CostCenter.status":
+		if e.complexity.CostCenter.Status == nil {
+			break
+		}
+
+		return e.complexity.CostCenter.Status(childComplexity), true
+
+	case "CostCenterHeadcount.assignedFte":
+		if e.complexity.CostCenterHeadcount.AssignedFte == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.AssignedFte(childComplexity), true
+
+	case "CostCenterHeadcount.availableHeadcount":
+		if e.complexity.CostCenterHeadcount.AvailableHeadcount == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.AvailableHeadcount(childComplexity), true
+
+	case "CostCenterHeadcount.costCenterCode":
+		if e.complexity.CostCenterHeadcount.CostCenterCode == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.CostCenterCode(childComplexity), true
+
+	case "CostCenterHeadcount.costCenterName":
+		if e.complexity.CostCenterHeadcount.CostCenterName == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.CostCenterName(childComplexity), true
+
+	case "CostCenterHeadcount.headcountCapacity":
+		if e.complexity.CostCenterHeadcount.HeadcountCapacity == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.HeadcountCapacity(childComplexity), true
+
+	case "CostCenterHeadcount.headcountInUse":
+		if e.complexity.CostCenterHeadcount.HeadcountInUse == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.HeadcountInUse(childComplexity), true
+
+	case "CostCenterHeadcount.legalEntityCode":
+		if e.complexity.CostCenterHeadcount.LegalEntityCode == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.LegalEntityCode(childComplexity), true
+
+	case "CostCenterHeadcount.positionCount":
+		if e.complexity.CostCenterHeadcount.PositionCount == nil {
+			break
+		}
+
+		return e.complexity.CostCenterHeadcount.PositionCount(childComplexity), true
+
+	case "CustomFieldValue.booleanValue":
+		if e.complexity.CustomFieldValue.BooleanValue == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.BooleanValue(childComplexity), true
+
+	case "CustomFieldValue.dateValue":
+		if e.complexity.CustomFieldValue.DateValue == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.DateValue(childComplexity), true
+
+	case "CustomFieldValue.key":
+		if e.complexity.CustomFieldValue.Key == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.Key(childComplexity), true
+
+	case "CustomFieldValue.numberValue":
+		if e.complexity.CustomFieldValue.NumberValue == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.NumberValue(childComplexity), true
+
+	case "CustomFieldValue.stringValue":
+		if e.complexity.CustomFieldValue.StringValue == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.StringValue(childComplexity), true
+
+	case "CustomFieldValue.type":
+		if e.complexity.CustomFieldValue.Type == nil {
+			break
+		}
+
+		return e.complexity.CustomFieldValue.Type(childComplexity), true
+
 	case "DataChanges.afterData":
 		if e.complexity.DataChanges.AfterData == nil {
 			break
@@ -924,6 +1397,90 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DepthViolation.ParentChain(childComplexity), true
 
+	case "EffectiveLocation.effectiveDate":
+		if e.complexity.EffectiveLocation.EffectiveDate == nil {
+			break
+		}
+
+		return e.complexity.EffectiveLocation.EffectiveDate(childComplexity), true
+
+	case "EffectiveLocation.location":
+		if e.complexity.EffectiveLocation.Location == nil {
+			break
+		}
+
+		return e.complexity.EffectiveLocation.Location(childComplexity), true
+
+	case "EffectiveLocation.source":
+		if e.complexity.EffectiveLocation.Source == nil {
+			break
+		}
+
+		return e.complexity.EffectiveLocation.Source(childComplexity), true
+
+	case "EmployeeAssignmentViolation.asOfDate":
+		if e.complexity.EmployeeAssignmentViolation.AsOfDate == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.AsOfDate(childComplexity), true
+
+	case "EmployeeAssignmentViolation.assignmentIds":
+		if e.complexity.EmployeeAssignmentViolation.AssignmentIds == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.AssignmentIds(childComplexity), true
+
+	case "EmployeeAssignmentViolation.employeeId":
+		if e.complexity.EmployeeAssignmentViolation.EmployeeID == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.EmployeeID(childComplexity), true
+
+	case "EmployeeAssignmentViolation.employeeName":
+		if e.complexity.EmployeeAssignmentViolation.EmployeeName == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.EmployeeName(childComplexity), true
+
+	case "EmployeeAssignmentViolation.maxFte":
+		if e.complexity.EmployeeAssignmentViolation.MaxFte == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.MaxFte(childComplexity), true
+
+	case "EmployeeAssignmentViolation.organizationCode":
+		if e.complexity.EmployeeAssignmentViolation.OrganizationCode == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.OrganizationCode(childComplexity), true
+
+	case "EmployeeAssignmentViolation.positionCodes":
+		if e.complexity.EmployeeAssignmentViolation.PositionCodes == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.PositionCodes(childComplexity), true
+
+	case "EmployeeAssignmentViolation.rule":
+		if e.complexity.EmployeeAssignmentViolation.Rule == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.Rule(childComplexity), true
+
+	case "EmployeeAssignmentViolation.totalFte":
+		if e.complexity.EmployeeAssignmentViolation.TotalFte == nil {
+			break
+		}
+
+		return e.complexity.EmployeeAssignmentViolation.TotalFte(childComplexity), true
+
 	case "FamilyHeadcount.available":
 		if e.complexity.FamilyHeadcount.Available == nil {
 			break
@@ -1253,6 +1810,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.JobFamily.Name(childComplexity), true
 
+	case "JobFamily.nameI18n":
+		if e.complexity.JobFamily.NameI18n == nil {
+			break
+		}
+
+		return e.complexity.JobFamily.NameI18n(childComplexity), true
+
 	case "JobFamily.recordId":
 		if e.complexity.JobFamily.RecordID == nil {
 			break
@@ -1302,6 +1866,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.JobFamilyGroup.Name(childComplexity), true
 
+	case "JobFamilyGroup.nameI18n":
+		if e.complexity.JobFamilyGroup.NameI18n == nil {
+			break
+		}
+
+		return e.complexity.JobFamilyGroup.NameI18n(childComplexity), true
+
 	case "JobFamilyGroup.recordId":
 		if e.complexity.JobFamilyGroup.RecordID == nil {
 			break
@@ -1323,6 +1894,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.JobLevel.Code(childComplexity), true
 
+	case "JobLevel.compensationGrades":
+		if e.complexity.JobLevel.CompensationGrades == nil {
+			break
+		}
+
+		args, err := ec.field_JobLevel_compensationGrades_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.JobLevel.CompensationGrades(childComplexity, args["asOfDate"].(*dto.Date), args["includeInactive"].(*bool)), true
+
 	case "JobLevel.description":
 		if e.complexity.JobLevel.Description == nil {
 			break
@@ -1358,6 +1941,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.JobLevel.Name(childComplexity), true
 
+	case "JobLevel.nameI18n":
+		if e.complexity.JobLevel.NameI18n == nil {
+			break
+		}
+
+		return e.complexity.JobLevel.NameI18n(childComplexity), true
+
 	case "JobLevel.recordId":
 		if e.complexity.JobLevel.RecordID == nil {
 			break
@@ -1421,6 +2011,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.JobRole.Name(childComplexity), true
 
+	case "JobRole.nameI18n":
+		if e.complexity.JobRole.NameI18n == nil {
+			break
+		}
+
+		return e.complexity.JobRole.NameI18n(childComplexity), true
+
 	case "JobRole.recordId":
 		if e.complexity.JobRole.RecordID == nil {
 			break
@@ -1512,6 +2109,167 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LevelStatistic.Level(childComplexity), true
 
+	case "LocalizedText.locale":
+		if e.complexity.LocalizedText.Locale == nil {
+			break
+		}
+
+		return e.complexity.LocalizedText.Locale(childComplexity), true
+
+	case "LocalizedText.value":
+		if e.complexity.LocalizedText.Value == nil {
+			break
+		}
+
+		return e.complexity.LocalizedText.Value(childComplexity), true
+
+	case "Location.address":
+		if e.complexity.Location.Address == nil {
+			break
+		}
+
+		return e.complexity.Location.Address(childComplexity), true
+
+	case "Location.code":
+		if e.complexity.Location.Code == nil {
+			break
+		}
+
+		return e.complexity.Location.Code(childComplexity), true
+
+	case "Location.countryCode":
+		if e.complexity.Location.CountryCode == nil {
+			break
+		}
+
+		return e.complexity.Location.CountryCode(childComplexity), true
+
+	case "Location.effectiveDate":
+		if e.complexity.Location.EffectiveDate == nil {
+			break
+		}
+
+		return e.complexity.Location.EffectiveDate(childComplexity), true
+
+	case "Location.endDate":
+		if e.complexity.Location.EndDate == nil {
+			break
+		}
+
+		return e.complexity.Location.EndDate(childComplexity), true
+
+	case "Location.isCurrent":
+		if e.complexity.Location.IsCurrent == nil {
+			break
+		}
+
+		return e.complexity.Location.IsCurrent(childComplexity), true
+
+	case "Location.name":
+		if e.complexity.Location.Name == nil {
+			break
+		}
+
+		return e.complexity.Location.Name(childComplexity), true
+
+	case "Location.parentCode":
+		if e.complexity.Location.ParentCode == nil {
+			break
+		}
+
+		return e.complexity.Location.ParentCode(childComplexity), true
+
+	case "Location.recordId":
+		if e.complexity.Location.RecordID == nil {
+			break
+		}
+
+		return e.complexity.Location.RecordID(childComplexity), true
+
+	case "Location.status":
+		if e.complexity.Location.Status == nil {
+			break
+		}
+
+		return e.complexity.Location.Status(childComplexity), true
+
+	case "Location.timeZone":
+		if e.complexity.Location.TimeZone == nil {
+			break
+		}
+
+		return e.complexity.Location.TimeZone(childComplexity), true
+
+	case "Location.type":
+		if e.complexity.Location.Type == nil {
+			break
+		}
+
+		return e.complexity.Location.Type(childComplexity), true
+
+	case "LocationHeadcount.assignedFte":
+		if e.complexity.LocationHeadcount.AssignedFte == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.AssignedFte(childComplexity), true
+
+	case "LocationHeadcount.availableHeadcount":
+		if e.complexity.LocationHeadcount.AvailableHeadcount == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.AvailableHeadcount(childComplexity), true
+
+	case "LocationHeadcount.countryCode":
+		if e.complexity.LocationHeadcount.CountryCode == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.CountryCode(childComplexity), true
+
+	case "LocationHeadcount.headcountCapacity":
+		if e.complexity.LocationHeadcount.HeadcountCapacity == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.HeadcountCapacity(childComplexity), true
+
+	case "LocationHeadcount.headcountInUse":
+		if e.complexity.LocationHeadcount.HeadcountInUse == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.HeadcountInUse(childComplexity), true
+
+	case "LocationHeadcount.locationCode":
+		if e.complexity.LocationHeadcount.LocationCode == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.LocationCode(childComplexity), true
+
+	case "LocationHeadcount.locationName":
+		if e.complexity.LocationHeadcount.LocationName == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.LocationName(childComplexity), true
+
+	case "LocationHeadcount.positionCount":
+		if e.complexity.LocationHeadcount.PositionCount == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.PositionCount(childComplexity), true
+
+	case "LocationHeadcount.timeZone":
+		if e.complexity.LocationHeadcount.TimeZone == nil {
+			break
+		}
+
+		return e.complexity.LocationHeadcount.TimeZone(childComplexity), true
+
 	case "OperatedBy.id":
 		if e.complexity.OperatedBy.ID == nil {
 			break
@@ -1596,6 +2354,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Organization.CreatedAt(childComplexity), true
 
+	case "Organization.customFields":
+		if e.complexity.Organization.CustomFields == nil {
+			break
+		}
+
+		return e.complexity.Organization.CustomFields(childComplexity), true
+
 	case "Organization.deletedAt":
 		if e.complexity.Organization.DeletedAt == nil {
 			break
@@ -1673,6 +2438,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Organization.Level(childComplexity), true
 
+	case "Organization.location":
+		if e.complexity.Organization.Location == nil {
+			break
+		}
+
+		args, err := ec.field_Organization_location_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Organization.Location(childComplexity, args["asOfDate"].(*dto.Date)), true
+
 	case "Organization.name":
 		if e.complexity.Organization.Name == nil {
 			break
@@ -1680,6 +2457,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Organization.Name(childComplexity), true
 
+	case "Organization.nameI18n":
+		if e.complexity.Organization.NameI18n == nil {
+			break
+		}
+
+		return e.complexity.Organization.NameI18n(childComplexity), true
+
 	case "Organization.namePath":
 		if e.complexity.Organization.NamePath == nil {
 			break
@@ -1687,6 +2471,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Organization.NamePath(childComplexity), true
 
+	case "Organization.namePathI18n":
+		if e.complexity.Organization.NamePathI18n == nil {
+			break
+		}
+
+		return e.complexity.Organization.NamePathI18n(childComplexity), true
+
 	case "Organization.parentCode":
 		if e.complexity.Organization.ParentCode == nil {
 			break
@@ -2037,6 +2828,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PathMismatch.Severity(childComplexity), true
 
+	case "PayRange.currency":
+		if e.complexity.PayRange.Currency == nil {
+			break
+		}
+
+		return e.complexity.PayRange.Currency(childComplexity), true
+
+	case "PayRange.max":
+		if e.complexity.PayRange.Max == nil {
+			break
+		}
+
+		return e.complexity.PayRange.Max(childComplexity), true
+
+	case "PayRange.mid":
+		if e.complexity.PayRange.Mid == nil {
+			break
+		}
+
+		return e.complexity.PayRange.Mid(childComplexity), true
+
+	case "PayRange.min":
+		if e.complexity.PayRange.Min == nil {
+			break
+		}
+
+		return e.complexity.PayRange.Min(childComplexity), true
+
+	case "PayRange.region":
+		if e.complexity.PayRange.Region == nil {
+			break
+		}
+
+		return e.complexity.PayRange.Region(childComplexity), true
+
 	case "Position.assignmentHistory":
 		if e.complexity.Position.AssignmentHistory == nil {
 			break
@@ -2058,6 +2884,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Position.Code(childComplexity), true
 
+	case "Position.compensationGrade":
+		if e.complexity.Position.CompensationGrade == nil {
+			break
+		}
+
+		args, err := ec.field_Position_compensationGrade_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Position.CompensationGrade(childComplexity, args["asOfDate"].(*dto.Date)), true
+
+	case "Position.costAllocations":
+		if e.complexity.Position.CostAllocations == nil {
+			break
+		}
+
+		args, err := ec.field_Position_costAllocations_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Position.CostAllocations(childComplexity, args["asOfDate"].(*dto.Date)), true
+
 	case "Position.createdAt":
 		if e.complexity.Position.CreatedAt == nil {
 			break
@@ -2072,6 +2922,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Position.CurrentAssignment(childComplexity), true
 
+	case "Position.customFields":
+		if e.complexity.Position.CustomFields == nil {
+			break
+		}
+
+		return e.complexity.Position.CustomFields(childComplexity), true
+
 	case "Position.effectiveDate":
 		if e.complexity.Position.EffectiveDate == nil {
 			break
@@ -2170,6 +3027,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Position.JobRoleCode(childComplexity), true
 
+	case "Position.location":
+		if e.complexity.Position.Location == nil {
+			break
+		}
+
+		args, err := ec.field_Position_location_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Position.Location(childComplexity, args["asOfDate"].(*dto.Date)), true
+
 	case "Position.organizationCode":
 		if e.complexity.Position.OrganizationCode == nil {
 			break
@@ -2226,6 +3095,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Position.Title(childComplexity), true
 
+	case "Position.titleI18n":
+		if e.complexity.Position.TitleI18n == nil {
+			break
+		}
+
+		return e.complexity.Position.TitleI18n(childComplexity), true
+
 	case "Position.updatedAt":
 		if e.complexity.Position.UpdatedAt == nil {
 			break
@@ -2681,6 +3557,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PositionTransferEdge.Node(childComplexity), true
 
+	case "PositionTransition.allowed":
+		if e.complexity.PositionTransition.Allowed == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.Allowed(childComplexity), true
+
+	case "PositionTransition.builtIn":
+		if e.complexity.PositionTransition.BuiltIn == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.BuiltIn(childComplexity), true
+
+	case "PositionTransition.event":
+		if e.complexity.PositionTransition.Event == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.Event(childComplexity), true
+
+	case "PositionTransition.failedGuards":
+		if e.complexity.PositionTransition.FailedGuards == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.FailedGuards(childComplexity), true
+
+	case "PositionTransition.fromStatus":
+		if e.complexity.PositionTransition.FromStatus == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.FromStatus(childComplexity), true
+
+	case "PositionTransition.guards":
+		if e.complexity.PositionTransition.Guards == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.Guards(childComplexity), true
+
+	case "PositionTransition.sideEffects":
+		if e.complexity.PositionTransition.SideEffects == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.SideEffects(childComplexity), true
+
+	case "PositionTransition.toStatus":
+		if e.complexity.PositionTransition.ToStatus == nil {
+			break
+		}
+
+		return e.complexity.PositionTransition.ToStatus(childComplexity), true
+
 	case "Query.assignmentHistory":
 		if e.complexity.Query.AssignmentHistory == nil {
 			break
@@ -2741,6 +3673,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AuditLog(childComplexity, args["auditId"].(string)), true
 
+	case "Query.compensationGrades":
+		if e.complexity.Query.CompensationGrades == nil {
+			break
+		}
+
+		args, err := ec.field_Query_compensationGrades_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CompensationGrades(childComplexity, args["jobLevelCode"].(*dto.JobLevelCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+
+	case "Query.costCenterHeadcountStats":
+		if e.complexity.Query.CostCenterHeadcountStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_costCenterHeadcountStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CostCenterHeadcountStats(childComplexity, args["asOfDate"].(*dto.Date), args["legalEntityCode"].(*string)), true
+
+	case "Query.costCenters":
+		if e.complexity.Query.CostCenters == nil {
+			break
+		}
+
+		args, err := ec.field_Query_costCenters_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CostCenters(childComplexity, args["legalEntityCode"].(*string), args["parentCode"].(*string), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+
+	case "Query.employeeAssignmentViolations":
+		if e.complexity.Query.EmployeeAssignmentViolations == nil {
+			break
+		}
+
+		args, err := ec.field_Query_employeeAssignmentViolations_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EmployeeAssignmentViolations(childComplexity, args["asOfDate"].(*dto.Date)), true
+
 	case "Query.hierarchyStatistics":
 		if e.complexity.Query.HierarchyStatistics == nil {
 			break
@@ -2763,7 +3743,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.JobFamilies(childComplexity, args["groupCode"].(dto.JobFamilyGroupCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+		return e.complexity.Query.JobFamilies(childComplexity, args["groupCode"].(dto.JobFamilyGroupCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date), args["locale"].(*string)), true
 
 	case "Query.jobFamilyGroups":
 		if e.complexity.Query.JobFamilyGroups == nil {
@@ -2775,7 +3755,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.JobFamilyGroups(childComplexity, args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+		return e.complexity.Query.JobFamilyGroups(childComplexity, args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date), args["locale"].(*string)), true
 
 	case "Query.jobLevels":
 		if e.complexity.Query.JobLevels == nil {
@@ -2787,7 +3767,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.JobLevels(childComplexity, args["roleCode"].(dto.JobRoleCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+		return e.complexity.Query.JobLevels(childComplexity, args["roleCode"].(dto.JobRoleCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date), args["locale"].(*string)), true
 
 	case "Query.jobRoles":
 		if e.complexity.Query.JobRoles == nil {
@@ -2799,7 +3779,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.JobRoles(childComplexity, args["familyCode"].(dto.JobFamilyCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
+		return e.complexity.Query.JobRoles(childComplexity, args["familyCode"].(dto.JobFamilyCode), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date), args["locale"].(*string)), true
+
+	case "Query.locationHeadcountStats":
+		if e.complexity.Query.LocationHeadcountStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_locationHeadcountStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LocationHeadcountStats(childComplexity, args["asOfDate"].(*dto.Date), args["locationCode"].(*string)), true
+
+	case "Query.locations":
+		if e.complexity.Query.Locations == nil {
+			break
+		}
+
+		args, err := ec.field_Query_locations_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Locations(childComplexity, args["type"].(*model.LocationType), args["parentCode"].(*string), args["countryCode"].(*string), args["includeInactive"].(*bool), args["asOfDate"].(*dto.Date)), true
 
 	case "Query.organization":
 		if e.complexity.Query.Organization == nil {
@@ -2811,7 +3815,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Organization(childComplexity, args["code"].(string), args["asOfDate"].(*string)), true
+		return e.complexity.Query.Organization(childComplexity, args["code"].(string), args["asOfDate"].(*string), args["locale"].(*string)), true
 
 	case "Query.organizationHierarchy":
 		if e.complexity.Query.OrganizationHierarchy == nil {
@@ -2859,7 +3863,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.OrganizationVersions(childComplexity, args["code"].(string), args["includeDeleted"].(*bool)), true
+		return e.complexity.Query.OrganizationVersions(childComplexity, args["code"].(string), args["includeDeleted"].(*bool), args["locale"].(*string)), true
 
 	case "Query.organizations":
 		if e.complexity.Query.Organizations == nil {
@@ -2871,7 +3875,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Organizations(childComplexity, args["filter"].(*model.OrganizationFilter), args["pagination"].(*model.PaginationInput)), true
+		return e.complexity.Query.Organizations(childComplexity, args["filter"].(*model.OrganizationFilter), args["pagination"].(*model.PaginationInput), args["locale"].(*string)), true
 
 	case "Query.position":
 		if e.complexity.Query.Position == nil {
@@ -2883,7 +3887,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Position(childComplexity, args["code"].(dto.PositionCode), args["asOfDate"].(*dto.Date)), true
+		return e.complexity.Query.Position(childComplexity, args["code"].(dto.PositionCode), args["asOfDate"].(*dto.Date), args["locale"].(*string)), true
+
+	case "Query.positionAllowedTransitions":
+		if e.complexity.Query.PositionAllowedTransitions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_positionAllowedTransitions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PositionAllowedTransitions(childComplexity, args["code"].(dto.PositionCode)), true
 
 	case "Query.positionAssignmentAudit":
 		if e.complexity.Query.PositionAssignmentAudit == nil {
@@ -2955,7 +3971,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.PositionVersions(childComplexity, args["code"].(dto.PositionCode), args["includeDeleted"].(*bool)), true
+		return e.complexity.Query.PositionVersions(childComplexity, args["code"].(dto.PositionCode), args["includeDeleted"].(*bool), args["locale"].(*string)), true
 
 	case "Query.positions":
 		if e.complexity.Query.Positions == nil {
@@ -2967,7 +3983,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Positions(childComplexity, args["filter"].(*model.PositionFilterInput), args["pagination"].(*model.PaginationInput), args["sorting"].([]model.PositionSortInput)), true
+		return e.complexity.Query.Positions(childComplexity, args["filter"].(*model.PositionFilterInput), args["pagination"].(*model.PaginationInput), args["sorting"].([]model.PositionSortInput), args["locale"].(*string)), true
 
 	case "Query.vacantPositions":
 		if e.complexity.Query.VacantPositions == nil {
@@ -3269,6 +4285,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCustomFieldFilterInput,
 		ec.unmarshalInputDateRangeInput,
 		ec.unmarshalInputOrganizationFilter,
 		ec.unmarshalInputPaginationInput,
@@ -3404,6 +4421,7 @@ type Query {
   organizations(
     filter: OrganizationFilter
     pagination: PaginationInput
+    locale: String
   ): OrganizationConnection!
   
   """
@@ -3415,6 +4433,7 @@ type Query {
   organization(
     code: String!
     asOfDate: String
+    locale: String
   ): Organization
   
   """
@@ -3478,6 +4497,7 @@ type Query {
     filter: PositionFilterInput
     pagination: PaginationInput
     sorting: [PositionSortInput!]
+    locale: String
   ): PositionConnection!
   
   """
@@ -3488,6 +4508,7 @@ type Query {
   position(
     code: PositionCode!
     asOfDate: Date
+    locale: String
   ): Position
   
   """
@@ -3509,6 +4530,7 @@ type Query {
   positionVersions(
     code: PositionCode!
     includeDeleted: Boolean = false
+    locale: String
   ): [Position!]!
 
   """
@@ -3570,6 +4592,17 @@ type Query {
     positionCode: PositionCode
   ): AssignmentStats!
 
+  """
+  List employees whose assignments effective on the given date (default today) break
+  cross-position rules: total FTE above 1.0, more than one PRIMARY assignment, or
+  overlapping ACTING assignments within the same organization.
+
+  Permissions Required: position:read:stats
+  """
+  employeeAssignmentViolations(
+    asOfDate: Date
+  ): [EmployeeAssignmentViolation!]!
+
   """
   Get vacant positions with optional filters.
 
@@ -3602,6 +4635,17 @@ type Query {
     includeSubordinates: Boolean = true
   ): HeadcountStats!
 
+  """
+  List lifecycle events that can be applied manually to a position in its current
+  status, evaluated against the tenant's configured state machine. Events whose guards
+  are not satisfied are returned with allowed = false and the failing guards.
+
+  Permissions Required: position:read
+  """
+  positionAllowedTransitions(
+    code: PositionCode!
+  ): [PositionTransition!]!
+
   # Audit and Analysis Queries
   
   """
@@ -3638,6 +4682,7 @@ type Query {
   organizationVersions(
     code: String!
     includeDeleted: Boolean
+    locale: String
   ): [Organization!]!
 
   # Job Catalog Queries
@@ -3650,6 +4695,7 @@ type Query {
   jobFamilyGroups(
     includeInactive: Boolean = false
     asOfDate: Date
+    locale: String
   ): [JobFamilyGroup!]!
   
   """
@@ -3661,6 +4707,7 @@ type Query {
     groupCode: JobFamilyGroupCode!
     includeInactive: Boolean = false
     asOfDate: Date
+    locale: String
   ): [JobFamily!]!
   
   """
@@ -3672,6 +4719,7 @@ type Query {
     familyCode: JobFamilyCode!
     includeInactive: Boolean = false
     asOfDate: Date
+    locale: String
   ): [JobRole!]!
   
   """
@@ -3683,8 +4731,73 @@ type Query {
     roleCode: JobRoleCode!
     includeInactive: Boolean = false
     asOfDate: Date
+    locale: String
   ): [JobLevel!]!
 
+  """
+  Get compensation grades effective at a date, optionally limited to the grades
+  allowed for a job level.
+  
+  Permissions Required: compensation:read
+  """
+  compensationGrades(
+    jobLevelCode: JobLevelCode
+    includeInactive: Boolean = false
+    asOfDate: Date
+  ): [CompensationGrade!]!
+
+  """
+  Get cost centers effective at a date, optionally filtered by legal entity
+  (COMPANY organization code) or parent cost center.
+  
+  Permissions Required: cost-center:read
+  """
+  costCenters(
+    legalEntityCode: String
+    parentCode: String
+    includeInactive: Boolean = false
+    asOfDate: Date
+  ): [CostCenter!]!
+
+  """
+  Headcount capacity, usage and assigned FTE per cost center. Positions with a
+  cost allocation are split by percentage; otherwise the position costCenterCode
+  carries 100%.
+  
+  Permissions Required: cost-center:read
+  """
+  costCenterHeadcountStats(
+    asOfDate: Date
+    legalEntityCode: String
+  ): [CostCenterHeadcount!]!
+
+  """
+  Get work locations (sites and regions) effective at a date, optionally filtered
+  by type, parent region or country.
+  
+  Permissions Required: location:read
+  """
+  locations(
+    type: LocationType
+    parentCode: String
+    countryCode: String
+    includeInactive: Boolean = false
+    asOfDate: Date
+  ): [Location!]!
+
+  """
+  Headcount capacity, usage and assigned FTE per work location. Positions are
+  counted at their effective location (position override, otherwise the
+  organization unit default). A region locationCode limits the result to the
+  sites below it; positions without a location are not counted.
+  
+  Permissions Required: location:read
+  """
+  locationHeadcountStats(
+    asOfDate: Date
+    locationCode: String
+  ): [LocationHeadcount!]!
+
 
   # System Maintenance and Monitoring
 
@@ -3715,6 +4828,10 @@ type Organization {
   sortOrder: Int
   codePath: String!
   namePath: String!
+  # Localized names (BCP 47 locale -> name) stored with this temporal version;
+  # name/namePath are resolved from these by Accept-Language or the locale argument
+  nameI18n: [LocalizedText!]!
+  namePathI18n: [LocalizedText!]!
   path: String @deprecated(reason: "使用 codePath/namePath 作为唯一事实来源，path 将在后续版本移除")
 
   # Configuration
@@ -3748,6 +4865,14 @@ type Organization {
   suspendedAt: String
   suspendedBy: String
   suspensionReason: String
+
+  # Tenant-defined custom fields stored with this temporal version
+  customFields: [CustomFieldValue!]!
+  """
+  Default work location at asOfDate (defaults to today). Null when no default
+  location is assigned. Requires location:read.
+  """
+  location(asOfDate: Date): EffectiveLocation
 }
 
 """
@@ -3913,6 +5038,7 @@ type Position {
   recordId: UUID!
   tenantId: UUID!
   title: String!
+  titleI18n: [LocalizedText!]!
   jobProfileCode: String
   jobProfileName: String
   jobFamilyGroupCode: JobFamilyGroupCode!
@@ -3937,6 +5063,25 @@ type Position {
   isFuture: Boolean!
   createdAt: DateTime!
   updatedAt: DateTime!
+  customFields: [CustomFieldValue!]!
+  """
+  Compensation grade referenced by gradeLevel, resolved at asOfDate (defaults to
+  today). Null when gradeLevel is empty or not a defined grade.
+  Requires compensation:read.
+  """
+  compensationGrade(asOfDate: Date): CompensationGrade
+  """
+  Cost allocation effective at asOfDate (defaults to today). Without an
+  allocation the position costCenterCode carries 100% and effectiveDate is null.
+  Requires cost-center:read.
+  """
+  costAllocations(asOfDate: Date): [CostAllocation!]!
+  """
+  Effective work location at asOfDate (defaults to today): the position
+  override when set, otherwise the organization unit default. Null when neither
+  is assigned. Requires location:read.
+  """
+  location(asOfDate: Date): EffectiveLocation
 }
 
 type PositionEdge {
@@ -4013,6 +5158,23 @@ type AssignmentStats {
   lastUpdatedAt: DateTime!
 }
 
+"""
+An existing breach of the cross-position assignment rules for one employee.
+rule is EMPLOYEE_FTE_EXCEEDED, EMPLOYEE_PRIMARY_CONFLICT or EMPLOYEE_ACTING_OVERLAP;
+organizationCode is set for ACTING overlaps only.
+"""
+type EmployeeAssignmentViolation {
+  rule: String!
+  employeeId: UUID!
+  employeeName: String!
+  organizationCode: String
+  totalFte: Float!
+  maxFte: Float!
+  assignmentIds: [String!]!
+  positionCodes: [String!]!
+  asOfDate: Date!
+}
+
 type VacantPosition {
   positionCode: PositionCode!
   organizationCode: String!
@@ -4099,6 +5261,7 @@ type JobFamilyGroup {
   code: JobFamilyGroupCode!
   recordId: UUID!
   name: String!
+  nameI18n: [LocalizedText!]!
   status: JobCatalogStatus!
   effectiveDate: Date!
   endDate: Date
@@ -4110,6 +5273,7 @@ type JobFamily {
   recordId: UUID!
   groupCode: JobFamilyGroupCode!
   name: String!
+  nameI18n: [LocalizedText!]!
   status: JobCatalogStatus!
   effectiveDate: Date!
   endDate: Date
@@ -4121,6 +5285,7 @@ type JobRole {
   recordId: UUID!
   familyCode: JobFamilyCode!
   name: String!
+  nameI18n: [LocalizedText!]!
   status: JobCatalogStatus!
   effectiveDate: Date!
   endDate: Date
@@ -4132,11 +5297,159 @@ type JobLevel {
   recordId: UUID!
   roleCode: JobRoleCode!
   name: String!
+  nameI18n: [LocalizedText!]!
   status: JobCatalogStatus!
   effectiveDate: Date!
   endDate: Date
   levelRank: Int!
   description: String
+  """
+  Compensation grades allowed for this level at asOfDate (defaults to today).
+  Requires compensation:read.
+  """
+  compensationGrades(asOfDate: Date, includeInactive: Boolean = false): [CompensationGrade!]
+}
+
+"""
+Versioned compensation grade with pay ranges per currency and region.
+endDate is derived from the next version's effective date.
+"""
+type CompensationGrade {
+  code: String!
+  recordId: UUID!
+  name: String!
+  description: String
+  status: CompensationGradeStatus!
+  jobLevelCodes: [JobLevelCode!]!
+  payRanges: [PayRange!]!
+  effectiveDate: Date!
+  endDate: Date
+  isCurrent: Boolean!
+}
+
+"""
+Pay range of a compensation grade for one currency and region (GLOBAL when not
+region specific).
+"""
+type PayRange {
+  currency: String!
+  region: String!
+  min: Float!
+  mid: Float!
+  max: Float!
+}
+
+"""
+Versioned cost center owned by a legal entity, optionally nested under a parent
+cost center of the same legal entity. endDate is derived from the next version's
+effective date.
+"""
+type CostCenter {
+  code: String!
+  recordId: UUID!
+  name: String!
+  description: String
+  status: CostCenterStatus!
+  parentCode: String
+  legalEntityCode: String!
+  effectiveDate: Date!
+  endDate: Date
+  isCurrent: Boolean!
+}
+
+"""
+Share of a position's cost carried by one cost center.
+"""
+type CostAllocation {
+  costCenterCode: String!
+  costCenterName: String
+  percentage: Float!
+  effectiveDate: Date
+}
+
+"""
+Headcount figures per cost center, weighted by allocation percentage.
+"""
+type CostCenterHeadcount {
+  costCenterCode: String!
+  costCenterName: String
+  legalEntityCode: String
+  positionCount: Int!
+  headcountCapacity: Float!
+  headcountInUse: Float!
+  assignedFte: Float!
+  availableHeadcount: Float!
+}
+
+"""
+Versioned work location. SITEs carry country, time zone and address and can be
+assigned to organization units and positions; REGIONs group sites and other
+regions into a hierarchy. endDate is derived from the next version's effective
+date.
+"""
+type Location {
+  code: String!
+  recordId: UUID!
+  name: String!
+  type: LocationType!
+  status: LocationStatus!
+  parentCode: String
+  countryCode: String
+  timeZone: String
+  address: Address
+  effectiveDate: Date!
+  endDate: Date
+  isCurrent: Boolean!
+}
+
+"""
+Postal address of a work location.
+"""
+type Address {
+  line1: String
+  line2: String
+  city: String
+  stateProvince: String
+  postalCode: String
+}
+
+"""
+Work location in effect for an organization unit or position, with the
+assignment it comes from.
+"""
+type EffectiveLocation {
+  location: Location!
+  source: LocationSource!
+  effectiveDate: Date!
+}
+
+"""
+Headcount figures per work location.
+"""
+type LocationHeadcount {
+  locationCode: String!
+  locationName: String
+  countryCode: String
+  timeZone: String
+  positionCount: Int!
+  headcountCapacity: Float!
+  headcountInUse: Float!
+  assignedFte: Float!
+  availableHeadcount: Float!
+}
+
+"""
+A position lifecycle event available from the position's current status.
+"""
+type PositionTransition {
+  event: String!
+  fromStatus: String!
+  toStatus: String!
+  allowed: Boolean!
+  guards: [String!]!
+  failedGuards: [String!]!
+  sideEffects: [String!]!
+  builtIn: Boolean!
 }
 
 """
@@ -4361,6 +5674,12 @@ input OrganizationFilter {
   operationType: OperationType
   operatedBy: String
   operationDateRange: DateRangeInput
+
+  # Custom Field Filtering (all conditions must match)
+  customFields: [CustomFieldFilterInput!]
+
+  # Default work location is this site or a site below this region
+  locationCode: String
 }
 
 """
@@ -4395,6 +5714,42 @@ input PositionFilterInput {
   positionTypes: [PositionType!]
   employmentTypes: [EmploymentType!]
   effectiveRange: DateRangeInput
+  customFields: [CustomFieldFilterInput!]
+  # Effective work location (override or inherited) is this site or below this region
+  locationCode: String
+}
+
+"""
+Localized variant of a name. Selection for name/title fields follows RFC 4647
+lookup: the exact locale, then progressively shorter tags (zh-Hant-TW -> zh-Hant
+-> zh), then the next preferred locale, finally the default name.
+"""
+type LocalizedText {
+  locale: String!
+  value: String!
+}
+
+"""
+Tenant-defined custom field value. Exactly one of the typed value fields is set
+according to ` + "`" + `type` + "`" + ` (ENUM values are returned in stringValue).
+"""
+type CustomFieldValue {
+  key: String!
+  type: CustomFieldType!
+  stringValue: String
+  numberValue: Float
+  booleanValue: Boolean
+  dateValue: Date
+}
+
+"""
+Filter on a tenant-defined custom field. ` + "`" + `value` + "`" + ` is compared as a number for
+GT/GTE/LT/LTE when it parses as one, otherwise as text; EXISTS ignores ` + "`" + `value` + "`" + `.
+"""
+input CustomFieldFilterInput {
+  key: String!
+  operator: CustomFieldFilterOperator = EQ
+  value: String
 }
 
 """
@@ -4456,6 +5811,7 @@ input VacantPositionFilterInput {
   positionTypes: [PositionType!]
   minimumVacantDays: Int
   asOfDate: Date
+  locationCode: String
 }
 
 """
@@ -4478,15 +5834,37 @@ input VacantPositionSortInput {
 # Enums
 
 """
-Organization unit types with specific business semantics.
+Data type of a tenant-defined custom field.
+"""
+enum CustomFieldType {
+  STRING
+  NUMBER
+  BOOLEAN
+  DATE
+  ENUM
+}
+
+"""
+Comparison operators for custom field filters.
 """
-enum UnitType {
-  DEPARTMENT      # Regular business department
-  ORGANIZATION_UNIT  # Generic organizational unit
-  COMPANY        # Legal entity company
-  PROJECT_TEAM   # Temporary project team
+enum CustomFieldFilterOperator {
+  EQ
+  NE
+  CONTAINS
+  GT
+  GTE
+  LT
+  LTE
+  EXISTS
 }
 
+"""
+Organization unit type code. Built-in types are DEPARTMENT, ORGANIZATION_UNIT, COMPANY and PROJECT_TEAM;
+tenants may define additional types (e.g. REGION, BRANCH) with allowed parent types and maximum depth.
+Accepts the former enum literals (e.g. ` + "`" + `unitType: DEPARTMENT` + "`" + `) as well as string values.
+"""
+scalar UnitType
+
 """
 Organization business status (ADR-008: 一维业务状态模型).
 """
@@ -4609,6 +5987,47 @@ enum JobCatalogStatus {
   INACTIVE
 }
 
+"""
+Status for compensation grades.
+"""
+enum CompensationGradeStatus {
+  ACTIVE
+  INACTIVE
+}
+
+"""
+Status for cost centers.
+"""
+enum CostCenterStatus {
+  ACTIVE
+  INACTIVE
+}
+
+"""
+Kind of work location.
+"""
+enum LocationType {
+  REGION
+  SITE
+}
+
+"""
+Status for work locations.
+"""
+enum LocationStatus {
+  ACTIVE
+  INACTIVE
+}
+
+"""
+Assignment an effective location comes from: a position override or the
+organization unit default.
+"""
+enum LocationSource {
+  POSITION
+  ORGANIZATION_UNIT
+}
+
 # Scalar Types
 
 """
@@ -4698,6 +6117,90 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_JobLevel_compensationGrades_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["includeInactive"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeInactive"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeInactive"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Organization_location_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Position_compensationGrade_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Position_costAllocations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Position_location_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4905,6 +6408,120 @@ func (ec *executionContext) field_Query_auditLog_args(ctx context.Context, rawAr
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_compensationGrades_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.JobLevelCode
+	if tmp, ok := rawArgs["jobLevelCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("jobLevelCode"))
+		arg0, err = ec.unmarshalOJobLevelCode2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["jobLevelCode"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["includeInactive"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeInactive"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeInactive"] = arg1
+	var arg2 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg2, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_costCenterHeadcountStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["legalEntityCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("legalEntityCode"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["legalEntityCode"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_costCenters_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["legalEntityCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("legalEntityCode"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["legalEntityCode"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["parentCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parentCode"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["parentCode"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["includeInactive"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeInactive"))
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeInactive"] = arg2
+	var arg3 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg3, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_employeeAssignmentViolations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_hierarchyStatistics_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4959,6 +6576,15 @@ func (ec *executionContext) field_Query_jobFamilies_args(ctx context.Context, ra
 		}
 	}
 	args["asOfDate"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg3
 	return args, nil
 }
 
@@ -4983,6 +6609,15 @@ func (ec *executionContext) field_Query_jobFamilyGroups_args(ctx context.Context
 		}
 	}
 	args["asOfDate"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
 	return args, nil
 }
 
@@ -5016,6 +6651,15 @@ func (ec *executionContext) field_Query_jobLevels_args(ctx context.Context, rawA
 		}
 	}
 	args["asOfDate"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg3
 	return args, nil
 }
 
@@ -5049,6 +6693,90 @@ func (ec *executionContext) field_Query_jobRoles_args(ctx context.Context, rawAr
 		}
 	}
 	args["asOfDate"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_locationHeadcountStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg0, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["locationCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationCode"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locationCode"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_locations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *model.LocationType
+	if tmp, ok := rawArgs["type"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+		arg0, err = ec.unmarshalOLocationType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["type"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["parentCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parentCode"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["parentCode"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["countryCode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("countryCode"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["countryCode"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["includeInactive"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeInactive"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeInactive"] = arg3
+	var arg4 *dto.Date
+	if tmp, ok := rawArgs["asOfDate"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOfDate"))
+		arg4, err = ec.unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["asOfDate"] = arg4
 	return args, nil
 }
 
@@ -5163,6 +6891,15 @@ func (ec *executionContext) field_Query_organizationVersions_args(ctx context.Co
 		}
 	}
 	args["includeDeleted"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
 	return args, nil
 }
 
@@ -5187,6 +6924,15 @@ func (ec *executionContext) field_Query_organization_args(ctx context.Context, r
 		}
 	}
 	args["asOfDate"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
 	return args, nil
 }
 
@@ -5211,6 +6957,30 @@ func (ec *executionContext) field_Query_organizations_args(ctx context.Context,
 		}
 	}
 	args["pagination"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_positionAllowedTransitions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 dto.PositionCode
+	if tmp, ok := rawArgs["code"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+		arg0, err = ec.unmarshalNPositionCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐPositionCode(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
 	return args, nil
 }
 
@@ -5409,6 +7179,15 @@ func (ec *executionContext) field_Query_positionVersions_args(ctx context.Contex
 		}
 	}
 	args["includeDeleted"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
 	return args, nil
 }
 
@@ -5433,6 +7212,15 @@ func (ec *executionContext) field_Query_position_args(ctx context.Context, rawAr
 		}
 	}
 	args["asOfDate"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
 	return args, nil
 }
 
@@ -5466,6 +7254,15 @@ func (ec *executionContext) field_Query_positions_args(ctx context.Context, rawA
 		}
 	}
 	args["sorting"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg3
 	return args, nil
 }
 
@@ -5540,6 +7337,211 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _Address_line1(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Address_line1(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line1, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Address_line1(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_line2(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Address_line2(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line2, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Address_line2(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_city(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Address_city(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.City, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Address_city(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_stateProvince(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Address_stateProvince(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StateProvince, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Address_stateProvince(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_postalCode(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Address_postalCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostalCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Address_postalCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AssignmentStats_positionCode(ctx context.Context, field graphql.CollectedField, obj *model.AssignmentStats) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AssignmentStats_positionCode(ctx, field)
 	if err != nil {
@@ -6723,8 +8725,8 @@ func (ec *executionContext) fieldContext_CircularReference_severity(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_pathMismatches(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_pathMismatches(ctx, field)
+func (ec *executionContext) _CompensationGrade_code(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6737,7 +8739,7 @@ func (ec *executionContext) _ConsistencyFindings_pathMismatches(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PathMismatches, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6749,40 +8751,26 @@ func (ec *executionContext) _ConsistencyFindings_pathMismatches(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.PathMismatch)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPathMismatch2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPathMismatchᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_pathMismatches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_PathMismatch_code(ctx, field)
-			case "expectedCodePath":
-				return ec.fieldContext_PathMismatch_expectedCodePath(ctx, field)
-			case "actualCodePath":
-				return ec.fieldContext_PathMismatch_actualCodePath(ctx, field)
-			case "expectedNamePath":
-				return ec.fieldContext_PathMismatch_expectedNamePath(ctx, field)
-			case "actualNamePath":
-				return ec.fieldContext_PathMismatch_actualNamePath(ctx, field)
-			case "severity":
-				return ec.fieldContext_PathMismatch_severity(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PathMismatch", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_levelInconsistencies(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_levelInconsistencies(ctx, field)
+func (ec *executionContext) _CompensationGrade_recordId(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_recordId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6795,7 +8783,7 @@ func (ec *executionContext) _ConsistencyFindings_levelInconsistencies(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LevelInconsistencies, nil
+		return obj.RecordID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6807,38 +8795,26 @@ func (ec *executionContext) _ConsistencyFindings_levelInconsistencies(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.LevelInconsistency)
+	res := resTmp.(dto.UUID)
 	fc.Result = res
-	return ec.marshalNLevelInconsistency2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelInconsistencyᚄ(ctx, field.Selections, res)
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_levelInconsistencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_LevelInconsistency_code(ctx, field)
-			case "expectedLevel":
-				return ec.fieldContext_LevelInconsistency_expectedLevel(ctx, field)
-			case "actualLevel":
-				return ec.fieldContext_LevelInconsistency_actualLevel(ctx, field)
-			case "parentCode":
-				return ec.fieldContext_LevelInconsistency_parentCode(ctx, field)
-			case "reason":
-				return ec.fieldContext_LevelInconsistency_reason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LevelInconsistency", field.Name)
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_orphanedNodes(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_orphanedNodes(ctx, field)
+func (ec *executionContext) _CompensationGrade_name(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6851,7 +8827,7 @@ func (ec *executionContext) _ConsistencyFindings_orphanedNodes(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OrphanedNodes, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6863,36 +8839,26 @@ func (ec *executionContext) _ConsistencyFindings_orphanedNodes(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.OrphanedNode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrphanedNode2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐOrphanedNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_orphanedNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_OrphanedNode_code(ctx, field)
-			case "name":
-				return ec.fieldContext_OrphanedNode_name(ctx, field)
-			case "parentCode":
-				return ec.fieldContext_OrphanedNode_parentCode(ctx, field)
-			case "reason":
-				return ec.fieldContext_OrphanedNode_reason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrphanedNode", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_circularReferences(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_circularReferences(ctx, field)
+func (ec *executionContext) _CompensationGrade_description(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6905,46 +8871,35 @@ func (ec *executionContext) _ConsistencyFindings_circularReferences(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CircularReferences, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.CircularReference)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReferenceᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_circularReferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "affectedCodes":
-				return ec.fieldContext_CircularReference_affectedCodes(ctx, field)
-			case "circularPath":
-				return ec.fieldContext_CircularReference_circularPath(ctx, field)
-			case "severity":
-				return ec.fieldContext_CircularReference_severity(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CircularReference", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_depthViolations(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_depthViolations(ctx, field)
+func (ec *executionContext) _CompensationGrade_status(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6957,7 +8912,7 @@ func (ec *executionContext) _ConsistencyFindings_depthViolations(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DepthViolations, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6969,36 +8924,26 @@ func (ec *executionContext) _ConsistencyFindings_depthViolations(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.DepthViolation)
+	res := resTmp.(model.CompensationGradeStatus)
 	fc.Result = res
-	return ec.marshalNDepthViolation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐDepthViolationᚄ(ctx, field.Selections, res)
+	return ec.marshalNCompensationGradeStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_depthViolations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_DepthViolation_code(ctx, field)
-			case "currentDepth":
-				return ec.fieldContext_DepthViolation_currentDepth(ctx, field)
-			case "maxAllowedDepth":
-				return ec.fieldContext_DepthViolation_maxAllowedDepth(ctx, field)
-			case "parentChain":
-				return ec.fieldContext_DepthViolation_parentChain(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DepthViolation", field.Name)
+			return nil, errors.New("field of type CompensationGradeStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConsistencyFindings_cacheInconsistencies(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConsistencyFindings_cacheInconsistencies(ctx, field)
+func (ec *executionContext) _CompensationGrade_jobLevelCodes(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_jobLevelCodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7011,7 +8956,7 @@ func (ec *executionContext) _ConsistencyFindings_cacheInconsistencies(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CacheInconsistencies, nil
+		return obj.JobLevelCodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7023,38 +8968,26 @@ func (ec *executionContext) _ConsistencyFindings_cacheInconsistencies(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.CacheInconsistency)
+	res := resTmp.([]dto.JobLevelCode)
 	fc.Result = res
-	return ec.marshalNCacheInconsistency2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCacheInconsistencyᚄ(ctx, field.Selections, res)
+	return ec.marshalNJobLevelCode2ᚕcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConsistencyFindings_cacheInconsistencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_jobLevelCodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConsistencyFindings",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_CacheInconsistency_code(ctx, field)
-			case "fieldName":
-				return ec.fieldContext_CacheInconsistency_fieldName(ctx, field)
-			case "cachedValue":
-				return ec.fieldContext_CacheInconsistency_cachedValue(ctx, field)
-			case "calculatedValue":
-				return ec.fieldContext_CacheInconsistency_calculatedValue(ctx, field)
-			case "impactLevel":
-				return ec.fieldContext_CacheInconsistency_impactLevel(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CacheInconsistency", field.Name)
+			return nil, errors.New("field of type JobLevelCode does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataChanges_beforeData(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DataChanges_beforeData(ctx, field)
+func (ec *executionContext) _CompensationGrade_payRanges(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_payRanges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7067,35 +9000,50 @@ func (ec *executionContext) _DataChanges_beforeData(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BeforeData, nil
+		return obj.PayRanges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JSON)
+	res := resTmp.([]model.PayRange)
 	fc.Result = res
-	return ec.marshalOJSON2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJSON(ctx, field.Selections, res)
+	return ec.marshalNPayRange2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPayRangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DataChanges_beforeData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_payRanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataChanges",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JSON does not have child fields")
+			switch field.Name {
+			case "currency":
+				return ec.fieldContext_PayRange_currency(ctx, field)
+			case "region":
+				return ec.fieldContext_PayRange_region(ctx, field)
+			case "min":
+				return ec.fieldContext_PayRange_min(ctx, field)
+			case "mid":
+				return ec.fieldContext_PayRange_mid(ctx, field)
+			case "max":
+				return ec.fieldContext_PayRange_max(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PayRange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataChanges_afterData(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DataChanges_afterData(ctx, field)
+func (ec *executionContext) _CompensationGrade_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7108,35 +9056,38 @@ func (ec *executionContext) _DataChanges_afterData(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AfterData, nil
+		return obj.EffectiveDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JSON)
+	res := resTmp.(dto.Date)
 	fc.Result = res
-	return ec.marshalOJSON2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJSON(ctx, field.Selections, res)
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DataChanges_afterData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataChanges",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JSON does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataChanges_modifiedFields(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DataChanges_modifiedFields(ctx, field)
+func (ec *executionContext) _CompensationGrade_endDate(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_endDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7149,38 +9100,35 @@ func (ec *executionContext) _DataChanges_modifiedFields(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModifiedFields, nil
+		return obj.EndDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*dto.Date)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DataChanges_modifiedFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataChanges",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateRange_earliest(ctx context.Context, field graphql.CollectedField, obj *model.DateRange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateRange_earliest(ctx, field)
+func (ec *executionContext) _CompensationGrade_isCurrent(ctx context.Context, field graphql.CollectedField, obj *model.CompensationGrade) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CompensationGrade_isCurrent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7193,7 +9141,7 @@ func (ec *executionContext) _DateRange_earliest(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Earliest, nil
+		return obj.IsCurrent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7205,26 +9153,26 @@ func (ec *executionContext) _DateRange_earliest(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.DateTime)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateRange_earliest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CompensationGrade_isCurrent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateRange",
+		Object:     "CompensationGrade",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateRange_latest(ctx context.Context, field graphql.CollectedField, obj *model.DateRange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateRange_latest(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_pathMismatches(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_pathMismatches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7237,7 +9185,7 @@ func (ec *executionContext) _DateRange_latest(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Latest, nil
+		return obj.PathMismatches, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7249,26 +9197,40 @@ func (ec *executionContext) _DateRange_latest(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.DateTime)
+	res := resTmp.([]model.PathMismatch)
 	fc.Result = res
-	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
+	return ec.marshalNPathMismatch2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPathMismatchᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateRange_latest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_pathMismatches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateRange",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_PathMismatch_code(ctx, field)
+			case "expectedCodePath":
+				return ec.fieldContext_PathMismatch_expectedCodePath(ctx, field)
+			case "actualCodePath":
+				return ec.fieldContext_PathMismatch_actualCodePath(ctx, field)
+			case "expectedNamePath":
+				return ec.fieldContext_PathMismatch_expectedNamePath(ctx, field)
+			case "actualNamePath":
+				return ec.fieldContext_PathMismatch_actualNamePath(ctx, field)
+			case "severity":
+				return ec.fieldContext_PathMismatch_severity(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PathMismatch", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthDistribution_depth(ctx context.Context, field graphql.CollectedField, obj *model.DepthDistribution) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthDistribution_depth(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_levelInconsistencies(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_levelInconsistencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7281,7 +9243,7 @@ func (ec *executionContext) _DepthDistribution_depth(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Depth, nil
+		return obj.LevelInconsistencies, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7293,26 +9255,38 @@ func (ec *executionContext) _DepthDistribution_depth(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]model.LevelInconsistency)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNLevelInconsistency2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelInconsistencyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthDistribution_depth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_levelInconsistencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthDistribution",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_LevelInconsistency_code(ctx, field)
+			case "expectedLevel":
+				return ec.fieldContext_LevelInconsistency_expectedLevel(ctx, field)
+			case "actualLevel":
+				return ec.fieldContext_LevelInconsistency_actualLevel(ctx, field)
+			case "parentCode":
+				return ec.fieldContext_LevelInconsistency_parentCode(ctx, field)
+			case "reason":
+				return ec.fieldContext_LevelInconsistency_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LevelInconsistency", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthDistribution_count(ctx context.Context, field graphql.CollectedField, obj *model.DepthDistribution) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthDistribution_count(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_orphanedNodes(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_orphanedNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7325,7 +9299,7 @@ func (ec *executionContext) _DepthDistribution_count(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.OrphanedNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7337,26 +9311,36 @@ func (ec *executionContext) _DepthDistribution_count(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]model.OrphanedNode)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOrphanedNode2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐOrphanedNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthDistribution_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_orphanedNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthDistribution",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_OrphanedNode_code(ctx, field)
+			case "name":
+				return ec.fieldContext_OrphanedNode_name(ctx, field)
+			case "parentCode":
+				return ec.fieldContext_OrphanedNode_parentCode(ctx, field)
+			case "reason":
+				return ec.fieldContext_OrphanedNode_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrphanedNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthViolation_code(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthViolation_code(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_circularReferences(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_circularReferences(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7369,7 +9353,7 @@ func (ec *executionContext) _DepthViolation_code(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.CircularReferences, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7381,26 +9365,34 @@ func (ec *executionContext) _DepthViolation_code(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.CircularReference)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthViolation_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_circularReferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthViolation",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "affectedCodes":
+				return ec.fieldContext_CircularReference_affectedCodes(ctx, field)
+			case "circularPath":
+				return ec.fieldContext_CircularReference_circularPath(ctx, field)
+			case "severity":
+				return ec.fieldContext_CircularReference_severity(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CircularReference", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthViolation_currentDepth(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthViolation_currentDepth(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_depthViolations(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_depthViolations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7413,7 +9405,7 @@ func (ec *executionContext) _DepthViolation_currentDepth(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CurrentDepth, nil
+		return obj.DepthViolations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7425,26 +9417,36 @@ func (ec *executionContext) _DepthViolation_currentDepth(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]model.DepthViolation)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNDepthViolation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐDepthViolationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthViolation_currentDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_depthViolations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthViolation",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_DepthViolation_code(ctx, field)
+			case "currentDepth":
+				return ec.fieldContext_DepthViolation_currentDepth(ctx, field)
+			case "maxAllowedDepth":
+				return ec.fieldContext_DepthViolation_maxAllowedDepth(ctx, field)
+			case "parentChain":
+				return ec.fieldContext_DepthViolation_parentChain(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DepthViolation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthViolation_maxAllowedDepth(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthViolation_maxAllowedDepth(ctx, field)
+func (ec *executionContext) _ConsistencyFindings_cacheInconsistencies(ctx context.Context, field graphql.CollectedField, obj *model.ConsistencyFindings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConsistencyFindings_cacheInconsistencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7457,7 +9459,7 @@ func (ec *executionContext) _DepthViolation_maxAllowedDepth(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxAllowedDepth, nil
+		return obj.CacheInconsistencies, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7469,26 +9471,38 @@ func (ec *executionContext) _DepthViolation_maxAllowedDepth(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]model.CacheInconsistency)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNCacheInconsistency2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCacheInconsistencyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthViolation_maxAllowedDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConsistencyFindings_cacheInconsistencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthViolation",
+		Object:     "ConsistencyFindings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_CacheInconsistency_code(ctx, field)
+			case "fieldName":
+				return ec.fieldContext_CacheInconsistency_fieldName(ctx, field)
+			case "cachedValue":
+				return ec.fieldContext_CacheInconsistency_cachedValue(ctx, field)
+			case "calculatedValue":
+				return ec.fieldContext_CacheInconsistency_calculatedValue(ctx, field)
+			case "impactLevel":
+				return ec.fieldContext_CacheInconsistency_impactLevel(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CacheInconsistency", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DepthViolation_parentChain(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DepthViolation_parentChain(ctx, field)
+func (ec *executionContext) _CostAllocation_costCenterCode(ctx context.Context, field graphql.CollectedField, obj *model.CostAllocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostAllocation_costCenterCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7501,7 +9515,7 @@ func (ec *executionContext) _DepthViolation_parentChain(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParentChain, nil
+		return obj.CostCenterCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7513,14 +9527,14 @@ func (ec *executionContext) _DepthViolation_parentChain(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DepthViolation_parentChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostAllocation_costCenterCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepthViolation",
+		Object:     "CostAllocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7531,8 +9545,8 @@ func (ec *executionContext) fieldContext_DepthViolation_parentChain(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _FamilyHeadcount_jobFamilyCode(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FamilyHeadcount_jobFamilyCode(ctx, field)
+func (ec *executionContext) _CostAllocation_costCenterName(ctx context.Context, field graphql.CollectedField, obj *model.CostAllocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostAllocation_costCenterName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7545,38 +9559,35 @@ func (ec *executionContext) _FamilyHeadcount_jobFamilyCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.JobFamilyCode, nil
+		return obj.CostCenterName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobFamilyCode)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FamilyHeadcount_jobFamilyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostAllocation_costCenterName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FamilyHeadcount",
+		Object:     "CostAllocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobFamilyCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FamilyHeadcount_jobFamilyName(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FamilyHeadcount_jobFamilyName(ctx, field)
+func (ec *executionContext) _CostAllocation_percentage(ctx context.Context, field graphql.CollectedField, obj *model.CostAllocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostAllocation_percentage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7589,35 +9600,38 @@ func (ec *executionContext) _FamilyHeadcount_jobFamilyName(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.JobFamilyName, nil
+		return obj.Percentage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FamilyHeadcount_jobFamilyName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostAllocation_percentage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FamilyHeadcount",
+		Object:     "CostAllocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FamilyHeadcount_capacity(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FamilyHeadcount_capacity(ctx, field)
+func (ec *executionContext) _CostAllocation_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.CostAllocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostAllocation_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7630,38 +9644,35 @@ func (ec *executionContext) _FamilyHeadcount_capacity(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Capacity, nil
+		return obj.EffectiveDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*dto.Date)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FamilyHeadcount_capacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostAllocation_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FamilyHeadcount",
+		Object:     "CostAllocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FamilyHeadcount_utilized(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FamilyHeadcount_utilized(ctx, field)
+func (ec *executionContext) _CostCenter_code(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7674,7 +9685,7 @@ func (ec *executionContext) _FamilyHeadcount_utilized(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Utilized, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7686,26 +9697,26 @@ func (ec *executionContext) _FamilyHeadcount_utilized(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FamilyHeadcount_utilized(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FamilyHeadcount",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FamilyHeadcount_available(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FamilyHeadcount_available(ctx, field)
+func (ec *executionContext) _CostCenter_recordId(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_recordId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7718,7 +9729,7 @@ func (ec *executionContext) _FamilyHeadcount_available(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Available, nil
+		return obj.RecordID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7730,26 +9741,26 @@ func (ec *executionContext) _FamilyHeadcount_available(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(dto.UUID)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FamilyHeadcount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FamilyHeadcount",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldChange_field(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FieldChange_field(ctx, field)
+func (ec *executionContext) _CostCenter_name(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7762,7 +9773,7 @@ func (ec *executionContext) _FieldChange_field(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Field, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7779,9 +9790,9 @@ func (ec *executionContext) _FieldChange_field(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FieldChange_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldChange",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7792,8 +9803,8 @@ func (ec *executionContext) fieldContext_FieldChange_field(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldChange_oldValue(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FieldChange_oldValue(ctx, field)
+func (ec *executionContext) _CostCenter_description(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7806,7 +9817,7 @@ func (ec *executionContext) _FieldChange_oldValue(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OldValue, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7820,9 +9831,9 @@ func (ec *executionContext) _FieldChange_oldValue(ctx context.Context, field gra
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FieldChange_oldValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldChange",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7833,8 +9844,8 @@ func (ec *executionContext) fieldContext_FieldChange_oldValue(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldChange_newValue(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FieldChange_newValue(ctx, field)
+func (ec *executionContext) _CostCenter_status(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7847,35 +9858,38 @@ func (ec *executionContext) _FieldChange_newValue(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NewValue, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.CostCenterStatus)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCostCenterStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FieldChange_newValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldChange",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type CostCenterStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FieldChange_dataType(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FieldChange_dataType(ctx, field)
+func (ec *executionContext) _CostCenter_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_parentCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7888,26 +9902,23 @@ func (ec *executionContext) _FieldChange_dataType(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataType, nil
+		return obj.ParentCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FieldChange_dataType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FieldChange",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7918,8 +9929,8 @@ func (ec *executionContext) fieldContext_FieldChange_dataType(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_organizationCode(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_organizationCode(ctx, field)
+func (ec *executionContext) _CostCenter_legalEntityCode(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_legalEntityCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7932,7 +9943,7 @@ func (ec *executionContext) _HeadcountStats_organizationCode(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OrganizationCode, nil
+		return obj.LegalEntityCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7949,9 +9960,9 @@ func (ec *executionContext) _HeadcountStats_organizationCode(ctx context.Context
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_organizationCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_legalEntityCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7962,8 +9973,8 @@ func (ec *executionContext) fieldContext_HeadcountStats_organizationCode(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_organizationName(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_organizationName(ctx, field)
+func (ec *executionContext) _CostCenter_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7976,7 +9987,7 @@ func (ec *executionContext) _HeadcountStats_organizationName(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OrganizationName, nil
+		return obj.EffectiveDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7988,26 +9999,26 @@ func (ec *executionContext) _HeadcountStats_organizationName(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(dto.Date)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_organizationName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_totalCapacity(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_totalCapacity(ctx, field)
+func (ec *executionContext) _CostCenter_endDate(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_endDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8020,38 +10031,35 @@ func (ec *executionContext) _HeadcountStats_totalCapacity(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCapacity, nil
+		return obj.EndDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*dto.Date)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_totalCapacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_totalFilled(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_totalFilled(ctx, field)
+func (ec *executionContext) _CostCenter_isCurrent(ctx context.Context, field graphql.CollectedField, obj *model.CostCenter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenter_isCurrent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8064,7 +10072,7 @@ func (ec *executionContext) _HeadcountStats_totalFilled(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalFilled, nil
+		return obj.IsCurrent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8076,26 +10084,26 @@ func (ec *executionContext) _HeadcountStats_totalFilled(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_totalFilled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenter_isCurrent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_totalAvailable(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_totalAvailable(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_costCenterCode(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_costCenterCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8108,7 +10116,7 @@ func (ec *executionContext) _HeadcountStats_totalAvailable(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalAvailable, nil
+		return obj.CostCenterCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8120,26 +10128,26 @@ func (ec *executionContext) _HeadcountStats_totalAvailable(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_totalAvailable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_costCenterCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_fillRate(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_fillRate(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_costCenterName(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_costCenterName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8152,7 +10160,89 @@ func (ec *executionContext) _HeadcountStats_fillRate(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FillRate, nil
+		return obj.CostCenterName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CostCenterHeadcount_costCenterName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CostCenterHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CostCenterHeadcount_legalEntityCode(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_legalEntityCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LegalEntityCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CostCenterHeadcount_legalEntityCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CostCenterHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CostCenterHeadcount_positionCount(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_positionCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PositionCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8164,26 +10254,26 @@ func (ec *executionContext) _HeadcountStats_fillRate(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_fillRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_positionCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_byLevel(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_byLevel(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_headcountCapacity(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_headcountCapacity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8196,7 +10286,7 @@ func (ec *executionContext) _HeadcountStats_byLevel(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ByLevel, nil
+		return obj.HeadcountCapacity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8208,36 +10298,26 @@ func (ec *executionContext) _HeadcountStats_byLevel(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.LevelHeadcount)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNLevelHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelHeadcountᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_byLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_headcountCapacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "jobLevelCode":
-				return ec.fieldContext_LevelHeadcount_jobLevelCode(ctx, field)
-			case "capacity":
-				return ec.fieldContext_LevelHeadcount_capacity(ctx, field)
-			case "utilized":
-				return ec.fieldContext_LevelHeadcount_utilized(ctx, field)
-			case "available":
-				return ec.fieldContext_LevelHeadcount_available(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LevelHeadcount", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_byType(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_byType(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_headcountInUse(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_headcountInUse(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8250,7 +10330,7 @@ func (ec *executionContext) _HeadcountStats_byType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ByType, nil
+		return obj.HeadcountInUse, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8262,36 +10342,26 @@ func (ec *executionContext) _HeadcountStats_byType(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.TypeHeadcount)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNTypeHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐTypeHeadcountᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_byType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_headcountInUse(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "positionType":
-				return ec.fieldContext_TypeHeadcount_positionType(ctx, field)
-			case "capacity":
-				return ec.fieldContext_TypeHeadcount_capacity(ctx, field)
-			case "filled":
-				return ec.fieldContext_TypeHeadcount_filled(ctx, field)
-			case "available":
-				return ec.fieldContext_TypeHeadcount_available(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeHeadcount", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeadcountStats_byFamily(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeadcountStats_byFamily(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_assignedFte(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_assignedFte(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8304,7 +10374,7 @@ func (ec *executionContext) _HeadcountStats_byFamily(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ByFamily, nil
+		return obj.AssignedFte, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8316,38 +10386,26 @@ func (ec *executionContext) _HeadcountStats_byFamily(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.FamilyHeadcount)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNFamilyHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐFamilyHeadcountᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeadcountStats_byFamily(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_assignedFte(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeadcountStats",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "jobFamilyCode":
-				return ec.fieldContext_FamilyHeadcount_jobFamilyCode(ctx, field)
-			case "jobFamilyName":
-				return ec.fieldContext_FamilyHeadcount_jobFamilyName(ctx, field)
-			case "capacity":
-				return ec.fieldContext_FamilyHeadcount_capacity(ctx, field)
-			case "utilized":
-				return ec.fieldContext_FamilyHeadcount_utilized(ctx, field)
-			case "available":
-				return ec.fieldContext_FamilyHeadcount_available(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FamilyHeadcount", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_checkId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_checkId(ctx, field)
+func (ec *executionContext) _CostCenterHeadcount_availableHeadcount(ctx context.Context, field graphql.CollectedField, obj *model.CostCenterHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CostCenterHeadcount_availableHeadcount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8360,7 +10418,7 @@ func (ec *executionContext) _HierarchyConsistencyReport_checkId(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CheckID, nil
+		return obj.AvailableHeadcount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8372,26 +10430,26 @@ func (ec *executionContext) _HierarchyConsistencyReport_checkId(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_checkId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CostCenterHeadcount_availableHeadcount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CostCenterHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_tenantId(ctx, field)
+func (ec *executionContext) _CustomFieldValue_key(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8404,7 +10462,7 @@ func (ec *executionContext) _HierarchyConsistencyReport_tenantId(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TenantID, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8421,9 +10479,9 @@ func (ec *executionContext) _HierarchyConsistencyReport_tenantId(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8434,8 +10492,8 @@ func (ec *executionContext) fieldContext_HierarchyConsistencyReport_tenantId(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_executedAt(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_executedAt(ctx, field)
+func (ec *executionContext) _CustomFieldValue_type(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8448,7 +10506,7 @@ func (ec *executionContext) _HierarchyConsistencyReport_executedAt(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExecutedAt, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8460,26 +10518,26 @@ func (ec *executionContext) _HierarchyConsistencyReport_executedAt(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.DateTime)
+	res := resTmp.(model.CustomFieldType)
 	fc.Result = res
-	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
+	return ec.marshalNCustomFieldType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_executedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type CustomFieldType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_executionTimeMs(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_executionTimeMs(ctx, field)
+func (ec *executionContext) _CustomFieldValue_stringValue(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_stringValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8492,38 +10550,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_executionTimeMs(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExecutionTimeMs, nil
+		return obj.StringValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_executionTimeMs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_stringValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_totalChecked(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_totalChecked(ctx, field)
+func (ec *executionContext) _CustomFieldValue_numberValue(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_numberValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8536,38 +10591,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_totalChecked(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalChecked, nil
+		return obj.NumberValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_totalChecked(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_numberValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_issuesFound(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_issuesFound(ctx, field)
+func (ec *executionContext) _CustomFieldValue_booleanValue(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_booleanValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8580,38 +10632,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_issuesFound(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IssuesFound, nil
+		return obj.BooleanValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_issuesFound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_booleanValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_checkMode(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_checkMode(ctx, field)
+func (ec *executionContext) _CustomFieldValue_dateValue(ctx context.Context, field graphql.CollectedField, obj *model.CustomFieldValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CustomFieldValue_dateValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8624,38 +10673,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_checkMode(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CheckMode, nil
+		return obj.DateValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ConsistencyCheckMode)
+	res := resTmp.(*dto.Date)
 	fc.Result = res
-	return ec.marshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_checkMode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CustomFieldValue_dateValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "CustomFieldValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ConsistencyCheckMode does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_consistencyReport(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_consistencyReport(ctx, field)
+func (ec *executionContext) _DataChanges_beforeData(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DataChanges_beforeData(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8668,52 +10714,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_consistencyReport(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConsistencyReport, nil
+		return obj.BeforeData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ConsistencyFindings)
+	res := resTmp.(dto.JSON)
 	fc.Result = res
-	return ec.marshalNConsistencyFindings2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyFindings(ctx, field.Selections, res)
+	return ec.marshalOJSON2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJSON(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_consistencyReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataChanges_beforeData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "DataChanges",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "pathMismatches":
-				return ec.fieldContext_ConsistencyFindings_pathMismatches(ctx, field)
-			case "levelInconsistencies":
-				return ec.fieldContext_ConsistencyFindings_levelInconsistencies(ctx, field)
-			case "orphanedNodes":
-				return ec.fieldContext_ConsistencyFindings_orphanedNodes(ctx, field)
-			case "circularReferences":
-				return ec.fieldContext_ConsistencyFindings_circularReferences(ctx, field)
-			case "depthViolations":
-				return ec.fieldContext_ConsistencyFindings_depthViolations(ctx, field)
-			case "cacheInconsistencies":
-				return ec.fieldContext_ConsistencyFindings_cacheInconsistencies(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ConsistencyFindings", field.Name)
+			return nil, errors.New("field of type JSON does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_repairSuggestions(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_repairSuggestions(ctx, field)
+func (ec *executionContext) _DataChanges_afterData(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DataChanges_afterData(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8726,50 +10755,35 @@ func (ec *executionContext) _HierarchyConsistencyReport_repairSuggestions(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RepairSuggestions, nil
+		return obj.AfterData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.RepairSuggestion)
+	res := resTmp.(dto.JSON)
 	fc.Result = res
-	return ec.marshalNRepairSuggestion2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐRepairSuggestionᚄ(ctx, field.Selections, res)
+	return ec.marshalOJSON2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJSON(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_repairSuggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataChanges_afterData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "DataChanges",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "issueType":
-				return ec.fieldContext_RepairSuggestion_issueType(ctx, field)
-			case "affectedCodes":
-				return ec.fieldContext_RepairSuggestion_affectedCodes(ctx, field)
-			case "suggestedAction":
-				return ec.fieldContext_RepairSuggestion_suggestedAction(ctx, field)
-			case "automatable":
-				return ec.fieldContext_RepairSuggestion_automatable(ctx, field)
-			case "riskLevel":
-				return ec.fieldContext_RepairSuggestion_riskLevel(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RepairSuggestion", field.Name)
+			return nil, errors.New("field of type JSON does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_healthScore(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_healthScore(ctx, field)
+func (ec *executionContext) _DataChanges_modifiedFields(ctx context.Context, field graphql.CollectedField, obj *model.DataChanges) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DataChanges_modifiedFields(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8782,7 +10796,7 @@ func (ec *executionContext) _HierarchyConsistencyReport_healthScore(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HealthScore, nil
+		return obj.ModifiedFields, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8794,26 +10808,26 @@ func (ec *executionContext) _HierarchyConsistencyReport_healthScore(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_healthScore(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataChanges_modifiedFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "DataChanges",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyConsistencyReport_recommendedActions(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyConsistencyReport_recommendedActions(ctx, field)
+func (ec *executionContext) _DateRange_earliest(ctx context.Context, field graphql.CollectedField, obj *model.DateRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateRange_earliest(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8826,7 +10840,7 @@ func (ec *executionContext) _HierarchyConsistencyReport_recommendedActions(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecommendedActions, nil
+		return obj.Earliest, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8838,26 +10852,26 @@ func (ec *executionContext) _HierarchyConsistencyReport_recommendedActions(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(dto.DateTime)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyConsistencyReport_recommendedActions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateRange_earliest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyConsistencyReport",
+		Object:     "DateRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_tenantId(ctx, field)
+func (ec *executionContext) _DateRange_latest(ctx context.Context, field graphql.CollectedField, obj *model.DateRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateRange_latest(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8870,7 +10884,7 @@ func (ec *executionContext) _HierarchyStatistics_tenantId(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TenantID, nil
+		return obj.Latest, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8882,26 +10896,26 @@ func (ec *executionContext) _HierarchyStatistics_tenantId(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(dto.DateTime)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateRange_latest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DateRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_totalOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_totalOrganizations(ctx, field)
+func (ec *executionContext) _DepthDistribution_depth(ctx context.Context, field graphql.CollectedField, obj *model.DepthDistribution) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthDistribution_depth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8914,7 +10928,7 @@ func (ec *executionContext) _HierarchyStatistics_totalOrganizations(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalOrganizations, nil
+		return obj.Depth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8931,9 +10945,9 @@ func (ec *executionContext) _HierarchyStatistics_totalOrganizations(ctx context.
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_totalOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthDistribution_depth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthDistribution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8944,8 +10958,8 @@ func (ec *executionContext) fieldContext_HierarchyStatistics_totalOrganizations(
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_maxDepth(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_maxDepth(ctx, field)
+func (ec *executionContext) _DepthDistribution_count(ctx context.Context, field graphql.CollectedField, obj *model.DepthDistribution) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthDistribution_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8958,7 +10972,7 @@ func (ec *executionContext) _HierarchyStatistics_maxDepth(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxDepth, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8975,9 +10989,9 @@ func (ec *executionContext) _HierarchyStatistics_maxDepth(ctx context.Context, f
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_maxDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthDistribution_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthDistribution",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8988,8 +11002,8 @@ func (ec *executionContext) fieldContext_HierarchyStatistics_maxDepth(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_avgDepth(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_avgDepth(ctx, field)
+func (ec *executionContext) _DepthViolation_code(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthViolation_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9002,7 +11016,7 @@ func (ec *executionContext) _HierarchyStatistics_avgDepth(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvgDepth, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9014,26 +11028,26 @@ func (ec *executionContext) _HierarchyStatistics_avgDepth(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_avgDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthViolation_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_depthDistribution(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_depthDistribution(ctx, field)
+func (ec *executionContext) _DepthViolation_currentDepth(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthViolation_currentDepth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9046,7 +11060,7 @@ func (ec *executionContext) _HierarchyStatistics_depthDistribution(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DepthDistribution, nil
+		return obj.CurrentDepth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9058,32 +11072,26 @@ func (ec *executionContext) _HierarchyStatistics_depthDistribution(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.DepthDistribution)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNDepthDistribution2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐDepthDistributionᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_depthDistribution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthViolation_currentDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "depth":
-				return ec.fieldContext_DepthDistribution_depth(ctx, field)
-			case "count":
-				return ec.fieldContext_DepthDistribution_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DepthDistribution", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_rootOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_rootOrganizations(ctx, field)
+func (ec *executionContext) _DepthViolation_maxAllowedDepth(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthViolation_maxAllowedDepth(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9096,7 +11104,7 @@ func (ec *executionContext) _HierarchyStatistics_rootOrganizations(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RootOrganizations, nil
+		return obj.MaxAllowedDepth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9113,9 +11121,9 @@ func (ec *executionContext) _HierarchyStatistics_rootOrganizations(ctx context.C
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_rootOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthViolation_maxAllowedDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9126,8 +11134,8 @@ func (ec *executionContext) fieldContext_HierarchyStatistics_rootOrganizations(c
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_leafOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_leafOrganizations(ctx, field)
+func (ec *executionContext) _DepthViolation_parentChain(ctx context.Context, field graphql.CollectedField, obj *model.DepthViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DepthViolation_parentChain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9140,7 +11148,7 @@ func (ec *executionContext) _HierarchyStatistics_leafOrganizations(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LeafOrganizations, nil
+		return obj.ParentChain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9152,26 +11160,26 @@ func (ec *executionContext) _HierarchyStatistics_leafOrganizations(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_leafOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepthViolation_parentChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "DepthViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_integrityIssues(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_integrityIssues(ctx, field)
+func (ec *executionContext) _EffectiveLocation_location(ctx context.Context, field graphql.CollectedField, obj *model.EffectiveLocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffectiveLocation_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9184,7 +11192,7 @@ func (ec *executionContext) _HierarchyStatistics_integrityIssues(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IntegrityIssues, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9196,34 +11204,52 @@ func (ec *executionContext) _HierarchyStatistics_integrityIssues(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.IntegrityIssue)
+	res := resTmp.(*model.Location)
 	fc.Result = res
-	return ec.marshalNIntegrityIssue2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐIntegrityIssueᚄ(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_integrityIssues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EffectiveLocation_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "EffectiveLocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "code":
+				return ec.fieldContext_Location_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_Location_recordId(ctx, field)
+			case "name":
+				return ec.fieldContext_Location_name(ctx, field)
 			case "type":
-				return ec.fieldContext_IntegrityIssue_type(ctx, field)
-			case "count":
-				return ec.fieldContext_IntegrityIssue_count(ctx, field)
-			case "affectedCodes":
-				return ec.fieldContext_IntegrityIssue_affectedCodes(ctx, field)
+				return ec.fieldContext_Location_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Location_status(ctx, field)
+			case "parentCode":
+				return ec.fieldContext_Location_parentCode(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_Location_countryCode(ctx, field)
+			case "timeZone":
+				return ec.fieldContext_Location_timeZone(ctx, field)
+			case "address":
+				return ec.fieldContext_Location_address(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_Location_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_Location_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_Location_isCurrent(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type IntegrityIssue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HierarchyStatistics_lastAnalyzed(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HierarchyStatistics_lastAnalyzed(ctx, field)
+func (ec *executionContext) _EffectiveLocation_source(ctx context.Context, field graphql.CollectedField, obj *model.EffectiveLocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffectiveLocation_source(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9236,7 +11262,7 @@ func (ec *executionContext) _HierarchyStatistics_lastAnalyzed(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastAnalyzed, nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9248,26 +11274,26 @@ func (ec *executionContext) _HierarchyStatistics_lastAnalyzed(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.LocationSource)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLocationSource2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationSource(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HierarchyStatistics_lastAnalyzed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EffectiveLocation_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HierarchyStatistics",
+		Object:     "EffectiveLocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type LocationSource does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrityIssue_type(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrityIssue_type(ctx, field)
+func (ec *executionContext) _EffectiveLocation_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.EffectiveLocation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffectiveLocation_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9280,7 +11306,7 @@ func (ec *executionContext) _IntegrityIssue_type(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.EffectiveDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9292,26 +11318,26 @@ func (ec *executionContext) _IntegrityIssue_type(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(dto.Date)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrityIssue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EffectiveLocation_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrityIssue",
+		Object:     "EffectiveLocation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrityIssue_count(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrityIssue_count(ctx, field)
+func (ec *executionContext) _EmployeeAssignmentViolation_rule(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_rule(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9324,7 +11350,7 @@ func (ec *executionContext) _IntegrityIssue_count(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.Rule, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9336,26 +11362,26 @@ func (ec *executionContext) _IntegrityIssue_count(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrityIssue_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_rule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrityIssue",
+		Object:     "EmployeeAssignmentViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrityIssue_affectedCodes(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrityIssue_affectedCodes(ctx, field)
+func (ec *executionContext) _EmployeeAssignmentViolation_employeeId(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_employeeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9368,7 +11394,7 @@ func (ec *executionContext) _IntegrityIssue_affectedCodes(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AffectedCodes, nil
+		return obj.EmployeeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9380,26 +11406,26 @@ func (ec *executionContext) _IntegrityIssue_affectedCodes(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(dto.UUID)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrityIssue_affectedCodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_employeeId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrityIssue",
+		Object:     "EmployeeAssignmentViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_code(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_code(ctx, field)
+func (ec *executionContext) _EmployeeAssignmentViolation_employeeName(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_employeeName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9412,7 +11438,7 @@ func (ec *executionContext) _JobFamily_code(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.EmployeeName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9424,26 +11450,3460 @@ func (ec *executionContext) _JobFamily_code(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobFamilyCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_employeeName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "EmployeeAssignmentViolation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobFamilyCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_recordId(ctx, field)
+func (ec *executionContext) _EmployeeAssignmentViolation_organizationCode(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_organizationCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrganizationCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_organizationCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmployeeAssignmentViolation_totalFte(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_totalFte(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalFte, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_totalFte(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmployeeAssignmentViolation_maxFte(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_maxFte(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxFte, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_maxFte(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmployeeAssignmentViolation_assignmentIds(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_assignmentIds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AssignmentIds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_assignmentIds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmployeeAssignmentViolation_positionCodes(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_positionCodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PositionCodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_positionCodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmployeeAssignmentViolation_asOfDate(ctx context.Context, field graphql.CollectedField, obj *model.EmployeeAssignmentViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EmployeeAssignmentViolation_asOfDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AsOfDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.Date)
+	fc.Result = res
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EmployeeAssignmentViolation_asOfDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmployeeAssignmentViolation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FamilyHeadcount_jobFamilyCode(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FamilyHeadcount_jobFamilyCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.JobFamilyCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobFamilyCode)
+	fc.Result = res
+	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FamilyHeadcount_jobFamilyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FamilyHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobFamilyCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FamilyHeadcount_jobFamilyName(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FamilyHeadcount_jobFamilyName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.JobFamilyName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FamilyHeadcount_jobFamilyName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FamilyHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FamilyHeadcount_capacity(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FamilyHeadcount_capacity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Capacity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FamilyHeadcount_capacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FamilyHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FamilyHeadcount_utilized(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FamilyHeadcount_utilized(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Utilized, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FamilyHeadcount_utilized(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FamilyHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FamilyHeadcount_available(ctx context.Context, field graphql.CollectedField, obj *model.FamilyHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FamilyHeadcount_available(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Available, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FamilyHeadcount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FamilyHeadcount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FieldChange_field(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FieldChange_field(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Field, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FieldChange_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FieldChange_oldValue(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FieldChange_oldValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OldValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FieldChange_oldValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FieldChange_newValue(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FieldChange_newValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FieldChange_newValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FieldChange_dataType(ctx context.Context, field graphql.CollectedField, obj *model.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FieldChange_dataType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_FieldChange_dataType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FieldChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_organizationCode(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_organizationCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrganizationCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_organizationCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_organizationName(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_organizationName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrganizationName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_organizationName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_totalCapacity(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_totalCapacity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCapacity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_totalCapacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_totalFilled(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_totalFilled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalFilled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_totalFilled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_totalAvailable(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_totalAvailable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalAvailable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_totalAvailable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_fillRate(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_fillRate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FillRate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_fillRate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_byLevel(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_byLevel(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ByLevel, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LevelHeadcount)
+	fc.Result = res
+	return ec.marshalNLevelHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelHeadcountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_byLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "jobLevelCode":
+				return ec.fieldContext_LevelHeadcount_jobLevelCode(ctx, field)
+			case "capacity":
+				return ec.fieldContext_LevelHeadcount_capacity(ctx, field)
+			case "utilized":
+				return ec.fieldContext_LevelHeadcount_utilized(ctx, field)
+			case "available":
+				return ec.fieldContext_LevelHeadcount_available(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LevelHeadcount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_byType(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_byType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ByType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.TypeHeadcount)
+	fc.Result = res
+	return ec.marshalNTypeHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐTypeHeadcountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_byType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "positionType":
+				return ec.fieldContext_TypeHeadcount_positionType(ctx, field)
+			case "capacity":
+				return ec.fieldContext_TypeHeadcount_capacity(ctx, field)
+			case "filled":
+				return ec.fieldContext_TypeHeadcount_filled(ctx, field)
+			case "available":
+				return ec.fieldContext_TypeHeadcount_available(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeHeadcount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HeadcountStats_byFamily(ctx context.Context, field graphql.CollectedField, obj *model.HeadcountStats) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeadcountStats_byFamily(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ByFamily, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.FamilyHeadcount)
+	fc.Result = res
+	return ec.marshalNFamilyHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐFamilyHeadcountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HeadcountStats_byFamily(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HeadcountStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "jobFamilyCode":
+				return ec.fieldContext_FamilyHeadcount_jobFamilyCode(ctx, field)
+			case "jobFamilyName":
+				return ec.fieldContext_FamilyHeadcount_jobFamilyName(ctx, field)
+			case "capacity":
+				return ec.fieldContext_FamilyHeadcount_capacity(ctx, field)
+			case "utilized":
+				return ec.fieldContext_FamilyHeadcount_utilized(ctx, field)
+			case "available":
+				return ec.fieldContext_FamilyHeadcount_available(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FamilyHeadcount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_checkId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_checkId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CheckID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_checkId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_tenantId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TenantID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_executedAt(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_executedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExecutedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.DateTime)
+	fc.Result = res
+	return ec.marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_executedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_executionTimeMs(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_executionTimeMs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExecutionTimeMs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_executionTimeMs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_totalChecked(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_totalChecked(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalChecked, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_totalChecked(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_issuesFound(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_issuesFound(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IssuesFound, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_issuesFound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_checkMode(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_checkMode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CheckMode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ConsistencyCheckMode)
+	fc.Result = res
+	return ec.marshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_checkMode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ConsistencyCheckMode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_consistencyReport(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_consistencyReport(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConsistencyReport, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ConsistencyFindings)
+	fc.Result = res
+	return ec.marshalNConsistencyFindings2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyFindings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_consistencyReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pathMismatches":
+				return ec.fieldContext_ConsistencyFindings_pathMismatches(ctx, field)
+			case "levelInconsistencies":
+				return ec.fieldContext_ConsistencyFindings_levelInconsistencies(ctx, field)
+			case "orphanedNodes":
+				return ec.fieldContext_ConsistencyFindings_orphanedNodes(ctx, field)
+			case "circularReferences":
+				return ec.fieldContext_ConsistencyFindings_circularReferences(ctx, field)
+			case "depthViolations":
+				return ec.fieldContext_ConsistencyFindings_depthViolations(ctx, field)
+			case "cacheInconsistencies":
+				return ec.fieldContext_ConsistencyFindings_cacheInconsistencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConsistencyFindings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_repairSuggestions(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_repairSuggestions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RepairSuggestions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.RepairSuggestion)
+	fc.Result = res
+	return ec.marshalNRepairSuggestion2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐRepairSuggestionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_repairSuggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "issueType":
+				return ec.fieldContext_RepairSuggestion_issueType(ctx, field)
+			case "affectedCodes":
+				return ec.fieldContext_RepairSuggestion_affectedCodes(ctx, field)
+			case "suggestedAction":
+				return ec.fieldContext_RepairSuggestion_suggestedAction(ctx, field)
+			case "automatable":
+				return ec.fieldContext_RepairSuggestion_automatable(ctx, field)
+			case "riskLevel":
+				return ec.fieldContext_RepairSuggestion_riskLevel(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RepairSuggestion", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_healthScore(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_healthScore(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HealthScore, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_healthScore(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyConsistencyReport_recommendedActions(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyConsistencyReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyConsistencyReport_recommendedActions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecommendedActions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyConsistencyReport_recommendedActions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyConsistencyReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_tenantId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TenantID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_totalOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_totalOrganizations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalOrganizations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_totalOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_maxDepth(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_maxDepth(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxDepth, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_maxDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_avgDepth(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_avgDepth(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AvgDepth, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_avgDepth(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_depthDistribution(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_depthDistribution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DepthDistribution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.DepthDistribution)
+	fc.Result = res
+	return ec.marshalNDepthDistribution2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐDepthDistributionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_depthDistribution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "depth":
+				return ec.fieldContext_DepthDistribution_depth(ctx, field)
+			case "count":
+				return ec.fieldContext_DepthDistribution_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DepthDistribution", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_rootOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_rootOrganizations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RootOrganizations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_rootOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_leafOrganizations(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_leafOrganizations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LeafOrganizations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_leafOrganizations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_integrityIssues(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_integrityIssues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IntegrityIssues, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.IntegrityIssue)
+	fc.Result = res
+	return ec.marshalNIntegrityIssue2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐIntegrityIssueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_integrityIssues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_IntegrityIssue_type(ctx, field)
+			case "count":
+				return ec.fieldContext_IntegrityIssue_count(ctx, field)
+			case "affectedCodes":
+				return ec.fieldContext_IntegrityIssue_affectedCodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IntegrityIssue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HierarchyStatistics_lastAnalyzed(ctx context.Context, field graphql.CollectedField, obj *model.HierarchyStatistics) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HierarchyStatistics_lastAnalyzed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastAnalyzed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_HierarchyStatistics_lastAnalyzed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HierarchyStatistics",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IntegrityIssue_type(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrityIssue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_IntegrityIssue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IntegrityIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IntegrityIssue_count(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrityIssue_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_IntegrityIssue_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IntegrityIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _IntegrityIssue_affectedCodes(ctx context.Context, field graphql.CollectedField, obj *model.IntegrityIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrityIssue_affectedCodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AffectedCodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_IntegrityIssue_affectedCodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "IntegrityIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_code(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobFamilyCode)
+	fc.Result = res
+	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobFamilyCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_recordId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecordID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_groupCode(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_groupCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GroupCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobFamilyGroupCode)
+	fc.Result = res
+	return ec.marshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_groupCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobFamilyGroupCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_name(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_nameI18n(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_nameI18n(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NameI18n, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocalizedText)
+	fc.Result = res
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_nameI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_status(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.JobCatalogStatus)
+	fc.Result = res
+	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_effectiveDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EffectiveDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.Date)
+	fc.Result = res
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_endDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*dto.Date)
+	fc.Result = res
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamily_description(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamily_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamily_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamily",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_code(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobFamilyGroupCode)
+	fc.Result = res
+	return ec.marshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobFamilyGroupCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_recordId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecordID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_nameI18n(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_nameI18n(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NameI18n, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocalizedText)
+	fc.Result = res
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_nameI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_status(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.JobCatalogStatus)
+	fc.Result = res
+	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_effectiveDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EffectiveDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.Date)
+	fc.Result = res
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_endDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*dto.Date)
+	fc.Result = res
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobFamilyGroup_description(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobFamilyGroup_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobFamilyGroup_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobFamilyGroup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_code(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobLevelCode)
+	fc.Result = res
+	return ec.marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobLevelCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_recordId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecordID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.UUID)
+	fc.Result = res
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_roleCode(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_roleCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RoleCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobRoleCode)
+	fc.Result = res
+	return ec.marshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_roleCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobRoleCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_name(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_nameI18n(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_nameI18n(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NameI18n, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocalizedText)
+	fc.Result = res
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_nameI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_status(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.JobCatalogStatus)
+	fc.Result = res
+	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_effectiveDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EffectiveDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.Date)
+	fc.Result = res
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_endDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*dto.Date)
+	fc.Result = res
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_levelRank(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_levelRank(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LevelRank, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_levelRank(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_description(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobLevel_compensationGrades(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobLevel_compensationGrades(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.JobLevel().CompensationGrades(rctx, obj, fc.Args["asOfDate"].(*dto.Date), fc.Args["includeInactive"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.CompensationGrade)
+	fc.Result = res
+	return ec.marshalOCompensationGrade2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobLevel_compensationGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobLevel",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_CompensationGrade_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_CompensationGrade_recordId(ctx, field)
+			case "name":
+				return ec.fieldContext_CompensationGrade_name(ctx, field)
+			case "description":
+				return ec.fieldContext_CompensationGrade_description(ctx, field)
+			case "status":
+				return ec.fieldContext_CompensationGrade_status(ctx, field)
+			case "jobLevelCodes":
+				return ec.fieldContext_CompensationGrade_jobLevelCodes(ctx, field)
+			case "payRanges":
+				return ec.fieldContext_CompensationGrade_payRanges(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_CompensationGrade_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_CompensationGrade_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_CompensationGrade_isCurrent(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CompensationGrade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_JobLevel_compensationGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobRole_code(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(dto.JobRoleCode)
+	fc.Result = res
+	return ec.marshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JobRole_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JobRole",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JobRoleCode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JobRole_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_recordId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9473,9 +14933,9 @@ func (ec *executionContext) _JobFamily_recordId(ctx context.Context, field graph
 	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9486,8 +14946,8 @@ func (ec *executionContext) fieldContext_JobFamily_recordId(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_groupCode(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_groupCode(ctx, field)
+func (ec *executionContext) _JobRole_familyCode(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_familyCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9500,7 +14960,7 @@ func (ec *executionContext) _JobFamily_groupCode(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GroupCode, nil
+		return obj.FamilyCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9512,26 +14972,26 @@ func (ec *executionContext) _JobFamily_groupCode(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobFamilyGroupCode)
+	res := resTmp.(dto.JobFamilyCode)
 	fc.Result = res
-	return ec.marshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx, field.Selections, res)
+	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_groupCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_familyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobFamilyGroupCode does not have child fields")
+			return nil, errors.New("field of type JobFamilyCode does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_name(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_name(ctx, field)
+func (ec *executionContext) _JobRole_name(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9561,9 +15021,9 @@ func (ec *executionContext) _JobFamily_name(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9574,8 +15034,8 @@ func (ec *executionContext) fieldContext_JobFamily_name(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_status(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_status(ctx, field)
+func (ec *executionContext) _JobRole_nameI18n(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_nameI18n(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9588,7 +15048,7 @@ func (ec *executionContext) _JobFamily_status(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.NameI18n, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9600,26 +15060,32 @@ func (ec *executionContext) _JobFamily_status(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.JobCatalogStatus)
+	res := resTmp.([]model.LocalizedText)
 	fc.Result = res
-	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_nameI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_effectiveDate(ctx, field)
+func (ec *executionContext) _JobRole_status(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9632,7 +15098,7 @@ func (ec *executionContext) _JobFamily_effectiveDate(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EffectiveDate, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9644,26 +15110,26 @@ func (ec *executionContext) _JobFamily_effectiveDate(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.Date)
+	res := resTmp.(model.JobCatalogStatus)
 	fc.Result = res
-	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_endDate(ctx, field)
+func (ec *executionContext) _JobRole_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9676,23 +15142,26 @@ func (ec *executionContext) _JobFamily_endDate(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndDate, nil
+		return obj.EffectiveDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*dto.Date)
+	res := resTmp.(dto.Date)
 	fc.Result = res
-	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9703,8 +15172,8 @@ func (ec *executionContext) fieldContext_JobFamily_endDate(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamily_description(ctx context.Context, field graphql.CollectedField, obj *model.JobFamily) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamily_description(ctx, field)
+func (ec *executionContext) _JobRole_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_endDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9717,7 +15186,7 @@ func (ec *executionContext) _JobFamily_description(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.EndDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9726,26 +15195,26 @@ func (ec *executionContext) _JobFamily_description(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*dto.Date)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamily_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamily",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamilyGroup_code(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_code(ctx, field)
+func (ec *executionContext) _JobRole_description(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JobRole_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9758,38 +15227,35 @@ func (ec *executionContext) _JobFamilyGroup_code(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobFamilyGroupCode)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamilyGroup_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JobRole_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
+		Object:     "JobRole",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobFamilyGroupCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamilyGroup_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_recordId(ctx, field)
+func (ec *executionContext) _LevelHeadcount_jobLevelCode(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelHeadcount_jobLevelCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9802,7 +15268,7 @@ func (ec *executionContext) _JobFamilyGroup_recordId(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecordID, nil
+		return obj.JobLevelCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9814,26 +15280,26 @@ func (ec *executionContext) _JobFamilyGroup_recordId(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.UUID)
+	res := resTmp.(dto.JobLevelCode)
 	fc.Result = res
-	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamilyGroup_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelHeadcount_jobLevelCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
+		Object:     "LevelHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type JobLevelCode does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamilyGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_name(ctx, field)
+func (ec *executionContext) _LevelHeadcount_capacity(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelHeadcount_capacity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9846,7 +15312,7 @@ func (ec *executionContext) _JobFamilyGroup_name(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Capacity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9858,26 +15324,26 @@ func (ec *executionContext) _JobFamilyGroup_name(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamilyGroup_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelHeadcount_capacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
+		Object:     "LevelHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamilyGroup_status(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_status(ctx, field)
+func (ec *executionContext) _LevelHeadcount_utilized(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelHeadcount_utilized(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9890,7 +15356,7 @@ func (ec *executionContext) _JobFamilyGroup_status(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Utilized, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9902,26 +15368,26 @@ func (ec *executionContext) _JobFamilyGroup_status(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.JobCatalogStatus)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamilyGroup_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelHeadcount_utilized(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
+		Object:     "LevelHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobFamilyGroup_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_effectiveDate(ctx, field)
+func (ec *executionContext) _LevelHeadcount_available(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelHeadcount_available(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9934,7 +15400,7 @@ func (ec *executionContext) _JobFamilyGroup_effectiveDate(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EffectiveDate, nil
+		return obj.Available, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9946,108 +15412,26 @@ func (ec *executionContext) _JobFamilyGroup_effectiveDate(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.Date)
-	fc.Result = res
-	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_JobFamilyGroup_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _JobFamilyGroup_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_endDate(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EndDate, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*dto.Date)
-	fc.Result = res
-	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_JobFamilyGroup_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _JobFamilyGroup_description(ctx context.Context, field graphql.CollectedField, obj *model.JobFamilyGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobFamilyGroup_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobFamilyGroup_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelHeadcount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobFamilyGroup",
+		Object:     "LevelHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_code(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_code(ctx, field)
+func (ec *executionContext) _LevelInconsistency_code(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelInconsistency_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10072,26 +15456,26 @@ func (ec *executionContext) _JobLevel_code(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobLevelCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelInconsistency_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelInconsistency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobLevelCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_recordId(ctx, field)
+func (ec *executionContext) _LevelInconsistency_expectedLevel(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelInconsistency_expectedLevel(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10104,7 +15488,7 @@ func (ec *executionContext) _JobLevel_recordId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecordID, nil
+		return obj.ExpectedLevel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10116,26 +15500,26 @@ func (ec *executionContext) _JobLevel_recordId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.UUID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelInconsistency_expectedLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelInconsistency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_roleCode(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_roleCode(ctx, field)
+func (ec *executionContext) _LevelInconsistency_actualLevel(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelInconsistency_actualLevel(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10148,7 +15532,7 @@ func (ec *executionContext) _JobLevel_roleCode(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RoleCode, nil
+		return obj.ActualLevel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10160,26 +15544,26 @@ func (ec *executionContext) _JobLevel_roleCode(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobRoleCode)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_roleCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelInconsistency_actualLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelInconsistency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobRoleCode does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_name(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_name(ctx, field)
+func (ec *executionContext) _LevelInconsistency_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelInconsistency_parentCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10192,7 +15576,7 @@ func (ec *executionContext) _JobLevel_name(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ParentCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10209,9 +15593,9 @@ func (ec *executionContext) _JobLevel_name(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelInconsistency_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelInconsistency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10222,8 +15606,8 @@ func (ec *executionContext) fieldContext_JobLevel_name(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_status(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_status(ctx, field)
+func (ec *executionContext) _LevelInconsistency_reason(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelInconsistency_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10236,7 +15620,7 @@ func (ec *executionContext) _JobLevel_status(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10248,26 +15632,26 @@ func (ec *executionContext) _JobLevel_status(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.JobCatalogStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelInconsistency_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelInconsistency",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_effectiveDate(ctx, field)
+func (ec *executionContext) _LevelStatistic_level(ctx context.Context, field graphql.CollectedField, obj *model.LevelStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelStatistic_level(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10280,7 +15664,7 @@ func (ec *executionContext) _JobLevel_effectiveDate(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EffectiveDate, nil
+		return obj.Level, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10292,26 +15676,26 @@ func (ec *executionContext) _JobLevel_effectiveDate(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.Date)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelStatistic_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_endDate(ctx, field)
+func (ec *executionContext) _LevelStatistic_count(ctx context.Context, field graphql.CollectedField, obj *model.LevelStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LevelStatistic_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10324,35 +15708,38 @@ func (ec *executionContext) _JobLevel_endDate(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndDate, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*dto.Date)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LevelStatistic_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LevelStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_levelRank(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_levelRank(ctx, field)
+func (ec *executionContext) _LocalizedText_locale(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedText) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocalizedText_locale(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10365,7 +15752,7 @@ func (ec *executionContext) _JobLevel_levelRank(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LevelRank, nil
+		return obj.Locale, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10377,26 +15764,26 @@ func (ec *executionContext) _JobLevel_levelRank(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_levelRank(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocalizedText_locale(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LocalizedText",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobLevel_description(ctx context.Context, field graphql.CollectedField, obj *model.JobLevel) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobLevel_description(ctx, field)
+func (ec *executionContext) _LocalizedText_value(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedText) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocalizedText_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10409,23 +15796,26 @@ func (ec *executionContext) _JobLevel_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobLevel_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocalizedText_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobLevel",
+		Object:     "LocalizedText",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10436,8 +15826,8 @@ func (ec *executionContext) fieldContext_JobLevel_description(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_code(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_code(ctx, field)
+func (ec *executionContext) _Location_code(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10462,26 +15852,26 @@ func (ec *executionContext) _JobRole_code(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobRoleCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobRoleCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_recordId(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_recordId(ctx, field)
+func (ec *executionContext) _Location_recordId(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_recordId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10511,9 +15901,9 @@ func (ec *executionContext) _JobRole_recordId(ctx context.Context, field graphql
 	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10524,8 +15914,8 @@ func (ec *executionContext) fieldContext_JobRole_recordId(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_familyCode(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_familyCode(ctx, field)
+func (ec *executionContext) _Location_name(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10538,7 +15928,7 @@ func (ec *executionContext) _JobRole_familyCode(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FamilyCode, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10550,26 +15940,26 @@ func (ec *executionContext) _JobRole_familyCode(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.JobFamilyCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_familyCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobFamilyCode does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_name(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_name(ctx, field)
+func (ec *executionContext) _Location_type(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10582,7 +15972,7 @@ func (ec *executionContext) _JobRole_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10594,26 +15984,26 @@ func (ec *executionContext) _JobRole_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.LocationType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLocationType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type LocationType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_status(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_status(ctx, field)
+func (ec *executionContext) _Location_status(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10638,26 +16028,202 @@ func (ec *executionContext) _JobRole_status(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.JobCatalogStatus)
+	res := resTmp.(model.LocationStatus)
 	fc.Result = res
-	return ec.marshalNJobCatalogStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobCatalogStatus(ctx, field.Selections, res)
+	return ec.marshalNLocationStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobCatalogStatus does not have child fields")
+			return nil, errors.New("field of type LocationStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_effectiveDate(ctx, field)
+func (ec *executionContext) _Location_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_parentCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Location_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_countryCode(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_countryCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CountryCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Location_countryCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_timeZone(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_timeZone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeZone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Location_timeZone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_address(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_address(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Address)
+	fc.Result = res
+	return ec.marshalOAddress2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAddress(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Location_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "stateProvince":
+				return ec.fieldContext_Address_stateProvince(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_Address_postalCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_effectiveDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10687,9 +16253,9 @@ func (ec *executionContext) _JobRole_effectiveDate(ctx context.Context, field gr
 	return ec.marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_effectiveDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10700,8 +16266,8 @@ func (ec *executionContext) fieldContext_JobRole_effectiveDate(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_endDate(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_endDate(ctx, field)
+func (ec *executionContext) _Location_endDate(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_endDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10728,9 +16294,9 @@ func (ec *executionContext) _JobRole_endDate(ctx context.Context, field graphql.
 	return ec.marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JobRole_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_endDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JobRole",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10741,93 +16307,8 @@ func (ec *executionContext) fieldContext_JobRole_endDate(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _JobRole_description(ctx context.Context, field graphql.CollectedField, obj *model.JobRole) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JobRole_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_JobRole_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "JobRole",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LevelHeadcount_jobLevelCode(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelHeadcount_jobLevelCode(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.JobLevelCode, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(dto.JobLevelCode)
-	fc.Result = res
-	return ec.marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LevelHeadcount_jobLevelCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LevelHeadcount",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JobLevelCode does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LevelHeadcount_capacity(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelHeadcount_capacity(ctx, field)
+func (ec *executionContext) _Location_isCurrent(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_isCurrent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10840,7 +16321,7 @@ func (ec *executionContext) _LevelHeadcount_capacity(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Capacity, nil
+		return obj.IsCurrent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10852,26 +16333,26 @@ func (ec *executionContext) _LevelHeadcount_capacity(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelHeadcount_capacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_isCurrent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelHeadcount",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelHeadcount_utilized(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelHeadcount_utilized(ctx, field)
+func (ec *executionContext) _LocationHeadcount_locationCode(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_locationCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10884,7 +16365,7 @@ func (ec *executionContext) _LevelHeadcount_utilized(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Utilized, nil
+		return obj.LocationCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10896,26 +16377,26 @@ func (ec *executionContext) _LevelHeadcount_utilized(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelHeadcount_utilized(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_locationCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelHeadcount",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelHeadcount_available(ctx context.Context, field graphql.CollectedField, obj *model.LevelHeadcount) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelHeadcount_available(ctx, field)
+func (ec *executionContext) _LocationHeadcount_locationName(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_locationName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10928,38 +16409,35 @@ func (ec *executionContext) _LevelHeadcount_available(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Available, nil
+		return obj.LocationName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelHeadcount_available(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_locationName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelHeadcount",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelInconsistency_code(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelInconsistency_code(ctx, field)
+func (ec *executionContext) _LocationHeadcount_countryCode(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_countryCode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10972,26 +16450,23 @@ func (ec *executionContext) _LevelInconsistency_code(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.CountryCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelInconsistency_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_countryCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelInconsistency",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11002,8 +16477,8 @@ func (ec *executionContext) fieldContext_LevelInconsistency_code(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelInconsistency_expectedLevel(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelInconsistency_expectedLevel(ctx, field)
+func (ec *executionContext) _LocationHeadcount_timeZone(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_timeZone(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11016,38 +16491,35 @@ func (ec *executionContext) _LevelInconsistency_expectedLevel(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpectedLevel, nil
+		return obj.TimeZone, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelInconsistency_expectedLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_timeZone(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelInconsistency",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelInconsistency_actualLevel(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelInconsistency_actualLevel(ctx, field)
+func (ec *executionContext) _LocationHeadcount_positionCount(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_positionCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11060,7 +16532,7 @@ func (ec *executionContext) _LevelInconsistency_actualLevel(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActualLevel, nil
+		return obj.PositionCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11077,9 +16549,9 @@ func (ec *executionContext) _LevelInconsistency_actualLevel(ctx context.Context,
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelInconsistency_actualLevel(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_positionCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelInconsistency",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11090,8 +16562,8 @@ func (ec *executionContext) fieldContext_LevelInconsistency_actualLevel(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelInconsistency_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelInconsistency_parentCode(ctx, field)
+func (ec *executionContext) _LocationHeadcount_headcountCapacity(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_headcountCapacity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11104,7 +16576,7 @@ func (ec *executionContext) _LevelInconsistency_parentCode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParentCode, nil
+		return obj.HeadcountCapacity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11116,26 +16588,26 @@ func (ec *executionContext) _LevelInconsistency_parentCode(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelInconsistency_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_headcountCapacity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelInconsistency",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelInconsistency_reason(ctx context.Context, field graphql.CollectedField, obj *model.LevelInconsistency) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelInconsistency_reason(ctx, field)
+func (ec *executionContext) _LocationHeadcount_headcountInUse(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_headcountInUse(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11148,7 +16620,7 @@ func (ec *executionContext) _LevelInconsistency_reason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
+		return obj.HeadcountInUse, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11160,26 +16632,26 @@ func (ec *executionContext) _LevelInconsistency_reason(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelInconsistency_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_headcountInUse(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelInconsistency",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelStatistic_level(ctx context.Context, field graphql.CollectedField, obj *model.LevelStatistic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelStatistic_level(ctx, field)
+func (ec *executionContext) _LocationHeadcount_assignedFte(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_assignedFte(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11192,7 +16664,7 @@ func (ec *executionContext) _LevelStatistic_level(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Level, nil
+		return obj.AssignedFte, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11204,26 +16676,26 @@ func (ec *executionContext) _LevelStatistic_level(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelStatistic_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_assignedFte(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelStatistic",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LevelStatistic_count(ctx context.Context, field graphql.CollectedField, obj *model.LevelStatistic) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LevelStatistic_count(ctx, field)
+func (ec *executionContext) _LocationHeadcount_availableHeadcount(ctx context.Context, field graphql.CollectedField, obj *model.LocationHeadcount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LocationHeadcount_availableHeadcount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11236,7 +16708,7 @@ func (ec *executionContext) _LevelStatistic_count(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.AvailableHeadcount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11248,19 +16720,19 @@ func (ec *executionContext) _LevelStatistic_count(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LevelStatistic_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LocationHeadcount_availableHeadcount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LevelStatistic",
+		Object:     "LocationHeadcount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
@@ -11776,9 +17248,9 @@ func (ec *executionContext) _Organization_unitType(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.UnitType)
+	res := resTmp.(dto.UnitTypeCode)
 	fc.Result = res
-	return ec.marshalNUnitType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx, field.Selections, res)
+	return ec.marshalNUnitType2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_Organization_unitType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -12011,6 +17483,106 @@ func (ec *executionContext) fieldContext_Organization_namePath(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Organization_nameI18n(ctx context.Context, field graphql.CollectedField, obj *model.Organization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Organization_nameI18n(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NameI18n, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocalizedText)
+	fc.Result = res
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Organization_nameI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Organization_namePathI18n(ctx context.Context, field graphql.CollectedField, obj *model.Organization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Organization_namePathI18n(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NamePathI18n, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocalizedText)
+	fc.Result = res
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Organization_namePathI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Organization_path(ctx context.Context, field graphql.CollectedField, obj *model.Organization) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Organization_path(ctx, field)
 	if err != nil {
@@ -12858,6 +18430,124 @@ func (ec *executionContext) fieldContext_Organization_suspensionReason(ctx conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Organization_customFields(ctx context.Context, field graphql.CollectedField, obj *model.Organization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Organization_customFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CustomFields, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.CustomFieldValue)
+	fc.Result = res
+	return ec.marshalNCustomFieldValue2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Organization_customFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_CustomFieldValue_key(ctx, field)
+			case "type":
+				return ec.fieldContext_CustomFieldValue_type(ctx, field)
+			case "stringValue":
+				return ec.fieldContext_CustomFieldValue_stringValue(ctx, field)
+			case "numberValue":
+				return ec.fieldContext_CustomFieldValue_numberValue(ctx, field)
+			case "booleanValue":
+				return ec.fieldContext_CustomFieldValue_booleanValue(ctx, field)
+			case "dateValue":
+				return ec.fieldContext_CustomFieldValue_dateValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CustomFieldValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Organization_location(ctx context.Context, field graphql.CollectedField, obj *model.Organization) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Organization_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Organization().Location(rctx, obj, fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffectiveLocation)
+	fc.Result = res
+	return ec.marshalOEffectiveLocation2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEffectiveLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Organization_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "location":
+				return ec.fieldContext_EffectiveLocation_location(ctx, field)
+			case "source":
+				return ec.fieldContext_EffectiveLocation_source(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_EffectiveLocation_effectiveDate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffectiveLocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Organization_location_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _OrganizationConnection_data(ctx context.Context, field graphql.CollectedField, obj *model.OrganizationConnection) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_OrganizationConnection_data(ctx, field)
 	if err != nil {
@@ -12917,6 +18607,10 @@ func (ec *executionContext) fieldContext_OrganizationConnection_data(ctx context
 				return ec.fieldContext_Organization_codePath(ctx, field)
 			case "namePath":
 				return ec.fieldContext_Organization_namePath(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_Organization_nameI18n(ctx, field)
+			case "namePathI18n":
+				return ec.fieldContext_Organization_namePathI18n(ctx, field)
 			case "path":
 				return ec.fieldContext_Organization_path(ctx, field)
 			case "description":
@@ -12957,6 +18651,10 @@ func (ec *executionContext) fieldContext_OrganizationConnection_data(ctx context
 				return ec.fieldContext_Organization_suspendedBy(ctx, field)
 			case "suspensionReason":
 				return ec.fieldContext_Organization_suspensionReason(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Organization_customFields(ctx, field)
+			case "location":
+				return ec.fieldContext_Organization_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Organization", field.Name)
 		},
@@ -14037,9 +19735,405 @@ func (ec *executionContext) _OrphanedNode_code(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OrphanedNode_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OrphanedNode_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrphanedNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrphanedNode_name(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OrphanedNode_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OrphanedNode_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrphanedNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrphanedNode_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OrphanedNode_parentCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OrphanedNode_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrphanedNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrphanedNode_reason(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OrphanedNode_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_OrphanedNode_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrphanedNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaginationInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaginationInfo_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaginationInfo_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaginationInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaginationInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaginationInfo_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaginationInfo_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaginationInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaginationInfo_pageSize(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaginationInfo_pageSize(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageSize, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaginationInfo_pageSize(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaginationInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaginationInfo_hasNext(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaginationInfo_hasNext(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNext, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaginationInfo_hasNext(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaginationInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaginationInfo_hasPrevious(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PaginationInfo_hasPrevious(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPrevious, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PaginationInfo_hasPrevious(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaginationInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PathMismatch_code(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_code(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PathMismatch_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrphanedNode",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14050,8 +20144,8 @@ func (ec *executionContext) fieldContext_OrphanedNode_code(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OrphanedNode_name(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OrphanedNode_name(ctx, field)
+func (ec *executionContext) _PathMismatch_expectedCodePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_expectedCodePath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14064,7 +20158,7 @@ func (ec *executionContext) _OrphanedNode_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ExpectedCodePath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14081,9 +20175,9 @@ func (ec *executionContext) _OrphanedNode_name(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OrphanedNode_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PathMismatch_expectedCodePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrphanedNode",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14094,8 +20188,8 @@ func (ec *executionContext) fieldContext_OrphanedNode_name(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OrphanedNode_parentCode(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OrphanedNode_parentCode(ctx, field)
+func (ec *executionContext) _PathMismatch_actualCodePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_actualCodePath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14108,7 +20202,7 @@ func (ec *executionContext) _OrphanedNode_parentCode(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParentCode, nil
+		return obj.ActualCodePath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14125,9 +20219,9 @@ func (ec *executionContext) _OrphanedNode_parentCode(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OrphanedNode_parentCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PathMismatch_actualCodePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrphanedNode",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14138,8 +20232,8 @@ func (ec *executionContext) fieldContext_OrphanedNode_parentCode(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _OrphanedNode_reason(ctx context.Context, field graphql.CollectedField, obj *model.OrphanedNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OrphanedNode_reason(ctx, field)
+func (ec *executionContext) _PathMismatch_expectedNamePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_expectedNamePath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14152,7 +20246,7 @@ func (ec *executionContext) _OrphanedNode_reason(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
+		return obj.ExpectedNamePath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14169,9 +20263,9 @@ func (ec *executionContext) _OrphanedNode_reason(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OrphanedNode_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PathMismatch_expectedNamePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrphanedNode",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14182,140 +20276,8 @@ func (ec *executionContext) fieldContext_OrphanedNode_reason(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _PaginationInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaginationInfo_total(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_PaginationInfo_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PaginationInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _PaginationInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaginationInfo_page(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_PaginationInfo_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PaginationInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _PaginationInfo_pageSize(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaginationInfo_pageSize(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_PaginationInfo_pageSize(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PaginationInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _PaginationInfo_hasNext(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaginationInfo_hasNext(ctx, field)
+func (ec *executionContext) _PathMismatch_actualNamePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_actualNamePath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14328,7 +20290,7 @@ func (ec *executionContext) _PaginationInfo_hasNext(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNext, nil
+		return obj.ActualNamePath, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14340,26 +20302,26 @@ func (ec *executionContext) _PaginationInfo_hasNext(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaginationInfo_hasNext(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PathMismatch_actualNamePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaginationInfo",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaginationInfo_hasPrevious(ctx context.Context, field graphql.CollectedField, obj *model.PaginationInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PaginationInfo_hasPrevious(ctx, field)
+func (ec *executionContext) _PathMismatch_severity(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PathMismatch_severity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14372,7 +20334,7 @@ func (ec *executionContext) _PaginationInfo_hasPrevious(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPrevious, nil
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14384,26 +20346,26 @@ func (ec *executionContext) _PaginationInfo_hasPrevious(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PaginationInfo_hasPrevious(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PathMismatch_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaginationInfo",
+		Object:     "PathMismatch",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_code(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_code(ctx, field)
+func (ec *executionContext) _PayRange_currency(ctx context.Context, field graphql.CollectedField, obj *model.PayRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PayRange_currency(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14416,7 +20378,7 @@ func (ec *executionContext) _PathMismatch_code(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.Currency, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14433,9 +20395,9 @@ func (ec *executionContext) _PathMismatch_code(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PayRange_currency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "PayRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14446,8 +20408,8 @@ func (ec *executionContext) fieldContext_PathMismatch_code(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_expectedCodePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_expectedCodePath(ctx, field)
+func (ec *executionContext) _PayRange_region(ctx context.Context, field graphql.CollectedField, obj *model.PayRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PayRange_region(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14460,7 +20422,7 @@ func (ec *executionContext) _PathMismatch_expectedCodePath(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpectedCodePath, nil
+		return obj.Region, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14477,9 +20439,9 @@ func (ec *executionContext) _PathMismatch_expectedCodePath(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_expectedCodePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PayRange_region(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "PayRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -14490,8 +20452,8 @@ func (ec *executionContext) fieldContext_PathMismatch_expectedCodePath(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_actualCodePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_actualCodePath(ctx, field)
+func (ec *executionContext) _PayRange_min(ctx context.Context, field graphql.CollectedField, obj *model.PayRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PayRange_min(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14504,7 +20466,7 @@ func (ec *executionContext) _PathMismatch_actualCodePath(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActualCodePath, nil
+		return obj.Min, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14516,26 +20478,26 @@ func (ec *executionContext) _PathMismatch_actualCodePath(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_actualCodePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PayRange_min(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "PayRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_expectedNamePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_expectedNamePath(ctx, field)
+func (ec *executionContext) _PayRange_mid(ctx context.Context, field graphql.CollectedField, obj *model.PayRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PayRange_mid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14548,7 +20510,7 @@ func (ec *executionContext) _PathMismatch_expectedNamePath(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpectedNamePath, nil
+		return obj.Mid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14560,26 +20522,26 @@ func (ec *executionContext) _PathMismatch_expectedNamePath(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_expectedNamePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PayRange_mid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "PayRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_actualNamePath(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_actualNamePath(ctx, field)
+func (ec *executionContext) _PayRange_max(ctx context.Context, field graphql.CollectedField, obj *model.PayRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PayRange_max(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14592,7 +20554,7 @@ func (ec *executionContext) _PathMismatch_actualNamePath(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActualNamePath, nil
+		return obj.Max, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14604,26 +20566,26 @@ func (ec *executionContext) _PathMismatch_actualNamePath(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_actualNamePath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PayRange_max(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "PayRange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PathMismatch_severity(ctx context.Context, field graphql.CollectedField, obj *model.PathMismatch) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PathMismatch_severity(ctx, field)
+func (ec *executionContext) _Position_code(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14636,7 +20598,7 @@ func (ec *executionContext) _PathMismatch_severity(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Severity, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14648,26 +20610,26 @@ func (ec *executionContext) _PathMismatch_severity(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(dto.PositionCode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPositionCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐPositionCode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PathMismatch_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Position_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PathMismatch",
+		Object:     "Position",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type PositionCode does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Position_code(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Position_code(ctx, field)
+func (ec *executionContext) _Position_recordId(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_recordId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14680,7 +20642,7 @@ func (ec *executionContext) _Position_code(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.RecordID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14692,26 +20654,26 @@ func (ec *executionContext) _Position_code(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.PositionCode)
+	res := resTmp.(dto.UUID)
 	fc.Result = res
-	return ec.marshalNPositionCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐPositionCode(ctx, field.Selections, res)
+	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Position_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Position_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Position",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PositionCode does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Position_recordId(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Position_recordId(ctx, field)
+func (ec *executionContext) _Position_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_tenantId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14724,7 +20686,7 @@ func (ec *executionContext) _Position_recordId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RecordID, nil
+		return obj.TenantID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14741,7 +20703,7 @@ func (ec *executionContext) _Position_recordId(ctx context.Context, field graphq
 	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Position_recordId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Position_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Position",
 		Field:      field,
@@ -14754,8 +20716,8 @@ func (ec *executionContext) fieldContext_Position_recordId(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Position_tenantId(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Position_tenantId(ctx, field)
+func (ec *executionContext) _Position_title(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14768,7 +20730,7 @@ func (ec *executionContext) _Position_tenantId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TenantID, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14780,26 +20742,26 @@ func (ec *executionContext) _Position_tenantId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(dto.UUID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Position_tenantId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Position_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Position",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type UUID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Position_title(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Position_title(ctx, field)
+func (ec *executionContext) _Position_titleI18n(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_titleI18n(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14812,7 +20774,7 @@ func (ec *executionContext) _Position_title(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.TitleI18n, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14824,19 +20786,25 @@ func (ec *executionContext) _Position_title(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.LocalizedText)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Position_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Position_titleI18n(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Position",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "locale":
+				return ec.fieldContext_LocalizedText_locale(ctx, field)
+			case "value":
+				return ec.fieldContext_LocalizedText_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedText", field.Name)
 		},
 	}
 	return fc, nil
@@ -15953,6 +21921,263 @@ func (ec *executionContext) fieldContext_Position_updatedAt(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Position_customFields(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_customFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CustomFields, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.CustomFieldValue)
+	fc.Result = res
+	return ec.marshalNCustomFieldValue2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Position_customFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Position",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_CustomFieldValue_key(ctx, field)
+			case "type":
+				return ec.fieldContext_CustomFieldValue_type(ctx, field)
+			case "stringValue":
+				return ec.fieldContext_CustomFieldValue_stringValue(ctx, field)
+			case "numberValue":
+				return ec.fieldContext_CustomFieldValue_numberValue(ctx, field)
+			case "booleanValue":
+				return ec.fieldContext_CustomFieldValue_booleanValue(ctx, field)
+			case "dateValue":
+				return ec.fieldContext_CustomFieldValue_dateValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CustomFieldValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Position_compensationGrade(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_compensationGrade(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Position().CompensationGrade(rctx, obj, fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.CompensationGrade)
+	fc.Result = res
+	return ec.marshalOCompensationGrade2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGrade(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Position_compensationGrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Position",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_CompensationGrade_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_CompensationGrade_recordId(ctx, field)
+			case "name":
+				return ec.fieldContext_CompensationGrade_name(ctx, field)
+			case "description":
+				return ec.fieldContext_CompensationGrade_description(ctx, field)
+			case "status":
+				return ec.fieldContext_CompensationGrade_status(ctx, field)
+			case "jobLevelCodes":
+				return ec.fieldContext_CompensationGrade_jobLevelCodes(ctx, field)
+			case "payRanges":
+				return ec.fieldContext_CompensationGrade_payRanges(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_CompensationGrade_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_CompensationGrade_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_CompensationGrade_isCurrent(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CompensationGrade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Position_compensationGrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Position_costAllocations(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_costAllocations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Position().CostAllocations(rctx, obj, fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.CostAllocation)
+	fc.Result = res
+	return ec.marshalNCostAllocation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostAllocationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Position_costAllocations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Position",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "costCenterCode":
+				return ec.fieldContext_CostAllocation_costCenterCode(ctx, field)
+			case "costCenterName":
+				return ec.fieldContext_CostAllocation_costCenterName(ctx, field)
+			case "percentage":
+				return ec.fieldContext_CostAllocation_percentage(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_CostAllocation_effectiveDate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostAllocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Position_costAllocations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Position_location(ctx context.Context, field graphql.CollectedField, obj *model.Position) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Position_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Position().Location(rctx, obj, fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffectiveLocation)
+	fc.Result = res
+	return ec.marshalOEffectiveLocation2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEffectiveLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Position_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Position",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "location":
+				return ec.fieldContext_EffectiveLocation_location(ctx, field)
+			case "source":
+				return ec.fieldContext_EffectiveLocation_source(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_EffectiveLocation_effectiveDate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffectiveLocation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Position_location_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PositionAssignment_assignmentId(ctx context.Context, field graphql.CollectedField, obj *model.PositionAssignment) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PositionAssignment_assignmentId(ctx, field)
 	if err != nil {
@@ -17703,6 +23928,8 @@ func (ec *executionContext) fieldContext_PositionConnection_data(ctx context.Con
 				return ec.fieldContext_Position_tenantId(ctx, field)
 			case "title":
 				return ec.fieldContext_Position_title(ctx, field)
+			case "titleI18n":
+				return ec.fieldContext_Position_titleI18n(ctx, field)
 			case "jobProfileCode":
 				return ec.fieldContext_Position_jobProfileCode(ctx, field)
 			case "jobProfileName":
@@ -17751,6 +23978,14 @@ func (ec *executionContext) fieldContext_PositionConnection_data(ctx context.Con
 				return ec.fieldContext_Position_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Position_updatedAt(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Position_customFields(ctx, field)
+			case "compensationGrade":
+				return ec.fieldContext_Position_compensationGrade(ctx, field)
+			case "costAllocations":
+				return ec.fieldContext_Position_costAllocations(ctx, field)
+			case "location":
+				return ec.fieldContext_Position_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Position", field.Name)
 		},
@@ -17893,6 +24128,8 @@ func (ec *executionContext) fieldContext_PositionEdge_node(ctx context.Context,
 				return ec.fieldContext_Position_tenantId(ctx, field)
 			case "title":
 				return ec.fieldContext_Position_title(ctx, field)
+			case "titleI18n":
+				return ec.fieldContext_Position_titleI18n(ctx, field)
 			case "jobProfileCode":
 				return ec.fieldContext_Position_jobProfileCode(ctx, field)
 			case "jobProfileName":
@@ -17941,6 +24178,14 @@ func (ec *executionContext) fieldContext_PositionEdge_node(ctx context.Context,
 				return ec.fieldContext_Position_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Position_updatedAt(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Position_customFields(ctx, field)
+			case "compensationGrade":
+				return ec.fieldContext_Position_compensationGrade(ctx, field)
+			case "costAllocations":
+				return ec.fieldContext_Position_costAllocations(ctx, field)
+			case "location":
+				return ec.fieldContext_Position_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Position", field.Name)
 		},
@@ -18899,8 +25144,158 @@ func (ec *executionContext) fieldContext_PositionTransferConnection_data(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _PositionTransferConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PositionTransferConnection_totalCount(ctx, field)
+func (ec *executionContext) _PositionTransferConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransferConnection_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransferConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransferConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransferEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransferEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransferEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransferEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransferEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransferEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PositionTransfer)
+	fc.Result = res
+	return ec.marshalNPositionTransfer2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransfer(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransferEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransferEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transferId":
+				return ec.fieldContext_PositionTransfer_transferId(ctx, field)
+			case "positionCode":
+				return ec.fieldContext_PositionTransfer_positionCode(ctx, field)
+			case "fromOrganizationCode":
+				return ec.fieldContext_PositionTransfer_fromOrganizationCode(ctx, field)
+			case "toOrganizationCode":
+				return ec.fieldContext_PositionTransfer_toOrganizationCode(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_PositionTransfer_effectiveDate(ctx, field)
+			case "initiatedBy":
+				return ec.fieldContext_PositionTransfer_initiatedBy(ctx, field)
+			case "operationReason":
+				return ec.fieldContext_PositionTransfer_operationReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PositionTransfer_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PositionTransfer", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_event(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_event(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18913,7 +25308,7 @@ func (ec *executionContext) _PositionTransferConnection_totalCount(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Event, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18925,26 +25320,26 @@ func (ec *executionContext) _PositionTransferConnection_totalCount(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PositionTransferConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PositionTransition_event(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PositionTransferConnection",
+		Object:     "PositionTransition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PositionTransferEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PositionTransferEdge_cursor(ctx, field)
+func (ec *executionContext) _PositionTransition_fromStatus(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_fromStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -18957,7 +25352,7 @@ func (ec *executionContext) _PositionTransferEdge_cursor(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.FromStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -18974,9 +25369,9 @@ func (ec *executionContext) _PositionTransferEdge_cursor(ctx context.Context, fi
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PositionTransferEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PositionTransition_fromStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PositionTransferEdge",
+		Object:     "PositionTransition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -18987,8 +25382,8 @@ func (ec *executionContext) fieldContext_PositionTransferEdge_cursor(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _PositionTransferEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransferEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PositionTransferEdge_node(ctx, field)
+func (ec *executionContext) _PositionTransition_toStatus(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_toStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -19001,7 +25396,7 @@ func (ec *executionContext) _PositionTransferEdge_node(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.ToStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19013,37 +25408,239 @@ func (ec *executionContext) _PositionTransferEdge_node(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PositionTransfer)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPositionTransfer2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransfer(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PositionTransferEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PositionTransition_toStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PositionTransferEdge",
+		Object:     "PositionTransition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "transferId":
-				return ec.fieldContext_PositionTransfer_transferId(ctx, field)
-			case "positionCode":
-				return ec.fieldContext_PositionTransfer_positionCode(ctx, field)
-			case "fromOrganizationCode":
-				return ec.fieldContext_PositionTransfer_fromOrganizationCode(ctx, field)
-			case "toOrganizationCode":
-				return ec.fieldContext_PositionTransfer_toOrganizationCode(ctx, field)
-			case "effectiveDate":
-				return ec.fieldContext_PositionTransfer_effectiveDate(ctx, field)
-			case "initiatedBy":
-				return ec.fieldContext_PositionTransfer_initiatedBy(ctx, field)
-			case "operationReason":
-				return ec.fieldContext_PositionTransfer_operationReason(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_PositionTransfer_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PositionTransfer", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_allowed(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_allowed(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Allowed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransition_allowed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_guards(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_guards(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Guards, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransition_guards(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_failedGuards(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_failedGuards(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FailedGuards, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransition_failedGuards(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_sideEffects(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_sideEffects(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SideEffects, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransition_sideEffects(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PositionTransition_builtIn(ctx context.Context, field graphql.CollectedField, obj *model.PositionTransition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PositionTransition_builtIn(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BuiltIn, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PositionTransition_builtIn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PositionTransition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
@@ -19063,7 +25660,7 @@ func (ec *executionContext) _Query_organizations(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Organizations(rctx, fc.Args["filter"].(*model.OrganizationFilter), fc.Args["pagination"].(*model.PaginationInput))
+		return ec.resolvers.Query().Organizations(rctx, fc.Args["filter"].(*model.OrganizationFilter), fc.Args["pagination"].(*model.PaginationInput), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19126,7 +25723,7 @@ func (ec *executionContext) _Query_organization(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Organization(rctx, fc.Args["code"].(string), fc.Args["asOfDate"].(*string))
+		return ec.resolvers.Query().Organization(rctx, fc.Args["code"].(string), fc.Args["asOfDate"].(*string), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19168,6 +25765,10 @@ func (ec *executionContext) fieldContext_Query_organization(ctx context.Context,
 				return ec.fieldContext_Organization_codePath(ctx, field)
 			case "namePath":
 				return ec.fieldContext_Organization_namePath(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_Organization_nameI18n(ctx, field)
+			case "namePathI18n":
+				return ec.fieldContext_Organization_namePathI18n(ctx, field)
 			case "path":
 				return ec.fieldContext_Organization_path(ctx, field)
 			case "description":
@@ -19208,6 +25809,10 @@ func (ec *executionContext) fieldContext_Query_organization(ctx context.Context,
 				return ec.fieldContext_Organization_suspendedBy(ctx, field)
 			case "suspensionReason":
 				return ec.fieldContext_Organization_suspensionReason(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Organization_customFields(ctx, field)
+			case "location":
+				return ec.fieldContext_Organization_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Organization", field.Name)
 		},
@@ -19545,7 +26150,7 @@ func (ec *executionContext) _Query_positions(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Positions(rctx, fc.Args["filter"].(*model.PositionFilterInput), fc.Args["pagination"].(*model.PaginationInput), fc.Args["sorting"].([]model.PositionSortInput))
+		return ec.resolvers.Query().Positions(rctx, fc.Args["filter"].(*model.PositionFilterInput), fc.Args["pagination"].(*model.PaginationInput), fc.Args["sorting"].([]model.PositionSortInput), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19610,7 +26215,7 @@ func (ec *executionContext) _Query_position(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Position(rctx, fc.Args["code"].(dto.PositionCode), fc.Args["asOfDate"].(*dto.Date))
+		return ec.resolvers.Query().Position(rctx, fc.Args["code"].(dto.PositionCode), fc.Args["asOfDate"].(*dto.Date), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19640,6 +26245,8 @@ func (ec *executionContext) fieldContext_Query_position(ctx context.Context, fie
 				return ec.fieldContext_Position_tenantId(ctx, field)
 			case "title":
 				return ec.fieldContext_Position_title(ctx, field)
+			case "titleI18n":
+				return ec.fieldContext_Position_titleI18n(ctx, field)
 			case "jobProfileCode":
 				return ec.fieldContext_Position_jobProfileCode(ctx, field)
 			case "jobProfileName":
@@ -19688,6 +26295,14 @@ func (ec *executionContext) fieldContext_Query_position(ctx context.Context, fie
 				return ec.fieldContext_Position_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Position_updatedAt(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Position_customFields(ctx, field)
+			case "compensationGrade":
+				return ec.fieldContext_Position_compensationGrade(ctx, field)
+			case "costAllocations":
+				return ec.fieldContext_Position_costAllocations(ctx, field)
+			case "location":
+				return ec.fieldContext_Position_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Position", field.Name)
 		},
@@ -19797,7 +26412,7 @@ func (ec *executionContext) _Query_positionVersions(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PositionVersions(rctx, fc.Args["code"].(dto.PositionCode), fc.Args["includeDeleted"].(*bool))
+		return ec.resolvers.Query().PositionVersions(rctx, fc.Args["code"].(dto.PositionCode), fc.Args["includeDeleted"].(*bool), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -19830,6 +26445,8 @@ func (ec *executionContext) fieldContext_Query_positionVersions(ctx context.Cont
 				return ec.fieldContext_Position_tenantId(ctx, field)
 			case "title":
 				return ec.fieldContext_Position_title(ctx, field)
+			case "titleI18n":
+				return ec.fieldContext_Position_titleI18n(ctx, field)
 			case "jobProfileCode":
 				return ec.fieldContext_Position_jobProfileCode(ctx, field)
 			case "jobProfileName":
@@ -19878,6 +26495,14 @@ func (ec *executionContext) fieldContext_Query_positionVersions(ctx context.Cont
 				return ec.fieldContext_Position_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Position_updatedAt(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Position_customFields(ctx, field)
+			case "compensationGrade":
+				return ec.fieldContext_Position_compensationGrade(ctx, field)
+			case "costAllocations":
+				return ec.fieldContext_Position_costAllocations(ctx, field)
+			case "location":
+				return ec.fieldContext_Position_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Position", field.Name)
 		},
@@ -20082,15 +26707,80 @@ func (ec *executionContext) fieldContext_Query_assignments(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_assignments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_assignments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_assignmentHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_assignmentHistory(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AssignmentHistory(rctx, fc.Args["positionCode"].(dto.PositionCode), fc.Args["filter"].(*model.PositionAssignmentFilterInput), fc.Args["pagination"].(*model.PaginationInput), fc.Args["sorting"].([]model.PositionAssignmentSortInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PositionAssignmentConnection)
+	fc.Result = res
+	return ec.marshalNPositionAssignmentConnection2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionAssignmentConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_assignmentHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_PositionAssignmentConnection_edges(ctx, field)
+			case "pagination":
+				return ec.fieldContext_PositionAssignmentConnection_pagination(ctx, field)
+			case "data":
+				return ec.fieldContext_PositionAssignmentConnection_data(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_PositionAssignmentConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PositionAssignmentConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_assignmentHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_assignmentHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_assignmentHistory(ctx, field)
+func (ec *executionContext) _Query_assignmentStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_assignmentStats(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20103,7 +26793,7 @@ func (ec *executionContext) _Query_assignmentHistory(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AssignmentHistory(rctx, fc.Args["positionCode"].(dto.PositionCode), fc.Args["filter"].(*model.PositionAssignmentFilterInput), fc.Args["pagination"].(*model.PaginationInput), fc.Args["sorting"].([]model.PositionAssignmentSortInput))
+		return ec.resolvers.Query().AssignmentStats(rctx, fc.Args["organizationCode"].(*string), fc.Args["positionCode"].(*dto.PositionCode))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20115,12 +26805,12 @@ func (ec *executionContext) _Query_assignmentHistory(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PositionAssignmentConnection)
+	res := resTmp.(*model.AssignmentStats)
 	fc.Result = res
-	return ec.marshalNPositionAssignmentConnection2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionAssignmentConnection(ctx, field.Selections, res)
+	return ec.marshalNAssignmentStats2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAssignmentStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_assignmentHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_assignmentStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -20128,16 +26818,28 @@ func (ec *executionContext) fieldContext_Query_assignmentHistory(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "edges":
-				return ec.fieldContext_PositionAssignmentConnection_edges(ctx, field)
-			case "pagination":
-				return ec.fieldContext_PositionAssignmentConnection_pagination(ctx, field)
-			case "data":
-				return ec.fieldContext_PositionAssignmentConnection_data(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_PositionAssignmentConnection_totalCount(ctx, field)
+			case "positionCode":
+				return ec.fieldContext_AssignmentStats_positionCode(ctx, field)
+			case "organizationCode":
+				return ec.fieldContext_AssignmentStats_organizationCode(ctx, field)
+			case "totalAssignments":
+				return ec.fieldContext_AssignmentStats_totalAssignments(ctx, field)
+			case "activeAssignments":
+				return ec.fieldContext_AssignmentStats_activeAssignments(ctx, field)
+			case "pendingAssignments":
+				return ec.fieldContext_AssignmentStats_pendingAssignments(ctx, field)
+			case "endedAssignments":
+				return ec.fieldContext_AssignmentStats_endedAssignments(ctx, field)
+			case "primaryAssignments":
+				return ec.fieldContext_AssignmentStats_primaryAssignments(ctx, field)
+			case "secondaryAssignments":
+				return ec.fieldContext_AssignmentStats_secondaryAssignments(ctx, field)
+			case "actingAssignments":
+				return ec.fieldContext_AssignmentStats_actingAssignments(ctx, field)
+			case "lastUpdatedAt":
+				return ec.fieldContext_AssignmentStats_lastUpdatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PositionAssignmentConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AssignmentStats", field.Name)
 		},
 	}
 	defer func() {
@@ -20147,15 +26849,15 @@ func (ec *executionContext) fieldContext_Query_assignmentHistory(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_assignmentHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_assignmentStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_assignmentStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_assignmentStats(ctx, field)
+func (ec *executionContext) _Query_employeeAssignmentViolations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_employeeAssignmentViolations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20168,7 +26870,7 @@ func (ec *executionContext) _Query_assignmentStats(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AssignmentStats(rctx, fc.Args["organizationCode"].(*string), fc.Args["positionCode"].(*dto.PositionCode))
+		return ec.resolvers.Query().EmployeeAssignmentViolations(rctx, fc.Args["asOfDate"].(*dto.Date))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20180,12 +26882,12 @@ func (ec *executionContext) _Query_assignmentStats(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AssignmentStats)
+	res := resTmp.([]model.EmployeeAssignmentViolation)
 	fc.Result = res
-	return ec.marshalNAssignmentStats2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAssignmentStats(ctx, field.Selections, res)
+	return ec.marshalNEmployeeAssignmentViolation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmployeeAssignmentViolationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_assignmentStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_employeeAssignmentViolations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -20193,28 +26895,26 @@ func (ec *executionContext) fieldContext_Query_assignmentStats(ctx context.Conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "positionCode":
-				return ec.fieldContext_AssignmentStats_positionCode(ctx, field)
+			case "rule":
+				return ec.fieldContext_EmployeeAssignmentViolation_rule(ctx, field)
+			case "employeeId":
+				return ec.fieldContext_EmployeeAssignmentViolation_employeeId(ctx, field)
+			case "employeeName":
+				return ec.fieldContext_EmployeeAssignmentViolation_employeeName(ctx, field)
 			case "organizationCode":
-				return ec.fieldContext_AssignmentStats_organizationCode(ctx, field)
-			case "totalAssignments":
-				return ec.fieldContext_AssignmentStats_totalAssignments(ctx, field)
-			case "activeAssignments":
-				return ec.fieldContext_AssignmentStats_activeAssignments(ctx, field)
-			case "pendingAssignments":
-				return ec.fieldContext_AssignmentStats_pendingAssignments(ctx, field)
-			case "endedAssignments":
-				return ec.fieldContext_AssignmentStats_endedAssignments(ctx, field)
-			case "primaryAssignments":
-				return ec.fieldContext_AssignmentStats_primaryAssignments(ctx, field)
-			case "secondaryAssignments":
-				return ec.fieldContext_AssignmentStats_secondaryAssignments(ctx, field)
-			case "actingAssignments":
-				return ec.fieldContext_AssignmentStats_actingAssignments(ctx, field)
-			case "lastUpdatedAt":
-				return ec.fieldContext_AssignmentStats_lastUpdatedAt(ctx, field)
+				return ec.fieldContext_EmployeeAssignmentViolation_organizationCode(ctx, field)
+			case "totalFte":
+				return ec.fieldContext_EmployeeAssignmentViolation_totalFte(ctx, field)
+			case "maxFte":
+				return ec.fieldContext_EmployeeAssignmentViolation_maxFte(ctx, field)
+			case "assignmentIds":
+				return ec.fieldContext_EmployeeAssignmentViolation_assignmentIds(ctx, field)
+			case "positionCodes":
+				return ec.fieldContext_EmployeeAssignmentViolation_positionCodes(ctx, field)
+			case "asOfDate":
+				return ec.fieldContext_EmployeeAssignmentViolation_asOfDate(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AssignmentStats", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type EmployeeAssignmentViolation", field.Name)
 		},
 	}
 	defer func() {
@@ -20224,7 +26924,7 @@ func (ec *executionContext) fieldContext_Query_assignmentStats(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_assignmentStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_employeeAssignmentViolations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -20436,6 +27136,79 @@ func (ec *executionContext) fieldContext_Query_positionHeadcountStats(ctx contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_positionAllowedTransitions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_positionAllowedTransitions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PositionAllowedTransitions(rctx, fc.Args["code"].(dto.PositionCode))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.PositionTransition)
+	fc.Result = res
+	return ec.marshalNPositionTransition2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransitionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_positionAllowedTransitions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "event":
+				return ec.fieldContext_PositionTransition_event(ctx, field)
+			case "fromStatus":
+				return ec.fieldContext_PositionTransition_fromStatus(ctx, field)
+			case "toStatus":
+				return ec.fieldContext_PositionTransition_toStatus(ctx, field)
+			case "allowed":
+				return ec.fieldContext_PositionTransition_allowed(ctx, field)
+			case "guards":
+				return ec.fieldContext_PositionTransition_guards(ctx, field)
+			case "failedGuards":
+				return ec.fieldContext_PositionTransition_failedGuards(ctx, field)
+			case "sideEffects":
+				return ec.fieldContext_PositionTransition_sideEffects(ctx, field)
+			case "builtIn":
+				return ec.fieldContext_PositionTransition_builtIn(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PositionTransition", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_positionAllowedTransitions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_auditHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_auditHistory(ctx, field)
 	if err != nil {
@@ -20597,7 +27370,7 @@ func (ec *executionContext) _Query_organizationVersions(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().OrganizationVersions(rctx, fc.Args["code"].(string), fc.Args["includeDeleted"].(*bool))
+		return ec.resolvers.Query().OrganizationVersions(rctx, fc.Args["code"].(string), fc.Args["includeDeleted"].(*bool), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20642,6 +27415,10 @@ func (ec *executionContext) fieldContext_Query_organizationVersions(ctx context.
 				return ec.fieldContext_Organization_codePath(ctx, field)
 			case "namePath":
 				return ec.fieldContext_Organization_namePath(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_Organization_nameI18n(ctx, field)
+			case "namePathI18n":
+				return ec.fieldContext_Organization_namePathI18n(ctx, field)
 			case "path":
 				return ec.fieldContext_Organization_path(ctx, field)
 			case "description":
@@ -20682,6 +27459,10 @@ func (ec *executionContext) fieldContext_Query_organizationVersions(ctx context.
 				return ec.fieldContext_Organization_suspendedBy(ctx, field)
 			case "suspensionReason":
 				return ec.fieldContext_Organization_suspensionReason(ctx, field)
+			case "customFields":
+				return ec.fieldContext_Organization_customFields(ctx, field)
+			case "location":
+				return ec.fieldContext_Organization_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Organization", field.Name)
 		},
@@ -20714,7 +27495,7 @@ func (ec *executionContext) _Query_jobFamilyGroups(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().JobFamilyGroups(rctx, fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+		return ec.resolvers.Query().JobFamilyGroups(rctx, fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20745,6 +27526,8 @@ func (ec *executionContext) fieldContext_Query_jobFamilyGroups(ctx context.Conte
 				return ec.fieldContext_JobFamilyGroup_recordId(ctx, field)
 			case "name":
 				return ec.fieldContext_JobFamilyGroup_name(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_JobFamilyGroup_nameI18n(ctx, field)
 			case "status":
 				return ec.fieldContext_JobFamilyGroup_status(ctx, field)
 			case "effectiveDate":
@@ -20785,7 +27568,157 @@ func (ec *executionContext) _Query_jobFamilies(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().JobFamilies(rctx, fc.Args["groupCode"].(dto.JobFamilyGroupCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+		return ec.resolvers.Query().JobFamilies(rctx, fc.Args["groupCode"].(dto.JobFamilyGroupCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date), fc.Args["locale"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.JobFamily)
+	fc.Result = res
+	return ec.marshalNJobFamily2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobFamilyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_jobFamilies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_JobFamily_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_JobFamily_recordId(ctx, field)
+			case "groupCode":
+				return ec.fieldContext_JobFamily_groupCode(ctx, field)
+			case "name":
+				return ec.fieldContext_JobFamily_name(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_JobFamily_nameI18n(ctx, field)
+			case "status":
+				return ec.fieldContext_JobFamily_status(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_JobFamily_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_JobFamily_endDate(ctx, field)
+			case "description":
+				return ec.fieldContext_JobFamily_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JobFamily", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_jobFamilies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_jobRoles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_jobRoles(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().JobRoles(rctx, fc.Args["familyCode"].(dto.JobFamilyCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date), fc.Args["locale"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.JobRole)
+	fc.Result = res
+	return ec.marshalNJobRole2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobRoleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_jobRoles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_JobRole_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_JobRole_recordId(ctx, field)
+			case "familyCode":
+				return ec.fieldContext_JobRole_familyCode(ctx, field)
+			case "name":
+				return ec.fieldContext_JobRole_name(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_JobRole_nameI18n(ctx, field)
+			case "status":
+				return ec.fieldContext_JobRole_status(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_JobRole_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_JobRole_endDate(ctx, field)
+			case "description":
+				return ec.fieldContext_JobRole_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JobRole", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_jobRoles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_jobLevels(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_jobLevels(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().JobLevels(rctx, fc.Args["roleCode"].(dto.JobRoleCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date), fc.Args["locale"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20797,12 +27730,12 @@ func (ec *executionContext) _Query_jobFamilies(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.JobFamily)
+	res := resTmp.([]model.JobLevel)
 	fc.Result = res
-	return ec.marshalNJobFamily2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobFamilyᚄ(ctx, field.Selections, res)
+	return ec.marshalNJobLevel2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobLevelᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_jobFamilies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_jobLevels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -20811,23 +27744,29 @@ func (ec *executionContext) fieldContext_Query_jobFamilies(ctx context.Context,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "code":
-				return ec.fieldContext_JobFamily_code(ctx, field)
+				return ec.fieldContext_JobLevel_code(ctx, field)
 			case "recordId":
-				return ec.fieldContext_JobFamily_recordId(ctx, field)
-			case "groupCode":
-				return ec.fieldContext_JobFamily_groupCode(ctx, field)
+				return ec.fieldContext_JobLevel_recordId(ctx, field)
+			case "roleCode":
+				return ec.fieldContext_JobLevel_roleCode(ctx, field)
 			case "name":
-				return ec.fieldContext_JobFamily_name(ctx, field)
+				return ec.fieldContext_JobLevel_name(ctx, field)
+			case "nameI18n":
+				return ec.fieldContext_JobLevel_nameI18n(ctx, field)
 			case "status":
-				return ec.fieldContext_JobFamily_status(ctx, field)
+				return ec.fieldContext_JobLevel_status(ctx, field)
 			case "effectiveDate":
-				return ec.fieldContext_JobFamily_effectiveDate(ctx, field)
+				return ec.fieldContext_JobLevel_effectiveDate(ctx, field)
 			case "endDate":
-				return ec.fieldContext_JobFamily_endDate(ctx, field)
+				return ec.fieldContext_JobLevel_endDate(ctx, field)
+			case "levelRank":
+				return ec.fieldContext_JobLevel_levelRank(ctx, field)
 			case "description":
-				return ec.fieldContext_JobFamily_description(ctx, field)
+				return ec.fieldContext_JobLevel_description(ctx, field)
+			case "compensationGrades":
+				return ec.fieldContext_JobLevel_compensationGrades(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type JobFamily", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type JobLevel", field.Name)
 		},
 	}
 	defer func() {
@@ -20837,15 +27776,15 @@ func (ec *executionContext) fieldContext_Query_jobFamilies(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_jobFamilies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_jobLevels_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_jobRoles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_jobRoles(ctx, field)
+func (ec *executionContext) _Query_compensationGrades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_compensationGrades(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20858,7 +27797,7 @@ func (ec *executionContext) _Query_jobRoles(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().JobRoles(rctx, fc.Args["familyCode"].(dto.JobFamilyCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+		return ec.resolvers.Query().CompensationGrades(rctx, fc.Args["jobLevelCode"].(*dto.JobLevelCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20870,12 +27809,12 @@ func (ec *executionContext) _Query_jobRoles(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.JobRole)
+	res := resTmp.([]model.CompensationGrade)
 	fc.Result = res
-	return ec.marshalNJobRole2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobRoleᚄ(ctx, field.Selections, res)
+	return ec.marshalNCompensationGrade2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_jobRoles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_compensationGrades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -20884,23 +27823,104 @@ func (ec *executionContext) fieldContext_Query_jobRoles(ctx context.Context, fie
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "code":
-				return ec.fieldContext_JobRole_code(ctx, field)
+				return ec.fieldContext_CompensationGrade_code(ctx, field)
 			case "recordId":
-				return ec.fieldContext_JobRole_recordId(ctx, field)
-			case "familyCode":
-				return ec.fieldContext_JobRole_familyCode(ctx, field)
+				return ec.fieldContext_CompensationGrade_recordId(ctx, field)
 			case "name":
-				return ec.fieldContext_JobRole_name(ctx, field)
+				return ec.fieldContext_CompensationGrade_name(ctx, field)
+			case "description":
+				return ec.fieldContext_CompensationGrade_description(ctx, field)
 			case "status":
-				return ec.fieldContext_JobRole_status(ctx, field)
+				return ec.fieldContext_CompensationGrade_status(ctx, field)
+			case "jobLevelCodes":
+				return ec.fieldContext_CompensationGrade_jobLevelCodes(ctx, field)
+			case "payRanges":
+				return ec.fieldContext_CompensationGrade_payRanges(ctx, field)
 			case "effectiveDate":
-				return ec.fieldContext_JobRole_effectiveDate(ctx, field)
+				return ec.fieldContext_CompensationGrade_effectiveDate(ctx, field)
 			case "endDate":
-				return ec.fieldContext_JobRole_endDate(ctx, field)
+				return ec.fieldContext_CompensationGrade_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_CompensationGrade_isCurrent(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CompensationGrade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_compensationGrades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_costCenters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_costCenters(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CostCenters(rctx, fc.Args["legalEntityCode"].(*string), fc.Args["parentCode"].(*string), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.CostCenter)
+	fc.Result = res
+	return ec.marshalNCostCenter2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_costCenters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_CostCenter_code(ctx, field)
+			case "recordId":
+				return ec.fieldContext_CostCenter_recordId(ctx, field)
+			case "name":
+				return ec.fieldContext_CostCenter_name(ctx, field)
 			case "description":
-				return ec.fieldContext_JobRole_description(ctx, field)
+				return ec.fieldContext_CostCenter_description(ctx, field)
+			case "status":
+				return ec.fieldContext_CostCenter_status(ctx, field)
+			case "parentCode":
+				return ec.fieldContext_CostCenter_parentCode(ctx, field)
+			case "legalEntityCode":
+				return ec.fieldContext_CostCenter_legalEntityCode(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_CostCenter_effectiveDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_CostCenter_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_CostCenter_isCurrent(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type JobRole", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CostCenter", field.Name)
 		},
 	}
 	defer func() {
@@ -20910,15 +27930,15 @@ func (ec *executionContext) fieldContext_Query_jobRoles(ctx context.Context, fie
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_jobRoles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_costCenters_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_jobLevels(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_jobLevels(ctx, field)
+func (ec *executionContext) _Query_costCenterHeadcountStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_costCenterHeadcountStats(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -20931,7 +27951,7 @@ func (ec *executionContext) _Query_jobLevels(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().JobLevels(rctx, fc.Args["roleCode"].(dto.JobRoleCode), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+		return ec.resolvers.Query().CostCenterHeadcountStats(rctx, fc.Args["asOfDate"].(*dto.Date), fc.Args["legalEntityCode"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -20943,12 +27963,85 @@ func (ec *executionContext) _Query_jobLevels(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.JobLevel)
+	res := resTmp.([]model.CostCenterHeadcount)
 	fc.Result = res
-	return ec.marshalNJobLevel2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobLevelᚄ(ctx, field.Selections, res)
+	return ec.marshalNCostCenterHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterHeadcountᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_jobLevels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_costCenterHeadcountStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "costCenterCode":
+				return ec.fieldContext_CostCenterHeadcount_costCenterCode(ctx, field)
+			case "costCenterName":
+				return ec.fieldContext_CostCenterHeadcount_costCenterName(ctx, field)
+			case "legalEntityCode":
+				return ec.fieldContext_CostCenterHeadcount_legalEntityCode(ctx, field)
+			case "positionCount":
+				return ec.fieldContext_CostCenterHeadcount_positionCount(ctx, field)
+			case "headcountCapacity":
+				return ec.fieldContext_CostCenterHeadcount_headcountCapacity(ctx, field)
+			case "headcountInUse":
+				return ec.fieldContext_CostCenterHeadcount_headcountInUse(ctx, field)
+			case "assignedFte":
+				return ec.fieldContext_CostCenterHeadcount_assignedFte(ctx, field)
+			case "availableHeadcount":
+				return ec.fieldContext_CostCenterHeadcount_availableHeadcount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CostCenterHeadcount", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_costCenterHeadcountStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_locations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Locations(rctx, fc.Args["type"].(*model.LocationType), fc.Args["parentCode"].(*string), fc.Args["countryCode"].(*string), fc.Args["includeInactive"].(*bool), fc.Args["asOfDate"].(*dto.Date))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Location)
+	fc.Result = res
+	return ec.marshalNLocation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -20957,25 +28050,31 @@ func (ec *executionContext) fieldContext_Query_jobLevels(ctx context.Context, fi
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "code":
-				return ec.fieldContext_JobLevel_code(ctx, field)
+				return ec.fieldContext_Location_code(ctx, field)
 			case "recordId":
-				return ec.fieldContext_JobLevel_recordId(ctx, field)
-			case "roleCode":
-				return ec.fieldContext_JobLevel_roleCode(ctx, field)
+				return ec.fieldContext_Location_recordId(ctx, field)
 			case "name":
-				return ec.fieldContext_JobLevel_name(ctx, field)
+				return ec.fieldContext_Location_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Location_type(ctx, field)
 			case "status":
-				return ec.fieldContext_JobLevel_status(ctx, field)
+				return ec.fieldContext_Location_status(ctx, field)
+			case "parentCode":
+				return ec.fieldContext_Location_parentCode(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_Location_countryCode(ctx, field)
+			case "timeZone":
+				return ec.fieldContext_Location_timeZone(ctx, field)
+			case "address":
+				return ec.fieldContext_Location_address(ctx, field)
 			case "effectiveDate":
-				return ec.fieldContext_JobLevel_effectiveDate(ctx, field)
+				return ec.fieldContext_Location_effectiveDate(ctx, field)
 			case "endDate":
-				return ec.fieldContext_JobLevel_endDate(ctx, field)
-			case "levelRank":
-				return ec.fieldContext_JobLevel_levelRank(ctx, field)
-			case "description":
-				return ec.fieldContext_JobLevel_description(ctx, field)
+				return ec.fieldContext_Location_endDate(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_Location_isCurrent(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type JobLevel", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	defer func() {
@@ -20985,7 +28084,82 @@ func (ec *executionContext) fieldContext_Query_jobLevels(ctx context.Context, fi
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_jobLevels_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_locations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_locationHeadcountStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_locationHeadcountStats(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LocationHeadcountStats(rctx, fc.Args["asOfDate"].(*dto.Date), fc.Args["locationCode"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LocationHeadcount)
+	fc.Result = res
+	return ec.marshalNLocationHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationHeadcountᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_locationHeadcountStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "locationCode":
+				return ec.fieldContext_LocationHeadcount_locationCode(ctx, field)
+			case "locationName":
+				return ec.fieldContext_LocationHeadcount_locationName(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_LocationHeadcount_countryCode(ctx, field)
+			case "timeZone":
+				return ec.fieldContext_LocationHeadcount_timeZone(ctx, field)
+			case "positionCount":
+				return ec.fieldContext_LocationHeadcount_positionCount(ctx, field)
+			case "headcountCapacity":
+				return ec.fieldContext_LocationHeadcount_headcountCapacity(ctx, field)
+			case "headcountInUse":
+				return ec.fieldContext_LocationHeadcount_headcountInUse(ctx, field)
+			case "assignedFte":
+				return ec.fieldContext_LocationHeadcount_assignedFte(ctx, field)
+			case "availableHeadcount":
+				return ec.fieldContext_LocationHeadcount_availableHeadcount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocationHeadcount", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_locationHeadcountStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -21983,9 +29157,9 @@ func (ec *executionContext) _TypeStatistic_unitType(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.UnitType)
+	res := resTmp.(dto.UnitTypeCode)
 	fc.Result = res
-	return ec.marshalNUnitType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx, field.Selections, res)
+	return ec.marshalNUnitType2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TypeStatistic_unitType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -24710,6 +31884,51 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Conte
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputCustomFieldFilterInput(ctx context.Context, obj interface{}) (*model.CustomFieldFilterInput, error) {
+	var it model.CustomFieldFilterInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["operator"]; !present {
+		asMap["operator"] = "EQ"
+	}
+
+	fieldsInOrder := [...]string{"key", "operator", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.Key = data
+		case "operator":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operator"))
+			data, err := ec.unmarshalOCustomFieldFilterOperator2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterOperator(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.Operator = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return &it, nil
+}
+
 func (ec *executionContext) unmarshalInputDateRangeInput(ctx context.Context, obj interface{}) (*model.DateRangeInput, error) {
 	var it model.DateRangeInput
 	asMap := map[string]interface{}{}
@@ -24770,7 +31989,7 @@ func (ec *executionContext) unmarshalInputOrganizationFilter(ctx context.Context
 		asMap["searchFields"] = []interface{}{"NAME", "DESCRIPTION"}
 	}
 
-	fieldsInOrder := [...]string{"asOfDate", "includeFuture", "onlyFuture", "unitType", "status", "parentCode", "codes", "excludeCodes", "excludeDescendantsOf", "includeDisabledAncestors", "level", "minLevel", "maxLevel", "rootsOnly", "leavesOnly", "searchText", "searchFields", "hasChildren", "hasProfile", "profileContains", "operationType", "operatedBy", "operationDateRange"}
+	fieldsInOrder := [...]string{"asOfDate", "includeFuture", "onlyFuture", "unitType", "status", "parentCode", "codes", "excludeCodes", "excludeDescendantsOf", "includeDisabledAncestors", "level", "minLevel", "maxLevel", "rootsOnly", "leavesOnly", "searchText", "searchFields", "hasChildren", "hasProfile", "profileContains", "operationType", "operatedBy", "operationDateRange", "customFields", "locationCode"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -24800,7 +32019,7 @@ func (ec *executionContext) unmarshalInputOrganizationFilter(ctx context.Context
 			it.OnlyFuture = data
 		case "unitType":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unitType"))
-			data, err := ec.unmarshalOUnitType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx, v)
+			data, err := ec.unmarshalOUnitType2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx, v)
 			if err != nil {
 				return &it, err
 			}
@@ -24938,6 +32157,20 @@ func (ec *executionContext) unmarshalInputOrganizationFilter(ctx context.Context
 				return &it, err
 			}
 			it.OperationDateRange = data
+		case "customFields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customFields"))
+			data, err := ec.unmarshalOCustomFieldFilterInput2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.CustomFields = data
+		case "locationCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.LocationCode = data
 		}
 	}
 
@@ -25126,7 +32359,7 @@ func (ec *executionContext) unmarshalInputPositionFilterInput(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"organizationCode", "positionCodes", "status", "jobFamilyGroupCodes", "jobFamilyCodes", "jobRoleCodes", "jobLevelCodes", "positionTypes", "employmentTypes", "effectiveRange"}
+	fieldsInOrder := [...]string{"organizationCode", "positionCodes", "status", "jobFamilyGroupCodes", "jobFamilyCodes", "jobRoleCodes", "jobLevelCodes", "positionTypes", "employmentTypes", "effectiveRange", "customFields", "locationCode"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -25203,6 +32436,20 @@ func (ec *executionContext) unmarshalInputPositionFilterInput(ctx context.Contex
 				return &it, err
 			}
 			it.EffectiveRange = data
+		case "customFields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("customFields"))
+			data, err := ec.unmarshalOCustomFieldFilterInput2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.CustomFields = data
+		case "locationCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.LocationCode = data
 		}
 	}
 
@@ -25254,7 +32501,7 @@ func (ec *executionContext) unmarshalInputVacantPositionFilterInput(ctx context.
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"organizationCodes", "jobFamilyCodes", "jobRoleCodes", "jobLevelCodes", "positionTypes", "minimumVacantDays", "asOfDate"}
+	fieldsInOrder := [...]string{"organizationCodes", "jobFamilyCodes", "jobRoleCodes", "jobLevelCodes", "positionTypes", "minimumVacantDays", "asOfDate", "locationCode"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -25310,6 +32557,13 @@ func (ec *executionContext) unmarshalInputVacantPositionFilterInput(ctx context.
 				return &it, err
 			}
 			it.AsOfDate = data
+		case "locationCode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationCode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return &it, err
+			}
+			it.LocationCode = data
 		}
 	}
 
@@ -25362,6 +32616,50 @@ func (ec *executionContext) unmarshalInputVacantPositionSortInput(ctx context.Co
 
 // region    **************************** object.gotpl ****************************
 
+var addressImplementors = []string{"Address"}
+
+func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *model.Address) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Address")
+		case "line1":
+			out.Values[i] = ec._Address_line1(ctx, field, obj)
+		case "line2":
+			out.Values[i] = ec._Address_line2(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._Address_city(ctx, field, obj)
+		case "stateProvince":
+			out.Values[i] = ec._Address_stateProvince(ctx, field, obj)
+		case "postalCode":
+			out.Values[i] = ec._Address_postalCode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var assignmentStatsImplementors = []string{"AssignmentStats"}
 
 func (ec *executionContext) _AssignmentStats(ctx context.Context, sel ast.SelectionSet, obj *model.AssignmentStats) graphql.Marshaler {
@@ -25618,6 +32916,84 @@ func (ec *executionContext) _CircularReference(ctx context.Context, sel ast.Sele
 	return out
 }
 
+var compensationGradeImplementors = []string{"CompensationGrade"}
+
+func (ec *executionContext) _CompensationGrade(ctx context.Context, sel ast.SelectionSet, obj *model.CompensationGrade) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, compensationGradeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompensationGrade")
+		case "code":
+			out.Values[i] = ec._CompensationGrade_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordId":
+			out.Values[i] = ec._CompensationGrade_recordId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._CompensationGrade_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._CompensationGrade_description(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CompensationGrade_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "jobLevelCodes":
+			out.Values[i] = ec._CompensationGrade_jobLevelCodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "payRanges":
+			out.Values[i] = ec._CompensationGrade_payRanges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveDate":
+			out.Values[i] = ec._CompensationGrade_effectiveDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._CompensationGrade_endDate(ctx, field, obj)
+		case "isCurrent":
+			out.Values[i] = ec._CompensationGrade_isCurrent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var consistencyFindingsImplementors = []string{"ConsistencyFindings"}
 
 func (ec *executionContext) _ConsistencyFindings(ctx context.Context, sel ast.SelectionSet, obj *model.ConsistencyFindings) graphql.Marshaler {
@@ -25682,6 +33058,249 @@ func (ec *executionContext) _ConsistencyFindings(ctx context.Context, sel ast.Se
 	return out
 }
 
+var costAllocationImplementors = []string{"CostAllocation"}
+
+func (ec *executionContext) _CostAllocation(ctx context.Context, sel ast.SelectionSet, obj *model.CostAllocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costAllocationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostAllocation")
+		case "costCenterCode":
+			out.Values[i] = ec._CostAllocation_costCenterCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costCenterName":
+			out.Values[i] = ec._CostAllocation_costCenterName(ctx, field, obj)
+		case "percentage":
+			out.Values[i] = ec._CostAllocation_percentage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveDate":
+			out.Values[i] = ec._CostAllocation_effectiveDate(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var costCenterImplementors = []string{"CostCenter"}
+
+func (ec *executionContext) _CostCenter(ctx context.Context, sel ast.SelectionSet, obj *model.CostCenter) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costCenterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostCenter")
+		case "code":
+			out.Values[i] = ec._CostCenter_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordId":
+			out.Values[i] = ec._CostCenter_recordId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._CostCenter_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._CostCenter_description(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CostCenter_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "parentCode":
+			out.Values[i] = ec._CostCenter_parentCode(ctx, field, obj)
+		case "legalEntityCode":
+			out.Values[i] = ec._CostCenter_legalEntityCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveDate":
+			out.Values[i] = ec._CostCenter_effectiveDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._CostCenter_endDate(ctx, field, obj)
+		case "isCurrent":
+			out.Values[i] = ec._CostCenter_isCurrent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var costCenterHeadcountImplementors = []string{"CostCenterHeadcount"}
+
+func (ec *executionContext) _CostCenterHeadcount(ctx context.Context, sel ast.SelectionSet, obj *model.CostCenterHeadcount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costCenterHeadcountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostCenterHeadcount")
+		case "costCenterCode":
+			out.Values[i] = ec._CostCenterHeadcount_costCenterCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costCenterName":
+			out.Values[i] = ec._CostCenterHeadcount_costCenterName(ctx, field, obj)
+		case "legalEntityCode":
+			out.Values[i] = ec._CostCenterHeadcount_legalEntityCode(ctx, field, obj)
+		case "positionCount":
+			out.Values[i] = ec._CostCenterHeadcount_positionCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headcountCapacity":
+			out.Values[i] = ec._CostCenterHeadcount_headcountCapacity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headcountInUse":
+			out.Values[i] = ec._CostCenterHeadcount_headcountInUse(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assignedFte":
+			out.Values[i] = ec._CostCenterHeadcount_assignedFte(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableHeadcount":
+			out.Values[i] = ec._CostCenterHeadcount_availableHeadcount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var customFieldValueImplementors = []string{"CustomFieldValue"}
+
+func (ec *executionContext) _CustomFieldValue(ctx context.Context, sel ast.SelectionSet, obj *model.CustomFieldValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, customFieldValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CustomFieldValue")
+		case "key":
+			out.Values[i] = ec._CustomFieldValue_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._CustomFieldValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stringValue":
+			out.Values[i] = ec._CustomFieldValue_stringValue(ctx, field, obj)
+		case "numberValue":
+			out.Values[i] = ec._CustomFieldValue_numberValue(ctx, field, obj)
+		case "booleanValue":
+			out.Values[i] = ec._CustomFieldValue_booleanValue(ctx, field, obj)
+		case "dateValue":
+			out.Values[i] = ec._CustomFieldValue_dateValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var dataChangesImplementors = []string{"DataChanges"}
 
 func (ec *executionContext) _DataChanges(ctx context.Context, sel ast.SelectionSet, obj *model.DataChanges) graphql.Marshaler {
@@ -25769,78 +33388,203 @@ func (ec *executionContext) _DateRange(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var depthDistributionImplementors = []string{"DepthDistribution"}
+var depthDistributionImplementors = []string{"DepthDistribution"}
+
+func (ec *executionContext) _DepthDistribution(ctx context.Context, sel ast.SelectionSet, obj *model.DepthDistribution) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, depthDistributionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DepthDistribution")
+		case "depth":
+			out.Values[i] = ec._DepthDistribution_depth(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._DepthDistribution_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var depthViolationImplementors = []string{"DepthViolation"}
+
+func (ec *executionContext) _DepthViolation(ctx context.Context, sel ast.SelectionSet, obj *model.DepthViolation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, depthViolationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DepthViolation")
+		case "code":
+			out.Values[i] = ec._DepthViolation_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currentDepth":
+			out.Values[i] = ec._DepthViolation_currentDepth(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxAllowedDepth":
+			out.Values[i] = ec._DepthViolation_maxAllowedDepth(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "parentChain":
+			out.Values[i] = ec._DepthViolation_parentChain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var effectiveLocationImplementors = []string{"EffectiveLocation"}
+
+func (ec *executionContext) _EffectiveLocation(ctx context.Context, sel ast.SelectionSet, obj *model.EffectiveLocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, effectiveLocationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EffectiveLocation")
+		case "location":
+			out.Values[i] = ec._EffectiveLocation_location(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._EffectiveLocation_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveDate":
+			out.Values[i] = ec._EffectiveLocation_effectiveDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var employeeAssignmentViolationImplementors = []string{"EmployeeAssignmentViolation"}
 
-func (ec *executionContext) _DepthDistribution(ctx context.Context, sel ast.SelectionSet, obj *model.DepthDistribution) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, depthDistributionImplementors)
+func (ec *executionContext) _EmployeeAssignmentViolation(ctx context.Context, sel ast.SelectionSet, obj *model.EmployeeAssignmentViolation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, employeeAssignmentViolationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DepthDistribution")
-		case "depth":
-			out.Values[i] = ec._DepthDistribution_depth(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("EmployeeAssignmentViolation")
+		case "rule":
+			out.Values[i] = ec._EmployeeAssignmentViolation_rule(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "count":
-			out.Values[i] = ec._DepthDistribution_count(ctx, field, obj)
+		case "employeeId":
+			out.Values[i] = ec._EmployeeAssignmentViolation_employeeId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var depthViolationImplementors = []string{"DepthViolation"}
-
-func (ec *executionContext) _DepthViolation(ctx context.Context, sel ast.SelectionSet, obj *model.DepthViolation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, depthViolationImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DepthViolation")
-		case "code":
-			out.Values[i] = ec._DepthViolation_code(ctx, field, obj)
+		case "employeeName":
+			out.Values[i] = ec._EmployeeAssignmentViolation_employeeName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "currentDepth":
-			out.Values[i] = ec._DepthViolation_currentDepth(ctx, field, obj)
+		case "organizationCode":
+			out.Values[i] = ec._EmployeeAssignmentViolation_organizationCode(ctx, field, obj)
+		case "totalFte":
+			out.Values[i] = ec._EmployeeAssignmentViolation_totalFte(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "maxAllowedDepth":
-			out.Values[i] = ec._DepthViolation_maxAllowedDepth(ctx, field, obj)
+		case "maxFte":
+			out.Values[i] = ec._EmployeeAssignmentViolation_maxFte(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "parentChain":
-			out.Values[i] = ec._DepthViolation_parentChain(ctx, field, obj)
+		case "assignmentIds":
+			out.Values[i] = ec._EmployeeAssignmentViolation_assignmentIds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "positionCodes":
+			out.Values[i] = ec._EmployeeAssignmentViolation_positionCodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "asOfDate":
+			out.Values[i] = ec._EmployeeAssignmentViolation_asOfDate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -26298,6 +34042,11 @@ func (ec *executionContext) _JobFamily(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "nameI18n":
+			out.Values[i] = ec._JobFamily_nameI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "status":
 			out.Values[i] = ec._JobFamily_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -26361,6 +34110,11 @@ func (ec *executionContext) _JobFamilyGroup(ctx context.Context, sel ast.Selecti
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "nameI18n":
+			out.Values[i] = ec._JobFamilyGroup_nameI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "status":
 			out.Values[i] = ec._JobFamilyGroup_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -26412,42 +34166,266 @@ func (ec *executionContext) _JobLevel(ctx context.Context, sel ast.SelectionSet,
 		case "code":
 			out.Values[i] = ec._JobLevel_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "recordId":
 			out.Values[i] = ec._JobLevel_recordId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "roleCode":
 			out.Values[i] = ec._JobLevel_roleCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "name":
 			out.Values[i] = ec._JobLevel_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "nameI18n":
+			out.Values[i] = ec._JobLevel_nameI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "status":
 			out.Values[i] = ec._JobLevel_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "effectiveDate":
 			out.Values[i] = ec._JobLevel_effectiveDate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "endDate":
 			out.Values[i] = ec._JobLevel_endDate(ctx, field, obj)
 		case "levelRank":
 			out.Values[i] = ec._JobLevel_levelRank(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "description":
 			out.Values[i] = ec._JobLevel_description(ctx, field, obj)
+		case "compensationGrades":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._JobLevel_compensationGrades(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var jobRoleImplementors = []string{"JobRole"}
+
+func (ec *executionContext) _JobRole(ctx context.Context, sel ast.SelectionSet, obj *model.JobRole) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jobRoleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JobRole")
+		case "code":
+			out.Values[i] = ec._JobRole_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordId":
+			out.Values[i] = ec._JobRole_recordId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "familyCode":
+			out.Values[i] = ec._JobRole_familyCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._JobRole_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nameI18n":
+			out.Values[i] = ec._JobRole_nameI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._JobRole_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectiveDate":
+			out.Values[i] = ec._JobRole_effectiveDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._JobRole_endDate(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._JobRole_description(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var levelHeadcountImplementors = []string{"LevelHeadcount"}
+
+func (ec *executionContext) _LevelHeadcount(ctx context.Context, sel ast.SelectionSet, obj *model.LevelHeadcount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, levelHeadcountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LevelHeadcount")
+		case "jobLevelCode":
+			out.Values[i] = ec._LevelHeadcount_jobLevelCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "capacity":
+			out.Values[i] = ec._LevelHeadcount_capacity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "utilized":
+			out.Values[i] = ec._LevelHeadcount_utilized(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "available":
+			out.Values[i] = ec._LevelHeadcount_available(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var levelInconsistencyImplementors = []string{"LevelInconsistency"}
+
+func (ec *executionContext) _LevelInconsistency(ctx context.Context, sel ast.SelectionSet, obj *model.LevelInconsistency) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, levelInconsistencyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LevelInconsistency")
+		case "code":
+			out.Values[i] = ec._LevelInconsistency_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expectedLevel":
+			out.Values[i] = ec._LevelInconsistency_expectedLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actualLevel":
+			out.Values[i] = ec._LevelInconsistency_actualLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "parentCode":
+			out.Values[i] = ec._LevelInconsistency_parentCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._LevelInconsistency_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -26471,51 +34449,27 @@ func (ec *executionContext) _JobLevel(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var jobRoleImplementors = []string{"JobRole"}
+var levelStatisticImplementors = []string{"LevelStatistic"}
 
-func (ec *executionContext) _JobRole(ctx context.Context, sel ast.SelectionSet, obj *model.JobRole) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jobRoleImplementors)
+func (ec *executionContext) _LevelStatistic(ctx context.Context, sel ast.SelectionSet, obj *model.LevelStatistic) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, levelStatisticImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("JobRole")
-		case "code":
-			out.Values[i] = ec._JobRole_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "recordId":
-			out.Values[i] = ec._JobRole_recordId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "familyCode":
-			out.Values[i] = ec._JobRole_familyCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._JobRole_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._JobRole_status(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LevelStatistic")
+		case "level":
+			out.Values[i] = ec._LevelStatistic_level(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "effectiveDate":
-			out.Values[i] = ec._JobRole_effectiveDate(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._LevelStatistic_count(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "endDate":
-			out.Values[i] = ec._JobRole_endDate(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec._JobRole_description(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -26539,34 +34493,24 @@ func (ec *executionContext) _JobRole(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var levelHeadcountImplementors = []string{"LevelHeadcount"}
+var localizedTextImplementors = []string{"LocalizedText"}
 
-func (ec *executionContext) _LevelHeadcount(ctx context.Context, sel ast.SelectionSet, obj *model.LevelHeadcount) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, levelHeadcountImplementors)
+func (ec *executionContext) _LocalizedText(ctx context.Context, sel ast.SelectionSet, obj *model.LocalizedText) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, localizedTextImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LevelHeadcount")
-		case "jobLevelCode":
-			out.Values[i] = ec._LevelHeadcount_jobLevelCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "capacity":
-			out.Values[i] = ec._LevelHeadcount_capacity(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "utilized":
-			out.Values[i] = ec._LevelHeadcount_utilized(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LocalizedText")
+		case "locale":
+			out.Values[i] = ec._LocalizedText_locale(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "available":
-			out.Values[i] = ec._LevelHeadcount_available(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._LocalizedText_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -26593,39 +34537,59 @@ func (ec *executionContext) _LevelHeadcount(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var levelInconsistencyImplementors = []string{"LevelInconsistency"}
+var locationImplementors = []string{"Location"}
 
-func (ec *executionContext) _LevelInconsistency(ctx context.Context, sel ast.SelectionSet, obj *model.LevelInconsistency) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, levelInconsistencyImplementors)
+func (ec *executionContext) _Location(ctx context.Context, sel ast.SelectionSet, obj *model.Location) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, locationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LevelInconsistency")
+			out.Values[i] = graphql.MarshalString("Location")
 		case "code":
-			out.Values[i] = ec._LevelInconsistency_code(ctx, field, obj)
+			out.Values[i] = ec._Location_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "expectedLevel":
-			out.Values[i] = ec._LevelInconsistency_expectedLevel(ctx, field, obj)
+		case "recordId":
+			out.Values[i] = ec._Location_recordId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "actualLevel":
-			out.Values[i] = ec._LevelInconsistency_actualLevel(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Location_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Location_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Location_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "parentCode":
-			out.Values[i] = ec._LevelInconsistency_parentCode(ctx, field, obj)
+			out.Values[i] = ec._Location_parentCode(ctx, field, obj)
+		case "countryCode":
+			out.Values[i] = ec._Location_countryCode(ctx, field, obj)
+		case "timeZone":
+			out.Values[i] = ec._Location_timeZone(ctx, field, obj)
+		case "address":
+			out.Values[i] = ec._Location_address(ctx, field, obj)
+		case "effectiveDate":
+			out.Values[i] = ec._Location_effectiveDate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "reason":
-			out.Values[i] = ec._LevelInconsistency_reason(ctx, field, obj)
+		case "endDate":
+			out.Values[i] = ec._Location_endDate(ctx, field, obj)
+		case "isCurrent":
+			out.Values[i] = ec._Location_isCurrent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -26652,24 +34616,50 @@ func (ec *executionContext) _LevelInconsistency(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var levelStatisticImplementors = []string{"LevelStatistic"}
+var locationHeadcountImplementors = []string{"LocationHeadcount"}
 
-func (ec *executionContext) _LevelStatistic(ctx context.Context, sel ast.SelectionSet, obj *model.LevelStatistic) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, levelStatisticImplementors)
+func (ec *executionContext) _LocationHeadcount(ctx context.Context, sel ast.SelectionSet, obj *model.LocationHeadcount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, locationHeadcountImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LevelStatistic")
-		case "level":
-			out.Values[i] = ec._LevelStatistic_level(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LocationHeadcount")
+		case "locationCode":
+			out.Values[i] = ec._LocationHeadcount_locationCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "count":
-			out.Values[i] = ec._LevelStatistic_count(ctx, field, obj)
+		case "locationName":
+			out.Values[i] = ec._LocationHeadcount_locationName(ctx, field, obj)
+		case "countryCode":
+			out.Values[i] = ec._LocationHeadcount_countryCode(ctx, field, obj)
+		case "timeZone":
+			out.Values[i] = ec._LocationHeadcount_timeZone(ctx, field, obj)
+		case "positionCount":
+			out.Values[i] = ec._LocationHeadcount_positionCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headcountCapacity":
+			out.Values[i] = ec._LocationHeadcount_headcountCapacity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "headcountInUse":
+			out.Values[i] = ec._LocationHeadcount_headcountInUse(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assignedFte":
+			out.Values[i] = ec._LocationHeadcount_assignedFte(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableHeadcount":
+			out.Values[i] = ec._LocationHeadcount_availableHeadcount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -26813,49 +34803,59 @@ func (ec *executionContext) _Organization(ctx context.Context, sel ast.Selection
 		case "code":
 			out.Values[i] = ec._Organization_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "parentCode":
 			out.Values[i] = ec._Organization_parentCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "tenantId":
 			out.Values[i] = ec._Organization_tenantId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "name":
 			out.Values[i] = ec._Organization_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "unitType":
 			out.Values[i] = ec._Organization_unitType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "status":
 			out.Values[i] = ec._Organization_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "level":
 			out.Values[i] = ec._Organization_level(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "sortOrder":
 			out.Values[i] = ec._Organization_sortOrder(ctx, field, obj)
 		case "codePath":
 			out.Values[i] = ec._Organization_codePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "namePath":
 			out.Values[i] = ec._Organization_namePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "nameI18n":
+			out.Values[i] = ec._Organization_nameI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "namePathI18n":
+			out.Values[i] = ec._Organization_namePathI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "path":
 			out.Values[i] = ec._Organization_path(ctx, field, obj)
@@ -26868,49 +34868,49 @@ func (ec *executionContext) _Organization(ctx context.Context, sel ast.Selection
 		case "effectiveDate":
 			out.Values[i] = ec._Organization_effectiveDate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "endDate":
 			out.Values[i] = ec._Organization_endDate(ctx, field, obj)
 		case "createdAt":
 			out.Values[i] = ec._Organization_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "updatedAt":
 			out.Values[i] = ec._Organization_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "recordId":
 			out.Values[i] = ec._Organization_recordId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "isCurrent":
 			out.Values[i] = ec._Organization_isCurrent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "isTemporal":
 			out.Values[i] = ec._Organization_isTemporal(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "isFuture":
 			out.Values[i] = ec._Organization_isFuture(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "hierarchyDepth":
 			out.Values[i] = ec._Organization_hierarchyDepth(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "childrenCount":
 			out.Values[i] = ec._Organization_childrenCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "deletedAt":
 			out.Values[i] = ec._Organization_deletedAt(ctx, field, obj)
@@ -26924,6 +34924,44 @@ func (ec *executionContext) _Organization(ctx context.Context, sel ast.Selection
 			out.Values[i] = ec._Organization_suspendedBy(ctx, field, obj)
 		case "suspensionReason":
 			out.Values[i] = ec._Organization_suspensionReason(ctx, field, obj)
+		case "customFields":
+			out.Values[i] = ec._Organization_customFields(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "location":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Organization_location(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -27164,93 +35202,157 @@ func (ec *executionContext) _OrganizationStats(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var orphanedNodeImplementors = []string{"OrphanedNode"}
+var orphanedNodeImplementors = []string{"OrphanedNode"}
+
+func (ec *executionContext) _OrphanedNode(ctx context.Context, sel ast.SelectionSet, obj *model.OrphanedNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orphanedNodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrphanedNode")
+		case "code":
+			out.Values[i] = ec._OrphanedNode_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._OrphanedNode_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "parentCode":
+			out.Values[i] = ec._OrphanedNode_parentCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._OrphanedNode_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var paginationInfoImplementors = []string{"PaginationInfo"}
+
+func (ec *executionContext) _PaginationInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PaginationInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paginationInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PaginationInfo")
+		case "total":
+			out.Values[i] = ec._PaginationInfo_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "page":
+			out.Values[i] = ec._PaginationInfo_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageSize":
+			out.Values[i] = ec._PaginationInfo_pageSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasNext":
+			out.Values[i] = ec._PaginationInfo_hasNext(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPrevious":
+			out.Values[i] = ec._PaginationInfo_hasPrevious(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pathMismatchImplementors = []string{"PathMismatch"}
 
-func (ec *executionContext) _OrphanedNode(ctx context.Context, sel ast.SelectionSet, obj *model.OrphanedNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orphanedNodeImplementors)
+func (ec *executionContext) _PathMismatch(ctx context.Context, sel ast.SelectionSet, obj *model.PathMismatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pathMismatchImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrphanedNode")
+			out.Values[i] = graphql.MarshalString("PathMismatch")
 		case "code":
-			out.Values[i] = ec._OrphanedNode_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._OrphanedNode_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "parentCode":
-			out.Values[i] = ec._OrphanedNode_parentCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "reason":
-			out.Values[i] = ec._OrphanedNode_reason(ctx, field, obj)
+			out.Values[i] = ec._PathMismatch_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var paginationInfoImplementors = []string{"PaginationInfo"}
-
-func (ec *executionContext) _PaginationInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PaginationInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paginationInfoImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaginationInfo")
-		case "total":
-			out.Values[i] = ec._PaginationInfo_total(ctx, field, obj)
+		case "expectedCodePath":
+			out.Values[i] = ec._PathMismatch_expectedCodePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "page":
-			out.Values[i] = ec._PaginationInfo_page(ctx, field, obj)
+		case "actualCodePath":
+			out.Values[i] = ec._PathMismatch_actualCodePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageSize":
-			out.Values[i] = ec._PaginationInfo_pageSize(ctx, field, obj)
+		case "expectedNamePath":
+			out.Values[i] = ec._PathMismatch_expectedNamePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "hasNext":
-			out.Values[i] = ec._PaginationInfo_hasNext(ctx, field, obj)
+		case "actualNamePath":
+			out.Values[i] = ec._PathMismatch_actualNamePath(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "hasPrevious":
-			out.Values[i] = ec._PaginationInfo_hasPrevious(ctx, field, obj)
+		case "severity":
+			out.Values[i] = ec._PathMismatch_severity(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -27277,44 +35379,39 @@ func (ec *executionContext) _PaginationInfo(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var pathMismatchImplementors = []string{"PathMismatch"}
+var payRangeImplementors = []string{"PayRange"}
 
-func (ec *executionContext) _PathMismatch(ctx context.Context, sel ast.SelectionSet, obj *model.PathMismatch) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pathMismatchImplementors)
+func (ec *executionContext) _PayRange(ctx context.Context, sel ast.SelectionSet, obj *model.PayRange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, payRangeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PathMismatch")
-		case "code":
-			out.Values[i] = ec._PathMismatch_code(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PayRange")
+		case "currency":
+			out.Values[i] = ec._PayRange_currency(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "expectedCodePath":
-			out.Values[i] = ec._PathMismatch_expectedCodePath(ctx, field, obj)
+		case "region":
+			out.Values[i] = ec._PayRange_region(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "actualCodePath":
-			out.Values[i] = ec._PathMismatch_actualCodePath(ctx, field, obj)
+		case "min":
+			out.Values[i] = ec._PayRange_min(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "expectedNamePath":
-			out.Values[i] = ec._PathMismatch_expectedNamePath(ctx, field, obj)
+		case "mid":
+			out.Values[i] = ec._PayRange_mid(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "actualNamePath":
-			out.Values[i] = ec._PathMismatch_actualNamePath(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "severity":
-			out.Values[i] = ec._PathMismatch_severity(ctx, field, obj)
+		case "max":
+			out.Values[i] = ec._PayRange_max(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -27355,22 +35452,27 @@ func (ec *executionContext) _Position(ctx context.Context, sel ast.SelectionSet,
 		case "code":
 			out.Values[i] = ec._Position_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "recordId":
 			out.Values[i] = ec._Position_recordId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "tenantId":
 			out.Values[i] = ec._Position_tenantId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "title":
 			out.Values[i] = ec._Position_title(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "titleI18n":
+			out.Values[i] = ec._Position_titleI18n(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "jobProfileCode":
 			out.Values[i] = ec._Position_jobProfileCode(ctx, field, obj)
@@ -27379,98 +35481,205 @@ func (ec *executionContext) _Position(ctx context.Context, sel ast.SelectionSet,
 		case "jobFamilyGroupCode":
 			out.Values[i] = ec._Position_jobFamilyGroupCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "jobFamilyCode":
 			out.Values[i] = ec._Position_jobFamilyCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "jobRoleCode":
 			out.Values[i] = ec._Position_jobRoleCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "jobLevelCode":
 			out.Values[i] = ec._Position_jobLevelCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "organizationCode":
 			out.Values[i] = ec._Position_organizationCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "organizationName":
 			out.Values[i] = ec._Position_organizationName(ctx, field, obj)
 		case "positionType":
 			out.Values[i] = ec._Position_positionType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "employmentType":
 			out.Values[i] = ec._Position_employmentType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "gradeLevel":
 			out.Values[i] = ec._Position_gradeLevel(ctx, field, obj)
 		case "headcountCapacity":
 			out.Values[i] = ec._Position_headcountCapacity(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "headcountInUse":
 			out.Values[i] = ec._Position_headcountInUse(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "availableHeadcount":
 			out.Values[i] = ec._Position_availableHeadcount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "currentAssignment":
 			out.Values[i] = ec._Position_currentAssignment(ctx, field, obj)
 		case "assignmentHistory":
 			out.Values[i] = ec._Position_assignmentHistory(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "reportsToPositionCode":
 			out.Values[i] = ec._Position_reportsToPositionCode(ctx, field, obj)
 		case "status":
 			out.Values[i] = ec._Position_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "effectiveDate":
 			out.Values[i] = ec._Position_effectiveDate(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "endDate":
 			out.Values[i] = ec._Position_endDate(ctx, field, obj)
 		case "isCurrent":
 			out.Values[i] = ec._Position_isCurrent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "isFuture":
 			out.Values[i] = ec._Position_isFuture(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "createdAt":
 			out.Values[i] = ec._Position_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "updatedAt":
 			out.Values[i] = ec._Position_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "customFields":
+			out.Values[i] = ec._Position_customFields(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "compensationGrade":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Position_compensationGrade(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "costAllocations":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Position_costAllocations(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "location":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Position_location(ctx, field, obj)
+				return res
 			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -28152,6 +36361,80 @@ func (ec *executionContext) _PositionTransferEdge(ctx context.Context, sel ast.S
 	return out
 }
 
+var positionTransitionImplementors = []string{"PositionTransition"}
+
+func (ec *executionContext) _PositionTransition(ctx context.Context, sel ast.SelectionSet, obj *model.PositionTransition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, positionTransitionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PositionTransition")
+		case "event":
+			out.Values[i] = ec._PositionTransition_event(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fromStatus":
+			out.Values[i] = ec._PositionTransition_fromStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "toStatus":
+			out.Values[i] = ec._PositionTransition_toStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allowed":
+			out.Values[i] = ec._PositionTransition_allowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "guards":
+			out.Values[i] = ec._PositionTransition_guards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "failedGuards":
+			out.Values[i] = ec._PositionTransition_failedGuards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sideEffects":
+			out.Values[i] = ec._PositionTransition_sideEffects(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "builtIn":
+			out.Values[i] = ec._PositionTransition_builtIn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -28491,6 +36774,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "employeeAssignmentViolations":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_employeeAssignmentViolations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "vacantPositions":
 			field := field
@@ -28557,6 +36862,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "positionAllowedTransitions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_positionAllowedTransitions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "auditHistory":
 			field := field
@@ -28708,6 +37035,116 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "compensationGrades":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_compensationGrades(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "costCenters":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_costCenters(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "costCenterHeadcountStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_costCenterHeadcountStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "locations":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_locations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "locationHeadcountStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_locationHeadcountStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -29705,7 +38142,277 @@ func (ec *executionContext) marshalNCacheInconsistency2ᚕcubeᚑcastleᚋcmdᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCacheInconsistency2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCacheInconsistency(ctx, sel, v[i])
+			ret[i] = ec.marshalNCacheInconsistency2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCacheInconsistency(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCircularReference2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReference(ctx context.Context, sel ast.SelectionSet, v model.CircularReference) graphql.Marshaler {
+	return ec._CircularReference(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReferenceᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CircularReference) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCircularReference2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReference(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCompensationGrade2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGrade(ctx context.Context, sel ast.SelectionSet, v model.CompensationGrade) graphql.Marshaler {
+	return ec._CompensationGrade(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCompensationGrade2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CompensationGrade) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCompensationGrade2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGrade(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNCompensationGradeStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeStatus(ctx context.Context, v interface{}) (model.CompensationGradeStatus, error) {
+	var res model.CompensationGradeStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCompensationGradeStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeStatus(ctx context.Context, sel ast.SelectionSet, v model.CompensationGradeStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx context.Context, v interface{}) (model.ConsistencyCheckMode, error) {
+	var res model.ConsistencyCheckMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx context.Context, sel ast.SelectionSet, v model.ConsistencyCheckMode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNConsistencyFindings2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyFindings(ctx context.Context, sel ast.SelectionSet, v *model.ConsistencyFindings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConsistencyFindings(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCostAllocation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostAllocation(ctx context.Context, sel ast.SelectionSet, v model.CostAllocation) graphql.Marshaler {
+	return ec._CostAllocation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCostAllocation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostAllocationᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CostAllocation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCostAllocation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostAllocation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCostCenter2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenter(ctx context.Context, sel ast.SelectionSet, v model.CostCenter) graphql.Marshaler {
+	return ec._CostCenter(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCostCenter2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CostCenter) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCostCenter2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCostCenterHeadcount2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterHeadcount(ctx context.Context, sel ast.SelectionSet, v model.CostCenterHeadcount) graphql.Marshaler {
+	return ec._CostCenterHeadcount(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCostCenterHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterHeadcountᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CostCenterHeadcount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCostCenterHeadcount2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterHeadcount(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -29725,11 +38432,36 @@ func (ec *executionContext) marshalNCacheInconsistency2ᚕcubeᚑcastleᚋcmdᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNCircularReference2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReference(ctx context.Context, sel ast.SelectionSet, v model.CircularReference) graphql.Marshaler {
-	return ec._CircularReference(ctx, sel, &v)
+func (ec *executionContext) unmarshalNCostCenterStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterStatus(ctx context.Context, v interface{}) (model.CostCenterStatus, error) {
+	var res model.CostCenterStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReferenceᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CircularReference) graphql.Marshaler {
+func (ec *executionContext) marshalNCostCenterStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCostCenterStatus(ctx context.Context, sel ast.SelectionSet, v model.CostCenterStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNCustomFieldFilterInput2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterInput(ctx context.Context, v interface{}) (model.CustomFieldFilterInput, error) {
+	res, err := ec.unmarshalInputCustomFieldFilterInput(ctx, v)
+	return *res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCustomFieldType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldType(ctx context.Context, v interface{}) (model.CustomFieldType, error) {
+	var res model.CustomFieldType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCustomFieldType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldType(ctx context.Context, sel ast.SelectionSet, v model.CustomFieldType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNCustomFieldValue2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldValue(ctx context.Context, sel ast.SelectionSet, v model.CustomFieldValue) graphql.Marshaler {
+	return ec._CustomFieldValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCustomFieldValue2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldValueᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CustomFieldValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -29753,7 +38485,7 @@ func (ec *executionContext) marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋh
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCircularReference2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCircularReference(ctx, sel, v[i])
+			ret[i] = ec.marshalNCustomFieldValue2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -29773,34 +38505,13 @@ func (ec *executionContext) marshalNCircularReference2ᚕcubeᚑcastleᚋcmdᚋh
 	return ret
 }
 
-func (ec *executionContext) unmarshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx context.Context, v interface{}) (model.ConsistencyCheckMode, error) {
-	var res model.ConsistencyCheckMode
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNConsistencyCheckMode2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyCheckMode(ctx context.Context, sel ast.SelectionSet, v model.ConsistencyCheckMode) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNConsistencyFindings2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐConsistencyFindings(ctx context.Context, sel ast.SelectionSet, v *model.ConsistencyFindings) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._ConsistencyFindings(ctx, sel, v)
-}
-
 func (ec *executionContext) unmarshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx context.Context, v interface{}) (dto.Date, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.Date(tmp)
+	res, err := dto.UnmarshalDate(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNDate2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx context.Context, sel ast.SelectionSet, v dto.Date) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalDate(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -29810,13 +38521,12 @@ func (ec *executionContext) marshalNDate2cubeᚑcastleᚋinternalᚋorganization
 }
 
 func (ec *executionContext) unmarshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx context.Context, v interface{}) (dto.DateTime, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.DateTime(tmp)
+	res, err := dto.UnmarshalDateTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNDateTime2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDateTime(ctx context.Context, sel ast.SelectionSet, v dto.DateTime) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalDateTime(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -29921,6 +38631,54 @@ func (ec *executionContext) marshalNDepthViolation2ᚕcubeᚑcastleᚋcmdᚋhrms
 	return ret
 }
 
+func (ec *executionContext) marshalNEmployeeAssignmentViolation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmployeeAssignmentViolation(ctx context.Context, sel ast.SelectionSet, v model.EmployeeAssignmentViolation) graphql.Marshaler {
+	return ec._EmployeeAssignmentViolation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEmployeeAssignmentViolation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmployeeAssignmentViolationᚄ(ctx context.Context, sel ast.SelectionSet, v []model.EmployeeAssignmentViolation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEmployeeAssignmentViolation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmployeeAssignmentViolation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNEmploymentType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmploymentType(ctx context.Context, v interface{}) (model.EmploymentType, error) {
 	var res model.EmploymentType
 	err := res.UnmarshalGQL(v)
@@ -30192,13 +38950,12 @@ func (ec *executionContext) marshalNJobFamily2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑse
 }
 
 func (ec *executionContext) unmarshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx context.Context, v interface{}) (dto.JobFamilyCode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.JobFamilyCode(tmp)
+	res, err := dto.UnmarshalJobFamilyCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNJobFamilyCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCode(ctx context.Context, sel ast.SelectionSet, v dto.JobFamilyCode) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalJobFamilyCode(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -30256,13 +39013,12 @@ func (ec *executionContext) marshalNJobFamilyGroup2ᚕcubeᚑcastleᚋcmdᚋhrms
 }
 
 func (ec *executionContext) unmarshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx context.Context, v interface{}) (dto.JobFamilyGroupCode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.JobFamilyGroupCode(tmp)
+	res, err := dto.UnmarshalJobFamilyGroupCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNJobFamilyGroupCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyGroupCode(ctx context.Context, sel ast.SelectionSet, v dto.JobFamilyGroupCode) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalJobFamilyGroupCode(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -30320,13 +39076,12 @@ func (ec *executionContext) marshalNJobLevel2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑser
 }
 
 func (ec *executionContext) unmarshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx context.Context, v interface{}) (dto.JobLevelCode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.JobLevelCode(tmp)
+	res, err := dto.UnmarshalJobLevelCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx context.Context, sel ast.SelectionSet, v dto.JobLevelCode) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalJobLevelCode(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -30335,6 +39090,38 @@ func (ec *executionContext) marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorga
 	return res
 }
 
+func (ec *executionContext) unmarshalNJobLevelCode2ᚕcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCodeᚄ(ctx context.Context, v interface{}) ([]dto.JobLevelCode, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]dto.JobLevelCode, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNJobLevelCode2ᚕcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCodeᚄ(ctx context.Context, sel ast.SelectionSet, v []dto.JobLevelCode) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNJobLevelCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNJobRole2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐJobRole(ctx context.Context, sel ast.SelectionSet, v model.JobRole) graphql.Marshaler {
 	return ec._JobRole(ctx, sel, &v)
 }
@@ -30384,13 +39171,12 @@ func (ec *executionContext) marshalNJobRole2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserv
 }
 
 func (ec *executionContext) unmarshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx context.Context, v interface{}) (dto.JobRoleCode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.JobRoleCode(tmp)
+	res, err := dto.UnmarshalJobRoleCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNJobRoleCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCode(ctx context.Context, sel ast.SelectionSet, v dto.JobRoleCode) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalJobRoleCode(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -30523,7 +39309,161 @@ func (ec *executionContext) marshalNLevelStatistic2ᚕcubeᚑcastleᚋcmdᚋhrms
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLevelStatistic2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelStatistic(ctx, sel, v[i])
+			ret[i] = ec.marshalNLevelStatistic2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLevelStatistic(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLocalizedText2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedText(ctx context.Context, sel ast.SelectionSet, v model.LocalizedText) graphql.Marshaler {
+	return ec._LocalizedText(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLocalizedText2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedTextᚄ(ctx context.Context, sel ast.SelectionSet, v []model.LocalizedText) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLocalizedText2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocalizedText(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLocation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx context.Context, sel ast.SelectionSet, v model.Location) graphql.Marshaler {
+	return ec._Location(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLocation2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Location) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLocation2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLocation2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx context.Context, sel ast.SelectionSet, v *model.Location) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Location(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNLocationHeadcount2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationHeadcount(ctx context.Context, sel ast.SelectionSet, v model.LocationHeadcount) graphql.Marshaler {
+	return ec._LocationHeadcount(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLocationHeadcount2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationHeadcountᚄ(ctx context.Context, sel ast.SelectionSet, v []model.LocationHeadcount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLocationHeadcount2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationHeadcount(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -30543,6 +39483,36 @@ func (ec *executionContext) marshalNLevelStatistic2ᚕcubeᚑcastleᚋcmdᚋhrms
 	return ret
 }
 
+func (ec *executionContext) unmarshalNLocationSource2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationSource(ctx context.Context, v interface{}) (model.LocationSource, error) {
+	var res model.LocationSource
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLocationSource2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationSource(ctx context.Context, sel ast.SelectionSet, v model.LocationSource) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNLocationStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationStatus(ctx context.Context, v interface{}) (model.LocationStatus, error) {
+	var res model.LocationStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLocationStatus2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationStatus(ctx context.Context, sel ast.SelectionSet, v model.LocationStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNLocationType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx context.Context, v interface{}) (model.LocationType, error) {
+	var res model.LocationType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLocationType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx context.Context, sel ast.SelectionSet, v model.LocationType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNOperatedBy2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐOperatedBy(ctx context.Context, sel ast.SelectionSet, v *model.OperatedBy) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -30783,6 +39753,54 @@ func (ec *executionContext) marshalNPathMismatch2ᚕcubeᚑcastleᚋcmdᚋhrms
 	return ret
 }
 
+func (ec *executionContext) marshalNPayRange2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPayRange(ctx context.Context, sel ast.SelectionSet, v model.PayRange) graphql.Marshaler {
+	return ec._PayRange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPayRange2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPayRangeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PayRange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPayRange2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPayRange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNPosition2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPosition(ctx context.Context, sel ast.SelectionSet, v model.Position) graphql.Marshaler {
 	return ec._Position(ctx, sel, &v)
 }
@@ -31059,13 +40077,12 @@ func (ec *executionContext) marshalNPositionAssignmentType2cubeᚑcastleᚋcmd
 }
 
 func (ec *executionContext) unmarshalNPositionCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐPositionCode(ctx context.Context, v interface{}) (dto.PositionCode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.PositionCode(tmp)
+	res, err := dto.UnmarshalPositionCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNPositionCode2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐPositionCode(ctx context.Context, sel ast.SelectionSet, v dto.PositionCode) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalPositionCode(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -31339,6 +40356,54 @@ func (ec *executionContext) marshalNPositionTransferEdge2ᚕcubeᚑcastleᚋcmd
 	return ret
 }
 
+func (ec *executionContext) marshalNPositionTransition2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransition(ctx context.Context, sel ast.SelectionSet, v model.PositionTransition) graphql.Marshaler {
+	return ec._PositionTransition(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPositionTransition2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransitionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PositionTransition) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPositionTransition2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionTransition(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNPositionType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐPositionType(ctx context.Context, v interface{}) (model.PositionType, error) {
 	var res model.PositionType
 	err := res.UnmarshalGQL(v)
@@ -31629,13 +40694,12 @@ func (ec *executionContext) marshalNTypeStatistic2ᚕcubeᚑcastleᚋcmdᚋhrms
 }
 
 func (ec *executionContext) unmarshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx context.Context, v interface{}) (dto.UUID, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.UUID(tmp)
+	res, err := dto.UnmarshalUUID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNUUID2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUUID(ctx context.Context, sel ast.SelectionSet, v dto.UUID) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
+	res := dto.MarshalUUID(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -31644,14 +40708,19 @@ func (ec *executionContext) marshalNUUID2cubeᚑcastleᚋinternalᚋorganization
 	return res
 }
 
-func (ec *executionContext) unmarshalNUnitType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx context.Context, v interface{}) (model.UnitType, error) {
-	var res model.UnitType
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalNUnitType2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx context.Context, v interface{}) (dto.UnitTypeCode, error) {
+	res, err := dto.UnmarshalUnitTypeCode(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNUnitType2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx context.Context, sel ast.SelectionSet, v model.UnitType) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNUnitType2cubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx context.Context, sel ast.SelectionSet, v dto.UnitTypeCode) graphql.Marshaler {
+	res := dto.MarshalUnitTypeCode(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
 func (ec *executionContext) marshalNVacantPosition2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPosition(ctx context.Context, sel ast.SelectionSet, v model.VacantPosition) graphql.Marshaler {
@@ -31754,7 +40823,146 @@ func (ec *executionContext) marshalNVacantPositionEdge2ᚕcubeᚑcastleᚋcmdᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNVacantPositionEdge2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionEdge(ctx, sel, v[i])
+			ret[i] = ec.marshalNVacantPositionEdge2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNVacantPositionSortField2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortField(ctx context.Context, v interface{}) (model.VacantPositionSortField, error) {
+	var res model.VacantPositionSortField
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVacantPositionSortField2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortField(ctx context.Context, sel ast.SelectionSet, v model.VacantPositionSortField) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNVacantPositionSortInput2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortInput(ctx context.Context, v interface{}) (model.VacantPositionSortInput, error) {
+	res, err := ec.unmarshalInputVacantPositionSortInput(ctx, v)
+	return *res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -31774,26 +40982,19 @@ func (ec *executionContext) marshalNVacantPositionEdge2ᚕcubeᚑcastleᚋcmdᚋ
 	return ret
 }
 
-func (ec *executionContext) unmarshalNVacantPositionSortField2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortField(ctx context.Context, v interface{}) (model.VacantPositionSortField, error) {
-	var res model.VacantPositionSortField
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNVacantPositionSortField2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortField(ctx context.Context, sel ast.SelectionSet, v model.VacantPositionSortField) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNVacantPositionSortInput2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionSortInput(ctx context.Context, v interface{}) (model.VacantPositionSortInput, error) {
-	res, err := ec.unmarshalInputVacantPositionSortInput(ctx, v)
-	return *res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -31817,7 +41018,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -31837,39 +41038,11 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -31893,7 +41066,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -31913,67 +41086,75 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) marshalOAddress2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAddress(ctx context.Context, sel ast.SelectionSet, v *model.Address) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
+	return ec._Address(ctx, sel, v)
+}
 
+func (ec *executionContext) marshalOAuditLogDetail2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAuditLogDetail(ctx context.Context, sel ast.SelectionSet, v *model.AuditLogDetail) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	wg.Wait()
+	return ec._AuditLogDetail(ctx, sel, v)
+}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return ret
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	return res
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalBoolean(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOCompensationGrade2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGradeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.CompensationGrade) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -31997,7 +41178,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNCompensationGrade2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGrade(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -32017,70 +41198,54 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOCompensationGrade2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCompensationGrade(ctx context.Context, sel ast.SelectionSet, v *model.CompensationGrade) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+	return ec._CompensationGrade(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOAuditLogDetail2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐAuditLogDetail(ctx context.Context, sel ast.SelectionSet, v *model.AuditLogDetail) graphql.Marshaler {
+func (ec *executionContext) unmarshalOCustomFieldFilterInput2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterInputᚄ(ctx context.Context, v interface{}) ([]model.CustomFieldFilterInput, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	return ec._AuditLogDetail(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	return res
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]model.CustomFieldFilterInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCustomFieldFilterInput2cubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+func (ec *executionContext) unmarshalOCustomFieldFilterOperator2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterOperator(ctx context.Context, v interface{}) (*model.CustomFieldFilterOperator, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	var res = new(model.CustomFieldFilterOperator)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOCustomFieldFilterOperator2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐCustomFieldFilterOperator(ctx context.Context, sel ast.SelectionSet, v *model.CustomFieldFilterOperator) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalBoolean(*v)
-	return res
+	return v
 }
 
 func (ec *executionContext) unmarshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐDate(ctx context.Context, v interface{}) (*dto.Date, error) {
 	if v == nil {
 		return nil, nil
 	}
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.Date(tmp)
+	res, err := dto.UnmarshalDate(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -32088,7 +41253,7 @@ func (ec *executionContext) marshalODate2ᚖcubeᚑcastleᚋinternalᚋorganizat
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalString(string(*v))
+	res := dto.MarshalDate(*v)
 	return res
 }
 
@@ -32104,8 +41269,7 @@ func (ec *executionContext) unmarshalODateTime2ᚖcubeᚑcastleᚋinternalᚋorg
 	if v == nil {
 		return nil, nil
 	}
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.DateTime(tmp)
+	res, err := dto.UnmarshalDateTime(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -32113,10 +41277,17 @@ func (ec *executionContext) marshalODateTime2ᚖcubeᚑcastleᚋinternalᚋorgan
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalString(string(*v))
+	res := dto.MarshalDateTime(*v)
 	return res
 }
 
+func (ec *executionContext) marshalOEffectiveLocation2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEffectiveLocation(ctx context.Context, sel ast.SelectionSet, v *model.EffectiveLocation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._EffectiveLocation(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOEmploymentType2ᚕcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐEmploymentTypeᚄ(ctx context.Context, v interface{}) ([]model.EmploymentType, error) {
 	if v == nil {
 		return nil, nil
@@ -32184,6 +41355,22 @@ func (ec *executionContext) marshalOEmploymentType2ᚕcubeᚑcastleᚋcmdᚋhrms
 	return ret
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -32204,7 +41391,7 @@ func (ec *executionContext) unmarshalOJSON2cubeᚑcastleᚋinternalᚋorganizati
 	if v == nil {
 		return nil, nil
 	}
-	res, err := ec.unmarshalInputJSON(ctx, v)
+	res, err := dto.UnmarshalJSON(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -32212,7 +41399,8 @@ func (ec *executionContext) marshalOJSON2cubeᚑcastleᚋinternalᚋorganization
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._JSON(ctx, sel, v)
+	res := dto.MarshalJSON(v)
+	return res
 }
 
 func (ec *executionContext) unmarshalOJobFamilyCode2ᚕcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobFamilyCodeᚄ(ctx context.Context, v interface{}) ([]dto.JobFamilyCode, error) {
@@ -32329,6 +41517,22 @@ func (ec *executionContext) marshalOJobLevelCode2ᚕcubeᚑcastleᚋinternalᚋo
 	return ret
 }
 
+func (ec *executionContext) unmarshalOJobLevelCode2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx context.Context, v interface{}) (*dto.JobLevelCode, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := dto.UnmarshalJobLevelCode(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOJobLevelCode2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobLevelCode(ctx context.Context, sel ast.SelectionSet, v *dto.JobLevelCode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := dto.MarshalJobLevelCode(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOJobRoleCode2ᚕcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐJobRoleCodeᚄ(ctx context.Context, v interface{}) ([]dto.JobRoleCode, error) {
 	if v == nil {
 		return nil, nil
@@ -32367,6 +41571,22 @@ func (ec *executionContext) marshalOJobRoleCode2ᚕcubeᚑcastleᚋinternalᚋor
 	return ret
 }
 
+func (ec *executionContext) unmarshalOLocationType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx context.Context, v interface{}) (*model.LocationType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.LocationType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOLocationType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐLocationType(ctx context.Context, sel ast.SelectionSet, v *model.LocationType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOOperationType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐOperationType(ctx context.Context, v interface{}) (*model.OperationType, error) {
 	if v == nil {
 		return nil, nil
@@ -32596,8 +41816,7 @@ func (ec *executionContext) unmarshalOPositionCode2ᚖcubeᚑcastleᚋinternal
 	if v == nil {
 		return nil, nil
 	}
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.PositionCode(tmp)
+	res, err := dto.UnmarshalPositionCode(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -32605,7 +41824,7 @@ func (ec *executionContext) marshalOPositionCode2ᚖcubeᚑcastleᚋinternalᚋo
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalString(string(*v))
+	res := dto.MarshalPositionCode(*v)
 	return res
 }
 
@@ -32877,8 +42096,7 @@ func (ec *executionContext) unmarshalOUUID2ᚖcubeᚑcastleᚋinternalᚋorganiz
 	if v == nil {
 		return nil, nil
 	}
-	tmp, err := graphql.UnmarshalString(v)
-	res := dto.UUID(tmp)
+	res, err := dto.UnmarshalUUID(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -32886,24 +42104,24 @@ func (ec *executionContext) marshalOUUID2ᚖcubeᚑcastleᚋinternalᚋorganizat
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalString(string(*v))
+	res := dto.MarshalUUID(*v)
 	return res
 }
 
-func (ec *executionContext) unmarshalOUnitType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx context.Context, v interface{}) (*model.UnitType, error) {
+func (ec *executionContext) unmarshalOUnitType2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx context.Context, v interface{}) (*dto.UnitTypeCode, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(model.UnitType)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	res, err := dto.UnmarshalUnitTypeCode(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOUnitType2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐUnitType(ctx context.Context, sel ast.SelectionSet, v *model.UnitType) graphql.Marshaler {
+func (ec *executionContext) marshalOUnitType2ᚖcubeᚑcastleᚋinternalᚋorganizationᚋdtoᚐUnitTypeCode(ctx context.Context, sel ast.SelectionSet, v *dto.UnitTypeCode) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	res := dto.MarshalUnitTypeCode(*v)
+	return res
 }
 
 func (ec *executionContext) unmarshalOVacantPositionFilterInput2ᚖcubeᚑcastleᚋcmdᚋhrmsᚑserverᚋqueryᚋinternalᚋgraphqlᚋmodelᚐVacantPositionFilterInput(ctx context.Context, v interface{}) (*model.VacantPositionFilterInput, error) {
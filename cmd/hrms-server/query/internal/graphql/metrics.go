@@ -0,0 +1,57 @@
+package graphqlruntime
+
+import (
+	"context"
+	"time"
+
+	"cube-castle/internal/organization/utils"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// otherOperation 未在 SLO 配置中声明的操作统一归入该标签，避免客户端操作名导致指标基数失控。
+const otherOperation = "other"
+
+// MetricsExtension 记录 GraphQL 操作耗时直方图，供 SLO 计算延迟与可用性。
+// Operations 为允许作为标签的操作集合：优先匹配客户端操作名，其次匹配首个根字段名。
+type MetricsExtension struct {
+	Operations map[string]bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = MetricsExtension{}
+
+// ExtensionName 实现 graphql.HandlerExtension。
+func (MetricsExtension) ExtensionName() string { return "OperationMetrics" }
+
+// Validate 实现 graphql.HandlerExtension。
+func (MetricsExtension) Validate(graphql.ExecutableSchema) error { return nil }
+
+// InterceptResponse 统计整个操作的耗时，响应含错误时记为失败。
+func (m MetricsExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	start := time.Now()
+	resp := next(ctx)
+	failed := resp != nil && len(resp.Errors) > 0
+	utils.ObserveGraphQLOperation(m.operationLabel(oc), failed, time.Since(start))
+	return resp
+}
+
+func (m MetricsExtension) operationLabel(oc *graphql.OperationContext) string {
+	if m.Operations[oc.OperationName] {
+		return oc.OperationName
+	}
+	if oc.Operation != nil {
+		for _, sel := range oc.Operation.SelectionSet {
+			if field, ok := sel.(*ast.Field); ok && m.Operations[field.Name] {
+				return field.Name
+			}
+		}
+	}
+	return otherOperation
+}
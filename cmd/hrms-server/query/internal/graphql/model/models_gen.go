@@ -9,6 +9,15 @@ import (
 	"strconv"
 )
 
+// Postal address of a work location.
+type Address struct {
+	Line1         *string `json:"line1,omitempty"`
+	Line2         *string `json:"line2,omitempty"`
+	City          *string `json:"city,omitempty"`
+	StateProvince *string `json:"stateProvince,omitempty"`
+	PostalCode    *string `json:"postalCode,omitempty"`
+}
+
 type AssignmentStats struct {
 	PositionCode         *dto.PositionCode `json:"positionCode,omitempty"`
 	OrganizationCode     *string           `json:"organizationCode,omitempty"`
@@ -52,6 +61,21 @@ type CircularReference struct {
 	Severity      string   `json:"severity"`
 }
 
+// Versioned compensation grade with pay ranges per currency and region.
+// endDate is derived from the next version's effective date.
+type CompensationGrade struct {
+	Code          string                  `json:"code"`
+	RecordID      dto.UUID                `json:"recordId"`
+	Name          string                  `json:"name"`
+	Description   *string                 `json:"description,omitempty"`
+	Status        CompensationGradeStatus `json:"status"`
+	JobLevelCodes []dto.JobLevelCode      `json:"jobLevelCodes"`
+	PayRanges     []PayRange              `json:"payRanges"`
+	EffectiveDate dto.Date                `json:"effectiveDate"`
+	EndDate       *dto.Date               `json:"endDate,omitempty"`
+	IsCurrent     bool                    `json:"isCurrent"`
+}
+
 // Consistency check findings with detailed issues.
 type ConsistencyFindings struct {
 	PathMismatches       []PathMismatch       `json:"pathMismatches"`
@@ -62,6 +86,61 @@ type ConsistencyFindings struct {
 	CacheInconsistencies []CacheInconsistency `json:"cacheInconsistencies"`
 }
 
+// Share of a position's cost carried by one cost center.
+type CostAllocation struct {
+	CostCenterCode string    `json:"costCenterCode"`
+	CostCenterName *string   `json:"costCenterName,omitempty"`
+	Percentage     float64   `json:"percentage"`
+	EffectiveDate  *dto.Date `json:"effectiveDate,omitempty"`
+}
+
+// Versioned cost center owned by a legal entity, optionally nested under a parent
+// cost center of the same legal entity. endDate is derived from the next version's
+// effective date.
+type CostCenter struct {
+	Code            string           `json:"code"`
+	RecordID        dto.UUID         `json:"recordId"`
+	Name            string           `json:"name"`
+	Description     *string          `json:"description,omitempty"`
+	Status          CostCenterStatus `json:"status"`
+	ParentCode      *string          `json:"parentCode,omitempty"`
+	LegalEntityCode string           `json:"legalEntityCode"`
+	EffectiveDate   dto.Date         `json:"effectiveDate"`
+	EndDate         *dto.Date        `json:"endDate,omitempty"`
+	IsCurrent       bool             `json:"isCurrent"`
+}
+
+// Headcount figures per cost center, weighted by allocation percentage.
+type CostCenterHeadcount struct {
+	CostCenterCode     string  `json:"costCenterCode"`
+	CostCenterName     *string `json:"costCenterName,omitempty"`
+	LegalEntityCode    *string `json:"legalEntityCode,omitempty"`
+	PositionCount      int     `json:"positionCount"`
+	HeadcountCapacity  float64 `json:"headcountCapacity"`
+	HeadcountInUse     float64 `json:"headcountInUse"`
+	AssignedFte        float64 `json:"assignedFte"`
+	AvailableHeadcount float64 `json:"availableHeadcount"`
+}
+
+// Filter on a tenant-defined custom field. `value` is compared as a number for
+// GT/GTE/LT/LTE when it parses as one, otherwise as text; EXISTS ignores `value`.
+type CustomFieldFilterInput struct {
+	Key      string                     `json:"key"`
+	Operator *CustomFieldFilterOperator `json:"operator,omitempty"`
+	Value    *string                    `json:"value,omitempty"`
+}
+
+// Tenant-defined custom field value. Exactly one of the typed value fields is set
+// according to `type` (ENUM values are returned in stringValue).
+type CustomFieldValue struct {
+	Key          string          `json:"key"`
+	Type         CustomFieldType `json:"type"`
+	StringValue  *string         `json:"stringValue,omitempty"`
+	NumberValue  *float64        `json:"numberValue,omitempty"`
+	BooleanValue *bool           `json:"booleanValue,omitempty"`
+	DateValue    *dto.Date       `json:"dateValue,omitempty"`
+}
+
 // Simplified data changes with before/after comparison.
 type DataChanges struct {
 	BeforeData     dto.JSON `json:"beforeData,omitempty"`
@@ -95,6 +174,29 @@ type DepthViolation struct {
 	ParentChain     []string `json:"parentChain"`
 }
 
+// Work location in effect for an organization unit or position, with the
+// assignment it comes from.
+type EffectiveLocation struct {
+	Location      *Location      `json:"location"`
+	Source        LocationSource `json:"source"`
+	EffectiveDate dto.Date       `json:"effectiveDate"`
+}
+
+// An existing breach of the cross-position assignment rules for one employee.
+// rule is EMPLOYEE_FTE_EXCEEDED, EMPLOYEE_PRIMARY_CONFLICT or EMPLOYEE_ACTING_OVERLAP;
+// organizationCode is set for ACTING overlaps only.
+type EmployeeAssignmentViolation struct {
+	Rule             string   `json:"rule"`
+	EmployeeID       dto.UUID `json:"employeeId"`
+	EmployeeName     string   `json:"employeeName"`
+	OrganizationCode *string  `json:"organizationCode,omitempty"`
+	TotalFte         float64  `json:"totalFte"`
+	MaxFte           float64  `json:"maxFte"`
+	AssignmentIds    []string `json:"assignmentIds"`
+	PositionCodes    []string `json:"positionCodes"`
+	AsOfDate         dto.Date `json:"asOfDate"`
+}
+
 type FamilyHeadcount struct {
 	JobFamilyCode dto.JobFamilyCode `json:"jobFamilyCode"`
 	JobFamilyName *string           `json:"jobFamilyName,omitempty"`
@@ -163,6 +265,7 @@ type JobFamily struct {
 	RecordID      dto.UUID               `json:"recordId"`
 	GroupCode     dto.JobFamilyGroupCode `json:"groupCode"`
 	Name          string                 `json:"name"`
+	NameI18n      []LocalizedText        `json:"nameI18n"`
 	Status        JobCatalogStatus       `json:"status"`
 	EffectiveDate dto.Date               `json:"effectiveDate"`
 	EndDate       *dto.Date              `json:"endDate,omitempty"`
@@ -173,6 +276,7 @@ type JobFamilyGroup struct {
 	Code          dto.JobFamilyGroupCode `json:"code"`
 	RecordID      dto.UUID               `json:"recordId"`
 	Name          string                 `json:"name"`
+	NameI18n      []LocalizedText        `json:"nameI18n"`
 	Status        JobCatalogStatus       `json:"status"`
 	EffectiveDate dto.Date               `json:"effectiveDate"`
 	EndDate       *dto.Date              `json:"endDate,omitempty"`
@@ -184,11 +288,15 @@ type JobLevel struct {
 	RecordID      dto.UUID         `json:"recordId"`
 	RoleCode      dto.JobRoleCode  `json:"roleCode"`
 	Name          string           `json:"name"`
+	NameI18n      []LocalizedText  `json:"nameI18n"`
 	Status        JobCatalogStatus `json:"status"`
 	EffectiveDate dto.Date         `json:"effectiveDate"`
 	EndDate       *dto.Date        `json:"endDate,omitempty"`
 	LevelRank     int              `json:"levelRank"`
 	Description   *string          `json:"description,omitempty"`
+	// Compensation grades allowed for this level at asOfDate (defaults to today).
+	// Requires compensation:read.
+	CompensationGrades []CompensationGrade `json:"compensationGrades,omitempty"`
 }
 
 type JobRole struct {
@@ -196,6 +304,7 @@ type JobRole struct {
 	RecordID      dto.UUID          `json:"recordId"`
 	FamilyCode    dto.JobFamilyCode `json:"familyCode"`
 	Name          string            `json:"name"`
+	NameI18n      []LocalizedText   `json:"nameI18n"`
 	Status        JobCatalogStatus  `json:"status"`
 	EffectiveDate dto.Date          `json:"effectiveDate"`
 	EndDate       *dto.Date         `json:"endDate,omitempty"`
@@ -224,6 +333,46 @@ type LevelStatistic struct {
 	Count int `json:"count"`
 }
 
+// Localized variant of a name. Selection for name/title fields follows RFC 4647
+// lookup: the exact locale, then progressively shorter tags (zh-Hant-TW -> zh-Hant
+// -> zh), then the next preferred locale, finally the default name.
+type LocalizedText struct {
+	Locale string `json:"locale"`
+	Value  string `json:"value"`
+}
+
+// Versioned work location. SITEs carry country, time zone and address and can be
+// assigned to organization units and positions; REGIONs group sites and other
+// regions into a hierarchy. endDate is derived from the next version's effective
+// date.
+type Location struct {
+	Code          string         `json:"code"`
+	RecordID      dto.UUID       `json:"recordId"`
+	Name          string         `json:"name"`
+	Type          LocationType   `json:"type"`
+	Status        LocationStatus `json:"status"`
+	ParentCode    *string        `json:"parentCode,omitempty"`
+	CountryCode   *string        `json:"countryCode,omitempty"`
+	TimeZone      *string        `json:"timeZone,omitempty"`
+	Address       *Address       `json:"address,omitempty"`
+	EffectiveDate dto.Date       `json:"effectiveDate"`
+	EndDate       *dto.Date      `json:"endDate,omitempty"`
+	IsCurrent     bool           `json:"isCurrent"`
+}
+
+// Headcount figures per work location.
+type LocationHeadcount struct {
+	LocationCode       string  `json:"locationCode"`
+	LocationName       *string `json:"locationName,omitempty"`
+	CountryCode        *string `json:"countryCode,omitempty"`
+	TimeZone           *string `json:"timeZone,omitempty"`
+	PositionCount      int     `json:"positionCount"`
+	HeadcountCapacity  float64 `json:"headcountCapacity"`
+	HeadcountInUse     float64 `json:"headcountInUse"`
+	AssignedFte        float64 `json:"assignedFte"`
+	AvailableHeadcount float64 `json:"availableHeadcount"`
+}
+
 // Standard operator information with ID and display name.
 type OperatedBy struct {
 	ID   string `json:"id"`
@@ -242,36 +391,42 @@ type OperationsSummary struct {
 // Organization unit entity with complete temporal and audit information.
 // Represents the current state based on asOfDate parameter or latest effective record.
 type Organization struct {
-	Code             string   `json:"code"`
-	ParentCode       string   `json:"parentCode"`
-	TenantID         string   `json:"tenantId"`
-	Name             string   `json:"name"`
-	UnitType         UnitType `json:"unitType"`
-	Status           Status   `json:"status"`
-	Level            int      `json:"level"`
-	SortOrder        *int     `json:"sortOrder,omitempty"`
-	CodePath         string   `json:"codePath"`
-	NamePath         string   `json:"namePath"`
-	Path             *string  `json:"path,omitempty"`
-	Description      *string  `json:"description,omitempty"`
-	Profile          *string  `json:"profile,omitempty"`
-	ChangeReason     *string  `json:"changeReason,omitempty"`
-	EffectiveDate    string   `json:"effectiveDate"`
-	EndDate          *string  `json:"endDate,omitempty"`
-	CreatedAt        string   `json:"createdAt"`
-	UpdatedAt        string   `json:"updatedAt"`
-	RecordID         string   `json:"recordId"`
-	IsCurrent        bool     `json:"isCurrent"`
-	IsTemporal       bool     `json:"isTemporal"`
-	IsFuture         bool     `json:"isFuture"`
-	HierarchyDepth   int      `json:"hierarchyDepth"`
-	ChildrenCount    int      `json:"childrenCount"`
-	DeletedAt        *string  `json:"deletedAt,omitempty"`
-	DeletedBy        *string  `json:"deletedBy,omitempty"`
-	DeletionReason   *string  `json:"deletionReason,omitempty"`
-	SuspendedAt      *string  `json:"suspendedAt,omitempty"`
-	SuspendedBy      *string  `json:"suspendedBy,omitempty"`
-	SuspensionReason *string  `json:"suspensionReason,omitempty"`
+	Code             string             `json:"code"`
+	ParentCode       string             `json:"parentCode"`
+	TenantID         string             `json:"tenantId"`
+	Name             string             `json:"name"`
+	UnitType         dto.UnitTypeCode   `json:"unitType"`
+	Status           Status             `json:"status"`
+	Level            int                `json:"level"`
+	SortOrder        *int               `json:"sortOrder,omitempty"`
+	CodePath         string             `json:"codePath"`
+	NamePath         string             `json:"namePath"`
+	NameI18n         []LocalizedText    `json:"nameI18n"`
+	NamePathI18n     []LocalizedText    `json:"namePathI18n"`
+	Path             *string            `json:"path,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	Profile          *string            `json:"profile,omitempty"`
+	ChangeReason     *string            `json:"changeReason,omitempty"`
+	EffectiveDate    string             `json:"effectiveDate"`
+	EndDate          *string            `json:"endDate,omitempty"`
+	CreatedAt        string             `json:"createdAt"`
+	UpdatedAt        string             `json:"updatedAt"`
+	RecordID         string             `json:"recordId"`
+	IsCurrent        bool               `json:"isCurrent"`
+	IsTemporal       bool               `json:"isTemporal"`
+	IsFuture         bool               `json:"isFuture"`
+	HierarchyDepth   int                `json:"hierarchyDepth"`
+	ChildrenCount    int                `json:"childrenCount"`
+	DeletedAt        *string            `json:"deletedAt,omitempty"`
+	DeletedBy        *string            `json:"deletedBy,omitempty"`
+	DeletionReason   *string            `json:"deletionReason,omitempty"`
+	SuspendedAt      *string            `json:"suspendedAt,omitempty"`
+	SuspendedBy      *string            `json:"suspendedBy,omitempty"`
+	SuspensionReason *string            `json:"suspensionReason,omitempty"`
+	CustomFields     []CustomFieldValue `json:"customFields"`
+	// Default work location at asOfDate (defaults to today). Null when no default
+	// location is assigned. Requires location:read.
+	Location *EffectiveLocation `json:"location,omitempty"`
 }
 
 // Connection type for paginated organization results with metadata.
@@ -283,29 +438,31 @@ type OrganizationConnection struct {
 
 // Comprehensive filter for organization queries with temporal support.
 type OrganizationFilter struct {
-	AsOfDate                 *string         `json:"asOfDate,omitempty"`
-	IncludeFuture            *bool           `json:"includeFuture,omitempty"`
-	OnlyFuture               *bool           `json:"onlyFuture,omitempty"`
-	UnitType                 *UnitType       `json:"unitType,omitempty"`
-	Status                   *Status         `json:"status,omitempty"`
-	ParentCode               *string         `json:"parentCode,omitempty"`
-	Codes                    []string        `json:"codes,omitempty"`
-	ExcludeCodes             []string        `json:"excludeCodes,omitempty"`
-	ExcludeDescendantsOf     *string         `json:"excludeDescendantsOf,omitempty"`
-	IncludeDisabledAncestors *bool           `json:"includeDisabledAncestors,omitempty"`
-	Level                    *int            `json:"level,omitempty"`
-	MinLevel                 *int            `json:"minLevel,omitempty"`
-	MaxLevel                 *int            `json:"maxLevel,omitempty"`
-	RootsOnly                *bool           `json:"rootsOnly,omitempty"`
-	LeavesOnly               *bool           `json:"leavesOnly,omitempty"`
-	SearchText               *string         `json:"searchText,omitempty"`
-	SearchFields             []SearchField   `json:"searchFields,omitempty"`
-	HasChildren              *bool           `json:"hasChildren,omitempty"`
-	HasProfile               *bool           `json:"hasProfile,omitempty"`
-	ProfileContains          dto.JSON        `json:"profileContains,omitempty"`
-	OperationType            *OperationType  `json:"operationType,omitempty"`
-	OperatedBy               *string         `json:"operatedBy,omitempty"`
-	OperationDateRange       *DateRangeInput `json:"operationDateRange,omitempty"`
+	AsOfDate                 *string                  `json:"asOfDate,omitempty"`
+	IncludeFuture            *bool                    `json:"includeFuture,omitempty"`
+	OnlyFuture               *bool                    `json:"onlyFuture,omitempty"`
+	UnitType                 *dto.UnitTypeCode        `json:"unitType,omitempty"`
+	Status                   *Status                  `json:"status,omitempty"`
+	ParentCode               *string                  `json:"parentCode,omitempty"`
+	Codes                    []string                 `json:"codes,omitempty"`
+	ExcludeCodes             []string                 `json:"excludeCodes,omitempty"`
+	ExcludeDescendantsOf     *string                  `json:"excludeDescendantsOf,omitempty"`
+	IncludeDisabledAncestors *bool                    `json:"includeDisabledAncestors,omitempty"`
+	Level                    *int                     `json:"level,omitempty"`
+	MinLevel                 *int                     `json:"minLevel,omitempty"`
+	MaxLevel                 *int                     `json:"maxLevel,omitempty"`
+	RootsOnly                *bool                    `json:"rootsOnly,omitempty"`
+	LeavesOnly               *bool                    `json:"leavesOnly,omitempty"`
+	SearchText               *string                  `json:"searchText,omitempty"`
+	SearchFields             []SearchField            `json:"searchFields,omitempty"`
+	HasChildren              *bool                    `json:"hasChildren,omitempty"`
+	HasProfile               *bool                    `json:"hasProfile,omitempty"`
+	ProfileContains          dto.JSON                 `json:"profileContains,omitempty"`
+	OperationType            *OperationType           `json:"operationType,omitempty"`
+	OperatedBy               *string                  `json:"operatedBy,omitempty"`
+	OperationDateRange       *DateRangeInput          `json:"operationDateRange,omitempty"`
+	CustomFields             []CustomFieldFilterInput `json:"customFields,omitempty"`
+	LocationCode             *string                  `json:"locationCode,omitempty"`
 }
 
 // Hierarchy-specific organization information with relationship context.
@@ -371,12 +528,23 @@ type PathMismatch struct {
 	Severity         string `json:"severity"`
 }
 
+// Pay range of a compensation grade for one currency and region (GLOBAL when not
+// region specific).
+type PayRange struct {
+	Currency string  `json:"currency"`
+	Region   string  `json:"region"`
+	Min      float64 `json:"min"`
+	Mid      float64 `json:"mid"`
+	Max      float64 `json:"max"`
+}
+
 // Position resource exposed via GraphQL.
 type Position struct {
 	Code                  dto.PositionCode       `json:"code"`
 	RecordID              dto.UUID               `json:"recordId"`
 	TenantID              dto.UUID               `json:"tenantId"`
 	Title                 string                 `json:"title"`
+	TitleI18n             []LocalizedText        `json:"titleI18n"`
 	JobProfileCode        *string                `json:"jobProfileCode,omitempty"`
 	JobProfileName        *string                `json:"jobProfileName,omitempty"`
 	JobFamilyGroupCode    dto.JobFamilyGroupCode `json:"jobFamilyGroupCode"`
@@ -401,6 +569,19 @@ type Position struct {
 	IsFuture              bool                   `json:"isFuture"`
 	CreatedAt             dto.DateTime           `json:"createdAt"`
 	UpdatedAt             dto.DateTime           `json:"updatedAt"`
+	CustomFields          []CustomFieldValue     `json:"customFields"`
+	// Compensation grade referenced by gradeLevel, resolved at asOfDate (defaults to
+	// today). Null when gradeLevel is empty or not a defined grade.
+	// Requires compensation:read.
+	CompensationGrade *CompensationGrade `json:"compensationGrade,omitempty"`
+	// Cost allocation effective at asOfDate (defaults to today). Without an
+	// allocation the position costCenterCode carries 100% and effectiveDate is null.
+	// Requires cost-center:read.
+	CostAllocations []CostAllocation `json:"costAllocations"`
+	// Effective work location at asOfDate (defaults to today): the position
+	// override when set, otherwise the organization unit default. Null when neither
+	// is assigned. Requires location:read.
+	Location *EffectiveLocation `json:"location,omitempty"`
 }
 
 type PositionAssignment struct {
@@ -493,6 +674,8 @@ type PositionFilterInput struct {
 	PositionTypes       []PositionType           `json:"positionTypes,omitempty"`
 	EmploymentTypes     []EmploymentType         `json:"employmentTypes,omitempty"`
 	EffectiveRange      *DateRangeInput          `json:"effectiveRange,omitempty"`
+	CustomFields        []CustomFieldFilterInput `json:"customFields,omitempty"`
+	LocationCode        *string                  `json:"locationCode,omitempty"`
 }
 
 // Sorting input for position queries.
@@ -538,6 +721,18 @@ type PositionTransferEdge struct {
 	Node   *PositionTransfer `json:"node"`
 }
 
+// A position lifecycle event available from the position's current status.
+type PositionTransition struct {
+	Event        string   `json:"event"`
+	FromStatus   string   `json:"fromStatus"`
+	ToStatus     string   `json:"toStatus"`
+	Allowed      bool     `json:"allowed"`
+	Guards       []string `json:"guards"`
+	FailedGuards []string `json:"failedGuards"`
+	SideEffects  []string `json:"sideEffects"`
+	BuiltIn      bool     `json:"builtIn"`
+}
+
 // Root Query type providing all organization management query operations.
 // All queries require appropriate OAuth 2.0 permissions and support multi-tenant isolation.
 type Query struct {
@@ -583,8 +778,8 @@ type TypeHeadcount struct {
 
 // Statistics by organization unit type.
 type TypeStatistic struct {
-	UnitType UnitType `json:"unitType"`
-	Count    int      `json:"count"`
+	UnitType dto.UnitTypeCode `json:"unitType"`
+	Count    int              `json:"count"`
 }
 
 // User information for audit trails.
@@ -628,6 +823,7 @@ type VacantPositionFilterInput struct {
 	PositionTypes     []PositionType      `json:"positionTypes,omitempty"`
 	MinimumVacantDays *int                `json:"minimumVacantDays,omitempty"`
 	AsOfDate          *dto.Date           `json:"asOfDate,omitempty"`
+	LocationCode      *string             `json:"locationCode,omitempty"`
 }
 
 // Sorting input for vacant position queries.
@@ -636,6 +832,48 @@ type VacantPositionSortInput struct {
 	Direction *SortOrder              `json:"direction,omitempty"`
 }
 
+// Status for compensation grades.
+type CompensationGradeStatus string
+
+const (
+	CompensationGradeStatusActive   CompensationGradeStatus = "ACTIVE"
+	CompensationGradeStatusInactive CompensationGradeStatus = "INACTIVE"
+)
+
+var AllCompensationGradeStatus = []CompensationGradeStatus{
+	CompensationGradeStatusActive,
+	CompensationGradeStatusInactive,
+}
+
+func (e CompensationGradeStatus) IsValid() bool {
+	switch e {
+	case CompensationGradeStatusActive, CompensationGradeStatusInactive:
+		return true
+	}
+	return false
+}
+
+func (e CompensationGradeStatus) String() string {
+	return string(e)
+}
+
+func (e *CompensationGradeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CompensationGradeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CompensationGradeStatus", str)
+	}
+	return nil
+}
+
+func (e CompensationGradeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Consistency check modes with different performance characteristics.
 type ConsistencyCheckMode string
 
@@ -680,6 +918,150 @@ func (e ConsistencyCheckMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Status for cost centers.
+type CostCenterStatus string
+
+const (
+	CostCenterStatusActive   CostCenterStatus = "ACTIVE"
+	CostCenterStatusInactive CostCenterStatus = "INACTIVE"
+)
+
+var AllCostCenterStatus = []CostCenterStatus{
+	CostCenterStatusActive,
+	CostCenterStatusInactive,
+}
+
+func (e CostCenterStatus) IsValid() bool {
+	switch e {
+	case CostCenterStatusActive, CostCenterStatusInactive:
+		return true
+	}
+	return false
+}
+
+func (e CostCenterStatus) String() string {
+	return string(e)
+}
+
+func (e *CostCenterStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CostCenterStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CostCenterStatus", str)
+	}
+	return nil
+}
+
+func (e CostCenterStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Comparison operators for custom field filters.
+type CustomFieldFilterOperator string
+
+const (
+	CustomFieldFilterOperatorEq       CustomFieldFilterOperator = "EQ"
+	CustomFieldFilterOperatorNe       CustomFieldFilterOperator = "NE"
+	CustomFieldFilterOperatorContains CustomFieldFilterOperator = "CONTAINS"
+	CustomFieldFilterOperatorGt       CustomFieldFilterOperator = "GT"
+	CustomFieldFilterOperatorGte      CustomFieldFilterOperator = "GTE"
+	CustomFieldFilterOperatorLt       CustomFieldFilterOperator = "LT"
+	CustomFieldFilterOperatorLte      CustomFieldFilterOperator = "LTE"
+	CustomFieldFilterOperatorExists   CustomFieldFilterOperator = "EXISTS"
+)
+
+var AllCustomFieldFilterOperator = []CustomFieldFilterOperator{
+	CustomFieldFilterOperatorEq,
+	CustomFieldFilterOperatorNe,
+	CustomFieldFilterOperatorContains,
+	CustomFieldFilterOperatorGt,
+	CustomFieldFilterOperatorGte,
+	CustomFieldFilterOperatorLt,
+	CustomFieldFilterOperatorLte,
+	CustomFieldFilterOperatorExists,
+}
+
+func (e CustomFieldFilterOperator) IsValid() bool {
+	switch e {
+	case CustomFieldFilterOperatorEq, CustomFieldFilterOperatorNe, CustomFieldFilterOperatorContains, CustomFieldFilterOperatorGt, CustomFieldFilterOperatorGte, CustomFieldFilterOperatorLt, CustomFieldFilterOperatorLte, CustomFieldFilterOperatorExists:
+		return true
+	}
+	return false
+}
+
+func (e CustomFieldFilterOperator) String() string {
+	return string(e)
+}
+
+func (e *CustomFieldFilterOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomFieldFilterOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomFieldFilterOperator", str)
+	}
+	return nil
+}
+
+func (e CustomFieldFilterOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Data type of a tenant-defined custom field.
+type CustomFieldType string
+
+const (
+	CustomFieldTypeString  CustomFieldType = "STRING"
+	CustomFieldTypeNumber  CustomFieldType = "NUMBER"
+	CustomFieldTypeBoolean CustomFieldType = "BOOLEAN"
+	CustomFieldTypeDate    CustomFieldType = "DATE"
+	CustomFieldTypeEnum    CustomFieldType = "ENUM"
+)
+
+var AllCustomFieldType = []CustomFieldType{
+	CustomFieldTypeString,
+	CustomFieldTypeNumber,
+	CustomFieldTypeBoolean,
+	CustomFieldTypeDate,
+	CustomFieldTypeEnum,
+}
+
+func (e CustomFieldType) IsValid() bool {
+	switch e {
+	case CustomFieldTypeString, CustomFieldTypeNumber, CustomFieldTypeBoolean, CustomFieldTypeDate, CustomFieldTypeEnum:
+		return true
+	}
+	return false
+}
+
+func (e CustomFieldType) String() string {
+	return string(e)
+}
+
+func (e *CustomFieldType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomFieldType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomFieldType", str)
+	}
+	return nil
+}
+
+func (e CustomFieldType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Employment type for position assignments.
 type EmploymentType string
 
@@ -766,6 +1148,133 @@ func (e JobCatalogStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Assignment an effective location comes from: a position override or the
+// organization unit default.
+type LocationSource string
+
+const (
+	LocationSourcePosition         LocationSource = "POSITION"
+	LocationSourceOrganizationUnit LocationSource = "ORGANIZATION_UNIT"
+)
+
+var AllLocationSource = []LocationSource{
+	LocationSourcePosition,
+	LocationSourceOrganizationUnit,
+}
+
+func (e LocationSource) IsValid() bool {
+	switch e {
+	case LocationSourcePosition, LocationSourceOrganizationUnit:
+		return true
+	}
+	return false
+}
+
+func (e LocationSource) String() string {
+	return string(e)
+}
+
+func (e *LocationSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationSource", str)
+	}
+	return nil
+}
+
+func (e LocationSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Status for work locations.
+type LocationStatus string
+
+const (
+	LocationStatusActive   LocationStatus = "ACTIVE"
+	LocationStatusInactive LocationStatus = "INACTIVE"
+)
+
+var AllLocationStatus = []LocationStatus{
+	LocationStatusActive,
+	LocationStatusInactive,
+}
+
+func (e LocationStatus) IsValid() bool {
+	switch e {
+	case LocationStatusActive, LocationStatusInactive:
+		return true
+	}
+	return false
+}
+
+func (e LocationStatus) String() string {
+	return string(e)
+}
+
+func (e *LocationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationStatus", str)
+	}
+	return nil
+}
+
+func (e LocationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Kind of work location.
+type LocationType string
+
+const (
+	LocationTypeRegion LocationType = "REGION"
+	LocationTypeSite   LocationType = "SITE"
+)
+
+var AllLocationType = []LocationType{
+	LocationTypeRegion,
+	LocationTypeSite,
+}
+
+func (e LocationType) IsValid() bool {
+	switch e {
+	case LocationTypeRegion, LocationTypeSite:
+		return true
+	}
+	return false
+}
+
+func (e LocationType) String() string {
+	return string(e)
+}
+
+func (e *LocationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationType", str)
+	}
+	return nil
+}
+
+func (e LocationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Operation types for audit and temporal tracking.
 type OperationType string
 
@@ -1315,52 +1824,6 @@ func (e Status) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-// Organization unit types with specific business semantics.
-type UnitType string
-
-const (
-	UnitTypeDepartment       UnitType = "DEPARTMENT"
-	UnitTypeOrganizationUnit UnitType = "ORGANIZATION_UNIT"
-	UnitTypeCompany          UnitType = "COMPANY"
-	UnitTypeProjectTeam      UnitType = "PROJECT_TEAM"
-)
-
-var AllUnitType = []UnitType{
-	UnitTypeDepartment,
-	UnitTypeOrganizationUnit,
-	UnitTypeCompany,
-	UnitTypeProjectTeam,
-}
-
-func (e UnitType) IsValid() bool {
-	switch e {
-	case UnitTypeDepartment, UnitTypeOrganizationUnit, UnitTypeCompany, UnitTypeProjectTeam:
-		return true
-	}
-	return false
-}
-
-func (e UnitType) String() string {
-	return string(e)
-}
-
-func (e *UnitType) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("enums must be strings")
-	}
-
-	*e = UnitType(str)
-	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid UnitType", str)
-	}
-	return nil
-}
-
-func (e UnitType) MarshalGQL(w io.Writer) {
-	fmt.Fprint(w, strconv.Quote(e.String()))
-}
-
 // Supported vacant position sorting fields.
 type VacantPositionSortField string
 
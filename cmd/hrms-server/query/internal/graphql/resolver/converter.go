@@ -1,12 +1,39 @@
 package resolver
 
 import (
+	"context"
 	"encoding/json"
 
 	"cube-castle/cmd/hrms-server/query/internal/graphql/model"
 	"cube-castle/internal/organization/dto"
+	"cube-castle/internal/types"
+	"github.com/99designs/gqlgen/graphql"
 )
 
+// registerAuditArchiveNotice 将审计归档提示写入响应 extensions.auditArchive（按字段别名区分同一请求内的多次查询）
+func registerAuditArchiveNotice(ctx context.Context, notice *dto.AuditArchiveNotice) {
+	if notice == nil || !graphql.HasOperationContext(ctx) {
+		return
+	}
+	key := "auditArchive"
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" && fc.Field.Alias != fc.Field.Name {
+		key += ":" + fc.Field.Alias
+	}
+	defer func() {
+		// 同一键重复注册时 gqlgen 会 panic，提示信息非关键，忽略即可
+		_ = recover()
+	}()
+	graphql.RegisterExtension(ctx, key, notice)
+}
+
+// withLocale 将 locale 参数作为首选语言写入上下文，优先于 Accept-Language
+func withLocale(ctx context.Context, locale *string) context.Context {
+	if locale == nil {
+		return ctx
+	}
+	return types.WithPreferredLocale(ctx, *locale)
+}
+
 type stringScalar interface {
 	~string
 }
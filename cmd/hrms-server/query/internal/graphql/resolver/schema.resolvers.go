@@ -9,10 +9,12 @@ import (
 	graphqlruntime "cube-castle/cmd/hrms-server/query/internal/graphql"
 	"cube-castle/cmd/hrms-server/query/internal/graphql/model"
 	"cube-castle/internal/organization/dto"
+	"strings"
 )
 
 // Organizations is the resolver for the organizations field.
-func (r *queryResolver) Organizations(ctx context.Context, filter *model.OrganizationFilter, pagination *model.PaginationInput) (*model.OrganizationConnection, error) {
+func (r *queryResolver) Organizations(ctx context.Context, filter *model.OrganizationFilter, pagination *model.PaginationInput, locale *string) (*model.OrganizationConnection, error) {
+	ctx = withLocale(ctx, locale)
 	dtoFilter, err := convertInput[model.OrganizationFilter, dto.OrganizationFilter](filter)
 	if err != nil {
 		return nil, err
@@ -35,7 +37,8 @@ func (r *queryResolver) Organizations(ctx context.Context, filter *model.Organiz
 }
 
 // Organization is the resolver for the organization field.
-func (r *queryResolver) Organization(ctx context.Context, code string, asOfDate *string) (*model.Organization, error) {
+func (r *queryResolver) Organization(ctx context.Context, code string, asOfDate *string, locale *string) (*model.Organization, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.Organization(ctx, struct {
 		Code     string
 		AsOfDate *string
@@ -130,7 +133,8 @@ func (r *queryResolver) HierarchyStatistics(ctx context.Context, tenantID string
 }
 
 // Positions is the resolver for the positions field.
-func (r *queryResolver) Positions(ctx context.Context, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput) (*model.PositionConnection, error) {
+func (r *queryResolver) Positions(ctx context.Context, filter *model.PositionFilterInput, pagination *model.PaginationInput, sorting []model.PositionSortInput, locale *string) (*model.PositionConnection, error) {
+	ctx = withLocale(ctx, locale)
 	dtoFilter, err := convertInput[model.PositionFilterInput, dto.PositionFilterInput](filter)
 	if err != nil {
 		return nil, err
@@ -159,7 +163,8 @@ func (r *queryResolver) Positions(ctx context.Context, filter *model.PositionFil
 }
 
 // Position is the resolver for the position field.
-func (r *queryResolver) Position(ctx context.Context, code dto.PositionCode, asOfDate *dto.Date) (*model.Position, error) {
+func (r *queryResolver) Position(ctx context.Context, code dto.PositionCode, asOfDate *dto.Date, locale *string) (*model.Position, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.Position(ctx, struct {
 		Code     string
 		AsOfDate *string
@@ -191,7 +196,8 @@ func (r *queryResolver) PositionTimeline(ctx context.Context, code dto.PositionC
 }
 
 // PositionVersions is the resolver for the positionVersions field.
-func (r *queryResolver) PositionVersions(ctx context.Context, code dto.PositionCode, includeDeleted *bool) ([]model.Position, error) {
+func (r *queryResolver) PositionVersions(ctx context.Context, code dto.PositionCode, includeDeleted *bool, locale *string) ([]model.Position, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.PositionVersions(ctx, struct {
 		Code           string
 		IncludeDeleted *bool
@@ -350,6 +356,12 @@ func (r *queryResolver) AssignmentStats(ctx context.Context, organizationCode *s
 	return convertToModel[model.AssignmentStats](res)
 }
 
+// EmployeeAssignmentViolations is the resolver for the employeeAssignmentViolations field.
+func (r *queryResolver) EmployeeAssignmentViolations(ctx context.Context, asOfDate *dto.Date) ([]model.EmployeeAssignmentViolation, error) {
+	res, err := r.QueryResolver.EmployeeAssignmentViolations(ctx, struct{ AsOfDate *string }{AsOfDate: dateToStringPtr(asOfDate)})
+	return convertSliceResult[model.EmployeeAssignmentViolation](res, err)
+}
+
 // VacantPositions is the resolver for the vacantPositions field.
 func (r *queryResolver) VacantPositions(ctx context.Context, filter *model.VacantPositionFilterInput, pagination *model.PaginationInput, sorting []model.VacantPositionSortInput) (*model.VacantPositionConnection, error) {
 	dtoFilter, err := convertInput[model.VacantPositionFilterInput, dto.VacantPositionFilterInput](filter)
@@ -415,6 +427,12 @@ func (r *queryResolver) PositionHeadcountStats(ctx context.Context, organization
 	return convertToModel[model.HeadcountStats](res)
 }
 
+// PositionAllowedTransitions is the resolver for the positionAllowedTransitions field.
+func (r *queryResolver) PositionAllowedTransitions(ctx context.Context, code dto.PositionCode) ([]model.PositionTransition, error) {
+	res, err := r.QueryResolver.PositionAllowedTransitions(ctx, struct{ Code string }{Code: string(code)})
+	return convertSliceResult[model.PositionTransition](res, err)
+}
+
 // AuditHistory is the resolver for the auditHistory field.
 func (r *queryResolver) AuditHistory(ctx context.Context, recordID string, startDate *string, endDate *string, operation *model.OperationType, userID *string, limit *int) ([]model.AuditLogDetail, error) {
 	op := (*string)(nil)
@@ -444,6 +462,7 @@ func (r *queryResolver) AuditHistory(ctx context.Context, recordID string, start
 	if err != nil {
 		return nil, err
 	}
+	registerAuditArchiveNotice(ctx, r.QueryResolver.AuditHistoryArchiveNotice(ctx, startDate, endDate))
 	// Specialized mapping to ensure operation field is populated (event_type)
 	return convertAuditSlice(res)
 }
@@ -460,7 +479,8 @@ func (r *queryResolver) AuditLog(ctx context.Context, auditID string) (*model.Au
 }
 
 // OrganizationVersions is the resolver for the organizationVersions field.
-func (r *queryResolver) OrganizationVersions(ctx context.Context, code string, includeDeleted *bool) ([]model.Organization, error) {
+func (r *queryResolver) OrganizationVersions(ctx context.Context, code string, includeDeleted *bool, locale *string) ([]model.Organization, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.OrganizationVersions(ctx, struct {
 		Code           string
 		IncludeDeleted *bool
@@ -475,7 +495,8 @@ func (r *queryResolver) OrganizationVersions(ctx context.Context, code string, i
 }
 
 // JobFamilyGroups is the resolver for the jobFamilyGroups field.
-func (r *queryResolver) JobFamilyGroups(ctx context.Context, includeInactive *bool, asOfDate *dto.Date) ([]model.JobFamilyGroup, error) {
+func (r *queryResolver) JobFamilyGroups(ctx context.Context, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobFamilyGroup, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.JobFamilyGroups(ctx, struct {
 		IncludeInactive *bool
 		AsOfDate        *string
@@ -490,7 +511,8 @@ func (r *queryResolver) JobFamilyGroups(ctx context.Context, includeInactive *bo
 }
 
 // JobFamilies is the resolver for the jobFamilies field.
-func (r *queryResolver) JobFamilies(ctx context.Context, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobFamily, error) {
+func (r *queryResolver) JobFamilies(ctx context.Context, groupCode dto.JobFamilyGroupCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobFamily, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.JobFamilies(ctx, struct {
 		GroupCode       string
 		IncludeInactive *bool
@@ -507,7 +529,8 @@ func (r *queryResolver) JobFamilies(ctx context.Context, groupCode dto.JobFamily
 }
 
 // JobRoles is the resolver for the jobRoles field.
-func (r *queryResolver) JobRoles(ctx context.Context, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobRole, error) {
+func (r *queryResolver) JobRoles(ctx context.Context, familyCode dto.JobFamilyCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobRole, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.JobRoles(ctx, struct {
 		FamilyCode      string
 		IncludeInactive *bool
@@ -524,7 +547,8 @@ func (r *queryResolver) JobRoles(ctx context.Context, familyCode dto.JobFamilyCo
 }
 
 // JobLevels is the resolver for the jobLevels field.
-func (r *queryResolver) JobLevels(ctx context.Context, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date) ([]model.JobLevel, error) {
+func (r *queryResolver) JobLevels(ctx context.Context, roleCode dto.JobRoleCode, includeInactive *bool, asOfDate *dto.Date, locale *string) ([]model.JobLevel, error) {
+	ctx = withLocale(ctx, locale)
 	res, err := r.QueryResolver.JobLevels(ctx, struct {
 		RoleCode        string
 		IncludeInactive *bool
@@ -540,7 +564,190 @@ func (r *queryResolver) JobLevels(ctx context.Context, roleCode dto.JobRoleCode,
 	return convertSlice[model.JobLevel](res)
 }
 
+// CompensationGrades is the resolver for the compensationGrades field.
+func (r *queryResolver) CompensationGrades(ctx context.Context, jobLevelCode *dto.JobLevelCode, includeInactive *bool, asOfDate *dto.Date) ([]model.CompensationGrade, error) {
+	res, err := r.QueryResolver.CompensationGrades(ctx, struct {
+		JobLevelCode    *string
+		GradeCode       *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{
+		JobLevelCode:    scalarPtrToStringPtr(jobLevelCode),
+		IncludeInactive: includeInactive,
+		AsOfDate:        dateToStringPtr(asOfDate),
+	})
+	return convertSliceResult[model.CompensationGrade](res, err)
+}
+
+// CostCenters is the resolver for the costCenters field.
+func (r *queryResolver) CostCenters(ctx context.Context, legalEntityCode *string, parentCode *string, includeInactive *bool, asOfDate *dto.Date) ([]model.CostCenter, error) {
+	res, err := r.QueryResolver.CostCenters(ctx, struct {
+		LegalEntityCode *string
+		ParentCode      *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{
+		LegalEntityCode: legalEntityCode,
+		ParentCode:      parentCode,
+		IncludeInactive: includeInactive,
+		AsOfDate:        dateToStringPtr(asOfDate),
+	})
+	return convertSliceResult[model.CostCenter](res, err)
+}
+
+// CostCenterHeadcountStats is the resolver for the costCenterHeadcountStats field.
+func (r *queryResolver) CostCenterHeadcountStats(ctx context.Context, asOfDate *dto.Date, legalEntityCode *string) ([]model.CostCenterHeadcount, error) {
+	res, err := r.QueryResolver.CostCenterHeadcountStats(ctx, struct {
+		AsOfDate        *string
+		LegalEntityCode *string
+	}{
+		AsOfDate:        dateToStringPtr(asOfDate),
+		LegalEntityCode: legalEntityCode,
+	})
+	return convertSliceResult[model.CostCenterHeadcount](res, err)
+}
+
+// Locations is the resolver for the locations field.
+func (r *queryResolver) Locations(ctx context.Context, typeArg *model.LocationType, parentCode *string, countryCode *string, includeInactive *bool, asOfDate *dto.Date) ([]model.Location, error) {
+	var locationType *string
+	if typeArg != nil {
+		value := string(*typeArg)
+		locationType = &value
+	}
+	res, err := r.QueryResolver.Locations(ctx, struct {
+		Type            *string
+		ParentCode      *string
+		CountryCode     *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{
+		Type:            locationType,
+		ParentCode:      parentCode,
+		CountryCode:     countryCode,
+		IncludeInactive: includeInactive,
+		AsOfDate:        dateToStringPtr(asOfDate),
+	})
+	return convertSliceResult[model.Location](res, err)
+}
+
+// LocationHeadcountStats is the resolver for the locationHeadcountStats field.
+func (r *queryResolver) LocationHeadcountStats(ctx context.Context, asOfDate *dto.Date, locationCode *string) ([]model.LocationHeadcount, error) {
+	res, err := r.QueryResolver.LocationHeadcountStats(ctx, struct {
+		AsOfDate     *string
+		LocationCode *string
+	}{
+		AsOfDate:     dateToStringPtr(asOfDate),
+		LocationCode: locationCode,
+	})
+	return convertSliceResult[model.LocationHeadcount](res, err)
+}
+
+// Location is the resolver for the location field.
+func (r *organizationResolver) Location(ctx context.Context, obj *model.Organization, asOfDate *dto.Date) (*model.EffectiveLocation, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	res, err := r.QueryResolver.OrganizationLocation(ctx, struct {
+		Code     string
+		AsOfDate *string
+	}{
+		Code:     obj.Code,
+		AsOfDate: dateToStringPtr(asOfDate),
+	})
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return convertToModel[model.EffectiveLocation](res)
+}
+
+// CompensationGrade is the resolver for the compensationGrade field.
+func (r *positionResolver) CompensationGrade(ctx context.Context, obj *model.Position, asOfDate *dto.Date) (*model.CompensationGrade, error) {
+	if obj == nil || obj.GradeLevel == nil || strings.TrimSpace(*obj.GradeLevel) == "" {
+		return nil, nil
+	}
+	includeInactive := true
+	grades, err := convertSliceResult[model.CompensationGrade](r.QueryResolver.CompensationGrades(ctx, struct {
+		JobLevelCode    *string
+		GradeCode       *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{
+		GradeCode:       obj.GradeLevel,
+		IncludeInactive: &includeInactive,
+		AsOfDate:        dateToStringPtr(asOfDate),
+	}))
+	if err != nil || len(grades) == 0 {
+		return nil, err
+	}
+	return &grades[0], nil
+}
+
+// CostAllocations is the resolver for the costAllocations field.
+func (r *positionResolver) CostAllocations(ctx context.Context, obj *model.Position, asOfDate *dto.Date) ([]model.CostAllocation, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	res, err := r.QueryResolver.PositionCostAllocations(ctx, struct {
+		PositionCode string
+		AsOfDate     *string
+	}{
+		PositionCode: string(obj.Code),
+		AsOfDate:     dateToStringPtr(asOfDate),
+	})
+	return convertSliceResult[model.CostAllocation](res, err)
+}
+
+// Location is the resolver for the location field.
+func (r *positionResolver) Location(ctx context.Context, obj *model.Position, asOfDate *dto.Date) (*model.EffectiveLocation, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	res, err := r.QueryResolver.PositionLocation(ctx, struct {
+		PositionCode string
+		AsOfDate     *string
+	}{
+		PositionCode: string(obj.Code),
+		AsOfDate:     dateToStringPtr(asOfDate),
+	})
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return convertToModel[model.EffectiveLocation](res)
+}
+
+// CompensationGrades is the resolver for the compensationGrades field.
+func (r *jobLevelResolver) CompensationGrades(ctx context.Context, obj *model.JobLevel, asOfDate *dto.Date, includeInactive *bool) ([]model.CompensationGrade, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	res, err := r.QueryResolver.CompensationGrades(ctx, struct {
+		JobLevelCode    *string
+		GradeCode       *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{
+		JobLevelCode:    scalarPtrToStringPtr(&obj.Code),
+		IncludeInactive: includeInactive,
+		AsOfDate:        dateToStringPtr(asOfDate),
+	})
+	return convertSliceResult[model.CompensationGrade](res, err)
+}
+
+// JobLevel returns graphqlruntime.JobLevelResolver implementation.
+func (r *Resolver) JobLevel() graphqlruntime.JobLevelResolver { return &jobLevelResolver{r} }
+
+// Organization returns graphqlruntime.OrganizationResolver implementation.
+func (r *Resolver) Organization() graphqlruntime.OrganizationResolver {
+	return &organizationResolver{r}
+}
+
+// Position returns graphqlruntime.PositionResolver implementation.
+func (r *Resolver) Position() graphqlruntime.PositionResolver { return &positionResolver{r} }
+
 // Query returns graphqlruntime.QueryResolver implementation.
 func (r *Resolver) Query() graphqlruntime.QueryResolver { return &queryResolver{r} }
 
+type jobLevelResolver struct{ *Resolver }
+type organizationResolver struct{ *Resolver }
+type positionResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
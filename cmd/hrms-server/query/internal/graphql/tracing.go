@@ -0,0 +1,70 @@
+package graphqlruntime
+
+import (
+	"context"
+
+	"cube-castle/pkg/tracing"
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const tracingComponent = "graphql"
+
+// TracingExtension 为 GraphQL 操作与解析器字段生成 OpenTelemetry span。
+// 仅对需要执行 resolver 的字段建 span，普通属性字段不追踪以控制 span 数量。
+type TracingExtension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = TracingExtension{}
+
+// ExtensionName 实现 graphql.HandlerExtension。
+func (TracingExtension) ExtensionName() string { return "OpenTelemetryTracing" }
+
+// Validate 实现 graphql.HandlerExtension。
+func (TracingExtension) Validate(graphql.ExecutableSchema) error { return nil }
+
+// InterceptResponse 以操作为单位开启 span，响应含错误时标记失败。
+func (TracingExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	operationType := "query"
+	if oc.Operation != nil {
+		operationType = string(oc.Operation.Operation)
+	}
+	name := "graphql." + operationType
+	if oc.OperationName != "" {
+		name += " " + oc.OperationName
+	}
+
+	ctx, span := tracing.Start(ctx, tracingComponent, name,
+		attribute.String("graphql.operation.type", operationType),
+		attribute.String("graphql.operation.name", oc.OperationName),
+	)
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetStatus(codes.Error, resp.Errors.Error())
+	}
+	return resp
+}
+
+// InterceptField 为 resolver 字段开启子 span。
+func (TracingExtension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	ctx, span := tracing.Start(ctx, tracingComponent, "graphql.resolve "+fc.Object+"."+fc.Field.Name,
+		attribute.String("graphql.field.path", fc.Path().String()),
+	)
+	res, err := next(ctx)
+	tracing.End(span, err)
+	return res, err
+}
@@ -26,8 +26,9 @@ import (
 )
 
 // BuildHandlers builds the http.Handlers for /graphql and /graphiql (optional).
+// denylist may be nil; when set, revoked access tokens are rejected.
 // Health/metrics should由上层统一暴露，避免重复端点。
-func BuildHandlers(sqlDB *sql.DB, repo organization.QueryRepositoryInterface, assignments organization.AssignmentFacade, logger pkglogger.Logger, devMode bool) (graphql http.Handler, graphiql http.Handler, err error) {
+func BuildHandlers(sqlDB *sql.DB, repo organization.QueryRepositoryInterface, assignments organization.AssignmentFacade, logger pkglogger.Logger, devMode bool, denylist auth.TokenDenylist) (graphql http.Handler, graphiql http.Handler, err error) {
 	jwtCfg := config.GetJWTConfig()
 	var pubPEM []byte
 	if jwtCfg.HasPublicKey() {
@@ -42,6 +43,7 @@ func BuildHandlers(sqlDB *sql.DB, repo organization.QueryRepositoryInterface, as
 		JWKSURL:      jwtCfg.JWKSUrl,
 		PublicKeyPEM: pubPEM,
 		ClockSkew:    jwtCfg.AllowedClockSkew,
+		Denylist:     denylist,
 	})
 	authLogger := logger.WithFields(pkglogger.Fields{"component": "graphql-auth"})
 	permissionChecker := auth.NewPBACPermissionChecker(sqlDB, authLogger)
@@ -54,6 +56,8 @@ func BuildHandlers(sqlDB *sql.DB, repo organization.QueryRepositoryInterface, as
 		Resolvers: gqlResolver,
 	})
 	graphqlServer := handler.NewDefaultServer(executableSchema)
+	graphqlServer.Use(graphqlruntime.TracingExtension{})
+	graphqlServer.Use(graphqlruntime.MetricsExtension{Operations: config.GetSLOConfig().Config.GraphQLOperations()})
 
 	envelope := middleware.NewGraphQLEnvelopeMiddleware()
 	baseGraphQLHandler := envelope.Middleware()(graphqlPerm.Middleware()(graphqlServer))
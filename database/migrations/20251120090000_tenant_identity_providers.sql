@@ -0,0 +1,31 @@
+-- +goose Up
+-- 租户级 OIDC 身份提供方配置（BFF 多 IdP 联邦登录）
+CREATE TABLE IF NOT EXISTS public.tenant_identity_providers (
+    id VARCHAR(64) PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    issuer TEXT NOT NULL,
+    client_id TEXT NOT NULL,
+    client_secret TEXT,
+    redirect_uri TEXT NOT NULL,
+    post_logout_redirect_uri TEXT,
+    scopes TEXT NOT NULL DEFAULT 'openid profile email',
+    email_domains TEXT[] NOT NULL DEFAULT '{}',
+    roles_claim VARCHAR(255),
+    groups_claim VARCHAR(255),
+    tenant_claim VARCHAR(255),
+    group_role_mappings JSONB NOT NULL DEFAULT '{}'::jsonb,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_identity_providers_tenant
+    ON public.tenant_identity_providers (tenant_id)
+    WHERE enabled;
+
+CREATE INDEX IF NOT EXISTS idx_tenant_identity_providers_domains
+    ON public.tenant_identity_providers USING GIN (email_domains);
+
+-- +goose Down
+DROP TABLE IF EXISTS public.tenant_identity_providers;
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- SCIM 2.0 用户/组供应（IdP 推送），角色在令牌签发时合并
+CREATE TABLE IF NOT EXISTS scim_users (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    user_name TEXT NOT NULL,
+    external_id TEXT,
+    email TEXT,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    employee_id UUID,
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    resource JSONB NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_scim_users_tenant_user_name
+    ON scim_users (tenant_id, lower(user_name));
+CREATE INDEX IF NOT EXISTS idx_scim_users_tenant_external
+    ON scim_users (tenant_id, external_id);
+CREATE INDEX IF NOT EXISTS idx_scim_users_tenant_email
+    ON scim_users (tenant_id, lower(email));
+
+CREATE TABLE IF NOT EXISTS scim_groups (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    display_name TEXT NOT NULL,
+    external_id TEXT,
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    resource JSONB NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_scim_groups_tenant_display_name
+    ON scim_groups (tenant_id, lower(display_name));
+
+CREATE TABLE IF NOT EXISTS scim_group_members (
+    group_id UUID NOT NULL REFERENCES scim_groups(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES scim_users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scim_group_members_user
+    ON scim_group_members (tenant_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scim_group_members;
+DROP TABLE IF EXISTS scim_groups;
+DROP TABLE IF EXISTS scim_users;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 审计日志防篡改：按租户哈希链 + 定期签名检查点
+-- record_hash = sha256(prev_hash || canonical(event))，链头行锁保证同租户写入串行
+ALTER TABLE audit_logs
+    ADD COLUMN IF NOT EXISTS chain_seq BIGINT,
+    ADD COLUMN IF NOT EXISTS prev_hash CHAR(64),
+    ADD COLUMN IF NOT EXISTS record_hash CHAR(64),
+    ADD COLUMN IF NOT EXISTS hash_version SMALLINT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_audit_logs_tenant_chain_seq
+    ON audit_logs (tenant_id, chain_seq)
+    WHERE chain_seq IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS audit_chain_heads (
+    tenant_id UUID PRIMARY KEY,
+    last_seq BIGINT NOT NULL DEFAULT 0,
+    last_hash CHAR(64) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS audit_chain_checkpoints (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    chain_seq BIGINT NOT NULL,
+    record_hash CHAR(64) NOT NULL,
+    key_id TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_audit_chain_checkpoints_tenant_seq
+    ON audit_chain_checkpoints (tenant_id, chain_seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_chain_checkpoints;
+DROP TABLE IF EXISTS audit_chain_heads;
+DROP INDEX IF EXISTS uq_audit_logs_tenant_chain_seq;
+ALTER TABLE audit_logs
+    DROP COLUMN IF EXISTS hash_version,
+    DROP COLUMN IF EXISTS record_hash,
+    DROP COLUMN IF EXISTS prev_hash,
+    DROP COLUMN IF EXISTS chain_seq;
+-- +goose StatementEnd
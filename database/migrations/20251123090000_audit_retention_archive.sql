@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 审计日志保留策略与归档：超期记录按租户写入 JSONL.gz 归档文件后从 audit_logs 移除
+CREATE TABLE IF NOT EXISTS audit_retention_policies (
+    tenant_id UUID PRIMARY KEY,
+    retention_days INTEGER NOT NULL CHECK (retention_days >= 30),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS audit_archives (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    storage_backend TEXT NOT NULL,
+    storage_key TEXT NOT NULL,
+    record_count INTEGER NOT NULL,
+    from_ts TIMESTAMPTZ NOT NULL,
+    to_ts TIMESTAMPTZ NOT NULL,
+    first_seq BIGINT,
+    last_seq BIGINT,
+    last_hash CHAR(64),
+    sha256 CHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_archives_tenant_range
+    ON audit_archives (tenant_id, from_ts, to_ts);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_timestamp
+    ON audit_logs (tenant_id, "timestamp");
+
+-- 归档后哈希链从 base_seq/base_hash 继续校验
+ALTER TABLE audit_chain_heads
+    ADD COLUMN IF NOT EXISTS base_seq BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS base_hash CHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE audit_chain_heads
+    DROP COLUMN IF EXISTS base_hash,
+    DROP COLUMN IF EXISTS base_seq;
+DROP INDEX IF EXISTS idx_audit_logs_tenant_timestamp;
+DROP TABLE IF EXISTS audit_archives;
+DROP TABLE IF EXISTS audit_retention_policies;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 告警邮件投递 outbox：邮件先落库再投递，失败按指数退避重试，超过上限标记为 dead
+CREATE TABLE IF NOT EXISTS alert_email_outbox (
+    id UUID PRIMARY KEY,
+    alert_ids TEXT[] NOT NULL DEFAULT '{}',
+    recipients TEXT[] NOT NULL,
+    subject TEXT NOT NULL,
+    message BYTEA NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_email_outbox_due
+    ON alert_email_outbox (next_attempt_at)
+    WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_alert_email_outbox_due;
+DROP TABLE IF EXISTS alert_email_outbox;
+-- +goose StatementEnd
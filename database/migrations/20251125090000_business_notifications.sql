@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 业务事件通知：租户订阅偏好、按 (订阅, 去重键) 幂等的投递记录与站内收件箱
+CREATE TABLE IF NOT EXISTS notification_subscriptions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    event_type TEXT NOT NULL CHECK (event_type IN ('assignment.acting_expiring', 'position.vacant_prolonged', 'organization.suspended')),
+    channel TEXT NOT NULL CHECK (channel IN ('email', 'webhook', 'inbox')),
+    target TEXT NOT NULL,
+    organization_code VARCHAR(12),
+    threshold_days INTEGER NOT NULL DEFAULT 0 CHECK (threshold_days >= 0),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_subscriptions_tenant_event
+    ON notification_subscriptions (tenant_id, event_type)
+    WHERE enabled;
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    subscription_id UUID NOT NULL REFERENCES notification_subscriptions (id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    dedup_key TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    target TEXT NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    CONSTRAINT uk_notification_deliveries_dedup UNIQUE (subscription_id, dedup_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_due
+    ON notification_deliveries (next_attempt_at)
+    WHERE status IN ('pending', 'failed');
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_tenant_created
+    ON notification_deliveries (tenant_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS notification_inbox (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    recipient TEXT NOT NULL,
+    delivery_id UUID NOT NULL UNIQUE REFERENCES notification_deliveries (id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_inbox_recipient
+    ON notification_inbox (tenant_id, recipient, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_notification_inbox_recipient;
+DROP TABLE IF EXISTS notification_inbox;
+DROP INDEX IF EXISTS idx_notification_deliveries_tenant_created;
+DROP INDEX IF EXISTS idx_notification_deliveries_due;
+DROP TABLE IF EXISTS notification_deliveries;
+DROP INDEX IF EXISTS idx_notification_subscriptions_tenant_event;
+DROP TABLE IF EXISTS notification_subscriptions;
+-- +goose StatementEnd
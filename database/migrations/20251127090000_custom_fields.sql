@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 租户自定义字段：字段定义（类型、校验、必填、适用的单元/职位类型）与随时态版本存储的字段值
+CREATE TABLE IF NOT EXISTS custom_field_definitions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    entity_type TEXT NOT NULL,
+    field_key TEXT NOT NULL,
+    label TEXT NOT NULL,
+    description TEXT,
+    data_type TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    applies_to TEXT[] NOT NULL DEFAULT '{}',
+    validation JSONB NOT NULL DEFAULT '{}'::jsonb,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_custom_field_definitions_key UNIQUE (tenant_id, entity_type, field_key),
+    CONSTRAINT chk_custom_field_definitions_entity CHECK (entity_type IN ('ORGANIZATION', 'POSITION')),
+    CONSTRAINT chk_custom_field_definitions_type CHECK (data_type IN ('STRING', 'NUMBER', 'BOOLEAN', 'DATE', 'ENUM'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_custom_field_definitions_tenant_entity
+    ON custom_field_definitions (tenant_id, entity_type)
+    WHERE is_active;
+
+ALTER TABLE organization_units
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_organization_units_custom_fields
+    ON organization_units USING GIN (custom_fields);
+
+ALTER TABLE positions
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_positions_custom_fields
+    ON positions USING GIN (custom_fields);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_positions_custom_fields;
+ALTER TABLE positions DROP COLUMN IF EXISTS custom_fields;
+DROP INDEX IF EXISTS idx_organization_units_custom_fields;
+ALTER TABLE organization_units DROP COLUMN IF EXISTS custom_fields;
+DROP INDEX IF EXISTS idx_custom_field_definitions_tenant_entity;
+DROP TABLE IF EXISTS custom_field_definitions;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 租户可配置的组织单元类型：允许的上级类型、是否可作为根节点与每类型最大层级。
+-- 内置类型（DEPARTMENT/ORGANIZATION_UNIT/COMPANY/PROJECT_TEAM）无需落库，租户可通过同编码记录覆盖其规则。
+CREATE TABLE IF NOT EXISTS organization_unit_types (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    code VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    allowed_parent_types TEXT[] NOT NULL DEFAULT '{}',
+    allow_root BOOLEAN NOT NULL DEFAULT TRUE,
+    max_depth INTEGER,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_organization_unit_types_code UNIQUE (tenant_id, code),
+    CONSTRAINT chk_organization_unit_types_code CHECK (code ~ '^[A-Z][A-Z0-9_]{1,63}$'),
+    CONSTRAINT chk_organization_unit_types_depth CHECK (max_depth IS NULL OR max_depth BETWEEN 1 AND 17)
+);
+
+-- 单元类型改为租户数据，移除固定枚举约束
+ALTER TABLE organization_units DROP CONSTRAINT IF EXISTS valid_unit_type;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE organization_units
+    ADD CONSTRAINT valid_unit_type CHECK (((unit_type)::text = ANY ((ARRAY['DEPARTMENT'::character varying, 'ORGANIZATION_UNIT'::character varying, 'PROJECT_TEAM'::character varying])::text[]))) NOT VALID;
+DROP TABLE IF EXISTS organization_unit_types;
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 多语言名称：{locale: name} 形式随时态版本存储，默认语言名称仍保存在 name/title 列
+ALTER TABLE organization_units
+    ADD COLUMN IF NOT EXISTS name_i18n JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN IF NOT EXISTS name_path_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE positions
+    ADD COLUMN IF NOT EXISTS title_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE job_family_groups
+    ADD COLUMN IF NOT EXISTS name_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE job_families
+    ADD COLUMN IF NOT EXISTS name_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE job_roles
+    ADD COLUMN IF NOT EXISTS name_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE job_levels
+    ADD COLUMN IF NOT EXISTS name_i18n JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- 按 RFC 4647 lookup 规则取本地化名称：完整语言标签 → 逐级截断子标签 → 默认名称
+-- 与 internal/types.LocalizedNames.Lookup 保持一致，供层级路径批量重算使用
+CREATE OR REPLACE FUNCTION localized_name(names JSONB, locale TEXT, fallback TEXT)
+RETURNS TEXT
+LANGUAGE plpgsql
+IMMUTABLE
+AS $$
+DECLARE
+    candidate TEXT := locale;
+    value TEXT;
+BEGIN
+    WHILE candidate IS NOT NULL AND candidate <> '' LOOP
+        value := names ->> candidate;
+        IF value IS NOT NULL AND value <> '' THEN
+            RETURN value;
+        END IF;
+        IF position('-' IN candidate) = 0 THEN
+            EXIT;
+        END IF;
+        candidate := regexp_replace(candidate, '-[^-]*$', '');
+    END LOOP;
+    RETURN fallback;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS localized_name(JSONB, TEXT, TEXT);
+ALTER TABLE job_levels DROP COLUMN IF EXISTS name_i18n;
+ALTER TABLE job_roles DROP COLUMN IF EXISTS name_i18n;
+ALTER TABLE job_families DROP COLUMN IF EXISTS name_i18n;
+ALTER TABLE job_family_groups DROP COLUMN IF EXISTS name_i18n;
+ALTER TABLE positions DROP COLUMN IF EXISTS title_i18n;
+ALTER TABLE organization_units
+    DROP COLUMN IF EXISTS name_path_i18n,
+    DROP COLUMN IF EXISTS name_i18n;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 薪酬等级（职等）：按生效日期版本化，每个版本记录允许关联的职级编码与按币种/地区的薪酬区间（min/mid/max）。
+-- 版本结束日期由下一版本生效日期推导，不单独落库。
+CREATE TABLE IF NOT EXISTS compensation_grades (
+    record_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    grade_code VARCHAR(20) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    job_level_codes TEXT[] NOT NULL DEFAULT '{}',
+    pay_ranges JSONB NOT NULL DEFAULT '[]'::jsonb,
+    effective_date DATE NOT NULL,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_compensation_grades_version UNIQUE (tenant_id, grade_code, effective_date),
+    CONSTRAINT chk_compensation_grades_code CHECK (grade_code ~ '^[A-Z0-9][A-Z0-9_-]{0,19}$'),
+    CONSTRAINT chk_compensation_grades_status CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    CONSTRAINT chk_compensation_grades_pay_ranges CHECK (jsonb_typeof(pay_ranges) = 'array')
+);
+
+CREATE INDEX IF NOT EXISTS idx_compensation_grades_job_levels
+    ON compensation_grades USING GIN (job_level_codes);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS compensation_grades;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 成本中心：按生效日期版本化，归属法人实体（COMPANY 组织单元），通过 parent_code 组成层级。
+-- 版本结束日期由下一版本生效日期推导，不单独落库。
+CREATE TABLE IF NOT EXISTS cost_centers (
+    record_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    parent_code VARCHAR(50),
+    legal_entity_code VARCHAR(12) NOT NULL,
+    effective_date DATE NOT NULL,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_cost_centers_version UNIQUE (tenant_id, code, effective_date),
+    CONSTRAINT chk_cost_centers_code CHECK (code ~ '^[A-Z0-9][A-Z0-9_-]{0,49}$'),
+    CONSTRAINT chk_cost_centers_status CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    CONSTRAINT chk_cost_centers_parent CHECK (parent_code IS NULL OR parent_code <> code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cost_centers_legal_entity
+    ON cost_centers (tenant_id, legal_entity_code);
+
+-- 职位成本分摊：同一职位同一生效日期的多行组成一套方案（比例合计 100），后一方案整体替换前一方案。
+CREATE TABLE IF NOT EXISTS position_cost_allocations (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    position_code VARCHAR(8) NOT NULL,
+    cost_center_code VARCHAR(50) NOT NULL,
+    percentage NUMERIC(5,2) NOT NULL,
+    effective_date DATE NOT NULL,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_position_cost_allocations UNIQUE (tenant_id, position_code, effective_date, cost_center_code),
+    CONSTRAINT chk_position_cost_allocations_percentage CHECK (percentage > 0 AND percentage <= 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_position_cost_allocations_cost_center
+    ON position_cost_allocations (tenant_id, cost_center_code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS position_cost_allocations;
+DROP TABLE IF EXISTS cost_centers;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 工作地点：按生效日期版本化的区域（REGION）与地点（SITE），通过 parent_code 组成区域层级。
+-- 版本结束日期由下一版本生效日期推导，不单独落库。
+CREATE TABLE IF NOT EXISTS work_locations (
+    record_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    location_type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    parent_code VARCHAR(50),
+    country_code CHAR(2),
+    time_zone VARCHAR(64),
+    address_line1 VARCHAR(255),
+    address_line2 VARCHAR(255),
+    city VARCHAR(100),
+    state_province VARCHAR(100),
+    postal_code VARCHAR(20),
+    effective_date DATE NOT NULL,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_work_locations_version UNIQUE (tenant_id, code, effective_date),
+    CONSTRAINT chk_work_locations_code CHECK (code ~ '^[A-Z0-9][A-Z0-9_-]{0,49}$'),
+    CONSTRAINT chk_work_locations_type CHECK (location_type IN ('REGION', 'SITE')),
+    CONSTRAINT chk_work_locations_status CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    CONSTRAINT chk_work_locations_parent CHECK (parent_code IS NULL OR parent_code <> code),
+    CONSTRAINT chk_work_locations_site CHECK (location_type <> 'SITE' OR (country_code IS NOT NULL AND time_zone IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_work_locations_parent
+    ON work_locations (tenant_id, parent_code);
+
+-- 地点分配：组织单元默认地点与职位地点覆盖，按生效日期取最近一条；location_code 为空表示清除
+-- （职位清除后继承所属组织单元的默认地点）。
+CREATE TABLE IF NOT EXISTS location_assignments (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    subject_type VARCHAR(20) NOT NULL,
+    subject_code VARCHAR(12) NOT NULL,
+    location_code VARCHAR(50),
+    effective_date DATE NOT NULL,
+    created_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_location_assignments UNIQUE (tenant_id, subject_type, subject_code, effective_date),
+    CONSTRAINT chk_location_assignments_subject CHECK (subject_type IN ('ORGANIZATION_UNIT', 'POSITION'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_location_assignments_location
+    ON location_assignments (tenant_id, location_code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS location_assignments;
+DROP TABLE IF EXISTS work_locations;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 职位与任职生命周期迁移规则的租户覆盖：内置规则无需落库，同 (entity, event) 的记录覆盖内置规则，
+-- 职位实体还可定义自定义事件。guards/side_effects 取值由应用层校验。
+CREATE TABLE IF NOT EXISTS position_lifecycle_transitions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    entity VARCHAR(20) NOT NULL,
+    event VARCHAR(20) NOT NULL,
+    from_statuses TEXT[] NOT NULL DEFAULT '{}',
+    to_status VARCHAR(20),
+    guards TEXT[] NOT NULL DEFAULT '{}',
+    side_effects TEXT[] NOT NULL DEFAULT '{}',
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uk_position_lifecycle_transitions UNIQUE (tenant_id, entity, event),
+    CONSTRAINT chk_position_lifecycle_transitions_entity CHECK (entity IN ('POSITION', 'ASSIGNMENT')),
+    CONSTRAINT chk_position_lifecycle_transitions_event CHECK (event ~ '^[A-Z][A-Z0-9_]{1,19}$')
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS position_lifecycle_transitions;
+-- +goose StatementEnd
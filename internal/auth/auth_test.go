@@ -17,8 +17,10 @@ import (
 	"time"
 
 	pkglogger "cube-castle/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
 )
 
 func TestNewJWTMiddlewareWithOptionsDefaults(t *testing.T) {
@@ -600,6 +602,28 @@ func TestCheckRESTPermission(t *testing.T) {
 	}
 }
 
+func TestCheckRESTPermissionJobCatalogCloneRequiresPlatformOperator(t *testing.T) {
+	checker := NewPBACPermissionChecker(nil, pkglogger.NewNoopLogger())
+	tenantAdmin := SetUserContext(context.Background(), &Claims{UserID: "tenant-admin", TenantID: "tenant", Roles: []string{"ADMIN"}})
+	if err := checker.CheckRESTPermission(tenantAdmin, http.MethodPost, "/api/v1/job-catalog/clone"); err == nil {
+		t.Fatalf("expected tenant ADMIN role to be denied job catalog clone")
+	}
+
+	scoped := SetUserContext(context.Background(), &Claims{UserID: "tenant-admin", TenantID: "tenant", Roles: []string{"ADMIN"}, Scope: "job-catalog:clone system:ops:write"})
+	if err := checker.CheckRESTPermission(scoped, http.MethodPost, "/api/v1/job-catalog/clone"); err == nil {
+		t.Fatalf("expected token scope not to grant job catalog clone")
+	}
+	opsScoped := SetUserContext(context.Background(), &Claims{UserID: "employee", TenantID: "tenant", Scope: "SYSTEM_OPS_WRITE"})
+	if err := checker.CheckRESTPermission(opsScoped, http.MethodPatch, "/api/v1/operational/runtime-config"); err == nil {
+		t.Fatalf("expected token scope not to grant SYSTEM_OPS_WRITE")
+	}
+
+	operator := SetUserContext(context.Background(), &Claims{UserID: "platform-ops", TenantID: "tenant", PlatformOperator: true})
+	if err := checker.CheckRESTPermission(operator, http.MethodPost, "/api/v1/job-catalog/clone"); err != nil {
+		t.Fatalf("expected platform operator to pass REST permission: %v", err)
+	}
+}
+
 func TestMockRESTPermissionCheck(t *testing.T) {
 	checker := NewPBACPermissionChecker(nil, pkglogger.NewNoopLogger())
 	ctx := SetUserContext(context.Background(), &Claims{UserID: "user", TenantID: "tenant", Roles: []string{"MANAGER"}})
@@ -612,3 +636,108 @@ func TestMockRESTPermissionCheck(t *testing.T) {
 		t.Fatalf("expected guest to be denied")
 	}
 }
+
+func TestJWTValidateTokenRejectsRevokedTokens(t *testing.T) {
+	denylist := NewInMemoryTokenDenylist()
+	mw := NewJWTMiddlewareWithOptions("super-secret", "cube", "castle", Options{Alg: "HS256", Denylist: denylist})
+
+	sign := func(jti, sid string, iat time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iss":       "cube",
+			"aud":       "castle",
+			"sub":       "user-1",
+			"tenant_id": "tenant-1",
+			"jti":       jti,
+			"sid":       sid,
+			"iat":       iat.Unix(),
+			"exp":       time.Now().Add(5 * time.Minute).Unix(),
+		})
+		signed, err := token.SignedString([]byte("super-secret"))
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+
+	ctx := context.Background()
+	issued := time.Now().Add(-time.Minute)
+	if _, err := mw.ValidateToken(sign("jti-1", "sid-1", issued)); err != nil {
+		t.Fatalf("expected token to be valid before revocation: %v", err)
+	}
+
+	if err := denylist.RevokeSession(ctx, "sid-1", time.Minute); err != nil {
+		t.Fatalf("revoke session: %v", err)
+	}
+	if _, err := mw.ValidateToken(sign("jti-1", "sid-1", issued)); err == nil {
+		t.Fatalf("expected revoked session token to be rejected")
+	}
+	if _, err := mw.ValidateToken(sign("jti-2", "sid-2", issued)); err != nil {
+		t.Fatalf("expected other session token to remain valid: %v", err)
+	}
+
+	if err := denylist.RevokeUser(ctx, "tenant-1", "user-1", time.Now(), time.Minute); err != nil {
+		t.Fatalf("revoke user: %v", err)
+	}
+	if _, err := mw.ValidateToken(sign("jti-2", "sid-2", issued)); err == nil {
+		t.Fatalf("expected token issued before user revocation to be rejected")
+	}
+	if _, err := mw.ValidateToken(sign("jti-3", "sid-3", time.Now().Add(time.Minute))); err != nil {
+		t.Fatalf("expected token issued after user revocation to be valid: %v", err)
+	}
+}
+
+func TestTokenDenylistCutoffSameSecondReLogin(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	cutoff := time.Unix(1_700_000_000, 400*int64(time.Millisecond))
+	denylists := map[string]TokenDenylist{
+		"memory": NewInMemoryTokenDenylist(),
+		"redis":  NewRedisTokenDenylist(redis.NewClient(&redis.Options{Addr: mr.Addr()}), nil),
+	}
+	for name, denylist := range denylists {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if err := denylist.RevokeUser(ctx, "tenant-1", "user-1", cutoff, time.Hour); err != nil {
+				t.Fatalf("revoke user: %v", err)
+			}
+			if err := denylist.RevokeTenant(ctx, "tenant-2", cutoff, time.Hour); err != nil {
+				t.Fatalf("revoke tenant: %v", err)
+			}
+			before := cutoff.Add(-time.Second).Unix()
+			sameSecond := cutoff.Unix()
+
+			if !denylist.IsRevoked(ctx, &Claims{TenantID: "tenant-1", UserID: "user-1", IssuedAt: before}) {
+				t.Fatalf("expected user token issued before cutoff second to be revoked")
+			}
+			if denylist.IsRevoked(ctx, &Claims{TenantID: "tenant-1", UserID: "user-1", IssuedAt: sameSecond}) {
+				t.Fatalf("expected re-login within the revocation second to stay valid")
+			}
+			if !denylist.IsRevoked(ctx, &Claims{TenantID: "tenant-2", UserID: "user-9", IssuedAt: before}) {
+				t.Fatalf("expected tenant token issued before cutoff second to be revoked")
+			}
+			if denylist.IsRevoked(ctx, &Claims{TenantID: "tenant-2", UserID: "user-9", IssuedAt: sameSecond}) {
+				t.Fatalf("expected tenant re-login within the revocation second to stay valid")
+			}
+		})
+	}
+}
+
+func TestRedisTokenDenylistFailsClosed(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("miniredis: %v", err)
+	}
+	denylist := NewRedisTokenDenylist(redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1}), nil)
+	claims := &Claims{TenantID: "tenant-1", UserID: "user-1", SessionID: "sid-1", IssuedAt: time.Now().Unix()}
+	if denylist.IsRevoked(context.Background(), claims) {
+		t.Fatalf("expected token without denylist entries to be valid")
+	}
+	mr.Close()
+	if !denylist.IsRevoked(context.Background(), claims) {
+		t.Fatalf("expected token to be rejected when the denylist is unavailable")
+	}
+}
@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// TokenDenylist 已签发访问令牌的吊销名单（按 jti / 会话 / 用户 / 租户）
+//
+// 会话吊销后，已下发给前端的短期访问令牌在过期前仍然有效；
+// JWT 中间件在校验签名后查询该名单，拒绝被吊销的令牌。
+type TokenDenylist interface {
+	// IsRevoked 判断令牌是否已被吊销
+	IsRevoked(ctx context.Context, claims *Claims) bool
+	// RevokeToken 吊销单个令牌（jti）
+	RevokeToken(ctx context.Context, tokenID string, ttl time.Duration) error
+	// RevokeSession 吊销某会话派生的全部令牌（sid）
+	RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error
+	// RevokeUser 吊销用户在 cutoff 所在秒之前签发的全部令牌（见 issuedBeforeCutoff）
+	RevokeUser(ctx context.Context, tenantID, userID string, cutoff time.Time, ttl time.Duration) error
+	// RevokeTenant 吊销租户在 cutoff 所在秒之前签发的全部令牌（见 issuedBeforeCutoff）
+	RevokeTenant(ctx context.Context, tenantID string, cutoff time.Time, ttl time.Duration) error
+}
+
+func denylistTokenKey(tokenID string) string { return "auth:deny:jti:" + tokenID }
+func denylistSessionKey(sessionID string) string {
+	return "auth:deny:sid:" + sessionID
+}
+func denylistUserKey(tenantID, userID string) string {
+	return "auth:deny:user:" + tenantID + ":" + userID
+}
+func denylistTenantKey(tenantID string) string { return "auth:deny:tenant:" + tenantID }
+
+// issuedBeforeCutoff 判断令牌是否早于吊销时间签发。
+// JWT iat 仅精确到秒，吊销时间按所在秒记录并以严格小于比较：
+// 吊销同一秒内签发的令牌（如用户被踢下线后立即重新登录）不会被误拒；
+// 同一秒内吊销前签发的令牌由批量吊销时逐个写入的会话（sid）吊销覆盖。
+func issuedBeforeCutoff(issuedAt int64, cutoffUnix int64) bool {
+	return issuedAt < cutoffUnix
+}
+
+type denyEntry struct {
+	cutoff    time.Time
+	expiresAt time.Time
+}
+
+// InMemoryTokenDenylist 单实例内存实现（开发/测试使用）
+type InMemoryTokenDenylist struct {
+	mu      sync.RWMutex
+	entries map[string]denyEntry
+	now     func() time.Time
+}
+
+func NewInMemoryTokenDenylist() *InMemoryTokenDenylist {
+	return &InMemoryTokenDenylist{entries: map[string]denyEntry{}, now: time.Now}
+}
+
+func (d *InMemoryTokenDenylist) IsRevoked(_ context.Context, claims *Claims) bool {
+	if claims == nil {
+		return false
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	now := d.now()
+	active := func(key string) (denyEntry, bool) {
+		e, ok := d.entries[key]
+		if !ok || now.After(e.expiresAt) {
+			return denyEntry{}, false
+		}
+		return e, true
+	}
+	if claims.TokenID != "" {
+		if _, ok := active(denylistTokenKey(claims.TokenID)); ok {
+			return true
+		}
+	}
+	if claims.SessionID != "" {
+		if _, ok := active(denylistSessionKey(claims.SessionID)); ok {
+			return true
+		}
+	}
+	if e, ok := active(denylistUserKey(claims.TenantID, claims.UserID)); ok && issuedBeforeCutoff(claims.IssuedAt, e.cutoff.Unix()) {
+		return true
+	}
+	if e, ok := active(denylistTenantKey(claims.TenantID)); ok && issuedBeforeCutoff(claims.IssuedAt, e.cutoff.Unix()) {
+		return true
+	}
+	return false
+}
+
+func (d *InMemoryTokenDenylist) RevokeToken(_ context.Context, tokenID string, ttl time.Duration) error {
+	d.put(denylistTokenKey(tokenID), time.Time{}, ttl)
+	return nil
+}
+
+func (d *InMemoryTokenDenylist) RevokeSession(_ context.Context, sessionID string, ttl time.Duration) error {
+	d.put(denylistSessionKey(sessionID), time.Time{}, ttl)
+	return nil
+}
+
+func (d *InMemoryTokenDenylist) RevokeUser(_ context.Context, tenantID, userID string, cutoff time.Time, ttl time.Duration) error {
+	d.put(denylistUserKey(tenantID, userID), cutoff, ttl)
+	return nil
+}
+
+func (d *InMemoryTokenDenylist) RevokeTenant(_ context.Context, tenantID string, cutoff time.Time, ttl time.Duration) error {
+	d.put(denylistTenantKey(tenantID), cutoff, ttl)
+	return nil
+}
+
+func (d *InMemoryTokenDenylist) put(key string, cutoff time.Time, ttl time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	// 顺带清理过期条目，避免无限增长
+	for k, e := range d.entries {
+		if now.After(e.expiresAt) {
+			delete(d.entries, k)
+		}
+	}
+	d.entries[key] = denyEntry{cutoff: cutoff, expiresAt: now.Add(ttl)}
+}
+
+// RedisTokenDenylist 基于 Redis 的多副本共享实现；条目 TTL 与访问令牌最长有效期对齐
+type RedisTokenDenylist struct {
+	cli    *redis.Client
+	logger pkglogger.Logger
+}
+
+func NewRedisTokenDenylist(cli *redis.Client, logger pkglogger.Logger) *RedisTokenDenylist {
+	return &RedisTokenDenylist{cli: cli, logger: scopedLogger(logger, "tokenDenylist", pkglogger.Fields{"module": "auth"})}
+}
+
+func (d *RedisTokenDenylist) IsRevoked(ctx context.Context, claims *Claims) bool {
+	if claims == nil || d.cli == nil {
+		return false
+	}
+	keys := []string{
+		denylistTokenKey(claims.TokenID),
+		denylistSessionKey(claims.SessionID),
+		denylistUserKey(claims.TenantID, claims.UserID),
+		denylistTenantKey(claims.TenantID),
+	}
+	values, err := d.cli.MGet(ctx, keys...).Result()
+	if err != nil {
+		// 无法确认吊销状态时按已吊销处理，避免 Redis 故障期间放行已吊销的令牌
+		d.logger.WithFields(pkglogger.Fields{"tenantId": claims.TenantID, "userId": claims.UserID, "error": err}).Error("token denylist lookup failed; rejecting token")
+		return true
+	}
+	if claims.TokenID != "" && values[0] != nil {
+		return true
+	}
+	if claims.SessionID != "" && values[1] != nil {
+		return true
+	}
+	for _, v := range values[2:] {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		cutoff, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			continue
+		}
+		if issuedBeforeCutoff(claims.IssuedAt, cutoff) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *RedisTokenDenylist) RevokeToken(ctx context.Context, tokenID string, ttl time.Duration) error {
+	return d.cli.Set(ctx, denylistTokenKey(tokenID), "1", ttl).Err()
+}
+
+func (d *RedisTokenDenylist) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	return d.cli.Set(ctx, denylistSessionKey(sessionID), "1", ttl).Err()
+}
+
+func (d *RedisTokenDenylist) RevokeUser(ctx context.Context, tenantID, userID string, cutoff time.Time, ttl time.Duration) error {
+	return d.cli.Set(ctx, denylistUserKey(tenantID, userID), strconv.FormatInt(cutoff.Unix(), 10), ttl).Err()
+}
+
+func (d *RedisTokenDenylist) RevokeTenant(ctx context.Context, tenantID string, cutoff time.Time, ttl time.Duration) error {
+	return d.cli.Set(ctx, denylistTenantKey(tenantID), strconv.FormatInt(cutoff.Unix(), 10), ttl).Err()
+}
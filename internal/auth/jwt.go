@@ -22,6 +22,7 @@ type JWTMiddleware struct {
 	publicKey  interface{}
 	keyID      string
 	clockSkew  time.Duration
+	denylist   TokenDenylist
 }
 
 type contextKey string
@@ -31,6 +32,7 @@ const (
 	tenantIDKey   contextKey = "tenant_id"
 	userRolesKey  contextKey = "user_roles"
 	userScopesKey contextKey = "user_scopes"
+	platformOpKey contextKey = "platform_operator"
 )
 
 func NewJWTMiddleware(secretKey, issuer, audience string) *JWTMiddleware {
@@ -49,6 +51,8 @@ type Options struct {
 	PrivateKeyPEM []byte
 	KeyID         string
 	ClockSkew     time.Duration
+	// Denylist 可选：已吊销令牌名单（会话强制下线）
+	Denylist TokenDenylist
 }
 
 func NewJWTMiddlewareWithOptions(secretKey, issuer, audience string, opt Options) *JWTMiddleware {
@@ -59,6 +63,7 @@ func NewJWTMiddlewareWithOptions(secretKey, issuer, audience string, opt Options
 		alg:       strings.ToUpper(strings.TrimSpace(opt.Alg)),
 		keyID:     strings.TrimSpace(opt.KeyID),
 		clockSkew: opt.ClockSkew,
+		denylist:  opt.Denylist,
 	}
 	if mw.alg == "" {
 		mw.alg = "RS256"
@@ -94,6 +99,17 @@ type Claims struct {
 	// PBAC scopes
 	Scope       string   `json:"scope"`
 	Permissions []string `json:"permissions"`
+	// PlatformOperator 平台运营方标记，仅由 BFF 按平台 IdP 运营组签发
+	PlatformOperator bool `json:"platform_operator"`
+	// 吊销检查所需标识
+	TokenID   string `json:"jti"`
+	SessionID string `json:"sid"`
+	IssuedAt  int64  `json:"iat"`
+}
+
+// SetDenylist 设置已吊销令牌名单（nil 表示关闭检查）
+func (j *JWTMiddleware) SetDenylist(d TokenDenylist) {
+	j.denylist = d
 }
 
 // ValidateToken 验证JWT令牌
@@ -147,8 +163,13 @@ func (j *JWTMiddleware) ValidateToken(tokenString string) (*Claims, error) {
 		}
 
 		userClaims := &Claims{
-			UserID:   getClaimString(claims, "sub"),
-			TenantID: getTenantIDClaim(claims),
+			UserID:    getClaimString(claims, "sub"),
+			TenantID:  getTenantIDClaim(claims),
+			TokenID:   getClaimString(claims, "jti"),
+			SessionID: getClaimString(claims, "sid"),
+		}
+		if iat, ok := claims["iat"].(float64); ok {
+			userClaims.IssuedAt = int64(iat)
 		}
 
 		if rolesClaim, ok := claims["roles"].([]interface{}); ok {
@@ -161,6 +182,9 @@ func (j *JWTMiddleware) ValidateToken(tokenString string) (*Claims, error) {
 		if scopeStr, ok := claims["scope"].(string); ok {
 			userClaims.Scope = scopeStr
 		}
+		if op, ok := claims["platform_operator"].(bool); ok {
+			userClaims.PlatformOperator = op
+		}
 		if perms, ok := claims["permissions"].([]interface{}); ok {
 			for _, p := range perms {
 				if ps, ok := p.(string); ok {
@@ -181,6 +205,9 @@ func (j *JWTMiddleware) ValidateToken(tokenString string) (*Claims, error) {
 				return nil, fmt.Errorf("token not valid yet")
 			}
 		}
+		if j.denylist != nil && j.denylist.IsRevoked(context.Background(), userClaims) {
+			return nil, fmt.Errorf("token revoked")
+		}
 
 		return userClaims, nil
 	}
@@ -220,6 +247,7 @@ func SetUserContext(ctx context.Context, claims *Claims) context.Context {
 		scopes = append(scopes, s)
 	}
 	ctx = context.WithValue(ctx, userScopesKey, scopes)
+	ctx = context.WithValue(ctx, platformOpKey, claims.PlatformOperator)
 	return ctx
 }
 
@@ -251,6 +279,12 @@ func GetUserScopes(ctx context.Context) []string {
 	return []string{}
 }
 
+// IsPlatformOperator 判断当前令牌是否由平台签发了运营方标记
+func IsPlatformOperator(ctx context.Context) bool {
+	v, _ := ctx.Value(platformOpKey).(bool)
+	return v
+}
+
 // GenerateTestToken 生成测试用的JWT令牌 (仅开发环境使用)
 func (j *JWTMiddleware) GenerateTestToken(userID, tenantID string, roles []string, duration time.Duration) (string, error) {
 	return j.GenerateTestTokenWithClaims(userID, tenantID, roles, "", nil, duration)
@@ -69,6 +69,15 @@ var RolePermissions = map[string][]string{
 	"GUEST": {},
 }
 
+// IsKnownRole 判断角色是否在 GraphQL/REST 角色预设中定义（用于外部 IdP 角色映射校验）
+func IsKnownRole(role string) bool {
+	if _, ok := RolePermissions[role]; ok {
+		return true
+	}
+	_, ok := restRolePermissions[role]
+	return ok
+}
+
 // CheckPermission 检查权限的主方法
 func (p *PBACPermissionChecker) CheckPermission(ctx context.Context, resource string) error {
 	tenantID := GetTenantID(ctx)
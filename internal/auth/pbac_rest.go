@@ -11,34 +11,119 @@ import (
 
 // RESTAPIPermissions 定义 REST 端点与权限映射
 var RESTAPIPermissions = map[string]string{
-	"POST /api/v1/organization-units":            "WRITE_ORGANIZATION",
-	"PUT /api/v1/organization-units/*":           "UPDATE_ORGANIZATION",
-	"POST /api/v1/organization-units/*/suspend":  "SUSPEND_ORGANIZATION",
-	"POST /api/v1/organization-units/*/activate": "ACTIVATE_ORGANIZATION",
-	"POST /api/v1/organization-units/*/events":   "MANAGE_ORGANIZATION_EVENTS",
-	"POST /api/v1/organization-units/*/versions": "CREATE_TEMPORAL_VERSION",
-	"PUT /api/v1/organization-units/*/history/*": "UPDATE_ORGANIZATION_HISTORY",
-	"GET /api/v1/operational/health":             "SYSTEM_MONITOR_READ",
-	"GET /api/v1/operational/metrics":            "SYSTEM_MONITOR_READ",
-	"GET /api/v1/operational/alerts":             "SYSTEM_MONITOR_READ",
-	"GET /api/v1/operational/rate-limit/stats":   "SYSTEM_MONITOR_READ",
-	"GET /api/v1/operational/tasks":              "SYSTEM_OPS_READ",
-	"GET /api/v1/operational/tasks/status":       "SYSTEM_OPS_READ",
-	"POST /api/v1/operational/tasks/*/trigger":   "SYSTEM_OPS_WRITE",
-	"POST /api/v1/operational/cutover":           "SYSTEM_OPS_WRITE",
-	"POST /api/v1/operational/consistency-check": "SYSTEM_OPS_WRITE",
-	"POST /api/v1/job-family-groups":             "job-catalog:write",
-	"PUT /api/v1/job-family-groups/*":            "job-catalog:write",
-	"POST /api/v1/job-family-groups/*/versions":  "job-catalog:write",
-	"POST /api/v1/job-families":                  "job-catalog:write",
-	"PUT /api/v1/job-families/*":                 "job-catalog:write",
-	"POST /api/v1/job-families/*/versions":       "job-catalog:write",
-	"POST /api/v1/job-roles":                     "job-catalog:write",
-	"PUT /api/v1/job-roles/*":                    "job-catalog:write",
-	"POST /api/v1/job-roles/*/versions":          "job-catalog:write",
-	"POST /api/v1/job-levels":                    "job-catalog:write",
-	"PUT /api/v1/job-levels/*":                   "job-catalog:write",
-	"POST /api/v1/job-levels/*/versions":         "job-catalog:write",
+	"POST /api/v1/organization-units":              "WRITE_ORGANIZATION",
+	"PUT /api/v1/organization-units/*":             "UPDATE_ORGANIZATION",
+	"POST /api/v1/organization-units/*/suspend":    "SUSPEND_ORGANIZATION",
+	"POST /api/v1/organization-units/*/activate":   "ACTIVATE_ORGANIZATION",
+	"POST /api/v1/organization-units/*/events":     "MANAGE_ORGANIZATION_EVENTS",
+	"POST /api/v1/organization-units/*/versions":   "CREATE_TEMPORAL_VERSION",
+	"PUT /api/v1/organization-units/*/history/*":   "UPDATE_ORGANIZATION_HISTORY",
+	"GET /api/v1/operational/health":               "SYSTEM_MONITOR_READ",
+	"GET /api/v1/operational/metrics":              "SYSTEM_MONITOR_READ",
+	"GET /api/v1/operational/alerts":               "SYSTEM_MONITOR_READ",
+	"GET /api/v1/operational/rate-limit/stats":     "SYSTEM_MONITOR_READ",
+	"GET /api/v1/operational/slo":                  "SYSTEM_MONITOR_READ",
+	"GET /api/v1/operational/runtime-config":       "SYSTEM_OPS_READ",
+	"PATCH /api/v1/operational/runtime-config":     "SYSTEM_OPS_WRITE",
+	"GET /api/v1/operational/tasks":                "SYSTEM_OPS_READ",
+	"GET /api/v1/operational/tasks/status":         "SYSTEM_OPS_READ",
+	"POST /api/v1/operational/tasks/*/trigger":     "SYSTEM_OPS_WRITE",
+	"POST /api/v1/operational/cutover":             "SYSTEM_OPS_WRITE",
+	"POST /api/v1/operational/consistency-check":   "SYSTEM_OPS_WRITE",
+	"GET /api/v1/audit/chain/verify":               "AUDIT_CHAIN_VERIFY",
+	"GET /api/v1/audit/chain/checkpoints":          "AUDIT_CHAIN_VERIFY",
+	"POST /api/v1/audit/chain/checkpoints":         "AUDIT_CHAIN_CHECKPOINT",
+	"GET /api/v1/audit/export":                     "AUDIT_EXPORT",
+	"GET /api/v1/audit/archives":                   "AUDIT_EXPORT",
+	"GET /api/v1/audit/retention-policy":           "AUDIT_RETENTION_ADMIN",
+	"PUT /api/v1/audit/retention-policy":           "AUDIT_RETENTION_ADMIN",
+	"GET /api/v1/auth/sessions":                    "SESSION_ADMIN",
+	"DELETE /api/v1/auth/sessions/*":               "SESSION_ADMIN",
+	"POST /api/v1/auth/sessions/revoke-all":        "SESSION_ADMIN",
+	"POST /api/v1/auth/users/*/sessions/revoke":    "SESSION_ADMIN",
+	"GET /api/v1/notifications/subscriptions":      "NOTIFICATION_ADMIN",
+	"POST /api/v1/notifications/subscriptions":     "NOTIFICATION_ADMIN",
+	"PUT /api/v1/notifications/subscriptions/*":    "NOTIFICATION_ADMIN",
+	"DELETE /api/v1/notifications/subscriptions/*": "NOTIFICATION_ADMIN",
+	"GET /api/v1/notifications/deliveries":         "NOTIFICATION_ADMIN",
+	"GET /api/v1/notifications/inbox":              "NOTIFICATION_INBOX",
+	"POST /api/v1/notifications/inbox/*/read":      "NOTIFICATION_INBOX",
+	"GET /api/v1/webhooks/endpoints":               "WEBHOOK_ADMIN",
+	"POST /api/v1/webhooks/endpoints":              "WEBHOOK_ADMIN",
+	"GET /api/v1/webhooks/endpoints/*":             "WEBHOOK_ADMIN",
+	"PUT /api/v1/webhooks/endpoints/*":             "WEBHOOK_ADMIN",
+	"DELETE /api/v1/webhooks/endpoints/*":          "WEBHOOK_ADMIN",
+	"POST /api/v1/webhooks/endpoints/*":            "WEBHOOK_ADMIN",
+	"GET /api/v1/webhooks/deliveries":              "WEBHOOK_ADMIN",
+	"GET /api/v1/webhooks/deliveries/*":            "WEBHOOK_ADMIN",
+	"POST /api/v1/webhooks/deliveries/*":           "WEBHOOK_ADMIN",
+	"GET /api/v1/custom-fields":                    "CUSTOM_FIELD_READ",
+	"GET /api/v1/custom-fields/*":                  "CUSTOM_FIELD_READ",
+	"POST /api/v1/custom-fields":                   "CUSTOM_FIELD_ADMIN",
+	"PUT /api/v1/custom-fields/*":                  "CUSTOM_FIELD_ADMIN",
+	"DELETE /api/v1/custom-fields/*":               "CUSTOM_FIELD_ADMIN",
+	"GET /api/v1/organization-unit-types":          "ORG_UNIT_TYPE_READ",
+	"GET /api/v1/organization-unit-types/*":        "ORG_UNIT_TYPE_READ",
+	"POST /api/v1/organization-unit-types":         "ORG_UNIT_TYPE_ADMIN",
+	"PUT /api/v1/organization-unit-types/*":        "ORG_UNIT_TYPE_ADMIN",
+	"DELETE /api/v1/organization-unit-types/*":     "ORG_UNIT_TYPE_ADMIN",
+	"GET /scim/v2/*":                               "SCIM_PROVISION",
+	"POST /scim/v2/*":                              "SCIM_PROVISION",
+	"PUT /scim/v2/*":                               "SCIM_PROVISION",
+	"PATCH /scim/v2/*":                             "SCIM_PROVISION",
+	"DELETE /scim/v2/*":                            "SCIM_PROVISION",
+	"POST /api/v1/job-family-groups":               "job-catalog:write",
+	"PUT /api/v1/job-family-groups/*":              "job-catalog:write",
+	"POST /api/v1/job-family-groups/*/versions":    "job-catalog:write",
+	"POST /api/v1/job-families":                    "job-catalog:write",
+	"PUT /api/v1/job-families/*":                   "job-catalog:write",
+	"POST /api/v1/job-families/*/versions":         "job-catalog:write",
+	"POST /api/v1/job-roles":                       "job-catalog:write",
+	"PUT /api/v1/job-roles/*":                      "job-catalog:write",
+	"POST /api/v1/job-roles/*/versions":            "job-catalog:write",
+	"POST /api/v1/job-levels":                      "job-catalog:write",
+	"PUT /api/v1/job-levels/*":                     "job-catalog:write",
+	"POST /api/v1/job-levels/*/versions":           "job-catalog:write",
+	"POST /api/v1/job-family-groups/*/retire":      "job-catalog:write",
+	"POST /api/v1/job-families/*/retire":           "job-catalog:write",
+	"POST /api/v1/job-roles/*/retire":              "job-catalog:write",
+	"POST /api/v1/job-levels/*/retire":             "job-catalog:write",
+	"GET /api/v1/job-catalog/export":               "job-catalog:read",
+	"POST /api/v1/job-catalog/import":              "job-catalog:write",
+	"POST /api/v1/job-catalog/clone":               "job-catalog:clone",
+	"GET /api/v1/compensation-grades":              "compensation:read",
+	"GET /api/v1/compensation-grades/*":            "compensation:read",
+	"GET /api/v1/compensation-grades/*/versions":   "compensation:read",
+	"POST /api/v1/compensation-grades":             "compensation:write",
+	"PUT /api/v1/compensation-grades/*":            "compensation:write",
+	"DELETE /api/v1/compensation-grades/*":         "compensation:write",
+	"POST /api/v1/compensation-grades/*/versions":  "compensation:write",
+	"GET /api/v1/cost-centers":                     "cost-center:read",
+	"GET /api/v1/cost-centers/*":                   "cost-center:read",
+	"GET /api/v1/cost-centers/*/versions":          "cost-center:read",
+	"POST /api/v1/cost-centers":                    "cost-center:write",
+	"PUT /api/v1/cost-centers/*":                   "cost-center:write",
+	"DELETE /api/v1/cost-centers/*":                "cost-center:write",
+	"POST /api/v1/cost-centers/*/versions":         "cost-center:write",
+	"PUT /api/v1/positions/*/cost-allocations":     "cost-center:write",
+	"GET /api/v1/locations":                        "location:read",
+	"GET /api/v1/locations/*":                      "location:read",
+	"GET /api/v1/locations/*/versions":             "location:read",
+	"POST /api/v1/locations":                       "location:write",
+	"PUT /api/v1/locations/*":                      "location:write",
+	"DELETE /api/v1/locations/*":                   "location:write",
+	"POST /api/v1/locations/*/versions":            "location:write",
+	"PUT /api/v1/location-assignments/*":           "location:write",
+
+	// 职位/任职生命周期迁移规则
+	"GET /api/v1/position-lifecycle/transitions":      "position-lifecycle:read",
+	"PUT /api/v1/position-lifecycle/transitions/*":    "position-lifecycle:write",
+	"DELETE /api/v1/position-lifecycle/transitions/*": "position-lifecycle:write",
+}
+
+// platformRESTPermissions 平台级权限：仅授予携带平台运营方标记的令牌，租户角色、用户授权与令牌 scope 均不授予
+var platformRESTPermissions = map[string]bool{
+	"job-catalog:clone": true,
 }
 
 // restRolePermissions 定义 REST 角色权限
@@ -54,22 +139,65 @@ var restRolePermissions = map[string][]string{
 		"SYSTEM_MONITOR_READ",
 		"SYSTEM_OPS_READ",
 		"SYSTEM_OPS_WRITE",
+		"AUDIT_CHAIN_VERIFY",
+		"AUDIT_CHAIN_CHECKPOINT",
+		"AUDIT_EXPORT",
+		"AUDIT_RETENTION_ADMIN",
+		"SESSION_ADMIN",
+		"SCIM_PROVISION",
+		"NOTIFICATION_ADMIN",
+		"NOTIFICATION_INBOX",
+		"WEBHOOK_ADMIN",
+		"CUSTOM_FIELD_READ",
+		"CUSTOM_FIELD_ADMIN",
+		"ORG_UNIT_TYPE_READ",
+		"ORG_UNIT_TYPE_ADMIN",
+		"job-catalog:read",
 		"job-catalog:write",
+		"compensation:read",
+		"compensation:write",
+		"cost-center:read",
+		"cost-center:write",
+		"location:read",
+		"location:write",
+		"position-lifecycle:read",
+		"position-lifecycle:write",
 	},
 	"MANAGER": {
 		"WRITE_ORGANIZATION",
 		"UPDATE_ORGANIZATION",
 		"SUSPEND_ORGANIZATION",
 		"ACTIVATE_ORGANIZATION",
+		"NOTIFICATION_INBOX",
+		"CUSTOM_FIELD_READ",
+		"ORG_UNIT_TYPE_READ",
+		"job-catalog:read",
 		"job-catalog:write",
+		"compensation:read",
+		"cost-center:read",
+		"location:read",
+		"position-lifecycle:read",
 	},
 	"HR_STAFF": {
 		"WRITE_ORGANIZATION",
 		"UPDATE_ORGANIZATION",
+		"NOTIFICATION_INBOX",
+		"CUSTOM_FIELD_READ",
+		"ORG_UNIT_TYPE_READ",
+		"job-catalog:read",
 		"job-catalog:write",
+		"compensation:read",
+		"compensation:write",
+		"cost-center:read",
+		"cost-center:write",
+		"location:read",
+		"location:write",
+		"position-lifecycle:read",
+	},
+	"EMPLOYEE": {
+		"NOTIFICATION_INBOX",
 	},
-	"EMPLOYEE": {},
-	"GUEST":    {},
+	"GUEST": {},
 }
 
 // CheckRESTPermission 按 method/path 检查权限
@@ -95,6 +223,14 @@ func (p *PBACPermissionChecker) CheckRESTPermission(ctx context.Context, method,
 		return fmt.Errorf("unknown endpoint: %s %s", method, path)
 	}
 
+	if platformRESTPermissions[requiredPermission] {
+		if IsPlatformOperator(ctx) {
+			logger.Info("REST access granted via platform operator")
+			return nil
+		}
+		return fmt.Errorf("access denied for: %s %s", method, path)
+	}
+
 	if p.checkUserPermission(ctx, tenantID, userID, requiredPermission) {
 		return nil
 	}
@@ -83,7 +83,7 @@ func (r *RESTPermissionMiddleware) handleDevMode(w http.ResponseWriter, req *htt
 		return
 	}
 
-	tenantHeader := strings.TrimSpace(req.Header.Get("X-Tenant-ID"))
+	tenantHeader := requestTenant(req, claims)
 	if tenantHeader == "" {
 		r.writeErrorResponse(w, req, logger, "TENANT_HEADER_REQUIRED", "X-Tenant-ID header required", http.StatusUnauthorized)
 		return
@@ -119,7 +119,7 @@ func (r *RESTPermissionMiddleware) handleProductionMode(w http.ResponseWriter, r
 		return
 	}
 
-	tenantHeader := strings.TrimSpace(req.Header.Get("X-Tenant-ID"))
+	tenantHeader := requestTenant(req, claims)
 	if tenantHeader == "" {
 		r.writeErrorResponse(w, req, logger, "TENANT_HEADER_REQUIRED", "X-Tenant-ID header required", http.StatusUnauthorized)
 		return
@@ -159,3 +159,12 @@ func (r *RESTPermissionMiddleware) CheckAPIPermission(ctx context.Context, metho
 	}
 	return r.permissionChecker.CheckRESTPermission(ctx, method, path)
 }
+
+// requestTenant 读取 X-Tenant-ID；SCIM 客户端（IdP）通常无法附加自定义头，/scim/ 路径以令牌内租户为准
+func requestTenant(req *http.Request, claims *Claims) string {
+	tenant := strings.TrimSpace(req.Header.Get("X-Tenant-ID"))
+	if tenant == "" && claims != nil && strings.HasPrefix(req.URL.Path, "/scim/") {
+		return claims.TenantID
+	}
+	return tenant
+}
@@ -78,6 +78,20 @@ func (c *L1Cache) Get(key string) (CacheEntry, bool) {
 	return item.entry, true
 }
 
+// TTL 返回新写入条目的存活时长。
+func (c *L1Cache) TTL() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ttl
+}
+
+// SetTTL 调整新写入条目的存活时长，已有条目保持原过期时间。
+func (c *L1Cache) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
 // Set 写入缓存条目。
 func (c *L1Cache) Set(key string, entry CacheEntry) {
 	c.mu.Lock()
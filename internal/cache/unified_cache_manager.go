@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +22,8 @@ type UnifiedCacheManager struct {
 	eventBus *CacheEventBus   // 缓存事件总线
 	logger   pkglogger.Logger
 	config   *CacheConfig
+	// l2TTLNanos 运行时可调整的 L2 TTL（config.L2TTL 仅作为初始值）
+	l2TTLNanos atomic.Int64
 }
 
 // 缓存配置
@@ -120,6 +123,7 @@ func NewUnifiedCacheManager(redisClient *redis.Client, l3Query L3QueryInterface,
 		logger: managerLogger,
 		config: config,
 	}
+	ucm.l2TTLNanos.Store(int64(config.L2TTL))
 
 	// 启动缓存事件监听
 	go ucm.startEventListener()
@@ -127,6 +131,26 @@ func NewUnifiedCacheManager(redisClient *redis.Client, l3Query L3QueryInterface,
 	return ucm
 }
 
+func (ucm *UnifiedCacheManager) l2TTL() time.Duration {
+	return time.Duration(ucm.l2TTLNanos.Load())
+}
+
+// TTLs 返回当前 L1/L2 TTL。
+func (ucm *UnifiedCacheManager) TTLs() (l1, l2 time.Duration) {
+	return ucm.l1Cache.TTL(), ucm.l2TTL()
+}
+
+// UpdateTTLs 运行时调整 L1/L2 TTL（非正值保持不变），仅影响之后写入的条目。
+func (ucm *UnifiedCacheManager) UpdateTTLs(l1, l2 time.Duration) {
+	if l1 > 0 {
+		ucm.l1Cache.SetTTL(l1)
+	}
+	if l2 > 0 {
+		ucm.l2TTLNanos.Store(int64(l2))
+	}
+	ucm.logger.WithFields(pkglogger.Fields{"l1TTL": ucm.l1Cache.TTL().String(), "l2TTL": ucm.l2TTL().String()}).Info("cache ttl updated")
+}
+
 // ==================== 查询接口 ====================
 
 // 获取组织列表 - 三层缓存策略
@@ -205,14 +229,14 @@ func (ucm *UnifiedCacheManager) GetOrganizations(ctx context.Context, tenantID u
 		},
 		Tags:      []string{fmt.Sprintf("tenant:%s", tenantID.String()), "type:organizations"},
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(ucm.config.L2TTL),
+		ExpiresAt: time.Now().Add(ucm.l2TTL()),
 	}
 
 	// 同时写入L1和L2
 	ucm.l1Cache.Set(cacheKey, entry)
 
 	if cacheData, err := json.Marshal(entry); err == nil {
-		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.config.L2TTL)
+		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.l2TTL())
 		ucm.logger.WithFields(pkglogger.Fields{
 			"event":     "refresh",
 			"layer":     "multi",
@@ -299,13 +323,13 @@ func (ucm *UnifiedCacheManager) GetOrganizationStats(ctx context.Context, tenant
 		},
 		Tags:      []string{fmt.Sprintf("tenant:%s", tenantID.String()), "type:stats"},
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(ucm.config.L2TTL),
+		ExpiresAt: time.Now().Add(ucm.l2TTL()),
 	}
 
 	ucm.l1Cache.Set(cacheKey, entry)
 
 	if cacheData, err := json.Marshal(entry); err == nil {
-		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.config.L2TTL)
+		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.l2TTL())
 		ucm.logger.WithFields(pkglogger.Fields{
 			"event":    "refresh",
 			"layer":    "multi",
@@ -389,13 +413,13 @@ func (ucm *UnifiedCacheManager) GetOrganization(ctx context.Context, tenantID uu
 		},
 		Tags:      []string{fmt.Sprintf("tenant:%s", tenantID.String()), fmt.Sprintf("org:%s", code)},
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(ucm.config.L2TTL),
+		ExpiresAt: time.Now().Add(ucm.l2TTL()),
 	}
 
 	ucm.l1Cache.Set(cacheKey, entry)
 
 	if cacheData, err := json.Marshal(entry); err == nil {
-		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.config.L2TTL)
+		ucm.l2Cache.Set(ctx, cacheKey, string(cacheData), ucm.l2TTL())
 		ucm.logger.WithFields(pkglogger.Fields{
 			"event":    "refresh",
 			"layer":    "multi",
@@ -465,13 +489,13 @@ func (ucm *UnifiedCacheManager) handleCreateEvent(ctx context.Context, event Cac
 		},
 		Tags:      []string{fmt.Sprintf("tenant:%s", org.TenantID), fmt.Sprintf("org:%s", org.Code)},
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(ucm.config.L2TTL),
+		ExpiresAt: time.Now().Add(ucm.l2TTL()),
 	}
 
 	ucm.l1Cache.Set(orgKey, entry)
 
 	if cacheData, err := json.Marshal(entry); err == nil {
-		ucm.l2Cache.Set(ctx, orgKey, string(cacheData), ucm.config.L2TTL)
+		ucm.l2Cache.Set(ctx, orgKey, string(cacheData), ucm.l2TTL())
 	}
 
 	// 2. 智能更新列表缓存
@@ -506,13 +530,13 @@ func (ucm *UnifiedCacheManager) handleUpdateEvent(ctx context.Context, event Cac
 		},
 		Tags:      []string{fmt.Sprintf("tenant:%s", org.TenantID), fmt.Sprintf("org:%s", org.Code)},
 		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(ucm.config.L2TTL),
+		ExpiresAt: time.Now().Add(ucm.l2TTL()),
 	}
 
 	ucm.l1Cache.Set(orgKey, entry)
 
 	if cacheData, err := json.Marshal(entry); err == nil {
-		ucm.l2Cache.Set(ctx, orgKey, string(cacheData), ucm.config.L2TTL)
+		ucm.l2Cache.Set(ctx, orgKey, string(cacheData), ucm.l2TTL())
 	}
 
 	// 2. 智能更新列表缓存
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AlertRulesConfig 声明式告警规则：指标阈值、持续时间与按严重级别路由的渠道。
+type AlertRulesConfig struct {
+	EvaluationInterval time.Duration
+	Channels           []AlertChannelConfig
+	// Routes 严重级别 -> 渠道名称；规则未指定 channels 时按级别路由
+	Routes map[string][]string
+	Rules  []MetricAlertRule
+}
+
+// AlertChannelConfig 告警渠道定义；log 与 email 渠道为内置，无需声明。
+type AlertChannelConfig struct {
+	Name string
+	Type string // webhook | slack
+	// URL 展开环境变量后为空时渠道视为未启用，投递时跳过
+	URL     string
+	Channel string // slack 频道
+	Headers map[string]string
+}
+
+// MetricAlertRule 单条指标告警规则，条件持续 For 时长后触发。
+type MetricAlertRule struct {
+	Name        string
+	Description string
+	Metric      string
+	Operator    string
+	Threshold   float64
+	For         time.Duration
+	Severity    string
+	Channels    []string
+}
+
+// Matches 判断指标取值是否满足规则条件。
+func (r MetricAlertRule) Matches(value float64) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	case "==":
+		return value == r.Threshold
+	case "!=":
+		return value != r.Threshold
+	}
+	return false
+}
+
+const defaultAlertEvaluationInterval = time.Minute
+
+var (
+	alertOperators    = map[string]bool{">": true, ">=": true, "<": true, "<=": true, "==": true, "!=": true}
+	alertSeverities   = map[string]bool{"info": true, "warning": true, "critical": true}
+	alertChannelTypes = map[string]bool{"webhook": true, "slack": true}
+)
+
+// ResolveAlertRulesFile 返回告警规则文件路径：ALERT_RULES_FILE 优先，其次 config/alert_rules.yaml。
+func ResolveAlertRulesFile() string {
+	if v := strings.TrimSpace(os.Getenv("ALERT_RULES_FILE")); v != "" {
+		return v
+	}
+	defaultPath := filepath.Join("config", "alert_rules.yaml")
+	if _, err := os.Stat(defaultPath); err == nil {
+		return defaultPath
+	}
+	return ""
+}
+
+// DefaultAlertRulesConfig 未提供规则文件时使用的内置规则（与时态监控默认阈值一致）。
+func DefaultAlertRulesConfig() *AlertRulesConfig {
+	return &AlertRulesConfig{
+		EvaluationInterval: defaultAlertEvaluationInterval,
+		Routes: map[string][]string{
+			"info":     {"log"},
+			"warning":  {"log"},
+			"critical": {"log"},
+		},
+		Rules: []MetricAlertRule{
+			{Name: "DUPLICATE_CURRENT_RECORDS", Description: "重复的当前记录数量超过阈值", Metric: "temporal.duplicate_current_records", Operator: ">", Threshold: 0, Severity: "critical"},
+			{Name: "MISSING_CURRENT_RECORDS", Description: "缺失当前记录的组织数量超过阈值", Metric: "temporal.missing_current_records", Operator: ">", Threshold: 0, Severity: "critical"},
+			{Name: "TIMELINE_OVERLAPS", Description: "时间线重叠记录数量超过阈值", Metric: "temporal.timeline_overlaps", Operator: ">", Threshold: 0, Severity: "critical"},
+			{Name: "INCONSISTENT_FLAGS", Description: "is_current/is_future标志不一致记录数量超过阈值", Metric: "temporal.inconsistent_flags", Operator: ">", Threshold: 5, Severity: "warning"},
+			{Name: "ORPHAN_RECORDS", Description: "孤立记录（父级不存在）数量超过阈值", Metric: "temporal.orphan_records", Operator: ">", Threshold: 10, Severity: "warning"},
+			{Name: "HEALTH_SCORE", Description: "系统健康分数低于阈值", Metric: "temporal.health_score", Operator: "<", Threshold: 85, Severity: "warning"},
+		},
+	}
+}
+
+type alertRulesYAML struct {
+	EvaluationInterval string `yaml:"evaluationInterval"`
+	Channels           []struct {
+		Name    string            `yaml:"name"`
+		Type    string            `yaml:"type"`
+		URL     string            `yaml:"url"`
+		Channel string            `yaml:"channel"`
+		Headers map[string]string `yaml:"headers"`
+	} `yaml:"channels"`
+	Routes map[string][]string `yaml:"routes"`
+	Rules  []struct {
+		Name        string   `yaml:"name"`
+		Description string   `yaml:"description"`
+		Metric      string   `yaml:"metric"`
+		Operator    string   `yaml:"op"`
+		Threshold   *float64 `yaml:"threshold"`
+		For         string   `yaml:"for"`
+		Severity    string   `yaml:"severity"`
+		Channels    []string `yaml:"channels"`
+	} `yaml:"rules"`
+}
+
+// LoadAlertRulesFile 读取并校验告警规则文件；URL 等字段支持 ${ENV} 展开。
+func LoadAlertRulesFile(path string) (*AlertRulesConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read alert rules: %w", err)
+	}
+	return ParseAlertRules(data)
+}
+
+// ParseAlertRules 解析告警规则 YAML。
+func ParseAlertRules(data []byte) (*AlertRulesConfig, error) {
+	var raw alertRulesYAML
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse alert rules: %w", err)
+	}
+
+	cfg := &AlertRulesConfig{
+		EvaluationInterval: defaultAlertEvaluationInterval,
+		Routes:             map[string][]string{},
+	}
+	if raw.EvaluationInterval != "" {
+		d, err := time.ParseDuration(raw.EvaluationInterval)
+		if err != nil {
+			return nil, fmt.Errorf("evaluationInterval: %w", err)
+		}
+		cfg.EvaluationInterval = d
+	}
+	for _, item := range raw.Channels {
+		ch := AlertChannelConfig{
+			Name:    strings.TrimSpace(item.Name),
+			Type:    strings.ToLower(strings.TrimSpace(item.Type)),
+			URL:     os.ExpandEnv(item.URL),
+			Channel: item.Channel,
+			Headers: map[string]string{},
+		}
+		for k, v := range item.Headers {
+			ch.Headers[k] = os.ExpandEnv(v)
+		}
+		cfg.Channels = append(cfg.Channels, ch)
+	}
+	for severity, channels := range raw.Routes {
+		cfg.Routes[strings.ToLower(severity)] = channels
+	}
+	for _, item := range raw.Rules {
+		rule := MetricAlertRule{
+			Name:        strings.TrimSpace(item.Name),
+			Description: item.Description,
+			Metric:      strings.TrimSpace(item.Metric),
+			Operator:    strings.TrimSpace(item.Operator),
+			Severity:    strings.ToLower(strings.TrimSpace(item.Severity)),
+			Channels:    item.Channels,
+		}
+		if item.Threshold != nil {
+			rule.Threshold = *item.Threshold
+		}
+		if item.For != "" {
+			d, err := time.ParseDuration(item.For)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: for: %w", rule.Name, err)
+			}
+			rule.For = d
+		}
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	if err := ValidateAlertRulesConfig(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ValidateAlertRulesConfig 校验规则、渠道与路由的引用关系。
+func ValidateAlertRulesConfig(cfg *AlertRulesConfig) error {
+	if cfg == nil {
+		return errors.New("alert rules config is nil")
+	}
+	var errs []error
+	if cfg.EvaluationInterval <= 0 {
+		errs = append(errs, errors.New("evaluationInterval must be > 0"))
+	}
+
+	known := map[string]bool{"log": true, "email": true}
+	for _, ch := range cfg.Channels {
+		switch {
+		case ch.Name == "":
+			errs = append(errs, errors.New("channel name is required"))
+		case known[ch.Name]:
+			errs = append(errs, fmt.Errorf("channel %s: duplicate or reserved name", ch.Name))
+		case !alertChannelTypes[ch.Type]:
+			errs = append(errs, fmt.Errorf("channel %s: unsupported type %q", ch.Name, ch.Type))
+		}
+		known[ch.Name] = true
+	}
+	for severity, channels := range cfg.Routes {
+		if !alertSeverities[severity] {
+			errs = append(errs, fmt.Errorf("routes: unsupported severity %q", severity))
+		}
+		for _, name := range channels {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("routes.%s: unknown channel %q", severity, name))
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range cfg.Rules {
+		if rule.Name == "" {
+			errs = append(errs, errors.New("rule name is required"))
+			continue
+		}
+		if seen[rule.Name] {
+			errs = append(errs, fmt.Errorf("rule %s: duplicate name", rule.Name))
+		}
+		seen[rule.Name] = true
+		if rule.Metric == "" {
+			errs = append(errs, fmt.Errorf("rule %s: metric is required", rule.Name))
+		}
+		if !alertOperators[rule.Operator] {
+			errs = append(errs, fmt.Errorf("rule %s: unsupported op %q", rule.Name, rule.Operator))
+		}
+		if !alertSeverities[rule.Severity] {
+			errs = append(errs, fmt.Errorf("rule %s: unsupported severity %q", rule.Name, rule.Severity))
+		}
+		if rule.For < 0 {
+			errs = append(errs, fmt.Errorf("rule %s: for must be >= 0", rule.Name))
+		}
+		for _, name := range rule.Channels {
+			if !known[name] {
+				errs = append(errs, fmt.Errorf("rule %s: unknown channel %q", rule.Name, name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ChannelsFor 返回规则应投递的渠道：规则显式指定优先，否则按严重级别路由，均未配置时回落到 log。
+func (c *AlertRulesConfig) ChannelsFor(rule MetricAlertRule) []string {
+	if len(rule.Channels) > 0 {
+		return rule.Channels
+	}
+	if channels := c.Routes[rule.Severity]; len(channels) > 0 {
+		return channels
+	}
+	return []string{"log"}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAlertRules(t *testing.T) {
+	t.Setenv("TEST_ALERT_HOOK", "https://hooks.example.com/alert")
+	cfg, err := ParseAlertRules([]byte(`
+evaluationInterval: 30s
+channels:
+  - name: ops
+    type: webhook
+    url: "${TEST_ALERT_HOOK}"
+routes:
+  critical: [log, ops]
+rules:
+  - name: OUTBOX_BACKLOG_AGE
+    metric: outbox.backlog_age_seconds
+    op: ">"
+    threshold: 300
+    for: 5m
+    severity: critical
+  - name: CACHE_HIT_RATE_LOW
+    metric: cache.assignment_hit_rate
+    op: "<"
+    threshold: 0.5
+    severity: info
+    channels: [ops]
+`))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if cfg.EvaluationInterval != 30*time.Second {
+		t.Fatalf("unexpected evaluation interval: %v", cfg.EvaluationInterval)
+	}
+	if cfg.Channels[0].URL != "https://hooks.example.com/alert" {
+		t.Fatalf("env not expanded: %q", cfg.Channels[0].URL)
+	}
+	backlog := cfg.Rules[0]
+	if backlog.For != 5*time.Minute || !backlog.Matches(301) || backlog.Matches(300) {
+		t.Fatalf("unexpected backlog rule: %+v", backlog)
+	}
+	if got := cfg.ChannelsFor(backlog); strings.Join(got, ",") != "log,ops" {
+		t.Fatalf("critical route not applied: %v", got)
+	}
+	if got := cfg.ChannelsFor(cfg.Rules[1]); strings.Join(got, ",") != "ops" {
+		t.Fatalf("rule channels should override route: %v", got)
+	}
+}
+
+func TestParseAlertRulesRejectsInvalidRules(t *testing.T) {
+	_, err := ParseAlertRules([]byte(`
+routes:
+  urgent: [pager]
+rules:
+  - name: BAD
+    metric: temporal.orphan_records
+    op: "=>"
+    severity: fatal
+`))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{`unsupported severity "urgent"`, `unknown channel "pager"`, `unsupported op "=>"`, `unsupported severity "fatal"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("error %q missing %q", err, want)
+		}
+	}
+}
+
+func TestRepositoryAlertRulesFileIsValid(t *testing.T) {
+	cfg, err := LoadAlertRulesFile(filepath.Join("..", "..", "config", "alert_rules.yaml"))
+	if err != nil {
+		t.Fatalf("config/alert_rules.yaml invalid: %v", err)
+	}
+	if len(cfg.Rules) == 0 {
+		t.Fatal("expected rules in config/alert_rules.yaml")
+	}
+}
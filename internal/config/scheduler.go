@@ -170,6 +170,24 @@ func defaultSchedulerConfig() *SchedulerConfig {
 					CronExpr:    "15 2 * * *",
 					Enabled:     true,
 				},
+				"audit_chain_checkpoint": {
+					Name:        "audit_chain_checkpoint",
+					Description: "签发审计哈希链检查点",
+					CronExpr:    "5 * * * *",
+					Enabled:     true,
+				},
+				"audit_chain_verify": {
+					Name:        "audit_chain_verify",
+					Description: "复算审计哈希链并报告断裂位置",
+					CronExpr:    "45 2 * * *",
+					Enabled:     true,
+				},
+				"audit_retention_archive": {
+					Name:        "audit_retention_archive",
+					Description: "按租户保留策略归档过期审计记录",
+					CronExpr:    "30 3 * * *",
+					Enabled:     true,
+				},
 				"data_consistency_check": {
 					Name:        "data_consistency_check",
 					Description: "数据一致性检查",
@@ -177,6 +195,24 @@ func defaultSchedulerConfig() *SchedulerConfig {
 					Enabled:     true,
 					Script:      "data-consistency-check.sql",
 				},
+				"notification_acting_reminders": {
+					Name:        "notification_acting_reminders",
+					Description: "提醒即将到期的代理任职",
+					CronExpr:    "0 8 * * *",
+					Enabled:     true,
+				},
+				"notification_delivery_retry": {
+					Name:        "notification_delivery_retry",
+					Description: "重试失败的业务通知投递",
+					CronExpr:    "*/5 * * * *",
+					Enabled:     true,
+				},
+				"notification_vacancy_scan": {
+					Name:        "notification_vacancy_scan",
+					Description: "通知长期空缺的职位",
+					CronExpr:    "30 8 * * *",
+					Enabled:     true,
+				},
 				"system_monitoring": {
 					Name:        "system_monitoring",
 					Description: "系统健康监控",
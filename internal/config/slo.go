@@ -0,0 +1,236 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SLO 目标类型
+const (
+	SLOKindREST    = "rest"
+	SLOKindGraphQL = "graphql"
+)
+
+// SLOConfigResult 包含解析后的 SLO 配置及其来源。
+type SLOConfigResult struct {
+	Config     *SLOConfig
+	ConfigFile string // 为空表示使用内置默认值
+	Err        error  // 配置文件读取或校验失败（此时 Config 为默认值）
+}
+
+// SLOConfig 描述按操作划分的可用性与延迟目标。
+type SLOConfig struct {
+	// Window 错误预算的滚动合规窗口
+	Window time.Duration
+	// SampleInterval 指标快照间隔，决定燃烧率的时间分辨率
+	SampleInterval time.Duration
+	Objectives     []SLOObjective
+}
+
+// SLOObjective 单个操作的 SLO。
+type SLOObjective struct {
+	Name string
+	Kind string // rest | graphql
+	// Method/Route 用于 REST 目标，Route 为 chi 路由模板
+	Method string
+	Route  string
+	// Operation 用于 GraphQL 目标：操作名或根字段名
+	Operation string
+	// Availability 非 5xx（GraphQL 为无错误响应）请求占比目标，0 表示不跟踪
+	Availability float64
+	// LatencyThreshold/LatencyObjective 在阈值内完成的请求占比目标，阈值为 0 表示不跟踪
+	LatencyThreshold time.Duration
+	LatencyObjective float64
+}
+
+// Target 返回用于展示的目标描述。
+func (o SLOObjective) Target() string {
+	if o.Kind == SLOKindGraphQL {
+		return "graphql " + o.Operation
+	}
+	return o.Method + " " + o.Route
+}
+
+// GraphQLOperations 返回配置中的 GraphQL 操作集合，用于限定指标标签基数。
+func (c *SLOConfig) GraphQLOperations() map[string]bool {
+	ops := map[string]bool{}
+	for _, o := range c.Objectives {
+		if o.Kind == SLOKindGraphQL {
+			ops[o.Operation] = true
+		}
+	}
+	return ops
+}
+
+var (
+	sloConfigOnce sync.Once
+	sloConfig     SLOConfigResult
+)
+
+// GetSLOConfig 加载 SLO_CONFIG_FILE 或 config/slo.yaml，缺失或无效时使用内置默认值。
+func GetSLOConfig() SLOConfigResult {
+	sloConfigOnce.Do(func() {
+		sloConfig = SLOConfigResult{Config: DefaultSLOConfig()}
+		path := resolveSLOConfigFile()
+		if path == "" {
+			return
+		}
+		cfg, err := LoadSLOConfigFile(path)
+		if err != nil {
+			sloConfig.Err = fmt.Errorf("%s: %w", path, err)
+			return
+		}
+		sloConfig.Config = cfg
+		sloConfig.ConfigFile = path
+	})
+	return sloConfig
+}
+
+func resolveSLOConfigFile() string {
+	if v := strings.TrimSpace(os.Getenv("SLO_CONFIG_FILE")); v != "" {
+		return v
+	}
+	defaultPath := filepath.Join("config", "slo.yaml")
+	if _, err := os.Stat(defaultPath); err == nil {
+		return defaultPath
+	}
+	return ""
+}
+
+// DefaultSLOConfig 与 GraphQL schema 文档中公布的性能目标一致。
+func DefaultSLOConfig() *SLOConfig {
+	return &SLOConfig{
+		Window:         7 * 24 * time.Hour,
+		SampleInterval: time.Minute,
+		Objectives: []SLOObjective{
+			{Name: "graphql-organizations", Kind: SLOKindGraphQL, Operation: "organizations", Availability: 0.999, LatencyThreshold: 50 * time.Millisecond, LatencyObjective: 0.95},
+			{Name: "graphql-organization-subtree", Kind: SLOKindGraphQL, Operation: "organizationSubtree", Availability: 0.999, LatencyThreshold: 200 * time.Millisecond, LatencyObjective: 0.95},
+			{Name: "graphql-hierarchy-statistics", Kind: SLOKindGraphQL, Operation: "hierarchyStatistics", Availability: 0.999, LatencyThreshold: 150 * time.Millisecond, LatencyObjective: 0.95},
+			{Name: "graphql-audit-history", Kind: SLOKindGraphQL, Operation: "auditHistory", Availability: 0.999, LatencyThreshold: 50 * time.Millisecond, LatencyObjective: 0.95},
+		},
+	}
+}
+
+type sloYAML struct {
+	Window         string `yaml:"window"`
+	SampleInterval string `yaml:"sampleInterval"`
+	Objectives     []struct {
+		Name         string  `yaml:"name"`
+		Kind         string  `yaml:"kind"`
+		Method       string  `yaml:"method"`
+		Route        string  `yaml:"route"`
+		Operation    string  `yaml:"operation"`
+		Availability float64 `yaml:"availability"`
+		Latency      struct {
+			Threshold string  `yaml:"threshold"`
+			Objective float64 `yaml:"objective"`
+		} `yaml:"latency"`
+	} `yaml:"objectives"`
+}
+
+// LoadSLOConfigFile 读取并校验 SLO 配置文件。
+func LoadSLOConfigFile(path string) (*SLOConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read slo config: %w", err)
+	}
+	return ParseSLOConfig(data)
+}
+
+// ParseSLOConfig 解析 SLO 配置 YAML。
+func ParseSLOConfig(data []byte) (*SLOConfig, error) {
+	var raw sloYAML
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse slo config: %w", err)
+	}
+	defaults := DefaultSLOConfig()
+	cfg := &SLOConfig{Window: defaults.Window, SampleInterval: defaults.SampleInterval}
+	if raw.Window != "" {
+		d, err := time.ParseDuration(raw.Window)
+		if err != nil {
+			return nil, fmt.Errorf("window: %w", err)
+		}
+		cfg.Window = d
+	}
+	if raw.SampleInterval != "" {
+		d, err := time.ParseDuration(raw.SampleInterval)
+		if err != nil {
+			return nil, fmt.Errorf("sampleInterval: %w", err)
+		}
+		cfg.SampleInterval = d
+	}
+	for _, item := range raw.Objectives {
+		o := SLOObjective{
+			Name:             strings.TrimSpace(item.Name),
+			Kind:             strings.ToLower(strings.TrimSpace(item.Kind)),
+			Method:           strings.ToUpper(strings.TrimSpace(item.Method)),
+			Route:            strings.TrimSpace(item.Route),
+			Operation:        strings.TrimSpace(item.Operation),
+			Availability:     item.Availability,
+			LatencyObjective: item.Latency.Objective,
+		}
+		if item.Latency.Threshold != "" {
+			d, err := time.ParseDuration(item.Latency.Threshold)
+			if err != nil {
+				return nil, fmt.Errorf("objective %s: latency.threshold: %w", o.Name, err)
+			}
+			o.LatencyThreshold = d
+		}
+		cfg.Objectives = append(cfg.Objectives, o)
+	}
+	if err := ValidateSLOConfig(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ValidateSLOConfig 校验窗口与目标取值。
+func ValidateSLOConfig(cfg *SLOConfig) error {
+	var errs []error
+	if cfg.SampleInterval <= 0 {
+		errs = append(errs, errors.New("sampleInterval must be > 0"))
+	}
+	if cfg.Window < cfg.SampleInterval {
+		errs = append(errs, errors.New("window must be >= sampleInterval"))
+	}
+	seen := map[string]bool{}
+	for _, o := range cfg.Objectives {
+		if o.Name == "" {
+			errs = append(errs, errors.New("objective name is required"))
+			continue
+		}
+		if seen[o.Name] {
+			errs = append(errs, fmt.Errorf("objective %s: duplicate name", o.Name))
+		}
+		seen[o.Name] = true
+		switch o.Kind {
+		case SLOKindREST:
+			if o.Method == "" || o.Route == "" {
+				errs = append(errs, fmt.Errorf("objective %s: method and route are required", o.Name))
+			}
+		case SLOKindGraphQL:
+			if o.Operation == "" {
+				errs = append(errs, fmt.Errorf("objective %s: operation is required", o.Name))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("objective %s: unsupported kind %q", o.Name, o.Kind))
+		}
+		if o.Availability < 0 || o.Availability >= 1 {
+			errs = append(errs, fmt.Errorf("objective %s: availability must be in [0, 1)", o.Name))
+		}
+		if o.LatencyThreshold < 0 || (o.LatencyThreshold > 0 && (o.LatencyObjective <= 0 || o.LatencyObjective >= 1)) {
+			errs = append(errs, fmt.Errorf("objective %s: latency objective must be in (0, 1)", o.Name))
+		}
+		if o.Availability == 0 && o.LatencyThreshold == 0 {
+			errs = append(errs, fmt.Errorf("objective %s: availability or latency is required", o.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRepositorySLOConfigIsValid(t *testing.T) {
+	cfg, err := LoadSLOConfigFile(filepath.Join("..", "..", "config", "slo.yaml"))
+	if err != nil {
+		t.Fatalf("config/slo.yaml invalid: %v", err)
+	}
+	if cfg.Window != 168*time.Hour {
+		t.Fatalf("unexpected window: %v", cfg.Window)
+	}
+	if !cfg.GraphQLOperations()["organizations"] {
+		t.Fatal("expected organizations GraphQL objective")
+	}
+}
+
+func TestParseSLOConfigRejectsInvalidObjectives(t *testing.T) {
+	_, err := ParseSLOConfig([]byte(`
+objectives:
+  - name: missing-route
+    kind: rest
+    method: POST
+    availability: 0.99
+  - name: bad-latency
+    kind: graphql
+    operation: organizations
+    latency:
+      threshold: 50ms
+      objective: 95
+  - name: unknown
+    kind: grpc
+    availability: 1
+`))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"missing-route: method and route are required", "bad-latency: latency objective", `unknown: unsupported kind "grpc"`, "unknown: availability must be"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("error %q missing %q", err, want)
+		}
+	}
+}
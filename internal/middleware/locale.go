@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"cube-castle/internal/types"
+)
+
+// AcceptLanguageMiddleware 解析 Accept-Language 请求头并记录偏好语言，供查询侧选择本地化名称；
+// 未携带或无法解析时保持默认语言。
+func AcceptLanguageMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if header := r.Header.Get("Accept-Language"); header != "" {
+			if locales := types.ParseAcceptLanguage(header); len(locales) > 0 {
+				r = r.WithContext(types.WithLocales(r.Context(), locales))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"cube-castle/internal/types"
+)
+
+func TestAcceptLanguageMiddlewareRecordsLocales(t *testing.T) {
+	var captured []string
+	handler := AcceptLanguageMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		captured = types.LocalesFromContext(r.Context())
+	}))
+
+	cases := []struct {
+		header string
+		want   []string
+	}{
+		{"", nil},
+		{"*", nil},
+		{"en-us;q=0.8, zh_hant_tw, fr;q=0, ja;q=0.5", []string{"zh-Hant-TW", "en-US", "ja"}},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		if tc.header != "" {
+			req.Header.Set("Accept-Language", tc.header)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if !reflect.DeepEqual(captured, tc.want) {
+			t.Fatalf("Accept-Language %q: expected %v, got %v", tc.header, tc.want, captured)
+		}
+	}
+}
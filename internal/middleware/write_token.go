@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"cube-castle/pkg/database"
+)
+
+// WriteTokenHeader 写入令牌头：命令服务在成功写入后返回，客户端在后续查询中回传以读到自己的写入。
+const WriteTokenHeader = "X-Write-Token"
+
+// WriteTokenMiddleware 为成功的写请求（POST/PUT/PATCH/DELETE 且状态码 < 400）附加写入令牌。
+func WriteTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			next.ServeHTTP(&writeTokenWriter{ResponseWriter: w}, r)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+type writeTokenWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *writeTokenWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		// 响应头写出时事务已提交，取当前时间作为写入时间点
+		if status < http.StatusBadRequest {
+			w.Header().Set(WriteTokenHeader, database.FormatWriteToken(time.Now()))
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *writeTokenWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *writeTokenWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *writeTokenWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// ReadYourWritesMiddleware 解析请求携带的写入令牌：写入后 MaxReplicaLag 内的只读查询走主库，
+// 此后仅使用已回放到该时间点的副本。令牌无效时忽略。
+func ReadYourWritesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get(WriteTokenHeader); token != "" {
+			if writtenAt, err := database.ParseWriteToken(token); err == nil {
+				r = r.WithContext(database.WithWriteToken(r.Context(), writtenAt))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cube-castle/pkg/database"
+)
+
+func TestWriteTokenMiddlewareIssuesTokenOnSuccessfulWrites(t *testing.T) {
+	handler := WriteTokenMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+
+	cases := []struct {
+		method, path string
+		wantToken    bool
+	}{
+		{http.MethodPost, "/ok", true},
+		{http.MethodDelete, "/ok", true},
+		{http.MethodPost, "/fail", false},
+		{http.MethodGet, "/ok", false},
+	}
+	for _, tc := range cases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.path, nil))
+		token := recorder.Header().Get(WriteTokenHeader)
+		if (token != "") != tc.wantToken {
+			t.Fatalf("%s %s: unexpected write token %q", tc.method, tc.path, token)
+		}
+		if token != "" {
+			if _, err := database.ParseWriteToken(token); err != nil {
+				t.Fatalf("%s %s: invalid write token: %v", tc.method, tc.path, err)
+			}
+		}
+	}
+}
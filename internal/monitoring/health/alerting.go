@@ -36,6 +36,17 @@ type Alert struct {
 	Timestamp  time.Time              `json:"timestamp"`
 	Resolved   bool                   `json:"resolved"`
 	ResolvedAt *time.Time             `json:"resolvedAt,omitempty"`
+	Rule       string                 `json:"rule,omitempty"`
+	// OriginalAlertID 恢复通知与重复告警摘要引用的原告警 ID
+	OriginalAlertID string       `json:"originalAlertId,omitempty"`
+	Digest          *AlertDigest `json:"digest,omitempty"`
+}
+
+// AlertDigest 冷却期内被抑制的重复告警摘要
+type AlertDigest struct {
+	Count     int       `json:"count"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
 }
 
 // AlertRule 告警规则
@@ -49,7 +60,9 @@ type AlertRule struct {
 	MaxRetries    int            `json:"maxRetries"`
 	EnabledBy     time.Time      `json:"enabledBy"`
 	lastTriggered time.Time
+	lastAlertID   string
 	retryCount    int
+	suppressed    *AlertDigest
 }
 
 // AlertCondition 告警条件
@@ -256,49 +269,6 @@ func (s *SlackChannel) Send(ctx context.Context, alert Alert) error {
 	return nil
 }
 
-// EmailChannel 邮件告警渠道 (简化实现)
-type EmailChannel struct {
-	smtpHost string
-	smtpPort int
-	username string
-	password string
-	from     string
-	to       []string
-	logger   pkglogger.Logger
-}
-
-// Name 返回邮件渠道标识。
-func (e *EmailChannel) Name() string {
-	return "email"
-}
-
-// SetLogger 允许注入结构化日志器。
-func (e *EmailChannel) SetLogger(logger pkglogger.Logger) {
-	if logger != nil {
-		e.logger = logger.WithFields(pkglogger.Fields{
-			"channel": "email",
-		})
-	}
-}
-
-// Send 模拟发送告警邮件。
-func (e *EmailChannel) Send(_ context.Context, alert Alert) error {
-	// 这里应该实现SMTP邮件发送
-	// 为了简化，这里只是记录日志
-	logger := e.logger
-	if logger == nil {
-		logger = pkglogger.NewNoopLogger()
-	}
-	logger.WithFields(pkglogger.Fields{
-		"channel":   "email",
-		"alertId":   alert.ID,
-		"level":     alert.Level,
-		"service":   alert.Service,
-		"component": alert.Component,
-	}).Infof("EMAIL ALERT: %s", alert.Message)
-	return nil
-}
-
 // AlertManager 告警管理器
 type AlertManager struct {
 	serviceName      string
@@ -389,25 +359,66 @@ func (am *AlertManager) ProcessHealthCheck(ctx context.Context, health ServiceHe
 }
 
 // evaluateRules 评估告警规则
+//
+// 冷却期内再次满足条件的告警不会重复发送，而是累计为摘要，在冷却期结束后随下一次评估发出。
 func (am *AlertManager) evaluateRules(ctx context.Context, serviceName string, check HealthCheck) {
-	for _, rule := range am.rules {
+	now := time.Now()
+	for i := range am.rules {
+		rule := &am.rules[i]
 		if rule.Component != "" && rule.Component != check.Name {
 			continue
 		}
 
+		matched := am.evaluateCondition(rule.Condition, serviceName, check)
+
 		// 检查冷却时间
-		if time.Since(rule.lastTriggered) < rule.Cooldown {
+		if now.Sub(rule.lastTriggered) < rule.Cooldown {
+			if matched {
+				rule.recordSuppressed(now)
+			}
 			continue
 		}
 
-		// 评估条件
-		if am.evaluateCondition(rule.Condition, serviceName, check) {
-			rule.lastTriggered = time.Now()
-			am.triggerAlert(ctx, rule, serviceName, check)
+		if rule.suppressed != nil {
+			am.sendDigest(ctx, rule, serviceName, check)
+		}
+		if matched {
+			rule.lastTriggered = now
+			rule.lastAlertID = am.triggerAlert(ctx, *rule, serviceName, check)
 		}
 	}
 }
 
+// recordSuppressed 记录冷却期内被抑制的一次重复告警
+func (r *AlertRule) recordSuppressed(at time.Time) {
+	if r.suppressed == nil {
+		r.suppressed = &AlertDigest{FirstSeen: at}
+	}
+	r.suppressed.Count++
+	r.suppressed.LastSeen = at
+}
+
+// sendDigest 发送冷却期重复告警摘要
+func (am *AlertManager) sendDigest(ctx context.Context, rule *AlertRule, serviceName string, check HealthCheck) {
+	digest := *rule.suppressed
+	rule.suppressed = nil
+
+	alert := Alert{
+		ID:              fmt.Sprintf("%s-digest-%d", rule.lastAlertID, time.Now().Unix()),
+		Service:         serviceName,
+		Component:       check.Name,
+		Level:           rule.Level,
+		Status:          check.Status,
+		Message:         fmt.Sprintf("告警规则 %s 在冷却期内重复触发 %d 次", rule.Name, digest.Count),
+		Timestamp:       time.Now(),
+		Rule:            rule.Name,
+		OriginalAlertID: rule.lastAlertID,
+		Digest:          &digest,
+	}
+	am.addToHistory(alert)
+	am.dispatch(ctx, alert, "failed to dispatch alert digest")
+}
+
 // evaluateCondition 评估告警条件
 func (am *AlertManager) evaluateCondition(condition AlertCondition, serviceName string, check HealthCheck) bool {
 	// 检查状态条件
@@ -431,9 +442,9 @@ func (am *AlertManager) evaluateCondition(condition AlertCondition, serviceName
 	return false
 }
 
-// triggerAlert 触发告警
-func (am *AlertManager) triggerAlert(ctx context.Context, rule AlertRule, serviceName string, check HealthCheck) {
-	alertID := fmt.Sprintf("%s-%s-%d", serviceName, check.Name, time.Now().Unix())
+// triggerAlert 触发告警，返回告警 ID
+func (am *AlertManager) triggerAlert(ctx context.Context, rule AlertRule, serviceName string, check HealthCheck) string {
+	alertID := fmt.Sprintf("%s-%s-%d", serviceName, check.Name, time.Now().UnixNano())
 
 	alert := Alert{
 		ID:        alertID,
@@ -445,6 +456,7 @@ func (am *AlertManager) triggerAlert(ctx context.Context, rule AlertRule, servic
 		Details:   check.Details,
 		Timestamp: time.Now(),
 		Resolved:  false,
+		Rule:      rule.Name,
 	}
 
 	// 保存活跃告警
@@ -454,25 +466,30 @@ func (am *AlertManager) triggerAlert(ctx context.Context, rule AlertRule, servic
 	am.addToHistory(alert)
 
 	// 发送告警到所有渠道
+	am.dispatch(ctx, alert, "failed to dispatch alert")
+
+	am.logger.WithFields(pkglogger.Fields{
+		"alertId":   alert.ID,
+		"level":     alert.Level,
+		"service":   alert.Service,
+		"component": alert.Component,
+	}).Info("alert triggered")
+	return alertID
+}
+
+// dispatch 异步发送告警到所有渠道
+func (am *AlertManager) dispatch(ctx context.Context, alert Alert, failureMessage string) {
 	for _, channel := range am.channels {
-		ch := channel
 		go func(ch AlertChannel) {
 			if err := ch.Send(ctx, alert); err != nil {
 				am.logger.WithFields(pkglogger.Fields{
 					"channel": ch.Name(),
 					"alertId": alert.ID,
 					"error":   err,
-				}).Error("failed to dispatch alert")
+				}).Error(failureMessage)
 			}
-		}(ch)
+		}(channel)
 	}
-
-	am.logger.WithFields(pkglogger.Fields{
-		"alertId":   alert.ID,
-		"level":     alert.Level,
-		"service":   alert.Service,
-		"component": alert.Component,
-	}).Info("alert triggered")
 }
 
 // checkResolvedAlerts 检查已解决的告警
@@ -490,23 +507,15 @@ func (am *AlertManager) checkResolvedAlerts(ctx context.Context, health ServiceH
 				now := time.Now()
 				alert.ResolvedAt = &now
 
-				// 发送解决通知
+				// 发送解决通知（引用原告警 ID）
 				resolvedAlert := *alert
+				resolvedAlert.ID = alert.ID + "-resolved"
+				resolvedAlert.OriginalAlertID = alert.ID
+				resolvedAlert.Status = check.Status
 				resolvedAlert.Message = fmt.Sprintf("✅ 告警已解决: %s 组件 %s 恢复正常", alert.Service, alert.Component)
 				resolvedAlert.Level = AlertLevelInfo
 
-				for _, channel := range am.channels {
-					ch := channel
-					go func(ch AlertChannel) {
-						if err := ch.Send(ctx, resolvedAlert); err != nil {
-							am.logger.WithFields(pkglogger.Fields{
-								"channel": ch.Name(),
-								"alertId": alert.ID,
-								"error":   err,
-							}).Error("failed to dispatch resolved alert")
-						}
-					}(ch)
-				}
+				am.dispatch(ctx, resolvedAlert, "failed to dispatch resolved alert")
 
 				am.logger.WithFields(pkglogger.Fields{
 					"alertId":   alert.ID,
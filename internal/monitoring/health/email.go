@@ -0,0 +1,581 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// SMTP 连接加密模式
+const (
+	SMTPTLSModeStartTLS = "starttls"
+	SMTPTLSModeImplicit = "tls"
+	SMTPTLSModeNone     = "none"
+)
+
+const (
+	defaultEmailMaxAttempts  = 5
+	defaultEmailRetryBackoff = 30 * time.Second
+	defaultEmailTimeout      = 10 * time.Second
+	defaultEmailBatchWindow  = 30 * time.Second
+	maxEmailRetryBackoff     = 30 * time.Minute
+	emailDeliverBatchSize    = 50
+	emailMessageIDDomain     = "alerts.cube-castle"
+)
+
+// EmailConfig SMTP 告警渠道配置
+type EmailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+	// TLSMode 取值 starttls（默认）/tls（465 隐式 TLS）/none
+	TLSMode            string
+	InsecureSkipVerify bool
+	// BatchWindow 合并窗口：窗口内到达的告警合并为一封邮件；<=0 表示逐条发送
+	BatchWindow  time.Duration
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	Timeout      time.Duration
+}
+
+func (c EmailConfig) normalize() EmailConfig {
+	cfg := c
+	cfg.TLSMode = strings.ToLower(strings.TrimSpace(cfg.TLSMode))
+	if cfg.TLSMode == "" {
+		cfg.TLSMode = SMTPTLSModeStartTLS
+	}
+	if cfg.Port <= 0 {
+		cfg.Port = 587
+		if cfg.TLSMode == SMTPTLSModeImplicit {
+			cfg.Port = 465
+		}
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultEmailMaxAttempts
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaultEmailRetryBackoff
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultEmailTimeout
+	}
+	return cfg
+}
+
+func (c EmailConfig) validate() error {
+	if strings.TrimSpace(c.Host) == "" {
+		return errors.New("smtp host is required")
+	}
+	if strings.TrimSpace(c.From) == "" {
+		return errors.New("email sender is required")
+	}
+	if len(c.To) == 0 {
+		return errors.New("at least one email recipient is required")
+	}
+	switch c.TLSMode {
+	case SMTPTLSModeStartTLS, SMTPTLSModeImplicit, SMTPTLSModeNone:
+	default:
+		return fmt.Errorf("unsupported smtp tls mode: %s", c.TLSMode)
+	}
+	return nil
+}
+
+// LoadEmailConfigFromEnv 从 ALERT_SMTP_* / ALERT_EMAIL_* 环境变量加载配置；未配置 SMTP 主机时返回 false。
+func LoadEmailConfigFromEnv() (EmailConfig, bool) {
+	host := strings.TrimSpace(os.Getenv("ALERT_SMTP_HOST"))
+	if host == "" {
+		return EmailConfig{}, false
+	}
+	cfg := EmailConfig{
+		Host:               host,
+		Username:           os.Getenv("ALERT_SMTP_USERNAME"),
+		Password:           os.Getenv("ALERT_SMTP_PASSWORD"),
+		From:               strings.TrimSpace(os.Getenv("ALERT_EMAIL_FROM")),
+		TLSMode:            os.Getenv("ALERT_SMTP_TLS_MODE"),
+		InsecureSkipVerify: os.Getenv("ALERT_SMTP_INSECURE_SKIP_VERIFY") == "true",
+		BatchWindow:        defaultEmailBatchWindow,
+	}
+	if port, err := strconv.Atoi(os.Getenv("ALERT_SMTP_PORT")); err == nil {
+		cfg.Port = port
+	}
+	for _, addr := range strings.Split(os.Getenv("ALERT_EMAIL_TO"), ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			cfg.To = append(cfg.To, addr)
+		}
+	}
+	if window, err := time.ParseDuration(os.Getenv("ALERT_EMAIL_BATCH_WINDOW")); err == nil {
+		cfg.BatchWindow = window
+	}
+	if attempts, err := strconv.Atoi(os.Getenv("ALERT_EMAIL_MAX_ATTEMPTS")); err == nil {
+		cfg.MaxAttempts = attempts
+	}
+	return cfg, true
+}
+
+// EmailChannel SMTP 邮件告警渠道：告警先渲染为邮件写入 outbox，再由投递循环发送并按指数退避重试。
+type EmailChannel struct {
+	cfg     EmailConfig
+	outbox  EmailOutbox
+	logger  pkglogger.Logger
+	now     func() time.Time
+	deliver func(ctx context.Context, msg *EmailOutboxMessage) error
+
+	mu      sync.Mutex
+	pending []Alert
+	timer   *time.Timer
+
+	deliverMu sync.Mutex
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewEmailChannel 创建邮件告警渠道；outbox 为空时使用进程内队列。
+func NewEmailChannel(cfg EmailConfig, outbox EmailOutbox) (*EmailChannel, error) {
+	cfg = cfg.normalize()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if outbox == nil {
+		outbox = NewMemoryEmailOutbox()
+	}
+	e := &EmailChannel{
+		cfg:    cfg,
+		outbox: outbox,
+		logger: pkglogger.NewNoopLogger(),
+		now:    time.Now,
+	}
+	e.deliver = e.sendSMTP
+	return e, nil
+}
+
+// Name 返回邮件渠道标识。
+func (e *EmailChannel) Name() string {
+	return "email"
+}
+
+// SetLogger 允许注入结构化日志器。
+func (e *EmailChannel) SetLogger(logger pkglogger.Logger) {
+	if logger != nil {
+		e.logger = logger.WithFields(pkglogger.Fields{
+			"channel": "email",
+		})
+	}
+}
+
+// Send 提交告警：配置了合并窗口时暂存到窗口结束统一发送，否则立即写入 outbox 并尝试投递。
+// 投递失败不会返回错误，邮件保留在 outbox 中等待重试。
+func (e *EmailChannel) Send(ctx context.Context, alert Alert) error {
+	if e.cfg.BatchWindow <= 0 {
+		if err := e.enqueue(ctx, []Alert{alert}); err != nil {
+			return err
+		}
+		return e.DeliverPending(ctx)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pending = append(e.pending, alert)
+	if e.timer == nil {
+		e.timer = time.AfterFunc(e.cfg.BatchWindow, func() {
+			if err := e.Flush(context.Background()); err != nil {
+				e.logger.WithFields(pkglogger.Fields{"error": err}).Error("failed to flush alert email batch")
+			}
+		})
+	}
+	return nil
+}
+
+// Flush 立即发送合并窗口内暂存的告警。
+func (e *EmailChannel) Flush(ctx context.Context) error {
+	e.mu.Lock()
+	batch := e.pending
+	e.pending = nil
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	e.mu.Unlock()
+
+	if len(batch) > 0 {
+		if err := e.enqueue(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return e.DeliverPending(ctx)
+}
+
+// Start 启动 outbox 重试循环，处理历史遗留与失败待重试的邮件。
+func (e *EmailChannel) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	e.cancel = cancel
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.cfg.RetryBackoff)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := e.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+					e.logger.WithFields(pkglogger.Fields{"error": err}).Warn("alert email retry loop failed")
+				}
+			}
+		}
+	}()
+}
+
+// Stop 停止重试循环并发送暂存批次。
+func (e *EmailChannel) Stop(ctx context.Context) error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	return e.Flush(ctx)
+}
+
+// DeliverPending 投递 outbox 中已到期的邮件。
+func (e *EmailChannel) DeliverPending(ctx context.Context) error {
+	e.deliverMu.Lock()
+	defer e.deliverMu.Unlock()
+
+	due, err := e.outbox.Due(ctx, e.now(), emailDeliverBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, msg := range due {
+		logger := e.logger.WithFields(pkglogger.Fields{
+			"messageId": msg.ID,
+			"alertIds":  msg.AlertIDs,
+			"attempt":   msg.Attempts + 1,
+		})
+		sendErr := e.deliver(ctx, msg)
+		if sendErr == nil {
+			if err := e.outbox.MarkSent(ctx, msg.ID, e.now()); err != nil {
+				return err
+			}
+			logger.Info("alert email delivered")
+			continue
+		}
+
+		attempts := msg.Attempts + 1
+		dead := attempts >= e.cfg.MaxAttempts
+		next := e.now().Add(e.retryBackoff(attempts))
+		if err := e.outbox.MarkFailed(ctx, msg.ID, attempts, next, sendErr.Error(), dead); err != nil {
+			return err
+		}
+		if dead {
+			logger.WithFields(pkglogger.Fields{"error": sendErr}).Error("alert email delivery abandoned after max attempts")
+		} else {
+			logger.WithFields(pkglogger.Fields{"error": sendErr, "nextAttemptAt": next}).Warn("alert email delivery failed, retry scheduled")
+		}
+	}
+	return nil
+}
+
+func (e *EmailChannel) retryBackoff(attempts int) time.Duration {
+	if attempts > 10 {
+		attempts = 10
+	}
+	delay := e.cfg.RetryBackoff * time.Duration(1<<(attempts-1))
+	if delay > maxEmailRetryBackoff {
+		delay = maxEmailRetryBackoff
+	}
+	return delay
+}
+
+func (e *EmailChannel) enqueue(ctx context.Context, alerts []Alert) error {
+	msg, err := e.compose(alerts)
+	if err != nil {
+		return err
+	}
+	return e.outbox.Enqueue(ctx, msg)
+}
+
+// compose 渲染邮件并生成 outbox 记录
+func (e *EmailChannel) compose(alerts []Alert) (*EmailOutboxMessage, error) {
+	now := e.now()
+	view := newEmailView(alerts)
+	tpl := emailTemplateFor(view)
+
+	var text, html bytes.Buffer
+	if err := tpl.text.Execute(&text, view); err != nil {
+		return nil, fmt.Errorf("render alert email text: %w", err)
+	}
+	if err := tpl.html.Execute(&html, view); err != nil {
+		return nil, fmt.Errorf("render alert email html: %w", err)
+	}
+
+	id := uuid.NewString()
+	messageID := id
+	if len(alerts) == 1 {
+		messageID = alerts[0].ID
+	}
+	subject := emailSubject(tpl.tag, view)
+	raw, err := buildEmailMessage(e.cfg, subject, messageID, view.references(), text.Bytes(), html.Bytes(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	alertIDs := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		alertIDs = append(alertIDs, alert.ID)
+	}
+	return &EmailOutboxMessage{
+		ID:            id,
+		AlertIDs:      alertIDs,
+		Recipients:    append([]string(nil), e.cfg.To...),
+		Subject:       subject,
+		Message:       raw,
+		Status:        EmailStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// sendSMTP 通过 SMTP 投递单封邮件（支持 STARTTLS、隐式 TLS 与 PLAIN 认证）
+func (e *EmailChannel) sendSMTP(ctx context.Context, msg *EmailOutboxMessage) error {
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: e.cfg.Host, InsecureSkipVerify: e.cfg.InsecureSkipVerify, MinVersion: tls.VersionTLS12} //nolint:gosec // 仅在显式配置时跳过校验
+
+	dialer := &net.Dialer{Timeout: e.cfg.Timeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if e.cfg.TLSMode == SMTPTLSModeImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("dial smtp %s: %w", addr, err)
+	}
+	deadline := time.Now().Add(e.cfg.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, e.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if e.cfg.TLSMode == SMTPTLSModeStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("smtp server does not support STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if e.cfg.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support AUTH")
+		}
+		if err := client.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := client.Mail(e.cfg.From); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	for _, rcpt := range msg.Recipients {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("smtp rcpt %s: %w", rcpt, err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(msg.Message); err != nil {
+		return fmt.Errorf("smtp write body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp finish data: %w", err)
+	}
+	return client.Quit()
+}
+
+// SendMail 绕过 outbox 与合并窗口直接投递一封邮件（收件人取 cfg.To），供业务通知复用 SMTP 传输；重试由调用方负责。
+func SendMail(ctx context.Context, cfg EmailConfig, subject, messageID string, text, html []byte) error {
+	cfg = cfg.normalize()
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	message, err := buildEmailMessage(cfg, subject, messageID, nil, text, html, time.Now())
+	if err != nil {
+		return fmt.Errorf("build email: %w", err)
+	}
+	sender := &EmailChannel{cfg: cfg}
+	return sender.sendSMTP(ctx, &EmailOutboxMessage{ID: messageID, Recipients: cfg.To, Subject: subject, Message: message})
+}
+
+// buildEmailMessage 组装 multipart/alternative 报文；解决类告警通过 In-Reply-To/References 关联原告警邮件
+func buildEmailMessage(cfg EmailConfig, subject, messageID string, references []string, text, html []byte, now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := qp.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	writeHeader := func(key, value string) {
+		msg.WriteString(key + ": " + value + "\r\n")
+	}
+	writeHeader("From", cfg.From)
+	writeHeader("To", strings.Join(cfg.To, ", "))
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", subject))
+	writeHeader("Date", now.Format(time.RFC1123Z))
+	writeHeader("Message-ID", emailMessageID(messageID))
+	if len(references) > 0 {
+		ids := make([]string, 0, len(references))
+		for _, ref := range references {
+			ids = append(ids, emailMessageID(ref))
+		}
+		writeHeader("In-Reply-To", ids[0])
+		writeHeader("References", strings.Join(ids, " "))
+	}
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", mw.Boundary()))
+	writeHeader("X-Cube-Castle-Alert", "health-monitor")
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+func emailMessageID(id string) string {
+	return "<" + strings.ReplaceAll(id, " ", "-") + "@" + emailMessageIDDomain + ">"
+}
+
+// emailAlertView 模板中的单条告警
+type emailAlertView struct {
+	Alert
+	LevelLabel  string
+	Color       string
+	OccurredAt  string
+	DetailLines []string
+	DigestRange string
+}
+
+// emailView 邮件模板数据
+type emailView struct {
+	Service  string
+	Alerts   []emailAlertView
+	Resolved bool
+}
+
+func newEmailView(alerts []Alert) emailView {
+	view := emailView{Resolved: true}
+	for _, alert := range alerts {
+		if view.Service == "" {
+			view.Service = alert.Service
+		}
+		if !alert.Resolved {
+			view.Resolved = false
+		}
+		item := emailAlertView{
+			Alert:      alert,
+			LevelLabel: strings.ToUpper(string(alert.Level)),
+			Color:      emailLevelStyles[alert.Level].color,
+			OccurredAt: alert.Timestamp.Format("2006-01-02 15:04:05 MST"),
+		}
+		if alert.Resolved {
+			item.LevelLabel = "RESOLVED"
+			item.Color = emailResolvedColor
+		}
+		if alert.Digest != nil {
+			item.DigestRange = alert.Digest.FirstSeen.Format("15:04:05") + " ~ " + alert.Digest.LastSeen.Format("15:04:05")
+		}
+		keys := make([]string, 0, len(alert.Details))
+		for k := range alert.Details {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			item.DetailLines = append(item.DetailLines, fmt.Sprintf("%s: %v", k, alert.Details[k]))
+		}
+		view.Alerts = append(view.Alerts, item)
+	}
+	return view
+}
+
+// references 返回解决/汇总邮件引用的原告警 ID
+func (v emailView) references() []string {
+	refs := make([]string, 0)
+	for _, alert := range v.Alerts {
+		if alert.OriginalAlertID != "" {
+			refs = append(refs, alert.OriginalAlertID)
+		}
+	}
+	return refs
+}
+
+// highestLevel 返回批次中最高的未解决告警级别
+func (v emailView) highestLevel() AlertLevel {
+	level := AlertLevelInfo
+	for _, alert := range v.Alerts {
+		if !alert.Resolved && emailLevelStyles[alert.Level].rank > emailLevelStyles[level].rank {
+			level = alert.Level
+		}
+	}
+	return level
+}
+
+func emailSubject(tag string, view emailView) string {
+	if len(view.Alerts) == 1 {
+		alert := view.Alerts[0]
+		if alert.Resolved {
+			return fmt.Sprintf("[%s] %s/%s 已恢复（原告警 %s）", tag, alert.Service, alert.Component, alert.OriginalAlertID)
+		}
+		return fmt.Sprintf("[%s] %s/%s: %s", tag, alert.Service, alert.Component, alert.Message)
+	}
+	return fmt.Sprintf("[%s] %s 告警汇总（%d 条）", tag, view.Service, len(view.Alerts))
+}
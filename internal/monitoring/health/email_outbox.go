@@ -0,0 +1,195 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// 邮件 outbox 状态
+const (
+	EmailStatusPending = "pending"
+	EmailStatusSent    = "sent"
+	EmailStatusDead    = "dead"
+)
+
+// EmailOutboxMessage 待投递的告警邮件（Message 为完整 RFC 5322 报文）
+type EmailOutboxMessage struct {
+	ID            string
+	AlertIDs      []string
+	Recipients    []string
+	Subject       string
+	Message       []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+// EmailOutbox 告警邮件持久化队列，保证进程重启后未送达邮件仍会重试。
+type EmailOutbox interface {
+	Enqueue(ctx context.Context, msg *EmailOutboxMessage) error
+	Due(ctx context.Context, now time.Time, limit int) ([]*EmailOutboxMessage, error)
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkFailed(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastErr string, dead bool) error
+}
+
+// MemoryEmailOutbox 进程内 outbox，适用于测试与未配置数据库的环境。
+type MemoryEmailOutbox struct {
+	mu       sync.Mutex
+	messages map[string]*EmailOutboxMessage
+}
+
+// NewMemoryEmailOutbox 创建进程内 outbox。
+func NewMemoryEmailOutbox() *MemoryEmailOutbox {
+	return &MemoryEmailOutbox{messages: make(map[string]*EmailOutboxMessage)}
+}
+
+// Enqueue 写入待投递邮件。
+func (m *MemoryEmailOutbox) Enqueue(_ context.Context, msg *EmailOutboxMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *msg
+	m.messages[msg.ID] = &copied
+	return nil
+}
+
+// Due 返回到期的待投递邮件。
+func (m *MemoryEmailOutbox) Due(_ context.Context, now time.Time, limit int) ([]*EmailOutboxMessage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	due := make([]*EmailOutboxMessage, 0)
+	for _, msg := range m.messages {
+		if msg.Status == EmailStatusPending && !msg.NextAttemptAt.After(now) {
+			copied := *msg
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// MarkSent 标记投递成功。
+func (m *MemoryEmailOutbox) MarkSent(_ context.Context, id string, sentAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	msg, ok := m.messages[id]
+	if !ok {
+		return fmt.Errorf("email outbox message %s not found", id)
+	}
+	msg.Status = EmailStatusSent
+	msg.SentAt = &sentAt
+	return nil
+}
+
+// MarkFailed 记录投递失败并安排下次重试。
+func (m *MemoryEmailOutbox) MarkFailed(_ context.Context, id string, attempts int, nextAttemptAt time.Time, lastErr string, dead bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	msg, ok := m.messages[id]
+	if !ok {
+		return fmt.Errorf("email outbox message %s not found", id)
+	}
+	msg.Attempts = attempts
+	msg.NextAttemptAt = nextAttemptAt
+	msg.LastError = lastErr
+	if dead {
+		msg.Status = EmailStatusDead
+	}
+	return nil
+}
+
+// Messages 返回全部邮件快照（按创建时间排序）。
+func (m *MemoryEmailOutbox) Messages() []EmailOutboxMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]EmailOutboxMessage, 0, len(m.messages))
+	for _, msg := range m.messages {
+		out = append(out, *msg)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// SQLEmailOutbox 基于 alert_email_outbox 表的持久化 outbox。
+type SQLEmailOutbox struct {
+	db *sql.DB
+}
+
+// NewSQLEmailOutbox 创建 PostgreSQL outbox。
+func NewSQLEmailOutbox(db *sql.DB) *SQLEmailOutbox {
+	return &SQLEmailOutbox{db: db}
+}
+
+// Enqueue 写入待投递邮件。
+func (s *SQLEmailOutbox) Enqueue(ctx context.Context, msg *EmailOutboxMessage) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO alert_email_outbox (id, alert_ids, recipients, subject, message, status, attempts, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		msg.ID, pq.Array(msg.AlertIDs), pq.Array(msg.Recipients), msg.Subject, msg.Message,
+		EmailStatusPending, msg.Attempts, msg.NextAttemptAt, msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("enqueue alert email: %w", err)
+	}
+	return nil
+}
+
+// Due 返回到期的待投递邮件。
+func (s *SQLEmailOutbox) Due(ctx context.Context, now time.Time, limit int) ([]*EmailOutboxMessage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id::text, alert_ids, recipients, subject, message, status, attempts, next_attempt_at, COALESCE(last_error, ''), created_at
+		FROM alert_email_outbox
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query due alert emails: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*EmailOutboxMessage, 0)
+	for rows.Next() {
+		var msg EmailOutboxMessage
+		if err := rows.Scan(&msg.ID, pq.Array(&msg.AlertIDs), pq.Array(&msg.Recipients), &msg.Subject, &msg.Message,
+			&msg.Status, &msg.Attempts, &msg.NextAttemptAt, &msg.LastError, &msg.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan alert email: %w", err)
+		}
+		messages = append(messages, &msg)
+	}
+	return messages, rows.Err()
+}
+
+// MarkSent 标记投递成功。
+func (s *SQLEmailOutbox) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE alert_email_outbox SET status = 'sent', sent_at = $2, last_error = NULL WHERE id = $1`, id, sentAt)
+	if err != nil {
+		return fmt.Errorf("mark alert email sent: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed 记录投递失败并安排下次重试。
+func (s *SQLEmailOutbox) MarkFailed(ctx context.Context, id string, attempts int, nextAttemptAt time.Time, lastErr string, dead bool) error {
+	status := EmailStatusPending
+	if dead {
+		status = EmailStatusDead
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE alert_email_outbox SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5 WHERE id = $1`,
+		id, status, attempts, nextAttemptAt, lastErr)
+	if err != nil {
+		return fmt.Errorf("mark alert email failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+// emailLevelStyles 告警级别排序与配色
+var emailLevelStyles = map[AlertLevel]struct {
+	rank  int
+	color string
+}{
+	AlertLevelInfo:     {rank: 1, color: "#1E88E5"},
+	AlertLevelWarning:  {rank: 2, color: "#FB8C00"},
+	AlertLevelCritical: {rank: 3, color: "#E53935"},
+}
+
+const emailResolvedColor = "#43A047"
+
+// emailTemplate 单个级别的主题标签与正文模板
+type emailTemplate struct {
+	tag  string
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+const emailTextLayout = `{{template "headline" .}}
+{{range .Alerts}}
+[{{.LevelLabel}}] {{.Service}}/{{.Component}}
+告警 ID: {{.ID}}
+{{- if .OriginalAlertID}}
+原告警 ID: {{.OriginalAlertID}}{{end}}
+{{- if .Rule}}
+规则: {{.Rule}}{{end}}
+状态: {{.Status}}
+时间: {{.OccurredAt}}
+内容: {{.Message}}
+{{- if .Digest}}
+冷却期内重复: {{.Digest.Count}} 次（{{.DigestRange}}）{{end}}
+{{- range .DetailLines}}
+  - {{.}}{{end}}
+{{end}}
+{{template "guidance" .}}
+
+--
+Cube Castle Health Monitor
+`
+
+const emailHTMLLayout = `<!DOCTYPE html>
+<html><body style="font-family:Helvetica,Arial,sans-serif;color:#212121">
+<h2>{{template "headline" .}}</h2>
+{{range .Alerts}}
+<table style="border-left:4px solid {{.Color}};margin:12px 0;padding:8px 12px;border-collapse:collapse">
+<tr><td colspan="2"><strong style="color:{{.Color}}">[{{.LevelLabel}}]</strong> {{.Service}}/{{.Component}}</td></tr>
+<tr><td>告警 ID</td><td><code>{{.ID}}</code></td></tr>
+{{- if .OriginalAlertID}}<tr><td>原告警 ID</td><td><code>{{.OriginalAlertID}}</code></td></tr>{{end}}
+{{- if .Rule}}<tr><td>规则</td><td>{{.Rule}}</td></tr>{{end}}
+<tr><td>状态</td><td>{{.Status}}</td></tr>
+<tr><td>时间</td><td>{{.OccurredAt}}</td></tr>
+<tr><td>内容</td><td>{{.Message}}</td></tr>
+{{- if .Digest}}<tr><td>冷却期内重复</td><td>{{.Digest.Count}} 次（{{.DigestRange}}）</td></tr>{{end}}
+{{- if .DetailLines}}<tr><td>详情</td><td><ul>{{range .DetailLines}}<li>{{.}}</li>{{end}}</ul></td></tr>{{end}}
+</table>
+{{end}}
+<p>{{template "guidance" .}}</p>
+<p style="color:#757575;font-size:12px">Cube Castle Health Monitor</p>
+</body></html>
+`
+
+// emailLevelBlocks 各级别（及恢复通知）的标题与处置建议
+var emailLevelBlocks = map[string]struct {
+	tag      string
+	headline string
+	guidance string
+}{
+	string(AlertLevelCritical): {
+		tag:      "CRITICAL",
+		headline: `🚨 {{.Service}} 严重告警`,
+		guidance: `请值班人员立即处理，并在处置完成后于告警渠道同步结论。`,
+	},
+	string(AlertLevelWarning): {
+		tag:      "WARNING",
+		headline: `⚠️ {{.Service}} 警告`,
+		guidance: `请在工作时间内排查，持续恶化时将升级为严重告警。`,
+	},
+	string(AlertLevelInfo): {
+		tag:      "INFO",
+		headline: `ℹ️ {{.Service}} 通知`,
+		guidance: `仅供知悉，无需处理。`,
+	},
+	"resolved": {
+		tag:      "RESOLVED",
+		headline: `✅ {{.Service}} 告警已恢复`,
+		guidance: `组件已恢复正常，原告警已自动关闭。`,
+	},
+}
+
+var emailTemplates = buildEmailTemplates()
+
+func buildEmailTemplates() map[string]emailTemplate {
+	templates := make(map[string]emailTemplate, len(emailLevelBlocks))
+	for key, block := range emailLevelBlocks {
+		blocks := `{{define "headline"}}` + block.headline + `{{end}}{{define "guidance"}}` + block.guidance + `{{end}}`
+		text := texttemplate.Must(texttemplate.New("text-" + key).Parse(emailTextLayout))
+		texttemplate.Must(text.Parse(blocks))
+		html := htmltemplate.Must(htmltemplate.New("html-" + key).Parse(emailHTMLLayout))
+		htmltemplate.Must(html.Parse(blocks))
+		templates[key] = emailTemplate{tag: block.tag, text: text, html: html}
+	}
+	return templates
+}
+
+// emailTemplateFor 全部为恢复通知时使用恢复模板，否则按批次最高级别选择
+func emailTemplateFor(view emailView) emailTemplate {
+	if view.Resolved {
+		return emailTemplates["resolved"]
+	}
+	return emailTemplates[string(view.highestLevel())]
+}
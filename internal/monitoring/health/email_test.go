@@ -0,0 +1,249 @@
+package health
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cube-castle/internal/monitoring/health/smtptest"
+)
+
+func newSinkChannel(t *testing.T, opts smtptest.Options, batchWindow time.Duration) (*EmailChannel, *smtptest.Sink, *MemoryEmailOutbox) {
+	t.Helper()
+	sink, err := smtptest.NewSink(opts)
+	if err != nil {
+		t.Fatalf("start smtp sink: %v", err)
+	}
+	t.Cleanup(func() { sink.Close() })
+
+	tlsMode := SMTPTLSModeNone
+	if opts.StartTLS {
+		tlsMode = SMTPTLSModeStartTLS
+	}
+	outbox := NewMemoryEmailOutbox()
+	channel, err := NewEmailChannel(EmailConfig{
+		Host:               sink.Host(),
+		Port:               sink.Port(),
+		Username:           opts.Username,
+		Password:           opts.Password,
+		From:               "alerts@cube-castle.local",
+		To:                 []string{"oncall@cube-castle.local"},
+		TLSMode:            tlsMode,
+		InsecureSkipVerify: true,
+		BatchWindow:        batchWindow,
+		Timeout:            5 * time.Second,
+	}, outbox)
+	if err != nil {
+		t.Fatalf("NewEmailChannel: %v", err)
+	}
+	return channel, sink, outbox
+}
+
+func readParts(t *testing.T, msg smtptest.Message) map[string]string {
+	t.Helper()
+	header := msg.Header()
+	_, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("parse content type: %v", err)
+	}
+	body := string(msg.Data)
+	body = body[strings.Index(body, "\r\n\r\n")+4:]
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	parts := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatalf("read multipart: %v", err)
+		}
+		content, _ := io.ReadAll(part)
+		mediaType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[mediaType] = string(content)
+	}
+}
+
+func TestEmailChannelDeliversOverStartTLSWithAuth(t *testing.T) {
+	channel, sink, outbox := newSinkChannel(t, smtptest.Options{StartTLS: true, Username: "alert", Password: "secret"}, 0)
+
+	alert := Alert{
+		ID: "command-postgres-1", Service: "command", Component: "postgres",
+		Level: AlertLevelCritical, Status: StatusUnhealthy, Message: "postgres unreachable",
+		Details: map[string]interface{}{"error": "dial tcp: refused"}, Timestamp: time.Now(),
+	}
+	if err := channel.Send(context.Background(), alert); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	msgs := sink.WaitForMessages(1, 2*time.Second)
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 delivered email, got %d", len(msgs))
+	}
+	msg := msgs[0]
+	if !msg.TLS || msg.Username != "alert" || msg.To[0] != "oncall@cube-castle.local" {
+		t.Fatalf("expected authenticated TLS delivery, got %+v", msg)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header().Get("Subject"))
+	if subject != "[CRITICAL] command/postgres: postgres unreachable" {
+		t.Fatalf("unexpected subject %q", subject)
+	}
+	if msg.Header().Get("Message-Id") != "<command-postgres-1@alerts.cube-castle>" {
+		t.Fatalf("unexpected message id %q", msg.Header().Get("Message-Id"))
+	}
+	parts := readParts(t, msg)
+	if !strings.Contains(parts["text/plain"], "告警 ID: command-postgres-1") || !strings.Contains(parts["text/plain"], "请值班人员立即处理") {
+		t.Fatalf("text part missing alert content: %s", parts["text/plain"])
+	}
+	if !strings.Contains(parts["text/html"], "dial tcp: refused") || !strings.Contains(parts["text/html"], "#E53935") {
+		t.Fatalf("html part missing critical styling: %s", parts["text/html"])
+	}
+	if got := outbox.Messages(); len(got) != 1 || got[0].Status != EmailStatusSent {
+		t.Fatalf("expected outbox message marked sent, got %+v", got)
+	}
+}
+
+func TestEmailChannelRetriesFromOutbox(t *testing.T) {
+	channel, sink, outbox := newSinkChannel(t, smtptest.Options{}, 0)
+	sink.FailNext(1)
+
+	now := time.Now()
+	channel.now = func() time.Time { return now }
+	if err := channel.Send(context.Background(), Alert{ID: "a-1", Service: "command", Component: "redis", Level: AlertLevelWarning}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	pending := outbox.Messages()
+	if len(pending) != 1 || pending[0].Status != EmailStatusPending || pending[0].Attempts != 1 || pending[0].LastError == "" {
+		t.Fatalf("expected failed delivery to stay pending, got %+v", pending)
+	}
+
+	// 退避时间未到不重试
+	if err := channel.DeliverPending(context.Background()); err != nil {
+		t.Fatalf("DeliverPending returned error: %v", err)
+	}
+	if len(sink.Messages()) != 0 {
+		t.Fatalf("message should wait for backoff before retry")
+	}
+
+	now = now.Add(defaultEmailRetryBackoff)
+	if err := channel.DeliverPending(context.Background()); err != nil {
+		t.Fatalf("DeliverPending returned error: %v", err)
+	}
+	if len(sink.Messages()) != 1 || outbox.Messages()[0].Status != EmailStatusSent {
+		t.Fatalf("expected retry to deliver the email")
+	}
+}
+
+func TestEmailChannelBatchesAlertsAndReferencesOriginal(t *testing.T) {
+	channel, sink, _ := newSinkChannel(t, smtptest.Options{}, time.Hour)
+	ctx := context.Background()
+	_ = channel.Send(ctx, Alert{ID: "a-1", Service: "command", Component: "postgres", Level: AlertLevelWarning, Message: "slow"})
+	_ = channel.Send(ctx, Alert{ID: "a-2", Service: "command", Component: "redis", Level: AlertLevelCritical, Message: "down"})
+	if len(sink.Messages()) != 0 {
+		t.Fatalf("alerts should be held until the batch window closes")
+	}
+	if err := channel.Flush(ctx); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+	msgs := sink.WaitForMessages(1, 2*time.Second)
+	subject, _ := new(mime.WordDecoder).DecodeHeader(msgs[0].Header().Get("Subject"))
+	if subject != "[CRITICAL] command 告警汇总（2 条）" {
+		t.Fatalf("unexpected batch subject %q", subject)
+	}
+
+	resolved := Alert{ID: "a-2-resolved", OriginalAlertID: "a-2", Service: "command", Component: "redis", Level: AlertLevelInfo, Resolved: true}
+	_ = channel.Send(ctx, resolved)
+	_ = channel.Flush(ctx)
+	msgs = sink.WaitForMessages(2, 2*time.Second)
+	header := msgs[1].Header()
+	if header.Get("In-Reply-To") != "<a-2@alerts.cube-castle>" {
+		t.Fatalf("resolution email should reply to original alert, got %q", header.Get("In-Reply-To"))
+	}
+	if text := readParts(t, msgs[1])["text/plain"]; !strings.Contains(text, "原告警 ID: a-2") || !strings.Contains(text, "[RESOLVED]") {
+		t.Fatalf("resolution email should reference original alert: %s", text)
+	}
+}
+
+type recordingChannel struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (r *recordingChannel) Name() string { return "recording" }
+
+func (r *recordingChannel) Send(_ context.Context, alert Alert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *recordingChannel) waitFor(t *testing.T, n int) []Alert {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		if len(r.alerts) >= n {
+			out := append([]Alert(nil), r.alerts...)
+			r.mu.Unlock()
+			return out
+		}
+		r.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d alerts", n)
+	return nil
+}
+
+func TestAlertManagerDigestsRepeatsWithinCooldown(t *testing.T) {
+	unhealthy := StatusUnhealthy
+	am := NewAlertManager("command")
+	channel := &recordingChannel{}
+	am.AddChannel(channel)
+	am.AddRule(AlertRule{
+		Name: "postgres-down", Component: "postgres", Level: AlertLevelCritical,
+		Message: "%s %s %s", Cooldown: 80 * time.Millisecond,
+		Condition: AlertCondition{StatusEquals: &unhealthy},
+	})
+
+	down := ServiceHealth{Service: "command", Checks: []HealthCheck{{Name: "postgres", Status: StatusUnhealthy}}}
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		am.ProcessHealthCheck(ctx, down)
+	}
+	first := channel.waitFor(t, 1)[0]
+
+	time.Sleep(100 * time.Millisecond)
+	am.ProcessHealthCheck(ctx, down)
+	alerts := channel.waitFor(t, 3)
+
+	var digest *Alert
+	for i := range alerts {
+		if alerts[i].Digest != nil {
+			digest = &alerts[i]
+		}
+	}
+	if digest == nil || digest.Digest.Count != 2 || digest.OriginalAlertID != first.ID {
+		t.Fatalf("expected digest of 2 suppressed repeats referencing %s, got %+v", first.ID, alerts)
+	}
+
+	am.ProcessHealthCheck(ctx, ServiceHealth{Service: "command", Checks: []HealthCheck{{Name: "postgres", Status: StatusHealthy}}})
+	alerts = channel.waitFor(t, 5)
+	resolvedCount := 0
+	for _, alert := range alerts {
+		if alert.Resolved {
+			resolvedCount++
+			if alert.OriginalAlertID == "" || alert.ID != alert.OriginalAlertID+"-resolved" {
+				t.Fatalf("resolution should reference original alert, got %+v", alert)
+			}
+		}
+	}
+	if resolvedCount != 2 {
+		t.Fatalf("expected both active alerts to resolve, got %d", resolvedCount)
+	}
+}
@@ -0,0 +1,412 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"cube-castle/internal/config"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// reloadPollInterval 规则文件变更检查周期；评估周期由配置 evaluationInterval 决定
+const reloadPollInterval = 10 * time.Second
+
+// MetricSource 向规则引擎提供指标，返回的键即规则中的 metric 名称
+type MetricSource interface {
+	AlertMetrics(ctx context.Context) (map[string]float64, error)
+}
+
+// MetricSourceFunc 函数形式的 MetricSource
+type MetricSourceFunc func(ctx context.Context) (map[string]float64, error)
+
+// AlertMetrics 实现 MetricSource
+func (f MetricSourceFunc) AlertMetrics(ctx context.Context) (map[string]float64, error) {
+	return f(ctx)
+}
+
+// LogChannel 将告警写入应用日志，作为内置 log 渠道
+type LogChannel struct {
+	logger pkglogger.Logger
+}
+
+// NewLogChannel 创建日志告警渠道
+func NewLogChannel(logger pkglogger.Logger) *LogChannel {
+	if logger == nil {
+		logger = pkglogger.NewNoopLogger()
+	}
+	return &LogChannel{logger: logger}
+}
+
+// Name 返回渠道名称
+func (l *LogChannel) Name() string {
+	return "log"
+}
+
+// Send 按告警级别写日志
+func (l *LogChannel) Send(_ context.Context, alert Alert) error {
+	entry := l.logger.WithFields(pkglogger.Fields{
+		"alertId":   alert.ID,
+		"rule":      alert.Rule,
+		"component": alert.Component,
+		"level":     alert.Level,
+		"resolved":  alert.Resolved,
+		"details":   alert.Details,
+	})
+	switch {
+	case alert.Resolved:
+		entry.Info(alert.Message)
+	case alert.Level == AlertLevelCritical:
+		entry.Error(alert.Message)
+	case alert.Level == AlertLevelWarning:
+		entry.Warn(alert.Message)
+	default:
+		entry.Info(alert.Message)
+	}
+	return nil
+}
+
+type metricRuleState struct {
+	pendingSince time.Time
+	firing       *Alert
+}
+
+// MetricRuleEngine 按声明式规则评估指标：条件持续满足 for 时长后触发，恢复后发送解除通知，
+// 告警按严重级别路由到渠道；规则文件变更时热加载。
+type MetricRuleEngine struct {
+	serviceName string
+	logger      pkglogger.Logger
+	now         func() time.Time
+
+	mu         sync.Mutex
+	cfg        *config.AlertRulesConfig
+	sources    []MetricSource
+	channels   map[string]AlertChannel
+	configured map[string]AlertChannel
+	states     map[string]*metricRuleState
+	path       string
+	modTime    time.Time
+	nextEval   time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewMetricRuleEngine 创建规则引擎；cfg 为空时使用内置默认规则
+func NewMetricRuleEngine(serviceName string, cfg *config.AlertRulesConfig, logger pkglogger.Logger) *MetricRuleEngine {
+	if logger == nil {
+		logger = pkglogger.NewNoopLogger()
+	}
+	logger = logger.WithFields(pkglogger.Fields{
+		"service":   serviceName,
+		"component": "metric-alerting",
+	})
+	e := &MetricRuleEngine{
+		serviceName: serviceName,
+		logger:      logger,
+		now:         time.Now,
+		channels:    map[string]AlertChannel{},
+		states:      map[string]*metricRuleState{},
+	}
+	e.channels["log"] = NewLogChannel(logger)
+	if cfg == nil {
+		cfg = config.DefaultAlertRulesConfig()
+	}
+	e.SetConfig(cfg)
+	return e
+}
+
+// AddSource 注册指标来源
+func (e *MetricRuleEngine) AddSource(source MetricSource) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sources = append(e.sources, source)
+}
+
+// AddChannel 注册内置渠道（如 email），按 Name() 被路由引用
+func (e *MetricRuleEngine) AddChannel(channel AlertChannel) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if lc, ok := channel.(loggerAwareChannel); ok {
+		lc.SetLogger(e.logger)
+	}
+	e.channels[channel.Name()] = channel
+}
+
+// SetConfig 替换规则与渠道配置；已删除规则的状态被清理，保留规则的持续计时与触发状态不受影响
+func (e *MetricRuleEngine) SetConfig(cfg *config.AlertRulesConfig) {
+	configured := make(map[string]AlertChannel, len(cfg.Channels))
+	for _, ch := range cfg.Channels {
+		if ch.URL == "" {
+			continue
+		}
+		switch ch.Type {
+		case "webhook":
+			wh := NewWebhookChannel(ch.Name, ch.URL)
+			for k, v := range ch.Headers {
+				wh.AddHeader(k, v)
+			}
+			configured[ch.Name] = wh
+		case "slack":
+			configured[ch.Name] = &namedChannel{name: ch.Name, AlertChannel: NewSlackChannel(ch.URL, ch.Channel, e.serviceName)}
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg = cfg
+	e.configured = configured
+	active := make(map[string]bool, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		active[rule.Name] = true
+	}
+	for name := range e.states {
+		if !active[name] {
+			delete(e.states, name)
+		}
+	}
+	e.nextEval = time.Time{}
+}
+
+// LoadFile 从文件加载规则并记录路径，供 Start 检测变更后热加载
+func (e *MetricRuleEngine) LoadFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat alert rules: %w", err)
+	}
+	cfg, err := config.LoadAlertRulesFile(path)
+	e.mu.Lock()
+	e.path = path
+	e.modTime = info.ModTime()
+	e.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	e.SetConfig(cfg)
+	return nil
+}
+
+// reloadIfChanged 文件修改时间变化时重新加载；新规则无效时保留旧规则
+func (e *MetricRuleEngine) reloadIfChanged() {
+	e.mu.Lock()
+	path, modTime := e.path, e.modTime
+	e.mu.Unlock()
+	if path == "" {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.ModTime().Equal(modTime) {
+		return
+	}
+	if err := e.LoadFile(path); err != nil {
+		e.logger.WithFields(pkglogger.Fields{
+			"path":  path,
+			"error": err,
+		}).Error("alert rules reload failed, keeping previous rules")
+		return
+	}
+	e.logger.WithFields(pkglogger.Fields{"path": path}).Info("alert rules reloaded")
+}
+
+// Evaluate 采集指标并评估全部规则，返回当前处于触发状态的告警
+func (e *MetricRuleEngine) Evaluate(ctx context.Context) []Alert {
+	e.mu.Lock()
+	sources := append([]MetricSource(nil), e.sources...)
+	e.mu.Unlock()
+
+	metrics := map[string]float64{}
+	for _, source := range sources {
+		values, err := source.AlertMetrics(ctx)
+		if err != nil {
+			e.logger.WithFields(pkglogger.Fields{"error": err}).Warn("collect alert metrics failed")
+			continue
+		}
+		for k, v := range values {
+			metrics[k] = v
+		}
+	}
+
+	now := e.now()
+	type pending struct {
+		alert    Alert
+		channels []string
+	}
+	var outgoing []pending
+
+	e.mu.Lock()
+	cfg := e.cfg
+	for _, rule := range cfg.Rules {
+		value, ok := metrics[rule.Metric]
+		if !ok {
+			// 指标缺失（来源未注册或采集失败）时保持原状态
+			continue
+		}
+		state := e.states[rule.Name]
+		if state == nil {
+			state = &metricRuleState{}
+			e.states[rule.Name] = state
+		}
+
+		if !rule.Matches(value) {
+			state.pendingSince = time.Time{}
+			if state.firing != nil {
+				resolved := *state.firing
+				resolved.ID = state.firing.ID + "-resolved"
+				resolved.OriginalAlertID = state.firing.ID
+				resolved.Resolved = true
+				resolved.ResolvedAt = &now
+				resolved.Timestamp = now
+				resolved.Status = StatusHealthy
+				resolved.Level = AlertLevelInfo
+				resolved.Message = fmt.Sprintf("✅ 告警已解除: %s（当前值=%g）", rule.Name, value)
+				resolved.Details = metricAlertDetails(rule, value)
+				outgoing = append(outgoing, pending{alert: resolved, channels: cfg.ChannelsFor(rule)})
+				state.firing = nil
+			}
+			continue
+		}
+
+		if state.pendingSince.IsZero() {
+			state.pendingSince = now
+		}
+		if state.firing != nil || now.Sub(state.pendingSince) < rule.For {
+			continue
+		}
+		alert := Alert{
+			ID:        fmt.Sprintf("%s-%s-%d", e.serviceName, rule.Name, now.UnixNano()),
+			Service:   e.serviceName,
+			Component: rule.Metric,
+			Level:     AlertLevel(rule.Severity),
+			Status:    metricAlertStatus(rule.Severity),
+			Message:   fmt.Sprintf("[%s] %s: 当前值=%g, 条件 %s %g", rule.Name, rule.Description, value, rule.Operator, rule.Threshold),
+			Details:   metricAlertDetails(rule, value),
+			Timestamp: now,
+			Rule:      rule.Name,
+		}
+		state.firing = &alert
+		outgoing = append(outgoing, pending{alert: alert, channels: cfg.ChannelsFor(rule)})
+	}
+	active := e.activeLocked()
+	e.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, item := range outgoing {
+		for _, ch := range e.resolveChannels(item.channels) {
+			wg.Add(1)
+			go func(ch AlertChannel, alert Alert) {
+				defer wg.Done()
+				if err := ch.Send(ctx, alert); err != nil {
+					e.logger.WithFields(pkglogger.Fields{
+						"channel": ch.Name(),
+						"alertId": alert.ID,
+						"error":   err,
+					}).Error("failed to dispatch metric alert")
+				}
+			}(ch, item.alert)
+		}
+	}
+	wg.Wait()
+	return active
+}
+
+func (e *MetricRuleEngine) resolveChannels(names []string) []AlertChannel {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var out []AlertChannel
+	for _, name := range names {
+		if ch, ok := e.configured[name]; ok {
+			out = append(out, ch)
+		} else if ch, ok := e.channels[name]; ok {
+			out = append(out, ch)
+		} else {
+			e.logger.WithFields(pkglogger.Fields{"channel": name}).Debug("alert channel not enabled, skipped")
+		}
+	}
+	return out
+}
+
+// ActiveAlerts 返回当前处于触发状态的告警
+func (e *MetricRuleEngine) ActiveAlerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.activeLocked()
+}
+
+func (e *MetricRuleEngine) activeLocked() []Alert {
+	var alerts []Alert
+	for _, state := range e.states {
+		if state.firing != nil {
+			alerts = append(alerts, *state.firing)
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Rule < alerts[j].Rule })
+	return alerts
+}
+
+// Start 启动评估循环：定期检查规则文件变更，并按 evaluationInterval 评估
+func (e *MetricRuleEngine) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	e.cancel = cancel
+	e.done = make(chan struct{})
+	go func() {
+		defer close(e.done)
+		ticker := time.NewTicker(reloadPollInterval)
+		defer ticker.Stop()
+		for {
+			e.reloadIfChanged()
+			e.mu.Lock()
+			due := !e.now().Before(e.nextEval)
+			if due {
+				e.nextEval = e.now().Add(e.cfg.EvaluationInterval)
+			}
+			e.mu.Unlock()
+			if due {
+				e.Evaluate(ctx)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止评估循环
+func (e *MetricRuleEngine) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	<-e.done
+}
+
+func metricAlertStatus(severity string) HealthStatus {
+	if severity == string(AlertLevelCritical) {
+		return StatusUnhealthy
+	}
+	return StatusDegraded
+}
+
+func metricAlertDetails(rule config.MetricAlertRule, value float64) map[string]interface{} {
+	return map[string]interface{}{
+		"metric":    rule.Metric,
+		"value":     value,
+		"operator":  rule.Operator,
+		"threshold": rule.Threshold,
+		"for":       rule.For.String(),
+	}
+}
+
+// namedChannel 以配置中的渠道名覆盖 Name()，便于路由按名称引用
+type namedChannel struct {
+	AlertChannel
+	name string
+}
+
+func (n *namedChannel) Name() string {
+	return n.name
+}
@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cube-castle/internal/config"
+)
+
+func TestMetricRuleEngineHonoursForDurationAndResolves(t *testing.T) {
+	cfg := &config.AlertRulesConfig{
+		EvaluationInterval: time.Minute,
+		Routes:             map[string][]string{"critical": {"recording"}},
+		Rules: []config.MetricAlertRule{{
+			Name: "OUTBOX_BACKLOG_AGE", Metric: "outbox.backlog_age_seconds",
+			Operator: ">", Threshold: 300, For: 5 * time.Minute, Severity: "critical",
+		}},
+	}
+	engine := NewMetricRuleEngine("command", cfg, nil)
+	ch := &recordingChannel{}
+	engine.AddChannel(ch)
+	received := func() []Alert {
+		ch.mu.Lock()
+		defer ch.mu.Unlock()
+		return append([]Alert(nil), ch.alerts...)
+	}
+
+	var age float64
+	engine.AddSource(MetricSourceFunc(func(context.Context) (map[string]float64, error) {
+		return map[string]float64{"outbox.backlog_age_seconds": age}, nil
+	}))
+	now := time.Date(2025, 11, 27, 9, 0, 0, 0, time.UTC)
+	engine.now = func() time.Time { return now }
+
+	age = 600
+	if active := engine.Evaluate(context.Background()); len(active) != 0 {
+		t.Fatalf("alert fired before for duration elapsed: %+v", active)
+	}
+	now = now.Add(5 * time.Minute)
+	active := engine.Evaluate(context.Background())
+	if len(active) != 1 || active[0].Level != AlertLevelCritical {
+		t.Fatalf("expected one critical alert, got %+v", active)
+	}
+	now = now.Add(time.Minute)
+	engine.Evaluate(context.Background())
+	if got := received(); len(got) != 1 {
+		t.Fatalf("firing alert should be sent once, got %d", len(got))
+	}
+
+	age = 10
+	now = now.Add(time.Minute)
+	if active := engine.Evaluate(context.Background()); len(active) != 0 {
+		t.Fatalf("alert should be resolved, got %+v", active)
+	}
+	got := received()
+	if len(got) != 2 || !got[1].Resolved || got[1].OriginalAlertID != got[0].ID {
+		t.Fatalf("expected resolution referencing original alert, got %+v", got)
+	}
+}
+
+func TestMetricRuleEngineHotReloadsRulesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alert_rules.yaml")
+	write := func(threshold string, mtime time.Time) {
+		t.Helper()
+		content := "rules:\n  - name: ORPHANS\n    metric: temporal.orphan_records\n    op: \">\"\n    threshold: " + threshold + "\n    severity: warning\n"
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	base := time.Now().Add(-time.Hour)
+	write("10", base)
+
+	engine := NewMetricRuleEngine("command", nil, nil)
+	if err := engine.LoadFile(path); err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	engine.AddSource(MetricSourceFunc(func(context.Context) (map[string]float64, error) {
+		return map[string]float64{"temporal.orphan_records": 5}, nil
+	}))
+	if active := engine.Evaluate(context.Background()); len(active) != 0 {
+		t.Fatalf("unexpected alert: %+v", active)
+	}
+
+	write("3", base.Add(time.Minute))
+	engine.reloadIfChanged()
+	if active := engine.Evaluate(context.Background()); len(active) != 1 {
+		t.Fatalf("reloaded threshold not applied: %+v", active)
+	}
+
+	// 无效文件不替换当前规则
+	if err := os.WriteFile(path, []byte("rules:\n  - name: ORPHANS\n    op: \"?\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := base.Add(2 * time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	engine.reloadIfChanged()
+	if active := engine.ActiveAlerts(); len(active) != 1 || active[0].Rule != "ORPHANS" {
+		t.Fatalf("previous rules should be kept after invalid reload: %+v", active)
+	}
+}
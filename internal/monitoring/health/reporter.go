@@ -3,16 +3,31 @@ package health
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"html/template"
 	"net/http"
 	"strings"
 	"time"
+
+	"cube-castle/internal/monitoring/slo"
 )
 
 // StatusReporter 状态报告生成器
 type StatusReporter struct {
 	healthManager *HealthManager
 	baseURL       string
+	slo           SLOReporter
+}
+
+// SLOReporter 提供 SLO 报告（由 slo.Tracker 实现）
+type SLOReporter interface {
+	Report() slo.Report
+}
+
+// WithSLO 在仪表板中展示 SLO 达标情况
+func (sr *StatusReporter) WithSLO(reporter SLOReporter) *StatusReporter {
+	sr.slo = reporter
+	return sr
 }
 
 // NewStatusReporter 创建状态报告生成器
@@ -35,6 +50,7 @@ type ServiceDashboard struct {
 	Metrics      ServiceMetrics     `json:"metrics"`
 	Environment  EnvironmentInfo    `json:"environment"`
 	Dependencies []DependencyStatus `json:"dependencies"`
+	SLOs         []slo.Status       `json:"slos,omitempty"`
 }
 
 // ServiceMetrics 服务指标
@@ -122,6 +138,11 @@ func (sr *StatusReporter) GenerateDashboard(ctx context.Context) ServiceDashboar
 	// 获取依赖状态
 	deps := sr.getDependencyStatus(health)
 
+	var slos []slo.Status
+	if sr.slo != nil {
+		slos = sr.slo.Report().Objectives
+	}
+
 	return ServiceDashboard{
 		Service:      health.Service,
 		Version:      health.Version,
@@ -133,6 +154,7 @@ func (sr *StatusReporter) GenerateDashboard(ctx context.Context) ServiceDashboar
 		Metrics:      metrics,
 		Environment:  env,
 		Dependencies: deps,
+		SLOs:         slos,
 	}
 }
 
@@ -250,6 +272,10 @@ func (sr *StatusReporter) renderHTMLDashboard(w http.ResponseWriter, dashboard S
         .badge-healthy { background-color: #4CAF50; }
         .badge-degraded { background-color: #FF9800; }
         .badge-unhealthy { background-color: #F44336; }
+        .badge-ok { background-color: #4CAF50; }
+        .badge-burning { background-color: #FF9800; }
+        .badge-breached { background-color: #F44336; }
+        .badge-no_data { background-color: #9E9E9E; }
         .refresh-btn { background: #007bff; color: white; padding: 10px 20px; border: none; border-radius: 4px; cursor: pointer; }
         .refresh-btn:hover { background: #0056b3; }
     </style>
@@ -344,6 +370,38 @@ func (sr *StatusReporter) renderHTMLDashboard(w http.ResponseWriter, dashboard S
             {{end}}
         </div>
         
+        {{if .SLOs}}
+        <div class="status-card">
+            <h2>🎯 SLO 达标情况</h2>
+            <table class="checks-table">
+                <thead>
+                    <tr>
+                        <th>SLO</th>
+                        <th>目标</th>
+                        <th>状态</th>
+                        <th>可用性 / 目标</th>
+                        <th>延迟达标率 / 目标</th>
+                        <th>剩余错误预算（可用性 / 延迟）</th>
+                        <th>燃烧率（5m / 1h / 6h）</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range .SLOs}}
+                    <tr>
+                        <td>{{.Name}}</td>
+                        <td>{{.Target}}</td>
+                        <td><span class="status-badge badge-{{.Status}}">{{.Status}}</span></td>
+                        <td>{{pct .Compliance.Availability}} / {{if .AvailabilityObjective}}{{ratio .AvailabilityObjective}}{{else}}-{{end}}</td>
+                        <td>{{pct .Compliance.LatencyCompliance}} / {{if .LatencyThreshold}}{{ratio .LatencyObjective}} &lt; {{.LatencyThreshold}}{{else}}-{{end}}</td>
+                        <td>{{pct .AvailabilityBudgetRemaining}} / {{pct .LatencyBudgetRemaining}}</td>
+                        <td>{{range $i, $w := .Windows}}{{if $i}} / {{end}}{{burn $w}}{{end}}</td>
+                    </tr>
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+        {{end}}
+
         <div class="status-card">
             <h2>⚙️ 环境信息</h2>
             <div class="metrics-grid">
@@ -370,7 +428,7 @@ func (sr *StatusReporter) renderHTMLDashboard(w http.ResponseWriter, dashboard S
 </html>
 `
 
-	t, err := template.New("dashboard").Parse(tmpl)
+	t, err := template.New("dashboard").Funcs(dashboardFuncs).Parse(tmpl)
 	if err != nil {
 		http.Error(w, "Template error", http.StatusInternalServerError)
 		return
@@ -383,6 +441,29 @@ func (sr *StatusReporter) renderHTMLDashboard(w http.ResponseWriter, dashboard S
 	}
 }
 
+var dashboardFuncs = template.FuncMap{
+	"ratio": func(v float64) string { return fmt.Sprintf("%.2f%%", v*100) },
+	"pct": func(v *float64) string {
+		if v == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%.2f%%", *v*100)
+	},
+	// burn 取可用性与延迟燃烧率中较大者
+	"burn": func(w slo.WindowStatus) string {
+		var worst *float64
+		for _, r := range []*float64{w.AvailabilityBurnRate, w.LatencyBurnRate} {
+			if r != nil && (worst == nil || *r > *worst) {
+				worst = r
+			}
+		}
+		if worst == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%.1fx", *worst)
+	},
+}
+
 // StatusPageHandler 状态页面处理器
 func (sr *StatusReporter) StatusPageHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, _ *http.Request) {
@@ -0,0 +1,312 @@
+// Package smtptest 提供进程内 SMTP 收件服务，供告警邮件的集成测试使用。
+//
+// Sink 支持 EHLO/HELO、STARTTLS（自签名证书）、AUTH PLAIN、MAIL/RCPT/DATA 等最小命令集，
+// 并可通过 FailNext 模拟临时投递失败以验证重试逻辑。
+package smtptest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message 收到的一封邮件
+type Message struct {
+	From     string
+	To       []string
+	Data     []byte
+	Username string
+	TLS      bool
+}
+
+// Header 解析邮件头（解析失败时返回空头）
+func (m Message) Header() mail.Header {
+	msg, err := mail.ReadMessage(bytes.NewReader(m.Data))
+	if err != nil {
+		return mail.Header{}
+	}
+	return msg.Header
+}
+
+// Options Sink 配置
+type Options struct {
+	// StartTLS 启用 STARTTLS 扩展（自签名证书，客户端需跳过校验）
+	StartTLS bool
+	// Username/Password 非空时要求 AUTH PLAIN 认证
+	Username string
+	Password string
+}
+
+// Sink 进程内 SMTP 服务
+type Sink struct {
+	opts      Options
+	listener  net.Listener
+	tlsConfig *tls.Config
+
+	mu       sync.Mutex
+	messages []Message
+	failNext int
+	notify   chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewSink 在 127.0.0.1 随机端口启动 SMTP 服务。
+func NewSink(opts Options) (*Sink, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Sink{opts: opts, listener: listener, notify: make(chan struct{}, 1)}
+	if opts.StartTLS {
+		cert, err := selfSignedCert()
+		if err != nil {
+			listener.Close()
+			return nil, err
+		}
+		s.tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Host 返回监听地址的主机部分。
+func (s *Sink) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port 返回监听端口。
+func (s *Sink) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Close 停止服务。
+func (s *Sink) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+// FailNext 令接下来 n 次 DATA 返回 451 临时失败。
+func (s *Sink) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failNext = n
+}
+
+// Messages 返回已接收邮件的快照。
+func (s *Sink) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// WaitForMessages 等待至少 n 封邮件，超时返回当前已接收的邮件。
+func (s *Sink) WaitForMessages(n int, timeout time.Duration) []Message {
+	deadline := time.After(timeout)
+	for {
+		if msgs := s.Messages(); len(msgs) >= n {
+			return msgs
+		}
+		select {
+		case <-s.notify:
+		case <-deadline:
+			return s.Messages()
+		}
+	}
+}
+
+func (s *Sink) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+type session struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	msg      Message
+	authed   bool
+	tls      bool
+	username string
+}
+
+func (ss *session) reply(format string, args ...interface{}) {
+	fmt.Fprintf(ss.conn, format+"\r\n", args...)
+}
+
+func (s *Sink) handle(conn net.Conn) {
+	ss := &session{conn: conn, reader: bufio.NewReader(conn)}
+	defer func() { ss.conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(30 * time.Second))
+
+	ss.reply("220 smtptest ready")
+	for {
+		line, err := ss.reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			ss.reply("250-smtptest")
+			if s.tlsConfig != nil && !ss.tls {
+				ss.reply("250-STARTTLS")
+			}
+			ss.reply("250 AUTH PLAIN")
+		case "STARTTLS":
+			if s.tlsConfig == nil {
+				ss.reply("502 STARTTLS not supported")
+				continue
+			}
+			ss.reply("220 ready to start TLS")
+			tlsConn := tls.Server(ss.conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			ss.conn = tlsConn
+			ss.reader = bufio.NewReader(tlsConn)
+			ss.tls = true
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			if !strings.EqualFold(mech, "PLAIN") {
+				ss.reply("504 unsupported mechanism")
+				continue
+			}
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			parts := strings.Split(string(decoded), "\x00")
+			if err != nil || len(parts) != 3 || parts[1] != s.opts.Username || parts[2] != s.opts.Password {
+				ss.reply("535 authentication failed")
+				continue
+			}
+			ss.authed = true
+			ss.username = parts[1]
+			ss.reply("235 authenticated")
+		case "MAIL":
+			if s.opts.Username != "" && !ss.authed {
+				ss.reply("530 authentication required")
+				continue
+			}
+			ss.msg = Message{From: extractAddress(arg)}
+			ss.reply("250 OK")
+		case "RCPT":
+			ss.msg.To = append(ss.msg.To, extractAddress(arg))
+			ss.reply("250 OK")
+		case "DATA":
+			ss.reply("354 end data with <CR><LF>.<CR><LF>")
+			data, err := readData(ss.reader)
+			if err != nil {
+				return
+			}
+			if s.consumeFailure() {
+				ss.reply("451 temporary failure")
+				continue
+			}
+			ss.msg.Data = data
+			ss.msg.Username = ss.username
+			ss.msg.TLS = ss.tls
+			s.record(ss.msg)
+			ss.reply("250 queued")
+		case "RSET":
+			ss.msg = Message{}
+			ss.reply("250 OK")
+		case "NOOP":
+			ss.reply("250 OK")
+		case "QUIT":
+			ss.reply("221 bye")
+			return
+		default:
+			ss.reply("502 command not implemented")
+		}
+	}
+}
+
+func (s *Sink) consumeFailure() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failNext > 0 {
+		s.failNext--
+		return true
+	}
+	return false
+}
+
+func (s *Sink) record(msg Message) {
+	s.mu.Lock()
+	s.messages = append(s.messages, msg)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func readData(r *bufio.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if line == ".\r\n" || line == ".\n" {
+			return buf.Bytes(), nil
+		}
+		// 去除 dot-stuffing
+		line = strings.TrimPrefix(line, ".")
+		buf.WriteString(line)
+	}
+}
+
+func extractAddress(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+	if i := strings.Index(addr, " "); i >= 0 {
+		addr = addr[:i]
+	}
+	return strings.Trim(addr, "<>")
+}
+
+func selfSignedCert() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "smtptest"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
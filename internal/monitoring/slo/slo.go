@@ -0,0 +1,349 @@
+// Package slo 基于进程内 Prometheus 直方图快照跟踪按操作划分的 SLO：滚动窗口内的可用性、
+// 延迟达标率、燃烧率与剩余错误预算。
+package slo
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cube-castle/internal/config"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// HTTPMetric REST 请求耗时直方图
+	HTTPMetric = "http_request_duration_seconds"
+	// GraphQLMetric GraphQL 操作耗时直方图
+	GraphQLMetric = "graphql_operation_duration_seconds"
+
+	// StatusOK 燃烧率正常
+	StatusOK = "ok"
+	// StatusBurning 短窗口燃烧率超过快速燃烧阈值，预算将在窗口结束前耗尽
+	StatusBurning = "burning"
+	// StatusBreached 合规窗口内错误预算已耗尽
+	StatusBreached = "breached"
+	// StatusNoData 窗口内无请求
+	StatusNoData = "no_data"
+)
+
+// fastBurnRate 多窗口告警阈值：1 小时内消耗 30 天预算的 2%（Google SRE 推荐值）
+const fastBurnRate = 14.4
+
+// burnWindows 报告中计算燃烧率的窗口
+var burnWindows = []time.Duration{5 * time.Minute, time.Hour, 6 * time.Hour}
+
+type counts struct {
+	total  float64
+	errors float64
+	fast   float64
+}
+
+type sample struct {
+	at     time.Time
+	counts map[string]counts
+}
+
+// Tracker 周期性快照直方图累计值，并按窗口差分计算 SLO 状态。
+type Tracker struct {
+	cfg      *config.SLOConfig
+	gatherer prometheus.Gatherer
+	logger   pkglogger.Logger
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	samples []sample
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTracker 创建 SLO 跟踪器；gatherer 为空时使用默认注册表。
+func NewTracker(cfg *config.SLOConfig, gatherer prometheus.Gatherer, logger pkglogger.Logger) *Tracker {
+	if cfg == nil {
+		cfg = config.DefaultSLOConfig()
+	}
+	if gatherer == nil {
+		gatherer = prometheus.DefaultGatherer
+	}
+	if logger == nil {
+		logger = pkglogger.NewNoopLogger()
+	}
+	return &Tracker{
+		cfg:      cfg,
+		gatherer: gatherer,
+		logger:   logger.WithFields(pkglogger.Fields{"component": "slo"}),
+		now:      time.Now,
+	}
+}
+
+// Sample 记录一次快照，并丢弃超出合规窗口的旧快照。
+func (t *Tracker) Sample() error {
+	families, err := t.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*dto.MetricFamily, len(families))
+	for _, mf := range families {
+		byName[mf.GetName()] = mf
+	}
+
+	snap := sample{at: t.now(), counts: make(map[string]counts, len(t.cfg.Objectives))}
+	for _, o := range t.cfg.Objectives {
+		snap.counts[o.Name] = collect(o, byName)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples = append(t.samples, snap)
+	// 保留一个早于窗口起点的快照作为差分基线
+	cutoff := snap.at.Add(-t.cfg.Window)
+	drop := 0
+	for drop+1 < len(t.samples) && !t.samples[drop+1].at.After(cutoff) {
+		drop++
+	}
+	t.samples = t.samples[drop:]
+	return nil
+}
+
+// collect 从直方图中累计目标的请求总数、失败数与阈值内完成数。
+func collect(o config.SLOObjective, families map[string]*dto.MetricFamily) counts {
+	var c counts
+	name := HTTPMetric
+	if o.Kind == config.SLOKindGraphQL {
+		name = GraphQLMetric
+	}
+	mf := families[name]
+	if mf == nil {
+		return c
+	}
+	threshold := o.LatencyThreshold.Seconds()
+	for _, m := range mf.GetMetric() {
+		labels := make(map[string]string, len(m.GetLabel()))
+		for _, lp := range m.GetLabel() {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+		failed := false
+		switch o.Kind {
+		case config.SLOKindGraphQL:
+			if labels["operation"] != o.Operation {
+				continue
+			}
+			failed = labels["status"] == "error"
+		default:
+			if labels["method"] != o.Method || !sameRoute(labels["route"], o.Route) {
+				continue
+			}
+			code, _ := strconv.Atoi(labels["status"])
+			failed = code >= 500
+		}
+		h := m.GetHistogram()
+		if h == nil {
+			continue
+		}
+		total := float64(h.GetSampleCount())
+		c.total += total
+		if failed {
+			c.errors += total
+		}
+		// 取不超过阈值的最大桶作为达标计数（阈值不在桶边界时偏保守）
+		var fast float64
+		for _, b := range h.GetBucket() {
+			if b.GetUpperBound() <= threshold+1e-9 {
+				fast = float64(b.GetCumulativeCount())
+			}
+		}
+		c.fast += fast
+	}
+	return c
+}
+
+func sameRoute(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// WindowStatus 单个窗口的达标情况。
+type WindowStatus struct {
+	Window   string `json:"window"`
+	Requests int64  `json:"requests"`
+	// Covered 实际覆盖的时长（进程启动不久时短于 Window）
+	Covered              string   `json:"covered"`
+	Availability         *float64 `json:"availability,omitempty"`
+	LatencyCompliance    *float64 `json:"latencyCompliance,omitempty"`
+	AvailabilityBurnRate *float64 `json:"availabilityBurnRate,omitempty"`
+	LatencyBurnRate      *float64 `json:"latencyBurnRate,omitempty"`
+}
+
+// Status 单个 SLO 的报告。
+type Status struct {
+	Name                  string         `json:"name"`
+	Kind                  string         `json:"kind"`
+	Target                string         `json:"target"`
+	AvailabilityObjective float64        `json:"availabilityObjective,omitempty"`
+	LatencyThreshold      string         `json:"latencyThreshold,omitempty"`
+	LatencyObjective      float64        `json:"latencyObjective,omitempty"`
+	Status                string         `json:"status"`
+	Compliance            WindowStatus   `json:"compliance"`
+	Windows               []WindowStatus `json:"burnRates"`
+	// AvailabilityBudgetRemaining/LatencyBudgetRemaining 合规窗口内剩余错误预算比例（可为负，表示超支）
+	AvailabilityBudgetRemaining *float64 `json:"availabilityBudgetRemaining,omitempty"`
+	LatencyBudgetRemaining      *float64 `json:"latencyBudgetRemaining,omitempty"`
+}
+
+// Report SLO 报告。
+type Report struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	Window      string    `json:"window"`
+	Objectives  []Status  `json:"objectives"`
+}
+
+// Report 计算当前全部 SLO 的状态。
+func (t *Tracker) Report() Report {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	report := Report{
+		GeneratedAt: t.now().UTC(),
+		Window:      t.cfg.Window.String(),
+		Objectives:  make([]Status, 0, len(t.cfg.Objectives)),
+	}
+	for _, o := range t.cfg.Objectives {
+		st := Status{
+			Name:                  o.Name,
+			Kind:                  o.Kind,
+			Target:                o.Target(),
+			AvailabilityObjective: o.Availability,
+			LatencyObjective:      o.LatencyObjective,
+		}
+		if o.LatencyThreshold > 0 {
+			st.LatencyThreshold = o.LatencyThreshold.String()
+		}
+		st.Compliance = t.window(o, t.cfg.Window)
+		for _, w := range burnWindows {
+			if w < t.cfg.Window {
+				st.Windows = append(st.Windows, t.window(o, w))
+			}
+		}
+		st.AvailabilityBudgetRemaining = remaining(st.Compliance.AvailabilityBurnRate)
+		st.LatencyBudgetRemaining = remaining(st.Compliance.LatencyBurnRate)
+		st.Status = classify(st)
+		report.Objectives = append(report.Objectives, st)
+	}
+	return report
+}
+
+// window 以最新快照与窗口起点处快照的差值计算指标；历史不足时使用最早快照。
+func (t *Tracker) window(o config.SLOObjective, d time.Duration) WindowStatus {
+	ws := WindowStatus{Window: d.String(), Covered: "0s"}
+	if len(t.samples) < 2 {
+		return ws
+	}
+	latest := t.samples[len(t.samples)-1]
+	start := latest.at.Add(-d)
+	base := t.samples[0]
+	for _, s := range t.samples {
+		if s.at.After(start) {
+			break
+		}
+		base = s
+	}
+	ws.Covered = latest.at.Sub(base.at).Round(time.Second).String()
+
+	cur, prev := latest.counts[o.Name], base.counts[o.Name]
+	total := cur.total - prev.total
+	if total <= 0 {
+		// 计数回退（指标重置）或窗口内无请求
+		return ws
+	}
+	ws.Requests = int64(total)
+	if o.Availability > 0 {
+		bad := (cur.errors - prev.errors) / total
+		ws.Availability = ptr(1 - bad)
+		ws.AvailabilityBurnRate = ptr(bad / (1 - o.Availability))
+	}
+	if o.LatencyThreshold > 0 {
+		slow := 1 - (cur.fast-prev.fast)/total
+		ws.LatencyCompliance = ptr(1 - slow)
+		ws.LatencyBurnRate = ptr(slow / (1 - o.LatencyObjective))
+	}
+	return ws
+}
+
+func classify(st Status) string {
+	if st.Compliance.Requests == 0 {
+		return StatusNoData
+	}
+	for _, r := range []*float64{st.AvailabilityBudgetRemaining, st.LatencyBudgetRemaining} {
+		if r != nil && *r <= 0 {
+			return StatusBreached
+		}
+	}
+	// 快速燃烧：长短两个窗口同时超过阈值，避免单次尖峰误报
+	var short, long *WindowStatus
+	for i := range st.Windows {
+		switch st.Windows[i].Window {
+		case (5 * time.Minute).String():
+			short = &st.Windows[i]
+		case time.Hour.String():
+			long = &st.Windows[i]
+		}
+	}
+	if short != nil && long != nil {
+		if burning(short.AvailabilityBurnRate, long.AvailabilityBurnRate) || burning(short.LatencyBurnRate, long.LatencyBurnRate) {
+			return StatusBurning
+		}
+	}
+	return StatusOK
+}
+
+func burning(short, long *float64) bool {
+	return short != nil && long != nil && *short > fastBurnRate && *long > fastBurnRate
+}
+
+func remaining(burn *float64) *float64 {
+	if burn == nil {
+		return nil
+	}
+	return ptr(1 - *burn)
+}
+
+func ptr(v float64) *float64 {
+	v = math.Round(v*1e6) / 1e6
+	return &v
+}
+
+// Start 启动快照循环。
+func (t *Tracker) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	t.cancel = cancel
+	t.done = make(chan struct{})
+	go func() {
+		defer close(t.done)
+		ticker := time.NewTicker(t.cfg.SampleInterval)
+		defer ticker.Stop()
+		for {
+			if err := t.Sample(); err != nil {
+				t.logger.WithFields(pkglogger.Fields{"error": err}).Warn("slo sample failed")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止快照循环。
+func (t *Tracker) Stop() {
+	if t.cancel == nil {
+		return
+	}
+	t.cancel()
+	<-t.done
+}
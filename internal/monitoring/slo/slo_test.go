@@ -0,0 +1,126 @@
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"cube-castle/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func newTestTracker(t *testing.T) (*Tracker, *prometheus.HistogramVec, *prometheus.HistogramVec, *time.Time) {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+	buckets := []float64{0.05, 0.2, 1}
+	httpHist := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: HTTPMetric, Buckets: buckets}, []string{"method", "route", "status"})
+	gqlHist := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: GraphQLMetric, Buckets: buckets}, []string{"operation", "status"})
+	reg.MustRegister(httpHist, gqlHist)
+
+	cfg := &config.SLOConfig{
+		Window:         24 * time.Hour,
+		SampleInterval: time.Minute,
+		Objectives: []config.SLOObjective{
+			{Name: "create-org", Kind: config.SLOKindREST, Method: "POST", Route: "/api/v1/organization-units", Availability: 0.99, LatencyThreshold: 200 * time.Millisecond, LatencyObjective: 0.9},
+			{Name: "organizations", Kind: config.SLOKindGraphQL, Operation: "organizations", LatencyThreshold: 50 * time.Millisecond, LatencyObjective: 0.95},
+		},
+	}
+	tracker := NewTracker(cfg, reg, nil)
+	now := time.Date(2025, 11, 28, 9, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+	return tracker, httpHist, gqlHist, &now
+}
+
+func TestTrackerComputesComplianceAndBudget(t *testing.T) {
+	tracker, httpHist, gqlHist, now := newTestTracker(t)
+	if err := tracker.Sample(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 路由模板带尾部斜杠（chi 子路由）也应匹配
+	for i := 0; i < 95; i++ {
+		httpHist.WithLabelValues("POST", "/api/v1/organization-units/", "201").Observe(0.1)
+	}
+	for i := 0; i < 5; i++ {
+		httpHist.WithLabelValues("POST", "/api/v1/organization-units/", "500").Observe(0.5)
+	}
+	httpHist.WithLabelValues("GET", "/api/v1/organization-units/", "500").Observe(0.1)
+	for i := 0; i < 10; i++ {
+		gqlHist.WithLabelValues("organizations", "success").Observe(0.01)
+	}
+
+	*now = now.Add(10 * time.Minute)
+	if err := tracker.Sample(); err != nil {
+		t.Fatal(err)
+	}
+	report := tracker.Report()
+	if len(report.Objectives) != 2 {
+		t.Fatalf("expected 2 objectives, got %d", len(report.Objectives))
+	}
+
+	rest := report.Objectives[0]
+	if rest.Compliance.Requests != 100 {
+		t.Fatalf("expected 100 matching requests, got %d", rest.Compliance.Requests)
+	}
+	if got := *rest.Compliance.Availability; got != 0.95 {
+		t.Fatalf("availability = %v, want 0.95", got)
+	}
+	// 5% 失败 / 1% 预算 = 5 倍燃烧，预算超支 400%
+	if got := *rest.Compliance.AvailabilityBurnRate; got != 5 {
+		t.Fatalf("availability burn rate = %v, want 5", got)
+	}
+	if got := *rest.AvailabilityBudgetRemaining; got != -4 {
+		t.Fatalf("availability budget remaining = %v, want -4", got)
+	}
+	if got := *rest.Compliance.LatencyCompliance; got != 0.95 {
+		t.Fatalf("latency compliance = %v, want 0.95", got)
+	}
+	if rest.Status != StatusBreached {
+		t.Fatalf("status = %s, want %s", rest.Status, StatusBreached)
+	}
+
+	gql := report.Objectives[1]
+	if gql.Status != StatusOK || gql.Compliance.Availability != nil || *gql.LatencyBudgetRemaining != 1 {
+		t.Fatalf("unexpected graphql status: %+v", gql)
+	}
+}
+
+func TestTrackerWindowsAndNoData(t *testing.T) {
+	tracker, httpHist, _, now := newTestTracker(t)
+	if got := tracker.Report().Objectives[0].Status; got != StatusNoData {
+		t.Fatalf("status without samples = %s, want %s", got, StatusNoData)
+	}
+
+	if err := tracker.Sample(); err != nil {
+		t.Fatal(err)
+	}
+	httpHist.WithLabelValues("POST", "/api/v1/organization-units", "201").Observe(0.1)
+	for i := 0; i < 2; i++ {
+		*now = now.Add(time.Hour)
+		if err := tracker.Sample(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	*now = now.Add(10 * time.Minute)
+	if err := tracker.Sample(); err != nil {
+		t.Fatal(err)
+	}
+
+	st := tracker.Report().Objectives[0]
+	if st.Compliance.Requests != 1 {
+		t.Fatalf("compliance window should include all requests, got %d", st.Compliance.Requests)
+	}
+	for _, w := range st.Windows {
+		if w.Window == time.Hour.String() && w.Requests != 0 {
+			t.Fatalf("1h window should exclude older requests, got %d", w.Requests)
+		}
+	}
+
+	// 超出合规窗口的快照被裁剪，仅保留一个基线
+	*now = now.Add(48 * time.Hour)
+	if err := tracker.Sample(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tracker.samples) != 2 {
+		t.Fatalf("expected old samples pruned, got %d", len(tracker.samples))
+	}
+}
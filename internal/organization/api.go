@@ -9,16 +9,26 @@ import (
 
 	auth "cube-castle/internal/auth"
 	configpkg "cube-castle/internal/config"
+	"cube-castle/internal/monitoring/health"
+	"cube-castle/internal/monitoring/slo"
 	auditpkg "cube-castle/internal/organization/audit"
+	compensationpkg "cube-castle/internal/organization/compensation"
+	costcenterpkg "cube-castle/internal/organization/costcenter"
+	customfieldpkg "cube-castle/internal/organization/customfield"
 	dto "cube-castle/internal/organization/dto"
 	handlerpkg "cube-castle/internal/organization/handler"
+	lifecyclepkg "cube-castle/internal/organization/lifecycle"
+	locationpkg "cube-castle/internal/organization/location"
 	middlewarepkg "cube-castle/internal/organization/middleware"
+	notificationpkg "cube-castle/internal/organization/notification"
 	repositorypkg "cube-castle/internal/organization/repository"
 	"cube-castle/internal/organization/resolver"
 	schedulerpkg "cube-castle/internal/organization/scheduler"
 	servicepkg "cube-castle/internal/organization/service"
+	unittypepkg "cube-castle/internal/organization/unittype"
 	utilspkg "cube-castle/internal/organization/utils"
 	validatorpkg "cube-castle/internal/organization/validator"
+	webhookpkg "cube-castle/internal/organization/webhook"
 	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
@@ -38,6 +48,16 @@ type PositionHandler = handlerpkg.PositionHandler
 type JobCatalogHandler = handlerpkg.JobCatalogHandler
 type OperationalHandler = handlerpkg.OperationalHandler
 type DevToolsHandler = handlerpkg.DevToolsHandler
+type AuditChainHandler = handlerpkg.AuditChainHandler
+type AuditArchiveHandler = handlerpkg.AuditArchiveHandler
+type NotificationHandler = handlerpkg.NotificationHandler
+type WebhookHandler = handlerpkg.WebhookHandler
+type CustomFieldHandler = handlerpkg.CustomFieldHandler
+type UnitTypeHandler = handlerpkg.UnitTypeHandler
+type CompensationGradeHandler = handlerpkg.CompensationGradeHandler
+type CostCenterHandler = handlerpkg.CostCenterHandler
+type LocationHandler = handlerpkg.LocationHandler
+type PositionLifecycleHandler = handlerpkg.PositionLifecycleHandler
 type AuditLogger = auditpkg.AuditLogger
 type AuditHistoryConfig = repositorypkg.AuditHistoryConfig
 type QueryRepository = repositorypkg.PostgreSQLRepository
@@ -58,6 +78,7 @@ type CommandModule struct {
 	Services     CommandServices
 	Validator    *validatorpkg.BusinessRuleValidator
 	AuditLogger  *auditpkg.AuditLogger
+	OutboxRepo   database.OutboxRepository
 }
 
 type CommandRepositories struct {
@@ -70,18 +91,39 @@ type CommandRepositories struct {
 }
 
 type CommandServices struct {
-	Cascade    *servicepkg.CascadeUpdateService
-	Scheduler  *schedulerpkg.Service
-	Position   *servicepkg.PositionService
-	JobCatalog *servicepkg.JobCatalogService
+	Cascade       *servicepkg.CascadeUpdateService
+	Scheduler     *schedulerpkg.Service
+	Position      *servicepkg.PositionService
+	JobCatalog    *servicepkg.JobCatalogService
+	AuditChain    *auditpkg.ChainService
+	AuditArchive  *auditpkg.ArchiveService
+	Notifications *notificationpkg.Service
+	Webhooks      *webhookpkg.Service
+	CustomFields  *customfieldpkg.Service
+	UnitTypes     *unittypepkg.Service
+	Grades        *compensationpkg.Service
+	CostCenters   *costcenterpkg.Service
+	Locations     *locationpkg.Service
+	Lifecycle     *lifecyclepkg.Service
+	SLO           *slo.Tracker
 }
 
 type CommandHandlers struct {
-	Organization *handlerpkg.OrganizationHandler
-	Position     *handlerpkg.PositionHandler
-	JobCatalog   *handlerpkg.JobCatalogHandler
-	Operational  *handlerpkg.OperationalHandler
-	DevTools     *handlerpkg.DevToolsHandler
+	Organization      *handlerpkg.OrganizationHandler
+	Position          *handlerpkg.PositionHandler
+	JobCatalog        *handlerpkg.JobCatalogHandler
+	Operational       *handlerpkg.OperationalHandler
+	DevTools          *handlerpkg.DevToolsHandler
+	AuditChain        *handlerpkg.AuditChainHandler
+	AuditArchive      *handlerpkg.AuditArchiveHandler
+	Notification      *handlerpkg.NotificationHandler
+	Webhook           *handlerpkg.WebhookHandler
+	CustomField       *handlerpkg.CustomFieldHandler
+	UnitType          *handlerpkg.UnitTypeHandler
+	CompensationGrade *handlerpkg.CompensationGradeHandler
+	CostCenter        *handlerpkg.CostCenterHandler
+	Location          *handlerpkg.LocationHandler
+	PositionLifecycle *handlerpkg.PositionLifecycleHandler
 }
 
 type CommandHandlerDeps struct {
@@ -117,6 +159,19 @@ func NewCommandModule(deps CommandModuleDeps) (*CommandModule, error) {
 	timelineManager := repositorypkg.NewTemporalTimelineManager(deps.DB, logger)
 
 	auditLogger := auditpkg.NewAuditLogger(deps.DB, logger)
+	checkpointSigner, err := auditpkg.LoadCheckpointSignerFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if checkpointSigner == nil {
+		logger.Warn("AUDIT_CHECKPOINT_SIGNING_KEY 未配置，审计链检查点签发已禁用")
+	}
+	auditChain := auditpkg.NewChainService(deps.DB, checkpointSigner, logger)
+	archiveStore, err := auditpkg.LoadArchiveStoreFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	auditArchive := auditpkg.NewArchiveService(deps.DB, archiveStore, logger)
 	cascadeService := servicepkg.NewCascadeUpdateService(hierarchyRepo, cascadeDepth, logger)
 	positionValidator, assignmentValidator := validatorpkg.NewPositionAssignmentValidationService(
 		orgRepo,
@@ -125,18 +180,60 @@ func NewCommandModule(deps CommandModuleDeps) (*CommandModule, error) {
 		positionAssignmentRepo,
 		logger,
 	)
+	customFieldService := customfieldpkg.NewService(customfieldpkg.NewSQLStore(deps.DB), logger)
+	if aware, ok := positionValidator.(validatorpkg.CustomFieldAware); ok {
+		aware.SetCustomFieldDefinitions(customFieldService)
+	}
+	gradeService := compensationpkg.NewService(compensationpkg.NewSQLStore(deps.DB), logger)
+	if aware, ok := positionValidator.(validatorpkg.CompensationGradeAware); ok {
+		aware.SetCompensationGrades(gradeService)
+	}
+	costCenterService := costcenterpkg.NewService(costcenterpkg.NewSQLStore(deps.DB), logger)
+	if aware, ok := positionValidator.(validatorpkg.CostCenterAware); ok {
+		aware.SetCostCenters(costCenterService)
+	}
+	locationService := locationpkg.NewService(locationpkg.NewSQLStore(deps.DB), logger)
+	lifecycleService := lifecyclepkg.NewService(lifecyclepkg.NewSQLStore(deps.DB), logger)
+	if aware, ok := positionValidator.(validatorpkg.LifecycleAware); ok {
+		aware.SetLifecycle(lifecycleService)
+	}
 	positionService := servicepkg.NewPositionService(positionRepo, positionAssignmentRepo, jobCatalogRepo, orgRepo, positionValidator, assignmentValidator, auditLogger, logger, deps.OutboxRepo)
+	positionService.SetLifecycle(lifecycleService)
 	jobCatalogValidator := validatorpkg.NewJobCatalogValidationService(jobCatalogRepo, logger)
-	jobCatalogService := servicepkg.NewJobCatalogService(jobCatalogRepo, jobCatalogValidator, auditLogger, logger, deps.OutboxRepo)
+	jobCatalogService := servicepkg.NewJobCatalogService(jobCatalogRepo, jobCatalogValidator, positionService, auditLogger, logger, deps.OutboxRepo)
+	notificationStore := notificationpkg.NewSQLStore(deps.DB)
+	notificationChannels := []notificationpkg.Channel{
+		notificationpkg.NewInboxChannel(notificationStore),
+		notificationpkg.NewWebhookChannel(nil),
+	}
+	if emailCfg, ok := health.LoadEmailConfigFromEnv(); ok {
+		notificationChannels = append(notificationChannels, notificationpkg.NewEmailChannel(emailCfg))
+	} else {
+		logger.Info("ALERT_SMTP_HOST 未配置，业务通知邮件渠道已禁用")
+	}
+	notificationService := notificationpkg.NewService(notificationStore, logger, notificationChannels...)
+	webhookService := webhookpkg.NewService(webhookpkg.NewSQLStore(deps.DB), webhookpkg.LoadConfigFromEnv(), nil, logger)
 	schedulerService := schedulerpkg.NewService(schedulerpkg.Dependencies{
 		DB:                     deps.DB,
 		Logger:                 logger,
 		OrganizationRepository: orgRepo,
 		PositionService:        positionService,
+		AuditChain:             auditChain,
+		AuditArchive:           auditArchive,
+		Notifications:          notificationService,
 		Config:                 deps.SchedulerConfig,
 	})
 
+	sloConfig := configpkg.GetSLOConfig()
+	if sloConfig.Err != nil {
+		logger.WithFields(pkglogger.Fields{"error": sloConfig.Err}).Warn("SLO 配置无效，使用内置默认目标")
+	}
+	sloTracker := slo.NewTracker(sloConfig.Config, nil, logger)
+
 	validator := validatorpkg.NewBusinessRuleValidator(hierarchyRepo, orgRepo, logger)
+	validator.SetCustomFieldDefinitions(customFieldService)
+	unitTypeService := unittypepkg.NewService(unittypepkg.NewSQLStore(deps.DB), logger)
+	validator.SetUnitTypeSource(unitTypeService)
 
 	module := &CommandModule{
 		DB:     deps.DB,
@@ -150,13 +247,25 @@ func NewCommandModule(deps CommandModuleDeps) (*CommandModule, error) {
 			TemporalTimeline:   timelineManager,
 		},
 		Services: CommandServices{
-			Cascade:    cascadeService,
-			Scheduler:  schedulerService,
-			Position:   positionService,
-			JobCatalog: jobCatalogService,
+			Cascade:       cascadeService,
+			Scheduler:     schedulerService,
+			Position:      positionService,
+			JobCatalog:    jobCatalogService,
+			AuditChain:    auditChain,
+			AuditArchive:  auditArchive,
+			Notifications: notificationService,
+			Webhooks:      webhookService,
+			CustomFields:  customFieldService,
+			UnitTypes:     unitTypeService,
+			Grades:        gradeService,
+			CostCenters:   costCenterService,
+			Locations:     locationService,
+			Lifecycle:     lifecycleService,
+			SLO:           sloTracker,
 		},
 		Validator:   validator,
 		AuditLogger: auditLogger,
+		OutboxRepo:  deps.OutboxRepo,
 	}
 
 	return module, nil
@@ -177,17 +286,43 @@ func (m *CommandModule) NewHandlers(deps CommandHandlerDeps) CommandHandlers {
 		m.Repositories.Hierarchy,
 		m.Validator,
 	)
+	if m.OutboxRepo != nil {
+		orgHandler.SetOutbox(m.DB, m.OutboxRepo)
+	}
 	positionHandler := handlerpkg.NewPositionHandler(m.Services.Position, m.AuditLogger, logger)
 	jobCatalogHandler := handlerpkg.NewJobCatalogHandler(m.Services.JobCatalog, logger)
 	operationalHandler := handlerpkg.NewOperationalHandler(schedulerService.Monitor(), schedulerService.Operational(), deps.RateLimitMiddleware, logger)
+	if m.Services.SLO != nil {
+		operationalHandler.SetSLOReporter(m.Services.SLO)
+	}
 	devToolsHandler := handlerpkg.NewDevToolsHandler(deps.JWTMiddleware, logger, deps.DevMode, m.DB)
+	auditChainHandler := handlerpkg.NewAuditChainHandler(m.Services.AuditChain, logger)
+	auditArchiveHandler := handlerpkg.NewAuditArchiveHandler(m.Services.AuditArchive, m.AuditLogger, logger)
+	notificationHandler := handlerpkg.NewNotificationHandler(m.Services.Notifications, m.AuditLogger, logger)
+	webhookHandler := handlerpkg.NewWebhookHandler(m.Services.Webhooks, m.AuditLogger, logger)
+	customFieldHandler := handlerpkg.NewCustomFieldHandler(m.Services.CustomFields, m.AuditLogger, logger)
+	unitTypeHandler := handlerpkg.NewUnitTypeHandler(m.Services.UnitTypes, m.AuditLogger, logger)
+	gradeHandler := handlerpkg.NewCompensationGradeHandler(m.Services.Grades, m.AuditLogger, logger)
+	costCenterHandler := handlerpkg.NewCostCenterHandler(m.Services.CostCenters, m.AuditLogger, logger)
+	locationHandler := handlerpkg.NewLocationHandler(m.Services.Locations, m.AuditLogger, logger)
+	lifecycleHandler := handlerpkg.NewPositionLifecycleHandler(m.Services.Lifecycle, m.AuditLogger, logger)
 
 	return CommandHandlers{
-		Organization: orgHandler,
-		Position:     positionHandler,
-		JobCatalog:   jobCatalogHandler,
-		Operational:  operationalHandler,
-		DevTools:     devToolsHandler,
+		Organization:      orgHandler,
+		Position:          positionHandler,
+		JobCatalog:        jobCatalogHandler,
+		Operational:       operationalHandler,
+		DevTools:          devToolsHandler,
+		AuditChain:        auditChainHandler,
+		AuditArchive:      auditArchiveHandler,
+		Notification:      notificationHandler,
+		Webhook:           webhookHandler,
+		CustomField:       customFieldHandler,
+		UnitType:          unitTypeHandler,
+		CompensationGrade: gradeHandler,
+		CostCenter:        costCenterHandler,
+		Location:          locationHandler,
+		PositionLifecycle: lifecycleHandler,
 	}
 }
 
@@ -210,7 +345,7 @@ func NewDevToolsHandler(db *sql.DB, jwt *auth.JWTMiddleware, logger pkglogger.Lo
 	return handlerpkg.NewDevToolsHandler(jwt, logger, devMode, db)
 }
 
-func NewQueryRepository(db *sql.DB, redisClient *redis.Client, logger pkglogger.Logger, auditConfig AuditHistoryConfig) *repositorypkg.PostgreSQLRepository {
+func NewQueryRepository(db database.Queryer, redisClient *redis.Client, logger pkglogger.Logger, auditConfig AuditHistoryConfig) *repositorypkg.PostgreSQLRepository {
 	return repositorypkg.NewPostgreSQLRepository(db, redisClient, logger, auditConfig)
 }
 
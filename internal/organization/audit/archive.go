@@ -0,0 +1,386 @@
+package audit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// MinRetentionDays 审计保留期下限（天）
+const MinRetentionDays = 30
+
+const defaultArchiveBatchSize = 5000
+
+var (
+	// ErrInvalidRetention 保留天数低于下限
+	ErrInvalidRetention = fmt.Errorf("retention days must be at least %d", MinRetentionDays)
+	// ErrArchiveStoreMissing 未配置归档存储
+	ErrArchiveStoreMissing = errors.New("audit archive store not configured")
+)
+
+// RetentionPolicy 租户审计保留策略
+type RetentionPolicy struct {
+	TenantID      uuid.UUID `json:"tenantId"`
+	RetentionDays int       `json:"retentionDays"`
+	Enabled       bool      `json:"enabled"`
+	UpdatedBy     string    `json:"updatedBy,omitempty"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// ArchiveFile 已归档的审计文件元数据
+type ArchiveFile struct {
+	ID             uuid.UUID `json:"id"`
+	TenantID       uuid.UUID `json:"tenantId"`
+	StorageBackend string    `json:"storageBackend"`
+	StorageKey     string    `json:"storageKey"`
+	RecordCount    int       `json:"recordCount"`
+	FromTS         time.Time `json:"from"`
+	ToTS           time.Time `json:"to"`
+	FirstSeq       *int64    `json:"firstSeq,omitempty"`
+	LastSeq        *int64    `json:"lastSeq,omitempty"`
+	LastHash       string    `json:"lastHash,omitempty"`
+	SHA256         string    `json:"sha256"`
+	SizeBytes      int64     `json:"sizeBytes"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// ArchiveRunResult 单租户归档结果
+type ArchiveRunResult struct {
+	TenantID uuid.UUID `json:"tenantId"`
+	Cutoff   time.Time `json:"cutoff"`
+	Archives int       `json:"archives"`
+	Records  int       `json:"records"`
+}
+
+// ArchiveService 审计保留策略、归档与导出
+type ArchiveService struct {
+	db        *sql.DB
+	store     ArchiveStore
+	logger    pkglogger.Logger
+	batchSize int
+	now       func() time.Time
+}
+
+// NewArchiveService 创建归档服务；store 为 nil 时仅支持在线记录导出与策略管理。
+func NewArchiveService(db *sql.DB, store ArchiveStore, baseLogger pkglogger.Logger) *ArchiveService {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &ArchiveService{
+		db:        db,
+		store:     store,
+		batchSize: defaultArchiveBatchSize,
+		now:       time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "auditArchive",
+			"module":    "command",
+		}),
+	}
+}
+
+// GetPolicy 读取租户保留策略；未配置时返回 nil
+func (s *ArchiveService) GetPolicy(ctx context.Context, tenantID uuid.UUID) (*RetentionPolicy, error) {
+	var p RetentionPolicy
+	var updatedBy sql.NullString
+	err := s.db.QueryRowContext(ctx, `
+	SELECT tenant_id, retention_days, enabled, updated_by, updated_at
+	FROM audit_retention_policies WHERE tenant_id = $1`, tenantID,
+	).Scan(&p.TenantID, &p.RetentionDays, &p.Enabled, &updatedBy, &p.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load audit retention policy: %w", err)
+	}
+	p.UpdatedBy = updatedBy.String
+	p.UpdatedAt = p.UpdatedAt.UTC()
+	return &p, nil
+}
+
+// UpsertPolicy 创建或更新租户保留策略
+func (s *ArchiveService) UpsertPolicy(ctx context.Context, policy RetentionPolicy) (*RetentionPolicy, error) {
+	if policy.RetentionDays < MinRetentionDays {
+		return nil, ErrInvalidRetention
+	}
+	policy.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO audit_retention_policies (tenant_id, retention_days, enabled, updated_by, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (tenant_id) DO UPDATE SET
+		retention_days = EXCLUDED.retention_days,
+		enabled = EXCLUDED.enabled,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = EXCLUDED.updated_at`,
+		policy.TenantID, policy.RetentionDays, policy.Enabled, policy.UpdatedBy, policy.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("upsert audit retention policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// ListArchives 按时间倒序列出租户归档文件
+func (s *ArchiveService) ListArchives(ctx context.Context, tenantID uuid.UUID, limit int) ([]ArchiveFile, error) {
+	return s.queryArchives(ctx, `
+	WHERE tenant_id = $1
+	ORDER BY from_ts DESC
+	LIMIT $2`, tenantID, limit)
+}
+
+func (s *ArchiveService) overlappingArchives(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]ArchiveFile, error) {
+	return s.queryArchives(ctx, `
+	WHERE tenant_id = $1 AND to_ts >= $2 AND from_ts < $3
+	ORDER BY from_ts, created_at`, tenantID, from, to)
+}
+
+func (s *ArchiveService) queryArchives(ctx context.Context, where string, args ...interface{}) ([]ArchiveFile, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT id, tenant_id, storage_backend, storage_key, record_count, from_ts, to_ts,
+		first_seq, last_seq, COALESCE(last_hash, ''), sha256, size_bytes, created_at
+	FROM audit_archives`+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query audit archives: %w", err)
+	}
+	defer rows.Close()
+
+	var out []ArchiveFile
+	for rows.Next() {
+		var a ArchiveFile
+		var firstSeq, lastSeq sql.NullInt64
+		if err := rows.Scan(&a.ID, &a.TenantID, &a.StorageBackend, &a.StorageKey, &a.RecordCount, &a.FromTS, &a.ToTS,
+			&firstSeq, &lastSeq, &a.LastHash, &a.SHA256, &a.SizeBytes, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan audit archive: %w", err)
+		}
+		if firstSeq.Valid {
+			a.FirstSeq = &firstSeq.Int64
+		}
+		if lastSeq.Valid {
+			a.LastSeq = &lastSeq.Int64
+		}
+		a.FromTS, a.ToTS, a.CreatedAt = a.FromTS.UTC(), a.ToTS.UTC(), a.CreatedAt.UTC()
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+// RunRetention 对所有启用策略的租户执行归档
+func (s *ArchiveService) RunRetention(ctx context.Context) ([]ArchiveRunResult, error) {
+	if s.store == nil {
+		return nil, ErrArchiveStoreMissing
+	}
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT tenant_id, retention_days FROM audit_retention_policies
+	WHERE enabled = TRUE ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query audit retention policies: %w", err)
+	}
+	type policy struct {
+		tenantID uuid.UUID
+		days     int
+	}
+	var policies []policy
+	for rows.Next() {
+		var p policy
+		if err := rows.Scan(&p.tenantID, &p.days); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan audit retention policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]ArchiveRunResult, 0, len(policies))
+	for _, p := range policies {
+		result, err := s.ArchiveTenant(ctx, p.tenantID, p.days)
+		if err != nil {
+			return results, fmt.Errorf("archive tenant %s: %w", p.tenantID, err)
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// ArchiveTenant 将超过保留期的审计记录分批写入归档文件并从 audit_logs 移除。
+// 已建链记录只归档连续前缀（chain_seq 小于首条未过期记录），链基线随之前移以便后续校验。
+func (s *ArchiveService) ArchiveTenant(ctx context.Context, tenantID uuid.UUID, retentionDays int) (*ArchiveRunResult, error) {
+	if s.store == nil {
+		return nil, ErrArchiveStoreMissing
+	}
+	if retentionDays < MinRetentionDays {
+		return nil, ErrInvalidRetention
+	}
+	cutoff := s.now().UTC().AddDate(0, 0, -retentionDays)
+	result := &ArchiveRunResult{TenantID: tenantID, Cutoff: cutoff}
+
+	var boundary int64
+	if err := s.db.QueryRowContext(ctx, `
+	SELECT COALESCE(
+		(SELECT MIN(chain_seq) FROM audit_logs WHERE tenant_id = $1 AND chain_seq IS NOT NULL AND timestamp >= $2),
+		(SELECT last_seq + 1 FROM audit_chain_heads WHERE tenant_id = $1),
+		1)`, tenantID, cutoff,
+	).Scan(&boundary); err != nil {
+		return nil, fmt.Errorf("resolve audit chain boundary: %w", err)
+	}
+
+	for {
+		batch, err := s.loadArchiveBatch(ctx, tenantID, cutoff, boundary)
+		if err != nil {
+			return result, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		if err := s.archiveBatch(ctx, tenantID, batch); err != nil {
+			return result, err
+		}
+		result.Archives++
+		result.Records += len(batch)
+		if len(batch) < s.batchSize {
+			break
+		}
+	}
+
+	if result.Records > 0 {
+		s.logger.WithFields(pkglogger.Fields{
+			"tenantId": tenantID.String(),
+			"cutoff":   cutoff,
+			"archives": result.Archives,
+			"records":  result.Records,
+		}).Info("审计记录已归档")
+	}
+	return result, nil
+}
+
+func (s *ArchiveService) loadArchiveBatch(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, boundary int64) ([]*ExportRecord, error) {
+	rows, err := s.db.QueryContext(ctx, auditRecordSelect+`
+	WHERE tenant_id = $1
+	  AND ((chain_seq IS NULL AND timestamp < $2) OR chain_seq < $3)
+	ORDER BY COALESCE(chain_seq, 0), timestamp, id
+	LIMIT $4`, tenantID, cutoff, boundary, s.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("query audit archive batch: %w", err)
+	}
+	defer rows.Close()
+
+	var batch []*ExportRecord
+	for rows.Next() {
+		rec, err := scanExportRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan audit archive record: %w", err)
+		}
+		batch = append(batch, rec)
+	}
+	return batch, rows.Err()
+}
+
+func (s *ArchiveService) archiveBatch(ctx context.Context, tenantID uuid.UUID, batch []*ExportRecord) error {
+	archive := ArchiveFile{
+		ID:             uuid.New(),
+		TenantID:       tenantID,
+		StorageBackend: s.store.Backend(),
+		RecordCount:    len(batch),
+		FromTS:         batch[0].Timestamp,
+		ToTS:           batch[0].Timestamp,
+	}
+	archive.StorageKey = fmt.Sprintf("audit/%s/%s/%s.jsonl.gz", tenantID, archive.FromTS.Format("2006"), archive.ID)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(gz)
+	ids := make([]string, 0, len(batch))
+	for _, rec := range batch {
+		if err := enc.Encode(rec); err != nil {
+			return fmt.Errorf("encode audit archive record: %w", err)
+		}
+		ids = append(ids, rec.ID)
+		if rec.Timestamp.Before(archive.FromTS) {
+			archive.FromTS = rec.Timestamp
+		}
+		if rec.Timestamp.After(archive.ToTS) {
+			archive.ToTS = rec.Timestamp
+		}
+		if rec.ChainSeq != nil {
+			if archive.FirstSeq == nil {
+				archive.FirstSeq = rec.ChainSeq
+			}
+			archive.LastSeq = rec.ChainSeq
+			archive.LastHash = rec.RecordHash
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("compress audit archive: %w", err)
+	}
+	data := buf.Bytes()
+	archive.SHA256 = sha256Hex(data)
+	archive.SizeBytes = int64(len(data))
+
+	// 先落盘归档文件，再在同一事务中登记并删除在线记录；事务失败时仅遗留孤立文件
+	if err := s.store.Put(ctx, archive.StorageKey, data); err != nil {
+		return fmt.Errorf("store audit archive: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin audit archive tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var lastHash interface{}
+	if archive.LastHash != "" {
+		lastHash = archive.LastHash
+	}
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO audit_archives (id, tenant_id, storage_backend, storage_key, record_count,
+		from_ts, to_ts, first_seq, last_seq, last_hash, sha256, size_bytes)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		archive.ID, archive.TenantID, archive.StorageBackend, archive.StorageKey, archive.RecordCount,
+		archive.FromTS, archive.ToTS, archive.FirstSeq, archive.LastSeq, lastHash, archive.SHA256, archive.SizeBytes,
+	); err != nil {
+		return fmt.Errorf("insert audit archive: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`DELETE FROM audit_logs WHERE tenant_id = $1 AND id = ANY($2::uuid[])`,
+		tenantID, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("delete archived audit records: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected != int64(len(ids)) {
+		s.logger.WithFields(pkglogger.Fields{
+			"tenantId":   tenantID.String(),
+			"archiveId":  archive.ID.String(),
+			"expected":   len(ids),
+			"deleted":    affected,
+			"storageKey": archive.StorageKey,
+		}).Warn("归档删除行数不一致，回滚并保留归档文件")
+		return fmt.Errorf("archived %d audit records but deleted %d", len(ids), affected)
+	}
+
+	if archive.LastSeq != nil {
+		if _, err := tx.ExecContext(ctx, `
+		UPDATE audit_chain_heads SET base_seq = $2, base_hash = $3
+		WHERE tenant_id = $1 AND base_seq < $2`,
+			tenantID, *archive.LastSeq, archive.LastHash,
+		); err != nil {
+			return fmt.Errorf("advance audit chain base: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit audit archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 归档存储后端
+const (
+	ArchiveBackendLocal = "local"
+	ArchiveBackendS3    = "s3"
+)
+
+// ArchiveStore 审计归档文件存储（本地文件系统或 S3 兼容对象存储）
+type ArchiveStore interface {
+	Backend() string
+	Put(ctx context.Context, key string, data []byte) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// LoadArchiveStoreFromEnv 按 AUDIT_ARCHIVE_BACKEND（local|s3，默认 local）构建归档存储
+func LoadArchiveStoreFromEnv() (ArchiveStore, error) {
+	backend := strings.ToLower(strings.TrimSpace(os.Getenv("AUDIT_ARCHIVE_BACKEND")))
+	switch backend {
+	case "", ArchiveBackendLocal:
+		dir := strings.TrimSpace(os.Getenv("AUDIT_ARCHIVE_DIR"))
+		if dir == "" {
+			dir = "./data/audit-archive"
+		}
+		return NewLocalArchiveStore(dir), nil
+	case ArchiveBackendS3:
+		return NewS3ArchiveStore(S3ArchiveConfig{
+			Endpoint:        os.Getenv("AUDIT_ARCHIVE_S3_ENDPOINT"),
+			Bucket:          os.Getenv("AUDIT_ARCHIVE_S3_BUCKET"),
+			Region:          os.Getenv("AUDIT_ARCHIVE_S3_REGION"),
+			AccessKeyID:     os.Getenv("AUDIT_ARCHIVE_S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AUDIT_ARCHIVE_S3_SECRET_ACCESS_KEY"),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported AUDIT_ARCHIVE_BACKEND: %s", backend)
+	}
+}
+
+// LocalArchiveStore 本地文件系统归档（开发环境及对象存储不可用时的替代）
+type LocalArchiveStore struct {
+	root string
+}
+
+func NewLocalArchiveStore(root string) *LocalArchiveStore {
+	return &LocalArchiveStore{root: root}
+}
+
+func (s *LocalArchiveStore) Backend() string { return ArchiveBackendLocal }
+
+func (s *LocalArchiveStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create archive dir: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("write archive file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("finalize archive file: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalArchiveStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (s *LocalArchiveStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if strings.Contains(key, "..") || clean == "/" {
+		return "", fmt.Errorf("invalid archive key: %s", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
+
+// S3ArchiveConfig S3 兼容存储配置（路径风格寻址，兼容 MinIO/Ceph 等）
+type S3ArchiveConfig struct {
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	HTTPClient      *http.Client
+}
+
+// S3ArchiveStore 使用 AWS Signature V4 直接访问 S3 兼容对象存储
+type S3ArchiveStore struct {
+	cfg    S3ArchiveConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3ArchiveStore(cfg S3ArchiveConfig) (*S3ArchiveStore, error) {
+	cfg.Endpoint = strings.TrimRight(strings.TrimSpace(cfg.Endpoint), "/")
+	if cfg.Endpoint == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 archive store requires endpoint, bucket and credentials")
+	}
+	if _, err := url.Parse(cfg.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &S3ArchiveStore{cfg: cfg, client: client, now: time.Now}, nil
+}
+
+func (s *S3ArchiveStore) Backend() string { return ArchiveBackendS3 }
+
+func (s *S3ArchiveStore) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 put %s: status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func (s *S3ArchiveStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("s3 get %s: status %d", key, resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+func (s *S3ArchiveStore) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	segments := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+	target := s.cfg.Endpoint + "/" + url.PathEscape(s.cfg.Bucket) + "/" + strings.Join(segments, "/")
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s: %w", method, key, err)
+	}
+	return resp, nil
+}
+
+// sign 按 AWS Signature V4 签名请求（无查询参数）
+func (s *S3ArchiveStore) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		"host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := day + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,215 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func TestLocalArchiveStoreRoundTrip(t *testing.T) {
+	store := NewLocalArchiveStore(t.TempDir())
+	ctx := context.Background()
+	if err := store.Put(ctx, "audit/t1/2025/a.jsonl.gz", []byte("payload")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	rc, err := store.Open(ctx, "audit/t1/2025/a.jsonl.gz")
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	defer rc.Close()
+	data, _ := io.ReadAll(rc)
+	if string(data) != "payload" {
+		t.Fatalf("unexpected archive content %q", data)
+	}
+	if err := store.Put(ctx, "../escape", []byte("x")); err == nil {
+		t.Fatalf("expected key with .. to be rejected")
+	}
+}
+
+func TestS3ArchiveStoreSignsRequests(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+		auths   []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		auths = append(auths, r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		if got := r.Header.Get("x-amz-content-sha256"); got != sha256Hex(body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		}
+	}))
+	defer server.Close()
+
+	store, err := NewS3ArchiveStore(S3ArchiveConfig{
+		Endpoint: server.URL, Bucket: "audit-bucket", Region: "cn-north-1",
+		AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewS3ArchiveStore: %v", err)
+	}
+	store.now = func() time.Time { return time.Date(2025, 11, 23, 9, 0, 0, 0, time.UTC) }
+
+	ctx := context.Background()
+	if err := store.Put(ctx, "audit/t1/2025/a.jsonl.gz", []byte("payload")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	rc, err := store.Open(ctx, "audit/t1/2025/a.jsonl.gz")
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "payload" {
+		t.Fatalf("unexpected object content %q", data)
+	}
+	if _, ok := objects["/audit-bucket/audit/t1/2025/a.jsonl.gz"]; !ok {
+		t.Fatalf("expected path-style object key, got %v", objects)
+	}
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20251123/cn-north-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="
+	for _, auth := range auths {
+		if !strings.HasPrefix(auth, want) || len(auth) != len(want)+64 {
+			t.Fatalf("unexpected Authorization header %q", auth)
+		}
+	}
+	if auths[0] == auths[1] {
+		t.Fatalf("PUT and GET must carry different signatures")
+	}
+}
+
+func TestArchiveTenantMovesChainPrefixAndExports(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock new: %v", err)
+	}
+	defer db.Close()
+
+	f := newChainFixture(t, 2)
+	store := NewLocalArchiveStore(t.TempDir())
+	svc := NewArchiveService(db, store, nil)
+	svc.now = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) }
+
+	var storageKey, archiveHash string
+	mock.ExpectQuery("SELECT COALESCE").WithArgs(f.tenantID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"boundary"}).AddRow(3))
+	mock.ExpectQuery("FROM audit_logs").WithArgs(f.tenantID, sqlmock.AnyArg(), int64(3), defaultArchiveBatchSize).
+		WillReturnRows(f.rows())
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO audit_archives").
+		WithArgs(sqlmock.AnyArg(), f.tenantID, ArchiveBackendLocal, captureArg{&storageKey}, 2,
+			f.cols[0].Timestamp, f.cols[1].Timestamp, sqlmock.AnyArg(), sqlmock.AnyArg(), f.hashes[1], captureArg{&archiveHash}, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM audit_logs").WithArgs(f.tenantID, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE audit_chain_heads SET base_seq").WithArgs(f.tenantID, int64(2), f.hashes[1]).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	result, err := svc.ArchiveTenant(context.Background(), f.tenantID, 90)
+	if err != nil {
+		t.Fatalf("ArchiveTenant returned error: %v", err)
+	}
+	if result.Archives != 1 || result.Records != 2 {
+		t.Fatalf("unexpected archive result: %+v", result)
+	}
+
+	rc, err := store.Open(context.Background(), storageKey)
+	if err != nil {
+		t.Fatalf("archive file missing: %v", err)
+	}
+	raw, _ := io.ReadAll(rc)
+	rc.Close()
+	if sha256Hex(raw) != archiveHash {
+		t.Fatalf("archive sha256 mismatch")
+	}
+
+	// 导出：归档文件内容按过滤条件输出，且记录仍可复算哈希链
+	archiveCols := []string{"id", "tenant_id", "storage_backend", "storage_key", "record_count", "from_ts", "to_ts",
+		"first_seq", "last_seq", "last_hash", "sha256", "size_bytes", "created_at"}
+	mock.ExpectQuery("FROM audit_archives").WillReturnRows(sqlmock.NewRows(archiveCols).AddRow(
+		uuid.New(), f.tenantID, ArchiveBackendLocal, storageKey, 2, f.cols[0].Timestamp, f.cols[1].Timestamp,
+		int64(1), int64(2), f.hashes[1], archiveHash, int64(len(raw)), time.Now()))
+	mock.ExpectQuery("FROM audit_logs").WillReturnRows(sqlmock.NewRows(chainRowColumns))
+
+	var buf bytes.Buffer
+	writer, _ := NewExportWriter(&buf, ExportFormatJSONL)
+	prev := chainGenesisHash
+	count, err := svc.Export(context.Background(), ExportFilter{
+		TenantID:        f.tenantID,
+		From:            f.cols[0].Timestamp,
+		To:              f.cols[1].Timestamp.Add(time.Second),
+		ResourceType:    ResourceTypeOrganization,
+		IncludeArchived: true,
+	}, func(rec *ExportRecord) error {
+		chainRec, err := newChainRecord(*rec.ChainSeq, rec.chainColumns())
+		if err != nil {
+			return err
+		}
+		hash, err := chainRec.hash(prev)
+		if err != nil || hash != rec.RecordHash {
+			t.Fatalf("archived record %d no longer matches its chain hash", *rec.ChainSeq)
+		}
+		prev = hash
+		return writer.Write(rec)
+	})
+	if err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	_ = writer.Flush()
+	if count != 2 || strings.Count(buf.String(), "\n") != 2 {
+		t.Fatalf("expected 2 exported lines, got %d: %s", count, buf.String())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("sqlmock expectations not met: %v", err)
+	}
+}
+
+func TestChainVerifyContinuesFromArchiveBase(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock new: %v", err)
+	}
+	defer db.Close()
+
+	f := newChainFixture(t, 3)
+	baseHash := f.hashes[0]
+	f.seqs, f.prevs, f.cols, f.hashes = f.seqs[1:], f.prevs[1:], f.cols[1:], f.hashes[1:]
+	expectVerifyFromBase(mock, f, nil, 3, f.hashes[1], 1, baseHash)
+
+	report, err := NewChainService(db, nil, nil).Verify(context.Background(), f.tenantID)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if !report.Valid || report.RecordsChecked != 2 || report.HeadSeq != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestUpsertPolicyEnforcesMinimumRetention(t *testing.T) {
+	svc := NewArchiveService(nil, nil, nil)
+	if _, err := svc.UpsertPolicy(context.Background(), RetentionPolicy{TenantID: uuid.New(), RetentionDays: 7}); err != ErrInvalidRetention {
+		t.Fatalf("expected ErrInvalidRetention, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChainHashVersion 当前哈希链规范化版本（变更规范化规则时递增）
+const ChainHashVersion = 1
+
+// chainGenesisHash 每个租户链的起始 prev_hash
+var chainGenesisHash = strings.Repeat("0", 64)
+
+// chainRecord 参与哈希计算的规范化审计记录。
+// 字段顺序固定；JSON 列统一解码后重新编码，使写入端与从 jsonb 读回的结果一致。
+type chainRecord struct {
+	Version         int             `json:"v"`
+	Seq             int64           `json:"seq"`
+	ID              string          `json:"id"`
+	TenantID        string          `json:"tenantId"`
+	EventType       string          `json:"eventType"`
+	ResourceType    string          `json:"resourceType"`
+	ResourceID      string          `json:"resourceId"`
+	ActorID         string          `json:"actorId"`
+	ActorType       string          `json:"actorType"`
+	ActionName      string          `json:"actionName"`
+	RequestID       string          `json:"requestId"`
+	OperationReason string          `json:"operationReason"`
+	Timestamp       string          `json:"timestamp"`
+	Success         bool            `json:"success"`
+	ErrorCode       string          `json:"errorCode"`
+	ErrorMessage    string          `json:"errorMessage"`
+	RequestData     json.RawMessage `json:"requestData"`
+	ResponseData    json.RawMessage `json:"responseData"`
+	ModifiedFields  json.RawMessage `json:"modifiedFields"`
+	Changes         json.RawMessage `json:"changes"`
+	RecordID        string          `json:"recordId"`
+	BusinessContext json.RawMessage `json:"businessContext"`
+}
+
+// chainColumns audit_logs 中参与哈希的原始列值（写入参数或数据库读回值）
+type chainColumns struct {
+	ID, TenantID, EventType, ResourceType, ResourceID   string
+	ActorID, ActorType, ActionName, RequestID, OpReason string
+	Timestamp                                           time.Time
+	Success                                             bool
+	ErrorCode, ErrorMessage                             string
+	RequestData, ResponseData, ModifiedFields, Changes  string
+	RecordID, BusinessContext                           string
+}
+
+func newChainRecord(seq int64, cols chainColumns) (*chainRecord, error) {
+	rec := &chainRecord{
+		Version:         ChainHashVersion,
+		Seq:             seq,
+		ID:              strings.ToLower(cols.ID),
+		TenantID:        strings.ToLower(cols.TenantID),
+		EventType:       cols.EventType,
+		ResourceType:    cols.ResourceType,
+		ResourceID:      cols.ResourceID,
+		ActorID:         cols.ActorID,
+		ActorType:       cols.ActorType,
+		ActionName:      cols.ActionName,
+		RequestID:       cols.RequestID,
+		OperationReason: cols.OpReason,
+		Timestamp:       chainTimestamp(cols.Timestamp),
+		Success:         cols.Success,
+		ErrorCode:       cols.ErrorCode,
+		ErrorMessage:    cols.ErrorMessage,
+		RecordID:        strings.ToLower(cols.RecordID),
+	}
+	var err error
+	if rec.RequestData, err = canonicalJSON(cols.RequestData, "{}"); err != nil {
+		return nil, fmt.Errorf("canonicalize request_data: %w", err)
+	}
+	if rec.ResponseData, err = canonicalJSON(cols.ResponseData, "{}"); err != nil {
+		return nil, fmt.Errorf("canonicalize response_data: %w", err)
+	}
+	if rec.ModifiedFields, err = canonicalJSON(cols.ModifiedFields, "[]"); err != nil {
+		return nil, fmt.Errorf("canonicalize modified_fields: %w", err)
+	}
+	if rec.Changes, err = canonicalJSON(cols.Changes, "[]"); err != nil {
+		return nil, fmt.Errorf("canonicalize changes: %w", err)
+	}
+	if rec.BusinessContext, err = canonicalJSON(cols.BusinessContext, "{}"); err != nil {
+		return nil, fmt.Errorf("canonicalize business_context: %w", err)
+	}
+	return rec, nil
+}
+
+// hash 计算 sha256(prev_hash || canonical(record))，返回十六进制串
+func (c *chainRecord) hash(prevHash string) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.New()
+	sum.Write([]byte(prevHash))
+	sum.Write(payload)
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// canonicalJSON 解码后重新编码（对象键排序、数字/转义统一），屏蔽 jsonb 存储带来的格式差异
+func canonicalJSON(raw, empty string) (json.RawMessage, error) {
+	if strings.TrimSpace(raw) == "" {
+		raw = empty
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// chainTimestamp 与 timestamptz 精度对齐（微秒，UTC）
+func chainTimestamp(t time.Time) string {
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// chainLink 新记录在租户链中的位置
+type chainLink struct {
+	Seq      int64
+	PrevHash string
+	Hash     string
+}
+
+// lockChainHead 锁定租户链头并返回下一条记录的位置。
+// 链头行锁持有到事务结束，同租户审计写入因此串行化。
+func lockChainHead(ctx context.Context, exec dbExecutor, tenantID uuid.UUID) (int64, string, error) {
+	if _, err := exec.ExecContext(ctx,
+		`INSERT INTO audit_chain_heads (tenant_id, last_seq, last_hash) VALUES ($1, 0, $2) ON CONFLICT (tenant_id) DO NOTHING`,
+		tenantID, chainGenesisHash,
+	); err != nil {
+		return 0, "", fmt.Errorf("init audit chain head: %w", err)
+	}
+	var (
+		lastSeq  int64
+		lastHash string
+	)
+	if err := exec.QueryRowContext(ctx,
+		`SELECT last_seq, last_hash FROM audit_chain_heads WHERE tenant_id = $1 FOR UPDATE`,
+		tenantID,
+	).Scan(&lastSeq, &lastHash); err != nil {
+		return 0, "", fmt.Errorf("lock audit chain head: %w", err)
+	}
+	return lastSeq + 1, strings.TrimSpace(lastHash), nil
+}
+
+func advanceChainHead(ctx context.Context, exec dbExecutor, tenantID uuid.UUID, link chainLink) error {
+	if _, err := exec.ExecContext(ctx,
+		`UPDATE audit_chain_heads SET last_seq = $2, last_hash = $3, updated_at = NOW() WHERE tenant_id = $1`,
+		tenantID, link.Seq, link.Hash,
+	); err != nil {
+		return fmt.Errorf("advance audit chain head: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// 哈希链断裂原因
+const (
+	ChainBreakSequenceGap        = "SEQUENCE_GAP"
+	ChainBreakPrevHashMismatch   = "PREV_HASH_MISMATCH"
+	ChainBreakHashMismatch       = "HASH_MISMATCH"
+	ChainBreakUnsupportedVersion = "UNSUPPORTED_HASH_VERSION"
+	ChainBreakHeadMismatch       = "HEAD_MISMATCH"
+	ChainBreakCheckpointMismatch = "CHECKPOINT_MISMATCH"
+	ChainBreakCheckpointInvalid  = "CHECKPOINT_SIGNATURE_INVALID"
+)
+
+// ErrChainBroken 链校验未通过（拒绝为断裂的链签发检查点）
+var ErrChainBroken = errors.New("audit hash chain broken")
+
+// ChainBreak 第一个断裂的链节点
+type ChainBreak struct {
+	Seq      int64  `json:"seq"`
+	RecordID string `json:"recordId,omitempty"`
+	Reason   string `json:"reason"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// ChainVerification 租户审计链校验报告
+type ChainVerification struct {
+	TenantID           uuid.UUID   `json:"tenantId"`
+	Valid              bool        `json:"valid"`
+	RecordsChecked     int64       `json:"recordsChecked"`
+	UnchainedRecords   int64       `json:"unchainedRecords"`
+	HeadSeq            int64       `json:"headSeq"`
+	HeadHash           string      `json:"headHash,omitempty"`
+	CheckpointsChecked int         `json:"checkpointsChecked"`
+	SignaturesVerified bool        `json:"signaturesVerified"`
+	FirstBroken        *ChainBreak `json:"firstBroken,omitempty"`
+	VerifiedAt         time.Time   `json:"verifiedAt"`
+}
+
+// ChainService 审计哈希链校验与检查点锚定
+type ChainService struct {
+	db     *sql.DB
+	signer *CheckpointSigner
+	logger pkglogger.Logger
+}
+
+// NewChainService 创建哈希链服务；signer 为 nil 时仅校验链本身，无法签发或验签检查点。
+func NewChainService(db *sql.DB, signer *CheckpointSigner, baseLogger pkglogger.Logger) *ChainService {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &ChainService{
+		db:     db,
+		signer: signer,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "auditChain",
+			"module":    "command",
+		}),
+	}
+}
+
+// SignerConfigured 是否配置了检查点签名密钥
+func (s *ChainService) SignerConfigured() bool {
+	return s.signer != nil
+}
+
+// Verify 按 chain_seq 顺序复算租户链，返回第一个断裂位置
+func (s *ChainService) Verify(ctx context.Context, tenantID uuid.UUID) (*ChainVerification, error) {
+	report := &ChainVerification{
+		TenantID:           tenantID,
+		Valid:              true,
+		SignaturesVerified: s.signer != nil,
+		VerifiedAt:         time.Now().UTC(),
+	}
+
+	// 链头记录归档基线：base_seq 之前的记录已移入归档文件，从 base_hash 继续复算
+	var (
+		headSeq, baseSeq   int64
+		headHash, baseHash string
+		headFound          = true
+	)
+	err := s.db.QueryRowContext(ctx,
+		`SELECT last_seq, last_hash, base_seq, COALESCE(base_hash, '') FROM audit_chain_heads WHERE tenant_id = $1`, tenantID,
+	).Scan(&headSeq, &headHash, &baseSeq, &baseHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		headFound = false
+	} else if err != nil {
+		return nil, fmt.Errorf("load audit chain head: %w", err)
+	}
+	headHash, baseHash = strings.TrimSpace(headHash), strings.TrimSpace(baseHash)
+
+	checkpoints, err := s.ListCheckpoints(ctx, tenantID, 0)
+	if err != nil {
+		return nil, err
+	}
+	bySeq := make(map[int64]*ChainCheckpoint, len(checkpoints))
+	for i := range checkpoints {
+		if checkpoints[i].ChainSeq > baseSeq {
+			bySeq[checkpoints[i].ChainSeq] = &checkpoints[i]
+		}
+	}
+
+	if err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM audit_logs WHERE tenant_id = $1 AND chain_seq IS NULL`, tenantID,
+	).Scan(&report.UnchainedRecords); err != nil {
+		return nil, fmt.Errorf("count unchained audit records: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, auditRecordSelect+`
+	WHERE tenant_id = $1 AND chain_seq IS NOT NULL
+	ORDER BY chain_seq`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query audit chain: %w", err)
+	}
+	defer rows.Close()
+
+	expectedSeq := baseSeq + 1
+	prev := chainGenesisHash
+	if baseSeq > 0 {
+		prev = baseHash
+	}
+	for rows.Next() {
+		rec, err := scanExportRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan audit chain record: %w", err)
+		}
+		report.RecordsChecked++
+
+		seq := *rec.ChainSeq
+		if brk := s.checkLink(rec.chainColumns(), seq, expectedSeq, rec.HashVersion, prev, rec.PrevHash, rec.RecordHash, bySeq[seq]); brk != nil {
+			report.CheckpointsChecked = countCheckpoints(bySeq, expectedSeq-1)
+			return report.broken(brk), nil
+		}
+		prev = rec.RecordHash
+		expectedSeq++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit chain: %w", err)
+	}
+	lastSeq := expectedSeq - 1
+	report.HeadSeq = lastSeq
+	if lastSeq > 0 {
+		report.HeadHash = prev
+	}
+	report.CheckpointsChecked = countCheckpoints(bySeq, lastSeq)
+
+	// 链尾被截断：链头或已签名检查点指向不存在的记录
+	if headFound && (headSeq != lastSeq || (lastSeq > 0 && headHash != prev)) {
+		return report.broken(&ChainBreak{
+			Seq:      minInt64(headSeq, lastSeq) + 1,
+			Reason:   ChainBreakHeadMismatch,
+			Expected: fmt.Sprintf("%d:%s", headSeq, headHash),
+			Actual:   fmt.Sprintf("%d:%s", lastSeq, prev),
+		}), nil
+	}
+	for _, cp := range bySeq {
+		if cp.ChainSeq > lastSeq {
+			return report.broken(&ChainBreak{
+				Seq:      lastSeq + 1,
+				Reason:   ChainBreakCheckpointMismatch,
+				Expected: fmt.Sprintf("%d:%s", cp.ChainSeq, cp.RecordHash),
+				Actual:   "missing",
+			}), nil
+		}
+	}
+	return report, nil
+}
+
+func (s *ChainService) checkLink(cols chainColumns, seq, expectedSeq int64, version int, prev, prevHash, recordHash string, cp *ChainCheckpoint) *ChainBreak {
+	brk := &ChainBreak{Seq: seq, RecordID: cols.ID}
+	switch {
+	case seq != expectedSeq:
+		brk.Seq, brk.RecordID = expectedSeq, ""
+		brk.Reason = ChainBreakSequenceGap
+		brk.Expected, brk.Actual = fmt.Sprint(expectedSeq), fmt.Sprint(seq)
+		return brk
+	case version != ChainHashVersion:
+		brk.Reason = ChainBreakUnsupportedVersion
+		brk.Expected, brk.Actual = fmt.Sprint(ChainHashVersion), fmt.Sprint(version)
+		return brk
+	case prevHash != prev:
+		brk.Reason = ChainBreakPrevHashMismatch
+		brk.Expected, brk.Actual = prev, prevHash
+		return brk
+	}
+	rec, err := newChainRecord(seq, cols)
+	var computed string
+	if err == nil {
+		computed, err = rec.hash(prev)
+	}
+	if err != nil || computed != recordHash {
+		brk.Reason = ChainBreakHashMismatch
+		brk.Expected, brk.Actual = computed, recordHash
+		return brk
+	}
+	if cp != nil {
+		if s.signer != nil && !s.signer.verify(cp) {
+			brk.Reason = ChainBreakCheckpointInvalid
+			brk.Expected, brk.Actual = cp.KeyID, cp.Signature
+			return brk
+		}
+		if cp.RecordHash != recordHash {
+			brk.Reason = ChainBreakCheckpointMismatch
+			brk.Expected, brk.Actual = cp.RecordHash, recordHash
+			return brk
+		}
+	}
+	return nil
+}
+
+func (r *ChainVerification) broken(brk *ChainBreak) *ChainVerification {
+	r.Valid = false
+	r.FirstBroken = brk
+	return r
+}
+
+// VerifyAllTenants 校验所有已建链租户
+func (s *ChainService) VerifyAllTenants(ctx context.Context) ([]*ChainVerification, error) {
+	tenants, err := s.chainTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*ChainVerification, 0, len(tenants))
+	for _, tenantID := range tenants {
+		report, err := s.Verify(ctx, tenantID)
+		if err != nil {
+			return reports, fmt.Errorf("verify tenant %s: %w", tenantID, err)
+		}
+		if !report.Valid {
+			s.logger.WithFields(pkglogger.Fields{
+				"tenantId": tenantID.String(),
+				"seq":      report.FirstBroken.Seq,
+				"reason":   report.FirstBroken.Reason,
+				"recordId": report.FirstBroken.RecordID,
+			}).Error("审计哈希链校验失败")
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// CreateCheckpoint 校验链后对当前链尾签发检查点；链为空或链尾已有检查点时 created=false
+func (s *ChainService) CreateCheckpoint(ctx context.Context, tenantID uuid.UUID) (*ChainCheckpoint, bool, *ChainVerification, error) {
+	if s.signer == nil {
+		return nil, false, nil, ErrCheckpointSignerMissing
+	}
+	report, err := s.Verify(ctx, tenantID)
+	if err != nil {
+		return nil, false, nil, err
+	}
+	if !report.Valid {
+		return nil, false, report, ErrChainBroken
+	}
+	if report.HeadSeq == 0 {
+		return nil, false, report, nil
+	}
+
+	cp := &ChainCheckpoint{
+		ID:         uuid.New(),
+		TenantID:   tenantID,
+		ChainSeq:   report.HeadSeq,
+		RecordHash: report.HeadHash,
+		CreatedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}
+	s.signer.sign(cp)
+	res, err := s.db.ExecContext(ctx, `
+	INSERT INTO audit_chain_checkpoints (id, tenant_id, chain_seq, record_hash, key_id, signature, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (tenant_id, chain_seq) DO NOTHING`,
+		cp.ID, cp.TenantID, cp.ChainSeq, cp.RecordHash, cp.KeyID, cp.Signature, cp.CreatedAt,
+	)
+	if err != nil {
+		return nil, false, report, fmt.Errorf("insert audit checkpoint: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return nil, false, report, nil
+	}
+	s.logger.WithFields(pkglogger.Fields{
+		"tenantId": tenantID.String(),
+		"seq":      cp.ChainSeq,
+		"keyId":    cp.KeyID,
+	}).Info("审计链检查点已签发")
+	return cp, true, report, nil
+}
+
+// CheckpointAllTenants 为所有已建链租户签发检查点，返回新增数量；任一租户链断裂时返回错误
+func (s *ChainService) CheckpointAllTenants(ctx context.Context) (int, error) {
+	if s.signer == nil {
+		return 0, ErrCheckpointSignerMissing
+	}
+	tenants, err := s.chainTenants(ctx)
+	if err != nil {
+		return 0, err
+	}
+	created := 0
+	var broken []string
+	for _, tenantID := range tenants {
+		_, ok, report, err := s.CreateCheckpoint(ctx, tenantID)
+		if errors.Is(err, ErrChainBroken) {
+			s.logger.WithFields(pkglogger.Fields{
+				"tenantId": tenantID.String(),
+				"seq":      report.FirstBroken.Seq,
+				"reason":   report.FirstBroken.Reason,
+			}).Error("审计哈希链断裂，跳过检查点签发")
+			broken = append(broken, tenantID.String())
+			continue
+		}
+		if err != nil {
+			return created, fmt.Errorf("checkpoint tenant %s: %w", tenantID, err)
+		}
+		if ok {
+			created++
+		}
+	}
+	if len(broken) > 0 {
+		return created, fmt.Errorf("%w: tenants %s", ErrChainBroken, strings.Join(broken, ","))
+	}
+	return created, nil
+}
+
+// ListCheckpoints 按 chain_seq 升序返回租户检查点；limit<=0 表示全部
+func (s *ChainService) ListCheckpoints(ctx context.Context, tenantID uuid.UUID, limit int) ([]ChainCheckpoint, error) {
+	query := `
+	SELECT id, tenant_id, chain_seq, record_hash, key_id, signature, created_at
+	FROM audit_chain_checkpoints
+	WHERE tenant_id = $1
+	ORDER BY chain_seq`
+	args := []interface{}{tenantID}
+	if limit > 0 {
+		// 取最新的 limit 条，再按升序返回
+		query = `
+	SELECT id, tenant_id, chain_seq, record_hash, key_id, signature, created_at
+	FROM (
+		SELECT * FROM audit_chain_checkpoints
+		WHERE tenant_id = $1
+		ORDER BY chain_seq DESC
+		LIMIT $2
+	) latest
+	ORDER BY chain_seq`
+		args = append(args, limit)
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query audit checkpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var out []ChainCheckpoint
+	for rows.Next() {
+		var cp ChainCheckpoint
+		if err := rows.Scan(&cp.ID, &cp.TenantID, &cp.ChainSeq, &cp.RecordHash, &cp.KeyID, &cp.Signature, &cp.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan audit checkpoint: %w", err)
+		}
+		cp.RecordHash = strings.TrimSpace(cp.RecordHash)
+		cp.CreatedAt = cp.CreatedAt.UTC()
+		out = append(out, cp)
+	}
+	return out, rows.Err()
+}
+
+func (s *ChainService) chainTenants(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT tenant_id FROM audit_chain_heads ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query audit chain tenants: %w", err)
+	}
+	defer rows.Close()
+	var tenants []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan audit chain tenant: %w", err)
+		}
+		tenants = append(tenants, id)
+	}
+	return tenants, rows.Err()
+}
+
+func countCheckpoints(bySeq map[int64]*ChainCheckpoint, upTo int64) int {
+	n := 0
+	for seq := range bySeq {
+		if seq <= upTo {
+			n++
+		}
+	}
+	return n
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
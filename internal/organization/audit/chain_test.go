@@ -0,0 +1,276 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+type captureArg struct {
+	value *string
+}
+
+func (c captureArg) Match(v driver.Value) bool {
+	*c.value = fmt.Sprint(v)
+	return true
+}
+
+var chainRowColumns = []string{
+	"id", "tenant_id", "event_type", "resource_type",
+	"resource_id", "actor_id", "actor_type",
+	"action_name", "request_id", "operation_reason",
+	"timestamp", "success", "error_code", "error_message",
+	"request_data", "response_data", "modified_fields", "changes",
+	"record_id", "business_context",
+	"chain_seq", "prev_hash", "record_hash", "hash_version",
+}
+
+type chainFixture struct {
+	tenantID uuid.UUID
+	seqs     []int64
+	prevs    []string
+	cols     []chainColumns
+	hashes   []string
+}
+
+// newChainFixture 构造 n 条合法链记录；JSON 列使用 jsonb 风格输出（键序/空白与写入端不同）
+func newChainFixture(t *testing.T, n int) *chainFixture {
+	t.Helper()
+	f := &chainFixture{tenantID: uuid.New()}
+	prev := chainGenesisHash
+	base := time.Date(2025, 11, 22, 9, 0, 0, 123456000, time.UTC)
+	for i := 0; i < n; i++ {
+		cols := chainColumns{
+			ID: uuid.NewString(), TenantID: f.tenantID.String(),
+			EventType: EventTypeUpdate, ResourceType: ResourceTypeOrganization, ResourceID: fmt.Sprintf("ORG%03d", i),
+			ActorID: "user-1", ActorType: ActorTypeUser, ActionName: "UpdateOrganization", RequestID: fmt.Sprintf("req-%d", i),
+			Timestamp: base.Add(time.Duration(i) * time.Second), Success: true,
+			RequestData: `{"name": "旧名称", "level": 2}`, ResponseData: `{"level": 2, "name": "新名称"}`,
+			ModifiedFields: `["name"]`, Changes: `[]`, BusinessContext: `{"payload": {"code": "ORG"}, "actorId": "user-1"}`,
+		}
+		rec, err := newChainRecord(int64(i+1), cols)
+		if err != nil {
+			t.Fatalf("newChainRecord: %v", err)
+		}
+		hash, err := rec.hash(prev)
+		if err != nil {
+			t.Fatalf("hash: %v", err)
+		}
+		f.seqs = append(f.seqs, int64(i+1))
+		f.prevs = append(f.prevs, prev)
+		f.cols = append(f.cols, cols)
+		f.hashes = append(f.hashes, hash)
+		prev = hash
+	}
+	return f
+}
+
+func (f *chainFixture) rows() *sqlmock.Rows {
+	rows := sqlmock.NewRows(chainRowColumns)
+	for i, c := range f.cols {
+		rows.AddRow(c.ID, c.TenantID, c.EventType, c.ResourceType, c.ResourceID, c.ActorID, c.ActorType,
+			c.ActionName, c.RequestID, c.OpReason, c.Timestamp, c.Success, c.ErrorCode, c.ErrorMessage,
+			c.RequestData, c.ResponseData, c.ModifiedFields, c.Changes, c.RecordID, c.BusinessContext,
+			f.seqs[i], f.prevs[i], f.hashes[i], ChainHashVersion)
+	}
+	return rows
+}
+
+func expectVerify(mock sqlmock.Sqlmock, f *chainFixture, checkpoints []ChainCheckpoint, headSeq int64, headHash string) {
+	expectVerifyFromBase(mock, f, checkpoints, headSeq, headHash, 0, "")
+}
+
+func expectVerifyFromBase(mock sqlmock.Sqlmock, f *chainFixture, checkpoints []ChainCheckpoint, headSeq int64, headHash string, baseSeq int64, baseHash string) {
+	mock.ExpectQuery("FROM audit_chain_heads").WithArgs(f.tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq", "last_hash", "base_seq", "base_hash"}).AddRow(headSeq, headHash, baseSeq, baseHash))
+	cpRows := sqlmock.NewRows([]string{"id", "tenant_id", "chain_seq", "record_hash", "key_id", "signature", "created_at"})
+	for _, cp := range checkpoints {
+		cpRows.AddRow(cp.ID, cp.TenantID, cp.ChainSeq, cp.RecordHash, cp.KeyID, cp.Signature, cp.CreatedAt)
+	}
+	mock.ExpectQuery("FROM audit_chain_checkpoints").WithArgs(f.tenantID).WillReturnRows(cpRows)
+	mock.ExpectQuery("SELECT COUNT").WithArgs(f.tenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery("FROM audit_logs").WithArgs(f.tenantID).WillReturnRows(f.rows())
+}
+
+func testSigner(t *testing.T) *CheckpointSigner {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i + 1)
+	}
+	signer, err := NewCheckpointSigner(seed)
+	if err != nil {
+		t.Fatalf("NewCheckpointSigner: %v", err)
+	}
+	return signer
+}
+
+func TestLogEventExtendsTenantChain(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock new: %v", err)
+	}
+	defer db.Close()
+
+	tenantID := uuid.New()
+	prevHash := fmt.Sprintf("%064x", 7)
+	var inserted, advanced string
+
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 7, prevHash)
+	args := make([]driver.Value, 0, 24)
+	for i := 0; i < 20; i++ {
+		args = append(args, sqlmock.AnyArg())
+	}
+	args = append(args, int64(8), prevHash, captureArg{&inserted}, ChainHashVersion)
+	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(args...).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE audit_chain_heads").WithArgs(tenantID, int64(8), captureArg{&advanced}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewAuditLogger(db, pkglogger.NewNoopLogger()).LogEvent(context.Background(), &AuditEvent{
+		TenantID:     tenantID,
+		EventType:    EventTypeAuth,
+		ResourceType: ResourceTypeUser,
+		ResourceID:   "user-9",
+		ActorID:      "user-9",
+		ActionName:   "LOGIN",
+		Timestamp:    time.Date(2025, 11, 22, 9, 0, 0, 123456789, time.UTC),
+		Success:      true,
+	})
+	if err != nil {
+		t.Fatalf("LogEvent returned error: %v", err)
+	}
+	if len(inserted) != 64 || inserted != advanced {
+		t.Fatalf("record hash %q must be 64 hex chars and become the new head %q", inserted, advanced)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("sqlmock expectations not met: %v", err)
+	}
+}
+
+func TestChainVerifyValidWithSignedCheckpoint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock new: %v", err)
+	}
+	defer db.Close()
+
+	signer := testSigner(t)
+	f := newChainFixture(t, 3)
+	cp := ChainCheckpoint{ID: uuid.New(), TenantID: f.tenantID, ChainSeq: 2, RecordHash: f.hashes[1], CreatedAt: time.Now().UTC().Truncate(time.Microsecond)}
+	signer.sign(&cp)
+	expectVerify(mock, f, []ChainCheckpoint{cp}, 3, f.hashes[2])
+
+	report, err := NewChainService(db, signer, nil).Verify(context.Background(), f.tenantID)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if !report.Valid || report.RecordsChecked != 3 || report.HeadSeq != 3 || report.CheckpointsChecked != 1 || report.UnchainedRecords != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("sqlmock expectations not met: %v", err)
+	}
+}
+
+func TestChainVerifyReportsFirstBrokenLink(t *testing.T) {
+	cases := []struct {
+		name   string
+		mutate func(f *chainFixture)
+		seq    int64
+		reason string
+	}{
+		{"edited content", func(f *chainFixture) { f.cols[1].ActionName = "DeleteOrganization" }, 2, ChainBreakHashMismatch},
+		{"edited json", func(f *chainFixture) { f.cols[0].ResponseData = `{"name": "新名称", "level": 3}` }, 1, ChainBreakHashMismatch},
+		{"deleted record", func(f *chainFixture) {
+			f.seqs = append(f.seqs[:1], f.seqs[2:]...)
+			f.prevs = append(f.prevs[:1], f.prevs[2:]...)
+			f.cols = append(f.cols[:1], f.cols[2:]...)
+			f.hashes = append(f.hashes[:1], f.hashes[2:]...)
+		}, 2, ChainBreakSequenceGap},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock new: %v", err)
+			}
+			defer db.Close()
+
+			f := newChainFixture(t, 3)
+			head := f.hashes[2]
+			tc.mutate(f)
+			expectVerify(mock, f, nil, 3, head)
+
+			report, err := NewChainService(db, nil, nil).Verify(context.Background(), f.tenantID)
+			if err != nil {
+				t.Fatalf("Verify returned error: %v", err)
+			}
+			if report.Valid || report.FirstBroken == nil {
+				t.Fatalf("expected broken chain, got %+v", report)
+			}
+			if report.FirstBroken.Seq != tc.seq || report.FirstBroken.Reason != tc.reason {
+				t.Fatalf("expected break at %d (%s), got %+v", tc.seq, tc.reason, report.FirstBroken)
+			}
+		})
+	}
+}
+
+func TestChainVerifyDetectsRewrittenChain(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock new: %v", err)
+	}
+	defer db.Close()
+
+	signer := testSigner(t)
+	original := newChainFixture(t, 2)
+	cp := ChainCheckpoint{ID: uuid.New(), TenantID: original.tenantID, ChainSeq: 2, RecordHash: original.hashes[1], CreatedAt: time.Now().UTC()}
+	signer.sign(&cp)
+
+	// 攻击者重写全部记录并重新计算哈希与链头，但无法重新签名检查点
+	rewritten := newChainFixture(t, 2)
+	rewritten.tenantID = original.tenantID
+	expectVerify(mock, rewritten, []ChainCheckpoint{cp}, 2, rewritten.hashes[1])
+
+	report, err := NewChainService(db, signer, nil).Verify(context.Background(), original.tenantID)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if report.Valid || report.FirstBroken.Seq != 2 || report.FirstBroken.Reason != ChainBreakCheckpointMismatch {
+		t.Fatalf("expected checkpoint mismatch at seq 2, got %+v", report.FirstBroken)
+	}
+}
+
+func TestCheckpointSignerRejectsForgery(t *testing.T) {
+	signer := testSigner(t)
+	cp := ChainCheckpoint{TenantID: uuid.New(), ChainSeq: 10, RecordHash: fmt.Sprintf("%064x", 10), CreatedAt: time.Now().UTC()}
+	signer.sign(&cp)
+	if !signer.verify(&cp) {
+		t.Fatalf("expected signature to verify")
+	}
+
+	tampered := cp
+	tampered.RecordHash = fmt.Sprintf("%064x", 11)
+	if signer.verify(&tampered) {
+		t.Fatalf("tampered checkpoint must not verify")
+	}
+
+	other, err := NewCheckpointSigner(make([]byte, ed25519.SeedSize))
+	if err != nil {
+		t.Fatalf("NewCheckpointSigner: %v", err)
+	}
+	forged := cp
+	other.sign(&forged)
+	if signer.verify(&forged) {
+		t.Fatalf("checkpoint signed by untrusted key must not verify")
+	}
+}
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrCheckpointSignerMissing 未配置检查点签名密钥
+var ErrCheckpointSignerMissing = errors.New("audit checkpoint signing key not configured")
+
+// ChainCheckpoint 对租户链某一位置的签名锚点
+type ChainCheckpoint struct {
+	ID         uuid.UUID `json:"id"`
+	TenantID   uuid.UUID `json:"tenantId"`
+	ChainSeq   int64     `json:"chainSeq"`
+	RecordHash string    `json:"recordHash"`
+	KeyID      string    `json:"keyId"`
+	Signature  string    `json:"signature"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// CheckpointSigner Ed25519 检查点签名器
+//
+// 当前密钥用于签名；trusted 额外保存轮换前的公钥，仅用于验证历史检查点。
+type CheckpointSigner struct {
+	keyID   string
+	private ed25519.PrivateKey
+	trusted map[string]ed25519.PublicKey
+}
+
+// NewCheckpointSigner 由 32 字节种子创建签名器
+func NewCheckpointSigner(seed []byte, trusted ...ed25519.PublicKey) (*CheckpointSigner, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("checkpoint signing key must be a %d-byte seed, got %d", ed25519.SeedSize, len(seed))
+	}
+	private := ed25519.NewKeyFromSeed(seed)
+	public := private.Public().(ed25519.PublicKey)
+	s := &CheckpointSigner{
+		keyID:   checkpointKeyID(public),
+		private: private,
+		trusted: map[string]ed25519.PublicKey{},
+	}
+	s.trusted[s.keyID] = public
+	for _, pub := range trusted {
+		if len(pub) == ed25519.PublicKeySize {
+			s.trusted[checkpointKeyID(pub)] = pub
+		}
+	}
+	return s, nil
+}
+
+// LoadCheckpointSignerFromEnv 读取 AUDIT_CHECKPOINT_SIGNING_KEY（base64 种子）与
+// AUDIT_CHECKPOINT_TRUSTED_KEYS（逗号分隔的 base64 公钥）；未配置时返回 nil。
+func LoadCheckpointSignerFromEnv() (*CheckpointSigner, error) {
+	raw := strings.TrimSpace(os.Getenv("AUDIT_CHECKPOINT_SIGNING_KEY"))
+	if raw == "" {
+		return nil, nil
+	}
+	seed, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode AUDIT_CHECKPOINT_SIGNING_KEY: %w", err)
+	}
+	var trusted []ed25519.PublicKey
+	for _, item := range strings.Split(os.Getenv("AUDIT_CHECKPOINT_TRUSTED_KEYS"), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		pub, err := base64.StdEncoding.DecodeString(item)
+		if err != nil || len(pub) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key in AUDIT_CHECKPOINT_TRUSTED_KEYS: %q", item)
+		}
+		trusted = append(trusted, pub)
+	}
+	return NewCheckpointSigner(seed, trusted...)
+}
+
+// KeyID 当前签名密钥标识
+func (s *CheckpointSigner) KeyID() string {
+	return s.keyID
+}
+
+func (s *CheckpointSigner) sign(cp *ChainCheckpoint) {
+	cp.KeyID = s.keyID
+	cp.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(s.private, checkpointMessage(cp)))
+}
+
+func (s *CheckpointSigner) verify(cp *ChainCheckpoint) bool {
+	pub, ok := s.trusted[cp.KeyID]
+	if !ok {
+		return false
+	}
+	sig, err := base64.StdEncoding.DecodeString(cp.Signature)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(pub, checkpointMessage(cp), sig)
+}
+
+func checkpointMessage(cp *ChainCheckpoint) []byte {
+	return []byte(fmt.Sprintf("cube-castle/audit-checkpoint/v1|%s|%d|%s|%s",
+		strings.ToLower(cp.TenantID.String()), cp.ChainSeq, cp.RecordHash, chainTimestamp(cp.CreatedAt)))
+}
+
+func checkpointKeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,299 @@
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 导出格式
+const (
+	ExportFormatJSONL = "jsonl"
+	ExportFormatCSV   = "csv"
+)
+
+// ExportRecord 审计导出/归档行格式（与 audit_logs 列一一对应，含哈希链字段以便归档后复核）
+type ExportRecord struct {
+	ID              string          `json:"id"`
+	TenantID        string          `json:"tenantId"`
+	EventType       string          `json:"eventType"`
+	ResourceType    string          `json:"resourceType"`
+	ResourceID      string          `json:"resourceId,omitempty"`
+	ActorID         string          `json:"actorId,omitempty"`
+	ActorType       string          `json:"actorType,omitempty"`
+	ActionName      string          `json:"actionName,omitempty"`
+	RequestID       string          `json:"requestId,omitempty"`
+	OperationReason string          `json:"operationReason,omitempty"`
+	Timestamp       time.Time       `json:"timestamp"`
+	Success         bool            `json:"success"`
+	ErrorCode       string          `json:"errorCode,omitempty"`
+	ErrorMessage    string          `json:"errorMessage,omitempty"`
+	RequestData     json.RawMessage `json:"requestData"`
+	ResponseData    json.RawMessage `json:"responseData"`
+	ModifiedFields  json.RawMessage `json:"modifiedFields"`
+	Changes         json.RawMessage `json:"changes"`
+	RecordID        string          `json:"recordId,omitempty"`
+	BusinessContext json.RawMessage `json:"businessContext"`
+	ChainSeq        *int64          `json:"chainSeq,omitempty"`
+	PrevHash        string          `json:"prevHash,omitempty"`
+	RecordHash      string          `json:"recordHash,omitempty"`
+	HashVersion     int             `json:"hashVersion,omitempty"`
+}
+
+// ExportFilter 审计导出过滤条件；From/To 为左闭右开区间
+type ExportFilter struct {
+	TenantID        uuid.UUID
+	From            time.Time
+	To              time.Time
+	ResourceType    string
+	ActorID         string
+	IncludeArchived bool
+}
+
+func (f ExportFilter) matches(rec *ExportRecord) bool {
+	if rec.Timestamp.Before(f.From) || !rec.Timestamp.Before(f.To) {
+		return false
+	}
+	if f.ResourceType != "" && rec.ResourceType != f.ResourceType {
+		return false
+	}
+	if f.ActorID != "" && rec.ActorID != f.ActorID {
+		return false
+	}
+	return true
+}
+
+// auditRecordSelect 导出、归档与链校验共用的列清单（顺序与 scanExportRecord 一致）
+const auditRecordSelect = `
+	SELECT
+		id::text, tenant_id::text, event_type, resource_type,
+		COALESCE(resource_id, ''), COALESCE(actor_id, ''), COALESCE(actor_type, ''),
+		COALESCE(action_name, ''), COALESCE(request_id, ''), COALESCE(operation_reason, ''),
+		timestamp, success, COALESCE(error_code, ''), COALESCE(error_message, ''),
+		COALESCE(request_data, '{}'::jsonb)::text,
+		COALESCE(response_data, '{}'::jsonb)::text,
+		COALESCE(modified_fields, '[]'::jsonb)::text,
+		COALESCE(changes, '[]'::jsonb)::text,
+		COALESCE(record_id::text, ''),
+		COALESCE(business_context, '{}'::jsonb)::text,
+		chain_seq, COALESCE(prev_hash, ''), COALESCE(record_hash, ''), COALESCE(hash_version, 0)
+	FROM audit_logs`
+
+func scanExportRecord(rows *sql.Rows) (*ExportRecord, error) {
+	var (
+		rec                                  ExportRecord
+		reqData, respData, modified, changes string
+		businessContext                      string
+		seq                                  sql.NullInt64
+	)
+	if err := rows.Scan(
+		&rec.ID, &rec.TenantID, &rec.EventType, &rec.ResourceType,
+		&rec.ResourceID, &rec.ActorID, &rec.ActorType,
+		&rec.ActionName, &rec.RequestID, &rec.OperationReason,
+		&rec.Timestamp, &rec.Success, &rec.ErrorCode, &rec.ErrorMessage,
+		&reqData, &respData, &modified, &changes,
+		&rec.RecordID, &businessContext,
+		&seq, &rec.PrevHash, &rec.RecordHash, &rec.HashVersion,
+	); err != nil {
+		return nil, err
+	}
+	rec.Timestamp = rec.Timestamp.UTC()
+	rec.RequestData = json.RawMessage(reqData)
+	rec.ResponseData = json.RawMessage(respData)
+	rec.ModifiedFields = json.RawMessage(modified)
+	rec.Changes = json.RawMessage(changes)
+	rec.BusinessContext = json.RawMessage(businessContext)
+	rec.PrevHash = strings.TrimSpace(rec.PrevHash)
+	rec.RecordHash = strings.TrimSpace(rec.RecordHash)
+	if seq.Valid {
+		v := seq.Int64
+		rec.ChainSeq = &v
+	}
+	return &rec, nil
+}
+
+// chainColumns 还原参与哈希计算的列值
+func (rec *ExportRecord) chainColumns() chainColumns {
+	return chainColumns{
+		ID: rec.ID, TenantID: rec.TenantID, EventType: rec.EventType, ResourceType: rec.ResourceType,
+		ResourceID: rec.ResourceID, ActorID: rec.ActorID, ActorType: rec.ActorType,
+		ActionName: rec.ActionName, RequestID: rec.RequestID, OpReason: rec.OperationReason,
+		Timestamp: rec.Timestamp, Success: rec.Success, ErrorCode: rec.ErrorCode, ErrorMessage: rec.ErrorMessage,
+		RequestData: string(rec.RequestData), ResponseData: string(rec.ResponseData),
+		ModifiedFields: string(rec.ModifiedFields), Changes: string(rec.Changes),
+		RecordID: rec.RecordID, BusinessContext: string(rec.BusinessContext),
+	}
+}
+
+// ExportWriter 导出流写入器
+type ExportWriter interface {
+	Write(rec *ExportRecord) error
+	Flush() error
+}
+
+// NewExportWriter 按格式创建写入器（jsonl|csv）
+func NewExportWriter(w io.Writer, format string) (ExportWriter, error) {
+	switch format {
+	case ExportFormatJSONL:
+		return &jsonlExportWriter{w: bufio.NewWriter(w)}, nil
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvExportHeader); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{w: cw}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+type jsonlExportWriter struct {
+	w *bufio.Writer
+}
+
+func (j *jsonlExportWriter) Write(rec *ExportRecord) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := j.w.Write(line); err != nil {
+		return err
+	}
+	return j.w.WriteByte('\n')
+}
+
+func (j *jsonlExportWriter) Flush() error { return j.w.Flush() }
+
+var csvExportHeader = []string{
+	"id", "tenantId", "eventType", "resourceType", "resourceId", "actorId", "actorType",
+	"actionName", "requestId", "operationReason", "timestamp", "success", "errorCode", "errorMessage",
+	"requestData", "responseData", "modifiedFields", "changes", "recordId", "businessContext",
+	"chainSeq", "prevHash", "recordHash",
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvExportWriter) Write(rec *ExportRecord) error {
+	seq := ""
+	if rec.ChainSeq != nil {
+		seq = strconv.FormatInt(*rec.ChainSeq, 10)
+	}
+	return c.w.Write([]string{
+		rec.ID, rec.TenantID, rec.EventType, rec.ResourceType, rec.ResourceID, rec.ActorID, rec.ActorType,
+		rec.ActionName, rec.RequestID, rec.OperationReason, rec.Timestamp.Format(time.RFC3339Nano),
+		strconv.FormatBool(rec.Success), rec.ErrorCode, rec.ErrorMessage,
+		string(rec.RequestData), string(rec.ResponseData), string(rec.ModifiedFields), string(rec.Changes),
+		rec.RecordID, string(rec.BusinessContext), seq, rec.PrevHash, rec.RecordHash,
+	})
+}
+
+func (c *csvExportWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// Export 按条件流式输出审计记录：先输出与时间范围重叠的归档文件内容，再输出在线记录。
+// 返回输出的记录数。
+func (s *ArchiveService) Export(ctx context.Context, filter ExportFilter, emit func(*ExportRecord) error) (int64, error) {
+	var count int64
+	if filter.IncludeArchived {
+		archives, err := s.overlappingArchives(ctx, filter.TenantID, filter.From, filter.To)
+		if err != nil {
+			return 0, err
+		}
+		if len(archives) > 0 && s.store == nil {
+			return 0, ErrArchiveStoreMissing
+		}
+		for _, archive := range archives {
+			n, err := s.exportArchive(ctx, archive, filter, emit)
+			count += n
+			if err != nil {
+				return count, err
+			}
+		}
+	}
+
+	query := auditRecordSelect + `
+	WHERE tenant_id = $1 AND timestamp >= $2 AND timestamp < $3`
+	args := []interface{}{filter.TenantID, filter.From, filter.To}
+	if filter.ResourceType != "" {
+		args = append(args, filter.ResourceType)
+		query += fmt.Sprintf(" AND resource_type = $%d", len(args))
+	}
+	if filter.ActorID != "" {
+		args = append(args, filter.ActorID)
+		query += fmt.Sprintf(" AND actor_id = $%d", len(args))
+	}
+	query += " ORDER BY timestamp, id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return count, fmt.Errorf("query audit export: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rec, err := scanExportRecord(rows)
+		if err != nil {
+			return count, fmt.Errorf("scan audit export record: %w", err)
+		}
+		if err := emit(rec); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("iterate audit export: %w", err)
+	}
+	return count, nil
+}
+
+func (s *ArchiveService) exportArchive(ctx context.Context, archive ArchiveFile, filter ExportFilter, emit func(*ExportRecord) error) (int64, error) {
+	var count int64
+	err := s.readArchive(ctx, archive, func(rec *ExportRecord) error {
+		if !filter.matches(rec) {
+			return nil
+		}
+		count++
+		return emit(rec)
+	})
+	return count, err
+}
+
+// readArchive 解压并逐行解析归档文件
+func (s *ArchiveService) readArchive(ctx context.Context, archive ArchiveFile, fn func(*ExportRecord) error) error {
+	rc, err := s.store.Open(ctx, archive.StorageKey)
+	if err != nil {
+		return fmt.Errorf("open audit archive %s: %w", archive.ID, err)
+	}
+	defer rc.Close()
+	gz, err := gzip.NewReader(rc)
+	if err != nil {
+		return fmt.Errorf("read audit archive %s: %w", archive.ID, err)
+	}
+	defer gz.Close()
+
+	dec := json.NewDecoder(gz)
+	for {
+		var rec ExportRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("decode audit archive %s: %w", archive.ID, err)
+		}
+		if err := fn(&rec); err != nil {
+			return err
+		}
+	}
+}
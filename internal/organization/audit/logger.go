@@ -10,7 +10,9 @@ import (
 
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // AuditLogger 结构化审计日志记录器。
@@ -102,11 +104,29 @@ func NewAuditLogger(db *sql.DB, baseLogger pkglogger.Logger) *AuditLogger {
 }
 
 // LogEvent 记录审计事件（自动处理默认值与 JSON 序列化）
+//
+// 写入与租户哈希链推进在同一事务内完成。
 func (a *AuditLogger) LogEvent(ctx context.Context, event *AuditEvent) error {
-	return a.logEvent(ctx, a.db, event)
+	if a.db == nil {
+		return fmt.Errorf("audit logger database not configured")
+	}
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin audit transaction: %w", err)
+	}
+	if err := a.logEvent(ctx, tx, event); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit audit event: %w", err)
+	}
+	return nil
 }
 
 // LogEventInTransaction 允许在现有事务中写入审计记录
+//
+// 租户链头行锁将持有到调用方事务结束。
 func (a *AuditLogger) LogEventInTransaction(ctx context.Context, tx *sql.Tx, event *AuditEvent) error {
 	if tx == nil {
 		return fmt.Errorf("nil transaction provided for audit logging")
@@ -119,10 +139,15 @@ type dbExecutor interface {
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
-func (a *AuditLogger) logEvent(ctx context.Context, exec dbExecutor, event *AuditEvent) error {
+func (a *AuditLogger) logEvent(ctx context.Context, exec dbExecutor, event *AuditEvent) (err error) {
 	if event == nil {
 		return fmt.Errorf("audit event is nil")
 	}
+	ctx, span := tracing.Start(ctx, "audit", "audit.write",
+		attribute.String("audit.event_type", event.EventType),
+		attribute.String("audit.resource_type", event.ResourceType),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	a.applyDefaults(ctx, exec, event)
 
@@ -132,30 +157,59 @@ func (a *AuditLogger) logEvent(ctx context.Context, exec dbExecutor, event *Audi
 	changesJSON := a.marshalOrDefault(event.Changes, "[]", "changes")
 	businessJSON := a.marshalOrDefault(event.BusinessContext, "{}", "business_context")
 
+	seq, prevHash, err := lockChainHead(ctx, exec, event.TenantID)
+	if err != nil {
+		a.logger.Errorf("审计哈希链锁定失败: %v", err)
+		return fmt.Errorf("failed to log audit event: %w", err)
+	}
+
+	var recordIDText string
+	var recordIDParam interface{}
+	if event.RecordID != uuid.Nil {
+		recordIDParam = event.RecordID
+		recordIDText = event.RecordID.String()
+	}
+
+	chained, err := newChainRecord(seq, chainColumns{
+		ID: event.ID.String(), TenantID: event.TenantID.String(),
+		EventType: event.EventType, ResourceType: event.ResourceType, ResourceID: event.ResourceID,
+		ActorID: event.ActorID, ActorType: event.ActorType, ActionName: event.ActionName,
+		RequestID: event.RequestID, OpReason: event.OperationReason,
+		Timestamp: event.Timestamp, Success: event.Success,
+		ErrorCode: event.ErrorCode, ErrorMessage: event.ErrorMessage,
+		RequestData: beforeJSON, ResponseData: afterJSON, ModifiedFields: modifiedJSON, Changes: changesJSON,
+		RecordID: recordIDText, BusinessContext: businessJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to canonicalize audit event: %w", err)
+	}
+	recordHash, err := chained.hash(prevHash)
+	if err != nil {
+		return fmt.Errorf("failed to hash audit event: %w", err)
+	}
+
 	query := `
         INSERT INTO audit_logs (
             id, tenant_id, event_type, resource_type, resource_id,
             actor_id, actor_type, action_name, request_id, operation_reason,
             timestamp, success, error_code, error_message,
             request_data, response_data, modified_fields, changes,
-            record_id, business_context
+            record_id, business_context,
+            chain_seq, prev_hash, record_hash, hash_version
         ) VALUES (
             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
             $11, $12, $13, $14, $15::jsonb, $16::jsonb, $17::jsonb, $18::jsonb,
-            $19, $20::jsonb
+            $19, $20::jsonb,
+            $21, $22, $23, $24
         )`
 
-	var recordIDParam interface{}
-	if event.RecordID != uuid.Nil {
-		recordIDParam = event.RecordID
-	}
-
-	_, err := exec.ExecContext(ctx, query,
+	_, err = exec.ExecContext(ctx, query,
 		event.ID, event.TenantID, event.EventType, event.ResourceType, event.ResourceID,
 		event.ActorID, event.ActorType, event.ActionName, event.RequestID, event.OperationReason,
 		event.Timestamp, event.Success, event.ErrorCode, event.ErrorMessage,
 		beforeJSON, afterJSON, modifiedJSON, changesJSON,
 		recordIDParam, businessJSON,
+		seq, prevHash, recordHash, ChainHashVersion,
 	)
 
 	if err != nil {
@@ -163,6 +217,11 @@ func (a *AuditLogger) logEvent(ctx context.Context, exec dbExecutor, event *Audi
 		return fmt.Errorf("failed to log audit event: %w", err)
 	}
 
+	if err := advanceChainHead(ctx, exec, event.TenantID, chainLink{Seq: seq, PrevHash: prevHash, Hash: recordHash}); err != nil {
+		a.logger.Errorf("审计哈希链推进失败: %v", err)
+		return fmt.Errorf("failed to log audit event: %w", err)
+	}
+
 	a.logger.Infof("审计事件已记录: %s/%s/%s (ID: %s)",
 		event.EventType, event.ResourceType, event.ActionName, event.ID.String())
 
@@ -176,6 +235,8 @@ func (a *AuditLogger) applyDefaults(ctx context.Context, exec dbExecutor, event
 	if event.Timestamp.IsZero() {
 		event.Timestamp = time.Now().UTC()
 	}
+	// 与 timestamptz 精度一致，保证哈希可由数据库记录复算
+	event.Timestamp = event.Timestamp.UTC().Truncate(time.Microsecond)
 	if event.ActorType == "" {
 		event.ActorType = ActorTypeUser
 	}
@@ -66,6 +66,18 @@ func (m fieldChangeHas) Match(v driver.Value) bool {
 	return false
 }
 
+func expectChainHead(mock sqlmock.Sqlmock, tenantID uuid.UUID, lastSeq int64, lastHash string) {
+	mock.ExpectExec("INSERT INTO audit_chain_heads").WithArgs(tenantID, chainGenesisHash).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT last_seq, last_hash FROM audit_chain_heads").WithArgs(tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"last_seq", "last_hash"}).AddRow(lastSeq, lastHash))
+}
+
+func expectChainAdvance(mock sqlmock.Sqlmock, tenantID uuid.UUID, seq int64) {
+	mock.ExpectExec("UPDATE audit_chain_heads").WithArgs(tenantID, seq, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func TestLogEvent_FallbackResourceID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -89,6 +101,8 @@ func TestLogEvent_FallbackResourceID(t *testing.T) {
 
 	expectedResourceID := fmt.Sprintf("%s_%s_%s", event.EventType, event.ResourceType, event.ActionName)
 
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(), // id
 		tenantID,         // tenant_id
@@ -110,7 +124,13 @@ func TestLogEvent_FallbackResourceID(t *testing.T) {
 		"[]",
 		nil,              // record_id
 		sqlmock.AnyArg(), // business_context
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 
 	if err := auditLogger.LogEvent(context.Background(), event); err != nil {
 		t.Fatalf("LogEvent returned error: %v", err)
@@ -140,6 +160,7 @@ func TestLogEventInTransaction(t *testing.T) {
 	recordID := uuid.New()
 
 	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -161,7 +182,12 @@ func TestLogEventInTransaction(t *testing.T) {
 		"[]",
 		recordID,
 		sqlmock.AnyArg(),
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
 	mock.ExpectRollback()
 
 	tx, err := db.Begin()
@@ -213,6 +239,8 @@ func TestLogErrorSetsPayload(t *testing.T) {
 	tenantID := uuid.New()
 	reqData := map[string]interface{}{"code": "ERR"}
 
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -234,7 +262,13 @@ func TestLogErrorSetsPayload(t *testing.T) {
 		"[]",
 		nil,
 		jsonContains{`"payload":{"code":"ERR"}`},
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 
 	if err := auditLogger.LogError(context.Background(), tenantID, ResourceTypeSystem, "entity-1", "DoSomething", "system", "req-err", "E001", "boom", reqData); err != nil {
 		t.Fatalf("LogError returned error: %v", err)
@@ -264,6 +298,8 @@ func TestLogOrganizationDeleteUsesRecordId(t *testing.T) {
 		Level:    1,
 	}
 
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -285,7 +321,13 @@ func TestLogOrganizationDeleteUsesRecordId(t *testing.T) {
 		sqlmock.AnyArg(),
 		recordID,
 		sqlmock.AnyArg(),
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 
 	if err := auditLogger.LogOrganizationDelete(context.Background(), tenantID, org.Code, org, "user-1", "req-del", "cleanup"); err != nil {
 		t.Fatalf("LogOrganizationDelete returned error: %v", err)
@@ -335,6 +377,8 @@ func TestLogOrganizationCreateCapturesNewValues(t *testing.T) {
 		ParentCode: &parentCode,
 	}
 
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -356,7 +400,13 @@ func TestLogOrganizationCreateCapturesNewValues(t *testing.T) {
 		fieldChangeHas{field: "code", newValue: "ORG001"},
 		recordID,
 		jsonContains{`"entityCode":"ORG001"`},
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 
 	req := &types.CreateOrganizationRequest{}
 	if err := auditLogger.LogOrganizationCreate(context.Background(), req, result, "user-1", "req-create", "reason"); err != nil {
@@ -457,6 +507,8 @@ func TestLogOrganizationUpdate_EmitsChanges(t *testing.T) {
 		Level:    1,
 	}
 
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -478,7 +530,13 @@ func TestLogOrganizationUpdate_EmitsChanges(t *testing.T) {
 		fieldChangeHas{field: "name", newValue: "New"},
 		recordID,
 		sqlmock.AnyArg(),
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 
 	if err := auditLogger.LogOrganizationUpdate(context.Background(), "ORG001", &types.UpdateOrganizationRequest{}, oldOrg, newOrg, "user-1", "req-upd", "reason"); err != nil {
 		t.Fatalf("LogOrganizationUpdate returned error: %v", err)
@@ -506,6 +564,8 @@ func TestLogOrganizationSuspendActivate(t *testing.T) {
 		Level:    1,
 	}
 	// Suspend: expect status INACTIVE
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -527,12 +587,20 @@ func TestLogOrganizationSuspendActivate(t *testing.T) {
 		fieldChangeHas{field: "status", newValue: "INACTIVE"},
 		recordID,
 		sqlmock.AnyArg(),
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 	if err := auditLogger.LogOrganizationSuspend(context.Background(), "ORG001", org, "user-1", "req-sus", "maint"); err != nil {
 		t.Fatalf("LogOrganizationSuspend returned error: %v", err)
 	}
 	// Activate: expect status ACTIVE
 	org.Status = "INACTIVE"
+	mock.ExpectBegin()
+	expectChainHead(mock, tenantID, 0, chainGenesisHash)
 	mock.ExpectExec("INSERT INTO audit_logs").WithArgs(
 		sqlmock.AnyArg(),
 		tenantID,
@@ -554,7 +622,13 @@ func TestLogOrganizationSuspendActivate(t *testing.T) {
 		fieldChangeHas{field: "status", newValue: "ACTIVE"},
 		recordID,
 		sqlmock.AnyArg(),
+		int64(1),
+		chainGenesisHash,
+		sqlmock.AnyArg(), // record_hash
+		ChainHashVersion,
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChainAdvance(mock, tenantID, 1)
+	mock.ExpectCommit()
 	if err := auditLogger.LogOrganizationActivate(context.Background(), "ORG001", org, "user-1", "req-act", "reopen"); err != nil {
 		t.Fatalf("LogOrganizationActivate returned error: %v", err)
 	}
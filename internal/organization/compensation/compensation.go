@@ -0,0 +1,162 @@
+// Package compensation 实现薪酬等级（职等）结构：按生效日期版本化的等级定义、按币种与地区的薪酬区间，以及等级与职级的关联。
+package compensation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+)
+
+const (
+	// StatusActive 职等可被职位引用
+	StatusActive = "ACTIVE"
+	// StatusInactive 职等停用，职位不可再引用
+	StatusInactive = "INACTIVE"
+	// RegionGlobal 未区分地区时的默认地区
+	RegionGlobal = "GLOBAL"
+
+	dateLayout    = temporal.DateLayout
+	maxNameLength = 255
+)
+
+var (
+	// ErrInvalidGrade 职等参数不合法
+	ErrInvalidGrade = errors.New("invalid compensation grade")
+	// ErrNotFound 职等不存在
+	ErrNotFound = errors.New("compensation grade not found")
+	// ErrDuplicateCode 同一租户下职等编码重复
+	ErrDuplicateCode = errors.New("compensation grade code already exists")
+	// ErrVersionExists 同一生效日期已存在版本
+	ErrVersionExists = errors.New("compensation grade version already exists for effective date")
+	// ErrInUse 职等仍被职位引用
+	ErrInUse = errors.New("compensation grade is in use")
+
+	codePattern     = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,19}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	regionPattern   = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,31}$`)
+)
+
+// PayRange 某币种与地区下的薪酬区间
+type PayRange struct {
+	Currency string  `json:"currency"`
+	Region   string  `json:"region"`
+	Min      float64 `json:"min"`
+	Mid      float64 `json:"mid"`
+	Max      float64 `json:"max"`
+}
+
+// Grade 职等的一个版本
+type Grade struct {
+	RecordID    uuid.UUID `json:"recordId"`
+	TenantID    uuid.UUID `json:"tenantId"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Status      string    `json:"status"`
+	// JobLevelCodes 允许使用该职等的职级编码
+	JobLevelCodes []string   `json:"jobLevelCodes"`
+	PayRanges     []PayRange `json:"payRanges"`
+	// EffectiveDate 版本生效日期（YYYY-MM-DD）
+	EffectiveDate string `json:"effectiveDate"`
+	// EndDate 版本结束日期（含），由下一版本生效日期推导；最新版本为空
+	EndDate   *string   `json:"endDate,omitempty"`
+	IsCurrent bool      `json:"isCurrent"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidCode 判断编码是否符合职等编码格式（与职位 gradeLevel 长度上限一致）
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Validate 校验职等版本
+func (g Grade) Validate() error {
+	if !ValidCode(g.Code) {
+		return errors.Join(ErrInvalidGrade, errors.New("code must be 1-20 uppercase letters, digits, underscores or hyphens"))
+	}
+	if name := strings.TrimSpace(g.Name); name == "" || len(name) > maxNameLength {
+		return errors.Join(ErrInvalidGrade, fmt.Errorf("name is required and must not exceed %d characters", maxNameLength))
+	}
+	if g.Status != StatusActive && g.Status != StatusInactive {
+		return errors.Join(ErrInvalidGrade, fmt.Errorf("status must be %s or %s", StatusActive, StatusInactive))
+	}
+	if _, err := time.Parse(dateLayout, g.EffectiveDate); err != nil {
+		return errors.Join(ErrInvalidGrade, errors.New("effectiveDate must be YYYY-MM-DD"))
+	}
+	for i, level := range g.JobLevelCodes {
+		if strings.TrimSpace(level) == "" || slices.Contains(g.JobLevelCodes[:i], level) {
+			return errors.Join(ErrInvalidGrade, fmt.Errorf("invalid or duplicate job level code %q", level))
+		}
+	}
+	for i, r := range g.PayRanges {
+		if !currencyPattern.MatchString(r.Currency) {
+			return errors.Join(ErrInvalidGrade, fmt.Errorf("payRanges[%d].currency must be an ISO 4217 code", i))
+		}
+		if !regionPattern.MatchString(r.Region) {
+			return errors.Join(ErrInvalidGrade, fmt.Errorf("payRanges[%d].region must be 1-32 uppercase letters, digits, underscores or hyphens", i))
+		}
+		if r.Min < 0 || r.Min > r.Mid || r.Mid > r.Max {
+			return errors.Join(ErrInvalidGrade, fmt.Errorf("payRanges[%d] must satisfy 0 <= min <= mid <= max", i))
+		}
+		if slices.ContainsFunc(g.PayRanges[:i], func(p PayRange) bool { return p.Currency == r.Currency && p.Region == r.Region }) {
+			return errors.Join(ErrInvalidGrade, fmt.Errorf("duplicate pay range for %s/%s", r.Currency, r.Region))
+		}
+	}
+	return nil
+}
+
+// AllowsJobLevel 判断职级是否可使用该职等
+func (g Grade) AllowsJobLevel(levelCode string) bool {
+	return slices.Contains(g.JobLevelCodes, levelCode)
+}
+
+// VersionCode 返回版本所属编码，供 temporal 推导时间轴
+func (g *Grade) VersionCode() string { return g.Code }
+
+// VersionEffectiveDate 返回版本生效日期
+func (g *Grade) VersionEffectiveDate() string { return g.EffectiveDate }
+
+// VersionEndDate 返回推导出的版本结束日期
+func (g *Grade) VersionEndDate() *string { return g.EndDate }
+
+// SetVersionSpan 写回推导出的结束日期与当前标记
+func (g *Grade) SetVersionSpan(endDate *string, isCurrent bool) {
+	g.EndDate = endDate
+	g.IsCurrent = isCurrent
+}
+
+// ListFilter 职等列表过滤条件
+type ListFilter struct {
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate string
+	// JobLevelCode 仅返回允许该职级使用的职等
+	JobLevelCode    string
+	IncludeInactive bool
+}
+
+// Source 按租户提供指定日期生效的职等，用于校验职位的 gradeLevel
+type Source interface {
+	ListGrades(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]Grade, error)
+}
+
+// Store 职等版本存储
+type Store interface {
+	// ListVersions 列出职等版本；code 为空时列出租户全部职等的版本
+	ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Grade, error)
+	// InsertVersion 写入新版本；同编码同生效日期冲突时返回 ErrVersionExists
+	InsertVersion(ctx context.Context, grade *Grade) error
+	// UpdateVersion 按 record_id 原位更正版本内容
+	UpdateVersion(ctx context.Context, grade *Grade) error
+	DeleteGrade(ctx context.Context, tenantID uuid.UUID, code string) error
+	// CountPositionUsage 统计引用该职等且未删除的当前职位数
+	CountPositionUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error)
+}
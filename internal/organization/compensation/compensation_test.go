@@ -0,0 +1,178 @@
+package compensation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type memoryStore struct {
+	versions []Grade
+	usage    map[string]int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{usage: map[string]int{}}
+}
+
+func (s *memoryStore) ListVersions(_ context.Context, tenantID uuid.UUID, code string) ([]Grade, error) {
+	out := make([]Grade, 0)
+	for _, v := range s.versions {
+		if v.TenantID == tenantID && (code == "" || v.Code == code) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) InsertVersion(_ context.Context, g *Grade) error {
+	for _, v := range s.versions {
+		if v.TenantID == g.TenantID && v.Code == g.Code && v.EffectiveDate == g.EffectiveDate {
+			return ErrVersionExists
+		}
+	}
+	s.versions = append(s.versions, *g)
+	return nil
+}
+
+func (s *memoryStore) UpdateVersion(_ context.Context, g *Grade) error {
+	for i, v := range s.versions {
+		if v.RecordID == g.RecordID {
+			s.versions[i] = *g
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s *memoryStore) DeleteGrade(_ context.Context, tenantID uuid.UUID, code string) error {
+	kept := s.versions[:0]
+	for _, v := range s.versions {
+		if v.TenantID != tenantID || v.Code != code {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == len(s.versions) {
+		return ErrNotFound
+	}
+	s.versions = kept
+	return nil
+}
+
+func (s *memoryStore) CountPositionUsage(_ context.Context, _ uuid.UUID, code string) (int, error) {
+	return s.usage[code], nil
+}
+
+func TestGradeValidate(t *testing.T) {
+	base := func() Grade {
+		return Grade{
+			Code:          "G5",
+			Name:          "Grade 5",
+			Status:        StatusActive,
+			JobLevelCodes: []string{"P1", "P2"},
+			PayRanges:     []PayRange{{Currency: "CNY", Region: RegionGlobal, Min: 100, Mid: 150, Max: 200}},
+			EffectiveDate: "2025-01-01",
+		}
+	}
+	cases := []struct {
+		name   string
+		mutate func(*Grade)
+		ok     bool
+	}{
+		{name: "valid", mutate: func(*Grade) {}, ok: true},
+		{name: "lowercase code", mutate: func(g *Grade) { g.Code = "g5" }},
+		{name: "missing name", mutate: func(g *Grade) { g.Name = " " }},
+		{name: "bad status", mutate: func(g *Grade) { g.Status = "DRAFT" }},
+		{name: "bad date", mutate: func(g *Grade) { g.EffectiveDate = "2025/01/01" }},
+		{name: "duplicate level", mutate: func(g *Grade) { g.JobLevelCodes = []string{"P1", "P1"} }},
+		{name: "bad currency", mutate: func(g *Grade) { g.PayRanges[0].Currency = "yuan" }},
+		{name: "mid above max", mutate: func(g *Grade) { g.PayRanges[0].Mid = 250 }},
+		{name: "duplicate range", mutate: func(g *Grade) { g.PayRanges = append(g.PayRanges, g.PayRanges[0]) }},
+	}
+	for _, tc := range cases {
+		g := base()
+		tc.mutate(&g)
+		err := g.Validate()
+		if tc.ok != (err == nil) {
+			t.Fatalf("%s: unexpected result %v", tc.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidGrade) {
+			t.Fatalf("%s: expected ErrInvalidGrade, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestServiceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	store := newMemoryStore()
+	svc := NewService(store, nil)
+	svc.now = func() time.Time { return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC) }
+
+	grade := Grade{
+		TenantID:      tenant,
+		Code:          "G5",
+		Name:          "Grade 5",
+		Status:        StatusActive,
+		JobLevelCodes: []string{"P1"},
+		PayRanges:     []PayRange{{Currency: "CNY", Region: RegionGlobal, Min: 100, Mid: 150, Max: 200}},
+		EffectiveDate: "2025-01-01",
+	}
+	created, err := svc.CreateGrade(ctx, grade)
+	if err != nil || created.RecordID == uuid.Nil || !created.IsCurrent {
+		t.Fatalf("create grade failed: %v %#v", err, created)
+	}
+	if _, err := svc.CreateGrade(ctx, grade); !errors.Is(err, ErrDuplicateCode) {
+		t.Fatalf("expected duplicate code, got %v", err)
+	}
+	if _, err := svc.CreateVersion(ctx, Grade{TenantID: tenant, Code: "G9", Name: "Grade 9", Status: StatusActive, EffectiveDate: "2025-01-01"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected version of unknown grade to fail, got %v", err)
+	}
+
+	next := grade
+	next.EffectiveDate = "2026-01-01"
+	next.JobLevelCodes = []string{"P1", "P2"}
+	if _, err := svc.CreateVersion(ctx, next); err != nil {
+		t.Fatalf("create version failed: %v", err)
+	}
+	if _, err := svc.CreateVersion(ctx, next); !errors.Is(err, ErrVersionExists) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	if got, err := svc.ListGrades(ctx, tenant, ListFilter{JobLevelCode: "P2"}); err != nil || len(got) != 0 {
+		t.Fatalf("P2 must not be allowed before 2026: %v %#v", err, got)
+	}
+	if got, err := svc.ListGrades(ctx, tenant, ListFilter{AsOfDate: "2026-03-01", JobLevelCode: "P2"}); err != nil || len(got) != 1 {
+		t.Fatalf("expected P2 to be allowed from 2026: %v %#v", err, got)
+	}
+
+	update := grade
+	update.Name = "Grade Five"
+	update.Status = StatusInactive
+	update.EffectiveDate = "2030-01-01"
+	updated, err := svc.UpdateGrade(ctx, update)
+	if err != nil || updated.EffectiveDate != "2025-01-01" || updated.RecordID != created.RecordID {
+		t.Fatalf("expected current version corrected in place: %v %#v", err, updated)
+	}
+	if got, _ := svc.ListGrades(ctx, tenant, ListFilter{}); len(got) != 0 {
+		t.Fatalf("inactive grade must be hidden by default: %#v", got)
+	}
+	if got, err := svc.GetGrade(ctx, tenant, "G5", ""); err != nil || got.Name != "Grade Five" {
+		t.Fatalf("unexpected current grade: %v %#v", err, got)
+	}
+
+	store.usage["G5"] = 3
+	if err := svc.DeleteGrade(ctx, tenant, "G5"); !errors.Is(err, ErrInUse) {
+		t.Fatalf("expected in-use grade deletion to fail, got %v", err)
+	}
+	store.usage["G5"] = 0
+	if err := svc.DeleteGrade(ctx, tenant, "G5"); err != nil {
+		t.Fatalf("delete grade failed: %v", err)
+	}
+	if _, err := svc.ListVersions(ctx, tenant, "G5"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected deleted grade to be gone, got %v", err)
+	}
+}
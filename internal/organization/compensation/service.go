@@ -0,0 +1,201 @@
+package compensation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 职等管理，同时作为职位 gradeLevel 校验的职等来源
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建职等服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "compensationGrade",
+			"module":    "command",
+		}),
+	}
+}
+
+func (s *Service) today() time.Time {
+	return s.now().UTC()
+}
+
+func (s *Service) timeline(ctx context.Context, tenantID uuid.UUID, code string) ([]Grade, error) {
+	versions, err := s.store.ListVersions(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	return temporal.Timeline(versions, s.today()), nil
+}
+
+// ListGrades 返回指定日期生效的职等，可按职级过滤
+func (s *Service) ListGrades(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]Grade, error) {
+	versions, err := s.timeline(ctx, tenantID, "")
+	if err != nil {
+		return nil, err
+	}
+	asOf := filter.AsOfDate
+	if asOf == "" {
+		asOf = s.today().Format(dateLayout)
+	}
+	grades := make([]Grade, 0)
+	for _, g := range temporal.AsOf(versions, asOf) {
+		if !filter.IncludeInactive && g.Status != StatusActive {
+			continue
+		}
+		if filter.JobLevelCode != "" && !g.AllowsJobLevel(filter.JobLevelCode) {
+			continue
+		}
+		grades = append(grades, g)
+	}
+	return grades, nil
+}
+
+// GetGrade 读取指定日期生效的职等版本，日期为空时取当天
+func (s *Service) GetGrade(ctx context.Context, tenantID uuid.UUID, code, asOfDate string) (*Grade, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if asOfDate == "" {
+		asOfDate = s.today().Format(dateLayout)
+	}
+	matched := temporal.AsOf(versions, asOfDate)
+	if len(matched) == 0 {
+		return nil, ErrNotFound
+	}
+	return &matched[0], nil
+}
+
+// ListVersions 返回职等全部版本（按生效日期升序）
+func (s *Service) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Grade, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	return versions, nil
+}
+
+// CreateGrade 创建职等及其首个版本
+func (s *Service) CreateGrade(ctx context.Context, g Grade) (*Grade, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, g.TenantID, g.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrDuplicateCode
+	}
+	created, err := s.insert(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": g.TenantID, "grade": g.Code}).Info("compensation grade created")
+	return created, nil
+}
+
+// CreateVersion 为已有职等新增生效版本
+func (s *Service) CreateVersion(ctx context.Context, g Grade) (*Grade, error) {
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, g.TenantID, g.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, ErrNotFound
+	}
+	created, err := s.insert(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": g.TenantID, "grade": g.Code, "effectiveDate": g.EffectiveDate}).Info("compensation grade version created")
+	return created, nil
+}
+
+func (s *Service) insert(ctx context.Context, g Grade) (*Grade, error) {
+	now := s.now().UTC().Truncate(time.Microsecond)
+	g.RecordID = uuid.New()
+	g.CreatedAt = now
+	g.UpdatedAt = now
+	if err := s.store.InsertVersion(ctx, &g); err != nil {
+		return nil, err
+	}
+	versions, err := s.timeline(ctx, g.TenantID, g.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.RecordID == g.RecordID {
+			return &v, nil
+		}
+	}
+	return &g, nil
+}
+
+// UpdateGrade 原位更正当前生效版本（无当前版本时更正最新版本）的名称、描述、状态、职级关联与薪酬区间；
+// 生效日期不可修改，调整生效日期应新增版本
+func (s *Service) UpdateGrade(ctx context.Context, g Grade) (*Grade, error) {
+	versions, err := s.timeline(ctx, g.TenantID, g.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	target := versions[len(versions)-1]
+	for _, v := range versions {
+		if v.IsCurrent {
+			target = v
+			break
+		}
+	}
+	g.RecordID = target.RecordID
+	g.EffectiveDate = target.EffectiveDate
+	g.EndDate = target.EndDate
+	g.IsCurrent = target.IsCurrent
+	g.CreatedBy = target.CreatedBy
+	g.CreatedAt = target.CreatedAt
+	g.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateVersion(ctx, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// DeleteGrade 删除职等全部版本；仍被当前职位引用时拒绝删除
+func (s *Service) DeleteGrade(ctx context.Context, tenantID uuid.UUID, code string) error {
+	count, err := s.store.CountPositionUsage(ctx, tenantID, code)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d positions use grade %s", ErrInUse, count, code)
+	}
+	return s.store.DeleteGrade(ctx, tenantID, code)
+}
@@ -0,0 +1,139 @@
+package compensation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的职等存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建职等存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const gradeColumns = `record_id, tenant_id, grade_code, name, COALESCE(description, ''), status, job_level_codes,
+	pay_ranges, effective_date, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanGrade(row interface{ Scan(...any) error }) (*Grade, error) {
+	var (
+		g             Grade
+		payRanges     []byte
+		effectiveDate time.Time
+	)
+	if err := row.Scan(&g.RecordID, &g.TenantID, &g.Code, &g.Name, &g.Description, &g.Status, pq.Array(&g.JobLevelCodes),
+		&payRanges, &effectiveDate, &g.CreatedBy, &g.CreatedAt, &g.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if len(payRanges) > 0 {
+		if err := json.Unmarshal(payRanges, &g.PayRanges); err != nil {
+			return nil, fmt.Errorf("decode pay ranges: %w", err)
+		}
+	}
+	if g.JobLevelCodes == nil {
+		g.JobLevelCodes = []string{}
+	}
+	if g.PayRanges == nil {
+		g.PayRanges = []PayRange{}
+	}
+	g.EffectiveDate = effectiveDate.Format(dateLayout)
+	g.CreatedAt = g.CreatedAt.UTC()
+	g.UpdatedAt = g.UpdatedAt.UTC()
+	return &g, nil
+}
+
+func encodePayRanges(ranges []PayRange) ([]byte, error) {
+	if ranges == nil {
+		ranges = []PayRange{}
+	}
+	return json.Marshal(ranges)
+}
+
+// ListVersions 列出职等版本
+func (s *SQLStore) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Grade, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+gradeColumns+`
+	FROM compensation_grades
+	WHERE tenant_id = $1 AND ($2 = '' OR grade_code = $2)
+	ORDER BY grade_code, effective_date`, tenantID, code)
+	if err != nil {
+		return nil, fmt.Errorf("list compensation grades: %w", err)
+	}
+	grades, err := temporal.CollectRows(rows, scanGrade)
+	if err != nil {
+		return nil, fmt.Errorf("scan compensation grade: %w", err)
+	}
+	return grades, nil
+}
+
+// InsertVersion 写入职等版本
+func (s *SQLStore) InsertVersion(ctx context.Context, g *Grade) error {
+	payRanges, err := encodePayRanges(g.PayRanges)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+	INSERT INTO compensation_grades
+		(record_id, tenant_id, grade_code, name, description, status, job_level_codes, pay_ranges, effective_date,
+		 created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, NULLIF($10, ''), $11, $12)`,
+		g.RecordID, g.TenantID, g.Code, g.Name, g.Description, g.Status, pq.Array(g.JobLevelCodes), payRanges, g.EffectiveDate,
+		g.CreatedBy, g.CreatedAt, g.UpdatedAt,
+	)
+	if err != nil {
+		if temporal.IsVersionConflict(err) {
+			return ErrVersionExists
+		}
+		return fmt.Errorf("insert compensation grade: %w", err)
+	}
+	return nil
+}
+
+// UpdateVersion 原位更正职等版本
+func (s *SQLStore) UpdateVersion(ctx context.Context, g *Grade) error {
+	payRanges, err := encodePayRanges(g.PayRanges)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE compensation_grades
+	SET name = $3, description = NULLIF($4, ''), status = $5, job_level_codes = $6, pay_ranges = $7, updated_at = $8
+	WHERE tenant_id = $1 AND record_id = $2`,
+		g.TenantID, g.RecordID, g.Name, g.Description, g.Status, pq.Array(g.JobLevelCodes), payRanges, g.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update compensation grade: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// DeleteGrade 删除职等的全部版本
+func (s *SQLStore) DeleteGrade(ctx context.Context, tenantID uuid.UUID, code string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM compensation_grades WHERE tenant_id = $1 AND grade_code = $2`, tenantID, code)
+	if err != nil {
+		return fmt.Errorf("delete compensation grade: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// CountPositionUsage 统计引用该职等的当前职位数
+func (s *SQLStore) CountPositionUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `
+	SELECT COUNT(*) FROM positions
+	WHERE tenant_id = $1 AND grade_level = $2 AND is_current = true AND deleted_at IS NULL`, tenantID, code).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count compensation grade usage: %w", err)
+	}
+	return count, nil
+}
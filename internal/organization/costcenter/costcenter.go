@@ -0,0 +1,192 @@
+// Package costcenter 实现成本中心主数据：按生效日期版本化、归属法人实体（COMPANY 组织单元）的层级成本中心，以及职位成本按比例分摊到多个成本中心。
+package costcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+)
+
+const (
+	// StatusActive 成本中心可被职位引用
+	StatusActive = "ACTIVE"
+	// StatusInactive 成本中心停用，职位不可再引用
+	StatusInactive = "INACTIVE"
+	// AllocationTotal 职位成本分摊比例合计
+	AllocationTotal = 100.0
+
+	dateLayout          = temporal.DateLayout
+	maxNameLength       = 255
+	allocationTolerance = 0.001
+)
+
+var (
+	// ErrInvalidCostCenter 成本中心参数不合法
+	ErrInvalidCostCenter = errors.New("invalid cost center")
+	// ErrNotFound 成本中心不存在
+	ErrNotFound = errors.New("cost center not found")
+	// ErrDuplicateCode 同一租户下成本中心编码重复
+	ErrDuplicateCode = errors.New("cost center code already exists")
+	// ErrVersionExists 同一生效日期已存在版本
+	ErrVersionExists = errors.New("cost center version already exists for effective date")
+	// ErrInUse 成本中心仍被职位、分摊或下级成本中心引用
+	ErrInUse = errors.New("cost center is in use")
+	// ErrInvalidAllocation 职位成本分摊不合法
+	ErrInvalidAllocation = errors.New("invalid cost allocation")
+	// ErrPositionNotFound 分摊的职位不存在
+	ErrPositionNotFound = errors.New("position not found")
+
+	codePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,49}$`)
+)
+
+// CostCenter 成本中心版本
+type CostCenter struct {
+	RecordID    uuid.UUID `json:"recordId"`
+	TenantID    uuid.UUID `json:"tenantId"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Status      string    `json:"status"`
+	// ParentCode 上级成本中心编码，为空表示顶级
+	ParentCode string `json:"parentCode,omitempty"`
+	// LegalEntityCode 所属法人实体（COMPANY 类型组织单元编码）
+	LegalEntityCode string `json:"legalEntityCode"`
+	// EffectiveDate 版本生效日期（YYYY-MM-DD）
+	EffectiveDate string `json:"effectiveDate"`
+	// EndDate 由下一版本生效日期推导，最新版本为空
+	EndDate   *string   `json:"endDate,omitempty"`
+	IsCurrent bool      `json:"isCurrent"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidCode 判断编码是否符合成本中心编码格式（与职位 costCenterCode 长度上限一致）
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Validate 校验成本中心版本自身字段；上级与法人实体的存在性由 Service 校验
+func (c CostCenter) Validate() error {
+	if !ValidCode(c.Code) {
+		return errors.Join(ErrInvalidCostCenter, errors.New("code must be 1-50 uppercase letters, digits, underscores or hyphens"))
+	}
+	if name := strings.TrimSpace(c.Name); name == "" || len(name) > maxNameLength {
+		return errors.Join(ErrInvalidCostCenter, fmt.Errorf("name is required and must not exceed %d characters", maxNameLength))
+	}
+	if c.Status != StatusActive && c.Status != StatusInactive {
+		return errors.Join(ErrInvalidCostCenter, fmt.Errorf("status must be %s or %s", StatusActive, StatusInactive))
+	}
+	if strings.TrimSpace(c.LegalEntityCode) == "" {
+		return errors.Join(ErrInvalidCostCenter, errors.New("legalEntityCode is required"))
+	}
+	if c.ParentCode != "" && (!ValidCode(c.ParentCode) || c.ParentCode == c.Code) {
+		return errors.Join(ErrInvalidCostCenter, errors.New("parentCode must be another cost center code"))
+	}
+	if _, err := time.Parse(dateLayout, c.EffectiveDate); err != nil {
+		return errors.Join(ErrInvalidCostCenter, errors.New("effectiveDate must be YYYY-MM-DD"))
+	}
+	return nil
+}
+
+// VersionCode 返回版本所属编码，供 temporal 推导时间轴
+func (c *CostCenter) VersionCode() string { return c.Code }
+
+// VersionEffectiveDate 返回版本生效日期
+func (c *CostCenter) VersionEffectiveDate() string { return c.EffectiveDate }
+
+// VersionEndDate 返回推导出的版本结束日期
+func (c *CostCenter) VersionEndDate() *string { return c.EndDate }
+
+// SetVersionSpan 写回推导出的结束日期与当前标记
+func (c *CostCenter) SetVersionSpan(endDate *string, isCurrent bool) {
+	c.EndDate = endDate
+	c.IsCurrent = isCurrent
+}
+
+// Allocation 职位成本分摊到某成本中心的比例（百分比）
+type Allocation struct {
+	CostCenterCode string  `json:"costCenterCode"`
+	Percentage     float64 `json:"percentage"`
+}
+
+// AllocationSet 职位自生效日期起的完整分摊方案，后一方案整体替换前一方案
+type AllocationSet struct {
+	TenantID      uuid.UUID    `json:"tenantId"`
+	PositionCode  string       `json:"positionCode"`
+	EffectiveDate string       `json:"effectiveDate"`
+	Allocations   []Allocation `json:"allocations"`
+	CreatedBy     string       `json:"createdBy,omitempty"`
+	CreatedAt     time.Time    `json:"createdAt"`
+}
+
+// Validate 校验分摊比例：每项大于 0、成本中心不重复且合计为 100
+func (s AllocationSet) Validate() error {
+	if strings.TrimSpace(s.PositionCode) == "" {
+		return errors.Join(ErrInvalidAllocation, errors.New("positionCode is required"))
+	}
+	if _, err := time.Parse(dateLayout, s.EffectiveDate); err != nil {
+		return errors.Join(ErrInvalidAllocation, errors.New("effectiveDate must be YYYY-MM-DD"))
+	}
+	if len(s.Allocations) == 0 {
+		return errors.Join(ErrInvalidAllocation, errors.New("at least one allocation is required"))
+	}
+	total := 0.0
+	for i, a := range s.Allocations {
+		if !ValidCode(a.CostCenterCode) {
+			return errors.Join(ErrInvalidAllocation, fmt.Errorf("allocations[%d].costCenterCode is invalid", i))
+		}
+		if a.Percentage <= 0 || a.Percentage > AllocationTotal {
+			return errors.Join(ErrInvalidAllocation, fmt.Errorf("allocations[%d].percentage must be greater than 0 and at most 100", i))
+		}
+		if slices.ContainsFunc(s.Allocations[:i], func(p Allocation) bool { return p.CostCenterCode == a.CostCenterCode }) {
+			return errors.Join(ErrInvalidAllocation, fmt.Errorf("duplicate allocation for cost center %s", a.CostCenterCode))
+		}
+		total += a.Percentage
+	}
+	if math.Abs(total-AllocationTotal) > allocationTolerance {
+		return errors.Join(ErrInvalidAllocation, fmt.Errorf("allocation percentages must total 100, got %.2f", total))
+	}
+	return nil
+}
+
+// ListFilter 成本中心列表过滤条件
+type ListFilter struct {
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate        string
+	LegalEntityCode string
+	ParentCode      string
+	IncludeInactive bool
+}
+
+// Source 按租户提供指定日期生效的成本中心，用于校验职位的 costCenterCode
+type Source interface {
+	ListCostCenters(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]CostCenter, error)
+}
+
+// Store 成本中心版本与职位分摊存储
+type Store interface {
+	// ListVersions 列出成本中心版本；code 为空时列出租户全部成本中心的版本
+	ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]CostCenter, error)
+	// InsertVersion 写入新版本；同编码同生效日期冲突时返回 ErrVersionExists
+	InsertVersion(ctx context.Context, cc *CostCenter) error
+	// UpdateVersion 按 record_id 原位更正版本内容
+	UpdateVersion(ctx context.Context, cc *CostCenter) error
+	DeleteCostCenter(ctx context.Context, tenantID uuid.UUID, code string) error
+	// CountUsage 统计引用该成本中心的当前职位与分摊记录数
+	CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error)
+	// IsLegalEntity 判断编码是否为当前有效的 COMPANY 组织单元
+	IsLegalEntity(ctx context.Context, tenantID uuid.UUID, orgCode string) (bool, error)
+	// PositionExists 判断职位是否存在且未删除
+	PositionExists(ctx context.Context, tenantID uuid.UUID, positionCode string) (bool, error)
+	// ReplaceAllocations 写入职位自生效日期起的分摊方案，同一生效日期的旧方案被替换
+	ReplaceAllocations(ctx context.Context, set *AllocationSet) error
+}
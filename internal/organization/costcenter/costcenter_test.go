@@ -0,0 +1,277 @@
+package costcenter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type memoryStore struct {
+	versions      []CostCenter
+	usage         map[string]int
+	legalEntities map[string]bool
+	positions     map[string]bool
+	allocations   []AllocationSet
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		usage:         map[string]int{},
+		legalEntities: map[string]bool{"1000000": true, "2000000": true},
+		positions:     map[string]bool{"P1000001": true},
+	}
+}
+
+func (s *memoryStore) ListVersions(_ context.Context, tenantID uuid.UUID, code string) ([]CostCenter, error) {
+	out := make([]CostCenter, 0)
+	for _, v := range s.versions {
+		if v.TenantID == tenantID && (code == "" || v.Code == code) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) InsertVersion(_ context.Context, c *CostCenter) error {
+	for _, v := range s.versions {
+		if v.TenantID == c.TenantID && v.Code == c.Code && v.EffectiveDate == c.EffectiveDate {
+			return ErrVersionExists
+		}
+	}
+	s.versions = append(s.versions, *c)
+	return nil
+}
+
+func (s *memoryStore) UpdateVersion(_ context.Context, c *CostCenter) error {
+	for i, v := range s.versions {
+		if v.RecordID == c.RecordID {
+			s.versions[i] = *c
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s *memoryStore) DeleteCostCenter(_ context.Context, tenantID uuid.UUID, code string) error {
+	kept := s.versions[:0]
+	for _, v := range s.versions {
+		if v.TenantID != tenantID || v.Code != code {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == len(s.versions) {
+		return ErrNotFound
+	}
+	s.versions = kept
+	return nil
+}
+
+func (s *memoryStore) CountUsage(_ context.Context, _ uuid.UUID, code string) (int, error) {
+	return s.usage[code], nil
+}
+
+func (s *memoryStore) IsLegalEntity(_ context.Context, _ uuid.UUID, orgCode string) (bool, error) {
+	return s.legalEntities[orgCode], nil
+}
+
+func (s *memoryStore) PositionExists(_ context.Context, _ uuid.UUID, positionCode string) (bool, error) {
+	return s.positions[positionCode], nil
+}
+
+func (s *memoryStore) ReplaceAllocations(_ context.Context, set *AllocationSet) error {
+	s.allocations = append(s.allocations, *set)
+	return nil
+}
+
+func TestCostCenterValidate(t *testing.T) {
+	base := func() CostCenter {
+		return CostCenter{
+			Code:            "CC-100",
+			Name:            "Finance",
+			Status:          StatusActive,
+			LegalEntityCode: "1000000",
+			EffectiveDate:   "2025-01-01",
+		}
+	}
+	cases := []struct {
+		name   string
+		mutate func(*CostCenter)
+		ok     bool
+	}{
+		{name: "valid", mutate: func(*CostCenter) {}, ok: true},
+		{name: "with parent", mutate: func(c *CostCenter) { c.ParentCode = "CC-ROOT" }, ok: true},
+		{name: "lowercase code", mutate: func(c *CostCenter) { c.Code = "cc-100" }},
+		{name: "missing name", mutate: func(c *CostCenter) { c.Name = " " }},
+		{name: "bad status", mutate: func(c *CostCenter) { c.Status = "CLOSED" }},
+		{name: "missing legal entity", mutate: func(c *CostCenter) { c.LegalEntityCode = "" }},
+		{name: "self parent", mutate: func(c *CostCenter) { c.ParentCode = c.Code }},
+		{name: "bad date", mutate: func(c *CostCenter) { c.EffectiveDate = "01/01/2025" }},
+	}
+	for _, tc := range cases {
+		c := base()
+		tc.mutate(&c)
+		err := c.Validate()
+		if tc.ok != (err == nil) {
+			t.Fatalf("%s: unexpected result %v", tc.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidCostCenter) {
+			t.Fatalf("%s: expected ErrInvalidCostCenter, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestAllocationSetValidate(t *testing.T) {
+	base := func() AllocationSet {
+		return AllocationSet{
+			PositionCode:  "P1000001",
+			EffectiveDate: "2025-01-01",
+			Allocations:   []Allocation{{CostCenterCode: "CC-A", Percentage: 60}, {CostCenterCode: "CC-B", Percentage: 40}},
+		}
+	}
+	cases := []struct {
+		name   string
+		mutate func(*AllocationSet)
+		ok     bool
+	}{
+		{name: "valid", mutate: func(*AllocationSet) {}, ok: true},
+		{name: "fractional", mutate: func(s *AllocationSet) {
+			s.Allocations = []Allocation{{CostCenterCode: "CC-A", Percentage: 33.33}, {CostCenterCode: "CC-B", Percentage: 66.67}}
+		}, ok: true},
+		{name: "empty", mutate: func(s *AllocationSet) { s.Allocations = nil }},
+		{name: "zero share", mutate: func(s *AllocationSet) { s.Allocations = append(s.Allocations, Allocation{CostCenterCode: "CC-C"}) }},
+		{name: "total below 100", mutate: func(s *AllocationSet) { s.Allocations[1].Percentage = 30 }},
+		{name: "duplicate", mutate: func(s *AllocationSet) { s.Allocations[1].CostCenterCode = "CC-A" }},
+		{name: "bad code", mutate: func(s *AllocationSet) { s.Allocations[0].CostCenterCode = "cc a" }},
+		{name: "bad date", mutate: func(s *AllocationSet) { s.EffectiveDate = "2025-13-01" }},
+	}
+	for _, tc := range cases {
+		s := base()
+		tc.mutate(&s)
+		err := s.Validate()
+		if tc.ok != (err == nil) {
+			t.Fatalf("%s: unexpected result %v", tc.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidAllocation) {
+			t.Fatalf("%s: expected ErrInvalidAllocation, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestServiceHierarchy(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	svc := NewService(newMemoryStore(), nil)
+	svc.now = func() time.Time { return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC) }
+
+	root := CostCenter{TenantID: tenant, Code: "ROOT", Name: "Group", Status: StatusActive, LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateCostCenter(ctx, root); err != nil {
+		t.Fatalf("create root failed: %v", err)
+	}
+	child := CostCenter{TenantID: tenant, Code: "OPS", Name: "Operations", Status: StatusActive, ParentCode: "ROOT", LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateCostCenter(ctx, child); err != nil {
+		t.Fatalf("create child failed: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		center CostCenter
+	}{
+		{name: "unknown legal entity", center: CostCenter{TenantID: tenant, Code: "X1", Name: "X", Status: StatusActive, LegalEntityCode: "9999999", EffectiveDate: "2025-01-01"}},
+		{name: "missing parent", center: CostCenter{TenantID: tenant, Code: "X2", Name: "X", Status: StatusActive, ParentCode: "NOPE", LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"}},
+		{name: "parent not yet effective", center: CostCenter{TenantID: tenant, Code: "X3", Name: "X", Status: StatusActive, ParentCode: "ROOT", LegalEntityCode: "1000000", EffectiveDate: "2024-06-01"}},
+		{name: "parent in other legal entity", center: CostCenter{TenantID: tenant, Code: "X4", Name: "X", Status: StatusActive, ParentCode: "ROOT", LegalEntityCode: "2000000", EffectiveDate: "2025-01-01"}},
+	}
+	for _, tc := range cases {
+		if _, err := svc.CreateCostCenter(ctx, tc.center); !errors.Is(err, ErrInvalidCostCenter) {
+			t.Fatalf("%s: expected ErrInvalidCostCenter, got %v", tc.name, err)
+		}
+	}
+
+	cycle := root
+	cycle.ParentCode = "OPS"
+	if _, err := svc.UpdateCostCenter(ctx, cycle); !errors.Is(err, ErrInvalidCostCenter) {
+		t.Fatalf("expected cycle to be rejected, got %v", err)
+	}
+
+	if got, err := svc.ListCostCenters(ctx, tenant, ListFilter{ParentCode: "ROOT"}); err != nil || len(got) != 1 || got[0].Code != "OPS" {
+		t.Fatalf("unexpected children of ROOT: %v %#v", err, got)
+	}
+	if got, err := svc.ListCostCenters(ctx, tenant, ListFilter{LegalEntityCode: "2000000"}); err != nil || len(got) != 0 {
+		t.Fatalf("expected no cost centers for other legal entity: %v %#v", err, got)
+	}
+}
+
+func TestServiceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	store := newMemoryStore()
+	svc := NewService(store, nil)
+	svc.now = func() time.Time { return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC) }
+
+	center := CostCenter{TenantID: tenant, Code: "CC-A", Name: "Sales", Status: StatusActive, LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"}
+	created, err := svc.CreateCostCenter(ctx, center)
+	if err != nil || created.RecordID == uuid.Nil || !created.IsCurrent {
+		t.Fatalf("create cost center failed: %v %#v", err, created)
+	}
+	if _, err := svc.CreateCostCenter(ctx, center); !errors.Is(err, ErrDuplicateCode) {
+		t.Fatalf("expected duplicate code, got %v", err)
+	}
+	other := CostCenter{TenantID: tenant, Code: "CC-B", Name: "Marketing", Status: StatusActive, LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateCostCenter(ctx, other); err != nil {
+		t.Fatalf("create second cost center failed: %v", err)
+	}
+
+	closing := other
+	closing.Status = StatusInactive
+	closing.EffectiveDate = "2026-01-01"
+	if _, err := svc.CreateVersion(ctx, closing); err != nil {
+		t.Fatalf("create version failed: %v", err)
+	}
+	if _, err := svc.CreateVersion(ctx, closing); !errors.Is(err, ErrVersionExists) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	split := AllocationSet{
+		TenantID:      tenant,
+		PositionCode:  "P1000001",
+		EffectiveDate: "2025-07-01",
+		Allocations:   []Allocation{{CostCenterCode: "CC-A", Percentage: 70}, {CostCenterCode: "CC-B", Percentage: 30}},
+	}
+	if _, err := svc.ReplaceAllocations(ctx, split); err != nil {
+		t.Fatalf("replace allocations failed: %v", err)
+	}
+	if len(store.allocations) != 1 || store.allocations[0].CreatedAt.IsZero() {
+		t.Fatalf("expected allocation set to be stored: %#v", store.allocations)
+	}
+
+	late := split
+	late.EffectiveDate = "2026-02-01"
+	if _, err := svc.ReplaceAllocations(ctx, late); !errors.Is(err, ErrInvalidAllocation) {
+		t.Fatalf("expected inactive cost center to be rejected, got %v", err)
+	}
+	unknown := split
+	unknown.Allocations = []Allocation{{CostCenterCode: "CC-Z", Percentage: 100}}
+	if _, err := svc.ReplaceAllocations(ctx, unknown); !errors.Is(err, ErrInvalidAllocation) {
+		t.Fatalf("expected unknown cost center to be rejected, got %v", err)
+	}
+	missing := split
+	missing.PositionCode = "P9999999"
+	if _, err := svc.ReplaceAllocations(ctx, missing); !errors.Is(err, ErrPositionNotFound) {
+		t.Fatalf("expected missing position, got %v", err)
+	}
+
+	store.usage["CC-A"] = 2
+	if err := svc.DeleteCostCenter(ctx, tenant, "CC-A"); !errors.Is(err, ErrInUse) {
+		t.Fatalf("expected in-use cost center deletion to fail, got %v", err)
+	}
+	store.usage["CC-A"] = 0
+	if err := svc.DeleteCostCenter(ctx, tenant, "CC-A"); err != nil {
+		t.Fatalf("delete cost center failed: %v", err)
+	}
+	if _, err := svc.ListVersions(ctx, tenant, "CC-A"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected deleted cost center to be gone, got %v", err)
+	}
+}
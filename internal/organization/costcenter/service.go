@@ -0,0 +1,299 @@
+package costcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 成本中心与职位成本分摊管理，同时作为职位 costCenterCode 校验的成本中心来源
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建成本中心服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "costCenter",
+			"module":    "command",
+		}),
+	}
+}
+
+func (s *Service) today() time.Time {
+	return s.now().UTC()
+}
+
+func (s *Service) timeline(ctx context.Context, tenantID uuid.UUID, code string) ([]CostCenter, error) {
+	versions, err := s.store.ListVersions(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	return temporal.Timeline(versions, s.today()), nil
+}
+
+// ListCostCenters 返回指定日期生效的成本中心，可按法人实体与上级过滤
+func (s *Service) ListCostCenters(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]CostCenter, error) {
+	versions, err := s.timeline(ctx, tenantID, "")
+	if err != nil {
+		return nil, err
+	}
+	asOf := filter.AsOfDate
+	if asOf == "" {
+		asOf = s.today().Format(dateLayout)
+	}
+	centers := make([]CostCenter, 0)
+	for _, c := range temporal.AsOf(versions, asOf) {
+		if !filter.IncludeInactive && c.Status != StatusActive {
+			continue
+		}
+		if filter.LegalEntityCode != "" && c.LegalEntityCode != filter.LegalEntityCode {
+			continue
+		}
+		if filter.ParentCode != "" && c.ParentCode != filter.ParentCode {
+			continue
+		}
+		centers = append(centers, c)
+	}
+	return centers, nil
+}
+
+// GetCostCenter 读取指定日期生效的成本中心版本，日期为空时取当天
+func (s *Service) GetCostCenter(ctx context.Context, tenantID uuid.UUID, code, asOfDate string) (*CostCenter, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if asOfDate == "" {
+		asOfDate = s.today().Format(dateLayout)
+	}
+	matched := temporal.AsOf(versions, asOfDate)
+	if len(matched) == 0 {
+		return nil, ErrNotFound
+	}
+	return &matched[0], nil
+}
+
+// ListVersions 返回成本中心全部版本（按生效日期升序）
+func (s *Service) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]CostCenter, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	return versions, nil
+}
+
+// CreateCostCenter 创建成本中心及其首个版本
+func (s *Service) CreateCostCenter(ctx context.Context, c CostCenter) (*CostCenter, error) {
+	if err := s.validate(ctx, c); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, c.TenantID, c.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrDuplicateCode
+	}
+	created, err := s.insert(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": c.TenantID, "costCenter": c.Code}).Info("cost center created")
+	return created, nil
+}
+
+// CreateVersion 为已有成本中心新增生效版本
+func (s *Service) CreateVersion(ctx context.Context, c CostCenter) (*CostCenter, error) {
+	if err := s.validate(ctx, c); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, c.TenantID, c.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, ErrNotFound
+	}
+	created, err := s.insert(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": c.TenantID, "costCenter": c.Code, "effectiveDate": c.EffectiveDate}).Info("cost center version created")
+	return created, nil
+}
+
+func (s *Service) insert(ctx context.Context, c CostCenter) (*CostCenter, error) {
+	now := s.now().UTC().Truncate(time.Microsecond)
+	c.RecordID = uuid.New()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	if err := s.store.InsertVersion(ctx, &c); err != nil {
+		return nil, err
+	}
+	versions, err := s.timeline(ctx, c.TenantID, c.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.RecordID == c.RecordID {
+			return &v, nil
+		}
+	}
+	return &c, nil
+}
+
+// UpdateCostCenter 原位更正当前生效版本（无当前版本时更正最新版本）的名称、描述、状态、上级与法人实体；
+// 生效日期不可修改，调整生效日期应新增版本
+func (s *Service) UpdateCostCenter(ctx context.Context, c CostCenter) (*CostCenter, error) {
+	versions, err := s.timeline(ctx, c.TenantID, c.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	target := versions[len(versions)-1]
+	for _, v := range versions {
+		if v.IsCurrent {
+			target = v
+			break
+		}
+	}
+	c.RecordID = target.RecordID
+	c.EffectiveDate = target.EffectiveDate
+	c.EndDate = target.EndDate
+	c.IsCurrent = target.IsCurrent
+	c.CreatedBy = target.CreatedBy
+	c.CreatedAt = target.CreatedAt
+	c.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.validate(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateVersion(ctx, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// DeleteCostCenter 删除成本中心全部版本；仍被职位、分摊或下级成本中心引用时拒绝删除
+func (s *Service) DeleteCostCenter(ctx context.Context, tenantID uuid.UUID, code string) error {
+	versions, err := s.timeline(ctx, tenantID, "")
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.ParentCode == code {
+			return fmt.Errorf("%w: cost center %s is the parent of %s", ErrInUse, code, v.Code)
+		}
+	}
+	count, err := s.store.CountUsage(ctx, tenantID, code)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d positions or allocations use cost center %s", ErrInUse, count, code)
+	}
+	return s.store.DeleteCostCenter(ctx, tenantID, code)
+}
+
+// validate 校验版本字段、法人实体与上级层级：上级须在生效日期存在、属于同一法人实体且不形成环
+func (s *Service) validate(ctx context.Context, c CostCenter) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	isLegalEntity, err := s.store.IsLegalEntity(ctx, c.TenantID, c.LegalEntityCode)
+	if err != nil {
+		return err
+	}
+	if !isLegalEntity {
+		return errors.Join(ErrInvalidCostCenter, fmt.Errorf("legalEntityCode %s must reference a current COMPANY unit", c.LegalEntityCode))
+	}
+	if c.ParentCode == "" {
+		return nil
+	}
+
+	versions, err := s.timeline(ctx, c.TenantID, "")
+	if err != nil {
+		return err
+	}
+	byCode := make(map[string]CostCenter)
+	for _, v := range temporal.AsOf(versions, c.EffectiveDate) {
+		byCode[v.Code] = v
+	}
+	byCode[c.Code] = c
+
+	parent, ok := byCode[c.ParentCode]
+	if !ok {
+		return errors.Join(ErrInvalidCostCenter, fmt.Errorf("parent cost center %s does not exist as of %s", c.ParentCode, c.EffectiveDate))
+	}
+	if parent.LegalEntityCode != c.LegalEntityCode {
+		return errors.Join(ErrInvalidCostCenter, fmt.Errorf("parent cost center %s belongs to legal entity %s", c.ParentCode, parent.LegalEntityCode))
+	}
+	visited := map[string]bool{c.Code: true}
+	for code := c.ParentCode; code != ""; code = byCode[code].ParentCode {
+		if visited[code] {
+			return errors.Join(ErrInvalidCostCenter, fmt.Errorf("parent cost center %s would create a cycle", c.ParentCode))
+		}
+		visited[code] = true
+	}
+	return nil
+}
+
+// ReplaceAllocations 设置职位自生效日期起的成本分摊方案；分摊的成本中心须在生效日期存在且启用
+func (s *Service) ReplaceAllocations(ctx context.Context, set AllocationSet) (*AllocationSet, error) {
+	if err := set.Validate(); err != nil {
+		return nil, err
+	}
+	exists, err := s.store.PositionExists(ctx, set.TenantID, set.PositionCode)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrPositionNotFound
+	}
+	centers, err := s.ListCostCenters(ctx, set.TenantID, ListFilter{AsOfDate: set.EffectiveDate, IncludeInactive: true})
+	if err != nil {
+		return nil, err
+	}
+	status := make(map[string]string, len(centers))
+	for _, c := range centers {
+		status[c.Code] = c.Status
+	}
+	for _, a := range set.Allocations {
+		switch status[a.CostCenterCode] {
+		case "":
+			return nil, errors.Join(ErrInvalidAllocation, fmt.Errorf("cost center %s does not exist as of %s", a.CostCenterCode, set.EffectiveDate))
+		case StatusInactive:
+			return nil, errors.Join(ErrInvalidAllocation, fmt.Errorf("cost center %s is inactive as of %s", a.CostCenterCode, set.EffectiveDate))
+		}
+	}
+
+	set.CreatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.store.ReplaceAllocations(ctx, &set); err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{
+		"tenantId":      set.TenantID,
+		"positionCode":  set.PositionCode,
+		"effectiveDate": set.EffectiveDate,
+		"allocations":   len(set.Allocations),
+	}).Info("position cost allocations replaced")
+	return &set, nil
+}
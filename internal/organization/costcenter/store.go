@@ -0,0 +1,174 @@
+package costcenter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+)
+
+// SQLStore 基于 PostgreSQL 的成本中心存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建成本中心存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const costCenterColumns = `record_id, tenant_id, code, name, COALESCE(description, ''), status, COALESCE(parent_code, ''),
+	legal_entity_code, effective_date, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanCostCenter(row interface{ Scan(...any) error }) (*CostCenter, error) {
+	var (
+		c             CostCenter
+		effectiveDate time.Time
+	)
+	if err := row.Scan(&c.RecordID, &c.TenantID, &c.Code, &c.Name, &c.Description, &c.Status, &c.ParentCode,
+		&c.LegalEntityCode, &effectiveDate, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	c.EffectiveDate = effectiveDate.Format(dateLayout)
+	c.CreatedAt = c.CreatedAt.UTC()
+	c.UpdatedAt = c.UpdatedAt.UTC()
+	return &c, nil
+}
+
+// ListVersions 列出成本中心版本
+func (s *SQLStore) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]CostCenter, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+costCenterColumns+`
+	FROM cost_centers
+	WHERE tenant_id = $1 AND ($2 = '' OR code = $2)
+	ORDER BY code, effective_date`, tenantID, code)
+	if err != nil {
+		return nil, fmt.Errorf("list cost centers: %w", err)
+	}
+	centers, err := temporal.CollectRows(rows, scanCostCenter)
+	if err != nil {
+		return nil, fmt.Errorf("scan cost center: %w", err)
+	}
+	return centers, nil
+}
+
+// InsertVersion 写入成本中心版本
+func (s *SQLStore) InsertVersion(ctx context.Context, c *CostCenter) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO cost_centers
+		(record_id, tenant_id, code, name, description, status, parent_code, legal_entity_code, effective_date,
+		 created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), $8, $9, NULLIF($10, ''), $11, $12)`,
+		c.RecordID, c.TenantID, c.Code, c.Name, c.Description, c.Status, c.ParentCode, c.LegalEntityCode, c.EffectiveDate,
+		c.CreatedBy, c.CreatedAt, c.UpdatedAt,
+	)
+	if err != nil {
+		if temporal.IsVersionConflict(err) {
+			return ErrVersionExists
+		}
+		return fmt.Errorf("insert cost center: %w", err)
+	}
+	return nil
+}
+
+// UpdateVersion 原位更正成本中心版本
+func (s *SQLStore) UpdateVersion(ctx context.Context, c *CostCenter) error {
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE cost_centers
+	SET name = $3, description = NULLIF($4, ''), status = $5, parent_code = NULLIF($6, ''), legal_entity_code = $7, updated_at = $8
+	WHERE tenant_id = $1 AND record_id = $2`,
+		c.TenantID, c.RecordID, c.Name, c.Description, c.Status, c.ParentCode, c.LegalEntityCode, c.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update cost center: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// DeleteCostCenter 删除成本中心的全部版本
+func (s *SQLStore) DeleteCostCenter(ctx context.Context, tenantID uuid.UUID, code string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM cost_centers WHERE tenant_id = $1 AND code = $2`, tenantID, code)
+	if err != nil {
+		return fmt.Errorf("delete cost center: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// CountUsage 统计引用该成本中心的当前职位与分摊记录数
+func (s *SQLStore) CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `
+	SELECT
+		(SELECT COUNT(*) FROM positions
+		 WHERE tenant_id = $1 AND cost_center_code = $2 AND is_current = true AND deleted_at IS NULL)
+		+
+		(SELECT COUNT(*) FROM position_cost_allocations
+		 WHERE tenant_id = $1 AND cost_center_code = $2)`, tenantID, code).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count cost center usage: %w", err)
+	}
+	return count, nil
+}
+
+// IsLegalEntity 判断编码是否为当前有效的 COMPANY 组织单元
+func (s *SQLStore) IsLegalEntity(ctx context.Context, tenantID uuid.UUID, orgCode string) (bool, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `
+	SELECT EXISTS (
+		SELECT 1 FROM organization_units
+		WHERE tenant_id = $1 AND code = $2 AND unit_type = 'COMPANY' AND is_current = true AND status <> 'DELETED'
+	)`, tenantID, orgCode).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("lookup legal entity: %w", err)
+	}
+	return exists, nil
+}
+
+// PositionExists 判断职位是否存在且未删除
+func (s *SQLStore) PositionExists(ctx context.Context, tenantID uuid.UUID, positionCode string) (bool, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `
+	SELECT EXISTS (
+		SELECT 1 FROM positions
+		WHERE tenant_id = $1 AND code = $2 AND is_current = true AND deleted_at IS NULL
+	)`, tenantID, positionCode).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("lookup position: %w", err)
+	}
+	return exists, nil
+}
+
+// ReplaceAllocations 在事务内替换职位同一生效日期的分摊方案
+func (s *SQLStore) ReplaceAllocations(ctx context.Context, set *AllocationSet) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin cost allocation tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM position_cost_allocations
+	WHERE tenant_id = $1 AND position_code = $2 AND effective_date = $3`,
+		set.TenantID, set.PositionCode, set.EffectiveDate,
+	); err != nil {
+		return fmt.Errorf("clear cost allocations: %w", err)
+	}
+	for _, a := range set.Allocations {
+		if _, err := tx.ExecContext(ctx, `
+		INSERT INTO position_cost_allocations
+			(id, tenant_id, position_code, cost_center_code, percentage, effective_date, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8)`,
+			uuid.New(), set.TenantID, set.PositionCode, a.CostCenterCode, a.Percentage, set.EffectiveDate,
+			set.CreatedBy, set.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("insert cost allocation: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit cost allocations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+// Package customfield 实现租户自定义字段：字段定义管理，以及按定义校验、规范化组织与职位上的字段值。
+package customfield
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// CodeUnknown 字段未定义或已停用
+	CodeUnknown = "CUSTOM_FIELD_UNKNOWN"
+	// CodeNotApplicable 字段不适用于当前单元/职位类型
+	CodeNotApplicable = "CUSTOM_FIELD_NOT_APPLICABLE"
+	// CodeTypeMismatch 字段值类型与定义不符
+	CodeTypeMismatch = "CUSTOM_FIELD_TYPE_MISMATCH"
+	// CodeInvalid 字段值未通过定义中的校验
+	CodeInvalid = "CUSTOM_FIELD_INVALID"
+	// CodeRequired 必填字段缺失或被清空
+	CodeRequired = "CUSTOM_FIELD_REQUIRED"
+
+	dateLayout = "2006-01-02"
+)
+
+var (
+	// ErrInvalidDefinition 字段定义参数不合法
+	ErrInvalidDefinition = errors.New("invalid custom field definition")
+	// ErrDefinitionNotFound 字段定义不存在
+	ErrDefinitionNotFound = errors.New("custom field definition not found")
+	// ErrDuplicateKey 同一租户、实体下字段键重复
+	ErrDuplicateKey = errors.New("custom field key already exists")
+
+	keyPattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]{0,62}$`)
+)
+
+// Validation 字段值校验规则，按数据类型选择生效项
+type Validation struct {
+	Pattern   string   `json:"pattern,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Options   []string `json:"options,omitempty"`
+}
+
+// Definition 租户自定义字段定义
+type Definition struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    uuid.UUID `json:"tenantId"`
+	EntityType  string    `json:"entityType"`
+	Key         string    `json:"key"`
+	Label       string    `json:"label"`
+	Description string    `json:"description,omitempty"`
+	DataType    string    `json:"dataType"`
+	Required    bool      `json:"required"`
+	// AppliesTo 适用的 UnitType（组织）或 PositionType（职位），为空表示全部适用
+	AppliesTo  []string   `json:"appliesTo"`
+	Validation Validation `json:"validation"`
+	Active     bool       `json:"active"`
+	CreatedBy  string     `json:"createdBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// Validate 校验字段定义
+func (d Definition) Validate() error {
+	switch d.EntityType {
+	case types.CustomFieldEntityOrganization, types.CustomFieldEntityPosition:
+	default:
+		return errors.Join(ErrInvalidDefinition, fmt.Errorf("unsupported entityType %q", d.EntityType))
+	}
+	if !keyPattern.MatchString(d.Key) {
+		return errors.Join(ErrInvalidDefinition, errors.New("key must start with a lowercase letter and contain only letters, digits or underscores (max 63)"))
+	}
+	if strings.TrimSpace(d.Label) == "" {
+		return errors.Join(ErrInvalidDefinition, errors.New("label is required"))
+	}
+	v := d.Validation
+	switch d.DataType {
+	case types.CustomFieldTypeString:
+		if v.Pattern != "" {
+			if _, err := regexp.Compile(v.Pattern); err != nil {
+				return errors.Join(ErrInvalidDefinition, fmt.Errorf("invalid pattern: %w", err))
+			}
+		}
+		if (v.MinLength != nil && *v.MinLength < 0) || (v.MaxLength != nil && *v.MaxLength < 0) ||
+			(v.MinLength != nil && v.MaxLength != nil && *v.MinLength > *v.MaxLength) {
+			return errors.Join(ErrInvalidDefinition, errors.New("invalid minLength/maxLength"))
+		}
+	case types.CustomFieldTypeNumber:
+		if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+			return errors.Join(ErrInvalidDefinition, errors.New("min must not exceed max"))
+		}
+	case types.CustomFieldTypeEnum:
+		if len(v.Options) == 0 {
+			return errors.Join(ErrInvalidDefinition, errors.New("ENUM fields require validation.options"))
+		}
+		for i, option := range v.Options {
+			if strings.TrimSpace(option) == "" || slices.Contains(v.Options[:i], option) {
+				return errors.Join(ErrInvalidDefinition, fmt.Errorf("invalid or duplicate option %q", option))
+			}
+		}
+	case types.CustomFieldTypeBoolean, types.CustomFieldTypeDate:
+	default:
+		return errors.Join(ErrInvalidDefinition, fmt.Errorf("unsupported dataType %q", d.DataType))
+	}
+	return nil
+}
+
+// AppliesToSubtype 判断字段是否适用于给定单元/职位类型
+func (d Definition) AppliesToSubtype(subtype string) bool {
+	return len(d.AppliesTo) == 0 || slices.Contains(d.AppliesTo, subtype)
+}
+
+// Violation 字段值校验失败项
+type Violation struct {
+	Key     string
+	Code    string
+	Message string
+	Value   interface{}
+}
+
+// DefinitionSource 按租户与实体类型提供生效中的字段定义
+type DefinitionSource interface {
+	ListDefinitions(ctx context.Context, tenantID uuid.UUID, entityType string) ([]Definition, error)
+}
+
+// Normalize 按定义校验 patch 中的字段值，并就地写入数据类型与规范化后的值；值为 nil 的项表示移除，不做校验。
+func Normalize(defs []Definition, subtype string, patch types.CustomFieldValues) []Violation {
+	var violations []Violation
+	for _, key := range sortedKeys(patch) {
+		value := patch[key]
+		if value == nil {
+			continue
+		}
+		def := findDefinition(defs, key)
+		if def == nil {
+			violations = append(violations, Violation{Key: key, Code: CodeUnknown, Message: fmt.Sprintf("custom field %q is not defined", key), Value: value.Value})
+			continue
+		}
+		if !def.AppliesToSubtype(subtype) {
+			violations = append(violations, Violation{Key: key, Code: CodeNotApplicable, Message: fmt.Sprintf("custom field %q does not apply to type %s", key, subtype), Value: value.Value})
+			continue
+		}
+		if value.Type != "" && value.Type != def.DataType {
+			violations = append(violations, Violation{Key: key, Code: CodeTypeMismatch, Message: fmt.Sprintf("custom field %q expects %s", key, def.DataType), Value: value.Value})
+			continue
+		}
+		normalized, violation := normalizeValue(*def, value.Value)
+		if violation != nil {
+			violations = append(violations, *violation)
+			continue
+		}
+		value.Type = def.DataType
+		value.Value = normalized
+	}
+	return violations
+}
+
+// MissingRequired 返回 values 中缺失的必填字段（仅检查适用于 subtype 的定义）
+func MissingRequired(defs []Definition, subtype string, values types.CustomFieldValues) []Violation {
+	var violations []Violation
+	for _, def := range defs {
+		if !def.Required || !def.AppliesToSubtype(subtype) {
+			continue
+		}
+		if value, ok := values[def.Key]; !ok || value == nil {
+			violations = append(violations, Violation{Key: def.Key, Code: CodeRequired, Message: fmt.Sprintf("custom field %q is required", def.Key)})
+		}
+	}
+	return violations
+}
+
+// ClearedRequired 返回 patch 中被置空的必填字段
+func ClearedRequired(defs []Definition, subtype string, patch types.CustomFieldValues) []Violation {
+	var violations []Violation
+	for _, key := range sortedKeys(patch) {
+		if patch[key] != nil {
+			continue
+		}
+		if def := findDefinition(defs, key); def != nil && def.Required && def.AppliesToSubtype(subtype) {
+			violations = append(violations, Violation{Key: key, Code: CodeRequired, Message: fmt.Sprintf("custom field %q is required and cannot be removed", key)})
+		}
+	}
+	return violations
+}
+
+func findDefinition(defs []Definition, key string) *Definition {
+	for i := range defs {
+		if defs[i].Key == key {
+			return &defs[i]
+		}
+	}
+	return nil
+}
+
+func sortedKeys(values types.CustomFieldValues) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func normalizeValue(def Definition, raw interface{}) (interface{}, *Violation) {
+	invalid := func(code, format string, args ...interface{}) (interface{}, *Violation) {
+		return nil, &Violation{Key: def.Key, Code: code, Message: fmt.Sprintf(format, args...), Value: raw}
+	}
+	v := def.Validation
+	switch def.DataType {
+	case types.CustomFieldTypeString, types.CustomFieldTypeEnum:
+		s, ok := raw.(string)
+		if !ok {
+			return invalid(CodeTypeMismatch, "custom field %q expects a string", def.Key)
+		}
+		s = strings.TrimSpace(s)
+		if def.DataType == types.CustomFieldTypeEnum {
+			if !slices.Contains(v.Options, s) {
+				return invalid(CodeInvalid, "custom field %q must be one of %s", def.Key, strings.Join(v.Options, ", "))
+			}
+			return s, nil
+		}
+		length := utf8.RuneCountInString(s)
+		if v.MinLength != nil && length < *v.MinLength {
+			return invalid(CodeInvalid, "custom field %q must be at least %d characters", def.Key, *v.MinLength)
+		}
+		if v.MaxLength != nil && length > *v.MaxLength {
+			return invalid(CodeInvalid, "custom field %q must be at most %d characters", def.Key, *v.MaxLength)
+		}
+		if v.Pattern != "" {
+			if re, err := regexp.Compile(v.Pattern); err == nil && !re.MatchString(s) {
+				return invalid(CodeInvalid, "custom field %q does not match the required format", def.Key)
+			}
+		}
+		return s, nil
+	case types.CustomFieldTypeNumber:
+		var n float64
+		switch value := raw.(type) {
+		case float64:
+			n = value
+		case int:
+			n = float64(value)
+		case int64:
+			n = float64(value)
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return invalid(CodeTypeMismatch, "custom field %q expects a number", def.Key)
+			}
+			n = parsed
+		default:
+			return invalid(CodeTypeMismatch, "custom field %q expects a number", def.Key)
+		}
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return invalid(CodeTypeMismatch, "custom field %q expects a finite number", def.Key)
+		}
+		if v.Min != nil && n < *v.Min {
+			return invalid(CodeInvalid, "custom field %q must be >= %v", def.Key, *v.Min)
+		}
+		if v.Max != nil && n > *v.Max {
+			return invalid(CodeInvalid, "custom field %q must be <= %v", def.Key, *v.Max)
+		}
+		return n, nil
+	case types.CustomFieldTypeBoolean:
+		b, ok := raw.(bool)
+		if !ok {
+			return invalid(CodeTypeMismatch, "custom field %q expects a boolean", def.Key)
+		}
+		return b, nil
+	case types.CustomFieldTypeDate:
+		s, ok := raw.(string)
+		if !ok {
+			return invalid(CodeTypeMismatch, "custom field %q expects a date (YYYY-MM-DD)", def.Key)
+		}
+		if _, err := time.Parse(dateLayout, strings.TrimSpace(s)); err != nil {
+			return invalid(CodeTypeMismatch, "custom field %q expects a date (YYYY-MM-DD)", def.Key)
+		}
+		return strings.TrimSpace(s), nil
+	}
+	return invalid(CodeInvalid, "custom field %q has unsupported type %s", def.Key, def.DataType)
+}
+
+// Store 字段定义存储
+type Store interface {
+	ListDefinitions(ctx context.Context, tenantID uuid.UUID, entityType string, activeOnly bool) ([]Definition, error)
+	GetDefinition(ctx context.Context, tenantID, id uuid.UUID) (*Definition, error)
+	// SaveDefinition 创建或更新定义；键冲突时返回 ErrDuplicateKey
+	SaveDefinition(ctx context.Context, def *Definition) error
+	DeleteDefinition(ctx context.Context, tenantID, id uuid.UUID) error
+}
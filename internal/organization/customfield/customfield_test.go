@@ -0,0 +1,134 @@
+package customfield
+
+import (
+	"errors"
+	"testing"
+
+	"cube-castle/internal/types"
+)
+
+func intPtr(v int) *int { return &v }
+
+func floatPtr(v float64) *float64 { return &v }
+
+func testDefinitions() []Definition {
+	return []Definition{
+		{Key: "taxId", DataType: types.CustomFieldTypeString, Required: true, AppliesTo: []string{"COMPANY"},
+			Validation: Validation{Pattern: `^[0-9A-Z]{18}$`}},
+		{Key: "headcountBudget", DataType: types.CustomFieldTypeNumber, Validation: Validation{Min: floatPtr(0), Max: floatPtr(500)}},
+		{Key: "remote", DataType: types.CustomFieldTypeBoolean},
+		{Key: "openedOn", DataType: types.CustomFieldTypeDate},
+		{Key: "tier", DataType: types.CustomFieldTypeEnum, Validation: Validation{Options: []string{"A", "B"}}},
+		{Key: "nickname", DataType: types.CustomFieldTypeString, Validation: Validation{MaxLength: intPtr(4)}},
+	}
+}
+
+func TestNormalizeAssignsTypesAndNormalizesValues(t *testing.T) {
+	patch := types.CustomFieldValues{
+		"taxId":           {Value: "91310000MA1K000000"},
+		"headcountBudget": {Value: "12.5"},
+		"remote":          {Value: true},
+		"openedOn":        {Value: " 2025-01-01 "},
+		"tier":            {Type: types.CustomFieldTypeEnum, Value: "B"},
+		"nickname":        nil,
+	}
+
+	if violations := Normalize(testDefinitions(), "COMPANY", patch); len(violations) != 0 {
+		t.Fatalf("unexpected violations: %#v", violations)
+	}
+	if got := patch["headcountBudget"]; got.Type != types.CustomFieldTypeNumber || got.Value != 12.5 {
+		t.Fatalf("number not normalized: %#v", got)
+	}
+	if got := patch["openedOn"]; got.Type != types.CustomFieldTypeDate || got.Value != "2025-01-01" {
+		t.Fatalf("date not normalized: %#v", got)
+	}
+	if patch["nickname"] != nil {
+		t.Fatalf("removal marker must be preserved")
+	}
+}
+
+func TestNormalizeReportsViolations(t *testing.T) {
+	patch := types.CustomFieldValues{
+		"taxId":           {Value: "not-a-tax-id"},
+		"headcountBudget": {Value: 501.0},
+		"remote":          {Value: "yes"},
+		"openedOn":        {Value: "2025/01/01"},
+		"tier":            {Value: "C"},
+		"nickname":        {Type: types.CustomFieldTypeNumber, Value: 1.0},
+		"unknown":         {Value: "x"},
+	}
+
+	violations := Normalize(testDefinitions(), "DEPARTMENT", patch)
+	codes := map[string]string{}
+	for _, v := range violations {
+		codes[v.Key] = v.Code
+	}
+	expected := map[string]string{
+		"taxId":           CodeNotApplicable,
+		"headcountBudget": CodeInvalid,
+		"remote":          CodeTypeMismatch,
+		"openedOn":        CodeTypeMismatch,
+		"tier":            CodeInvalid,
+		"nickname":        CodeTypeMismatch,
+		"unknown":         CodeUnknown,
+	}
+	for key, code := range expected {
+		if codes[key] != code {
+			t.Fatalf("expected %s for %s, got %q (all: %#v)", code, key, codes[key], violations)
+		}
+	}
+}
+
+func TestRequiredChecks(t *testing.T) {
+	defs := testDefinitions()
+
+	if missing := MissingRequired(defs, "COMPANY", types.CustomFieldValues{}); len(missing) != 1 || missing[0].Key != "taxId" {
+		t.Fatalf("expected taxId to be required for COMPANY, got %#v", missing)
+	}
+	if missing := MissingRequired(defs, "DEPARTMENT", types.CustomFieldValues{}); len(missing) != 0 {
+		t.Fatalf("taxId must not be required outside COMPANY, got %#v", missing)
+	}
+	cleared := ClearedRequired(defs, "COMPANY", types.CustomFieldValues{"taxId": nil, "remote": nil})
+	if len(cleared) != 1 || cleared[0].Code != CodeRequired {
+		t.Fatalf("expected clearing taxId to be rejected, got %#v", cleared)
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	valid := Definition{EntityType: types.CustomFieldEntityPosition, Key: "location", Label: "Location", DataType: types.CustomFieldTypeString}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected valid definition: %v", err)
+	}
+
+	cases := map[string]func(d *Definition){
+		"entityType":   func(d *Definition) { d.EntityType = "ASSIGNMENT" },
+		"key":          func(d *Definition) { d.Key = "Location" },
+		"label":        func(d *Definition) { d.Label = " " },
+		"dataType":     func(d *Definition) { d.DataType = "JSON" },
+		"pattern":      func(d *Definition) { d.Validation.Pattern = "(" },
+		"lengthBounds": func(d *Definition) { d.Validation.MinLength, d.Validation.MaxLength = intPtr(5), intPtr(2) },
+		"enumOptions":  func(d *Definition) { d.DataType = types.CustomFieldTypeEnum },
+		"duplicateOpt": func(d *Definition) { d.DataType, d.Validation.Options = types.CustomFieldTypeEnum, []string{"A", "A"} },
+	}
+	for name, mutate := range cases {
+		d := valid
+		mutate(&d)
+		if err := d.Validate(); !errors.Is(err, ErrInvalidDefinition) {
+			t.Fatalf("%s: expected ErrInvalidDefinition, got %v", name, err)
+		}
+	}
+}
+
+func TestCustomFieldValuesMergeAndScan(t *testing.T) {
+	var stored types.CustomFieldValues
+	if err := stored.Scan([]byte(`{"remote":{"type":"BOOLEAN","value":true},"tier":{"type":"ENUM","value":"A"}}`)); err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	merged := stored.Merge(types.CustomFieldValues{"remote": nil, "tier": {Type: types.CustomFieldTypeEnum, Value: "B"}})
+	if _, ok := merged["remote"]; ok || merged["tier"].Value != "B" {
+		t.Fatalf("unexpected merge result: %#v", merged)
+	}
+	if stored["tier"].Value != "A" {
+		t.Fatalf("merge must not modify the receiver")
+	}
+}
@@ -0,0 +1,105 @@
+package customfield
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 字段定义管理，同时作为校验链的定义来源
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建自定义字段服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "customField",
+			"module":    "command",
+		}),
+	}
+}
+
+// ListDefinitions 返回生效中的字段定义（供校验链使用）
+func (s *Service) ListDefinitions(ctx context.Context, tenantID uuid.UUID, entityType string) ([]Definition, error) {
+	return s.store.ListDefinitions(ctx, tenantID, entityType, true)
+}
+
+// ListAllDefinitions 返回全部字段定义（含已停用），entityType 为空时不过滤
+func (s *Service) ListAllDefinitions(ctx context.Context, tenantID uuid.UUID, entityType string) ([]Definition, error) {
+	return s.store.ListDefinitions(ctx, tenantID, entityType, false)
+}
+
+// GetDefinition 读取单个字段定义
+func (s *Service) GetDefinition(ctx context.Context, tenantID, id uuid.UUID) (*Definition, error) {
+	return s.store.GetDefinition(ctx, tenantID, id)
+}
+
+// CreateDefinition 校验并创建字段定义
+func (s *Service) CreateDefinition(ctx context.Context, d Definition) (*Definition, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	d.ID = uuid.New()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	if d.AppliesTo == nil {
+		d.AppliesTo = []string{}
+	}
+	if err := s.store.SaveDefinition(ctx, &d); err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{
+		"tenantId":   d.TenantID,
+		"entityType": d.EntityType,
+		"fieldKey":   d.Key,
+	}).Info("custom field definition created")
+	return &d, nil
+}
+
+// UpdateDefinition 更新标签、描述、必填、适用类型、校验与启用状态；实体类型、键与数据类型不可变更，
+// 以保证各历史版本中已存储的值仍可按原类型解释
+func (s *Service) UpdateDefinition(ctx context.Context, d Definition) (*Definition, error) {
+	existing, err := s.store.GetDefinition(ctx, d.TenantID, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	if (d.EntityType != "" && d.EntityType != existing.EntityType) ||
+		(d.Key != "" && d.Key != existing.Key) ||
+		(d.DataType != "" && d.DataType != existing.DataType) {
+		return nil, errors.Join(ErrInvalidDefinition, errors.New("entityType, key and dataType cannot be changed"))
+	}
+	d.EntityType = existing.EntityType
+	d.Key = existing.Key
+	d.DataType = existing.DataType
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	d.CreatedBy = existing.CreatedBy
+	d.CreatedAt = existing.CreatedAt
+	d.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if d.AppliesTo == nil {
+		d.AppliesTo = []string{}
+	}
+	if err := s.store.SaveDefinition(ctx, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeleteDefinition 删除字段定义
+func (s *Service) DeleteDefinition(ctx context.Context, tenantID, id uuid.UUID) error {
+	return s.store.DeleteDefinition(ctx, tenantID, id)
+}
@@ -0,0 +1,132 @@
+package customfield
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的字段定义存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建字段定义存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const definitionColumns = `id, tenant_id, entity_type, field_key, label, COALESCE(description, ''), data_type,
+	required, applies_to, validation, is_active, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanDefinition(row interface{ Scan(...any) error }) (*Definition, error) {
+	var (
+		d          Definition
+		validation []byte
+	)
+	if err := row.Scan(&d.ID, &d.TenantID, &d.EntityType, &d.Key, &d.Label, &d.Description, &d.DataType,
+		&d.Required, pq.Array(&d.AppliesTo), &validation, &d.Active, &d.CreatedBy, &d.CreatedAt, &d.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if len(validation) > 0 {
+		if err := json.Unmarshal(validation, &d.Validation); err != nil {
+			return nil, fmt.Errorf("decode custom field validation: %w", err)
+		}
+	}
+	if d.AppliesTo == nil {
+		d.AppliesTo = []string{}
+	}
+	d.CreatedAt = d.CreatedAt.UTC()
+	d.UpdatedAt = d.UpdatedAt.UTC()
+	return &d, nil
+}
+
+// ListDefinitions 列出租户字段定义；entityType 为空时返回全部实体
+func (s *SQLStore) ListDefinitions(ctx context.Context, tenantID uuid.UUID, entityType string, activeOnly bool) ([]Definition, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+definitionColumns+`
+	FROM custom_field_definitions
+	WHERE tenant_id = $1 AND ($2 = '' OR entity_type = $2) AND (NOT $3 OR is_active)
+	ORDER BY entity_type, field_key`, tenantID, entityType, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("list custom field definitions: %w", err)
+	}
+	defer rows.Close()
+	var defs []Definition
+	for rows.Next() {
+		d, err := scanDefinition(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan custom field definition: %w", err)
+		}
+		defs = append(defs, *d)
+	}
+	return defs, rows.Err()
+}
+
+// GetDefinition 读取单个字段定义
+func (s *SQLStore) GetDefinition(ctx context.Context, tenantID, id uuid.UUID) (*Definition, error) {
+	d, err := scanDefinition(s.db.QueryRowContext(ctx, `
+	SELECT `+definitionColumns+`
+	FROM custom_field_definitions WHERE tenant_id = $1 AND id = $2`, tenantID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDefinitionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load custom field definition: %w", err)
+	}
+	return d, nil
+}
+
+// SaveDefinition 创建或更新字段定义
+func (s *SQLStore) SaveDefinition(ctx context.Context, d *Definition) error {
+	validation, err := json.Marshal(d.Validation)
+	if err != nil {
+		return fmt.Errorf("encode custom field validation: %w", err)
+	}
+	appliesTo := d.AppliesTo
+	if appliesTo == nil {
+		appliesTo = []string{}
+	}
+	_, err = s.db.ExecContext(ctx, `
+	INSERT INTO custom_field_definitions
+		(id, tenant_id, entity_type, field_key, label, description, data_type, required, applies_to,
+		 validation, is_active, created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, $10, $11, NULLIF($12, ''), $13, $14)
+	ON CONFLICT (id) DO UPDATE SET
+		label = EXCLUDED.label,
+		description = EXCLUDED.description,
+		required = EXCLUDED.required,
+		applies_to = EXCLUDED.applies_to,
+		validation = EXCLUDED.validation,
+		is_active = EXCLUDED.is_active,
+		updated_at = EXCLUDED.updated_at
+	WHERE custom_field_definitions.tenant_id = EXCLUDED.tenant_id`,
+		d.ID, d.TenantID, d.EntityType, d.Key, d.Label, d.Description, d.DataType, d.Required, pq.Array(appliesTo),
+		validation, d.Active, d.CreatedBy, d.CreatedAt, d.UpdatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("save custom field definition: %w", err)
+	}
+	return nil
+}
+
+// DeleteDefinition 删除字段定义（已写入记录的历史值保留在各版本中）
+func (s *SQLStore) DeleteDefinition(ctx context.Context, tenantID, id uuid.UUID) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM custom_field_definitions WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		return fmt.Errorf("delete custom field definition: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrDefinitionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PayRange GraphQL PayRange，某币种与地区下的薪酬区间
+type PayRange struct {
+	CurrencyField string  `json:"currency"`
+	RegionField   string  `json:"region"`
+	MinField      float64 `json:"min"`
+	MidField      float64 `json:"mid"`
+	MaxField      float64 `json:"max"`
+}
+
+func (p PayRange) Currency() string { return p.CurrencyField }
+func (p PayRange) Region() string   { return p.RegionField }
+func (p PayRange) Min() float64     { return p.MinField }
+func (p PayRange) Mid() float64     { return p.MidField }
+func (p PayRange) Max() float64     { return p.MaxField }
+
+// PayRangeList 由 pay_ranges JSONB 扫描得到的薪酬区间列表
+type PayRangeList []PayRange
+
+// MarshalJSON 空集合输出为 []，满足 GraphQL 非空列表约束
+func (l PayRangeList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]PayRange(l))
+}
+
+// Scan 实现 sql.Scanner，解析 [{currency, region, min, mid, max}] 结构
+func (l *PayRangeList) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case nil:
+		*l = PayRangeList{}
+		return nil
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return fmt.Errorf("unsupported pay_ranges type %T", src)
+	}
+	var ranges []PayRange
+	if err := json.Unmarshal(data, &ranges); err != nil {
+		return fmt.Errorf("decode pay_ranges: %w", err)
+	}
+	*l = ranges
+	return nil
+}
+
+// CompensationGrade 职等版本
+type CompensationGrade struct {
+	RecordIDField      string       `json:"recordId" db:"record_id"`
+	CodeField          string       `json:"code" db:"grade_code"`
+	NameField          string       `json:"name" db:"name"`
+	DescriptionField   *string      `json:"description" db:"description"`
+	StatusField        string       `json:"status" db:"status"`
+	JobLevelCodesField []string     `json:"jobLevelCodes" db:"job_level_codes"`
+	PayRangesField     PayRangeList `json:"payRanges" db:"pay_ranges"`
+	EffectiveDateField Date         `json:"effectiveDate" db:"effective_date"`
+	EndDateField       *Date        `json:"endDate" db:"end_date"`
+	IsCurrentField     bool         `json:"isCurrent"`
+}
+
+func (g CompensationGrade) RecordId() UUID        { return UUID(g.RecordIDField) }
+func (g CompensationGrade) Code() string          { return g.CodeField }
+func (g CompensationGrade) Name() string          { return g.NameField }
+func (g CompensationGrade) Description() *string  { return g.DescriptionField }
+func (g CompensationGrade) Status() string        { return g.StatusField }
+func (g CompensationGrade) EffectiveDate() Date   { return g.EffectiveDateField }
+func (g CompensationGrade) EndDate() *Date        { return g.EndDateField }
+func (g CompensationGrade) IsCurrent() bool       { return g.IsCurrentField }
+func (g CompensationGrade) PayRanges() []PayRange { return g.PayRangesField }
+func (g CompensationGrade) JobLevelCodes() []JobLevelCode {
+	codes := make([]JobLevelCode, 0, len(g.JobLevelCodesField))
+	for _, code := range g.JobLevelCodesField {
+		codes = append(codes, JobLevelCode(code))
+	}
+	return codes
+}
+
+// CompensationGradeFilter 职等查询条件
+type CompensationGradeFilter struct {
+	// JobLevelCode 仅返回允许该职级使用的职等
+	JobLevelCode *string
+	// GradeCode 仅返回指定编码的职等
+	GradeCode       *string
+	IncludeInactive bool
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate *string
+}
@@ -0,0 +1,69 @@
+package dto
+
+// CostCenter 成本中心版本
+type CostCenter struct {
+	RecordIDField        string  `json:"recordId" db:"record_id"`
+	CodeField            string  `json:"code" db:"code"`
+	NameField            string  `json:"name" db:"name"`
+	DescriptionField     *string `json:"description" db:"description"`
+	StatusField          string  `json:"status" db:"status"`
+	ParentCodeField      *string `json:"parentCode" db:"parent_code"`
+	LegalEntityCodeField string  `json:"legalEntityCode" db:"legal_entity_code"`
+	EffectiveDateField   Date    `json:"effectiveDate" db:"effective_date"`
+	EndDateField         *Date   `json:"endDate" db:"end_date"`
+	IsCurrentField       bool    `json:"isCurrent"`
+}
+
+func (c CostCenter) RecordId() UUID          { return UUID(c.RecordIDField) }
+func (c CostCenter) Code() string            { return c.CodeField }
+func (c CostCenter) Name() string            { return c.NameField }
+func (c CostCenter) Description() *string    { return c.DescriptionField }
+func (c CostCenter) Status() string          { return c.StatusField }
+func (c CostCenter) ParentCode() *string     { return c.ParentCodeField }
+func (c CostCenter) LegalEntityCode() string { return c.LegalEntityCodeField }
+func (c CostCenter) EffectiveDate() Date     { return c.EffectiveDateField }
+func (c CostCenter) EndDate() *Date          { return c.EndDateField }
+func (c CostCenter) IsCurrent() bool         { return c.IsCurrentField }
+
+// CostCenterFilter 成本中心查询条件
+type CostCenterFilter struct {
+	LegalEntityCode *string
+	ParentCode      *string
+	IncludeInactive bool
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate *string
+}
+
+// CostAllocation 职位成本分摊到某成本中心的比例；未设置分摊时由职位 costCenterCode 承担 100%
+type CostAllocation struct {
+	CostCenterCodeField string  `json:"costCenterCode"`
+	CostCenterNameField *string `json:"costCenterName"`
+	PercentageField     float64 `json:"percentage"`
+	EffectiveDateField  *Date   `json:"effectiveDate"`
+}
+
+func (a CostAllocation) CostCenterCode() string  { return a.CostCenterCodeField }
+func (a CostAllocation) CostCenterName() *string { return a.CostCenterNameField }
+func (a CostAllocation) Percentage() float64     { return a.PercentageField }
+func (a CostAllocation) EffectiveDate() *Date    { return a.EffectiveDateField }
+
+// CostCenterHeadcount 按成本中心汇总的编制与 FTE，按分摊比例折算
+type CostCenterHeadcount struct {
+	CostCenterCodeField     string  `json:"costCenterCode"`
+	CostCenterNameField     *string `json:"costCenterName"`
+	LegalEntityCodeField    *string `json:"legalEntityCode"`
+	PositionCountField      int     `json:"positionCount"`
+	HeadcountCapacityField  float64 `json:"headcountCapacity"`
+	HeadcountInUseField     float64 `json:"headcountInUse"`
+	AssignedFteField        float64 `json:"assignedFte"`
+	AvailableHeadcountField float64 `json:"availableHeadcount"`
+}
+
+func (h CostCenterHeadcount) CostCenterCode() string      { return h.CostCenterCodeField }
+func (h CostCenterHeadcount) CostCenterName() *string     { return h.CostCenterNameField }
+func (h CostCenterHeadcount) LegalEntityCode() *string    { return h.LegalEntityCodeField }
+func (h CostCenterHeadcount) PositionCount() int32        { return clampToInt32(h.PositionCountField) }
+func (h CostCenterHeadcount) HeadcountCapacity() float64  { return h.HeadcountCapacityField }
+func (h CostCenterHeadcount) HeadcountInUse() float64     { return h.HeadcountInUseField }
+func (h CostCenterHeadcount) AssignedFte() float64        { return h.AssignedFteField }
+func (h CostCenterHeadcount) AvailableHeadcount() float64 { return h.AvailableHeadcountField }
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CustomFieldEntry GraphQL CustomFieldValue，按数据类型填充对应的取值字段
+type CustomFieldEntry struct {
+	KeyField          string   `json:"key"`
+	TypeField         string   `json:"type"`
+	StringValueField  *string  `json:"stringValue"`
+	NumberValueField  *float64 `json:"numberValue"`
+	BooleanValueField *bool    `json:"booleanValue"`
+	DateValueField    *Date    `json:"dateValue"`
+}
+
+func (e CustomFieldEntry) Key() string           { return e.KeyField }
+func (e CustomFieldEntry) Type() string          { return e.TypeField }
+func (e CustomFieldEntry) StringValue() *string  { return e.StringValueField }
+func (e CustomFieldEntry) NumberValue() *float64 { return e.NumberValueField }
+func (e CustomFieldEntry) BooleanValue() *bool   { return e.BooleanValueField }
+func (e CustomFieldEntry) DateValue() *Date      { return e.DateValueField }
+
+// CustomFieldList 由 custom_fields JSONB 扫描得到的字段列表，按键排序
+type CustomFieldList []CustomFieldEntry
+
+// MarshalJSON 空集合输出为 []，满足 GraphQL 非空列表约束
+func (l CustomFieldList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]CustomFieldEntry(l))
+}
+
+// Scan 实现 sql.Scanner，解析 {key: {type, value}} 结构
+func (l *CustomFieldList) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case nil:
+		*l = CustomFieldList{}
+		return nil
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return fmt.Errorf("unsupported custom_fields type %T", src)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		*l = CustomFieldList{}
+		return nil
+	}
+
+	var raw map[string]struct {
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("decode custom_fields: %w", err)
+	}
+
+	entries := make(CustomFieldList, 0, len(raw))
+	for key, item := range raw {
+		if item.Value == nil {
+			continue
+		}
+		entry := CustomFieldEntry{KeyField: key, TypeField: strings.ToUpper(item.Type)}
+		switch v := item.Value.(type) {
+		case bool:
+			entry.BooleanValueField = &v
+		case float64:
+			entry.NumberValueField = &v
+		case string:
+			if entry.TypeField == "DATE" {
+				date := Date(v)
+				entry.DateValueField = &date
+			} else {
+				entry.StringValueField = &v
+			}
+		default:
+			text := fmt.Sprint(v)
+			entry.StringValueField = &text
+		}
+		if entry.TypeField == "" {
+			entry.TypeField = inferCustomFieldType(item.Value)
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].KeyField < entries[j].KeyField })
+	*l = entries
+	return nil
+}
+
+func inferCustomFieldType(value interface{}) string {
+	switch value.(type) {
+	case bool:
+		return "BOOLEAN"
+	case float64:
+		return "NUMBER"
+	default:
+		return "STRING"
+	}
+}
+
+// 自定义字段过滤运算符
+const (
+	CustomFieldOpEquals      = "EQ"
+	CustomFieldOpNotEquals   = "NE"
+	CustomFieldOpContains    = "CONTAINS"
+	CustomFieldOpGreaterThan = "GT"
+	CustomFieldOpGreaterOrEq = "GTE"
+	CustomFieldOpLessThan    = "LT"
+	CustomFieldOpLessOrEq    = "LTE"
+	CustomFieldOpExists      = "EXISTS"
+)
+
+// CustomFieldFilter 按自定义字段过滤；Value 以字符串传入，比较运算在其为数值时按数值比较
+type CustomFieldFilter struct {
+	Key      string  `json:"key"`
+	Operator string  `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+// IsNumeric 判断过滤值是否可按数值比较
+func (f CustomFieldFilter) IsNumeric() bool {
+	if f.Value == nil {
+		return false
+	}
+	_, err := strconv.ParseFloat(strings.TrimSpace(*f.Value), 64)
+	return err == nil
+}
+
+func asCustomFieldFilters(value interface{}) ([]CustomFieldFilter, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("期望对象数组，实际得到 %T", value)
+	}
+	filters := make([]CustomFieldFilter, 0, len(items))
+	for idx, item := range items {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[%d]: 期望对象类型，实际得到 %T", idx, item)
+		}
+		key, err := asRequiredString(raw, "key")
+		if err != nil {
+			return nil, fmt.Errorf("[%d].key: %w", idx, err)
+		}
+		operator := CustomFieldOpEquals
+		if op, err := asOptionalString(raw["operator"]); err != nil {
+			return nil, fmt.Errorf("[%d].operator: %w", idx, err)
+		} else if op != nil {
+			operator = strings.ToUpper(*op)
+		}
+		filterValue, err := asOptionalString(raw["value"])
+		if err != nil {
+			return nil, fmt.Errorf("[%d].value: %w", idx, err)
+		}
+		filters = append(filters, CustomFieldFilter{Key: key, Operator: operator, Value: filterValue})
+	}
+	return filters, nil
+}
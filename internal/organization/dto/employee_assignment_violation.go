@@ -0,0 +1,24 @@
+package dto
+
+// EmployeeAssignmentViolation 员工跨职位任职约束的现存违规（FTE 合计超限、多个 PRIMARY、同组织 ACTING 重叠）
+type EmployeeAssignmentViolation struct {
+	RuleField             string   `json:"rule"`
+	EmployeeIDField       string   `json:"employeeId"`
+	EmployeeNameField     string   `json:"employeeName"`
+	OrganizationCodeField *string  `json:"organizationCode"`
+	TotalFteField         float64  `json:"totalFte"`
+	MaxFteField           float64  `json:"maxFte"`
+	AssignmentIDsField    []string `json:"assignmentIds"`
+	PositionCodesField    []string `json:"positionCodes"`
+	AsOfDateField         string   `json:"asOfDate"`
+}
+
+func (v EmployeeAssignmentViolation) Rule() string              { return v.RuleField }
+func (v EmployeeAssignmentViolation) EmployeeId() UUID          { return UUID(v.EmployeeIDField) }
+func (v EmployeeAssignmentViolation) EmployeeName() string      { return v.EmployeeNameField }
+func (v EmployeeAssignmentViolation) OrganizationCode() *string { return v.OrganizationCodeField }
+func (v EmployeeAssignmentViolation) TotalFte() float64         { return v.TotalFteField }
+func (v EmployeeAssignmentViolation) MaxFte() float64           { return v.MaxFteField }
+func (v EmployeeAssignmentViolation) AssignmentIds() []string   { return v.AssignmentIDsField }
+func (v EmployeeAssignmentViolation) PositionCodes() []string   { return v.PositionCodesField }
+func (v EmployeeAssignmentViolation) AsOfDate() string          { return v.AsOfDateField }
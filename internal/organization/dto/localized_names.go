@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"cube-castle/internal/types"
+)
+
+// LocalizedText GraphQL LocalizedText，单个语言的名称
+type LocalizedText struct {
+	LocaleField string `json:"locale"`
+	ValueField  string `json:"value"`
+}
+
+func (t LocalizedText) Locale() string { return t.LocaleField }
+func (t LocalizedText) Value() string  { return t.ValueField }
+
+// LocalizedTextList 由 *_i18n JSONB 扫描得到的多语言名称列表，按语言标签排序
+type LocalizedTextList []LocalizedText
+
+// MarshalJSON 空集合输出为 []，满足 GraphQL 非空列表约束
+func (l LocalizedTextList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]LocalizedText(l))
+}
+
+// Scan 实现 sql.Scanner，解析 {locale: name} 结构
+func (l *LocalizedTextList) Scan(src interface{}) error {
+	var names types.LocalizedNames
+	if err := names.Scan(src); err != nil {
+		return err
+	}
+	list := make(LocalizedTextList, 0, len(names))
+	for _, locale := range names.Locales() {
+		list = append(list, LocalizedText{LocaleField: locale, ValueField: names[locale]})
+	}
+	*l = list
+	return nil
+}
+
+// Names 转换为 {locale: name} 集合
+func (l LocalizedTextList) Names() types.LocalizedNames {
+	names := make(types.LocalizedNames, len(l))
+	for _, item := range l {
+		names[item.LocaleField] = item.ValueField
+	}
+	return names
+}
+
+// Resolve 按偏好语言选择名称，规则同 types.LocalizedNames.Resolve
+func (l LocalizedTextList) Resolve(locales []string, fallback string) string {
+	if len(l) == 0 || len(locales) == 0 {
+		return fallback
+	}
+	return l.Names().Resolve(locales, fallback)
+}
+
+func localizedTexts(list LocalizedTextList) []LocalizedText {
+	if list == nil {
+		return []LocalizedText{}
+	}
+	return list
+}
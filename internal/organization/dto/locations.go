@@ -0,0 +1,89 @@
+package dto
+
+// Location 工作地点或区域版本
+type Location struct {
+	RecordIDField      string           `json:"recordId" db:"record_id"`
+	CodeField          string           `json:"code" db:"code"`
+	NameField          string           `json:"name" db:"name"`
+	TypeField          string           `json:"type" db:"location_type"`
+	StatusField        string           `json:"status" db:"status"`
+	ParentCodeField    *string          `json:"parentCode" db:"parent_code"`
+	CountryCodeField   *string          `json:"countryCode" db:"country_code"`
+	TimeZoneField      *string          `json:"timeZone" db:"time_zone"`
+	AddressField       *LocationAddress `json:"address"`
+	EffectiveDateField Date             `json:"effectiveDate" db:"effective_date"`
+	EndDateField       *Date            `json:"endDate" db:"end_date"`
+	IsCurrentField     bool             `json:"isCurrent"`
+}
+
+func (l Location) RecordId() UUID            { return UUID(l.RecordIDField) }
+func (l Location) Code() string              { return l.CodeField }
+func (l Location) Name() string              { return l.NameField }
+func (l Location) Type() string              { return l.TypeField }
+func (l Location) Status() string            { return l.StatusField }
+func (l Location) ParentCode() *string       { return l.ParentCodeField }
+func (l Location) CountryCode() *string      { return l.CountryCodeField }
+func (l Location) TimeZone() *string         { return l.TimeZoneField }
+func (l Location) Address() *LocationAddress { return l.AddressField }
+func (l Location) EffectiveDate() Date       { return l.EffectiveDateField }
+func (l Location) EndDate() *Date            { return l.EndDateField }
+func (l Location) IsCurrent() bool           { return l.IsCurrentField }
+
+// LocationAddress 地点邮寄地址
+type LocationAddress struct {
+	Line1Field         *string `json:"line1" db:"address_line1"`
+	Line2Field         *string `json:"line2" db:"address_line2"`
+	CityField          *string `json:"city" db:"city"`
+	StateProvinceField *string `json:"stateProvince" db:"state_province"`
+	PostalCodeField    *string `json:"postalCode" db:"postal_code"`
+}
+
+func (a LocationAddress) Line1() *string         { return a.Line1Field }
+func (a LocationAddress) Line2() *string         { return a.Line2Field }
+func (a LocationAddress) City() *string          { return a.CityField }
+func (a LocationAddress) StateProvince() *string { return a.StateProvinceField }
+func (a LocationAddress) PostalCode() *string    { return a.PostalCodeField }
+
+// LocationFilter 地点查询条件
+type LocationFilter struct {
+	Type            *string
+	ParentCode      *string
+	CountryCode     *string
+	IncludeInactive bool
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate *string
+}
+
+// EffectiveLocation 组织单元或职位在指定日期的生效地点；Source 标明地点来自职位覆盖还是组织单元默认地点
+type EffectiveLocation struct {
+	LocationField      Location `json:"location"`
+	SourceField        string   `json:"source"`
+	EffectiveDateField Date     `json:"effectiveDate"`
+}
+
+func (e EffectiveLocation) Location() Location  { return e.LocationField }
+func (e EffectiveLocation) Source() string      { return e.SourceField }
+func (e EffectiveLocation) EffectiveDate() Date { return e.EffectiveDateField }
+
+// LocationHeadcount 按工作地点汇总的职位编制与 FTE
+type LocationHeadcount struct {
+	LocationCodeField       string  `json:"locationCode"`
+	LocationNameField       *string `json:"locationName"`
+	CountryCodeField        *string `json:"countryCode"`
+	TimeZoneField           *string `json:"timeZone"`
+	PositionCountField      int     `json:"positionCount"`
+	HeadcountCapacityField  float64 `json:"headcountCapacity"`
+	HeadcountInUseField     float64 `json:"headcountInUse"`
+	AssignedFteField        float64 `json:"assignedFte"`
+	AvailableHeadcountField float64 `json:"availableHeadcount"`
+}
+
+func (h LocationHeadcount) LocationCode() string        { return h.LocationCodeField }
+func (h LocationHeadcount) LocationName() *string       { return h.LocationNameField }
+func (h LocationHeadcount) CountryCode() *string        { return h.CountryCodeField }
+func (h LocationHeadcount) TimeZone() *string           { return h.TimeZoneField }
+func (h LocationHeadcount) PositionCount() int32        { return clampToInt32(h.PositionCountField) }
+func (h LocationHeadcount) HeadcountCapacity() float64  { return h.HeadcountCapacityField }
+func (h LocationHeadcount) HeadcountInUse() float64     { return h.HeadcountInUseField }
+func (h LocationHeadcount) AssignedFte() float64        { return h.AssignedFteField }
+func (h LocationHeadcount) AvailableHeadcount() float64 { return h.AvailableHeadcountField }
@@ -38,6 +38,11 @@ type Organization struct {
 
 	HierarchyDepthField int `json:"hierarchyDepth" db:"hierarchy_depth"`
 	ChildrenCountField  int `json:"childrenCount" db:"children_count"`
+
+	CustomFieldsField CustomFieldList `json:"customFields" db:"custom_fields"`
+
+	NameI18nField     LocalizedTextList `json:"nameI18n" db:"name_i18n"`
+	NamePathI18nField LocalizedTextList `json:"namePathI18n" db:"name_path_i18n"`
 }
 
 func clampToInt32(value int) int32 {
@@ -168,6 +173,20 @@ func (o Organization) ChangeReason() *string { return o.ChangeReasonField }
 func (o Organization) HierarchyDepth() int32 { return clampToInt32(o.HierarchyDepthField) }
 func (o Organization) ChildrenCount() int32  { return clampToInt32(o.ChildrenCountField) }
 
+// CustomFields 返回当前版本的自定义字段。
+func (o Organization) CustomFields() []CustomFieldEntry {
+	if o.CustomFieldsField == nil {
+		return []CustomFieldEntry{}
+	}
+	return o.CustomFieldsField
+}
+
+// NameI18n 返回当前版本的多语言名称。
+func (o Organization) NameI18n() []LocalizedText { return localizedTexts(o.NameI18nField) }
+
+// NamePathI18n 返回按语言计算的名称路径。
+func (o Organization) NamePathI18n() []LocalizedText { return localizedTexts(o.NamePathI18nField) }
+
 func (o Organization) DeletedAt() *string {
 	if o.DeletedAtField == nil {
 		return nil
@@ -488,6 +507,15 @@ func (a AuditRecordData) AfterData() *string         { return a.AfterDataField }
 func (a AuditRecordData) ModifiedFields() []string   { return a.ModifiedFieldsField }
 func (a AuditRecordData) Changes() []FieldChangeData { return a.ChangesField }
 
+// AuditArchiveNotice 查询时间范围内存在已归档审计记录时的提示（随 GraphQL 响应 extensions 返回）
+type AuditArchiveNotice struct {
+	Archived       bool   `json:"archived"`
+	ArchiveCount   int    `json:"archiveCount"`
+	ArchivedFrom   string `json:"archivedFrom"`
+	ArchivedBefore string `json:"archivedBefore"`
+	ExportPath     string `json:"exportPath"`
+}
+
 // OperatedByData 审计操作人信息
 type OperatedByData struct {
 	IDField   string `json:"id"`
@@ -505,29 +533,32 @@ type DateRangeInput struct {
 
 // OrganizationFilter 查询过滤条件
 type OrganizationFilter struct {
-	AsOfDate                 *string         `json:"asOfDate"`
-	IncludeFuture            bool            `json:"includeFuture"`
-	OnlyFuture               bool            `json:"onlyFuture"`
-	UnitType                 *string         `json:"unitType"`
-	Status                   *string         `json:"status"`
-	ParentCode               *string         `json:"parentCode"`
-	Codes                    *[]string       `json:"codes"`
-	ExcludeCodes             *[]string       `json:"excludeCodes"`
-	ExcludeDescendantsOf     *string         `json:"excludeDescendantsOf"`
-	IncludeDisabledAncestors bool            `json:"includeDisabledAncestors"`
-	Level                    *int32          `json:"level"`
-	MinLevel                 *int32          `json:"minLevel"`
-	MaxLevel                 *int32          `json:"maxLevel"`
-	RootsOnly                bool            `json:"rootsOnly"`
-	LeavesOnly               bool            `json:"leavesOnly"`
-	SearchText               *string         `json:"searchText"`
-	SearchFields             []string        `json:"searchFields"`
-	HasChildren              *bool           `json:"hasChildren"`
-	HasProfile               *bool           `json:"hasProfile"`
-	ProfileContains          *string         `json:"profileContains"`
-	OperationType            *string         `json:"operationType"`
-	OperatedBy               *string         `json:"operatedBy"`
-	OperationDateRange       *DateRangeInput `json:"operationDateRange"`
+	AsOfDate                 *string             `json:"asOfDate"`
+	IncludeFuture            bool                `json:"includeFuture"`
+	OnlyFuture               bool                `json:"onlyFuture"`
+	UnitType                 *string             `json:"unitType"`
+	Status                   *string             `json:"status"`
+	ParentCode               *string             `json:"parentCode"`
+	Codes                    *[]string           `json:"codes"`
+	ExcludeCodes             *[]string           `json:"excludeCodes"`
+	ExcludeDescendantsOf     *string             `json:"excludeDescendantsOf"`
+	IncludeDisabledAncestors bool                `json:"includeDisabledAncestors"`
+	Level                    *int32              `json:"level"`
+	MinLevel                 *int32              `json:"minLevel"`
+	MaxLevel                 *int32              `json:"maxLevel"`
+	RootsOnly                bool                `json:"rootsOnly"`
+	LeavesOnly               bool                `json:"leavesOnly"`
+	SearchText               *string             `json:"searchText"`
+	SearchFields             []string            `json:"searchFields"`
+	HasChildren              *bool               `json:"hasChildren"`
+	HasProfile               *bool               `json:"hasProfile"`
+	ProfileContains          *string             `json:"profileContains"`
+	OperationType            *string             `json:"operationType"`
+	OperatedBy               *string             `json:"operatedBy"`
+	OperationDateRange       *DateRangeInput     `json:"operationDateRange"`
+	CustomFields             []CustomFieldFilter `json:"customFields"`
+	// LocationCode 默认地点为该地点或位于该区域之下的组织单元
+	LocationCode *string `json:"locationCode"`
 }
 
 func (f *OrganizationFilter) UnmarshalGraphQL(input interface{}) error {
@@ -592,6 +623,20 @@ func (f *OrganizationFilter) UnmarshalGraphQL(input interface{}) error {
 		}
 		f.HasProfile = boolPtr
 	}
+	if value, exists := raw["customFields"]; exists {
+		filters, err := asCustomFieldFilters(value)
+		if err != nil {
+			return fmt.Errorf("OrganizationFilter.customFields: %w", err)
+		}
+		f.CustomFields = filters
+	}
+	if value, exists := raw["locationCode"]; exists {
+		strPtr, err := asOptionalString(value)
+		if err != nil {
+			return fmt.Errorf("OrganizationFilter.locationCode: %w", err)
+		}
+		f.LocationCode = strPtr
+	}
 
 	return nil
 }
@@ -636,6 +681,8 @@ type Position struct {
 	OrganizationNameField   *string              `json:"organizationName" db:"organization_name"`
 	CurrentAssignmentField  *PositionAssignment  `json:"currentAssignment"`
 	AssignmentHistoryField  []PositionAssignment `json:"assignmentHistory"`
+	CustomFieldsField       CustomFieldList      `json:"customFields" db:"custom_fields"`
+	TitleI18nField          LocalizedTextList    `json:"titleI18n" db:"title_i18n"`
 }
 
 func (p Position) Code() PositionCode      { return PositionCode(p.CodeField) }
@@ -702,6 +749,13 @@ func (p Position) AssignmentHistory() []PositionAssignment {
 	}
 	return p.AssignmentHistoryField
 }
+func (p Position) CustomFields() []CustomFieldEntry {
+	if p.CustomFieldsField == nil {
+		return []CustomFieldEntry{}
+	}
+	return p.CustomFieldsField
+}
+func (p Position) TitleI18n() []LocalizedText { return localizedTexts(p.TitleI18nField) }
 
 // PositionConnection 连接结果
 type PositionConnection struct {
@@ -1014,16 +1068,19 @@ func (c PositionTransferConnection) TotalCount() int32             { return int3
 
 // PositionFilterInput 过滤条件
 type PositionFilterInput struct {
-	OrganizationCode    *string         `json:"organizationCode"`
-	PositionCodes       *[]string       `json:"positionCodes"`
-	Status              *string         `json:"status"`
-	JobFamilyGroupCodes *[]string       `json:"jobFamilyGroupCodes"`
-	JobFamilyCodes      *[]string       `json:"jobFamilyCodes"`
-	JobRoleCodes        *[]string       `json:"jobRoleCodes"`
-	JobLevelCodes       *[]string       `json:"jobLevelCodes"`
-	PositionTypes       *[]string       `json:"positionTypes"`
-	EmploymentTypes     *[]string       `json:"employmentTypes"`
-	EffectiveRange      *DateRangeInput `json:"effectiveRange"`
+	OrganizationCode    *string             `json:"organizationCode"`
+	PositionCodes       *[]string           `json:"positionCodes"`
+	Status              *string             `json:"status"`
+	JobFamilyGroupCodes *[]string           `json:"jobFamilyGroupCodes"`
+	JobFamilyCodes      *[]string           `json:"jobFamilyCodes"`
+	JobRoleCodes        *[]string           `json:"jobRoleCodes"`
+	JobLevelCodes       *[]string           `json:"jobLevelCodes"`
+	PositionTypes       *[]string           `json:"positionTypes"`
+	EmploymentTypes     *[]string           `json:"employmentTypes"`
+	EffectiveRange      *DateRangeInput     `json:"effectiveRange"`
+	CustomFields        []CustomFieldFilter `json:"customFields"`
+	// LocationCode 生效地点（职位覆盖或继承的组织单元默认地点）为该地点或位于该区域之下的职位
+	LocationCode *string `json:"locationCode"`
 }
 
 func (f *PositionFilterInput) UnmarshalGraphQL(input interface{}) error {
@@ -1102,6 +1159,20 @@ func (f *PositionFilterInput) UnmarshalGraphQL(input interface{}) error {
 		}
 		f.EffectiveRange = rangePtr
 	}
+	if value, exists := raw["customFields"]; exists {
+		filters, err := asCustomFieldFilters(value)
+		if err != nil {
+			return fmt.Errorf("PositionFilterInput.customFields: %w", err)
+		}
+		f.CustomFields = filters
+	}
+	if value, exists := raw["locationCode"]; exists {
+		strPtr, err := asOptionalString(value)
+		if err != nil {
+			return fmt.Errorf("PositionFilterInput.locationCode: %w", err)
+		}
+		f.LocationCode = strPtr
+	}
 
 	return nil
 }
@@ -1141,6 +1212,7 @@ type VacantPositionFilterInput struct {
 	PositionTypes     *[]string `json:"positionTypes"`
 	MinimumVacantDays *int      `json:"minimumVacantDays"`
 	AsOfDate          *string   `json:"asOfDate"`
+	LocationCode      *string   `json:"locationCode"`
 }
 
 func (f *VacantPositionFilterInput) UnmarshalGraphQL(input interface{}) error {
@@ -1198,6 +1270,13 @@ func (f *VacantPositionFilterInput) UnmarshalGraphQL(input interface{}) error {
 		}
 		f.AsOfDate = strPtr
 	}
+	if value, exists := raw["locationCode"]; exists {
+		strPtr, err := asOptionalString(value)
+		if err != nil {
+			return fmt.Errorf("VacantPositionFilterInput.locationCode: %w", err)
+		}
+		f.LocationCode = strPtr
+	}
 
 	return nil
 }
@@ -1461,15 +1540,16 @@ func (t TypeHeadcount) Available() float64   { return t.AvailableField }
 
 // Job catalog 基础类型
 type JobFamilyGroup struct {
-	RecordIDField      string     `json:"recordId" db:"record_id"`
-	TenantIDField      string     `json:"tenantId" db:"tenant_id"`
-	CodeField          string     `json:"code" db:"family_group_code"`
-	NameField          string     `json:"name" db:"name"`
-	DescriptionField   *string    `json:"description" db:"description"`
-	StatusField        string     `json:"status" db:"status"`
-	EffectiveDateField time.Time  `json:"effectiveDate" db:"effective_date"`
-	EndDateField       *time.Time `json:"endDate" db:"end_date"`
-	IsCurrentField     bool       `json:"isCurrent" db:"is_current"`
+	RecordIDField      string            `json:"recordId" db:"record_id"`
+	TenantIDField      string            `json:"tenantId" db:"tenant_id"`
+	CodeField          string            `json:"code" db:"family_group_code"`
+	NameField          string            `json:"name" db:"name"`
+	NameI18nField      LocalizedTextList `json:"nameI18n" db:"name_i18n"`
+	DescriptionField   *string           `json:"description" db:"description"`
+	StatusField        string            `json:"status" db:"status"`
+	EffectiveDateField time.Time         `json:"effectiveDate" db:"effective_date"`
+	EndDateField       *time.Time        `json:"endDate" db:"end_date"`
+	IsCurrentField     bool              `json:"isCurrent" db:"is_current"`
 }
 
 func (g JobFamilyGroup) RecordId() UUID { return UUID(g.RecordIDField) }
@@ -1477,9 +1557,10 @@ func (g JobFamilyGroup) TenantId() UUID { return UUID(g.TenantIDField) }
 func (g JobFamilyGroup) Code() JobFamilyGroupCode {
 	return JobFamilyGroupCode(g.CodeField)
 }
-func (g JobFamilyGroup) Name() string         { return g.NameField }
-func (g JobFamilyGroup) Description() *string { return g.DescriptionField }
-func (g JobFamilyGroup) Status() string       { return g.StatusField }
+func (g JobFamilyGroup) Name() string              { return g.NameField }
+func (g JobFamilyGroup) NameI18n() []LocalizedText { return localizedTexts(g.NameI18nField) }
+func (g JobFamilyGroup) Description() *string      { return g.DescriptionField }
+func (g JobFamilyGroup) Status() string            { return g.StatusField }
 func (g JobFamilyGroup) EffectiveDate() Date {
 	return Date(g.EffectiveDateField.Format("2006-01-02"))
 }
@@ -1493,24 +1574,26 @@ func (g JobFamilyGroup) EndDate() *Date {
 func (g JobFamilyGroup) IsCurrent() bool { return g.IsCurrentField }
 
 type JobFamily struct {
-	RecordIDField        string     `json:"recordId" db:"record_id"`
-	TenantIDField        string     `json:"tenantId" db:"tenant_id"`
-	CodeField            string     `json:"code" db:"family_code"`
-	NameField            string     `json:"name" db:"name"`
-	DescriptionField     *string    `json:"description" db:"description"`
-	StatusField          string     `json:"status" db:"status"`
-	EffectiveDateField   time.Time  `json:"effectiveDate" db:"effective_date"`
-	EndDateField         *time.Time `json:"endDate" db:"end_date"`
-	IsCurrentField       bool       `json:"isCurrent" db:"is_current"`
-	FamilyGroupCodeField string     `json:"groupCode" db:"family_group_code"`
-}
-
-func (f JobFamily) RecordId() UUID       { return UUID(f.RecordIDField) }
-func (f JobFamily) TenantId() UUID       { return UUID(f.TenantIDField) }
-func (f JobFamily) Code() JobFamilyCode  { return JobFamilyCode(f.CodeField) }
-func (f JobFamily) Name() string         { return f.NameField }
-func (f JobFamily) Description() *string { return f.DescriptionField }
-func (f JobFamily) Status() string       { return f.StatusField }
+	RecordIDField        string            `json:"recordId" db:"record_id"`
+	TenantIDField        string            `json:"tenantId" db:"tenant_id"`
+	CodeField            string            `json:"code" db:"family_code"`
+	NameField            string            `json:"name" db:"name"`
+	NameI18nField        LocalizedTextList `json:"nameI18n" db:"name_i18n"`
+	DescriptionField     *string           `json:"description" db:"description"`
+	StatusField          string            `json:"status" db:"status"`
+	EffectiveDateField   time.Time         `json:"effectiveDate" db:"effective_date"`
+	EndDateField         *time.Time        `json:"endDate" db:"end_date"`
+	IsCurrentField       bool              `json:"isCurrent" db:"is_current"`
+	FamilyGroupCodeField string            `json:"groupCode" db:"family_group_code"`
+}
+
+func (f JobFamily) RecordId() UUID            { return UUID(f.RecordIDField) }
+func (f JobFamily) TenantId() UUID            { return UUID(f.TenantIDField) }
+func (f JobFamily) Code() JobFamilyCode       { return JobFamilyCode(f.CodeField) }
+func (f JobFamily) Name() string              { return f.NameField }
+func (f JobFamily) NameI18n() []LocalizedText { return localizedTexts(f.NameI18nField) }
+func (f JobFamily) Description() *string      { return f.DescriptionField }
+func (f JobFamily) Status() string            { return f.StatusField }
 func (f JobFamily) EffectiveDate() Date {
 	return Date(f.EffectiveDateField.Format("2006-01-02"))
 }
@@ -1527,24 +1610,26 @@ func (f JobFamily) GroupCode() JobFamilyGroupCode {
 }
 
 type JobRole struct {
-	RecordIDField      string     `json:"recordId" db:"record_id"`
-	TenantIDField      string     `json:"tenantId" db:"tenant_id"`
-	CodeField          string     `json:"code" db:"role_code"`
-	NameField          string     `json:"name" db:"name"`
-	DescriptionField   *string    `json:"description" db:"description"`
-	StatusField        string     `json:"status" db:"status"`
-	EffectiveDateField time.Time  `json:"effectiveDate" db:"effective_date"`
-	EndDateField       *time.Time `json:"endDate" db:"end_date"`
-	IsCurrentField     bool       `json:"isCurrent" db:"is_current"`
-	FamilyCodeField    string     `json:"familyCode" db:"family_code"`
-}
-
-func (r JobRole) RecordId() UUID       { return UUID(r.RecordIDField) }
-func (r JobRole) TenantId() UUID       { return UUID(r.TenantIDField) }
-func (r JobRole) Code() JobRoleCode    { return JobRoleCode(r.CodeField) }
-func (r JobRole) Name() string         { return r.NameField }
-func (r JobRole) Description() *string { return r.DescriptionField }
-func (r JobRole) Status() string       { return r.StatusField }
+	RecordIDField      string            `json:"recordId" db:"record_id"`
+	TenantIDField      string            `json:"tenantId" db:"tenant_id"`
+	CodeField          string            `json:"code" db:"role_code"`
+	NameField          string            `json:"name" db:"name"`
+	NameI18nField      LocalizedTextList `json:"nameI18n" db:"name_i18n"`
+	DescriptionField   *string           `json:"description" db:"description"`
+	StatusField        string            `json:"status" db:"status"`
+	EffectiveDateField time.Time         `json:"effectiveDate" db:"effective_date"`
+	EndDateField       *time.Time        `json:"endDate" db:"end_date"`
+	IsCurrentField     bool              `json:"isCurrent" db:"is_current"`
+	FamilyCodeField    string            `json:"familyCode" db:"family_code"`
+}
+
+func (r JobRole) RecordId() UUID            { return UUID(r.RecordIDField) }
+func (r JobRole) TenantId() UUID            { return UUID(r.TenantIDField) }
+func (r JobRole) Code() JobRoleCode         { return JobRoleCode(r.CodeField) }
+func (r JobRole) Name() string              { return r.NameField }
+func (r JobRole) NameI18n() []LocalizedText { return localizedTexts(r.NameI18nField) }
+func (r JobRole) Description() *string      { return r.DescriptionField }
+func (r JobRole) Status() string            { return r.StatusField }
 func (r JobRole) EffectiveDate() Date {
 	return Date(r.EffectiveDateField.Format("2006-01-02"))
 }
@@ -1559,25 +1644,27 @@ func (r JobRole) IsCurrent() bool           { return r.IsCurrentField }
 func (r JobRole) FamilyCode() JobFamilyCode { return JobFamilyCode(r.FamilyCodeField) }
 
 type JobLevel struct {
-	RecordIDField      string     `json:"recordId" db:"record_id"`
-	TenantIDField      string     `json:"tenantId" db:"tenant_id"`
-	CodeField          string     `json:"code" db:"level_code"`
-	NameField          string     `json:"name" db:"name"`
-	DescriptionField   *string    `json:"description" db:"description"`
-	StatusField        string     `json:"status" db:"status"`
-	EffectiveDateField time.Time  `json:"effectiveDate" db:"effective_date"`
-	EndDateField       *time.Time `json:"endDate" db:"end_date"`
-	IsCurrentField     bool       `json:"isCurrent" db:"is_current"`
-	RoleCodeField      string     `json:"roleCode" db:"role_code"`
-	LevelRankField     string     `json:"levelRank" db:"level_rank"`
-}
-
-func (l JobLevel) RecordId() UUID       { return UUID(l.RecordIDField) }
-func (l JobLevel) TenantId() UUID       { return UUID(l.TenantIDField) }
-func (l JobLevel) Code() JobLevelCode   { return JobLevelCode(l.CodeField) }
-func (l JobLevel) Name() string         { return l.NameField }
-func (l JobLevel) Description() *string { return l.DescriptionField }
-func (l JobLevel) Status() string       { return l.StatusField }
+	RecordIDField      string            `json:"recordId" db:"record_id"`
+	TenantIDField      string            `json:"tenantId" db:"tenant_id"`
+	CodeField          string            `json:"code" db:"level_code"`
+	NameField          string            `json:"name" db:"name"`
+	NameI18nField      LocalizedTextList `json:"nameI18n" db:"name_i18n"`
+	DescriptionField   *string           `json:"description" db:"description"`
+	StatusField        string            `json:"status" db:"status"`
+	EffectiveDateField time.Time         `json:"effectiveDate" db:"effective_date"`
+	EndDateField       *time.Time        `json:"endDate" db:"end_date"`
+	IsCurrentField     bool              `json:"isCurrent" db:"is_current"`
+	RoleCodeField      string            `json:"roleCode" db:"role_code"`
+	LevelRankField     string            `json:"levelRank" db:"level_rank"`
+}
+
+func (l JobLevel) RecordId() UUID            { return UUID(l.RecordIDField) }
+func (l JobLevel) TenantId() UUID            { return UUID(l.TenantIDField) }
+func (l JobLevel) Code() JobLevelCode        { return JobLevelCode(l.CodeField) }
+func (l JobLevel) Name() string              { return l.NameField }
+func (l JobLevel) NameI18n() []LocalizedText { return localizedTexts(l.NameI18nField) }
+func (l JobLevel) Description() *string      { return l.DescriptionField }
+func (l JobLevel) Status() string            { return l.StatusField }
 func (l JobLevel) EffectiveDate() Date {
 	return Date(l.EffectiveDateField.Format("2006-01-02"))
 }
@@ -0,0 +1,22 @@
+package dto
+
+// PositionTransition 职位当前状态下可手动执行的生命周期事件
+type PositionTransition struct {
+	EventField        string   `json:"event"`
+	FromStatusField   string   `json:"fromStatus"`
+	ToStatusField     string   `json:"toStatus"`
+	AllowedField      bool     `json:"allowed"`
+	GuardsField       []string `json:"guards"`
+	FailedGuardsField []string `json:"failedGuards"`
+	SideEffectsField  []string `json:"sideEffects"`
+	BuiltInField      bool     `json:"builtIn"`
+}
+
+func (t PositionTransition) Event() string          { return t.EventField }
+func (t PositionTransition) FromStatus() string     { return t.FromStatusField }
+func (t PositionTransition) ToStatus() string       { return t.ToStatusField }
+func (t PositionTransition) Allowed() bool          { return t.AllowedField }
+func (t PositionTransition) Guards() []string       { return t.GuardsField }
+func (t PositionTransition) FailedGuards() []string { return t.FailedGuardsField }
+func (t PositionTransition) SideEffects() []string  { return t.SideEffectsField }
+func (t PositionTransition) BuiltIn() bool          { return t.BuiltInField }
@@ -112,6 +112,22 @@ func (c *JobLevelCode) UnmarshalGraphQL(input interface{}) error {
 	return nil
 }
 
+// UnitTypeCode 表示组织单元类型编码标量（兼容原 UnitType 枚举字面量）。
+type UnitTypeCode string
+
+// ImplementsGraphQLType 声明 UnitTypeCode 满足 gqlgen 接口。
+func (UnitTypeCode) ImplementsGraphQLType(name string) bool { return name == "UnitType" }
+
+// UnmarshalGraphQL 解析 UnitTypeCode，统一为大写。
+func (c *UnitTypeCode) UnmarshalGraphQL(input interface{}) error {
+	var base scalarString
+	if err := base.unmarshal("UnitType", input); err != nil {
+		return err
+	}
+	*c = UnitTypeCode(strings.ToUpper(string(base)))
+	return nil
+}
+
 // UUID 表示 GraphQL UUID 标量。
 type UUID string
 
@@ -258,6 +274,17 @@ func UnmarshalPositionCode(v interface{}) (PositionCode, error) {
 	return PositionCode(str), err
 }
 
+// MarshalUnitTypeCode 序列化组织单元类型编码。
+func MarshalUnitTypeCode(value UnitTypeCode) graphql.Marshaler {
+	return graphql.MarshalString(string(value))
+}
+
+// UnmarshalUnitTypeCode 解析组织单元类型编码。
+func UnmarshalUnitTypeCode(v interface{}) (UnitTypeCode, error) {
+	str, err := graphql.UnmarshalString(v)
+	return UnitTypeCode(strings.ToUpper(strings.TrimSpace(str))), err
+}
+
 // MarshalUUID 序列化 UUID。
 func MarshalUUID(value UUID) graphql.Marshaler {
 	return graphql.MarshalString(string(value))
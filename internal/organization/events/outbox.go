@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cube-castle/pkg/database"
+	"cube-castle/pkg/tracing"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +19,7 @@ const (
 	aggregateAssignment = "assignment"
 	aggregatePosition   = "position"
 	aggregateJobLevel   = "jobLevel"
+	aggregateOrg        = "organization"
 
 	// EventAssignmentFilled 表示任命占用。
 	EventAssignmentFilled = "assignment.filled"
@@ -33,12 +35,28 @@ const (
 	// EventPositionUpdated 表示职位更新。
 	EventPositionUpdated = "position.updated"
 
+	// EventOrganizationSuspended 表示组织停用。
+	EventOrganizationSuspended = "organization.suspended"
+
 	// EventJobLevelVersionCreated 表示职级版本创建。
 	EventJobLevelVersionCreated = "jobLevel.versionCreated"
 	// EventJobLevelVersionConflict 表示职级版本冲突。
 	EventJobLevelVersionConflict = "jobLevel.versionConflict"
 )
 
+// PublishedEventTypes 列出 command 服务写入 outbox 的全部事件类型，供按类型订阅的消费方使用。
+var PublishedEventTypes = []string{
+	EventAssignmentFilled,
+	EventAssignmentVacated,
+	EventAssignmentUpdated,
+	EventAssignmentClosed,
+	EventPositionCreated,
+	EventPositionUpdated,
+	EventOrganizationSuspended,
+	EventJobLevelVersionCreated,
+	EventJobLevelVersionConflict,
+}
+
 // Context 描述 outbox 事件的通用上下文。
 type Context struct {
 	TenantID      uuid.UUID
@@ -46,6 +64,8 @@ type Context struct {
 	CorrelationID string
 	Operation     string
 	Source        string
+	// Headers 承载 W3C traceparent/tracestate，消费方据此延续调用链。
+	Headers map[string]string
 }
 
 // NewAssignmentEvent 构造 assignment.* 事件。
@@ -93,6 +113,19 @@ func NewJobLevelEvent(eventType string, ctx Context, jobLevelCode string, payloa
 	return newOutboxEvent(eventType, aggregateJobLevel, aggregateID, ctx, payload)
 }
 
+// NewOrganizationEvent 构造 organization.* 事件。
+func NewOrganizationEvent(eventType string, ctx Context, organizationCode string, payload map[string]interface{}) (*database.OutboxEvent, error) {
+	aggregateID := strings.TrimSpace(organizationCode)
+	if aggregateID == "" {
+		aggregateID = ctx.TenantID.String()
+	}
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	payload["organizationCode"] = strings.TrimSpace(organizationCode)
+	return newOutboxEvent(eventType, aggregateOrg, aggregateID, ctx, payload)
+}
+
 func newOutboxEvent(eventType, aggregateType, aggregateID string, ctx Context, attributes map[string]interface{}) (*database.OutboxEvent, error) {
 	aggregateID = strings.TrimSpace(aggregateID)
 	if aggregateID == "" {
@@ -125,6 +158,9 @@ func newOutboxEvent(eventType, aggregateType, aggregateID string, ctx Context, a
 		source = DefaultSourceCommand
 	}
 	data["source"] = source
+	if len(ctx.Headers) > 0 {
+		data[tracing.PayloadHeadersKey] = ctx.Headers
+	}
 	data["aggregateType"] = aggregateType
 	data["aggregateId"] = aggregateID
 	data["eventType"] = eventType
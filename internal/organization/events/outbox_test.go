@@ -2,6 +2,7 @@ package events
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -68,3 +69,26 @@ func TestNewJobLevelEventMissingAggregate(t *testing.T) {
 		t.Fatalf("expected error when aggregate id is missing")
 	}
 }
+
+func TestNewPositionEventCarriesTraceHeaders(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := Context{TenantID: uuid.New(), Headers: map[string]string{"traceparent": traceparent}}
+	ev, err := NewPositionEvent(EventPositionUpdated, ctx, "P-2", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var payload struct {
+		Headers map[string]string `json:"headers"`
+	}
+	if err := json.Unmarshal([]byte(ev.Payload), &payload); err != nil {
+		t.Fatalf("payload json invalid: %v", err)
+	}
+	if payload.Headers["traceparent"] != traceparent {
+		t.Fatalf("trace headers missing in payload: %#v", payload.Headers)
+	}
+
+	ev, _ = NewPositionEvent(EventPositionUpdated, Context{TenantID: uuid.New()}, "P-3", nil)
+	if strings.Contains(ev.Payload, `"headers"`) {
+		t.Fatalf("headers should be omitted without trace context: %s", ev.Payload)
+	}
+}
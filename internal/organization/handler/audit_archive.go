@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+// exportFlushEvery 导出流每输出多少条记录刷新一次响应
+const exportFlushEvery = 500
+
+// AuditArchiveHandler 审计导出与保留策略/归档管理
+type AuditArchiveHandler struct {
+	archive     *auditpkg.ArchiveService
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewAuditArchiveHandler 创建审计导出/归档处理器
+func NewAuditArchiveHandler(archive *auditpkg.ArchiveService, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *AuditArchiveHandler {
+	return &AuditArchiveHandler{
+		archive:     archive,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "auditArchive", pkglogger.Fields{"module": "audit"}),
+	}
+}
+
+func (h *AuditArchiveHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置审计导出与归档路由
+func (h *AuditArchiveHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/audit", func(r chi.Router) {
+		r.Get("/export", h.ExportAuditEvents)
+		r.Get("/retention-policy", h.GetRetentionPolicy)
+		r.Put("/retention-policy", h.UpdateRetentionPolicy)
+		r.Get("/archives", h.ListArchives)
+	})
+}
+
+// ExportAuditEvents 按时间范围/资源类型/操作人流式导出审计事件（JSONL 或 CSV），默认包含已归档记录
+func (h *AuditArchiveHandler) ExportAuditEvents(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	query := r.URL.Query()
+
+	from, err := parseExportTime(query.Get("from"))
+	if err != nil || from.IsZero() {
+		_ = utils.WriteBadRequest(w, "INVALID_FROM", "from 为必填项，格式为 RFC3339 或 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	to := time.Now().UTC()
+	if raw := query.Get("to"); raw != "" {
+		if to, err = parseExportTime(raw); err != nil {
+			_ = utils.WriteBadRequest(w, "INVALID_TO", "to 格式必须为 RFC3339 或 YYYY-MM-DD", requestID, nil)
+			return
+		}
+	}
+	if !from.Before(to) {
+		_ = utils.WriteBadRequest(w, "INVALID_RANGE", "from 必须早于 to", requestID, nil)
+		return
+	}
+	format := strings.ToLower(query.Get("format"))
+	if format == "" {
+		format = auditpkg.ExportFormatJSONL
+	}
+	if format != auditpkg.ExportFormatJSONL && format != auditpkg.ExportFormatCSV {
+		_ = utils.WriteBadRequest(w, "INVALID_FORMAT", "format 仅支持 jsonl 或 csv", requestID, nil)
+		return
+	}
+	includeArchived := true
+	if raw := query.Get("includeArchived"); raw != "" {
+		if includeArchived, err = strconv.ParseBool(raw); err != nil {
+			_ = utils.WriteBadRequest(w, "INVALID_INCLUDE_ARCHIVED", "includeArchived 必须为布尔值", requestID, nil)
+			return
+		}
+	}
+
+	filter := auditpkg.ExportFilter{
+		TenantID:        tenantID,
+		From:            from,
+		To:              to,
+		ResourceType:    strings.ToUpper(strings.TrimSpace(query.Get("resourceType"))),
+		ActorID:         strings.TrimSpace(query.Get("actorId")),
+		IncludeArchived: includeArchived,
+	}
+	logger := h.requestLogger(r, "ExportAuditEvents", pkglogger.Fields{
+		"tenantId":        tenantID.String(),
+		"from":            from,
+		"to":              to,
+		"resourceType":    filter.ResourceType,
+		"actorId":         filter.ActorID,
+		"format":          format,
+		"includeArchived": includeArchived,
+	})
+
+	// 首条记录输出前才写响应头，便于前置错误仍以统一 JSON 错误返回
+	var writer auditpkg.ExportWriter
+	start := func() error {
+		contentType, ext := "application/x-ndjson", "jsonl"
+		if format == auditpkg.ExportFormatCSV {
+			contentType, ext = "text/csv; charset=utf-8", "csv"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%s-%s.%s"`,
+			from.Format("20060102"), to.Format("20060102"), ext))
+		w.Header().Set("X-Request-ID", requestID)
+		w.WriteHeader(http.StatusOK)
+		var err error
+		writer, err = auditpkg.NewExportWriter(w, format)
+		return err
+	}
+	flusher, _ := w.(http.Flusher)
+	written := 0
+
+	count, err := h.archive.Export(r.Context(), filter, func(rec *auditpkg.ExportRecord) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := writer.Write(rec); err != nil {
+			return err
+		}
+		written++
+		if written%exportFlushEvery == 0 {
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		return nil
+	})
+	if err != nil && writer == nil {
+		if errors.Is(err, auditpkg.ErrArchiveStoreMissing) {
+			_ = utils.WriteError(w, http.StatusServiceUnavailable, "AUDIT_ARCHIVE_STORE_MISSING", "未配置审计归档存储，无法读取已归档记录", requestID, nil)
+			return
+		}
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("export audit events failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if err != nil {
+		// 响应头已发送，只能截断输出并记录错误
+		logger.WithFields(pkglogger.Fields{"error": err, "exported": count}).Error("export audit events aborted")
+		return
+	}
+	if writer == nil {
+		if err := start(); err != nil {
+			logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit export header failed")
+			return
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("flush audit export failed")
+		return
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	logger.WithFields(pkglogger.Fields{"exported": count}).Info("audit events exported")
+	h.logAuditAction(r, auditpkg.EventTypeQuery, "ExportAuditEvents", nil, map[string]interface{}{
+		"from":            from.Format(time.RFC3339),
+		"to":              to.Format(time.RFC3339),
+		"resourceType":    filter.ResourceType,
+		"actorId":         filter.ActorID,
+		"format":          format,
+		"includeArchived": includeArchived,
+		"exported":        count,
+	})
+}
+
+// GetRetentionPolicy 查询当前租户审计保留策略
+func (h *AuditArchiveHandler) GetRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "GetRetentionPolicy", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	policy, err := h.archive.GetPolicy(r.Context(), tenantID)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("load audit retention policy failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if policy == nil {
+		_ = utils.WriteError(w, http.StatusNotFound, "AUDIT_RETENTION_POLICY_NOT_FOUND", "当前租户未配置审计保留策略", requestID, nil)
+		return
+	}
+	if err := utils.WriteSuccess(w, policy, "Audit retention policy retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit retention policy failed")
+	}
+}
+
+type updateRetentionPolicyRequest struct {
+	RetentionDays int   `json:"retentionDays"`
+	Enabled       *bool `json:"enabled"`
+}
+
+// UpdateRetentionPolicy 创建或更新当前租户审计保留策略
+func (h *AuditArchiveHandler) UpdateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "UpdateRetentionPolicy", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req updateRetentionPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	previous, err := h.archive.GetPolicy(r.Context(), tenantID)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("load audit retention policy failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	policy, err := h.archive.UpsertPolicy(r.Context(), auditpkg.RetentionPolicy{
+		TenantID:      tenantID,
+		RetentionDays: req.RetentionDays,
+		Enabled:       enabled,
+		UpdatedBy:     getActorID(r),
+	})
+	if errors.Is(err, auditpkg.ErrInvalidRetention) {
+		_ = utils.WriteBadRequest(w, "INVALID_RETENTION_DAYS",
+			fmt.Sprintf("retentionDays 不得少于 %d 天", auditpkg.MinRetentionDays), requestID, nil)
+		return
+	}
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("update audit retention policy failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+
+	var before map[string]interface{}
+	if previous != nil {
+		before = map[string]interface{}{"retentionDays": previous.RetentionDays, "enabled": previous.Enabled}
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateAuditRetentionPolicy", before, map[string]interface{}{
+		"retentionDays": policy.RetentionDays,
+		"enabled":       policy.Enabled,
+	})
+	logger.WithFields(pkglogger.Fields{"retentionDays": policy.RetentionDays, "enabled": policy.Enabled}).Info("audit retention policy updated")
+	if err := utils.WriteSuccess(w, policy, "Audit retention policy updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit retention policy failed")
+	}
+}
+
+// ListArchives 列出当前租户最近的归档文件
+func (h *AuditArchiveHandler) ListArchives(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListArchives", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	limit := 50
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > 500 {
+			_ = utils.WriteBadRequest(w, "INVALID_LIMIT", "limit 必须为 1-500 之间的整数", requestID, nil)
+			return
+		}
+		limit = parsed
+	}
+
+	archives, err := h.archive.ListArchives(r.Context(), tenantID, limit)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("list audit archives failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if archives == nil {
+		archives = []auditpkg.ArchiveFile{}
+	}
+	if err := utils.WriteSuccess(w, archives, "Audit archives retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit archives failed")
+	}
+}
+
+// logAuditAction 记录导出/策略变更本身的审计事件（失败仅告警，不影响主流程）
+func (h *AuditArchiveHandler) logAuditAction(r *http.Request, eventType, action string, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	tenantID := getTenantIDFromRequest(r)
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     tenantID,
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "audit_logs",
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
+
+func parseExportTime(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+// AuditChainHandler 审计哈希链校验与检查点管理
+type AuditChainHandler struct {
+	chain  *auditpkg.ChainService
+	logger pkglogger.Logger
+}
+
+// NewAuditChainHandler 创建审计哈希链处理器
+func NewAuditChainHandler(chain *auditpkg.ChainService, baseLogger pkglogger.Logger) *AuditChainHandler {
+	return &AuditChainHandler{
+		chain:  chain,
+		logger: scopedLogger(baseLogger, "auditChain", pkglogger.Fields{"module": "audit"}),
+	}
+}
+
+func (h *AuditChainHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置审计哈希链路由
+func (h *AuditChainHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/audit/chain", func(r chi.Router) {
+		r.Get("/verify", h.VerifyChain)
+		r.Get("/checkpoints", h.ListCheckpoints)
+		r.Post("/checkpoints", h.CreateCheckpoint)
+	})
+}
+
+// VerifyChain 复算当前租户审计链并返回第一个断裂位置
+func (h *AuditChainHandler) VerifyChain(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "VerifyChain", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	report, err := h.chain.Verify(r.Context(), tenantID)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("verify audit chain failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if !report.Valid {
+		logger.WithFields(pkglogger.Fields{
+			"seq":    report.FirstBroken.Seq,
+			"reason": report.FirstBroken.Reason,
+		}).Warn("audit chain broken")
+	}
+	if err := utils.WriteSuccess(w, report, "Audit chain verified", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit chain verification failed")
+	}
+}
+
+// ListCheckpoints 列出当前租户最近的签名检查点
+func (h *AuditChainHandler) ListCheckpoints(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListCheckpoints", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	limit := 50
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > 500 {
+			_ = utils.WriteBadRequest(w, "INVALID_LIMIT", "limit 必须为 1-500 之间的整数", requestID, nil)
+			return
+		}
+		limit = parsed
+	}
+
+	checkpoints, err := h.chain.ListCheckpoints(r.Context(), tenantID, limit)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("list audit checkpoints failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if checkpoints == nil {
+		checkpoints = []auditpkg.ChainCheckpoint{}
+	}
+	if err := utils.WriteSuccess(w, checkpoints, "Audit checkpoints retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit checkpoints failed")
+	}
+}
+
+// CreateCheckpoint 立即为当前租户链尾签发检查点（链断裂时拒绝）
+func (h *AuditChainHandler) CreateCheckpoint(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateCheckpoint", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	cp, created, report, err := h.chain.CreateCheckpoint(r.Context(), tenantID)
+	switch {
+	case errors.Is(err, auditpkg.ErrCheckpointSignerMissing):
+		_ = utils.WriteError(w, http.StatusServiceUnavailable, "AUDIT_CHECKPOINT_SIGNER_MISSING", "未配置审计检查点签名密钥", requestID, nil)
+		return
+	case errors.Is(err, auditpkg.ErrChainBroken):
+		logger.WithFields(pkglogger.Fields{
+			"seq":    report.FirstBroken.Seq,
+			"reason": report.FirstBroken.Reason,
+		}).Warn("refuse to checkpoint broken audit chain")
+		_ = utils.WriteError(w, http.StatusConflict, "AUDIT_CHAIN_BROKEN", "审计哈希链已断裂，拒绝签发检查点", requestID, report)
+		return
+	case err != nil:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("create audit checkpoint failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+
+	data := map[string]interface{}{
+		"created":    created,
+		"checkpoint": cp,
+		"headSeq":    report.HeadSeq,
+	}
+	if created {
+		if err := utils.WriteCreated(w, data, "Audit checkpoint created", requestID); err != nil {
+			logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit checkpoint failed")
+		}
+		return
+	}
+	if err := utils.WriteSuccess(w, data, "Audit chain already checkpointed", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write audit checkpoint failed")
+	}
+}
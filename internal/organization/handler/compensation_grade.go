@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/compensation"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// CompensationGradeHandler 职等（薪酬等级）管理
+type CompensationGradeHandler struct {
+	grades      *compensation.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewCompensationGradeHandler 创建职等处理器
+func NewCompensationGradeHandler(grades *compensation.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *CompensationGradeHandler {
+	return &CompensationGradeHandler{
+		grades:      grades,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "compensationGrade", pkglogger.Fields{"module": "compensation"}),
+	}
+}
+
+func (h *CompensationGradeHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置职等路由
+func (h *CompensationGradeHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/compensation-grades", func(r chi.Router) {
+		r.Get("/", h.ListGrades)
+		r.Post("/", h.CreateGrade)
+		r.Get("/{code}", h.GetGrade)
+		r.Put("/{code}", h.UpdateGrade)
+		r.Delete("/{code}", h.DeleteGrade)
+		r.Get("/{code}/versions", h.ListGradeVersions)
+		r.Post("/{code}/versions", h.CreateGradeVersion)
+	})
+}
+
+type compensationGradeRequest struct {
+	Code          string                  `json:"code"`
+	Name          string                  `json:"name"`
+	Description   string                  `json:"description"`
+	Status        string                  `json:"status"`
+	JobLevelCodes []string                `json:"jobLevelCodes"`
+	PayRanges     []compensation.PayRange `json:"payRanges"`
+	EffectiveDate string                  `json:"effectiveDate"`
+}
+
+func (req compensationGradeRequest) toGrade(tenantID uuid.UUID, code string) compensation.Grade {
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status == "" {
+		status = compensation.StatusActive
+	}
+	levels := make([]string, 0, len(req.JobLevelCodes))
+	for _, level := range req.JobLevelCodes {
+		if level = strings.ToUpper(strings.TrimSpace(level)); level != "" {
+			levels = append(levels, level)
+		}
+	}
+	ranges := make([]compensation.PayRange, 0, len(req.PayRanges))
+	for _, pr := range req.PayRanges {
+		pr.Currency = strings.ToUpper(strings.TrimSpace(pr.Currency))
+		pr.Region = strings.ToUpper(strings.TrimSpace(pr.Region))
+		if pr.Region == "" {
+			pr.Region = compensation.RegionGlobal
+		}
+		ranges = append(ranges, pr)
+	}
+	return compensation.Grade{
+		TenantID:      tenantID,
+		Code:          strings.ToUpper(strings.TrimSpace(code)),
+		Name:          strings.TrimSpace(req.Name),
+		Description:   strings.TrimSpace(req.Description),
+		Status:        status,
+		JobLevelCodes: levels,
+		PayRanges:     ranges,
+		EffectiveDate: strings.TrimSpace(req.EffectiveDate),
+	}
+}
+
+func gradeCodeParam(r *http.Request) string {
+	return strings.ToUpper(strings.TrimSpace(chi.URLParam(r, "code")))
+}
+
+// asOfDateParam 读取 asOfDate 查询参数，格式不合法时返回 false
+func asOfDateParam(r *http.Request) (string, bool) {
+	asOf := strings.TrimSpace(r.URL.Query().Get("asOfDate"))
+	if asOf == "" {
+		return "", true
+	}
+	if _, err := time.Parse("2006-01-02", asOf); err != nil {
+		return "", false
+	}
+	return asOf, true
+}
+
+// ListGrades 列出指定日期生效的职等，可按职级过滤
+func (h *CompensationGradeHandler) ListGrades(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListCompensationGrades", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	includeInactive, _ := strconv.ParseBool(r.URL.Query().Get("includeInactive"))
+	grades, err := h.grades.ListGrades(r.Context(), tenantID, compensation.ListFilter{
+		AsOfDate:        asOf,
+		JobLevelCode:    strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("jobLevelCode"))),
+		IncludeInactive: includeInactive,
+	})
+	if writeCompensationGradeError(w, requestID, logger, "list compensation grades failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, grades, "Compensation grades retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grades failed")
+	}
+}
+
+// GetGrade 读取指定日期生效的职等版本
+func (h *CompensationGradeHandler) GetGrade(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := gradeCodeParam(r)
+	logger := h.requestLogger(r, "GetCompensationGrade", pkglogger.Fields{"tenantId": tenantID.String(), "grade": code})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	grade, err := h.grades.GetGrade(r.Context(), tenantID, code, asOf)
+	if writeCompensationGradeError(w, requestID, logger, "load compensation grade failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, grade, "Compensation grade retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade failed")
+	}
+}
+
+// ListGradeVersions 列出职等全部版本
+func (h *CompensationGradeHandler) ListGradeVersions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := gradeCodeParam(r)
+	logger := h.requestLogger(r, "ListCompensationGradeVersions", pkglogger.Fields{"tenantId": tenantID.String(), "grade": code})
+
+	versions, err := h.grades.ListVersions(r.Context(), tenantID, code)
+	if writeCompensationGradeError(w, requestID, logger, "list compensation grade versions failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, versions, "Compensation grade versions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade versions failed")
+	}
+}
+
+// CreateGrade 创建职等及其首个版本
+func (h *CompensationGradeHandler) CreateGrade(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateCompensationGrade", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req compensationGradeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	grade := req.toGrade(tenantID, req.Code)
+	grade.CreatedBy = getActorID(r)
+
+	created, err := h.grades.CreateGrade(r.Context(), grade)
+	if writeCompensationGradeError(w, requestID, logger, "create compensation grade failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateCompensationGrade", created.Code, nil, compensationGradeAuditData(created))
+	logger.WithFields(pkglogger.Fields{"grade": created.Code}).Info("compensation grade created")
+	if err := utils.WriteCreated(w, created, "Compensation grade created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade failed")
+	}
+}
+
+// CreateGradeVersion 为职等新增生效版本
+func (h *CompensationGradeHandler) CreateGradeVersion(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := gradeCodeParam(r)
+	logger := h.requestLogger(r, "CreateCompensationGradeVersion", pkglogger.Fields{"tenantId": tenantID.String(), "grade": code})
+
+	var req compensationGradeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	grade := req.toGrade(tenantID, code)
+	grade.CreatedBy = getActorID(r)
+
+	created, err := h.grades.CreateVersion(r.Context(), grade)
+	if writeCompensationGradeError(w, requestID, logger, "create compensation grade version failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateCompensationGradeVersion", code, nil, compensationGradeAuditData(created))
+	logger.WithFields(pkglogger.Fields{"effectiveDate": created.EffectiveDate}).Info("compensation grade version created")
+	if err := utils.WriteCreated(w, created, "Compensation grade version created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade version failed")
+	}
+}
+
+// UpdateGrade 原位更正当前生效版本
+func (h *CompensationGradeHandler) UpdateGrade(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := gradeCodeParam(r)
+	logger := h.requestLogger(r, "UpdateCompensationGrade", pkglogger.Fields{"tenantId": tenantID.String(), "grade": code})
+
+	var req compensationGradeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.grades.GetGrade(r.Context(), tenantID, code, "")
+	if err != nil && !errors.Is(err, compensation.ErrNotFound) {
+		writeCompensationGradeError(w, requestID, logger, "load compensation grade failed", err)
+		return
+	}
+
+	updated, err := h.grades.UpdateGrade(r.Context(), req.toGrade(tenantID, code))
+	if writeCompensationGradeError(w, requestID, logger, "update compensation grade failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateCompensationGrade", code, compensationGradeAuditData(previous), compensationGradeAuditData(updated))
+	logger.Info("compensation grade updated")
+	if err := utils.WriteSuccess(w, updated, "Compensation grade updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade failed")
+	}
+}
+
+// DeleteGrade 删除职等全部版本
+func (h *CompensationGradeHandler) DeleteGrade(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := gradeCodeParam(r)
+	logger := h.requestLogger(r, "DeleteCompensationGrade", pkglogger.Fields{"tenantId": tenantID.String(), "grade": code})
+
+	err := h.grades.DeleteGrade(r.Context(), tenantID, code)
+	if writeCompensationGradeError(w, requestID, logger, "delete compensation grade failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteCompensationGrade", code, nil, nil)
+	logger.Info("compensation grade deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"code": code}, "Compensation grade deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write compensation grade delete failed")
+	}
+}
+
+// writeCompensationGradeError 将职等服务错误映射为响应；返回 true 表示已写出错误
+func writeCompensationGradeError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, compensation.ErrInvalidGrade):
+		_ = utils.WriteBadRequest(w, "INVALID_COMPENSATION_GRADE", err.Error(), requestID, nil)
+	case errors.Is(err, compensation.ErrDuplicateCode):
+		_ = utils.WriteError(w, http.StatusConflict, "COMPENSATION_GRADE_CODE_EXISTS", "职等编码已存在", requestID, nil)
+	case errors.Is(err, compensation.ErrVersionExists):
+		_ = utils.WriteError(w, http.StatusConflict, "COMPENSATION_GRADE_VERSION_EXISTS", "该生效日期已存在职等版本", requestID, nil)
+	case errors.Is(err, compensation.ErrInUse):
+		_ = utils.WriteError(w, http.StatusConflict, "COMPENSATION_GRADE_IN_USE", "职等仍被职位引用，请先停用", requestID, nil)
+	case errors.Is(err, compensation.ErrNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "COMPENSATION_GRADE_NOT_FOUND", "职等不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func compensationGradeAuditData(grade *compensation.Grade) map[string]interface{} {
+	if grade == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"code":          grade.Code,
+		"name":          grade.Name,
+		"status":        grade.Status,
+		"jobLevelCodes": grade.JobLevelCodes,
+		"payRanges":     grade.PayRanges,
+		"effectiveDate": grade.EffectiveDate,
+	}
+}
+
+// logAuditAction 记录职等变更的审计事件（失败仅告警，不影响主流程）
+func (h *CompensationGradeHandler) logAuditAction(r *http.Request, eventType, action, code string, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "compensation_grade:" + code,
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
@@ -0,0 +1,350 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/costcenter"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// CostCenterHandler 成本中心主数据与职位成本分摊
+type CostCenterHandler struct {
+	costCenters *costcenter.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewCostCenterHandler 创建成本中心处理器
+func NewCostCenterHandler(costCenters *costcenter.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *CostCenterHandler {
+	return &CostCenterHandler{
+		costCenters: costCenters,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "costCenter", pkglogger.Fields{"module": "costCenter"}),
+	}
+}
+
+func (h *CostCenterHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置成本中心路由；职位分摊写入挂在职位路径下，读取走 GraphQL Position.costAllocations
+func (h *CostCenterHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/cost-centers", func(r chi.Router) {
+		r.Get("/", h.ListCostCenters)
+		r.Post("/", h.CreateCostCenter)
+		r.Get("/{code}", h.GetCostCenter)
+		r.Put("/{code}", h.UpdateCostCenter)
+		r.Delete("/{code}", h.DeleteCostCenter)
+		r.Get("/{code}/versions", h.ListCostCenterVersions)
+		r.Post("/{code}/versions", h.CreateCostCenterVersion)
+	})
+	r.Put("/api/v1/positions/{code}/cost-allocations", h.ReplacePositionAllocations)
+}
+
+type costCenterRequest struct {
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	ParentCode      string `json:"parentCode"`
+	LegalEntityCode string `json:"legalEntityCode"`
+	EffectiveDate   string `json:"effectiveDate"`
+}
+
+func (req costCenterRequest) toCostCenter(tenantID uuid.UUID, code string) costcenter.CostCenter {
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status == "" {
+		status = costcenter.StatusActive
+	}
+	return costcenter.CostCenter{
+		TenantID:        tenantID,
+		Code:            strings.ToUpper(strings.TrimSpace(code)),
+		Name:            strings.TrimSpace(req.Name),
+		Description:     strings.TrimSpace(req.Description),
+		Status:          status,
+		ParentCode:      strings.ToUpper(strings.TrimSpace(req.ParentCode)),
+		LegalEntityCode: strings.TrimSpace(req.LegalEntityCode),
+		EffectiveDate:   strings.TrimSpace(req.EffectiveDate),
+	}
+}
+
+type costAllocationRequest struct {
+	EffectiveDate string                  `json:"effectiveDate"`
+	Allocations   []costcenter.Allocation `json:"allocations"`
+}
+
+func costCenterCodeParam(r *http.Request) string {
+	return strings.ToUpper(strings.TrimSpace(chi.URLParam(r, "code")))
+}
+
+// ListCostCenters 列出指定日期生效的成本中心，可按法人实体与上级过滤
+func (h *CostCenterHandler) ListCostCenters(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListCostCenters", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	includeInactive, _ := strconv.ParseBool(r.URL.Query().Get("includeInactive"))
+	centers, err := h.costCenters.ListCostCenters(r.Context(), tenantID, costcenter.ListFilter{
+		AsOfDate:        asOf,
+		LegalEntityCode: strings.TrimSpace(r.URL.Query().Get("legalEntityCode")),
+		ParentCode:      strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("parentCode"))),
+		IncludeInactive: includeInactive,
+	})
+	if writeCostCenterError(w, requestID, logger, "list cost centers failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, centers, "Cost centers retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost centers failed")
+	}
+}
+
+// GetCostCenter 读取指定日期生效的成本中心版本
+func (h *CostCenterHandler) GetCostCenter(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "GetCostCenter", pkglogger.Fields{"tenantId": tenantID.String(), "costCenter": code})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	center, err := h.costCenters.GetCostCenter(r.Context(), tenantID, code, asOf)
+	if writeCostCenterError(w, requestID, logger, "load cost center failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, center, "Cost center retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center failed")
+	}
+}
+
+// ListCostCenterVersions 列出成本中心全部版本
+func (h *CostCenterHandler) ListCostCenterVersions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "ListCostCenterVersions", pkglogger.Fields{"tenantId": tenantID.String(), "costCenter": code})
+
+	versions, err := h.costCenters.ListVersions(r.Context(), tenantID, code)
+	if writeCostCenterError(w, requestID, logger, "list cost center versions failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, versions, "Cost center versions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center versions failed")
+	}
+}
+
+// CreateCostCenter 创建成本中心及其首个版本
+func (h *CostCenterHandler) CreateCostCenter(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateCostCenter", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req costCenterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	center := req.toCostCenter(tenantID, req.Code)
+	center.CreatedBy = getActorID(r)
+
+	created, err := h.costCenters.CreateCostCenter(r.Context(), center)
+	if writeCostCenterError(w, requestID, logger, "create cost center failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateCostCenter", "cost_center:"+created.Code, nil, costCenterAuditData(created))
+	logger.WithFields(pkglogger.Fields{"costCenter": created.Code}).Info("cost center created")
+	if err := utils.WriteCreated(w, created, "Cost center created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center failed")
+	}
+}
+
+// CreateCostCenterVersion 为成本中心新增生效版本
+func (h *CostCenterHandler) CreateCostCenterVersion(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "CreateCostCenterVersion", pkglogger.Fields{"tenantId": tenantID.String(), "costCenter": code})
+
+	var req costCenterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	center := req.toCostCenter(tenantID, code)
+	center.CreatedBy = getActorID(r)
+
+	created, err := h.costCenters.CreateVersion(r.Context(), center)
+	if writeCostCenterError(w, requestID, logger, "create cost center version failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateCostCenterVersion", "cost_center:"+code, nil, costCenterAuditData(created))
+	logger.WithFields(pkglogger.Fields{"effectiveDate": created.EffectiveDate}).Info("cost center version created")
+	if err := utils.WriteCreated(w, created, "Cost center version created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center version failed")
+	}
+}
+
+// UpdateCostCenter 原位更正当前生效版本
+func (h *CostCenterHandler) UpdateCostCenter(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "UpdateCostCenter", pkglogger.Fields{"tenantId": tenantID.String(), "costCenter": code})
+
+	var req costCenterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.costCenters.GetCostCenter(r.Context(), tenantID, code, "")
+	if err != nil && !errors.Is(err, costcenter.ErrNotFound) {
+		writeCostCenterError(w, requestID, logger, "load cost center failed", err)
+		return
+	}
+
+	updated, err := h.costCenters.UpdateCostCenter(r.Context(), req.toCostCenter(tenantID, code))
+	if writeCostCenterError(w, requestID, logger, "update cost center failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateCostCenter", "cost_center:"+code, costCenterAuditData(previous), costCenterAuditData(updated))
+	logger.Info("cost center updated")
+	if err := utils.WriteSuccess(w, updated, "Cost center updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center failed")
+	}
+}
+
+// DeleteCostCenter 删除成本中心全部版本
+func (h *CostCenterHandler) DeleteCostCenter(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "DeleteCostCenter", pkglogger.Fields{"tenantId": tenantID.String(), "costCenter": code})
+
+	err := h.costCenters.DeleteCostCenter(r.Context(), tenantID, code)
+	if writeCostCenterError(w, requestID, logger, "delete cost center failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteCostCenter", "cost_center:"+code, nil, nil)
+	logger.Info("cost center deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"code": code}, "Cost center deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write cost center delete failed")
+	}
+}
+
+// ReplacePositionAllocations 设置职位自生效日期起的成本分摊方案
+func (h *CostCenterHandler) ReplacePositionAllocations(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	positionCode := costCenterCodeParam(r)
+	logger := h.requestLogger(r, "ReplacePositionCostAllocations", pkglogger.Fields{"tenantId": tenantID.String(), "positionCode": positionCode})
+
+	var req costAllocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	allocations := make([]costcenter.Allocation, 0, len(req.Allocations))
+	for _, a := range req.Allocations {
+		a.CostCenterCode = strings.ToUpper(strings.TrimSpace(a.CostCenterCode))
+		allocations = append(allocations, a)
+	}
+
+	saved, err := h.costCenters.ReplaceAllocations(r.Context(), costcenter.AllocationSet{
+		TenantID:      tenantID,
+		PositionCode:  positionCode,
+		EffectiveDate: strings.TrimSpace(req.EffectiveDate),
+		Allocations:   allocations,
+		CreatedBy:     getActorID(r),
+	})
+	if writeCostCenterError(w, requestID, logger, "replace position cost allocations failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "ReplacePositionCostAllocations", "position_cost_allocation:"+positionCode, nil, map[string]interface{}{
+		"positionCode":  saved.PositionCode,
+		"effectiveDate": saved.EffectiveDate,
+		"allocations":   saved.Allocations,
+	})
+	logger.WithFields(pkglogger.Fields{"effectiveDate": saved.EffectiveDate}).Info("position cost allocations replaced")
+	if err := utils.WriteSuccess(w, saved, "Position cost allocations updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write position cost allocations failed")
+	}
+}
+
+// writeCostCenterError 将成本中心服务错误映射为响应；返回 true 表示已写出错误
+func writeCostCenterError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, costcenter.ErrInvalidCostCenter):
+		_ = utils.WriteBadRequest(w, "INVALID_COST_CENTER", err.Error(), requestID, nil)
+	case errors.Is(err, costcenter.ErrInvalidAllocation):
+		_ = utils.WriteBadRequest(w, "INVALID_COST_ALLOCATION", err.Error(), requestID, nil)
+	case errors.Is(err, costcenter.ErrDuplicateCode):
+		_ = utils.WriteError(w, http.StatusConflict, "COST_CENTER_CODE_EXISTS", "成本中心编码已存在", requestID, nil)
+	case errors.Is(err, costcenter.ErrVersionExists):
+		_ = utils.WriteError(w, http.StatusConflict, "COST_CENTER_VERSION_EXISTS", "该生效日期已存在成本中心版本", requestID, nil)
+	case errors.Is(err, costcenter.ErrInUse):
+		_ = utils.WriteError(w, http.StatusConflict, "COST_CENTER_IN_USE", "成本中心仍被职位、分摊或下级成本中心引用，请先停用", requestID, nil)
+	case errors.Is(err, costcenter.ErrNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "COST_CENTER_NOT_FOUND", "成本中心不存在", requestID, nil)
+	case errors.Is(err, costcenter.ErrPositionNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "POSITION_NOT_FOUND", "职位不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func costCenterAuditData(center *costcenter.CostCenter) map[string]interface{} {
+	if center == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"code":            center.Code,
+		"name":            center.Name,
+		"status":          center.Status,
+		"parentCode":      center.ParentCode,
+		"legalEntityCode": center.LegalEntityCode,
+		"effectiveDate":   center.EffectiveDate,
+	}
+}
+
+// logAuditAction 记录成本中心与分摊变更的审计事件（失败仅告警，不影响主流程）
+func (h *CostCenterHandler) logAuditAction(r *http.Request, eventType, action, resourceID string, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   resourceID,
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/customfield"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	"cube-castle/internal/types"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// CustomFieldHandler 租户自定义字段定义管理
+type CustomFieldHandler struct {
+	customFields *customfield.Service
+	auditLogger  *auditpkg.AuditLogger
+	logger       pkglogger.Logger
+}
+
+// NewCustomFieldHandler 创建自定义字段处理器
+func NewCustomFieldHandler(customFields *customfield.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		customFields: customFields,
+		auditLogger:  auditLogger,
+		logger:       scopedLogger(baseLogger, "customField", pkglogger.Fields{"module": "customField"}),
+	}
+}
+
+func (h *CustomFieldHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置自定义字段路由
+func (h *CustomFieldHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/custom-fields", func(r chi.Router) {
+		r.Get("/", h.ListDefinitions)
+		r.Post("/", h.CreateDefinition)
+		r.Get("/{id}", h.GetDefinition)
+		r.Put("/{id}", h.UpdateDefinition)
+		r.Delete("/{id}", h.DeleteDefinition)
+	})
+}
+
+type definitionRequest struct {
+	EntityType  string                 `json:"entityType"`
+	Key         string                 `json:"key"`
+	Label       string                 `json:"label"`
+	Description string                 `json:"description"`
+	DataType    string                 `json:"dataType"`
+	Required    bool                   `json:"required"`
+	AppliesTo   []string               `json:"appliesTo"`
+	Validation  customfield.Validation `json:"validation"`
+	Active      *bool                  `json:"active"`
+}
+
+func (req definitionRequest) toDefinition(tenantID uuid.UUID) customfield.Definition {
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+	appliesTo := make([]string, 0, len(req.AppliesTo))
+	for _, subtype := range req.AppliesTo {
+		if subtype = strings.ToUpper(strings.TrimSpace(subtype)); subtype != "" {
+			appliesTo = append(appliesTo, subtype)
+		}
+	}
+	return customfield.Definition{
+		TenantID:    tenantID,
+		EntityType:  strings.ToUpper(strings.TrimSpace(req.EntityType)),
+		Key:         strings.TrimSpace(req.Key),
+		Label:       strings.TrimSpace(req.Label),
+		Description: strings.TrimSpace(req.Description),
+		DataType:    strings.ToUpper(strings.TrimSpace(req.DataType)),
+		Required:    req.Required,
+		AppliesTo:   appliesTo,
+		Validation:  req.Validation,
+		Active:      active,
+	}
+}
+
+func parseCustomFieldID(w http.ResponseWriter, r *http.Request, requestID string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_CUSTOM_FIELD_ID", "ID 必须为 UUID", requestID, nil)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// ListDefinitions 列出当前租户的字段定义，可按 entityType 过滤
+func (h *CustomFieldHandler) ListDefinitions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListCustomFields", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	entityType := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("entityType")))
+	switch entityType {
+	case "", types.CustomFieldEntityOrganization, types.CustomFieldEntityPosition:
+	default:
+		_ = utils.WriteBadRequest(w, "INVALID_ENTITY_TYPE", "entityType 仅支持 ORGANIZATION/POSITION", requestID, nil)
+		return
+	}
+
+	defs, err := h.customFields.ListAllDefinitions(r.Context(), tenantID, entityType)
+	if writeCustomFieldError(w, requestID, logger, "list custom field definitions failed", err) {
+		return
+	}
+	if defs == nil {
+		defs = []customfield.Definition{}
+	}
+	if err := utils.WriteSuccess(w, defs, "Custom field definitions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write custom field definitions failed")
+	}
+}
+
+// GetDefinition 读取单个字段定义
+func (h *CustomFieldHandler) GetDefinition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "GetCustomField", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseCustomFieldID(w, r, requestID)
+	if !ok {
+		return
+	}
+	def, err := h.customFields.GetDefinition(r.Context(), tenantID, id)
+	if writeCustomFieldError(w, requestID, logger, "load custom field definition failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, def, "Custom field definition retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write custom field definition failed")
+	}
+}
+
+// CreateDefinition 创建字段定义
+func (h *CustomFieldHandler) CreateDefinition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateCustomField", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req definitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	def := req.toDefinition(tenantID)
+	def.CreatedBy = getActorID(r)
+
+	created, err := h.customFields.CreateDefinition(r.Context(), def)
+	if writeCustomFieldError(w, requestID, logger, "create custom field definition failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateCustomField", created.ID, nil, definitionAuditData(created))
+	logger.WithFields(pkglogger.Fields{"definitionId": created.ID.String(), "fieldKey": created.Key}).Info("custom field definition created")
+	if err := utils.WriteCreated(w, created, "Custom field definition created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write custom field definition failed")
+	}
+}
+
+// UpdateDefinition 更新字段定义（entityType、key、dataType 不可修改）
+func (h *CustomFieldHandler) UpdateDefinition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "UpdateCustomField", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseCustomFieldID(w, r, requestID)
+	if !ok {
+		return
+	}
+	var req definitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.customFields.GetDefinition(r.Context(), tenantID, id)
+	if writeCustomFieldError(w, requestID, logger, "load custom field definition failed", err) {
+		return
+	}
+	def := req.toDefinition(tenantID)
+	def.ID = id
+
+	updated, err := h.customFields.UpdateDefinition(r.Context(), def)
+	if writeCustomFieldError(w, requestID, logger, "update custom field definition failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateCustomField", id, definitionAuditData(previous), definitionAuditData(updated))
+	logger.WithFields(pkglogger.Fields{"definitionId": id.String()}).Info("custom field definition updated")
+	if err := utils.WriteSuccess(w, updated, "Custom field definition updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write custom field definition failed")
+	}
+}
+
+// DeleteDefinition 删除字段定义；已写入各版本的历史值保留
+func (h *CustomFieldHandler) DeleteDefinition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "DeleteCustomField", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseCustomFieldID(w, r, requestID)
+	if !ok {
+		return
+	}
+	err := h.customFields.DeleteDefinition(r.Context(), tenantID, id)
+	if writeCustomFieldError(w, requestID, logger, "delete custom field definition failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteCustomField", id, nil, nil)
+	logger.WithFields(pkglogger.Fields{"definitionId": id.String()}).Info("custom field definition deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"id": id}, "Custom field definition deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write custom field delete failed")
+	}
+}
+
+// writeCustomFieldError 将自定义字段服务错误映射为响应；返回 true 表示已写出错误
+func writeCustomFieldError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, customfield.ErrInvalidDefinition):
+		_ = utils.WriteBadRequest(w, "INVALID_CUSTOM_FIELD", err.Error(), requestID, nil)
+	case errors.Is(err, customfield.ErrDuplicateKey):
+		_ = utils.WriteError(w, http.StatusConflict, "CUSTOM_FIELD_KEY_EXISTS", "自定义字段键已存在", requestID, nil)
+	case errors.Is(err, customfield.ErrDefinitionNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "CUSTOM_FIELD_NOT_FOUND", "自定义字段定义不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func definitionAuditData(def *customfield.Definition) map[string]interface{} {
+	if def == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"entityType": def.EntityType,
+		"key":        def.Key,
+		"label":      def.Label,
+		"dataType":   def.DataType,
+		"required":   def.Required,
+		"appliesTo":  def.AppliesTo,
+		"validation": def.Validation,
+		"active":     def.Active,
+	}
+}
+
+// logAuditAction 记录字段定义变更的审计事件（失败仅告警，不影响主流程）
+func (h *CustomFieldHandler) logAuditAction(r *http.Request, eventType, action string, id uuid.UUID, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "custom_field:" + id.String(),
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	auth "cube-castle/internal/auth"
@@ -24,6 +25,8 @@ type DevToolsHandler struct {
 	logger        pkglogger.Logger
 	devMode       bool
 	db            *sql.DB
+	// disabled 运行时开关：开发模式下可临时关闭开发工具端点
+	disabled atomic.Bool
 }
 
 // NewDevToolsHandler 创建开发工具处理器
@@ -53,11 +56,13 @@ func (h *DevToolsHandler) SetupRoutes(r chi.Router) {
 	// 只在开发模式下启用开发工具端点
 	if h.devMode {
 		r.Route("/auth", func(r chi.Router) {
+			r.Use(h.enabledGate)
 			r.Post("/dev-token", h.GenerateDevToken)
 			r.Get("/dev-token/info", h.GetTokenInfo)
 		})
 
 		r.Route("/dev", func(r chi.Router) {
+			r.Use(h.enabledGate)
 			r.Get("/status", h.DevStatus)
 			r.Get("/test-endpoints", h.ListTestEndpoints)
 			r.Get("/database-status", h.DatabaseStatus)
@@ -67,6 +72,21 @@ func (h *DevToolsHandler) SetupRoutes(r chi.Router) {
 	}
 }
 
+// SetEnabled 运行时开启或关闭开发工具端点（仅在开发模式下挂载的路由生效）
+func (h *DevToolsHandler) SetEnabled(enabled bool) {
+	h.disabled.Store(!enabled)
+}
+
+func (h *DevToolsHandler) enabledGate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.disabled.Load() {
+			h.writeErrorResponse(w, "DEV_TOOLS_DISABLED", "Development tools are disabled at runtime", http.StatusForbidden, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // GenerateDevToken 生成开发测试令牌
 func (h *DevToolsHandler) GenerateDevToken(w http.ResponseWriter, r *http.Request) {
 	if !h.devMode {
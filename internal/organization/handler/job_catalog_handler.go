@@ -1,12 +1,16 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	"cube-castle/internal/auth"
 	"cube-castle/internal/organization/middleware"
 	"cube-castle/internal/organization/service"
 	"cube-castle/internal/organization/utils"
@@ -14,6 +18,7 @@ import (
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 type JobCatalogHandler struct {
@@ -56,6 +61,13 @@ func (h *JobCatalogHandler) SetupRoutes(r chi.Router) {
 	r.Post("/api/v1/job-levels", h.CreateJobLevel)
 	r.Put("/api/v1/job-levels/{code}", h.UpdateJobLevel)
 	r.Post("/api/v1/job-levels/{code}/versions", h.CreateJobLevelVersion)
+	r.Post("/api/v1/job-family-groups/{code}/retire", h.RetireJobFamilyGroup)
+	r.Post("/api/v1/job-families/{code}/retire", h.RetireJobFamily)
+	r.Post("/api/v1/job-roles/{code}/retire", h.RetireJobRole)
+	r.Post("/api/v1/job-levels/{code}/retire", h.RetireJobLevel)
+	r.Get("/api/v1/job-catalog/export", h.ExportJobCatalog)
+	r.Post("/api/v1/job-catalog/import", h.ImportJobCatalog)
+	r.Post("/api/v1/job-catalog/clone", h.CloneJobCatalog)
 }
 
 func (h *JobCatalogHandler) CreateJobFamilyGroup(w http.ResponseWriter, r *http.Request) {
@@ -503,6 +515,209 @@ func (h *JobCatalogHandler) CreateJobLevelVersion(w http.ResponseWriter, r *http
 	}
 }
 
+func (h *JobCatalogHandler) RetireJobFamilyGroup(w http.ResponseWriter, r *http.Request) {
+	h.retireJobCatalog(w, r, types.JobCatalogLevelFamilyGroup, "RetireJobFamilyGroup", "缺少职类代码")
+}
+
+func (h *JobCatalogHandler) RetireJobFamily(w http.ResponseWriter, r *http.Request) {
+	h.retireJobCatalog(w, r, types.JobCatalogLevelFamily, "RetireJobFamily", "缺少职种代码")
+}
+
+func (h *JobCatalogHandler) RetireJobRole(w http.ResponseWriter, r *http.Request) {
+	h.retireJobCatalog(w, r, types.JobCatalogLevelRole, "RetireJobRole", "缺少职务代码")
+}
+
+func (h *JobCatalogHandler) RetireJobLevel(w http.ResponseWriter, r *http.Request) {
+	h.retireJobCatalog(w, r, types.JobCatalogLevelLevel, "RetireJobLevel", "缺少职级代码")
+}
+
+// retireJobCatalog 停用职位目录条目；dryRun 时仅返回影响报告
+func (h *JobCatalogHandler) retireJobCatalog(w http.ResponseWriter, r *http.Request, level, action, missingCodeMessage string) {
+	code := strings.ToUpper(strings.TrimSpace(chi.URLParam(r, "code")))
+	if code == "" {
+		h.writeError(w, r, http.StatusBadRequest, "MISSING_CODE", missingCodeMessage, nil)
+		return
+	}
+	reqLogger := h.requestLogger(r, action)
+
+	var req types.RetireJobCatalogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, r, http.StatusBadRequest, "INVALID_REQUEST", "请求格式无效", err)
+		return
+	}
+	req.EffectiveDate = strings.TrimSpace(req.EffectiveDate)
+	req.OperationReason = strings.TrimSpace(req.OperationReason)
+	if req.EffectiveDate == "" || (!req.DryRun && req.OperationReason == "") {
+		h.writeError(w, r, http.StatusBadRequest, "VALIDATION_ERROR", "停用日期与操作原因为必填项", map[string]interface{}{
+			"effectiveDate":   req.EffectiveDate,
+			"operationReason": req.OperationReason,
+		})
+		return
+	}
+
+	tenantID := getTenantIDFromRequest(r)
+	operator := getOperatorFromRequest(r)
+
+	result, err := h.service.RetireJobCatalog(r.Context(), tenantID, level, code, &req, operator)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	message := "Job catalog entry retired successfully"
+	if result.DryRun {
+		message = "Job catalog retirement impact analyzed"
+	}
+	requestID := middleware.GetRequestID(r.Context())
+	if err := utils.WriteSuccess(w, result, message, requestID); err != nil {
+		reqLogger.WithFields(pkglogger.Fields{"error": err}).Error("write job catalog retirement response failed")
+	}
+}
+
+// jobCatalogImportMaxBytes 导入文档大小上限
+const jobCatalogImportMaxBytes = 10 << 20
+
+var jobCatalogContentTypes = map[string]string{
+	types.JobCatalogFormatJSON: "application/json",
+	types.JobCatalogFormatYAML: "application/yaml",
+	types.JobCatalogFormatCSV:  "text/csv; charset=utf-8",
+}
+
+// ExportJobCatalog 以交换格式（json / yaml / csv）导出租户职位目录的全部版本
+func (h *JobCatalogHandler) ExportJobCatalog(w http.ResponseWriter, r *http.Request) {
+	reqLogger := h.requestLogger(r, "ExportJobCatalog")
+	format := types.JobCatalogFormatJSON
+	if raw := r.URL.Query().Get("format"); raw != "" {
+		if format = service.NormalizeJobCatalogFormat(raw); format == "" {
+			h.writeError(w, r, http.StatusBadRequest, "INVALID_FORMAT", "format 仅支持 json、yaml 或 csv", nil)
+			return
+		}
+	}
+
+	tenantID := getTenantIDFromRequest(r)
+	doc, err := h.service.ExportJobCatalog(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+
+	// 先完整编码再写响应头，编码失败时仍可返回统一错误
+	var buf bytes.Buffer
+	if err := service.EncodeJobCatalogDocument(&buf, doc, format); err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", jobCatalogContentTypes[format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="job-catalog-%s.%s"`, time.Now().UTC().Format("20060102"), format))
+	w.Header().Set("X-Request-ID", middleware.GetRequestID(r.Context()))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		reqLogger.WithFields(pkglogger.Fields{"error": err}).Error("write job catalog export failed")
+	}
+}
+
+// ImportJobCatalog 导入职位目录交换文档；dryRun=true 时仅返回与现有目录的差异
+func (h *JobCatalogHandler) ImportJobCatalog(w http.ResponseWriter, r *http.Request) {
+	reqLogger := h.requestLogger(r, "ImportJobCatalog")
+	query := r.URL.Query()
+	format := jobCatalogFormatFromContentType(r.Header.Get("Content-Type"))
+	if raw := query.Get("format"); raw != "" {
+		format = service.NormalizeJobCatalogFormat(raw)
+	}
+	if format == "" {
+		h.writeError(w, r, http.StatusBadRequest, "INVALID_FORMAT", "format 仅支持 json、yaml 或 csv", nil)
+		return
+	}
+	dryRun := false
+	if raw := query.Get("dryRun"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.writeError(w, r, http.StatusBadRequest, "INVALID_DRY_RUN", "dryRun 必须为布尔值", nil)
+			return
+		}
+		dryRun = parsed
+	}
+
+	doc, err := service.DecodeJobCatalogDocument(http.MaxBytesReader(w, r.Body, jobCatalogImportMaxBytes), format)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.writeError(w, r, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "导入文档超过大小上限", nil)
+			return
+		}
+		h.writeError(w, r, http.StatusBadRequest, "INVALID_REQUEST", "导入文档格式无效", err.Error())
+		return
+	}
+
+	tenantID := getTenantIDFromRequest(r)
+	operator := getOperatorFromRequest(r)
+	result, err := h.service.ImportJobCatalog(r.Context(), tenantID, doc, dryRun, operator)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	h.writeImportResult(w, r, reqLogger, result, "Job catalog imported successfully")
+}
+
+// CloneJobCatalog 将指定源租户的职位目录克隆到当前租户
+func (h *JobCatalogHandler) CloneJobCatalog(w http.ResponseWriter, r *http.Request) {
+	reqLogger := h.requestLogger(r, "CloneJobCatalog")
+	var req types.CloneJobCatalogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, r, http.StatusBadRequest, "INVALID_REQUEST", "请求格式无效", err)
+		return
+	}
+	sourceTenantID, err := uuid.Parse(strings.TrimSpace(req.SourceTenantID))
+	if err != nil {
+		h.writeError(w, r, http.StatusBadRequest, "VALIDATION_ERROR", "sourceTenantId 必须为有效的 UUID", map[string]interface{}{
+			"sourceTenantId": req.SourceTenantID,
+		})
+		return
+	}
+
+	tenantID := getTenantIDFromRequest(r)
+	// 跨租户克隆仅限平台签发运营方标记的令牌，租户角色（含 ADMIN）与 IdP 声明的 scope 均不授予该权限
+	if sourceTenantID != tenantID && !auth.IsPlatformOperator(r.Context()) {
+		reqLogger.WithFields(pkglogger.Fields{"sourceTenantId": sourceTenantID.String()}).Warn("cross-tenant job catalog clone denied")
+		h.writeError(w, r, http.StatusForbidden, "CROSS_TENANT_CLONE_FORBIDDEN", "无权克隆其他租户的职位目录", map[string]interface{}{
+			"sourceTenantId": sourceTenantID.String(),
+		})
+		return
+	}
+	operator := getOperatorFromRequest(r)
+	result, err := h.service.CloneJobCatalog(r.Context(), sourceTenantID, tenantID, req.DryRun, operator)
+	if err != nil {
+		h.handleServiceError(w, r, err)
+		return
+	}
+	h.writeImportResult(w, r, reqLogger, result, "Job catalog cloned successfully")
+}
+
+func (h *JobCatalogHandler) writeImportResult(w http.ResponseWriter, r *http.Request, reqLogger pkglogger.Logger, result *types.JobCatalogImportResult, message string) {
+	if result.DryRun {
+		message = "Job catalog import diff computed"
+	}
+	requestID := middleware.GetRequestID(r.Context())
+	if err := utils.WriteSuccess(w, result, message, requestID); err != nil {
+		reqLogger.WithFields(pkglogger.Fields{"error": err}).Error("write job catalog import response failed")
+	}
+}
+
+// jobCatalogFormatFromContentType 根据 Content-Type 推断导入格式，未指定时按 JSON 处理
+func jobCatalogFormatFromContentType(contentType string) string {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch mediaType {
+	case "", "application/json":
+		return types.JobCatalogFormatJSON
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return types.JobCatalogFormatYAML
+	case "text/csv", "application/csv":
+		return types.JobCatalogFormatCSV
+	default:
+		return ""
+	}
+}
+
 func (h *JobCatalogHandler) handleServiceError(w http.ResponseWriter, r *http.Request, err error) {
 	var validationErr *validator.ValidationFailedError
 	if errors.As(err, &validationErr) {
@@ -519,6 +734,12 @@ func (h *JobCatalogHandler) handleServiceError(w http.ResponseWriter, r *http.Re
 		h.writeError(w, r, http.StatusNotFound, "JOB_CATALOG_NOT_FOUND", "职位分类不存在", err)
 	case errors.Is(err, service.ErrJobCatalogConflict):
 		h.writeError(w, r, http.StatusConflict, "JOB_CATALOG_CONFLICT", "职位分类存在冲突的生效日期", err)
+	case errors.Is(err, service.ErrJobCatalogAlreadyRetired):
+		h.writeError(w, r, http.StatusConflict, "JOB_CATALOG_ALREADY_RETIRED", "职位分类在该日期已停用", err)
+	case errors.Is(err, service.ErrJobCatalogReplacementInvalid):
+		h.writeError(w, r, http.StatusBadRequest, "JOB_CATALOG_REPLACEMENT_INVALID", "替代职位分类无效", err)
+	case errors.Is(err, service.ErrPositionVersionExists):
+		h.writeError(w, r, http.StatusConflict, "POSITION_VERSION_EXISTS", "职位在停用日已存在版本", err)
 	case errors.Is(err, service.ErrJobCatalogPreconditionFailed):
 		h.writeError(w, r, http.StatusPreconditionFailed, "PRECONDITION_FAILED", "资源版本已过期，请刷新后重试", err)
 	default:
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"cube-castle/internal/auth"
 	"cube-castle/internal/organization/service"
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
@@ -40,6 +41,39 @@ func TestJobCatalogHandlePreconditionFailed(t *testing.T) {
 	}
 }
 
+func TestCloneJobCatalogRejectsForeignSourceTenant(t *testing.T) {
+	handler := &JobCatalogHandler{
+		logger: pkglogger.NewNoopLogger(),
+	}
+	tenantID := "3b99930c-4dc6-4cc9-8e4d-7d960a931cb9"
+	foreignTenantID := "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	// 租户 ADMIN 令牌即使携带 job-catalog:clone scope 也不是平台运营方
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/job-catalog/clone", strings.NewReader(`{"sourceTenantId":"`+foreignTenantID+`","dryRun":true}`))
+	req.Header.Set("X-Tenant-ID", tenantID)
+	req = req.WithContext(auth.SetUserContext(req.Context(), &auth.Claims{
+		UserID:   "tenant-admin",
+		TenantID: tenantID,
+		Roles:    []string{"ADMIN"},
+		Scope:    "job-catalog:read job-catalog:write job-catalog:clone",
+	}))
+	rec := httptest.NewRecorder()
+
+	handler.CloneJobCatalog(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	errorField, _ := body["error"].(map[string]any)
+	if code, _ := errorField["code"].(string); code != "CROSS_TENANT_CLONE_FORBIDDEN" {
+		t.Fatalf("expected error code CROSS_TENANT_CLONE_FORBIDDEN, got %v", body)
+	}
+}
+
 // TestValidateCreateJobLevelRequest tests the CreateJobLevel request validation
 func TestValidateCreateJobLevelRequest(t *testing.T) {
 	tests := []struct {
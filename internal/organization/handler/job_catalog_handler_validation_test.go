@@ -80,4 +80,55 @@ func TestJobCatalogHandlers_EarlyValidationFailures(t *testing.T) {
 			t.Fatalf("expected 400, got %d", rr.Code)
 		}
 	})
+
+	t.Run("RetireJobRole missing code", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/job-roles//retire", strings.NewReader(`{}`))
+		req = withCodeParam(req, "")
+		handler.RetireJobRole(rr, req)
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", rr.Code)
+		}
+	})
+
+	t.Run("RetireJobLevel requires operation reason unless dry run", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/job-levels/LL-1/retire", strings.NewReader(`{"effectiveDate":"2025-01-01"}`))
+		req = withCodeParam(req, "LL-1")
+		handler.RetireJobLevel(rr, req)
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", rr.Code)
+		}
+		if !strings.Contains(rr.Body.String(), "VALIDATION_ERROR") {
+			t.Fatalf("expected VALIDATION_ERROR, got %s", rr.Body.String())
+		}
+	})
+
+	t.Run("ExportJobCatalog rejects unknown format", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/job-catalog/export?format=xml", nil)
+		handler.ExportJobCatalog(rr, req)
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "INVALID_FORMAT") {
+			t.Fatalf("expected INVALID_FORMAT, got %d %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("ImportJobCatalog rejects malformed csv", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/job-catalog/import?dryRun=true", strings.NewReader("level,code\nJOB_FAMILY_GROUP,PROF\n"))
+		req.Header.Set("Content-Type", "text/csv")
+		handler.ImportJobCatalog(rr, req)
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "INVALID_REQUEST") {
+			t.Fatalf("expected INVALID_REQUEST, got %d %s", rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("CloneJobCatalog requires source tenant uuid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/job-catalog/clone", strings.NewReader(`{"sourceTenantId":"tenant-a"}`))
+		handler.CloneJobCatalog(rr, req)
+		if rr.Code != http.StatusBadRequest || !strings.Contains(rr.Body.String(), "VALIDATION_ERROR") {
+			t.Fatalf("expected VALIDATION_ERROR, got %d %s", rr.Code, rr.Body.String())
+		}
+	})
 }
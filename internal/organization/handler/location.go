@@ -0,0 +1,372 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/location"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// LocationHandler 工作地点主数据与组织单元、职位的地点分配
+type LocationHandler struct {
+	locations   *location.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewLocationHandler 创建地点处理器
+func NewLocationHandler(locations *location.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *LocationHandler {
+	return &LocationHandler{
+		locations:   locations,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "location", pkglogger.Fields{"module": "location"}),
+	}
+}
+
+func (h *LocationHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// locationSubjects 分配路径中的对象类型段
+var locationSubjects = map[string]string{
+	"organization-units": location.SubjectOrganization,
+	"positions":          location.SubjectPosition,
+}
+
+// SetupRoutes 设置地点路由；分配写入按对象类型区分路径，生效地点读取走 GraphQL Organization.location / Position.location
+func (h *LocationHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/locations", func(r chi.Router) {
+		r.Get("/", h.ListLocations)
+		r.Post("/", h.CreateLocation)
+		r.Get("/{code}", h.GetLocation)
+		r.Put("/{code}", h.UpdateLocation)
+		r.Delete("/{code}", h.DeleteLocation)
+		r.Get("/{code}/versions", h.ListLocationVersions)
+		r.Post("/{code}/versions", h.CreateLocationVersion)
+	})
+	r.Put("/api/v1/location-assignments/{subject}/{code}", h.AssignLocation)
+}
+
+type locationRequest struct {
+	Code          string           `json:"code"`
+	Name          string           `json:"name"`
+	Type          string           `json:"type"`
+	Status        string           `json:"status"`
+	ParentCode    string           `json:"parentCode"`
+	CountryCode   string           `json:"countryCode"`
+	TimeZone      string           `json:"timeZone"`
+	Address       location.Address `json:"address"`
+	EffectiveDate string           `json:"effectiveDate"`
+}
+
+func (req locationRequest) toLocation(tenantID uuid.UUID, code string) location.Location {
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status == "" {
+		status = location.StatusActive
+	}
+	return location.Location{
+		TenantID:    tenantID,
+		Code:        strings.ToUpper(strings.TrimSpace(code)),
+		Name:        strings.TrimSpace(req.Name),
+		Type:        strings.ToUpper(strings.TrimSpace(req.Type)),
+		Status:      status,
+		ParentCode:  strings.ToUpper(strings.TrimSpace(req.ParentCode)),
+		CountryCode: strings.ToUpper(strings.TrimSpace(req.CountryCode)),
+		TimeZone:    strings.TrimSpace(req.TimeZone),
+		Address: location.Address{
+			Line1:         strings.TrimSpace(req.Address.Line1),
+			Line2:         strings.TrimSpace(req.Address.Line2),
+			City:          strings.TrimSpace(req.Address.City),
+			StateProvince: strings.TrimSpace(req.Address.StateProvince),
+			PostalCode:    strings.TrimSpace(req.Address.PostalCode),
+		},
+		EffectiveDate: strings.TrimSpace(req.EffectiveDate),
+	}
+}
+
+type locationAssignmentRequest struct {
+	LocationCode  string `json:"locationCode"`
+	EffectiveDate string `json:"effectiveDate"`
+}
+
+func locationCodeParam(r *http.Request) string {
+	return strings.ToUpper(strings.TrimSpace(chi.URLParam(r, "code")))
+}
+
+// ListLocations 列出指定日期生效的地点，可按类型、上级区域与国家过滤
+func (h *LocationHandler) ListLocations(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListLocations", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	query := r.URL.Query()
+	includeInactive, _ := strconv.ParseBool(query.Get("includeInactive"))
+	locations, err := h.locations.ListLocations(r.Context(), tenantID, location.ListFilter{
+		AsOfDate:        asOf,
+		Type:            strings.ToUpper(strings.TrimSpace(query.Get("type"))),
+		ParentCode:      strings.ToUpper(strings.TrimSpace(query.Get("parentCode"))),
+		CountryCode:     strings.ToUpper(strings.TrimSpace(query.Get("countryCode"))),
+		IncludeInactive: includeInactive,
+	})
+	if writeLocationError(w, requestID, logger, "list locations failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, locations, "Locations retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write locations failed")
+	}
+}
+
+// GetLocation 读取指定日期生效的地点版本
+func (h *LocationHandler) GetLocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := locationCodeParam(r)
+	logger := h.requestLogger(r, "GetLocation", pkglogger.Fields{"tenantId": tenantID.String(), "location": code})
+
+	asOf, ok := asOfDateParam(r)
+	if !ok {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "asOfDate 格式应为 YYYY-MM-DD", requestID, nil)
+		return
+	}
+	loc, err := h.locations.GetLocation(r.Context(), tenantID, code, asOf)
+	if writeLocationError(w, requestID, logger, "load location failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, loc, "Location retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location failed")
+	}
+}
+
+// ListLocationVersions 列出地点全部版本
+func (h *LocationHandler) ListLocationVersions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := locationCodeParam(r)
+	logger := h.requestLogger(r, "ListLocationVersions", pkglogger.Fields{"tenantId": tenantID.String(), "location": code})
+
+	versions, err := h.locations.ListVersions(r.Context(), tenantID, code)
+	if writeLocationError(w, requestID, logger, "list location versions failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, versions, "Location versions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location versions failed")
+	}
+}
+
+// CreateLocation 创建地点及其首个版本
+func (h *LocationHandler) CreateLocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateLocation", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req locationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	loc := req.toLocation(tenantID, req.Code)
+	loc.CreatedBy = getActorID(r)
+
+	created, err := h.locations.CreateLocation(r.Context(), loc)
+	if writeLocationError(w, requestID, logger, "create location failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateLocation", "location:"+created.Code, nil, locationAuditData(created))
+	logger.WithFields(pkglogger.Fields{"location": created.Code}).Info("location created")
+	if err := utils.WriteCreated(w, created, "Location created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location failed")
+	}
+}
+
+// CreateLocationVersion 为地点新增生效版本
+func (h *LocationHandler) CreateLocationVersion(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := locationCodeParam(r)
+	logger := h.requestLogger(r, "CreateLocationVersion", pkglogger.Fields{"tenantId": tenantID.String(), "location": code})
+
+	var req locationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	loc := req.toLocation(tenantID, code)
+	loc.CreatedBy = getActorID(r)
+
+	created, err := h.locations.CreateVersion(r.Context(), loc)
+	if writeLocationError(w, requestID, logger, "create location version failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateLocationVersion", "location:"+code, nil, locationAuditData(created))
+	logger.WithFields(pkglogger.Fields{"effectiveDate": created.EffectiveDate}).Info("location version created")
+	if err := utils.WriteCreated(w, created, "Location version created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location version failed")
+	}
+}
+
+// UpdateLocation 原位更正当前生效版本
+func (h *LocationHandler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := locationCodeParam(r)
+	logger := h.requestLogger(r, "UpdateLocation", pkglogger.Fields{"tenantId": tenantID.String(), "location": code})
+
+	var req locationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.locations.GetLocation(r.Context(), tenantID, code, "")
+	if err != nil && !errors.Is(err, location.ErrNotFound) {
+		writeLocationError(w, requestID, logger, "load location failed", err)
+		return
+	}
+
+	updated, err := h.locations.UpdateLocation(r.Context(), req.toLocation(tenantID, code))
+	if writeLocationError(w, requestID, logger, "update location failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateLocation", "location:"+code, locationAuditData(previous), locationAuditData(updated))
+	logger.Info("location updated")
+	if err := utils.WriteSuccess(w, updated, "Location updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location failed")
+	}
+}
+
+// DeleteLocation 删除地点全部版本
+func (h *LocationHandler) DeleteLocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := locationCodeParam(r)
+	logger := h.requestLogger(r, "DeleteLocation", pkglogger.Fields{"tenantId": tenantID.String(), "location": code})
+
+	err := h.locations.DeleteLocation(r.Context(), tenantID, code)
+	if writeLocationError(w, requestID, logger, "delete location failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteLocation", "location:"+code, nil, nil)
+	logger.Info("location deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"code": code}, "Location deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location delete failed")
+	}
+}
+
+// AssignLocation 设置组织单元默认地点或职位地点覆盖；locationCode 为空时清除（职位恢复继承组织单元默认地点）
+func (h *LocationHandler) AssignLocation(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	subjectCode := locationCodeParam(r)
+	subjectType, ok := locationSubjects[chi.URLParam(r, "subject")]
+	if !ok {
+		_ = utils.WriteError(w, http.StatusNotFound, "NOT_FOUND", "不支持的地点分配对象", requestID, nil)
+		return
+	}
+	logger := h.requestLogger(r, "AssignLocation", pkglogger.Fields{"tenantId": tenantID.String(), "subjectType": subjectType, "subjectCode": subjectCode})
+
+	var req locationAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+
+	saved, err := h.locations.Assign(r.Context(), location.Assignment{
+		TenantID:      tenantID,
+		SubjectType:   subjectType,
+		SubjectCode:   subjectCode,
+		LocationCode:  strings.ToUpper(strings.TrimSpace(req.LocationCode)),
+		EffectiveDate: strings.TrimSpace(req.EffectiveDate),
+		CreatedBy:     getActorID(r),
+	})
+	if writeLocationError(w, requestID, logger, "assign location failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "AssignLocation", "location_assignment:"+subjectType+":"+subjectCode, nil, map[string]interface{}{
+		"subjectType":   saved.SubjectType,
+		"subjectCode":   saved.SubjectCode,
+		"locationCode":  saved.LocationCode,
+		"effectiveDate": saved.EffectiveDate,
+	})
+	logger.WithFields(pkglogger.Fields{"location": saved.LocationCode, "effectiveDate": saved.EffectiveDate}).Info("location assigned")
+	if err := utils.WriteSuccess(w, saved, "Location assigned", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write location assignment failed")
+	}
+}
+
+// writeLocationError 将地点服务错误映射为响应；返回 true 表示已写出错误
+func writeLocationError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, location.ErrInvalidLocation):
+		_ = utils.WriteBadRequest(w, "INVALID_LOCATION", err.Error(), requestID, nil)
+	case errors.Is(err, location.ErrInvalidAssignment):
+		_ = utils.WriteBadRequest(w, "INVALID_LOCATION_ASSIGNMENT", err.Error(), requestID, nil)
+	case errors.Is(err, location.ErrDuplicateCode):
+		_ = utils.WriteError(w, http.StatusConflict, "LOCATION_CODE_EXISTS", "地点编码已存在", requestID, nil)
+	case errors.Is(err, location.ErrVersionExists):
+		_ = utils.WriteError(w, http.StatusConflict, "LOCATION_VERSION_EXISTS", "该生效日期已存在地点版本", requestID, nil)
+	case errors.Is(err, location.ErrInUse):
+		_ = utils.WriteError(w, http.StatusConflict, "LOCATION_IN_USE", "地点仍被组织、职位或下级地点引用，请先停用", requestID, nil)
+	case errors.Is(err, location.ErrNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "LOCATION_NOT_FOUND", "地点不存在", requestID, nil)
+	case errors.Is(err, location.ErrSubjectNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "LOCATION_SUBJECT_NOT_FOUND", "组织单元或职位不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func locationAuditData(loc *location.Location) map[string]interface{} {
+	if loc == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"code":          loc.Code,
+		"name":          loc.Name,
+		"type":          loc.Type,
+		"status":        loc.Status,
+		"parentCode":    loc.ParentCode,
+		"countryCode":   loc.CountryCode,
+		"timeZone":      loc.TimeZone,
+		"effectiveDate": loc.EffectiveDate,
+	}
+}
+
+// logAuditAction 记录地点与分配变更的审计事件（失败仅告警，不影响主流程）
+func (h *LocationHandler) logAuditAction(r *http.Request, eventType, action, resourceID string, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   resourceID,
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
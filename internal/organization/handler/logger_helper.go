@@ -39,6 +39,7 @@ func requestScopedLogger(base pkglogger.Logger, r *http.Request, action string,
 		fields["method"] = r.Method
 		fields["path"] = r.URL.Path
 		fields["requestId"] = middleware.GetRequestID(r.Context())
+		base = base.WithContext(r.Context())
 	}
 	return base.WithFields(fields)
 }
@@ -0,0 +1,338 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/notification"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// NotificationHandler 业务通知订阅、投递记录与站内收件箱
+type NotificationHandler struct {
+	notifications *notification.Service
+	auditLogger   *auditpkg.AuditLogger
+	logger        pkglogger.Logger
+}
+
+// NewNotificationHandler 创建业务通知处理器
+func NewNotificationHandler(notifications *notification.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notifications: notifications,
+		auditLogger:   auditLogger,
+		logger:        scopedLogger(baseLogger, "notification", pkglogger.Fields{"module": "notification"}),
+	}
+}
+
+func (h *NotificationHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置业务通知路由
+func (h *NotificationHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/notifications", func(r chi.Router) {
+		r.Get("/subscriptions", h.ListSubscriptions)
+		r.Post("/subscriptions", h.CreateSubscription)
+		r.Put("/subscriptions/{id}", h.UpdateSubscription)
+		r.Delete("/subscriptions/{id}", h.DeleteSubscription)
+		r.Get("/deliveries", h.ListDeliveries)
+		r.Get("/inbox", h.ListInbox)
+		r.Post("/inbox/{id}/read", h.MarkInboxRead)
+	})
+}
+
+type subscriptionRequest struct {
+	EventType        string `json:"eventType"`
+	Channel          string `json:"channel"`
+	Target           string `json:"target"`
+	OrganizationCode string `json:"organizationCode"`
+	ThresholdDays    int    `json:"thresholdDays"`
+	Enabled          *bool  `json:"enabled"`
+}
+
+func (req subscriptionRequest) toSubscription(tenantID uuid.UUID) notification.Subscription {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	return notification.Subscription{
+		TenantID:         tenantID,
+		EventType:        strings.TrimSpace(req.EventType),
+		Channel:          strings.ToLower(strings.TrimSpace(req.Channel)),
+		Target:           strings.TrimSpace(req.Target),
+		OrganizationCode: strings.TrimSpace(req.OrganizationCode),
+		ThresholdDays:    req.ThresholdDays,
+		Enabled:          enabled,
+	}
+}
+
+// ListSubscriptions 列出当前租户的通知订阅
+func (h *NotificationHandler) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListSubscriptions", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	subs, err := h.notifications.ListSubscriptions(r.Context(), tenantID)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("list notification subscriptions failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if subs == nil {
+		subs = []notification.Subscription{}
+	}
+	if err := utils.WriteSuccess(w, subs, "Notification subscriptions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification subscriptions failed")
+	}
+}
+
+// CreateSubscription 创建通知订阅
+func (h *NotificationHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateSubscription", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req subscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	sub := req.toSubscription(tenantID)
+	sub.CreatedBy = getActorID(r)
+
+	created, err := h.notifications.CreateSubscription(r.Context(), sub)
+	if writeSubscriptionError(w, requestID, logger, "create notification subscription failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateNotificationSubscription", created.ID, nil, subscriptionAuditData(created))
+	logger.WithFields(pkglogger.Fields{"subscriptionId": created.ID.String(), "eventType": created.EventType, "channel": created.Channel}).Info("notification subscription created")
+	if err := utils.WriteCreated(w, created, "Notification subscription created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification subscription failed")
+	}
+}
+
+// UpdateSubscription 更新通知订阅
+func (h *NotificationHandler) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "UpdateSubscription", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_SUBSCRIPTION_ID", "订阅 ID 必须为 UUID", requestID, nil)
+		return
+	}
+	var req subscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.notifications.GetSubscription(r.Context(), tenantID, id)
+	if writeSubscriptionError(w, requestID, logger, "load notification subscription failed", err) {
+		return
+	}
+	sub := req.toSubscription(tenantID)
+	sub.ID = id
+
+	updated, err := h.notifications.UpdateSubscription(r.Context(), sub)
+	if writeSubscriptionError(w, requestID, logger, "update notification subscription failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateNotificationSubscription", id, subscriptionAuditData(previous), subscriptionAuditData(updated))
+	logger.WithFields(pkglogger.Fields{"subscriptionId": id.String()}).Info("notification subscription updated")
+	if err := utils.WriteSuccess(w, updated, "Notification subscription updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification subscription failed")
+	}
+}
+
+// DeleteSubscription 删除通知订阅
+func (h *NotificationHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "DeleteSubscription", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_SUBSCRIPTION_ID", "订阅 ID 必须为 UUID", requestID, nil)
+		return
+	}
+	err = h.notifications.DeleteSubscription(r.Context(), tenantID, id)
+	if writeSubscriptionError(w, requestID, logger, "delete notification subscription failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteNotificationSubscription", id, nil, nil)
+	logger.WithFields(pkglogger.Fields{"subscriptionId": id.String()}).Info("notification subscription deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"id": id}, "Notification subscription deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification subscription delete failed")
+	}
+}
+
+// ListDeliveries 列出当前租户的通知投递记录
+func (h *NotificationHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListDeliveries", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	limit, ok := parseNotificationLimit(w, r, requestID)
+	if !ok {
+		return
+	}
+	status := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("status")))
+	switch status {
+	case "", notification.DeliveryPending, notification.DeliverySent, notification.DeliveryFailed, notification.DeliveryDead:
+	default:
+		_ = utils.WriteBadRequest(w, "INVALID_STATUS", "status 仅支持 pending/sent/failed/dead", requestID, nil)
+		return
+	}
+
+	deliveries, err := h.notifications.ListDeliveries(r.Context(), tenantID, status, limit)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("list notification deliveries failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if deliveries == nil {
+		deliveries = []notification.Delivery{}
+	}
+	if err := utils.WriteSuccess(w, deliveries, "Notification deliveries retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification deliveries failed")
+	}
+}
+
+// ListInbox 列出当前用户的站内通知
+func (h *NotificationHandler) ListInbox(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	recipient := getActorID(r)
+	logger := h.requestLogger(r, "ListInbox", pkglogger.Fields{"tenantId": tenantID.String(), "recipient": recipient})
+
+	limit, ok := parseNotificationLimit(w, r, requestID)
+	if !ok {
+		return
+	}
+	unreadOnly := false
+	if raw := r.URL.Query().Get("unreadOnly"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			_ = utils.WriteBadRequest(w, "INVALID_UNREAD_ONLY", "unreadOnly 必须为布尔值", requestID, nil)
+			return
+		}
+		unreadOnly = parsed
+	}
+
+	items, err := h.notifications.ListInbox(r.Context(), tenantID, recipient, unreadOnly, limit)
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("list notification inbox failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if items == nil {
+		items = []notification.InboxItem{}
+	}
+	if err := utils.WriteSuccess(w, items, "Notification inbox retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification inbox failed")
+	}
+}
+
+// MarkInboxRead 将当前用户的一条站内通知标记为已读
+func (h *NotificationHandler) MarkInboxRead(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	recipient := getActorID(r)
+	logger := h.requestLogger(r, "MarkInboxRead", pkglogger.Fields{"tenantId": tenantID.String(), "recipient": recipient})
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_INBOX_ID", "消息 ID 必须为 UUID", requestID, nil)
+		return
+	}
+	err = h.notifications.MarkInboxRead(r.Context(), tenantID, recipient, id)
+	if errors.Is(err, notification.ErrInboxItemNotFound) {
+		_ = utils.WriteError(w, http.StatusNotFound, "NOTIFICATION_NOT_FOUND", "站内通知不存在", requestID, nil)
+		return
+	}
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("mark notification read failed")
+		_ = utils.WriteInternalError(w, requestID, nil)
+		return
+	}
+	if err := utils.WriteSuccess(w, map[string]interface{}{"id": id, "read": true}, "Notification marked as read", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write notification read failed")
+	}
+}
+
+func parseNotificationLimit(w http.ResponseWriter, r *http.Request, requestID string) (int, bool) {
+	limit := 50
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > 500 {
+			_ = utils.WriteBadRequest(w, "INVALID_LIMIT", "limit 必须为 1-500 之间的整数", requestID, nil)
+			return 0, false
+		}
+		limit = parsed
+	}
+	return limit, true
+}
+
+// writeSubscriptionError 将订阅服务错误映射为响应（非客户端错误记录日志）；返回 true 表示已写出错误
+func writeSubscriptionError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, notification.ErrInvalidSubscription):
+		_ = utils.WriteBadRequest(w, "INVALID_SUBSCRIPTION", err.Error(), requestID, nil)
+	case errors.Is(err, notification.ErrChannelUnavailable):
+		_ = utils.WriteBadRequest(w, "NOTIFICATION_CHANNEL_UNAVAILABLE", "通知渠道未配置: "+err.Error(), requestID, nil)
+	case errors.Is(err, notification.ErrSubscriptionNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "SUBSCRIPTION_NOT_FOUND", "通知订阅不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func subscriptionAuditData(sub *notification.Subscription) map[string]interface{} {
+	if sub == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"eventType":        sub.EventType,
+		"channel":          sub.Channel,
+		"target":           sub.Target,
+		"organizationCode": sub.OrganizationCode,
+		"thresholdDays":    sub.ThresholdDays,
+		"enabled":          sub.Enabled,
+	}
+}
+
+// logAuditAction 记录订阅变更的审计事件（失败仅告警，不影响主流程）
+func (h *NotificationHandler) logAuditAction(r *http.Request, eventType, action string, id uuid.UUID, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "notification_subscription:" + id.String(),
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
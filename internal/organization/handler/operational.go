@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"cube-castle/internal/monitoring/slo"
+	auditpkg "cube-castle/internal/organization/audit"
 	"cube-castle/internal/organization/middleware"
 	scheduler "cube-castle/internal/organization/scheduler"
 	pkglogger "cube-castle/pkg/logger"
@@ -18,12 +20,20 @@ type monitoringCollector interface {
 	CheckAlerts(ctx context.Context) ([]string, error)
 }
 
+type sloReporter interface {
+	Report() slo.Report
+}
+
 // OperationalHandler 运维管理处理器
 type OperationalHandler struct {
 	monitor   monitoringCollector
 	scheduler *scheduler.OperationalScheduler
 	logger    pkglogger.Logger
 	rateLimit *middleware.RateLimitMiddleware
+	slo       sloReporter
+	// runtime 运行时配置（为空时相关端点返回 503）
+	runtime     runtimeConfigurator
+	auditLogger *auditpkg.AuditLogger
 }
 
 // NewOperationalHandler 创建运维管理处理器
@@ -36,6 +46,11 @@ func NewOperationalHandler(monitor monitoringCollector, scheduler *scheduler.Ope
 	}
 }
 
+// SetSLOReporter 启用 SLO 报告端点
+func (h *OperationalHandler) SetSLOReporter(reporter sloReporter) {
+	h.slo = reporter
+}
+
 func (h *OperationalHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
 	return requestScopedLogger(h.logger, r, action, extra)
 }
@@ -48,6 +63,11 @@ func (h *OperationalHandler) SetupRoutes(r chi.Router) {
 		r.Get("/metrics", h.GetMetrics)
 		r.Get("/alerts", h.GetAlerts)
 		r.Get("/rate-limit/stats", h.GetRateLimitStats)
+		r.Get("/slo", h.GetSLOReport)
+
+		// 运行时配置（日志级别、功能开关、限流与缓存 TTL）
+		r.Get("/runtime-config", h.GetRuntimeConfig)
+		r.Patch("/runtime-config", h.PatchRuntimeConfig)
 
 		// 任务调度相关端点
 		r.Get("/tasks", h.GetTasks)
@@ -91,6 +111,25 @@ func (h *OperationalHandler) GetRateLimitStats(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// GetSLOReport 获取各操作的 SLO 达标情况、燃烧率与剩余错误预算
+func (h *OperationalHandler) GetSLOReport(w http.ResponseWriter, r *http.Request) {
+	if h.slo == nil {
+		http.Error(w, "SLO tracking disabled", http.StatusServiceUnavailable)
+		return
+	}
+	logger := h.requestLogger(r, "GetSLOReport", nil)
+
+	response := map[string]interface{}{
+		"success":   true,
+		"timestamp": time.Now().Format(time.RFC3339),
+		"data":      h.slo.Report(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("encode slo report response failed")
+	}
+}
+
 // GetHealth 获取系统健康状态
 func (h *OperationalHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	if h.monitor == nil {
@@ -213,6 +252,7 @@ func (h *OperationalHandler) GetTasks(w http.ResponseWriter, r *http.Request) {
 		"data": map[string]interface{}{
 			"tasks":            tasks,
 			"schedulerRunning": h.scheduler.IsRunning(),
+			"schedulerPaused":  h.scheduler.IsPaused(),
 		},
 	}
 
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	"cube-castle/internal/runtimeconfig"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+type runtimeConfigurator interface {
+	Snapshot() runtimeconfig.Snapshot
+	Apply(ctx context.Context, change runtimeconfig.Change, actor string) (runtimeconfig.Snapshot, error)
+}
+
+// SetRuntimeConfig 启用运行时配置端点（日志级别、功能开关、限流与缓存 TTL）
+func (h *OperationalHandler) SetRuntimeConfig(rc runtimeConfigurator, auditLogger *auditpkg.AuditLogger) {
+	h.runtime = rc
+	h.auditLogger = auditLogger
+}
+
+// GetRuntimeConfig 获取当前生效的运行时配置与覆盖项
+func (h *OperationalHandler) GetRuntimeConfig(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	if h.runtime == nil {
+		_ = utils.WriteError(w, http.StatusServiceUnavailable, "RUNTIME_CONFIG_DISABLED", "运行时配置未启用", requestID, nil)
+		return
+	}
+	if err := utils.WriteSuccess(w, h.runtime.Snapshot(), "Runtime config retrieved", requestID); err != nil {
+		h.requestLogger(r, "GetRuntimeConfig", nil).WithFields(pkglogger.Fields{"error": err}).Error("encode runtime config response failed")
+	}
+}
+
+// PatchRuntimeConfig 增量修改运行时配置，变更记入审计并广播到所有副本
+func (h *OperationalHandler) PatchRuntimeConfig(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	logger := h.requestLogger(r, "PatchRuntimeConfig", nil)
+	if h.runtime == nil {
+		_ = utils.WriteError(w, http.StatusServiceUnavailable, "RUNTIME_CONFIG_DISABLED", "运行时配置未启用", requestID, nil)
+		return
+	}
+
+	var change runtimeconfig.Change
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&change); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+
+	before := h.runtime.Snapshot().Overrides
+	actor := getActorID(r)
+	snapshot, err := h.runtime.Apply(r.Context(), change, actor)
+	if err != nil {
+		if errors.Is(err, runtimeconfig.ErrInvalidChange) {
+			_ = utils.WriteBadRequest(w, "INVALID_RUNTIME_CONFIG", err.Error(), requestID, nil)
+			return
+		}
+		if errors.Is(err, runtimeconfig.ErrConcurrentChange) {
+			_ = utils.WriteError(w, http.StatusConflict, "RUNTIME_CONFIG_CONFLICT", "运行时配置并发修改冲突，请重试", requestID, nil)
+			return
+		}
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("apply runtime config failed")
+		_ = utils.WriteError(w, http.StatusServiceUnavailable, "RUNTIME_CONFIG_UNAVAILABLE", "运行时配置存储不可用", requestID, nil)
+		return
+	}
+
+	logger.WithFields(pkglogger.Fields{"version": snapshot.Overrides.Version}).Info("runtime config changed")
+	h.auditRuntimeConfig(r, before, snapshot.Overrides)
+	if err := utils.WriteSuccess(w, snapshot, "Runtime config updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("encode runtime config response failed")
+	}
+}
+
+// auditRuntimeConfig 记录运行时配置变更（失败仅告警，不影响主流程）
+func (h *OperationalHandler) auditRuntimeConfig(r *http.Request, before, after runtimeconfig.Overrides) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    auditpkg.EventTypeUpdate,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "runtime_config",
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   "PatchRuntimeConfig",
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   overridesAuditData(before),
+		AfterData:    overridesAuditData(after),
+	})
+	if err != nil {
+		h.requestLogger(r, "PatchRuntimeConfig", nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
+
+func overridesAuditData(o runtimeconfig.Overrides) map[string]interface{} {
+	return map[string]interface{}{
+		"version":   o.Version,
+		"logLevels": o.LogLevels,
+		"features":  o.Features,
+		"rateLimit": o.RateLimit,
+		"cacheTtls": o.CacheTTLs,
+	}
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"database/sql"
 	"net/http"
 
 	"cube-castle/internal/organization/audit"
 	"cube-castle/internal/organization/repository"
 	scheduler "cube-castle/internal/organization/scheduler"
 	"cube-castle/internal/organization/validator"
+	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
 )
 
@@ -18,6 +20,8 @@ type OrganizationHandler struct {
 	timelineManager *repository.TemporalTimelineManager
 	hierarchyRepo   *repository.HierarchyRepository
 	validator       *validator.BusinessRuleValidator
+	db              *sql.DB
+	outboxRepo      database.OutboxRepository
 }
 
 func NewOrganizationHandler(repo *repository.OrganizationRepository, temporalService *scheduler.TemporalService, auditLogger *audit.AuditLogger, baseLogger pkglogger.Logger, timelineManager *repository.TemporalTimelineManager, hierarchyRepo *repository.HierarchyRepository, validator *validator.BusinessRuleValidator) *OrganizationHandler {
@@ -34,6 +38,12 @@ func NewOrganizationHandler(repo *repository.OrganizationRepository, temporalSer
 	}
 }
 
+// SetOutbox 启用组织状态变更的 outbox 事件（organization.suspended 等），供通知等下游消费
+func (h *OrganizationHandler) SetOutbox(db *sql.DB, repo database.OutboxRepository) {
+	h.db = db
+	h.outboxRepo = repo
+}
+
 func (h *OrganizationHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
 	return requestScopedLogger(h.logger, r, action, extra)
 }
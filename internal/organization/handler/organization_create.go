@@ -57,7 +57,7 @@ func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.
 		}
 	}
 
-	fields, err := h.repo.ComputeHierarchyForNew(r.Context(), tenantID, code, normalizedParent, req.Name)
+	fields, err := h.repo.ComputeHierarchyForNew(r.Context(), tenantID, code, normalizedParent, req.Name, req.NameI18n)
 	if err != nil {
 		errorMessage := err.Error()
 		switch {
@@ -84,6 +84,9 @@ func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.
 		NamePath:      fields.NamePath,
 		SortOrder:     req.SortOrder,
 		Description:   req.Description,
+		CustomFields:  req.CustomFields,
+		NameI18n:      req.NameI18n,
+		NamePathI18n:  fields.NamePathI18n,
 		EffectiveDate: req.EffectiveDate,
 		EndDate:       req.EndDate,
 		ChangeReason: func() *string {
@@ -220,7 +223,33 @@ func (h *OrganizationHandler) CreateOrganizationVersion(w http.ResponseWriter, r
 		}
 	}
 
-	fields, err := h.repo.ComputeHierarchyForNew(r.Context(), tenantID, code, targetParent, req.Name)
+	if h.validator != nil {
+		validation := h.validator.ValidateOrganizationVersion(r.Context(), tenantID, existingOrg, req.UnitType, targetParent, req.CustomFields)
+		if !validation.Valid {
+			payload := map[string]interface{}{
+				"unitType": req.UnitType,
+			}
+			if targetParent != nil {
+				payload["parentCode"] = strings.TrimSpace(*targetParent)
+			}
+			if len(req.CustomFields) > 0 {
+				payload["customFields"] = req.CustomFields
+			}
+			h.writeValidationErrors(w, r, validation, &validationFailureContext{
+				TenantID:     tenantID,
+				ResourceType: audit.ResourceTypeOrganization,
+				ResourceID:   code,
+				Action:       "ValidateOrganizationVersion",
+				Payload:      payload,
+			})
+			return
+		}
+	}
+
+	// 多语言名称在当前版本基础上合并
+	nameI18n := existingOrg.NameI18n.Merge(req.NameI18n)
+
+	fields, err := h.repo.ComputeHierarchyForNew(r.Context(), tenantID, code, targetParent, req.Name, nameI18n)
 	if err != nil {
 		errorMessage := err.Error()
 		if strings.Contains(errorMessage, "父组织不存在") {
@@ -257,6 +286,10 @@ func (h *OrganizationHandler) CreateOrganizationVersion(w http.ResponseWriter, r
 			}
 			return existingOrg.Description // 继承原有描述
 		}(),
+		// 自定义字段在前一版本取值基础上合并
+		CustomFields: req.CustomFields,
+		NameI18n:     nameI18n,
+		NamePathI18n: fields.NamePathI18n,
 		// 时态管理字段
 		EffectiveDate: types.NewDateFromTime(effectiveDate),
 		EndDate: func() *types.Date {
@@ -246,6 +246,9 @@ func (h *OrganizationHandler) toOrganizationResponse(org *types.Organization) *t
 		SortOrder:     org.SortOrder,
 		Description:   org.Description,
 		ParentCode:    org.ParentCode,
+		CustomFields:  org.CustomFields,
+		NameI18n:      org.NameI18n,
+		NamePathI18n:  org.NamePathI18n,
 		CreatedAt:     org.CreatedAt,
 		UpdatedAt:     org.UpdatedAt,
 		EffectiveDate: org.EffectiveDate,
@@ -79,7 +79,8 @@ func (h *OrganizationHandler) UpdateHistoryRecord(w http.ResponseWriter, r *http
 		return
 	}
 
-	if parentChanged {
+	// 上级或多语言名称变化时下级的名称路径需要重算
+	if parentChanged || len(req.NameI18n) > 0 {
 		if err := h.refreshHierarchyPaths(r.Context(), tenantID, updatedOrg.Code); err != nil {
 			h.writeErrorResponse(w, r, http.StatusInternalServerError, "HIERARCHY_UPDATE_FAILED", "层级路径更新失败", err)
 			return
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/unittype"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// UnitTypeHandler 租户组织单元类型管理
+type UnitTypeHandler struct {
+	unitTypes   *unittype.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewUnitTypeHandler 创建单元类型处理器
+func NewUnitTypeHandler(unitTypes *unittype.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *UnitTypeHandler {
+	return &UnitTypeHandler{
+		unitTypes:   unitTypes,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "unitType", pkglogger.Fields{"module": "unitType"}),
+	}
+}
+
+func (h *UnitTypeHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置单元类型路由
+func (h *UnitTypeHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/organization-unit-types", func(r chi.Router) {
+		r.Get("/", h.ListUnitTypes)
+		r.Post("/", h.CreateUnitType)
+		r.Get("/{code}", h.GetUnitType)
+		r.Put("/{code}", h.UpdateUnitType)
+		r.Delete("/{code}", h.DeleteUnitType)
+	})
+}
+
+type unitTypeRequest struct {
+	Code               string   `json:"code"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	AllowedParentTypes []string `json:"allowedParentTypes"`
+	AllowRoot          *bool    `json:"allowRoot"`
+	MaxDepth           *int     `json:"maxDepth"`
+	Active             *bool    `json:"active"`
+}
+
+func (req unitTypeRequest) toDefinition(tenantID uuid.UUID, code string) unittype.Definition {
+	allowRoot, active := true, true
+	if req.AllowRoot != nil {
+		allowRoot = *req.AllowRoot
+	}
+	if req.Active != nil {
+		active = *req.Active
+	}
+	parents := make([]string, 0, len(req.AllowedParentTypes))
+	for _, parent := range req.AllowedParentTypes {
+		if parent = strings.ToUpper(strings.TrimSpace(parent)); parent != "" {
+			parents = append(parents, parent)
+		}
+	}
+	return unittype.Definition{
+		TenantID:           tenantID,
+		Code:               strings.ToUpper(strings.TrimSpace(code)),
+		Name:               strings.TrimSpace(req.Name),
+		Description:        strings.TrimSpace(req.Description),
+		AllowedParentTypes: parents,
+		AllowRoot:          allowRoot,
+		MaxDepth:           req.MaxDepth,
+		Active:             active,
+	}
+}
+
+func unitTypeCodeParam(r *http.Request) string {
+	return strings.ToUpper(strings.TrimSpace(chi.URLParam(r, "code")))
+}
+
+// ListUnitTypes 列出当前租户生效的单元类型（含内置类型）
+func (h *UnitTypeHandler) ListUnitTypes(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListUnitTypes", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	defs, err := h.unitTypes.ListUnitTypes(r.Context(), tenantID)
+	if writeUnitTypeError(w, requestID, logger, "list organization unit types failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, defs, "Organization unit types retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write organization unit types failed")
+	}
+}
+
+// GetUnitType 读取单个单元类型
+func (h *UnitTypeHandler) GetUnitType(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "GetUnitType", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	def, err := h.unitTypes.GetUnitType(r.Context(), tenantID, unitTypeCodeParam(r))
+	if writeUnitTypeError(w, requestID, logger, "load organization unit type failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, def, "Organization unit type retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write organization unit type failed")
+	}
+}
+
+// CreateUnitType 创建租户单元类型
+func (h *UnitTypeHandler) CreateUnitType(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateUnitType", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req unitTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	def := req.toDefinition(tenantID, req.Code)
+	def.CreatedBy = getActorID(r)
+
+	created, err := h.unitTypes.CreateUnitType(r.Context(), def)
+	if writeUnitTypeError(w, requestID, logger, "create organization unit type failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateUnitType", created.Code, nil, unitTypeAuditData(created))
+	logger.WithFields(pkglogger.Fields{"unitType": created.Code}).Info("organization unit type created")
+	if err := utils.WriteCreated(w, created, "Organization unit type created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write organization unit type failed")
+	}
+}
+
+// UpdateUnitType 更新单元类型规则；内置类型首次更新时生成租户覆盖
+func (h *UnitTypeHandler) UpdateUnitType(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := unitTypeCodeParam(r)
+	logger := h.requestLogger(r, "UpdateUnitType", pkglogger.Fields{"tenantId": tenantID.String(), "unitType": code})
+
+	var req unitTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.unitTypes.GetUnitType(r.Context(), tenantID, code)
+	if writeUnitTypeError(w, requestID, logger, "load organization unit type failed", err) {
+		return
+	}
+	def := req.toDefinition(tenantID, code)
+	def.CreatedBy = getActorID(r)
+
+	updated, err := h.unitTypes.UpdateUnitType(r.Context(), def)
+	if writeUnitTypeError(w, requestID, logger, "update organization unit type failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateUnitType", code, unitTypeAuditData(previous), unitTypeAuditData(updated))
+	logger.Info("organization unit type updated")
+	if err := utils.WriteSuccess(w, updated, "Organization unit type updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write organization unit type failed")
+	}
+}
+
+// DeleteUnitType 删除租户单元类型；内置类型删除覆盖后恢复默认规则
+func (h *UnitTypeHandler) DeleteUnitType(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	code := unitTypeCodeParam(r)
+	logger := h.requestLogger(r, "DeleteUnitType", pkglogger.Fields{"tenantId": tenantID.String(), "unitType": code})
+
+	err := h.unitTypes.DeleteUnitType(r.Context(), tenantID, code)
+	if writeUnitTypeError(w, requestID, logger, "delete organization unit type failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteUnitType", code, nil, nil)
+	logger.Info("organization unit type deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"code": code}, "Organization unit type deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write organization unit type delete failed")
+	}
+}
+
+// writeUnitTypeError 将单元类型服务错误映射为响应；返回 true 表示已写出错误
+func writeUnitTypeError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, unittype.ErrInvalidDefinition):
+		_ = utils.WriteBadRequest(w, "INVALID_UNIT_TYPE", err.Error(), requestID, nil)
+	case errors.Is(err, unittype.ErrDuplicateCode):
+		_ = utils.WriteError(w, http.StatusConflict, "UNIT_TYPE_CODE_EXISTS", "单元类型编码已存在", requestID, nil)
+	case errors.Is(err, unittype.ErrInUse):
+		_ = utils.WriteError(w, http.StatusConflict, "UNIT_TYPE_IN_USE", "单元类型仍被组织使用，请先停用", requestID, nil)
+	case errors.Is(err, unittype.ErrNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "UNIT_TYPE_NOT_FOUND", "单元类型不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func unitTypeAuditData(def *unittype.Definition) map[string]interface{} {
+	if def == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"code":               def.Code,
+		"name":               def.Name,
+		"allowedParentTypes": def.AllowedParentTypes,
+		"allowRoot":          def.AllowRoot,
+		"maxDepth":           def.MaxDepth,
+		"active":             def.Active,
+	}
+}
+
+// logAuditAction 记录单元类型变更的审计事件（失败仅告警，不影响主流程）
+func (h *UnitTypeHandler) logAuditAction(r *http.Request, eventType, action, code string, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "unit_type:" + code,
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
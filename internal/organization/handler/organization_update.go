@@ -10,11 +10,14 @@ import (
 	"time"
 
 	"cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/events"
 	"cube-castle/internal/organization/middleware"
 	"cube-castle/internal/organization/repository"
 	"cube-castle/internal/organization/utils"
 	"cube-castle/internal/types"
+	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 )
@@ -84,7 +87,8 @@ func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if parentChanged {
+	// 上级或多语言名称变化时下级的名称路径需要重算
+	if parentChanged || len(req.NameI18n) > 0 {
 		if err := h.refreshHierarchyPaths(r.Context(), tenantID, updatedOrg.Code); err != nil {
 			h.writeErrorResponse(w, r, http.StatusInternalServerError, "HIERARCHY_UPDATE_FAILED", "层级路径更新失败", err)
 			return
@@ -300,6 +304,12 @@ func (h *OrganizationHandler) changeOrganizationStatusWithTimeline(w http.Respon
 		logger.WithFields(pkglogger.Fields{"error": err}).Warn("audit event logging failed")
 	}
 
+	if operationType == "SUSPEND" {
+		if err := h.publishSuspendedEvent(r, tenantID, currentOrg, req.EffectiveDate, operationReason); err != nil {
+			logger.WithFields(pkglogger.Fields{"error": err}).Warn("enqueue organization.suspended event failed")
+		}
+	}
+
 	// 构造响应 - 返回更新后的时间轴
 	timelineResponse := make([]map[string]interface{}, len(*timeline))
 	for i, version := range *timeline {
@@ -360,3 +370,36 @@ func (h *OrganizationHandler) changeOrganizationStatusWithTimeline(w http.Respon
 		"effectiveDate": req.EffectiveDate,
 	}).Info("organization status changed")
 }
+
+// publishSuspendedEvent 写入 organization.suspended outbox 事件；未启用 outbox 时跳过
+func (h *OrganizationHandler) publishSuspendedEvent(r *http.Request, tenantID uuid.UUID, org *types.Organization, effectiveDate, operationReason string) error {
+	if h.outboxRepo == nil || h.db == nil || org == nil {
+		return nil
+	}
+	ctx := r.Context()
+	event, err := events.NewOrganizationEvent(events.EventOrganizationSuspended, events.Context{
+		TenantID:      tenantID,
+		RequestID:     middleware.GetRequestID(ctx),
+		CorrelationID: middleware.GetCorrelationID(ctx),
+		Operation:     "SUSPEND",
+		Source:        events.DefaultSourceCommand,
+		Headers:       tracing.InjectMap(ctx),
+	}, org.Code, map[string]interface{}{
+		"name":            org.Name,
+		"codePath":        org.CodePath,
+		"effectiveDate":   effectiveDate,
+		"operationReason": operationReason,
+	})
+	if err != nil {
+		return err
+	}
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := h.outboxRepo.Save(ctx, database.WrapSQLTx(tx), event); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
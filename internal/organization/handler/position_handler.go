@@ -526,8 +526,12 @@ func (h *PositionHandler) handleServiceError(w http.ResponseWriter, r *http.Requ
 		h.writeError(w, r, http.StatusPreconditionFailed, "PRECONDITION_FAILED", "资源已发生变更，请刷新后重试", err)
 	case errors.Is(err, service.ErrInvalidHeadcount):
 		h.writeError(w, r, http.StatusBadRequest, "INVALID_HEADCOUNT", "编制或占用人数无效", err)
+	case errors.Is(err, service.ErrInvalidTitleI18n):
+		h.writeError(w, r, http.StatusBadRequest, "POSITION_TITLE_I18N_INVALID", "多语言职位名称无效", err)
 	case errors.Is(err, service.ErrInvalidTransition):
 		h.writeError(w, r, http.StatusBadRequest, "INVALID_TRANSITION", "不支持的职位状态变更", err)
+	case errors.Is(err, service.ErrTransitionGuardFailed):
+		h.writeError(w, r, http.StatusConflict, "TRANSITION_GUARD_FAILED", "职位当前状况不满足状态变更条件", err)
 	case errors.Is(err, service.ErrAssignmentNotFound):
 		h.writeError(w, r, http.StatusNotFound, "ASSIGNMENT_NOT_FOUND", "任职记录不存在", err)
 	case errors.Is(err, service.ErrInvalidAssignmentState):
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/lifecycle"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+)
+
+// lifecycleEntities 路由中的实体段与状态机实体的对应关系
+var lifecycleEntities = map[string]string{
+	"positions":   lifecycle.EntityPosition,
+	"assignments": lifecycle.EntityAssignment,
+}
+
+// PositionLifecycleHandler 租户职位/任职生命周期迁移规则管理
+type PositionLifecycleHandler struct {
+	lifecycle   *lifecycle.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewPositionLifecycleHandler 创建生命周期规则处理器
+func NewPositionLifecycleHandler(svc *lifecycle.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *PositionLifecycleHandler {
+	return &PositionLifecycleHandler{
+		lifecycle:   svc,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "positionLifecycle", pkglogger.Fields{"module": "positionLifecycle"}),
+	}
+}
+
+func (h *PositionLifecycleHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置生命周期规则路由
+func (h *PositionLifecycleHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/position-lifecycle/transitions", func(r chi.Router) {
+		r.Get("/", h.ListTransitions)
+		r.Put("/{entity}/{event}", h.SaveTransition)
+		r.Delete("/{entity}/{event}", h.ResetTransition)
+	})
+}
+
+type lifecycleTransitionRequest struct {
+	FromStatuses []string `json:"fromStatuses"`
+	ToStatus     string   `json:"toStatus"`
+	Guards       []string `json:"guards"`
+	SideEffects  []string `json:"sideEffects"`
+	Enabled      *bool    `json:"enabled"`
+}
+
+// lifecycleRouteParams 解析路由中的实体与事件；实体段不合法时返回空实体
+func lifecycleRouteParams(r *http.Request) (string, string) {
+	entity := lifecycleEntities[strings.ToLower(strings.TrimSpace(chi.URLParam(r, "entity")))]
+	return entity, lifecycle.NormalizeEvent(chi.URLParam(r, "event"))
+}
+
+// ListTransitions 列出当前租户生效的迁移规则（含内置规则与已停用规则）
+func (h *PositionLifecycleHandler) ListTransitions(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListLifecycleTransitions", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	transitions, err := h.lifecycle.ListTransitions(r.Context(), tenantID)
+	if writeLifecycleError(w, requestID, logger, "list position lifecycle transitions failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, transitions, "Position lifecycle transitions retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write position lifecycle transitions failed")
+	}
+}
+
+// SaveTransition 覆盖内置迁移规则或创建/更新自定义职位事件
+func (h *PositionLifecycleHandler) SaveTransition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	entity, event := lifecycleRouteParams(r)
+	logger := h.requestLogger(r, "SaveLifecycleTransition", pkglogger.Fields{"tenantId": tenantID.String(), "entity": entity, "event": event})
+	if entity == "" {
+		_ = utils.WriteError(w, http.StatusNotFound, "LIFECYCLE_ENTITY_NOT_FOUND", "生命周期实体不存在", requestID, nil)
+		return
+	}
+
+	var req lifecycleTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	saved, err := h.lifecycle.SaveTransition(r.Context(), lifecycle.Transition{
+		TenantID:     tenantID,
+		Entity:       entity,
+		Event:        event,
+		FromStatuses: req.FromStatuses,
+		ToStatus:     req.ToStatus,
+		Guards:       req.Guards,
+		SideEffects:  req.SideEffects,
+		Enabled:      enabled,
+		UpdatedBy:    getActorID(r),
+	})
+	if writeLifecycleError(w, requestID, logger, "save position lifecycle transition failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "SaveLifecycleTransition", entity+"/"+event, map[string]interface{}{
+		"fromStatuses": saved.FromStatuses,
+		"toStatus":     saved.ToStatus,
+		"guards":       saved.Guards,
+		"sideEffects":  saved.SideEffects,
+		"enabled":      saved.Enabled,
+	})
+	logger.Info("position lifecycle transition saved")
+	if err := utils.WriteSuccess(w, saved, "Position lifecycle transition saved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write position lifecycle transition failed")
+	}
+}
+
+// ResetTransition 删除租户覆盖；内置规则恢复默认，自定义事件被移除
+func (h *PositionLifecycleHandler) ResetTransition(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	entity, event := lifecycleRouteParams(r)
+	logger := h.requestLogger(r, "ResetLifecycleTransition", pkglogger.Fields{"tenantId": tenantID.String(), "entity": entity, "event": event})
+	if entity == "" {
+		_ = utils.WriteError(w, http.StatusNotFound, "LIFECYCLE_ENTITY_NOT_FOUND", "生命周期实体不存在", requestID, nil)
+		return
+	}
+
+	err := h.lifecycle.DeleteTransition(r.Context(), tenantID, entity, event)
+	if writeLifecycleError(w, requestID, logger, "reset position lifecycle transition failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "ResetLifecycleTransition", entity+"/"+event, nil)
+	logger.Info("position lifecycle transition reset")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"entity": entity, "event": event}, "Position lifecycle transition reset", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write position lifecycle reset failed")
+	}
+}
+
+// writeLifecycleError 将生命周期服务错误映射为响应；返回 true 表示已写出错误
+func writeLifecycleError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, lifecycle.ErrInvalidTransition):
+		_ = utils.WriteBadRequest(w, "INVALID_LIFECYCLE_TRANSITION", err.Error(), requestID, nil)
+	case errors.Is(err, lifecycle.ErrNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "LIFECYCLE_TRANSITION_NOT_FOUND", "生命周期迁移规则覆盖不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+// logAuditAction 记录生命周期规则变更的审计事件（失败仅告警，不影响主流程）
+func (h *PositionLifecycleHandler) logAuditAction(r *http.Request, eventType, action, key string, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "position_lifecycle:" + key,
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/middleware"
+	"cube-castle/internal/organization/utils"
+	"cube-castle/internal/organization/webhook"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// WebhookHandler 租户出站 webhook 端点、投递日志与手动重投
+type WebhookHandler struct {
+	webhooks    *webhook.Service
+	auditLogger *auditpkg.AuditLogger
+	logger      pkglogger.Logger
+}
+
+// NewWebhookHandler 创建 webhook 处理器
+func NewWebhookHandler(webhooks *webhook.Service, auditLogger *auditpkg.AuditLogger, baseLogger pkglogger.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		webhooks:    webhooks,
+		auditLogger: auditLogger,
+		logger:      scopedLogger(baseLogger, "webhook", pkglogger.Fields{"module": "webhook"}),
+	}
+}
+
+func (h *WebhookHandler) requestLogger(r *http.Request, action string, extra pkglogger.Fields) pkglogger.Logger {
+	return requestScopedLogger(h.logger, r, action, extra)
+}
+
+// SetupRoutes 设置 webhook 路由
+func (h *WebhookHandler) SetupRoutes(r chi.Router) {
+	r.Route("/api/v1/webhooks", func(r chi.Router) {
+		r.Get("/endpoints", h.ListEndpoints)
+		r.Post("/endpoints", h.CreateEndpoint)
+		r.Get("/endpoints/{id}", h.GetEndpoint)
+		r.Put("/endpoints/{id}", h.UpdateEndpoint)
+		r.Delete("/endpoints/{id}", h.DeleteEndpoint)
+		r.Post("/endpoints/{id}/rotate-secret", h.RotateSecret)
+		r.Post("/endpoints/{id}/test", h.SendTestEvent)
+		r.Get("/deliveries", h.ListDeliveries)
+		r.Get("/deliveries/{id}", h.GetDelivery)
+		r.Post("/deliveries/{id}/redeliver", h.Redeliver)
+	})
+}
+
+type endpointRequest struct {
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"eventTypes"`
+	Description string   `json:"description"`
+	Enabled     *bool    `json:"enabled"`
+}
+
+func (req endpointRequest) toEndpoint(tenantID uuid.UUID) webhook.Endpoint {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	eventTypes := make([]string, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	return webhook.Endpoint{
+		TenantID:    tenantID,
+		URL:         strings.TrimSpace(req.URL),
+		EventTypes:  eventTypes,
+		Description: strings.TrimSpace(req.Description),
+		Enabled:     enabled,
+	}
+}
+
+func parseWebhookID(w http.ResponseWriter, r *http.Request, requestID, code string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		_ = utils.WriteBadRequest(w, code, "ID 必须为 UUID", requestID, nil)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// ListEndpoints 列出当前租户的 webhook 端点
+func (h *WebhookHandler) ListEndpoints(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListWebhookEndpoints", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	endpoints, err := h.webhooks.ListEndpoints(r.Context(), tenantID)
+	if writeWebhookError(w, requestID, logger, "list webhook endpoints failed", err) {
+		return
+	}
+	if endpoints == nil {
+		endpoints = []webhook.Endpoint{}
+	}
+	if err := utils.WriteSuccess(w, endpoints, "Webhook endpoints retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoints failed")
+	}
+}
+
+// GetEndpoint 读取单个端点
+func (h *WebhookHandler) GetEndpoint(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "GetWebhookEndpoint", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_ENDPOINT_ID")
+	if !ok {
+		return
+	}
+	endpoint, err := h.webhooks.GetEndpoint(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "load webhook endpoint failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, endpoint, "Webhook endpoint retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoint failed")
+	}
+}
+
+// CreateEndpoint 创建端点，响应中返回仅此一次可见的签名密钥
+func (h *WebhookHandler) CreateEndpoint(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "CreateWebhookEndpoint", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	var req endpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	endpoint := req.toEndpoint(tenantID)
+	endpoint.CreatedBy = getActorID(r)
+
+	created, err := h.webhooks.CreateEndpoint(r.Context(), endpoint)
+	if writeWebhookError(w, requestID, logger, "create webhook endpoint failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeCreate, "CreateWebhookEndpoint", created.ID, nil, endpointAuditData(created))
+	logger.WithFields(pkglogger.Fields{"endpointId": created.ID.String(), "eventTypes": created.EventTypes}).Info("webhook endpoint created")
+	if err := utils.WriteCreated(w, created, "Webhook endpoint created", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoint failed")
+	}
+}
+
+// UpdateEndpoint 更新端点
+func (h *WebhookHandler) UpdateEndpoint(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "UpdateWebhookEndpoint", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_ENDPOINT_ID")
+	if !ok {
+		return
+	}
+	var req endpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		_ = utils.WriteBadRequest(w, "INVALID_REQUEST", "请求格式无效", requestID, nil)
+		return
+	}
+	previous, err := h.webhooks.GetEndpoint(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "load webhook endpoint failed", err) {
+		return
+	}
+	endpoint := req.toEndpoint(tenantID)
+	endpoint.ID = id
+
+	updated, err := h.webhooks.UpdateEndpoint(r.Context(), endpoint)
+	if writeWebhookError(w, requestID, logger, "update webhook endpoint failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "UpdateWebhookEndpoint", id, endpointAuditData(previous), endpointAuditData(updated))
+	logger.WithFields(pkglogger.Fields{"endpointId": id.String()}).Info("webhook endpoint updated")
+	if err := utils.WriteSuccess(w, updated, "Webhook endpoint updated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoint failed")
+	}
+}
+
+// DeleteEndpoint 删除端点及其投递日志
+func (h *WebhookHandler) DeleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "DeleteWebhookEndpoint", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_ENDPOINT_ID")
+	if !ok {
+		return
+	}
+	err := h.webhooks.DeleteEndpoint(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "delete webhook endpoint failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeDelete, "DeleteWebhookEndpoint", id, nil, nil)
+	logger.WithFields(pkglogger.Fields{"endpointId": id.String()}).Info("webhook endpoint deleted")
+	if err := utils.WriteSuccess(w, map[string]interface{}{"id": id}, "Webhook endpoint deleted", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoint delete failed")
+	}
+}
+
+// RotateSecret 轮换端点签名密钥
+func (h *WebhookHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "RotateWebhookSecret", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_ENDPOINT_ID")
+	if !ok {
+		return
+	}
+	rotated, err := h.webhooks.RotateSecret(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "rotate webhook secret failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "RotateWebhookSecret", id, nil, nil)
+	logger.WithFields(pkglogger.Fields{"endpointId": id.String()}).Info("webhook secret rotated")
+	if err := utils.WriteSuccess(w, rotated, "Webhook secret rotated", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook endpoint failed")
+	}
+}
+
+// SendTestEvent 同步发送测试事件，返回投递结果（接收方失败时仍返回 200 与失败详情）
+func (h *WebhookHandler) SendTestEvent(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "SendWebhookTestEvent", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_ENDPOINT_ID")
+	if !ok {
+		return
+	}
+	delivery, err := h.webhooks.SendTestEvent(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "send webhook test event failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, delivery, "Webhook test event sent", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook delivery failed")
+	}
+}
+
+// ListDeliveries 列出投递日志
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "ListWebhookDeliveries", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	limit, ok := parseNotificationLimit(w, r, requestID)
+	if !ok {
+		return
+	}
+	filter := webhook.DeliveryFilter{Limit: limit}
+	if raw := r.URL.Query().Get("endpointId"); raw != "" {
+		endpointID, err := uuid.Parse(raw)
+		if err != nil {
+			_ = utils.WriteBadRequest(w, "INVALID_ENDPOINT_ID", "endpointId 必须为 UUID", requestID, nil)
+			return
+		}
+		filter.EndpointID = &endpointID
+	}
+	filter.Status = strings.ToLower(strings.TrimSpace(r.URL.Query().Get("status")))
+	switch filter.Status {
+	case "", webhook.DeliveryPending, webhook.DeliverySucceeded, webhook.DeliveryFailed, webhook.DeliveryDead:
+	default:
+		_ = utils.WriteBadRequest(w, "INVALID_STATUS", "status 仅支持 pending/succeeded/failed/dead", requestID, nil)
+		return
+	}
+
+	deliveries, err := h.webhooks.ListDeliveries(r.Context(), tenantID, filter)
+	if writeWebhookError(w, requestID, logger, "list webhook deliveries failed", err) {
+		return
+	}
+	if deliveries == nil {
+		deliveries = []webhook.Delivery{}
+	}
+	if err := utils.WriteSuccess(w, deliveries, "Webhook deliveries retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook deliveries failed")
+	}
+}
+
+// GetDelivery 读取投递记录及逐次尝试日志
+func (h *WebhookHandler) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "GetWebhookDelivery", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_DELIVERY_ID")
+	if !ok {
+		return
+	}
+	delivery, err := h.webhooks.GetDelivery(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "load webhook delivery failed", err) {
+		return
+	}
+	if err := utils.WriteSuccess(w, delivery, "Webhook delivery retrieved", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook delivery failed")
+	}
+}
+
+// Redeliver 立即重新发送一条投递
+func (h *WebhookHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	requestID := middleware.GetRequestID(r.Context())
+	tenantID := getTenantIDFromRequest(r)
+	logger := h.requestLogger(r, "RedeliverWebhook", pkglogger.Fields{"tenantId": tenantID.String()})
+
+	id, ok := parseWebhookID(w, r, requestID, "INVALID_DELIVERY_ID")
+	if !ok {
+		return
+	}
+	delivery, err := h.webhooks.Redeliver(r.Context(), tenantID, id)
+	if writeWebhookError(w, requestID, logger, "redeliver webhook failed", err) {
+		return
+	}
+	h.logAuditAction(r, auditpkg.EventTypeUpdate, "RedeliverWebhook", delivery.EndpointID, nil, map[string]interface{}{
+		"deliveryId": delivery.ID.String(),
+		"eventId":    delivery.EventID,
+		"status":     delivery.Status,
+	})
+	logger.WithFields(pkglogger.Fields{"deliveryId": id.String(), "status": delivery.Status}).Info("webhook redelivered")
+	if err := utils.WriteSuccess(w, delivery, "Webhook redelivered", requestID); err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err}).Error("write webhook delivery failed")
+	}
+}
+
+// writeWebhookError 将 webhook 服务错误映射为响应（非客户端错误记录日志）；返回 true 表示已写出错误
+func writeWebhookError(w http.ResponseWriter, requestID string, logger pkglogger.Logger, failure string, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, webhook.ErrInvalidEndpoint):
+		_ = utils.WriteBadRequest(w, "INVALID_WEBHOOK_ENDPOINT", err.Error(), requestID, nil)
+	case errors.Is(err, webhook.ErrEndpointNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "WEBHOOK_ENDPOINT_NOT_FOUND", "webhook 端点不存在", requestID, nil)
+	case errors.Is(err, webhook.ErrDeliveryNotFound):
+		_ = utils.WriteError(w, http.StatusNotFound, "WEBHOOK_DELIVERY_NOT_FOUND", "webhook 投递记录不存在", requestID, nil)
+	default:
+		logger.WithFields(pkglogger.Fields{"error": err}).Error(failure)
+		_ = utils.WriteInternalError(w, requestID, nil)
+	}
+	return true
+}
+
+func endpointAuditData(endpoint *webhook.Endpoint) map[string]interface{} {
+	if endpoint == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"url":         endpoint.URL,
+		"eventTypes":  endpoint.EventTypes,
+		"description": endpoint.Description,
+		"enabled":     endpoint.Enabled,
+	}
+}
+
+// logAuditAction 记录端点变更的审计事件（失败仅告警，不影响主流程）
+func (h *WebhookHandler) logAuditAction(r *http.Request, eventType, action string, id uuid.UUID, before, after map[string]interface{}) {
+	if h.auditLogger == nil {
+		return
+	}
+	err := h.auditLogger.LogEvent(r.Context(), &auditpkg.AuditEvent{
+		TenantID:     getTenantIDFromRequest(r),
+		EventType:    eventType,
+		ResourceType: auditpkg.ResourceTypeSystem,
+		ResourceID:   "webhook_endpoint:" + id.String(),
+		ActorID:      getActorID(r),
+		ActorType:    auditpkg.ActorTypeUser,
+		ActionName:   action,
+		RequestID:    middleware.GetRequestID(r.Context()),
+		Success:      true,
+		BeforeData:   before,
+		AfterData:    after,
+	})
+	if err != nil {
+		h.requestLogger(r, action, nil).WithFields(pkglogger.Fields{"error": err}).Warn("record audit action failed")
+	}
+}
@@ -0,0 +1,426 @@
+// Package lifecycle 实现职位与任职的声明式状态机：内置迁移规则（允许的来源状态、目标状态、守卫条件与副作用），
+// 以及租户级的规则覆盖与自定义职位事件。命令服务执行事件与校验链判断状态均以此为准。
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 状态机实体
+const (
+	EntityPosition   = "POSITION"
+	EntityAssignment = "ASSIGNMENT"
+)
+
+// 职位状态
+const (
+	PositionPlanned         = "PLANNED"
+	PositionActive          = "ACTIVE"
+	PositionVacant          = "VACANT"
+	PositionPartiallyFilled = "PARTIALLY_FILLED"
+	PositionFilled          = "FILLED"
+	PositionInactive        = "INACTIVE"
+	PositionDeleted         = "DELETED"
+)
+
+// 任职状态
+const (
+	AssignmentPending = "PENDING"
+	AssignmentActive  = "ACTIVE"
+	AssignmentEnded   = "ENDED"
+)
+
+// 内置事件
+const (
+	EventSuspend    = "SUSPEND"
+	EventActivate   = "ACTIVATE"
+	EventReactivate = "REACTIVATE"
+	EventDelete     = "DELETE"
+	// EventFill 系统事件：创建任职/填充职位，目标状态由占编情况推导
+	EventFill = "FILL"
+	// EventUpdate 系统事件：更新任职，状态保持不变
+	EventUpdate = "UPDATE"
+	// EventClose 系统事件：结束任职
+	EventClose = "CLOSE"
+)
+
+// 守卫条件
+const (
+	// GuardNoActiveAssignments 职位不存在生效中的任职
+	GuardNoActiveAssignments = "NO_ACTIVE_ASSIGNMENTS"
+	// GuardHeadcountAvailable 职位当前占用 FTE 低于编制
+	GuardHeadcountAvailable = "HEADCOUNT_AVAILABLE"
+)
+
+// 副作用
+const (
+	// EffectEndActiveAssignments 迁移前以事件生效日期结束职位的全部生效任职
+	EffectEndActiveAssignments = "END_ACTIVE_ASSIGNMENTS"
+	// EffectRecalculateTimeline 迁移后重算职位时间轴；迁移至 DELETED 时必须声明
+	EffectRecalculateTimeline = "RECALCULATE_TIMELINE"
+)
+
+var (
+	// ErrInvalidTransition 迁移规则定义不合法
+	ErrInvalidTransition = errors.New("invalid lifecycle transition")
+	// ErrNotFound 迁移规则或租户覆盖不存在
+	ErrNotFound = errors.New("lifecycle transition not found")
+	// ErrUnknownEvent 事件未定义或已停用
+	ErrUnknownEvent = errors.New("lifecycle event is not defined")
+	// ErrNotAllowed 当前状态不允许执行该事件
+	ErrNotAllowed = errors.New("lifecycle transition is not allowed from current status")
+	// ErrGuardFailed 守卫条件不满足
+	ErrGuardFailed = errors.New("lifecycle transition guard not satisfied")
+
+	eventPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,19}$`)
+
+	// eventAliases 兼容历史事件名称
+	eventAliases = map[string]string{
+		"INACTIVE": EventSuspend,
+	}
+
+	entityStatuses = map[string][]string{
+		EntityPosition: {
+			PositionPlanned, PositionActive, PositionVacant, PositionPartiallyFilled,
+			PositionFilled, PositionInactive, PositionDeleted,
+		},
+		EntityAssignment: {AssignmentPending, AssignmentActive, AssignmentEnded},
+	}
+
+	// manualTargets 手动事件可指定的职位目标状态；占编相关状态只能由任职变化推导
+	manualTargets = []string{PositionPlanned, PositionActive, PositionInactive, PositionDeleted}
+
+	entityGuards = map[string][]string{
+		EntityPosition: {GuardNoActiveAssignments, GuardHeadcountAvailable},
+	}
+
+	entitySideEffects = map[string][]string{
+		EntityPosition: {EffectEndActiveAssignments, EffectRecalculateTimeline},
+	}
+)
+
+// Transition 一条状态迁移规则
+type Transition struct {
+	ID       uuid.UUID `json:"id,omitempty"`
+	TenantID uuid.UUID `json:"tenantId,omitempty"`
+	Entity   string    `json:"entity"`
+	Event    string    `json:"event"`
+	// FromStatuses 允许执行该事件的来源状态
+	FromStatuses []string `json:"fromStatuses"`
+	// ToStatus 目标状态；为空表示由占编情况推导（FILL）或保持不变（UPDATE）
+	ToStatus    string   `json:"toStatus,omitempty"`
+	Guards      []string `json:"guards"`
+	SideEffects []string `json:"sideEffects"`
+	Enabled     bool     `json:"enabled"`
+	// System 系统事件由填充职位、更新/结束任职等业务操作触发，不可通过事件接口直接执行
+	System bool `json:"system"`
+	// BuiltIn 内置规则（未被租户覆盖时不落库）
+	BuiltIn   bool      `json:"builtIn"`
+	UpdatedBy string    `json:"updatedBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Builtins 返回内置迁移规则，与原有职位事件及任职状态校验保持兼容
+func Builtins() []Transition {
+	occupiable := []string{PositionPlanned, PositionActive, PositionVacant, PositionPartiallyFilled, PositionFilled}
+	return []Transition{
+		{
+			Entity: EntityAssignment, Event: EventClose, FromStatuses: []string{AssignmentActive},
+			ToStatus: AssignmentEnded, System: true,
+		},
+		{
+			Entity: EntityAssignment, Event: EventUpdate, FromStatuses: []string{AssignmentPending, AssignmentActive},
+			System: true,
+		},
+		{
+			Entity: EntityPosition, Event: EventActivate, FromStatuses: []string{PositionPlanned, PositionInactive},
+			ToStatus: PositionActive,
+		},
+		{
+			Entity: EntityPosition, Event: EventDelete, FromStatuses: append(slices.Clone(occupiable), PositionInactive),
+			ToStatus: PositionDeleted, SideEffects: []string{EffectRecalculateTimeline},
+		},
+		{
+			Entity: EntityPosition, Event: EventFill, FromStatuses: slices.Clone(occupiable),
+			System: true,
+		},
+		{
+			Entity: EntityPosition, Event: EventReactivate, FromStatuses: []string{PositionInactive},
+			ToStatus: PositionActive,
+		},
+		{
+			Entity: EntityPosition, Event: EventSuspend, FromStatuses: slices.Clone(occupiable),
+			ToStatus: PositionInactive, Guards: []string{GuardNoActiveAssignments},
+		},
+	}
+}
+
+func builtin(entity, event string) *Transition {
+	for _, t := range Builtins() {
+		if t.Entity == entity && t.Event == event {
+			t.Enabled = true
+			t.BuiltIn = true
+			t.Guards = nonNil(t.Guards)
+			t.SideEffects = nonNil(t.SideEffects)
+			return &t
+		}
+	}
+	return nil
+}
+
+// IsBuiltin 判断 (entity, event) 是否为内置规则
+func IsBuiltin(entity, event string) bool {
+	return builtin(entity, event) != nil
+}
+
+// Effective 合并内置规则与租户覆盖：同 (entity, event) 的覆盖替换内置规则，结果按实体、事件排序
+func Effective(stored []Transition) []Transition {
+	merged := make([]Transition, 0, len(stored)+len(Builtins()))
+	for _, b := range Builtins() {
+		base := builtin(b.Entity, b.Event)
+		if idx := slices.IndexFunc(stored, func(t Transition) bool { return t.Entity == b.Entity && t.Event == b.Event }); idx >= 0 {
+			override := stored[idx]
+			override.BuiltIn = true
+			override.System = base.System
+			merged = append(merged, override)
+			continue
+		}
+		merged = append(merged, *base)
+	}
+	for _, t := range stored {
+		if !IsBuiltin(t.Entity, t.Event) {
+			t.System = false
+			merged = append(merged, t)
+		}
+	}
+	slices.SortFunc(merged, func(a, b Transition) int {
+		if c := strings.Compare(a.Entity, b.Entity); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Event, b.Event)
+	})
+	return merged
+}
+
+// NormalizeEvent 统一事件名称大小写并解析历史别名
+func NormalizeEvent(event string) string {
+	event = strings.ToUpper(strings.TrimSpace(event))
+	if alias, ok := eventAliases[event]; ok {
+		return alias
+	}
+	return event
+}
+
+// Normalize 统一编码大小写并去除首尾空白
+func (t *Transition) Normalize() {
+	t.Entity = strings.ToUpper(strings.TrimSpace(t.Entity))
+	t.Event = NormalizeEvent(t.Event)
+	t.ToStatus = strings.ToUpper(strings.TrimSpace(t.ToStatus))
+	t.FromStatuses = normalizeList(t.FromStatuses)
+	t.Guards = normalizeList(t.Guards)
+	t.SideEffects = normalizeList(t.SideEffects)
+}
+
+// Validate 校验迁移规则；系统事件的目标状态固定，自定义事件仅支持职位实体
+func (t Transition) Validate() error {
+	statuses, ok := entityStatuses[t.Entity]
+	if !ok {
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("entity must be %s or %s", EntityPosition, EntityAssignment))
+	}
+	if !eventPattern.MatchString(t.Event) {
+		return errors.Join(ErrInvalidTransition, errors.New("event must be 2-20 uppercase letters, digits or underscores starting with a letter"))
+	}
+	if len(t.FromStatuses) == 0 {
+		return errors.Join(ErrInvalidTransition, errors.New("fromStatuses is required"))
+	}
+	for i, status := range t.FromStatuses {
+		if !slices.Contains(statuses, status) || slices.Contains(t.FromStatuses[:i], status) {
+			return errors.Join(ErrInvalidTransition, fmt.Errorf("invalid or duplicate from status %q", status))
+		}
+		if status == PositionDeleted && t.Entity == EntityPosition {
+			return errors.Join(ErrInvalidTransition, errors.New("deleted positions cannot transition"))
+		}
+	}
+
+	base := builtin(t.Entity, t.Event)
+	switch {
+	case base != nil && base.System:
+		if t.ToStatus != base.ToStatus {
+			return errors.Join(ErrInvalidTransition, fmt.Errorf("target status of system event %s cannot be changed", t.Event))
+		}
+	case t.Entity != EntityPosition:
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("custom events are only supported for %s", EntityPosition))
+	case !slices.Contains(manualTargets, t.ToStatus):
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("toStatus must be one of %s", strings.Join(manualTargets, ", ")))
+	}
+
+	if err := checkNames("guard", t.Guards, entityGuards[t.Entity]); err != nil {
+		return err
+	}
+	if err := checkNames("side effect", t.SideEffects, entitySideEffects[t.Entity]); err != nil {
+		return err
+	}
+	if base != nil && base.System && len(t.SideEffects) > 0 {
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("system event %s does not support side effects", t.Event))
+	}
+	if t.ToStatus == PositionDeleted && !t.HasSideEffect(EffectRecalculateTimeline) {
+		return errors.Join(ErrInvalidTransition, fmt.Errorf("transitions to %s must declare %s", PositionDeleted, EffectRecalculateTimeline))
+	}
+	return nil
+}
+
+// AllowsFrom 判断来源状态是否允许执行该事件
+func (t Transition) AllowsFrom(status string) bool {
+	return slices.Contains(t.FromStatuses, strings.ToUpper(strings.TrimSpace(status)))
+}
+
+// HasSideEffect 判断是否声明了指定副作用
+func (t Transition) HasSideEffect(effect string) bool {
+	return slices.Contains(t.SideEffects, effect)
+}
+
+// Facts 评估守卫条件所需的实体现状
+type Facts struct {
+	Status            string
+	ActiveFTE         float64
+	HeadcountCapacity float64
+}
+
+// Decision 一次迁移评估结果
+type Decision struct {
+	Transition Transition
+	// Allowed 来源状态匹配且守卫条件全部满足
+	Allowed bool
+	// FailedGuards 未满足的守卫条件
+	FailedGuards []string
+}
+
+// Evaluate 按现状评估守卫条件；调用方需先确认来源状态匹配
+func (t Transition) Evaluate(facts Facts) Decision {
+	failed := []string{}
+	for _, guard := range t.Guards {
+		if !guardSatisfied(guard, facts) {
+			failed = append(failed, guard)
+		}
+	}
+	return Decision{Transition: t, Allowed: len(failed) == 0, FailedGuards: failed}
+}
+
+func guardSatisfied(guard string, facts Facts) bool {
+	switch guard {
+	case GuardNoActiveAssignments:
+		return facts.ActiveFTE <= 1e-9
+	case GuardHeadcountAvailable:
+		return facts.ActiveFTE < facts.HeadcountCapacity-1e-9
+	default:
+		return false
+	}
+}
+
+// Machine 租户生效的状态机
+type Machine struct {
+	transitions []Transition
+}
+
+// NewMachine 基于生效规则构建状态机
+func NewMachine(transitions []Transition) *Machine {
+	return &Machine{transitions: transitions}
+}
+
+// Default 仅包含内置规则的状态机
+func Default() *Machine {
+	return NewMachine(Effective(nil))
+}
+
+// Transitions 返回状态机的全部规则（含已停用）
+func (m *Machine) Transitions() []Transition {
+	return m.transitions
+}
+
+// Find 按实体与事件查找启用的规则
+func (m *Machine) Find(entity, event string) *Transition {
+	event = NormalizeEvent(event)
+	for i := range m.transitions {
+		t := &m.transitions[i]
+		if t.Entity == entity && t.Event == event && t.Enabled {
+			return t
+		}
+	}
+	return nil
+}
+
+// Check 校验事件能否从当前状态执行，返回命中的规则；失败时错误包裹 ErrUnknownEvent、ErrNotAllowed 或 ErrGuardFailed
+func (m *Machine) Check(entity, event string, facts Facts) (*Transition, error) {
+	t := m.Find(entity, event)
+	if t == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnknownEvent, entity, NormalizeEvent(event))
+	}
+	status := strings.ToUpper(strings.TrimSpace(facts.Status))
+	if !t.AllowsFrom(status) {
+		return t, fmt.Errorf("%w: %s %s from %s", ErrNotAllowed, entity, t.Event, status)
+	}
+	if decision := t.Evaluate(facts); !decision.Allowed {
+		return t, fmt.Errorf("%w: %s %s requires %s", ErrGuardFailed, entity, t.Event, strings.Join(decision.FailedGuards, ", "))
+	}
+	return t, nil
+}
+
+// Available 列出当前状态下可手动执行的事件（不含系统事件），守卫不满足的规则以 Allowed=false 返回供界面提示
+func (m *Machine) Available(entity string, facts Facts) []Decision {
+	decisions := []Decision{}
+	for _, t := range m.transitions {
+		if t.Entity != entity || !t.Enabled || t.System || !t.AllowsFrom(facts.Status) {
+			continue
+		}
+		decisions = append(decisions, t.Evaluate(facts))
+	}
+	return decisions
+}
+
+// Source 按租户提供生效的状态机
+type Source interface {
+	Machine(ctx context.Context, tenantID uuid.UUID) (*Machine, error)
+}
+
+// Store 租户迁移规则覆盖存储
+type Store interface {
+	ListTransitions(ctx context.Context, tenantID uuid.UUID) ([]Transition, error)
+	// SaveTransition 按 (tenant, entity, event) 创建或更新覆盖
+	SaveTransition(ctx context.Context, t *Transition) error
+	DeleteTransition(ctx context.Context, tenantID uuid.UUID, entity, event string) error
+}
+
+func checkNames(kind string, names, allowed []string) error {
+	for i, name := range names {
+		if !slices.Contains(allowed, name) || slices.Contains(names[:i], name) {
+			return errors.Join(ErrInvalidTransition, fmt.Errorf("invalid or duplicate %s %q", kind, name))
+		}
+	}
+	return nil
+}
+
+func normalizeList(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.ToUpper(strings.TrimSpace(v)); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,177 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type memoryStore struct {
+	transitions map[string]Transition
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{transitions: map[string]Transition{}}
+}
+
+func (s *memoryStore) ListTransitions(context.Context, uuid.UUID) ([]Transition, error) {
+	out := make([]Transition, 0, len(s.transitions))
+	for _, t := range s.transitions {
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) SaveTransition(_ context.Context, t *Transition) error {
+	s.transitions[t.Entity+"/"+t.Event] = *t
+	return nil
+}
+
+func (s *memoryStore) DeleteTransition(_ context.Context, _ uuid.UUID, entity, event string) error {
+	key := entity + "/" + event
+	if _, ok := s.transitions[key]; !ok {
+		return ErrNotFound
+	}
+	delete(s.transitions, key)
+	return nil
+}
+
+func TestBuiltinsAreValid(t *testing.T) {
+	for _, tr := range Effective(nil) {
+		if err := tr.Validate(); err != nil {
+			t.Fatalf("builtin %s/%s invalid: %v", tr.Entity, tr.Event, err)
+		}
+		if !tr.Enabled || !tr.BuiltIn {
+			t.Fatalf("builtin %s/%s should be enabled builtin", tr.Entity, tr.Event)
+		}
+	}
+}
+
+func TestMachineCheck(t *testing.T) {
+	m := Default()
+
+	if _, err := m.Check(EntityPosition, "inactive", Facts{Status: PositionVacant}); err != nil {
+		t.Fatalf("expected INACTIVE alias to suspend vacant position: %v", err)
+	}
+	if _, err := m.Check(EntityPosition, EventSuspend, Facts{Status: PositionFilled, ActiveFTE: 1, HeadcountCapacity: 1}); !errors.Is(err, ErrGuardFailed) {
+		t.Fatalf("expected guard failure while assignments are active, got %v", err)
+	}
+	if _, err := m.Check(EntityPosition, EventReactivate, Facts{Status: PositionActive}); !errors.Is(err, ErrNotAllowed) {
+		t.Fatalf("expected reactivate from ACTIVE to be rejected, got %v", err)
+	}
+	if _, err := m.Check(EntityPosition, "ARCHIVE", Facts{Status: PositionActive}); !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected unknown event, got %v", err)
+	}
+	tr, err := m.Check(EntityPosition, EventDelete, Facts{Status: PositionInactive})
+	if err != nil || tr.ToStatus != PositionDeleted || !tr.HasSideEffect(EffectRecalculateTimeline) {
+		t.Fatalf("unexpected delete transition: %#v %v", tr, err)
+	}
+	if _, err := m.Check(EntityAssignment, EventClose, Facts{Status: AssignmentPending}); !errors.Is(err, ErrNotAllowed) {
+		t.Fatalf("expected closing pending assignment to be rejected, got %v", err)
+	}
+}
+
+func TestMachineAvailable(t *testing.T) {
+	decisions := Default().Available(EntityPosition, Facts{Status: PositionFilled, ActiveFTE: 1, HeadcountCapacity: 1})
+	got := map[string]Decision{}
+	for _, d := range decisions {
+		got[d.Transition.Event] = d
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected SUSPEND and DELETE only, got %#v", got)
+	}
+	if suspend := got[EventSuspend]; suspend.Allowed || len(suspend.FailedGuards) != 1 || suspend.FailedGuards[0] != GuardNoActiveAssignments {
+		t.Fatalf("expected suspend blocked by guard: %#v", suspend)
+	}
+	if !got[EventDelete].Allowed {
+		t.Fatalf("expected delete to be allowed")
+	}
+}
+
+func TestTransitionValidate(t *testing.T) {
+	cases := []struct {
+		name string
+		tr   Transition
+		ok   bool
+	}{
+		{"custom position event", Transition{Entity: EntityPosition, Event: "FREEZE", FromStatuses: []string{PositionActive}, ToStatus: PositionInactive}, true},
+		{"unknown entity", Transition{Entity: "EMPLOYEE", Event: "FREEZE", FromStatuses: []string{PositionActive}, ToStatus: PositionInactive}, false},
+		{"bad event code", Transition{Entity: EntityPosition, Event: "x", FromStatuses: []string{PositionActive}, ToStatus: PositionInactive}, false},
+		{"missing from", Transition{Entity: EntityPosition, Event: "FREEZE", ToStatus: PositionInactive}, false},
+		{"from deleted", Transition{Entity: EntityPosition, Event: "FREEZE", FromStatuses: []string{PositionDeleted}, ToStatus: PositionInactive}, false},
+		{"derived target", Transition{Entity: EntityPosition, Event: "FREEZE", FromStatuses: []string{PositionActive}, ToStatus: PositionFilled}, false},
+		{"unknown guard", Transition{Entity: EntityPosition, Event: "FREEZE", FromStatuses: []string{PositionActive}, ToStatus: PositionInactive, Guards: []string{"NOPE"}}, false},
+		{"delete without recalc", Transition{Entity: EntityPosition, Event: "PURGE", FromStatuses: []string{PositionActive}, ToStatus: PositionDeleted}, false},
+		{"system target fixed", Transition{Entity: EntityAssignment, Event: EventClose, FromStatuses: []string{AssignmentActive}, ToStatus: AssignmentPending}, false},
+		{"system side effect", Transition{Entity: EntityPosition, Event: EventFill, FromStatuses: []string{PositionActive}, SideEffects: []string{EffectRecalculateTimeline}}, false},
+		{"custom assignment event", Transition{Entity: EntityAssignment, Event: "PAUSE", FromStatuses: []string{AssignmentActive}, ToStatus: AssignmentPending}, false},
+		{"restrict system from", Transition{Entity: EntityPosition, Event: EventFill, FromStatuses: []string{PositionActive, PositionVacant}, Guards: []string{GuardHeadcountAvailable}}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.tr.Validate()
+			if tc.ok && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tc.ok && !errors.Is(err, ErrInvalidTransition) {
+				t.Fatalf("expected ErrInvalidTransition, got %v", err)
+			}
+		})
+	}
+}
+
+func TestServiceOverridesAndReset(t *testing.T) {
+	store := newMemoryStore()
+	svc := NewService(store, nil)
+	ctx := context.Background()
+	tenant := uuid.New()
+
+	saved, err := svc.SaveTransition(ctx, Transition{
+		TenantID:     tenant,
+		Entity:       "position",
+		Event:        "suspend",
+		FromStatuses: []string{"active", "filled"},
+		ToStatus:     "inactive",
+		SideEffects:  []string{EffectEndActiveAssignments},
+		Enabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("save override: %v", err)
+	}
+	if !saved.BuiltIn || saved.ID == uuid.Nil {
+		t.Fatalf("expected builtin override with id: %#v", saved)
+	}
+
+	machine, err := svc.Machine(ctx, tenant)
+	if err != nil {
+		t.Fatalf("machine: %v", err)
+	}
+	tr, err := machine.Check(EntityPosition, EventSuspend, Facts{Status: PositionFilled, ActiveFTE: 1, HeadcountCapacity: 1})
+	if err != nil || !tr.HasSideEffect(EffectEndActiveAssignments) {
+		t.Fatalf("expected override without guard to allow suspend: %#v %v", tr, err)
+	}
+	if _, err := machine.Check(EntityPosition, EventSuspend, Facts{Status: PositionVacant}); !errors.Is(err, ErrNotAllowed) {
+		t.Fatalf("expected override to restrict from statuses, got %v", err)
+	}
+
+	if _, err := svc.SaveTransition(ctx, Transition{TenantID: tenant, Entity: EntityPosition, Event: EventDelete, FromStatuses: []string{PositionActive}, ToStatus: PositionDeleted, SideEffects: []string{EffectRecalculateTimeline}}); err != nil {
+		t.Fatalf("disable delete: %v", err)
+	}
+	machine, _ = svc.Machine(ctx, tenant)
+	if _, err := machine.Check(EntityPosition, EventDelete, Facts{Status: PositionActive}); !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected disabled event to be unknown, got %v", err)
+	}
+
+	if err := svc.DeleteTransition(ctx, tenant, "position", "suspend"); err != nil {
+		t.Fatalf("reset override: %v", err)
+	}
+	if err := svc.DeleteTransition(ctx, tenant, EntityPosition, EventSuspend); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound on second reset, got %v", err)
+	}
+	machine, _ = svc.Machine(ctx, tenant)
+	if _, err := machine.Check(EntityPosition, EventSuspend, Facts{Status: PositionFilled, ActiveFTE: 1, HeadcountCapacity: 1}); !errors.Is(err, ErrGuardFailed) {
+		t.Fatalf("expected builtin guard after reset, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 生命周期规则管理，同时作为命令服务与校验链的状态机来源
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建生命周期规则服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "positionLifecycle",
+			"module":    "command",
+		}),
+	}
+}
+
+// ListTransitions 返回租户生效的迁移规则（内置规则与租户覆盖合并，含已停用）
+func (s *Service) ListTransitions(ctx context.Context, tenantID uuid.UUID) ([]Transition, error) {
+	stored, err := s.store.ListTransitions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return Effective(stored), nil
+}
+
+// Machine 构建租户生效的状态机
+func (s *Service) Machine(ctx context.Context, tenantID uuid.UUID) (*Machine, error) {
+	transitions, err := s.ListTransitions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return NewMachine(transitions), nil
+}
+
+// SaveTransition 覆盖内置规则或创建/更新自定义职位事件
+func (s *Service) SaveTransition(ctx context.Context, t Transition) (*Transition, error) {
+	t.Normalize()
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	transitions, err := s.ListTransitions(ctx, t.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	t.ID = uuid.New()
+	t.CreatedAt = now
+	for _, existing := range transitions {
+		if existing.Entity == t.Entity && existing.Event == t.Event && existing.ID != uuid.Nil {
+			t.ID = existing.ID
+			t.CreatedAt = existing.CreatedAt
+		}
+	}
+	t.BuiltIn = IsBuiltin(t.Entity, t.Event)
+	t.System = t.BuiltIn && builtin(t.Entity, t.Event).System
+	t.UpdatedAt = now
+	if err := s.store.SaveTransition(ctx, &t); err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": t.TenantID, "entity": t.Entity, "event": t.Event}).Info("position lifecycle transition saved")
+	return &t, nil
+}
+
+// DeleteTransition 删除租户覆盖：内置规则恢复默认，自定义事件被移除
+func (s *Service) DeleteTransition(ctx context.Context, tenantID uuid.UUID, entity, event string) error {
+	t := Transition{Entity: entity, Event: event}
+	t.Normalize()
+	if err := s.store.DeleteTransition(ctx, tenantID, t.Entity, t.Event); err != nil {
+		return err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": tenantID, "entity": t.Entity, "event": t.Event}).Info("position lifecycle transition reset")
+	return nil
+}
@@ -0,0 +1,103 @@
+package lifecycle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"cube-castle/pkg/database"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的迁移规则覆盖存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建迁移规则覆盖存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const transitionColumns = `id, tenant_id, entity, event, from_statuses, COALESCE(to_status, ''), guards, side_effects,
+	is_enabled, COALESCE(updated_by, ''), created_at, updated_at`
+
+func scanTransition(row interface{ Scan(...any) error }) (*Transition, error) {
+	var t Transition
+	if err := row.Scan(&t.ID, &t.TenantID, &t.Entity, &t.Event, pq.Array(&t.FromStatuses), &t.ToStatus,
+		pq.Array(&t.Guards), pq.Array(&t.SideEffects), &t.Enabled, &t.UpdatedBy, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	t.FromStatuses = nonNil(t.FromStatuses)
+	t.Guards = nonNil(t.Guards)
+	t.SideEffects = nonNil(t.SideEffects)
+	t.CreatedAt = t.CreatedAt.UTC()
+	t.UpdatedAt = t.UpdatedAt.UTC()
+	return &t, nil
+}
+
+// ListTransitions 列出租户的迁移规则覆盖与自定义事件
+func (s *SQLStore) ListTransitions(ctx context.Context, tenantID uuid.UUID) ([]Transition, error) {
+	return QueryTransitions(ctx, s.db, tenantID)
+}
+
+// QueryTransitions 通过任意查询连接（含只读副本）读取租户迁移规则覆盖，供查询服务构建状态机
+func QueryTransitions(ctx context.Context, db database.Queryer, tenantID uuid.UUID) ([]Transition, error) {
+	rows, err := db.QueryContext(ctx, `
+	SELECT `+transitionColumns+`
+	FROM position_lifecycle_transitions
+	WHERE tenant_id = $1
+	ORDER BY entity, event`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list position lifecycle transitions: %w", err)
+	}
+	defer rows.Close()
+	var transitions []Transition
+	for rows.Next() {
+		t, err := scanTransition(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan position lifecycle transition: %w", err)
+		}
+		transitions = append(transitions, *t)
+	}
+	return transitions, rows.Err()
+}
+
+// SaveTransition 创建或更新迁移规则覆盖
+func (s *SQLStore) SaveTransition(ctx context.Context, t *Transition) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO position_lifecycle_transitions
+		(id, tenant_id, entity, event, from_statuses, to_status, guards, side_effects, is_enabled,
+		 updated_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, NULLIF($10, ''), $11, $12)
+	ON CONFLICT (tenant_id, entity, event) DO UPDATE SET
+		from_statuses = EXCLUDED.from_statuses,
+		to_status = EXCLUDED.to_status,
+		guards = EXCLUDED.guards,
+		side_effects = EXCLUDED.side_effects,
+		is_enabled = EXCLUDED.is_enabled,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = EXCLUDED.updated_at`,
+		t.ID, t.TenantID, t.Entity, t.Event, pq.Array(nonNil(t.FromStatuses)), t.ToStatus,
+		pq.Array(nonNil(t.Guards)), pq.Array(nonNil(t.SideEffects)), t.Enabled, t.UpdatedBy, t.CreatedAt, t.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save position lifecycle transition: %w", err)
+	}
+	return nil
+}
+
+// DeleteTransition 删除迁移规则覆盖
+func (s *SQLStore) DeleteTransition(ctx context.Context, tenantID uuid.UUID, entity, event string) error {
+	res, err := s.db.ExecContext(ctx, `
+	DELETE FROM position_lifecycle_transitions
+	WHERE tenant_id = $1 AND entity = $2 AND event = $3`, tenantID, entity, event)
+	if err != nil {
+		return fmt.Errorf("delete position lifecycle transition: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
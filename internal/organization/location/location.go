@@ -0,0 +1,199 @@
+// Package location 实现工作地点主数据：按生效日期版本化的地点（SITE）与区域（REGION）层级，
+// 以及组织单元默认地点和职位地点覆盖（职位未覆盖时继承所属组织单元的默认地点）。
+package location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+)
+
+const (
+	// TypeRegion 区域，仅用于组织地点层级，不可直接分配给组织或职位
+	TypeRegion = "REGION"
+	// TypeSite 具体工作地点，需提供国家与时区
+	TypeSite = "SITE"
+
+	// StatusActive 地点可被分配
+	StatusActive = "ACTIVE"
+	// StatusInactive 地点停用，不可再分配
+	StatusInactive = "INACTIVE"
+
+	// SubjectOrganization 组织单元默认地点
+	SubjectOrganization = "ORGANIZATION_UNIT"
+	// SubjectPosition 职位地点覆盖
+	SubjectPosition = "POSITION"
+
+	dateLayout    = temporal.DateLayout
+	maxNameLength = 255
+)
+
+var (
+	// ErrInvalidLocation 地点参数不合法
+	ErrInvalidLocation = errors.New("invalid location")
+	// ErrNotFound 地点不存在
+	ErrNotFound = errors.New("location not found")
+	// ErrDuplicateCode 同一租户下地点编码重复
+	ErrDuplicateCode = errors.New("location code already exists")
+	// ErrVersionExists 同一生效日期已存在版本
+	ErrVersionExists = errors.New("location version already exists for effective date")
+	// ErrInUse 地点仍被组织、职位或下级地点引用
+	ErrInUse = errors.New("location is in use")
+	// ErrInvalidAssignment 地点分配不合法
+	ErrInvalidAssignment = errors.New("invalid location assignment")
+	// ErrSubjectNotFound 分配地点的组织单元或职位不存在
+	ErrSubjectNotFound = errors.New("location assignment subject not found")
+
+	codePattern    = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{0,49}$`)
+	countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// Address 地点的邮寄地址
+type Address struct {
+	Line1         string `json:"line1,omitempty"`
+	Line2         string `json:"line2,omitempty"`
+	City          string `json:"city,omitempty"`
+	StateProvince string `json:"stateProvince,omitempty"`
+	PostalCode    string `json:"postalCode,omitempty"`
+}
+
+// Location 地点或区域版本
+type Location struct {
+	RecordID uuid.UUID `json:"recordId"`
+	TenantID uuid.UUID `json:"tenantId"`
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	Type     string    `json:"type"`
+	Status   string    `json:"status"`
+	// ParentCode 上级区域编码，为空表示顶级
+	ParentCode string `json:"parentCode,omitempty"`
+	// CountryCode ISO 3166-1 alpha-2 国家代码
+	CountryCode string `json:"countryCode,omitempty"`
+	// TimeZone IANA 时区名称，例如 Asia/Shanghai
+	TimeZone      string  `json:"timeZone,omitempty"`
+	Address       Address `json:"address"`
+	EffectiveDate string  `json:"effectiveDate"`
+	// EndDate 由下一版本生效日期推导，最新版本为空
+	EndDate   *string   `json:"endDate,omitempty"`
+	IsCurrent bool      `json:"isCurrent"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidCode 判断编码是否符合地点编码格式
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Validate 校验地点版本自身字段；上级区域的存在性由 Service 校验
+func (l Location) Validate() error {
+	if !ValidCode(l.Code) {
+		return errors.Join(ErrInvalidLocation, errors.New("code must be 1-50 uppercase letters, digits, underscores or hyphens"))
+	}
+	if name := strings.TrimSpace(l.Name); name == "" || len(name) > maxNameLength {
+		return errors.Join(ErrInvalidLocation, fmt.Errorf("name is required and must not exceed %d characters", maxNameLength))
+	}
+	if l.Type != TypeRegion && l.Type != TypeSite {
+		return errors.Join(ErrInvalidLocation, fmt.Errorf("type must be %s or %s", TypeRegion, TypeSite))
+	}
+	if l.Status != StatusActive && l.Status != StatusInactive {
+		return errors.Join(ErrInvalidLocation, fmt.Errorf("status must be %s or %s", StatusActive, StatusInactive))
+	}
+	if l.ParentCode != "" && (!ValidCode(l.ParentCode) || l.ParentCode == l.Code) {
+		return errors.Join(ErrInvalidLocation, errors.New("parentCode must be another region code"))
+	}
+	if l.Type == TypeSite && (l.CountryCode == "" || l.TimeZone == "") {
+		return errors.Join(ErrInvalidLocation, errors.New("countryCode and timeZone are required for sites"))
+	}
+	if l.CountryCode != "" && !countryPattern.MatchString(l.CountryCode) {
+		return errors.Join(ErrInvalidLocation, errors.New("countryCode must be an ISO 3166-1 alpha-2 code"))
+	}
+	if l.TimeZone != "" {
+		if _, err := time.LoadLocation(l.TimeZone); err != nil || l.TimeZone == "Local" {
+			return errors.Join(ErrInvalidLocation, fmt.Errorf("timeZone %s is not a valid IANA time zone", l.TimeZone))
+		}
+	}
+	if _, err := time.Parse(dateLayout, l.EffectiveDate); err != nil {
+		return errors.Join(ErrInvalidLocation, errors.New("effectiveDate must be YYYY-MM-DD"))
+	}
+	return nil
+}
+
+// VersionCode 返回版本所属编码，供 temporal 推导时间轴
+func (l *Location) VersionCode() string { return l.Code }
+
+// VersionEffectiveDate 返回版本生效日期
+func (l *Location) VersionEffectiveDate() string { return l.EffectiveDate }
+
+// VersionEndDate 返回推导出的版本结束日期
+func (l *Location) VersionEndDate() *string { return l.EndDate }
+
+// SetVersionSpan 写回推导出的结束日期与当前标记
+func (l *Location) SetVersionSpan(endDate *string, isCurrent bool) {
+	l.EndDate = endDate
+	l.IsCurrent = isCurrent
+}
+
+// Assignment 组织单元或职位自生效日期起的地点；LocationCode 为空表示清除
+// （组织单元不再有默认地点，职位恢复继承所属组织单元的默认地点）
+type Assignment struct {
+	TenantID      uuid.UUID `json:"tenantId"`
+	SubjectType   string    `json:"subjectType"`
+	SubjectCode   string    `json:"subjectCode"`
+	LocationCode  string    `json:"locationCode,omitempty"`
+	EffectiveDate string    `json:"effectiveDate"`
+	CreatedBy     string    `json:"createdBy,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// Validate 校验分配对象、地点编码与生效日期格式
+func (a Assignment) Validate() error {
+	if a.SubjectType != SubjectOrganization && a.SubjectType != SubjectPosition {
+		return errors.Join(ErrInvalidAssignment, fmt.Errorf("subjectType must be %s or %s", SubjectOrganization, SubjectPosition))
+	}
+	if strings.TrimSpace(a.SubjectCode) == "" {
+		return errors.Join(ErrInvalidAssignment, errors.New("subject code is required"))
+	}
+	if a.LocationCode != "" && !ValidCode(a.LocationCode) {
+		return errors.Join(ErrInvalidAssignment, errors.New("locationCode is invalid"))
+	}
+	if _, err := time.Parse(dateLayout, a.EffectiveDate); err != nil {
+		return errors.Join(ErrInvalidAssignment, errors.New("effectiveDate must be YYYY-MM-DD"))
+	}
+	return nil
+}
+
+// ListFilter 地点列表过滤条件
+type ListFilter struct {
+	// AsOfDate 查询日期（YYYY-MM-DD），为空时取当天
+	AsOfDate        string
+	Type            string
+	ParentCode      string
+	CountryCode     string
+	IncludeInactive bool
+}
+
+// Store 地点版本与分配存储
+type Store interface {
+	// ListVersions 列出地点版本；code 为空时列出租户全部地点的版本
+	ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Location, error)
+	// InsertVersion 写入新版本；同编码同生效日期冲突时返回 ErrVersionExists
+	InsertVersion(ctx context.Context, l *Location) error
+	// UpdateVersion 按 record_id 原位更正版本内容
+	UpdateVersion(ctx context.Context, l *Location) error
+	// DeleteLocation 删除地点全部版本
+	DeleteLocation(ctx context.Context, tenantID uuid.UUID, code string) error
+	// CountUsage 统计引用地点的分配记录数
+	CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error)
+	// SubjectExists 判断组织单元或职位是否存在且未删除
+	SubjectExists(ctx context.Context, tenantID uuid.UUID, subjectType, code string) (bool, error)
+	// SaveAssignment 写入分配；同对象同生效日期的分配被覆盖
+	SaveAssignment(ctx context.Context, a *Assignment) error
+}
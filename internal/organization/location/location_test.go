@@ -0,0 +1,253 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type memoryStore struct {
+	versions    []Location
+	usage       map[string]int
+	subjects    map[string]bool
+	assignments []Assignment
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		usage: map[string]int{},
+		subjects: map[string]bool{
+			SubjectOrganization + ":1000001": true,
+			SubjectPosition + ":P1000001":    true,
+		},
+	}
+}
+
+func (s *memoryStore) ListVersions(_ context.Context, tenantID uuid.UUID, code string) ([]Location, error) {
+	out := make([]Location, 0)
+	for _, v := range s.versions {
+		if v.TenantID == tenantID && (code == "" || v.Code == code) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) InsertVersion(_ context.Context, l *Location) error {
+	for _, v := range s.versions {
+		if v.TenantID == l.TenantID && v.Code == l.Code && v.EffectiveDate == l.EffectiveDate {
+			return ErrVersionExists
+		}
+	}
+	s.versions = append(s.versions, *l)
+	return nil
+}
+
+func (s *memoryStore) UpdateVersion(_ context.Context, l *Location) error {
+	for i, v := range s.versions {
+		if v.RecordID == l.RecordID {
+			s.versions[i] = *l
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (s *memoryStore) DeleteLocation(_ context.Context, tenantID uuid.UUID, code string) error {
+	kept := s.versions[:0]
+	for _, v := range s.versions {
+		if v.TenantID != tenantID || v.Code != code {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == len(s.versions) {
+		return ErrNotFound
+	}
+	s.versions = kept
+	return nil
+}
+
+func (s *memoryStore) CountUsage(_ context.Context, _ uuid.UUID, code string) (int, error) {
+	return s.usage[code], nil
+}
+
+func (s *memoryStore) SubjectExists(_ context.Context, _ uuid.UUID, subjectType, code string) (bool, error) {
+	return s.subjects[subjectType+":"+code], nil
+}
+
+func (s *memoryStore) SaveAssignment(_ context.Context, a *Assignment) error {
+	s.assignments = append(s.assignments, *a)
+	return nil
+}
+
+func TestLocationValidate(t *testing.T) {
+	base := func() Location {
+		return Location{
+			Code:          "SH-01",
+			Name:          "Shanghai HQ",
+			Type:          TypeSite,
+			Status:        StatusActive,
+			CountryCode:   "CN",
+			TimeZone:      "Asia/Shanghai",
+			EffectiveDate: "2025-01-01",
+		}
+	}
+	cases := []struct {
+		name   string
+		mutate func(*Location)
+		ok     bool
+	}{
+		{name: "valid site", mutate: func(*Location) {}, ok: true},
+		{name: "region without country", mutate: func(l *Location) { l.Type, l.CountryCode, l.TimeZone = TypeRegion, "", "" }, ok: true},
+		{name: "lowercase code", mutate: func(l *Location) { l.Code = "sh-01" }},
+		{name: "missing name", mutate: func(l *Location) { l.Name = " " }},
+		{name: "bad type", mutate: func(l *Location) { l.Type = "BUILDING" }},
+		{name: "bad status", mutate: func(l *Location) { l.Status = "CLOSED" }},
+		{name: "self parent", mutate: func(l *Location) { l.ParentCode = l.Code }},
+		{name: "site without country", mutate: func(l *Location) { l.CountryCode = "" }},
+		{name: "site without time zone", mutate: func(l *Location) { l.TimeZone = "" }},
+		{name: "bad country", mutate: func(l *Location) { l.CountryCode = "CHN" }},
+		{name: "unknown time zone", mutate: func(l *Location) { l.TimeZone = "Mars/Olympus" }},
+		{name: "local time zone", mutate: func(l *Location) { l.TimeZone = "Local" }},
+		{name: "bad date", mutate: func(l *Location) { l.EffectiveDate = "01/01/2025" }},
+	}
+	for _, tc := range cases {
+		l := base()
+		tc.mutate(&l)
+		err := l.Validate()
+		if tc.ok != (err == nil) {
+			t.Fatalf("%s: unexpected result %v", tc.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidLocation) {
+			t.Fatalf("%s: expected ErrInvalidLocation, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestServiceHierarchy(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	svc := NewService(newMemoryStore(), nil)
+	svc.now = func() time.Time { return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC) }
+
+	apac := Location{TenantID: tenant, Code: "APAC", Name: "Asia Pacific", Type: TypeRegion, Status: StatusActive, EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateLocation(ctx, apac); err != nil {
+		t.Fatalf("create region failed: %v", err)
+	}
+	china := Location{TenantID: tenant, Code: "CN", Name: "China", Type: TypeRegion, Status: StatusActive, ParentCode: "APAC", EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateLocation(ctx, china); err != nil {
+		t.Fatalf("create sub-region failed: %v", err)
+	}
+	site := Location{TenantID: tenant, Code: "SH-01", Name: "Shanghai HQ", Type: TypeSite, Status: StatusActive, ParentCode: "CN", CountryCode: "CN", TimeZone: "Asia/Shanghai", EffectiveDate: "2025-01-01"}
+	if _, err := svc.CreateLocation(ctx, site); err != nil {
+		t.Fatalf("create site failed: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		location Location
+	}{
+		{name: "missing parent", location: Location{TenantID: tenant, Code: "X1", Name: "X", Type: TypeRegion, Status: StatusActive, ParentCode: "NOPE", EffectiveDate: "2025-01-01"}},
+		{name: "parent not yet effective", location: Location{TenantID: tenant, Code: "X2", Name: "X", Type: TypeRegion, Status: StatusActive, ParentCode: "APAC", EffectiveDate: "2024-06-01"}},
+		{name: "site as parent", location: Location{TenantID: tenant, Code: "X3", Name: "X", Type: TypeRegion, Status: StatusActive, ParentCode: "SH-01", EffectiveDate: "2025-01-01"}},
+	}
+	for _, tc := range cases {
+		if _, err := svc.CreateLocation(ctx, tc.location); !errors.Is(err, ErrInvalidLocation) {
+			t.Fatalf("%s: expected ErrInvalidLocation, got %v", tc.name, err)
+		}
+	}
+
+	cycle := apac
+	cycle.ParentCode = "CN"
+	if _, err := svc.UpdateLocation(ctx, cycle); !errors.Is(err, ErrInvalidLocation) {
+		t.Fatalf("expected cycle to be rejected, got %v", err)
+	}
+	retype := site
+	retype.Type = TypeRegion
+	retype.EffectiveDate = "2026-01-01"
+	if _, err := svc.CreateVersion(ctx, retype); !errors.Is(err, ErrInvalidLocation) {
+		t.Fatalf("expected type change to be rejected, got %v", err)
+	}
+
+	if got, err := svc.ListLocations(ctx, tenant, ListFilter{Type: TypeSite, CountryCode: "CN"}); err != nil || len(got) != 1 || got[0].Code != "SH-01" {
+		t.Fatalf("unexpected sites in CN: %v %#v", err, got)
+	}
+	if got, err := svc.ListLocations(ctx, tenant, ListFilter{ParentCode: "APAC"}); err != nil || len(got) != 1 || got[0].Code != "CN" {
+		t.Fatalf("unexpected children of APAC: %v %#v", err, got)
+	}
+	if err := svc.DeleteLocation(ctx, tenant, "CN"); !errors.Is(err, ErrInUse) {
+		t.Fatalf("expected region with children to stay, got %v", err)
+	}
+}
+
+func TestServiceAssignAndDelete(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	store := newMemoryStore()
+	svc := NewService(store, nil)
+	svc.now = func() time.Time { return time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC) }
+
+	region := Location{TenantID: tenant, Code: "EMEA", Name: "Europe", Type: TypeRegion, Status: StatusActive, EffectiveDate: "2025-01-01"}
+	site := Location{TenantID: tenant, Code: "BER-01", Name: "Berlin", Type: TypeSite, Status: StatusActive, ParentCode: "EMEA", CountryCode: "DE", TimeZone: "Europe/Berlin", EffectiveDate: "2025-01-01"}
+	for _, l := range []Location{region, site} {
+		if _, err := svc.CreateLocation(ctx, l); err != nil {
+			t.Fatalf("create %s failed: %v", l.Code, err)
+		}
+	}
+	if _, err := svc.CreateLocation(ctx, site); !errors.Is(err, ErrDuplicateCode) {
+		t.Fatalf("expected duplicate code, got %v", err)
+	}
+	closing := site
+	closing.Status = StatusInactive
+	closing.EffectiveDate = "2026-01-01"
+	if _, err := svc.CreateVersion(ctx, closing); err != nil {
+		t.Fatalf("create version failed: %v", err)
+	}
+	if _, err := svc.CreateVersion(ctx, closing); !errors.Is(err, ErrVersionExists) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	orgDefault := Assignment{TenantID: tenant, SubjectType: SubjectOrganization, SubjectCode: "1000001", LocationCode: "BER-01", EffectiveDate: "2025-03-01"}
+	if _, err := svc.Assign(ctx, orgDefault); err != nil {
+		t.Fatalf("assign organization default failed: %v", err)
+	}
+	clearOverride := Assignment{TenantID: tenant, SubjectType: SubjectPosition, SubjectCode: "P1000001", EffectiveDate: "2025-03-01"}
+	if _, err := svc.Assign(ctx, clearOverride); err != nil {
+		t.Fatalf("clear position override failed: %v", err)
+	}
+	if len(store.assignments) != 2 || store.assignments[0].CreatedAt.IsZero() {
+		t.Fatalf("expected assignments to be stored: %#v", store.assignments)
+	}
+
+	invalid := []struct {
+		name       string
+		assignment Assignment
+		want       error
+	}{
+		{name: "region", assignment: Assignment{TenantID: tenant, SubjectType: SubjectPosition, SubjectCode: "P1000001", LocationCode: "EMEA", EffectiveDate: "2025-03-01"}, want: ErrInvalidAssignment},
+		{name: "inactive site", assignment: Assignment{TenantID: tenant, SubjectType: SubjectPosition, SubjectCode: "P1000001", LocationCode: "BER-01", EffectiveDate: "2026-02-01"}, want: ErrInvalidAssignment},
+		{name: "unknown site", assignment: Assignment{TenantID: tenant, SubjectType: SubjectPosition, SubjectCode: "P1000001", LocationCode: "NOPE", EffectiveDate: "2025-03-01"}, want: ErrInvalidAssignment},
+		{name: "bad subject type", assignment: Assignment{TenantID: tenant, SubjectType: "EMPLOYEE", SubjectCode: "E1", LocationCode: "BER-01", EffectiveDate: "2025-03-01"}, want: ErrInvalidAssignment},
+		{name: "missing position", assignment: Assignment{TenantID: tenant, SubjectType: SubjectPosition, SubjectCode: "P9999999", LocationCode: "BER-01", EffectiveDate: "2025-03-01"}, want: ErrSubjectNotFound},
+	}
+	for _, tc := range invalid {
+		if _, err := svc.Assign(ctx, tc.assignment); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	store.usage["BER-01"] = 1
+	if err := svc.DeleteLocation(ctx, tenant, "BER-01"); !errors.Is(err, ErrInUse) {
+		t.Fatalf("expected assigned location deletion to fail, got %v", err)
+	}
+	store.usage["BER-01"] = 0
+	if err := svc.DeleteLocation(ctx, tenant, "BER-01"); err != nil {
+		t.Fatalf("delete location failed: %v", err)
+	}
+	if _, err := svc.ListVersions(ctx, tenant, "BER-01"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected deleted location to be gone, got %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 工作地点主数据及组织单元、职位的地点分配
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建地点服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "location",
+			"module":    "command",
+		}),
+	}
+}
+
+func (s *Service) today() time.Time {
+	return s.now().UTC()
+}
+
+func (s *Service) timeline(ctx context.Context, tenantID uuid.UUID, code string) ([]Location, error) {
+	versions, err := s.store.ListVersions(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	return temporal.Timeline(versions, s.today()), nil
+}
+
+// ListLocations 返回指定日期生效的地点，可按类型、上级区域与国家过滤
+func (s *Service) ListLocations(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]Location, error) {
+	versions, err := s.timeline(ctx, tenantID, "")
+	if err != nil {
+		return nil, err
+	}
+	asOf := filter.AsOfDate
+	if asOf == "" {
+		asOf = s.today().Format(dateLayout)
+	}
+	locations := make([]Location, 0)
+	for _, l := range temporal.AsOf(versions, asOf) {
+		if !filter.IncludeInactive && l.Status != StatusActive {
+			continue
+		}
+		if filter.Type != "" && l.Type != filter.Type {
+			continue
+		}
+		if filter.ParentCode != "" && l.ParentCode != filter.ParentCode {
+			continue
+		}
+		if filter.CountryCode != "" && l.CountryCode != filter.CountryCode {
+			continue
+		}
+		locations = append(locations, l)
+	}
+	return locations, nil
+}
+
+// GetLocation 读取指定日期生效的地点版本，日期为空时取当天
+func (s *Service) GetLocation(ctx context.Context, tenantID uuid.UUID, code, asOfDate string) (*Location, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if asOfDate == "" {
+		asOfDate = s.today().Format(dateLayout)
+	}
+	matched := temporal.AsOf(versions, asOfDate)
+	if len(matched) == 0 {
+		return nil, ErrNotFound
+	}
+	return &matched[0], nil
+}
+
+// ListVersions 返回地点全部版本（按生效日期升序）
+func (s *Service) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Location, error) {
+	versions, err := s.timeline(ctx, tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	return versions, nil
+}
+
+// CreateLocation 创建地点及其首个版本
+func (s *Service) CreateLocation(ctx context.Context, l Location) (*Location, error) {
+	if err := s.validate(ctx, l); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, l.TenantID, l.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrDuplicateCode
+	}
+	created, err := s.insert(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": l.TenantID, "location": l.Code, "type": l.Type}).Info("location created")
+	return created, nil
+}
+
+// CreateVersion 为已有地点新增生效版本；地点类型不可变更
+func (s *Service) CreateVersion(ctx context.Context, l Location) (*Location, error) {
+	if err := s.validate(ctx, l); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.ListVersions(ctx, l.TenantID, l.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, ErrNotFound
+	}
+	if existing[0].Type != l.Type {
+		return nil, errors.Join(ErrInvalidLocation, fmt.Errorf("type of location %s cannot change from %s", l.Code, existing[0].Type))
+	}
+	created, err := s.insert(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": l.TenantID, "location": l.Code, "effectiveDate": l.EffectiveDate}).Info("location version created")
+	return created, nil
+}
+
+func (s *Service) insert(ctx context.Context, l Location) (*Location, error) {
+	now := s.now().UTC().Truncate(time.Microsecond)
+	l.RecordID = uuid.New()
+	l.CreatedAt = now
+	l.UpdatedAt = now
+	if err := s.store.InsertVersion(ctx, &l); err != nil {
+		return nil, err
+	}
+	versions, err := s.timeline(ctx, l.TenantID, l.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.RecordID == l.RecordID {
+			return &v, nil
+		}
+	}
+	return &l, nil
+}
+
+// UpdateLocation 原位更正当前生效版本（无当前版本时更正最新版本）；类型与生效日期不可修改
+func (s *Service) UpdateLocation(ctx context.Context, l Location) (*Location, error) {
+	versions, err := s.timeline(ctx, l.TenantID, l.Code)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+	target := versions[len(versions)-1]
+	for _, v := range versions {
+		if v.IsCurrent {
+			target = v
+			break
+		}
+	}
+	l.RecordID = target.RecordID
+	l.Type = target.Type
+	l.EffectiveDate = target.EffectiveDate
+	l.EndDate = target.EndDate
+	l.IsCurrent = target.IsCurrent
+	l.CreatedBy = target.CreatedBy
+	l.CreatedAt = target.CreatedAt
+	l.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.validate(ctx, l); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateVersion(ctx, &l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// DeleteLocation 删除地点全部版本；仍被组织、职位或下级地点引用时拒绝删除
+func (s *Service) DeleteLocation(ctx context.Context, tenantID uuid.UUID, code string) error {
+	versions, err := s.timeline(ctx, tenantID, "")
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.ParentCode == code {
+			return fmt.Errorf("%w: location %s is the parent of %s", ErrInUse, code, v.Code)
+		}
+	}
+	count, err := s.store.CountUsage(ctx, tenantID, code)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d organization units or positions use location %s", ErrInUse, count, code)
+	}
+	return s.store.DeleteLocation(ctx, tenantID, code)
+}
+
+// validate 校验版本字段与区域层级：上级须为生效日期存在的区域且不形成环
+func (s *Service) validate(ctx context.Context, l Location) error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+	if l.ParentCode == "" {
+		return nil
+	}
+
+	versions, err := s.timeline(ctx, l.TenantID, "")
+	if err != nil {
+		return err
+	}
+	byCode := make(map[string]Location)
+	for _, v := range temporal.AsOf(versions, l.EffectiveDate) {
+		byCode[v.Code] = v
+	}
+	byCode[l.Code] = l
+
+	parent, ok := byCode[l.ParentCode]
+	if !ok {
+		return errors.Join(ErrInvalidLocation, fmt.Errorf("parent region %s does not exist as of %s", l.ParentCode, l.EffectiveDate))
+	}
+	if parent.Type != TypeRegion {
+		return errors.Join(ErrInvalidLocation, fmt.Errorf("parent %s must be a %s", l.ParentCode, TypeRegion))
+	}
+	visited := map[string]bool{l.Code: true}
+	for code := l.ParentCode; code != ""; code = byCode[code].ParentCode {
+		if visited[code] {
+			return errors.Join(ErrInvalidLocation, fmt.Errorf("parent region %s would create a cycle", l.ParentCode))
+		}
+		visited[code] = true
+	}
+	return nil
+}
+
+// Assign 设置组织单元默认地点或职位地点覆盖；地点须为生效日期存在且启用的 SITE
+func (s *Service) Assign(ctx context.Context, a Assignment) (*Assignment, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	exists, err := s.store.SubjectExists(ctx, a.TenantID, a.SubjectType, a.SubjectCode)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrSubjectNotFound
+	}
+	if a.LocationCode != "" {
+		loc, err := s.GetLocation(ctx, a.TenantID, a.LocationCode, a.EffectiveDate)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return nil, errors.Join(ErrInvalidAssignment, fmt.Errorf("location %s does not exist as of %s", a.LocationCode, a.EffectiveDate))
+		case err != nil:
+			return nil, err
+		case loc.Type != TypeSite:
+			return nil, errors.Join(ErrInvalidAssignment, fmt.Errorf("location %s is a %s; only sites can be assigned", a.LocationCode, loc.Type))
+		case loc.Status != StatusActive:
+			return nil, errors.Join(ErrInvalidAssignment, fmt.Errorf("location %s is inactive as of %s", a.LocationCode, a.EffectiveDate))
+		}
+	}
+
+	a.CreatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.store.SaveAssignment(ctx, &a); err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{
+		"tenantId":      a.TenantID,
+		"subjectType":   a.SubjectType,
+		"subjectCode":   a.SubjectCode,
+		"location":      a.LocationCode,
+		"effectiveDate": a.EffectiveDate,
+	}).Info("location assigned")
+	return &a, nil
+}
@@ -0,0 +1,155 @@
+package location
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"cube-castle/internal/organization/temporal"
+	"github.com/google/uuid"
+)
+
+// SQLStore 基于 PostgreSQL 的地点存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建地点存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const locationColumns = `record_id, tenant_id, code, name, location_type, status, COALESCE(parent_code, ''),
+	COALESCE(country_code, ''), COALESCE(time_zone, ''), COALESCE(address_line1, ''), COALESCE(address_line2, ''),
+	COALESCE(city, ''), COALESCE(state_province, ''), COALESCE(postal_code, ''),
+	effective_date, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanLocation(row interface{ Scan(...any) error }) (*Location, error) {
+	var (
+		l             Location
+		effectiveDate time.Time
+	)
+	if err := row.Scan(&l.RecordID, &l.TenantID, &l.Code, &l.Name, &l.Type, &l.Status, &l.ParentCode,
+		&l.CountryCode, &l.TimeZone, &l.Address.Line1, &l.Address.Line2,
+		&l.Address.City, &l.Address.StateProvince, &l.Address.PostalCode,
+		&effectiveDate, &l.CreatedBy, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	l.EffectiveDate = effectiveDate.Format(dateLayout)
+	l.CreatedAt = l.CreatedAt.UTC()
+	l.UpdatedAt = l.UpdatedAt.UTC()
+	return &l, nil
+}
+
+// ListVersions 列出地点版本
+func (s *SQLStore) ListVersions(ctx context.Context, tenantID uuid.UUID, code string) ([]Location, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+locationColumns+`
+	FROM work_locations
+	WHERE tenant_id = $1 AND ($2 = '' OR code = $2)
+	ORDER BY code, effective_date`, tenantID, code)
+	if err != nil {
+		return nil, fmt.Errorf("list locations: %w", err)
+	}
+	locations, err := temporal.CollectRows(rows, scanLocation)
+	if err != nil {
+		return nil, fmt.Errorf("scan location: %w", err)
+	}
+	return locations, nil
+}
+
+// InsertVersion 写入地点版本
+func (s *SQLStore) InsertVersion(ctx context.Context, l *Location) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO work_locations
+		(record_id, tenant_id, code, name, location_type, status, parent_code, country_code, time_zone,
+		 address_line1, address_line2, city, state_province, postal_code, effective_date, created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''),
+		NULLIF($10, ''), NULLIF($11, ''), NULLIF($12, ''), NULLIF($13, ''), NULLIF($14, ''), $15, NULLIF($16, ''), $17, $18)`,
+		l.RecordID, l.TenantID, l.Code, l.Name, l.Type, l.Status, l.ParentCode, l.CountryCode, l.TimeZone,
+		l.Address.Line1, l.Address.Line2, l.Address.City, l.Address.StateProvince, l.Address.PostalCode,
+		l.EffectiveDate, l.CreatedBy, l.CreatedAt, l.UpdatedAt,
+	)
+	if err != nil {
+		if temporal.IsVersionConflict(err) {
+			return ErrVersionExists
+		}
+		return fmt.Errorf("insert location: %w", err)
+	}
+	return nil
+}
+
+// UpdateVersion 原位更正地点版本
+func (s *SQLStore) UpdateVersion(ctx context.Context, l *Location) error {
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE work_locations
+	SET name = $3, status = $4, parent_code = NULLIF($5, ''), country_code = NULLIF($6, ''), time_zone = NULLIF($7, ''),
+		address_line1 = NULLIF($8, ''), address_line2 = NULLIF($9, ''), city = NULLIF($10, ''),
+		state_province = NULLIF($11, ''), postal_code = NULLIF($12, ''), updated_at = $13
+	WHERE tenant_id = $1 AND record_id = $2`,
+		l.TenantID, l.RecordID, l.Name, l.Status, l.ParentCode, l.CountryCode, l.TimeZone,
+		l.Address.Line1, l.Address.Line2, l.Address.City, l.Address.StateProvince, l.Address.PostalCode, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update location: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// DeleteLocation 删除地点的全部版本
+func (s *SQLStore) DeleteLocation(ctx context.Context, tenantID uuid.UUID, code string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM work_locations WHERE tenant_id = $1 AND code = $2`, tenantID, code)
+	if err != nil {
+		return fmt.Errorf("delete location: %w", err)
+	}
+	return temporal.RequireAffected(res, ErrNotFound)
+}
+
+// CountUsage 统计引用该地点的分配记录数
+func (s *SQLStore) CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `
+	SELECT COUNT(*) FROM location_assignments WHERE tenant_id = $1 AND location_code = $2`, tenantID, code).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count location usage: %w", err)
+	}
+	return count, nil
+}
+
+// SubjectExists 判断组织单元或职位是否存在且未删除
+func (s *SQLStore) SubjectExists(ctx context.Context, tenantID uuid.UUID, subjectType, code string) (bool, error) {
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM organization_units
+		WHERE tenant_id = $1 AND code = $2 AND is_current = true AND status <> 'DELETED'
+	)`
+	if subjectType == SubjectPosition {
+		query = `
+	SELECT EXISTS (
+		SELECT 1 FROM positions
+		WHERE tenant_id = $1 AND code = $2 AND is_current = true AND deleted_at IS NULL
+	)`
+	}
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, query, tenantID, code).Scan(&exists); err != nil {
+		return false, fmt.Errorf("lookup location subject: %w", err)
+	}
+	return exists, nil
+}
+
+// SaveAssignment 写入地点分配，同对象同生效日期的分配被覆盖
+func (s *SQLStore) SaveAssignment(ctx context.Context, a *Assignment) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO location_assignments
+		(id, tenant_id, subject_type, subject_code, location_code, effective_date, created_by, created_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), $8)
+	ON CONFLICT (tenant_id, subject_type, subject_code, effective_date)
+	DO UPDATE SET location_code = EXCLUDED.location_code, created_by = EXCLUDED.created_by, created_at = EXCLUDED.created_at`,
+		uuid.New(), a.TenantID, a.SubjectType, a.SubjectCode, a.LocationCode, a.EffectiveDate, a.CreatedBy, a.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save location assignment: %w", err)
+	}
+	return nil
+}
@@ -88,6 +88,7 @@ func (p *PerformanceMiddleware) Middleware() func(http.Handler) http.Handler {
 				route = "unknown"
 			}
 			utilspkg.RecordHTTPRequest(r.Method, route, wrapper.statusCode)
+			utilspkg.ObserveHTTPRequestDuration(r.Method, route, wrapper.statusCode, duration)
 		})
 	}
 }
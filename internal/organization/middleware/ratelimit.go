@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -45,6 +46,14 @@ type RateLimitMiddleware struct {
 	mutex   sync.RWMutex
 	logger  pkglogger.Logger
 	stats   *RateLimitStats
+	// sample 上次告警采样时的计数，用于计算区间限流占比
+	sample rateLimitSample
+}
+
+type rateLimitSample struct {
+	total     int64
+	blocked   int64
+	lastReset time.Time
 }
 
 // RateLimitStats 限流统计
@@ -328,6 +337,30 @@ func (rlm *RateLimitMiddleware) GetClientInfo(ip string) *ClientInfo {
 	return nil
 }
 
+// AlertMetrics 返回自上次采样以来的限流占比（ratelimit.block_ratio），区间内无请求时占比为 0
+func (rlm *RateLimitMiddleware) AlertMetrics(_ context.Context) (map[string]float64, error) {
+	rlm.stats.mutex.Lock()
+	defer rlm.stats.mutex.Unlock()
+
+	prev := rlm.sample
+	if !prev.lastReset.Equal(rlm.stats.LastReset) {
+		prev = rateLimitSample{}
+	}
+	total := rlm.stats.TotalRequests - prev.total
+	blocked := rlm.stats.BlockedRequests - prev.blocked
+	rlm.sample = rateLimitSample{
+		total:     rlm.stats.TotalRequests,
+		blocked:   rlm.stats.BlockedRequests,
+		lastReset: rlm.stats.LastReset,
+	}
+
+	ratio := 0.0
+	if total > 0 {
+		ratio = float64(blocked) / float64(total)
+	}
+	return map[string]float64{"ratelimit.block_ratio": ratio}, nil
+}
+
 // ResetStats 重置统计信息
 func (rlm *RateLimitMiddleware) ResetStats() {
 	rlm.stats.mutex.Lock()
@@ -0,0 +1,141 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"cube-castle/internal/monitoring/health"
+	"cube-castle/internal/organization/webhook"
+	"github.com/google/uuid"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// EmailChannel 通过 SMTP 发送通知邮件；订阅 target 为逗号分隔的收件人列表
+type EmailChannel struct {
+	cfg health.EmailConfig
+}
+
+// NewEmailChannel 复用告警邮件的 SMTP 配置创建邮件渠道（cfg.To 被订阅收件人覆盖）
+func NewEmailChannel(cfg health.EmailConfig) *EmailChannel {
+	return &EmailChannel{cfg: cfg}
+}
+
+// Name 返回渠道标识
+func (c *EmailChannel) Name() string { return ChannelEmail }
+
+// Deliver 发送通知邮件，Message-ID 取投递 ID 便于收件端去重
+func (c *EmailChannel) Deliver(ctx context.Context, d *Delivery) error {
+	cfg := c.cfg
+	cfg.To = nil
+	for _, addr := range strings.Split(d.Target, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			cfg.To = append(cfg.To, addr)
+		}
+	}
+	text := d.Body + "\n\n--\nCube Castle 业务通知\n"
+	htmlBody := fmt.Sprintf(`<!DOCTYPE html>
+<html><body style="font-family:Helvetica,Arial,sans-serif;color:#212121">
+<h3>%s</h3>
+<p style="white-space:pre-line">%s</p>
+<p style="color:#757575;font-size:12px">Cube Castle 业务通知</p>
+</body></html>
+`, html.EscapeString(d.Title), html.EscapeString(d.Body))
+	return health.SendMail(ctx, cfg, d.Title, "notification-"+d.ID.String(), []byte(text), []byte(htmlBody))
+}
+
+// WebhookChannel 以 JSON POST 推送通知；Idempotency-Key 头取投递 ID，接收方据此去重重试请求
+type WebhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel 创建 webhook 渠道；client 为空时复用 webhook 投递的防护客户端
+// （10s 超时、不跟随重定向、拨号时拒绝内网与元数据地址）
+func NewWebhookChannel(client *http.Client) *WebhookChannel {
+	if client == nil {
+		client = webhook.NewClient(webhook.Config{Timeout: defaultWebhookTimeout})
+	}
+	return &WebhookChannel{client: client}
+}
+
+// Name 返回渠道标识
+func (c *WebhookChannel) Name() string { return ChannelWebhook }
+
+type webhookBody struct {
+	ID        uuid.UUID              `json:"id"`
+	TenantID  uuid.UUID              `json:"tenantId"`
+	EventType string                 `json:"eventType"`
+	Title     string                 `json:"title"`
+	Body      string                 `json:"body"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// Deliver 推送通知，非 2xx 响应视为失败
+func (c *WebhookChannel) Deliver(ctx context.Context, d *Delivery) error {
+	payload, err := json.Marshal(webhookBody{
+		ID:        d.ID,
+		TenantID:  d.TenantID,
+		EventType: d.EventType,
+		Title:     d.Title,
+		Body:      d.Body,
+		Data:      d.Payload,
+		CreatedAt: d.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Target, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", d.ID.String())
+	req.Header.Set("X-Cube-Castle-Event", d.EventType)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// InboxChannel 写入站内收件箱；订阅 target 为收件人用户 ID
+type InboxChannel struct {
+	store Store
+	now   func() time.Time
+}
+
+// NewInboxChannel 创建站内收件箱渠道
+func NewInboxChannel(store Store) *InboxChannel {
+	return &InboxChannel{store: store, now: time.Now}
+}
+
+// Name 返回渠道标识
+func (c *InboxChannel) Name() string { return ChannelInbox }
+
+// Deliver 写入收件箱，按投递 ID 幂等
+func (c *InboxChannel) Deliver(ctx context.Context, d *Delivery) error {
+	return c.store.AddInboxItem(ctx, &InboxItem{
+		ID:         uuid.New(),
+		TenantID:   d.TenantID,
+		Recipient:  strings.TrimSpace(d.Target),
+		DeliveryID: d.ID,
+		EventType:  d.EventType,
+		Title:      d.Title,
+		Body:       d.Body,
+		Payload:    d.Payload,
+		CreatedAt:  c.now().UTC(),
+	})
+}
@@ -0,0 +1,251 @@
+// Package notification 实现面向业务管理者的事件通知：代理任职即将到期、职位长期空缺、组织停用影响子树。
+//
+// 通知由两类来源驱动：调度任务定期扫描（代理到期、职位空缺）与 outbox 事件（组织停用）。
+// 每个租户可按事件类型订阅 email/webhook/inbox 渠道；投递记录以 (订阅, 去重键) 唯一，
+// 重复扫描或事件重放不会重复通知，失败投递按指数退避重试。
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/webhook"
+	"github.com/google/uuid"
+)
+
+// 通知事件类型
+const (
+	EventActingExpiring = "assignment.acting_expiring"
+	EventPositionVacant = "position.vacant_prolonged"
+	EventOrgSuspended   = "organization.suspended"
+)
+
+const (
+	defaultActingLeadDays = 7
+	defaultVacantDays     = 30
+	defaultMaxAttempts    = 5
+	defaultRetryBackoff   = time.Minute
+	maxRetryBackoff       = time.Hour
+	scanBatchSize         = 500
+	deliverBatchSize      = 100
+)
+
+// 投递渠道
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+	ChannelInbox   = "inbox"
+)
+
+// 投递状态
+const (
+	DeliveryPending = "pending"
+	DeliverySent    = "sent"
+	DeliveryFailed  = "failed"
+	DeliveryDead    = "dead"
+)
+
+var (
+	// ErrInvalidSubscription 订阅参数不合法
+	ErrInvalidSubscription = errors.New("invalid notification subscription")
+	// ErrSubscriptionNotFound 订阅不存在
+	ErrSubscriptionNotFound = errors.New("notification subscription not found")
+	// ErrInboxItemNotFound 收件箱消息不存在
+	ErrInboxItemNotFound = errors.New("notification inbox item not found")
+)
+
+// Subscription 租户订阅偏好
+//
+// ThresholdDays 对代理到期表示提前提醒天数（默认 7），对职位空缺表示空缺天数阈值（默认 30），组织停用忽略。
+// OrganizationCode 非空时仅通知该组织及其下级范围内的事件。
+type Subscription struct {
+	ID               uuid.UUID `json:"id"`
+	TenantID         uuid.UUID `json:"tenantId"`
+	EventType        string    `json:"eventType"`
+	Channel          string    `json:"channel"`
+	Target           string    `json:"target"`
+	OrganizationCode string    `json:"organizationCode,omitempty"`
+	ThresholdDays    int       `json:"thresholdDays"`
+	Enabled          bool      `json:"enabled"`
+	CreatedBy        string    `json:"createdBy,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// Threshold 返回生效的阈值天数
+func (s Subscription) Threshold() int {
+	if s.ThresholdDays > 0 {
+		return s.ThresholdDays
+	}
+	switch s.EventType {
+	case EventActingExpiring:
+		return defaultActingLeadDays
+	case EventPositionVacant:
+		return defaultVacantDays
+	}
+	return 0
+}
+
+// covers 判断订阅范围是否包含给定组织路径（/1000000/1000001 形式）
+func (s Subscription) covers(codePath string) bool {
+	scope := strings.TrimSpace(s.OrganizationCode)
+	if scope == "" {
+		return true
+	}
+	for _, segment := range strings.Split(codePath, "/") {
+		if segment == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 校验订阅参数
+func (s Subscription) Validate() error {
+	switch s.EventType {
+	case EventActingExpiring, EventPositionVacant, EventOrgSuspended:
+	default:
+		return errors.Join(ErrInvalidSubscription, errors.New("unsupported eventType"))
+	}
+	switch s.Channel {
+	case ChannelEmail, ChannelWebhook, ChannelInbox:
+	default:
+		return errors.Join(ErrInvalidSubscription, errors.New("unsupported channel"))
+	}
+	if strings.TrimSpace(s.Target) == "" {
+		return errors.Join(ErrInvalidSubscription, errors.New("target is required"))
+	}
+	if s.Channel == ChannelWebhook {
+		if err := validateWebhookTarget(s.Target); err != nil {
+			return errors.Join(ErrInvalidSubscription, err)
+		}
+	}
+	if s.ThresholdDays < 0 || s.ThresholdDays > 365 {
+		return errors.Join(ErrInvalidSubscription, errors.New("thresholdDays must be between 0 and 365"))
+	}
+	return nil
+}
+
+// validateWebhookTarget 校验 webhook 目标为 http(s) URL 且不指向回环、私有、链路本地或云元数据地址；
+// 域名解析后的地址由 WebhookChannel 的拨号检查兜底
+func validateWebhookTarget(target string) error {
+	u, err := url.Parse(strings.TrimSpace(target))
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return errors.New("webhook target must be an http(s) URL")
+	}
+	return webhook.CheckTargetHost(u.Hostname())
+}
+
+// Notification 一条待分发的业务事件
+type Notification struct {
+	TenantID  uuid.UUID
+	EventType string
+	// DedupKey 标识同一业务事实，同一订阅下相同键只投递一次
+	DedupKey string
+	// CodePath 事件所属组织路径，用于订阅范围过滤
+	CodePath string
+	// Affected 事件波及的其他组织编码（如停用子树），订阅范围命中任一即通知
+	Affected []string
+	// Days 事件的天数度量（距代理到期剩余天数 / 已空缺天数），用于阈值过滤
+	Days  int
+	Title string
+	Body  string
+	Data  map[string]interface{}
+}
+
+// Delivery 单个订阅的一次投递记录
+type Delivery struct {
+	ID             uuid.UUID              `json:"id"`
+	TenantID       uuid.UUID              `json:"tenantId"`
+	SubscriptionID uuid.UUID              `json:"subscriptionId"`
+	EventType      string                 `json:"eventType"`
+	DedupKey       string                 `json:"dedupKey"`
+	Channel        string                 `json:"channel"`
+	Target         string                 `json:"target"`
+	Title          string                 `json:"title"`
+	Body           string                 `json:"body"`
+	Payload        map[string]interface{} `json:"payload,omitempty"`
+	Status         string                 `json:"status"`
+	Attempts       int                    `json:"attempts"`
+	NextAttemptAt  time.Time              `json:"nextAttemptAt"`
+	LastError      string                 `json:"lastError,omitempty"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	SentAt         *time.Time             `json:"sentAt,omitempty"`
+}
+
+// InboxItem 站内收件箱消息
+type InboxItem struct {
+	ID         uuid.UUID              `json:"id"`
+	TenantID   uuid.UUID              `json:"tenantId"`
+	Recipient  string                 `json:"recipient"`
+	DeliveryID uuid.UUID              `json:"deliveryId"`
+	EventType  string                 `json:"eventType"`
+	Title      string                 `json:"title"`
+	Body       string                 `json:"body"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+	ReadAt     *time.Time             `json:"readAt,omitempty"`
+	CreatedAt  time.Time              `json:"createdAt"`
+}
+
+// Channel 投递渠道适配器
+type Channel interface {
+	Name() string
+	Deliver(ctx context.Context, delivery *Delivery) error
+}
+
+// ActingCandidate 即将到期的代理任职
+type ActingCandidate struct {
+	AssignmentID     uuid.UUID
+	PositionCode     string
+	PositionTitle    string
+	OrganizationCode string
+	CodePath         string
+	EmployeeID       uuid.UUID
+	EmployeeName     string
+	ActingUntil      time.Time
+	ReminderSent     bool
+}
+
+// VacantCandidate 空缺职位
+type VacantCandidate struct {
+	PositionCode     string
+	PositionTitle    string
+	OrganizationCode string
+	CodePath         string
+	VacantSince      time.Time
+}
+
+// SubtreeImpact 组织停用影响的子树范围
+type SubtreeImpact struct {
+	Organizations     []string
+	Positions         int
+	ActiveAssignments int
+}
+
+// Store 通知持久化与扫描查询
+type Store interface {
+	ListSubscriptions(ctx context.Context, tenantID uuid.UUID, eventType string, enabledOnly bool) ([]Subscription, error)
+	GetSubscription(ctx context.Context, tenantID, id uuid.UUID) (*Subscription, error)
+	SaveSubscription(ctx context.Context, sub *Subscription) error
+	DeleteSubscription(ctx context.Context, tenantID, id uuid.UUID) error
+	SubscribedTenants(ctx context.Context, eventType string) ([]uuid.UUID, error)
+
+	// ClaimDelivery 按 (订阅, 去重键) 插入投递记录；已存在时返回 false
+	ClaimDelivery(ctx context.Context, delivery *Delivery) (bool, error)
+	DueDeliveries(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+	MarkDelivered(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+	MarkDeliveryFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, lastErr string, dead bool) error
+	ListDeliveries(ctx context.Context, tenantID uuid.UUID, status string, limit int) ([]Delivery, error)
+
+	AddInboxItem(ctx context.Context, item *InboxItem) error
+	ListInbox(ctx context.Context, tenantID uuid.UUID, recipient string, unreadOnly bool, limit int) ([]InboxItem, error)
+	MarkInboxRead(ctx context.Context, tenantID uuid.UUID, recipient string, id uuid.UUID, readAt time.Time) error
+
+	ActingExpiringCandidates(ctx context.Context, tenantID uuid.UUID, from, until time.Time, limit int) ([]ActingCandidate, error)
+	MarkReminderSent(ctx context.Context, tenantID, assignmentID uuid.UUID, sentAt time.Time) error
+	VacantPositionCandidates(ctx context.Context, tenantID uuid.UUID, vacantBefore time.Time, limit int) ([]VacantCandidate, error)
+	SubtreeImpact(ctx context.Context, tenantID uuid.UUID, codePath string) (*SubtreeImpact, error)
+}
@@ -0,0 +1,447 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/events"
+	"cube-castle/pkg/eventbus"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// ErrChannelUnavailable 订阅使用的渠道未在当前进程配置（如未配置 SMTP）
+var ErrChannelUnavailable = errors.New("notification channel not configured")
+
+// Service 订阅管理、通知分发与扫描
+type Service struct {
+	store        Store
+	channels     map[string]Channel
+	logger       pkglogger.Logger
+	now          func() time.Time
+	maxAttempts  int
+	retryBackoff time.Duration
+}
+
+// NewService 创建通知服务；channels 按 Name() 注册，未注册渠道的订阅投递会失败并重试
+func NewService(store Store, baseLogger pkglogger.Logger, channels ...Channel) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	s := &Service{
+		store:        store,
+		channels:     make(map[string]Channel, len(channels)),
+		now:          time.Now,
+		maxAttempts:  defaultMaxAttempts,
+		retryBackoff: defaultRetryBackoff,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "notification",
+			"module":    "command",
+		}),
+	}
+	for _, ch := range channels {
+		if ch != nil {
+			s.channels[ch.Name()] = ch
+		}
+	}
+	return s
+}
+
+// ListSubscriptions 列出租户全部订阅
+func (s *Service) ListSubscriptions(ctx context.Context, tenantID uuid.UUID) ([]Subscription, error) {
+	return s.store.ListSubscriptions(ctx, tenantID, "", false)
+}
+
+// GetSubscription 读取单个订阅
+func (s *Service) GetSubscription(ctx context.Context, tenantID, id uuid.UUID) (*Subscription, error) {
+	return s.store.GetSubscription(ctx, tenantID, id)
+}
+
+// CreateSubscription 校验并创建订阅
+func (s *Service) CreateSubscription(ctx context.Context, sub Subscription) (*Subscription, error) {
+	if err := s.checkSubscription(sub); err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	sub.ID = uuid.New()
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+	if err := s.store.SaveSubscription(ctx, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// UpdateSubscription 更新已有订阅（保留创建人与创建时间）
+func (s *Service) UpdateSubscription(ctx context.Context, sub Subscription) (*Subscription, error) {
+	existing, err := s.store.GetSubscription(ctx, sub.TenantID, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSubscription(sub); err != nil {
+		return nil, err
+	}
+	sub.CreatedBy = existing.CreatedBy
+	sub.CreatedAt = existing.CreatedAt
+	sub.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.store.SaveSubscription(ctx, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// DeleteSubscription 删除订阅
+func (s *Service) DeleteSubscription(ctx context.Context, tenantID, id uuid.UUID) error {
+	return s.store.DeleteSubscription(ctx, tenantID, id)
+}
+
+func (s *Service) checkSubscription(sub Subscription) error {
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	if _, ok := s.channels[sub.Channel]; !ok {
+		return fmt.Errorf("%w: %s", ErrChannelUnavailable, sub.Channel)
+	}
+	return nil
+}
+
+// ListDeliveries 列出租户投递记录
+func (s *Service) ListDeliveries(ctx context.Context, tenantID uuid.UUID, status string, limit int) ([]Delivery, error) {
+	return s.store.ListDeliveries(ctx, tenantID, status, limit)
+}
+
+// ListInbox 列出收件人站内消息
+func (s *Service) ListInbox(ctx context.Context, tenantID uuid.UUID, recipient string, unreadOnly bool, limit int) ([]InboxItem, error) {
+	return s.store.ListInbox(ctx, tenantID, recipient, unreadOnly, limit)
+}
+
+// MarkInboxRead 标记站内消息已读
+func (s *Service) MarkInboxRead(ctx context.Context, tenantID uuid.UUID, recipient string, id uuid.UUID) error {
+	return s.store.MarkInboxRead(ctx, tenantID, recipient, id, s.now().UTC())
+}
+
+// Notify 将通知分发给租户内匹配的启用订阅，返回新建投递数（已投递过的去重键不计）
+func (s *Service) Notify(ctx context.Context, n Notification) (int, error) {
+	subs, err := s.store.ListSubscriptions(ctx, n.TenantID, n.EventType, true)
+	if err != nil {
+		return 0, err
+	}
+	return s.dispatch(ctx, subs, n)
+}
+
+func (s *Service) dispatch(ctx context.Context, subs []Subscription, n Notification) (int, error) {
+	created := 0
+	for _, sub := range subs {
+		if !matches(sub, n) {
+			continue
+		}
+		now := s.now().UTC()
+		d := &Delivery{
+			ID:             uuid.New(),
+			TenantID:       n.TenantID,
+			SubscriptionID: sub.ID,
+			EventType:      n.EventType,
+			DedupKey:       n.DedupKey,
+			Channel:        sub.Channel,
+			Target:         sub.Target,
+			Title:          n.Title,
+			Body:           n.Body,
+			Payload:        n.Data,
+			Status:         DeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		}
+		claimed, err := s.store.ClaimDelivery(ctx, d)
+		if err != nil {
+			return created, err
+		}
+		if !claimed {
+			continue
+		}
+		created++
+		s.attempt(ctx, d)
+	}
+	return created, nil
+}
+
+// matches 按订阅范围与阈值过滤通知
+func matches(sub Subscription, n Notification) bool {
+	if !sub.covers(n.CodePath) && !slices.Contains(n.Affected, strings.TrimSpace(sub.OrganizationCode)) {
+		return false
+	}
+	switch n.EventType {
+	case EventActingExpiring:
+		return n.Days <= sub.Threshold()
+	case EventPositionVacant:
+		return n.Days >= sub.Threshold()
+	}
+	return true
+}
+
+// attempt 投递一次并记录结果；失败按指数退避安排重试，达到上限后标记为 dead
+func (s *Service) attempt(ctx context.Context, d *Delivery) {
+	logger := s.logger.WithFields(pkglogger.Fields{
+		"deliveryId": d.ID.String(),
+		"tenantId":   d.TenantID.String(),
+		"eventType":  d.EventType,
+		"channel":    d.Channel,
+	})
+	var err error
+	if ch, ok := s.channels[d.Channel]; ok {
+		err = ch.Deliver(ctx, d)
+	} else {
+		err = fmt.Errorf("%w: %s", ErrChannelUnavailable, d.Channel)
+	}
+	now := s.now().UTC()
+	if err == nil {
+		if markErr := s.store.MarkDelivered(ctx, d.ID, now); markErr != nil {
+			logger.WithFields(pkglogger.Fields{"error": markErr}).Error("mark notification delivered failed")
+		}
+		d.Status = DeliverySent
+		d.Attempts++
+		d.SentAt = &now
+		return
+	}
+
+	d.Attempts++
+	d.LastError = err.Error()
+	dead := d.Attempts >= s.maxAttempts
+	d.Status = DeliveryFailed
+	if dead {
+		d.Status = DeliveryDead
+	}
+	d.NextAttemptAt = now.Add(s.backoff(d.Attempts))
+	if markErr := s.store.MarkDeliveryFailed(ctx, d.ID, d.Attempts, d.NextAttemptAt, d.LastError, dead); markErr != nil {
+		logger.WithFields(pkglogger.Fields{"error": markErr}).Error("mark notification delivery failed")
+	}
+	logger.WithFields(pkglogger.Fields{"error": err, "attempts": d.Attempts, "dead": dead}).Warn("notification delivery failed")
+}
+
+func (s *Service) backoff(attempts int) time.Duration {
+	backoff := s.retryBackoff
+	for i := 1; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// DeliverPending 重试到期的失败投递（以及进程中断遗留的待投递记录），返回本轮处理数
+func (s *Service) DeliverPending(ctx context.Context) (int, error) {
+	due, err := s.store.DueDeliveries(ctx, s.now().UTC(), deliverBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		s.attempt(ctx, d)
+	}
+	return len(due), nil
+}
+
+func (s *Service) today() time.Time {
+	return s.now().UTC().Truncate(24 * time.Hour)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// ScanActingExpiring 扫描各租户即将到期的代理任职并提醒，首次提醒后回写 reminder_sent_at
+func (s *Service) ScanActingExpiring(ctx context.Context) (int, error) {
+	tenants, err := s.store.SubscribedTenants(ctx, EventActingExpiring)
+	if err != nil {
+		return 0, err
+	}
+	today := s.today()
+	total := 0
+	for _, tenantID := range tenants {
+		subs, err := s.store.ListSubscriptions(ctx, tenantID, EventActingExpiring, true)
+		if err != nil {
+			return total, err
+		}
+		lead := 0
+		for _, sub := range subs {
+			lead = max(lead, sub.Threshold())
+		}
+		candidates, err := s.store.ActingExpiringCandidates(ctx, tenantID, today, today.AddDate(0, 0, lead), scanBatchSize)
+		if err != nil {
+			return total, err
+		}
+		for _, c := range candidates {
+			n := actingExpiringNotification(tenantID, c, daysBetween(today, c.ActingUntil))
+			created, err := s.dispatch(ctx, subs, n)
+			if err != nil {
+				return total, err
+			}
+			total += created
+			if created > 0 && !c.ReminderSent {
+				if err := s.store.MarkReminderSent(ctx, tenantID, c.AssignmentID, s.now().UTC()); err != nil {
+					return total, err
+				}
+			}
+		}
+	}
+	return total, nil
+}
+
+func actingExpiringNotification(tenantID uuid.UUID, c ActingCandidate, daysLeft int) Notification {
+	until := c.ActingUntil.Format("2006-01-02")
+	return Notification{
+		TenantID:  tenantID,
+		EventType: EventActingExpiring,
+		DedupKey:  fmt.Sprintf("acting:%s:%s", c.AssignmentID, until),
+		CodePath:  c.CodePath,
+		Days:      daysLeft,
+		Title:     fmt.Sprintf("代理任职即将到期：%s（%s）", c.PositionTitle, c.PositionCode),
+		Body: fmt.Sprintf("%s 在职位 %s（%s，组织 %s）的代理任职将于 %s 到期（剩余 %d 天），请确认是否延长代理或安排正式任命。",
+			c.EmployeeName, c.PositionTitle, c.PositionCode, c.OrganizationCode, until, daysLeft),
+		Data: map[string]interface{}{
+			"assignmentId":     c.AssignmentID.String(),
+			"positionCode":     c.PositionCode,
+			"organizationCode": c.OrganizationCode,
+			"employeeId":       c.EmployeeID.String(),
+			"employeeName":     c.EmployeeName,
+			"actingUntil":      until,
+			"daysRemaining":    daysLeft,
+		},
+	}
+}
+
+// ScanVacantPositions 扫描各租户空缺超过阈值天数的职位
+func (s *Service) ScanVacantPositions(ctx context.Context) (int, error) {
+	tenants, err := s.store.SubscribedTenants(ctx, EventPositionVacant)
+	if err != nil {
+		return 0, err
+	}
+	today := s.today()
+	total := 0
+	for _, tenantID := range tenants {
+		subs, err := s.store.ListSubscriptions(ctx, tenantID, EventPositionVacant, true)
+		if err != nil {
+			return total, err
+		}
+		if len(subs) == 0 {
+			continue
+		}
+		minDays := subs[0].Threshold()
+		for _, sub := range subs[1:] {
+			minDays = min(minDays, sub.Threshold())
+		}
+		candidates, err := s.store.VacantPositionCandidates(ctx, tenantID, today.AddDate(0, 0, -minDays), scanBatchSize)
+		if err != nil {
+			return total, err
+		}
+		for _, c := range candidates {
+			n := vacantPositionNotification(tenantID, c, daysBetween(c.VacantSince, today))
+			created, err := s.dispatch(ctx, subs, n)
+			if err != nil {
+				return total, err
+			}
+			total += created
+		}
+	}
+	return total, nil
+}
+
+func vacantPositionNotification(tenantID uuid.UUID, c VacantCandidate, days int) Notification {
+	since := c.VacantSince.Format("2006-01-02")
+	return Notification{
+		TenantID:  tenantID,
+		EventType: EventPositionVacant,
+		DedupKey:  fmt.Sprintf("vacant:%s:%s", c.PositionCode, since),
+		CodePath:  c.CodePath,
+		Days:      days,
+		Title:     fmt.Sprintf("职位长期空缺：%s（%s）", c.PositionTitle, c.PositionCode),
+		Body: fmt.Sprintf("职位 %s（%s，组织 %s）自 %s 起空缺，已持续 %d 天。",
+			c.PositionTitle, c.PositionCode, c.OrganizationCode, since, days),
+		Data: map[string]interface{}{
+			"positionCode":     c.PositionCode,
+			"organizationCode": c.OrganizationCode,
+			"vacantSince":      since,
+			"vacantDays":       days,
+		},
+	}
+}
+
+// Subscribe 在事件总线上注册 outbox 事件处理器
+func (s *Service) Subscribe(bus eventbus.EventBus) error {
+	return bus.Subscribe(events.EventOrganizationSuspended, s.HandleOrganizationSuspended)
+}
+
+type payloadEvent interface {
+	Payload() json.RawMessage
+}
+
+type organizationSuspendedPayload struct {
+	TenantID         string `json:"tenantId"`
+	OrganizationCode string `json:"organizationCode"`
+	Name             string `json:"name"`
+	CodePath         string `json:"codePath"`
+	EffectiveDate    string `json:"effectiveDate"`
+	OperationReason  string `json:"operationReason"`
+}
+
+// HandleOrganizationSuspended 处理 organization.suspended 事件，按子树影响范围通知订阅者
+func (s *Service) HandleOrganizationSuspended(ctx context.Context, event eventbus.Event) error {
+	pe, ok := event.(payloadEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event payload type %T", event)
+	}
+	var p organizationSuspendedPayload
+	if err := json.Unmarshal(pe.Payload(), &p); err != nil {
+		return fmt.Errorf("decode organization.suspended payload: %w", err)
+	}
+	tenantID, err := uuid.Parse(p.TenantID)
+	if err != nil {
+		return fmt.Errorf("invalid tenantId in organization.suspended: %w", err)
+	}
+	if p.CodePath == "" {
+		p.CodePath = "/" + p.OrganizationCode
+	}
+	impact, err := s.store.SubtreeImpact(ctx, tenantID, p.CodePath)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("组织 %s（%s）自 %s 起停用，影响 %d 个组织单元、%d 个职位、%d 条在任任职。",
+		p.Name, p.OrganizationCode, p.EffectiveDate, len(impact.Organizations), impact.Positions, impact.ActiveAssignments)
+	if reason := strings.TrimSpace(p.OperationReason); reason != "" {
+		body += "原因：" + reason
+	}
+	created, err := s.Notify(ctx, Notification{
+		TenantID:  tenantID,
+		EventType: EventOrgSuspended,
+		DedupKey:  fmt.Sprintf("suspend:%s:%s", p.OrganizationCode, p.EffectiveDate),
+		CodePath:  p.CodePath,
+		Affected:  impact.Organizations,
+		Title:     fmt.Sprintf("组织已停用：%s（%s）", p.Name, p.OrganizationCode),
+		Body:      body,
+		Data: map[string]interface{}{
+			"organizationCode":      p.OrganizationCode,
+			"codePath":              p.CodePath,
+			"effectiveDate":         p.EffectiveDate,
+			"affectedOrganizations": impact.Organizations,
+			"affectedPositions":     impact.Positions,
+			"activeAssignments":     impact.ActiveAssignments,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.WithFields(pkglogger.Fields{
+		"tenantId":         tenantID.String(),
+		"organizationCode": p.OrganizationCode,
+		"deliveries":       created,
+	}).Info("organization suspension notifications dispatched")
+	return nil
+}
@@ -0,0 +1,623 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cube-castle/internal/monitoring/health"
+	"cube-castle/internal/monitoring/health/smtptest"
+	"cube-castle/internal/organization/events"
+	"cube-castle/internal/organization/webhook"
+	"cube-castle/pkg/eventbus"
+	"github.com/google/uuid"
+)
+
+type memStore struct {
+	subs       []Subscription
+	deliveries []*Delivery
+	inbox      []InboxItem
+	acting     []ActingCandidate
+	vacant     []VacantCandidate
+	impact     SubtreeImpact
+	reminders  []uuid.UUID
+	actingTo   time.Time
+}
+
+func (m *memStore) ListSubscriptions(_ context.Context, tenantID uuid.UUID, eventType string, enabledOnly bool) ([]Subscription, error) {
+	var out []Subscription
+	for _, s := range m.subs {
+		if s.TenantID == tenantID && (eventType == "" || s.EventType == eventType) && (!enabledOnly || s.Enabled) {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) GetSubscription(_ context.Context, tenantID, id uuid.UUID) (*Subscription, error) {
+	for _, s := range m.subs {
+		if s.TenantID == tenantID && s.ID == id {
+			sub := s
+			return &sub, nil
+		}
+	}
+	return nil, ErrSubscriptionNotFound
+}
+
+func (m *memStore) SaveSubscription(_ context.Context, sub *Subscription) error {
+	for i := range m.subs {
+		if m.subs[i].ID == sub.ID {
+			m.subs[i] = *sub
+			return nil
+		}
+	}
+	m.subs = append(m.subs, *sub)
+	return nil
+}
+
+func (m *memStore) DeleteSubscription(_ context.Context, tenantID, id uuid.UUID) error {
+	for i := range m.subs {
+		if m.subs[i].TenantID == tenantID && m.subs[i].ID == id {
+			m.subs = append(m.subs[:i], m.subs[i+1:]...)
+			return nil
+		}
+	}
+	return ErrSubscriptionNotFound
+}
+
+func (m *memStore) SubscribedTenants(_ context.Context, eventType string) ([]uuid.UUID, error) {
+	seen := map[uuid.UUID]bool{}
+	var out []uuid.UUID
+	for _, s := range m.subs {
+		if s.EventType == eventType && s.Enabled && !seen[s.TenantID] {
+			seen[s.TenantID] = true
+			out = append(out, s.TenantID)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) ClaimDelivery(_ context.Context, d *Delivery) (bool, error) {
+	for _, existing := range m.deliveries {
+		if existing.SubscriptionID == d.SubscriptionID && existing.DedupKey == d.DedupKey {
+			return false, nil
+		}
+	}
+	copied := *d
+	m.deliveries = append(m.deliveries, &copied)
+	return true, nil
+}
+
+func (m *memStore) find(id uuid.UUID) *Delivery {
+	for _, d := range m.deliveries {
+		if d.ID == id {
+			return d
+		}
+	}
+	return nil
+}
+
+func (m *memStore) DueDeliveries(_ context.Context, now time.Time, limit int) ([]*Delivery, error) {
+	var out []*Delivery
+	for _, d := range m.deliveries {
+		if (d.Status == DeliveryPending || d.Status == DeliveryFailed) && !d.NextAttemptAt.After(now) && len(out) < limit {
+			copied := *d
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) MarkDelivered(_ context.Context, id uuid.UUID, sentAt time.Time) error {
+	d := m.find(id)
+	d.Status = DeliverySent
+	d.Attempts++
+	d.SentAt = &sentAt
+	return nil
+}
+
+func (m *memStore) MarkDeliveryFailed(_ context.Context, id uuid.UUID, attempts int, next time.Time, lastErr string, dead bool) error {
+	d := m.find(id)
+	d.Attempts = attempts
+	d.NextAttemptAt = next
+	d.LastError = lastErr
+	d.Status = DeliveryFailed
+	if dead {
+		d.Status = DeliveryDead
+	}
+	return nil
+}
+
+func (m *memStore) ListDeliveries(_ context.Context, tenantID uuid.UUID, status string, _ int) ([]Delivery, error) {
+	var out []Delivery
+	for _, d := range m.deliveries {
+		if d.TenantID == tenantID && (status == "" || d.Status == status) {
+			out = append(out, *d)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) AddInboxItem(_ context.Context, item *InboxItem) error {
+	for _, existing := range m.inbox {
+		if existing.DeliveryID == item.DeliveryID {
+			return nil
+		}
+	}
+	m.inbox = append(m.inbox, *item)
+	return nil
+}
+
+func (m *memStore) ListInbox(_ context.Context, tenantID uuid.UUID, recipient string, unreadOnly bool, _ int) ([]InboxItem, error) {
+	var out []InboxItem
+	for _, item := range m.inbox {
+		if item.TenantID == tenantID && item.Recipient == recipient && (!unreadOnly || item.ReadAt == nil) {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) MarkInboxRead(_ context.Context, tenantID uuid.UUID, recipient string, id uuid.UUID, readAt time.Time) error {
+	for i := range m.inbox {
+		if m.inbox[i].TenantID == tenantID && m.inbox[i].Recipient == recipient && m.inbox[i].ID == id {
+			m.inbox[i].ReadAt = &readAt
+			return nil
+		}
+	}
+	return ErrInboxItemNotFound
+}
+
+func (m *memStore) ActingExpiringCandidates(_ context.Context, _ uuid.UUID, _, until time.Time, _ int) ([]ActingCandidate, error) {
+	m.actingTo = until
+	var out []ActingCandidate
+	for _, c := range m.acting {
+		if !c.ActingUntil.After(until) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) MarkReminderSent(_ context.Context, _, assignmentID uuid.UUID, _ time.Time) error {
+	m.reminders = append(m.reminders, assignmentID)
+	for i := range m.acting {
+		if m.acting[i].AssignmentID == assignmentID {
+			m.acting[i].ReminderSent = true
+		}
+	}
+	return nil
+}
+
+func (m *memStore) VacantPositionCandidates(_ context.Context, _ uuid.UUID, vacantBefore time.Time, _ int) ([]VacantCandidate, error) {
+	var out []VacantCandidate
+	for _, c := range m.vacant {
+		if !c.VacantSince.After(vacantBefore) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) SubtreeImpact(_ context.Context, _ uuid.UUID, _ string) (*SubtreeImpact, error) {
+	impact := m.impact
+	return &impact, nil
+}
+
+type recordingChannel struct {
+	name      string
+	failures  int
+	delivered []*Delivery
+}
+
+func (c *recordingChannel) Name() string { return c.name }
+
+func (c *recordingChannel) Deliver(_ context.Context, d *Delivery) error {
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("endpoint unavailable")
+	}
+	c.delivered = append(c.delivered, d)
+	return nil
+}
+
+var testTenant = uuid.MustParse("3b99930c-4dc6-4cc9-8e4d-7d960a931cb9")
+
+func newTestService(store *memStore, channels ...Channel) (*Service, *time.Time) {
+	now := time.Date(2025, 11, 25, 8, 0, 0, 0, time.UTC)
+	svc := NewService(store, nil, channels...)
+	svc.now = func() time.Time { return now }
+	return svc, &now
+}
+
+func subscription(eventType, channel, target, orgCode string, threshold int) Subscription {
+	return Subscription{
+		ID:               uuid.New(),
+		TenantID:         testTenant,
+		EventType:        eventType,
+		Channel:          channel,
+		Target:           target,
+		OrganizationCode: orgCode,
+		ThresholdDays:    threshold,
+		Enabled:          true,
+	}
+}
+
+func TestCreateSubscriptionValidatesChannelAvailability(t *testing.T) {
+	svc, _ := newTestService(&memStore{}, &recordingChannel{name: ChannelWebhook})
+
+	_, err := svc.CreateSubscription(context.Background(), subscription(EventActingExpiring, ChannelEmail, "hr@example.com", "", 0))
+	if !errors.Is(err, ErrChannelUnavailable) {
+		t.Fatalf("expected ErrChannelUnavailable, got %v", err)
+	}
+	_, err = svc.CreateSubscription(context.Background(), subscription(EventActingExpiring, ChannelWebhook, "ftp://example.com", "", 0))
+	if !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("expected ErrInvalidSubscription for non-http webhook, got %v", err)
+	}
+	_, err = svc.CreateSubscription(context.Background(), subscription("position.created", ChannelWebhook, "https://example.com", "", 0))
+	if !errors.Is(err, ErrInvalidSubscription) {
+		t.Fatalf("expected ErrInvalidSubscription for unknown event, got %v", err)
+	}
+	created, err := svc.CreateSubscription(context.Background(), subscription(EventActingExpiring, ChannelWebhook, "https://example.com/hook", "", 0))
+	if err != nil || created.ID == uuid.Nil || created.CreatedAt.IsZero() {
+		t.Fatalf("unexpected create result %+v err=%v", created, err)
+	}
+}
+
+func TestNotifyIsIdempotentPerDedupKey(t *testing.T) {
+	store := &memStore{subs: []Subscription{subscription(EventOrgSuspended, ChannelWebhook, "https://example.com/hook", "", 0)}}
+	ch := &recordingChannel{name: ChannelWebhook}
+	svc, _ := newTestService(store, ch)
+
+	n := Notification{TenantID: testTenant, EventType: EventOrgSuspended, DedupKey: "suspend:1000001:2025-11-25", CodePath: "/1000000/1000001"}
+	for i := 0; i < 3; i++ {
+		if _, err := svc.Notify(context.Background(), n); err != nil {
+			t.Fatalf("Notify: %v", err)
+		}
+	}
+	if len(store.deliveries) != 1 || len(ch.delivered) != 1 {
+		t.Fatalf("expected exactly one delivery, got %d records / %d sends", len(store.deliveries), len(ch.delivered))
+	}
+	if store.deliveries[0].Status != DeliverySent {
+		t.Fatalf("expected sent status, got %s", store.deliveries[0].Status)
+	}
+}
+
+func TestMatchesScopeAndThreshold(t *testing.T) {
+	cases := []struct {
+		name string
+		sub  Subscription
+		n    Notification
+		want bool
+	}{
+		{"tenant wide", subscription(EventOrgSuspended, ChannelInbox, "u1", "", 0), Notification{EventType: EventOrgSuspended, CodePath: "/1000000/1000001"}, true},
+		{"ancestor scope", subscription(EventOrgSuspended, ChannelInbox, "u1", "1000000", 0), Notification{EventType: EventOrgSuspended, CodePath: "/1000000/1000001"}, true},
+		{"sibling scope", subscription(EventOrgSuspended, ChannelInbox, "u1", "1000002", 0), Notification{EventType: EventOrgSuspended, CodePath: "/1000000/1000001"}, false},
+		{"descendant affected", subscription(EventOrgSuspended, ChannelInbox, "u1", "1000003", 0), Notification{EventType: EventOrgSuspended, CodePath: "/1000000/1000001", Affected: []string{"1000001", "1000003"}}, true},
+		{"acting within default lead", subscription(EventActingExpiring, ChannelInbox, "u1", "", 0), Notification{EventType: EventActingExpiring, Days: 7}, true},
+		{"acting beyond lead", subscription(EventActingExpiring, ChannelInbox, "u1", "", 3), Notification{EventType: EventActingExpiring, Days: 5}, false},
+		{"vacant below threshold", subscription(EventPositionVacant, ChannelInbox, "u1", "", 0), Notification{EventType: EventPositionVacant, Days: 29}, false},
+		{"vacant over custom threshold", subscription(EventPositionVacant, ChannelInbox, "u1", "", 14), Notification{EventType: EventPositionVacant, Days: 20}, true},
+	}
+	for _, tc := range cases {
+		if got := matches(tc.sub, tc.n); got != tc.want {
+			t.Errorf("%s: matches=%v want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestFailedDeliveryRetriesWithBackoffUntilDead(t *testing.T) {
+	store := &memStore{subs: []Subscription{subscription(EventOrgSuspended, ChannelWebhook, "https://example.com/hook", "", 0)}}
+	ch := &recordingChannel{name: ChannelWebhook, failures: 100}
+	svc, now := newTestService(store, ch)
+
+	if _, err := svc.Notify(context.Background(), Notification{TenantID: testTenant, EventType: EventOrgSuspended, DedupKey: "k"}); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	d := store.deliveries[0]
+	if d.Status != DeliveryFailed || d.Attempts != 1 || !d.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected state after first failure: %+v", d)
+	}
+
+	// 未到重试时间不处理
+	if processed, _ := svc.DeliverPending(context.Background()); processed != 0 {
+		t.Fatalf("expected no due deliveries, got %d", processed)
+	}
+	for attempt := 2; attempt <= defaultMaxAttempts; attempt++ {
+		*now = d.NextAttemptAt
+		if processed, err := svc.DeliverPending(context.Background()); err != nil || processed != 1 {
+			t.Fatalf("attempt %d: processed=%d err=%v", attempt, processed, err)
+		}
+		if d.Attempts != attempt {
+			t.Fatalf("expected %d attempts, got %d", attempt, d.Attempts)
+		}
+	}
+	if d.Status != DeliveryDead {
+		t.Fatalf("expected dead after %d attempts, got %s", defaultMaxAttempts, d.Status)
+	}
+	*now = now.Add(24 * time.Hour)
+	if processed, _ := svc.DeliverPending(context.Background()); processed != 0 {
+		t.Fatalf("dead deliveries must not be retried, got %d", processed)
+	}
+	if got := svc.backoff(20); got != maxRetryBackoff {
+		t.Fatalf("backoff should be capped at %s, got %s", maxRetryBackoff, got)
+	}
+}
+
+func TestRecoveredDeliveryIsMarkedSent(t *testing.T) {
+	store := &memStore{subs: []Subscription{subscription(EventOrgSuspended, ChannelWebhook, "https://example.com/hook", "", 0)}}
+	ch := &recordingChannel{name: ChannelWebhook, failures: 1}
+	svc, now := newTestService(store, ch)
+
+	_, _ = svc.Notify(context.Background(), Notification{TenantID: testTenant, EventType: EventOrgSuspended, DedupKey: "k"})
+	*now = now.Add(time.Minute)
+	if _, err := svc.DeliverPending(context.Background()); err != nil {
+		t.Fatalf("DeliverPending: %v", err)
+	}
+	d := store.deliveries[0]
+	if d.Status != DeliverySent || d.SentAt == nil || d.Attempts != 2 {
+		t.Fatalf("expected sent after retry, got %+v", d)
+	}
+}
+
+func TestScanActingExpiringRemindsOnceAndMarksReminder(t *testing.T) {
+	assignmentID := uuid.New()
+	store := &memStore{
+		subs: []Subscription{
+			subscription(EventActingExpiring, ChannelInbox, "manager-1", "1000001", 0),
+			subscription(EventActingExpiring, ChannelInbox, "hrbp-1", "", 14),
+		},
+		acting: []ActingCandidate{{
+			AssignmentID:     assignmentID,
+			PositionCode:     "P1000001",
+			PositionTitle:    "财务经理",
+			OrganizationCode: "1000001",
+			CodePath:         "/1000000/1000001",
+			EmployeeName:     "张三",
+			ActingUntil:      time.Date(2025, 12, 5, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+	svc, now := newTestService(store, NewInboxChannel(store))
+
+	created, err := svc.ScanActingExpiring(context.Background())
+	if err != nil {
+		t.Fatalf("ScanActingExpiring: %v", err)
+	}
+	// 剩余 10 天：仅 14 天阈值的订阅命中
+	if created != 1 || len(store.inbox) != 1 || store.inbox[0].Recipient != "hrbp-1" {
+		t.Fatalf("expected one reminder to hrbp-1, got created=%d inbox=%+v", created, store.inbox)
+	}
+	if want := time.Date(2025, 12, 9, 0, 0, 0, 0, time.UTC); !store.actingTo.Equal(want) {
+		t.Fatalf("expected scan window until %s, got %s", want, store.actingTo)
+	}
+	if len(store.reminders) != 1 || store.reminders[0] != assignmentID {
+		t.Fatalf("expected reminder_sent marked once, got %v", store.reminders)
+	}
+
+	*now = now.AddDate(0, 0, 4)
+	created, err = svc.ScanActingExpiring(context.Background())
+	if err != nil {
+		t.Fatalf("ScanActingExpiring: %v", err)
+	}
+	if created != 1 || len(store.inbox) != 2 || store.inbox[1].Recipient != "manager-1" {
+		t.Fatalf("expected manager reminder at 6 days left, got created=%d inbox=%+v", created, store.inbox)
+	}
+	if len(store.reminders) != 1 {
+		t.Fatalf("reminder_sent must not be rewritten, got %v", store.reminders)
+	}
+	if created, _ = svc.ScanActingExpiring(context.Background()); created != 0 {
+		t.Fatalf("rescan must not duplicate reminders, got %d", created)
+	}
+}
+
+func TestScanVacantPositionsUsesThreshold(t *testing.T) {
+	store := &memStore{
+		subs: []Subscription{subscription(EventPositionVacant, ChannelInbox, "hrbp-1", "", 0)},
+		vacant: []VacantCandidate{
+			{PositionCode: "P1000001", PositionTitle: "会计", OrganizationCode: "1000001", CodePath: "/1000000/1000001", VacantSince: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+			{PositionCode: "P1000002", PositionTitle: "出纳", OrganizationCode: "1000001", CodePath: "/1000000/1000001", VacantSince: time.Date(2025, 11, 10, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	svc, _ := newTestService(store, NewInboxChannel(store))
+
+	created, err := svc.ScanVacantPositions(context.Background())
+	if err != nil {
+		t.Fatalf("ScanVacantPositions: %v", err)
+	}
+	if created != 1 || store.deliveries[0].DedupKey != "vacant:P1000001:2025-10-01" {
+		t.Fatalf("expected only the long-vacant position, got created=%d deliveries=%+v", created, store.deliveries)
+	}
+	if days := store.deliveries[0].Payload["vacantDays"]; days != 55 {
+		t.Fatalf("expected 55 vacant days, got %v", days)
+	}
+}
+
+func TestHandleOrganizationSuspendedNotifiesSubtreeSubscribers(t *testing.T) {
+	store := &memStore{
+		subs: []Subscription{
+			subscription(EventOrgSuspended, ChannelInbox, "manager-child", "1000003", 0),
+			subscription(EventOrgSuspended, ChannelInbox, "manager-sibling", "1000002", 0),
+		},
+		impact: SubtreeImpact{Organizations: []string{"1000001", "1000003"}, Positions: 4, ActiveAssignments: 3},
+	}
+	svc, _ := newTestService(store, NewInboxChannel(store))
+	bus := eventbus.NewMemoryEventBus(nil, nil)
+	if err := svc.Subscribe(bus); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	payload, _ := json.Marshal(map[string]string{
+		"tenantId":         testTenant.String(),
+		"organizationCode": "1000001",
+		"name":             "财务部",
+		"codePath":         "/1000000/1000001",
+		"effectiveDate":    "2025-11-25",
+		"operationReason":  "组织调整",
+	})
+	event := eventbus.NewGenericJSONEvent(events.EventOrganizationSuspended, "1000001", "organization", payload)
+	for i := 0; i < 2; i++ {
+		if err := bus.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	if len(store.inbox) != 1 || store.inbox[0].Recipient != "manager-child" {
+		t.Fatalf("expected one inbox item for manager-child, got %+v", store.inbox)
+	}
+	if !strings.Contains(store.inbox[0].Body, "2 个组织单元、4 个职位、3 条在任任职") || !strings.Contains(store.inbox[0].Body, "组织调整") {
+		t.Fatalf("unexpected body %q", store.inbox[0].Body)
+	}
+
+	items, _ := svc.ListInbox(context.Background(), testTenant, "manager-child", true, 10)
+	if len(items) != 1 {
+		t.Fatalf("expected unread item, got %d", len(items))
+	}
+	if err := svc.MarkInboxRead(context.Background(), testTenant, "manager-child", items[0].ID); err != nil {
+		t.Fatalf("MarkInboxRead: %v", err)
+	}
+	if err := svc.MarkInboxRead(context.Background(), testTenant, "manager-sibling", items[0].ID); !errors.Is(err, ErrInboxItemNotFound) {
+		t.Fatalf("other recipients must not read the item, got %v", err)
+	}
+	if items, _ = svc.ListInbox(context.Background(), testTenant, "manager-child", true, 10); len(items) != 0 {
+		t.Fatalf("expected no unread items, got %d", len(items))
+	}
+}
+
+func TestSubscriptionValidateRejectsPrivateWebhookTargets(t *testing.T) {
+	for _, target := range []string{
+		"http://localhost:8080/hook",
+		"http://127.0.0.1/hook",
+		"https://10.0.0.5/hook",
+		"https://192.168.1.10/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://metadata.google.internal/computeMetadata/v1",
+		"https://[::1]/hook",
+	} {
+		err := subscription(EventActingExpiring, ChannelWebhook, target, "", 0).Validate()
+		if !errors.Is(err, ErrInvalidSubscription) || !errors.Is(err, webhook.ErrBlockedTarget) {
+			t.Errorf("%s: expected blocked target, got %v", target, err)
+		}
+	}
+	if err := subscription(EventActingExpiring, ChannelWebhook, "https://hooks.example.com/notify", "", 0).Validate(); err != nil {
+		t.Fatalf("expected public target to be accepted, got %v", err)
+	}
+}
+
+func TestWebhookChannelRejectsPrivateAndRedirectTargets(t *testing.T) {
+	var internalHits int
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalHits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer internal.Close()
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusTemporaryRedirect)
+	}))
+	defer redirector.Close()
+	delivery := &Delivery{ID: uuid.New(), TenantID: testTenant, EventType: EventOrgSuspended}
+
+	// 默认客户端在拨号时拒绝回环地址（覆盖域名解析到内网的情况）
+	ch := NewWebhookChannel(nil)
+	delivery.Target = internal.URL
+	if err := ch.Deliver(context.Background(), delivery); err == nil || !errors.Is(err, webhook.ErrBlockedTarget) {
+		t.Fatalf("expected loopback target to be blocked at dial time, got %v", err)
+	}
+
+	// 放开拨号检查后仍不跟随重定向，避免公网目标 30x 跳转到内网
+	ch.client.Transport = http.DefaultTransport
+	delivery.Target = redirector.URL
+	if err := ch.Deliver(context.Background(), delivery); err == nil || !strings.Contains(err.Error(), "307") {
+		t.Fatalf("expected redirect response to fail delivery, got %v", err)
+	}
+	if internalHits != 0 {
+		t.Fatalf("redirect target must not be requested, got %d hits", internalHits)
+	}
+}
+
+func TestWebhookChannelSendsIdempotencyKey(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		keys     []string
+		received webhookBody
+	)
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if r.Header.Get("X-Cube-Castle-Event") != EventOrgSuspended {
+			t.Errorf("unexpected event header %q", r.Header.Get("X-Cube-Castle-Event"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	store := &memStore{subs: []Subscription{subscription(EventOrgSuspended, ChannelWebhook, server.URL, "", 0)}}
+	svc, now := newTestService(store, NewWebhookChannel(server.Client()))
+
+	_, _ = svc.Notify(context.Background(), Notification{TenantID: testTenant, EventType: EventOrgSuspended, DedupKey: "k", Title: "组织已停用"})
+	if store.deliveries[0].Status != DeliveryFailed || !strings.Contains(store.deliveries[0].LastError, "503") {
+		t.Fatalf("expected failed delivery with status error, got %+v", store.deliveries[0])
+	}
+	mu.Lock()
+	status = http.StatusAccepted
+	mu.Unlock()
+	*now = now.Add(time.Minute)
+	_, _ = svc.DeliverPending(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	id := store.deliveries[0].ID.String()
+	if len(keys) != 2 || keys[0] != id || keys[1] != id {
+		t.Fatalf("retries must reuse the delivery id as Idempotency-Key, got %v", keys)
+	}
+	if received.Title != "组织已停用" || received.TenantID != testTenant {
+		t.Fatalf("unexpected webhook body %+v", received)
+	}
+	if store.deliveries[0].Status != DeliverySent {
+		t.Fatalf("expected sent after retry, got %s", store.deliveries[0].Status)
+	}
+}
+
+func TestEmailChannelSendsToSubscriptionRecipients(t *testing.T) {
+	sink, err := smtptest.NewSink(smtptest.Options{})
+	if err != nil {
+		t.Fatalf("start smtp sink: %v", err)
+	}
+	defer sink.Close()
+
+	ch := NewEmailChannel(health.EmailConfig{
+		Host:    sink.Host(),
+		Port:    sink.Port(),
+		From:    "hr-notify@cube-castle.local",
+		To:      []string{"oncall@cube-castle.local"},
+		TLSMode: health.SMTPTLSModeNone,
+		Timeout: 5 * time.Second,
+	})
+	err = ch.Deliver(context.Background(), &Delivery{
+		ID:     uuid.New(),
+		Target: "a@example.com, b@example.com",
+		Title:  "职位长期空缺：会计（P1000001）",
+		Body:   "职位 <会计> 已空缺 55 天。",
+	})
+	if err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	msgs := sink.WaitForMessages(1, 5*time.Second)
+	if len(msgs) != 1 {
+		t.Fatalf("expected one message, got %d", len(msgs))
+	}
+	if got := strings.Join(msgs[0].To, ","); got != "a@example.com,b@example.com" {
+		t.Fatalf("expected subscription recipients, got %s", got)
+	}
+	if got := msgs[0].Header().Get("Message-Id"); !strings.Contains(got, "notification-") {
+		t.Fatalf("expected delivery-based Message-ID, got %q", got)
+	}
+}
@@ -0,0 +1,446 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的通知存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建通知存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const subscriptionColumns = `id, tenant_id, event_type, channel, target, COALESCE(organization_code, ''),
+	threshold_days, enabled, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanSubscription(row interface{ Scan(...any) error }) (*Subscription, error) {
+	var sub Subscription
+	if err := row.Scan(&sub.ID, &sub.TenantID, &sub.EventType, &sub.Channel, &sub.Target, &sub.OrganizationCode,
+		&sub.ThresholdDays, &sub.Enabled, &sub.CreatedBy, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+		return nil, err
+	}
+	sub.CreatedAt = sub.CreatedAt.UTC()
+	sub.UpdatedAt = sub.UpdatedAt.UTC()
+	return &sub, nil
+}
+
+// ListSubscriptions 列出租户订阅；eventType 为空时返回全部类型
+func (s *SQLStore) ListSubscriptions(ctx context.Context, tenantID uuid.UUID, eventType string, enabledOnly bool) ([]Subscription, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+subscriptionColumns+`
+	FROM notification_subscriptions
+	WHERE tenant_id = $1
+	  AND ($2 = '' OR event_type = $2)
+	  AND (NOT $3 OR enabled)
+	ORDER BY created_at, id`, tenantID, eventType, enabledOnly)
+	if err != nil {
+		return nil, fmt.Errorf("list notification subscriptions: %w", err)
+	}
+	defer rows.Close()
+	var subs []Subscription
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan notification subscription: %w", err)
+		}
+		subs = append(subs, *sub)
+	}
+	return subs, rows.Err()
+}
+
+// GetSubscription 读取单个订阅
+func (s *SQLStore) GetSubscription(ctx context.Context, tenantID, id uuid.UUID) (*Subscription, error) {
+	sub, err := scanSubscription(s.db.QueryRowContext(ctx, `
+	SELECT `+subscriptionColumns+`
+	FROM notification_subscriptions WHERE tenant_id = $1 AND id = $2`, tenantID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load notification subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// SaveSubscription 创建或更新订阅
+func (s *SQLStore) SaveSubscription(ctx context.Context, sub *Subscription) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO notification_subscriptions
+		(id, tenant_id, event_type, channel, target, organization_code, threshold_days, enabled, created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, NULLIF($9, ''), $10, $11)
+	ON CONFLICT (id) DO UPDATE SET
+		event_type = EXCLUDED.event_type,
+		channel = EXCLUDED.channel,
+		target = EXCLUDED.target,
+		organization_code = EXCLUDED.organization_code,
+		threshold_days = EXCLUDED.threshold_days,
+		enabled = EXCLUDED.enabled,
+		updated_at = EXCLUDED.updated_at
+	WHERE notification_subscriptions.tenant_id = EXCLUDED.tenant_id`,
+		sub.ID, sub.TenantID, sub.EventType, sub.Channel, sub.Target, sub.OrganizationCode,
+		sub.ThresholdDays, sub.Enabled, sub.CreatedBy, sub.CreatedAt, sub.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save notification subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteSubscription 删除订阅（投递记录随之级联删除）
+func (s *SQLStore) DeleteSubscription(ctx context.Context, tenantID, id uuid.UUID) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM notification_subscriptions WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		return fmt.Errorf("delete notification subscription: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// SubscribedTenants 返回存在启用订阅的租户
+func (s *SQLStore) SubscribedTenants(ctx context.Context, eventType string) ([]uuid.UUID, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT DISTINCT tenant_id FROM notification_subscriptions
+	WHERE event_type = $1 AND enabled
+	ORDER BY tenant_id`, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("list subscribed tenants: %w", err)
+	}
+	defer rows.Close()
+	var tenants []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, id)
+	}
+	return tenants, rows.Err()
+}
+
+// ClaimDelivery 插入投递记录，依赖 (subscription_id, dedup_key) 唯一约束保证幂等
+func (s *SQLStore) ClaimDelivery(ctx context.Context, d *Delivery) (bool, error) {
+	payload, err := json.Marshal(d.Payload)
+	if err != nil {
+		return false, fmt.Errorf("marshal notification payload: %w", err)
+	}
+	res, err := s.db.ExecContext(ctx, `
+	INSERT INTO notification_deliveries
+		(id, tenant_id, subscription_id, event_type, dedup_key, channel, target, title, body, payload,
+		 status, attempts, next_attempt_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, 0, $12, $12)
+	ON CONFLICT (subscription_id, dedup_key) DO NOTHING`,
+		d.ID, d.TenantID, d.SubscriptionID, d.EventType, d.DedupKey, d.Channel, d.Target, d.Title, d.Body, payload,
+		DeliveryPending, d.CreatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("claim notification delivery: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+const deliveryColumns = `id, tenant_id, subscription_id, event_type, dedup_key, channel, target, title, body, payload,
+	status, attempts, next_attempt_at, COALESCE(last_error, ''), created_at, sent_at`
+
+func scanDelivery(row interface{ Scan(...any) error }) (*Delivery, error) {
+	var (
+		d       Delivery
+		payload []byte
+		sentAt  sql.NullTime
+	)
+	if err := row.Scan(&d.ID, &d.TenantID, &d.SubscriptionID, &d.EventType, &d.DedupKey, &d.Channel, &d.Target,
+		&d.Title, &d.Body, &payload, &d.Status, &d.Attempts, &d.NextAttemptAt, &d.LastError, &d.CreatedAt, &sentAt); err != nil {
+		return nil, err
+	}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &d.Payload); err != nil {
+			return nil, fmt.Errorf("decode notification payload: %w", err)
+		}
+	}
+	if sentAt.Valid {
+		t := sentAt.Time.UTC()
+		d.SentAt = &t
+	}
+	return &d, nil
+}
+
+// DueDeliveries 返回到期待投递（新建或失败待重试）的记录
+func (s *SQLStore) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]*Delivery, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+deliveryColumns+`
+	FROM notification_deliveries
+	WHERE status IN ('pending', 'failed') AND next_attempt_at <= $1
+	ORDER BY next_attempt_at
+	LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("load due notification deliveries: %w", err)
+	}
+	defer rows.Close()
+	var due []*Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		due = append(due, d)
+	}
+	return due, rows.Err()
+}
+
+// MarkDelivered 标记投递成功
+func (s *SQLStore) MarkDelivered(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+	UPDATE notification_deliveries
+	SET status = 'sent', attempts = attempts + 1, sent_at = $2, last_error = NULL
+	WHERE id = $1`, id, sentAt)
+	if err != nil {
+		return fmt.Errorf("mark notification delivered: %w", err)
+	}
+	return nil
+}
+
+// MarkDeliveryFailed 记录失败并安排下次重试；dead 为 true 时不再重试
+func (s *SQLStore) MarkDeliveryFailed(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time, lastErr string, dead bool) error {
+	status := DeliveryFailed
+	if dead {
+		status = DeliveryDead
+	}
+	_, err := s.db.ExecContext(ctx, `
+	UPDATE notification_deliveries
+	SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5
+	WHERE id = $1`, id, status, attempts, nextAttemptAt, lastErr)
+	if err != nil {
+		return fmt.Errorf("mark notification delivery failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries 按时间倒序列出租户投递记录
+func (s *SQLStore) ListDeliveries(ctx context.Context, tenantID uuid.UUID, status string, limit int) ([]Delivery, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+deliveryColumns+`
+	FROM notification_deliveries
+	WHERE tenant_id = $1 AND ($2 = '' OR status = $2)
+	ORDER BY created_at DESC
+	LIMIT $3`, tenantID, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list notification deliveries: %w", err)
+	}
+	defer rows.Close()
+	var deliveries []Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *d)
+	}
+	return deliveries, rows.Err()
+}
+
+// AddInboxItem 写入站内消息；同一投递重试时不重复写入
+func (s *SQLStore) AddInboxItem(ctx context.Context, item *InboxItem) error {
+	payload, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("marshal inbox payload: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx, `
+	INSERT INTO notification_inbox (id, tenant_id, recipient, delivery_id, event_type, title, body, payload, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (delivery_id) DO NOTHING`,
+		item.ID, item.TenantID, item.Recipient, item.DeliveryID, item.EventType, item.Title, item.Body, payload, item.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("add inbox item: %w", err)
+	}
+	return nil
+}
+
+// ListInbox 按时间倒序列出收件人的站内消息
+func (s *SQLStore) ListInbox(ctx context.Context, tenantID uuid.UUID, recipient string, unreadOnly bool, limit int) ([]InboxItem, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT id, tenant_id, recipient, delivery_id, event_type, title, body, payload, read_at, created_at
+	FROM notification_inbox
+	WHERE tenant_id = $1 AND recipient = $2 AND (NOT $3 OR read_at IS NULL)
+	ORDER BY created_at DESC
+	LIMIT $4`, tenantID, recipient, unreadOnly, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list inbox: %w", err)
+	}
+	defer rows.Close()
+	var items []InboxItem
+	for rows.Next() {
+		var (
+			item    InboxItem
+			payload []byte
+			readAt  sql.NullTime
+		)
+		if err := rows.Scan(&item.ID, &item.TenantID, &item.Recipient, &item.DeliveryID, &item.EventType,
+			&item.Title, &item.Body, &payload, &readAt, &item.CreatedAt); err != nil {
+			return nil, err
+		}
+		if len(payload) > 0 {
+			if err := json.Unmarshal(payload, &item.Payload); err != nil {
+				return nil, fmt.Errorf("decode inbox payload: %w", err)
+			}
+		}
+		if readAt.Valid {
+			t := readAt.Time.UTC()
+			item.ReadAt = &t
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// MarkInboxRead 标记站内消息已读（重复标记保持首次已读时间）
+func (s *SQLStore) MarkInboxRead(ctx context.Context, tenantID uuid.UUID, recipient string, id uuid.UUID, readAt time.Time) error {
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE notification_inbox SET read_at = COALESCE(read_at, $4)
+	WHERE tenant_id = $1 AND recipient = $2 AND id = $3`, tenantID, recipient, id, readAt)
+	if err != nil {
+		return fmt.Errorf("mark inbox read: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrInboxItemNotFound
+	}
+	return nil
+}
+
+// ActingExpiringCandidates 查询 acting_until 落在 [from, until] 内的在任代理任职
+func (s *SQLStore) ActingExpiringCandidates(ctx context.Context, tenantID uuid.UUID, from, until time.Time, limit int) ([]ActingCandidate, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT pa.assignment_id, pa.position_code, COALESCE(p.title, ''), COALESCE(p.organization_code, ''),
+	       COALESCE(o.code_path, ''), pa.employee_id, pa.employee_name, pa.acting_until, pa.reminder_sent_at IS NOT NULL
+	FROM position_assignments pa
+	LEFT JOIN positions p
+	       ON p.tenant_id = pa.tenant_id AND p.code = pa.position_code AND p.is_current = true
+	LEFT JOIN organization_units o
+	       ON o.tenant_id = p.tenant_id AND o.code = p.organization_code AND o.is_current = true
+	WHERE pa.tenant_id = $1
+	  AND pa.assignment_type = 'ACTING'
+	  AND pa.assignment_status = 'ACTIVE'
+	  AND pa.acting_until IS NOT NULL
+	  AND pa.acting_until >= $2::date
+	  AND pa.acting_until <= $3::date
+	ORDER BY pa.acting_until, pa.assignment_id
+	LIMIT $4`, tenantID, from, until, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query acting expiring assignments: %w", err)
+	}
+	defer rows.Close()
+	var candidates []ActingCandidate
+	for rows.Next() {
+		var c ActingCandidate
+		if err := rows.Scan(&c.AssignmentID, &c.PositionCode, &c.PositionTitle, &c.OrganizationCode, &c.CodePath,
+			&c.EmployeeID, &c.EmployeeName, &c.ActingUntil, &c.ReminderSent); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// MarkReminderSent 回写 position_assignments.reminder_sent_at（仅首次提醒）
+func (s *SQLStore) MarkReminderSent(ctx context.Context, tenantID, assignmentID uuid.UUID, sentAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `
+	UPDATE position_assignments SET reminder_sent_at = $3
+	WHERE tenant_id = $1 AND assignment_id = $2 AND reminder_sent_at IS NULL`, tenantID, assignmentID, sentAt)
+	if err != nil {
+		return fmt.Errorf("mark acting reminder sent: %w", err)
+	}
+	return nil
+}
+
+// VacantPositionCandidates 查询自 vacantBefore 之前即处于空缺状态的当前职位
+//
+// 空缺起始日取最后一次任职结束日；从未任职的职位取职位生效日。
+func (s *SQLStore) VacantPositionCandidates(ctx context.Context, tenantID uuid.UUID, vacantBefore time.Time, limit int) ([]VacantCandidate, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT p.code, p.title, p.organization_code, COALESCE(o.code_path, ''),
+	       COALESCE(MAX(pa.end_date), p.effective_date) AS vacant_since
+	FROM positions p
+	LEFT JOIN position_assignments pa
+	       ON pa.tenant_id = p.tenant_id AND pa.position_code = p.code
+	LEFT JOIN organization_units o
+	       ON o.tenant_id = p.tenant_id AND o.code = p.organization_code AND o.is_current = true
+	WHERE p.tenant_id = $1
+	  AND p.is_current = true
+	  AND p.deleted_at IS NULL
+	  AND p.status = 'VACANT'
+	GROUP BY p.code, p.title, p.organization_code, o.code_path, p.effective_date
+	HAVING COALESCE(MAX(pa.end_date), p.effective_date) <= $2::date
+	ORDER BY vacant_since, p.code
+	LIMIT $3`, tenantID, vacantBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query vacant positions: %w", err)
+	}
+	defer rows.Close()
+	var candidates []VacantCandidate
+	for rows.Next() {
+		var c VacantCandidate
+		if err := rows.Scan(&c.PositionCode, &c.PositionTitle, &c.OrganizationCode, &c.CodePath, &c.VacantSince); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// SubtreeImpact 统计以 codePath 为根的子树中受影响的组织、职位与在任任职
+func (s *SQLStore) SubtreeImpact(ctx context.Context, tenantID uuid.UUID, codePath string) (*SubtreeImpact, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT code FROM organization_units
+	WHERE tenant_id = $1 AND is_current = true
+	  AND (code_path = $2 OR code_path LIKE $2 || '/%')
+	ORDER BY code_path`, tenantID, codePath)
+	if err != nil {
+		return nil, fmt.Errorf("query suspended subtree: %w", err)
+	}
+	impact := &SubtreeImpact{}
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		impact.Organizations = append(impact.Organizations, code)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(impact.Organizations) == 0 {
+		return impact, nil
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+	SELECT COUNT(DISTINCT p.code), COUNT(pa.assignment_id)
+	FROM positions p
+	LEFT JOIN position_assignments pa
+	       ON pa.tenant_id = p.tenant_id AND pa.position_code = p.code AND pa.assignment_status = 'ACTIVE'
+	WHERE p.tenant_id = $1 AND p.is_current = true AND p.deleted_at IS NULL
+	  AND p.organization_code = ANY($2)`, tenantID, pq.Array(impact.Organizations),
+	).Scan(&impact.Positions, &impact.ActiveAssignments)
+	if err != nil {
+		return nil, fmt.Errorf("count suspended subtree positions: %w", err)
+	}
+	return impact, nil
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cube-castle/internal/organization/dto"
@@ -27,10 +29,18 @@ type assignmentRepository interface {
 
 // AssignmentQueryFacade 封装任职查询与缓存刷新逻辑。
 type AssignmentQueryFacade struct {
-	repo     assignmentRepository
-	redis    *redis.Client
-	logger   pkglogger.Logger
-	cacheTTL time.Duration
+	repo   assignmentRepository
+	redis  *redis.Client
+	logger pkglogger.Logger
+	// cacheTTL 统计缓存 TTL（纳秒），可在运行时调整
+	cacheTTL atomic.Int64
+
+	hits   atomic.Int64
+	misses atomic.Int64
+	// sampleMu 保护告警采样基线，命中率按两次采样之间的增量计算
+	sampleMu     sync.Mutex
+	sampleHits   int64
+	sampleMisses int64
 }
 
 // NewAssignmentQueryFacade 创建 AssignmentQueryFacade。
@@ -41,12 +51,26 @@ func NewAssignmentQueryFacade(repo assignmentRepository, redisClient *redis.Clie
 	if cacheTTL <= 0 {
 		cacheTTL = defaultAssignmentCacheTTL
 	}
-	return &AssignmentQueryFacade{
-		repo:     repo,
-		redis:    redisClient,
-		logger:   logger.WithFields(pkglogger.Fields{"component": "assignment-facade"}),
-		cacheTTL: cacheTTL,
+	f := &AssignmentQueryFacade{
+		repo:   repo,
+		redis:  redisClient,
+		logger: logger.WithFields(pkglogger.Fields{"component": "assignment-facade"}),
 	}
+	f.cacheTTL.Store(int64(cacheTTL))
+	return f
+}
+
+// CacheTTL 返回当前统计缓存 TTL。
+func (f *AssignmentQueryFacade) CacheTTL() time.Duration {
+	return time.Duration(f.cacheTTL.Load())
+}
+
+// SetCacheTTL 运行时调整统计缓存 TTL，仅影响之后写入的缓存条目。
+func (f *AssignmentQueryFacade) SetCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = defaultAssignmentCacheTTL
+	}
+	f.cacheTTL.Store(int64(ttl))
 }
 
 // GetAssignments 获取职位任职列表（不强制缓存，保持实时读取）。
@@ -100,6 +124,7 @@ func (f *AssignmentQueryFacade) GetAssignmentStats(
 		if cached, err := f.redis.Get(ctx, cacheKey).Result(); err == nil {
 			var stats dto.AssignmentStats
 			if json.Unmarshal([]byte(cached), &stats) == nil {
+				f.hits.Add(1)
 				f.logger.WithFields(pkglogger.Fields{
 					"tenantId":     tenantID.String(),
 					"positionCode": positionCode,
@@ -110,6 +135,10 @@ func (f *AssignmentQueryFacade) GetAssignmentStats(
 		}
 	}
 
+	if useCache {
+		f.misses.Add(1)
+	}
+
 	stats, err := f.repo.GetAssignmentStats(ctx, tenantID, positionCode, organizationCode)
 	if err != nil {
 		return nil, err
@@ -121,7 +150,7 @@ func (f *AssignmentQueryFacade) GetAssignmentStats(
 	if useCache && stats != nil {
 		data, err := json.Marshal(stats)
 		if err == nil {
-			if err := f.redis.Set(ctx, cacheKey, data, f.cacheTTL).Err(); err != nil {
+			if err := f.redis.Set(ctx, cacheKey, data, f.CacheTTL()).Err(); err != nil {
 				f.logger.WithFields(pkglogger.Fields{
 					"cacheKey": cacheKey,
 					"error":    err,
@@ -166,6 +195,22 @@ func (f *AssignmentQueryFacade) RefreshPositionCache(ctx context.Context, tenant
 	return nil
 }
 
+// AlertMetrics 返回自上次采样以来的任职统计缓存命中率（cache.assignment_hit_rate）；区间内无缓存读取时不返回该指标。
+func (f *AssignmentQueryFacade) AlertMetrics(_ context.Context) (map[string]float64, error) {
+	hits, misses := f.hits.Load(), f.misses.Load()
+
+	f.sampleMu.Lock()
+	deltaHits, deltaMisses := hits-f.sampleHits, misses-f.sampleMisses
+	f.sampleHits, f.sampleMisses = hits, misses
+	f.sampleMu.Unlock()
+
+	total := deltaHits + deltaMisses
+	if total <= 0 {
+		return map[string]float64{}, nil
+	}
+	return map[string]float64{"cache.assignment_hit_rate": float64(deltaHits) / float64(total)}, nil
+}
+
 func (f *AssignmentQueryFacade) statsCacheKey(tenantID uuid.UUID, positionCode string) string {
 	return fmt.Sprintf("%s:%s:%s:%s", statsCachePrefix, tenantID.String(), strings.ToUpper(positionCode), "v1")
 }
@@ -169,23 +169,24 @@ func (h *HierarchyRepository) GetDirectChildren(ctx context.Context, parentCode
 	return children, nil
 }
 
-// UpdateHierarchyPaths 更新层级路径 (code_path, name_path)
+// UpdateHierarchyPaths 更新层级路径 (code_path, name_path, name_path_i18n)
 func (h *HierarchyRepository) UpdateHierarchyPaths(ctx context.Context, parentCode string, tenantID uuid.UUID) error {
 	// 获取父组织路径
-	var parentCodePath, parentNamePath string
+	var parentCodePath, parentNamePath, parentNamePathI18n string
 	var parentLevel int
 
 	if parentCode == "" {
 		// 根组织情况
 		parentCodePath = ""
 		parentNamePath = ""
+		parentNamePathI18n = "{}"
 		parentLevel = 0
 	} else {
 		err := h.db.QueryRowContext(ctx, `
-			SELECT COALESCE(code_path, code), COALESCE(name_path, name), level
+			SELECT COALESCE(code_path, code), COALESCE(name_path, name), COALESCE(name_path_i18n, '{}'::jsonb)::text, level
 			FROM organization_units 
 			WHERE code = $1 AND tenant_id = $2 AND is_current = true
-		`, parentCode, tenantID.String()).Scan(&parentCodePath, &parentNamePath, &parentLevel)
+		`, parentCode, tenantID.String()).Scan(&parentCodePath, &parentNamePath, &parentNamePathI18n, &parentLevel)
 
 		if err != nil {
 			if err == sql.ErrNoRows {
@@ -202,6 +203,7 @@ func (h *HierarchyRepository) UpdateHierarchyPaths(ctx context.Context, parentCo
 	}
 	defer tx.Rollback()
 
+	// name_path_i18n 覆盖父路径与子组织名称已配置语言的并集，取值规则见 localized_name()
 	updateQuery := `
 	UPDATE organization_units SET
 		code_path = CASE 
@@ -212,12 +214,23 @@ func (h *HierarchyRepository) UpdateHierarchyPaths(ctx context.Context, parentCo
 			WHEN $2 = '' THEN name
 			ELSE $2 || '/' || name  
 		END,
+		name_path_i18n = COALESCE((
+			SELECT jsonb_object_agg(locales.locale, CASE
+				WHEN $2 = '' THEN localized_name(name_i18n, locales.locale, name)
+				ELSE localized_name($6::jsonb, locales.locale, $2) || '/' || localized_name(name_i18n, locales.locale, name)
+			END)
+			FROM (
+				SELECT jsonb_object_keys($6::jsonb) AS locale
+				UNION
+				SELECT jsonb_object_keys(name_i18n)
+			) locales
+		), '{}'::jsonb),
 		level = $3 + 1,
 		updated_at = NOW()
 	WHERE parent_code = $4 AND tenant_id = $5 AND is_current = true;
 	`
 
-	result, err := tx.ExecContext(ctx, updateQuery, parentCodePath, parentNamePath, parentLevel, parentCode, tenantID.String())
+	result, err := tx.ExecContext(ctx, updateQuery, parentCodePath, parentNamePath, parentLevel, parentCode, tenantID.String(), parentNamePathI18n)
 	if err != nil {
 		return fmt.Errorf("failed to update hierarchy paths: %w", err)
 	}
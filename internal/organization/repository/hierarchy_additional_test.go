@@ -23,7 +23,7 @@ func TestHierarchyRepository_UpdateHierarchyPaths_ParentNotFound(t *testing.T) {
 	tenant := uuid.New()
 
 	// 父节点查询返回 no rows
-	mock.ExpectQuery(`SELECT COALESCE\(code_path, code\), COALESCE\(name_path, name\), level\s+FROM organization_units`).
+	mock.ExpectQuery(`SELECT COALESCE\(code_path, code\), COALESCE\(name_path, name\), COALESCE\(name_path_i18n, '\{\}'::jsonb\)::text, level\s+FROM organization_units`).
 		WithArgs("1000999", tenant.String()).
 		WillReturnError(sql.ErrNoRows)
 
@@ -53,7 +53,7 @@ func TestHierarchyRepository_UpdateHierarchyPaths_RootParent_Success(t *testing.
 	// 期望开启事务、执行 UPDATE、提交
 	mock.ExpectBegin()
 	mock.ExpectExec(`UPDATE organization_units SET`).
-		WithArgs("", "", 0, "", tenant.String()).
+		WithArgs("", "", 0, "", tenant.String(), "{}").
 		WillReturnResult(sqlmock.NewResult(0, 3))
 	mock.ExpectCommit()
 
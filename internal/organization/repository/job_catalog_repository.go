@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"cube-castle/internal/types"
+	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -31,6 +32,14 @@ func (r *JobCatalogRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 }
 
+// WithTx 在 SERIALIZABLE 事务中执行回调；序列化失败或死锁时按退避策略整体重试，回调需可重复执行。
+func (r *JobCatalogRepository) WithTx(ctx context.Context, operation string, fn database.SQLTxFunc) error {
+	opts := database.DefaultTxOptions()
+	opts.Isolation = sql.LevelSerializable
+	opts.Operation = "jobCatalog." + operation
+	return database.RunTx(ctx, r.db, opts, fn)
+}
+
 type temporalRow struct {
 	RecordID      uuid.UUID
 	EffectiveDate time.Time
@@ -205,10 +214,20 @@ func (r *JobCatalogRepository) applyTemporalUpdates(ctx context.Context, tx *sql
 	return nil
 }
 
+// latestNameI18n 读取编码最新版本的多语言名称，供新版本继承
+func (r *JobCatalogRepository) latestNameI18n(ctx context.Context, tx *sql.Tx, table, codeColumn string, tenantID uuid.UUID, code string) (types.LocalizedNames, error) {
+	query := fmt.Sprintf(`SELECT name_i18n FROM %s WHERE tenant_id = $1 AND %s = $2 ORDER BY effective_date DESC LIMIT 1`, table, codeColumn)
+	var names types.LocalizedNames
+	if err := r.queryRow(ctx, tx, query, tenantID, code).Scan(&names); err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to load localized names from %s: %w", table, err)
+	}
+	return names, nil
+}
+
 // Job Family Group operations
 
 func (r *JobCatalogRepository) GetCurrentFamilyGroup(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code string) (*types.JobFamilyGroup, error) {
-	query := `SELECT record_id, tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, family_group_code, name, name_i18n, description, status, effective_date, end_date, is_current
 FROM job_family_groups WHERE tenant_id = $1 AND family_group_code = $2 AND is_current = true LIMIT 1`
 
 	var entry types.JobFamilyGroup
@@ -217,6 +236,7 @@ FROM job_family_groups WHERE tenant_id = $1 AND family_group_code = $2 AND is_cu
 		&entry.TenantID,
 		&entry.Code,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -243,9 +263,9 @@ func (r *JobCatalogRepository) InsertFamilyGroup(ctx context.Context, tx *sql.Tx
 	isCurrent := !effectiveDate.After(today)
 
 	query := `INSERT INTO job_family_groups (
-tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,NULL,$7,NOW(),NOW())
-RETURNING record_id, tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current`
+tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,NULL,$7,NOW(),NOW(),$8)
+RETURNING record_id, tenant_id, family_group_code, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamilyGroup
 	err = r.queryRow(ctx, tx, query,
@@ -256,11 +276,13 @@ RETURNING record_id, tenant_id, family_group_code, name, description, status, ef
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		req.NameI18n.Merge(nil),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
 		&entry.Code,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -296,9 +318,10 @@ SET name = $1,
     description = $2,
     status = $3,
     effective_date = $4,
+    name_i18n = $7,
     updated_at = NOW()
 WHERE tenant_id = $5 AND record_id = $6
-RETURNING record_id, tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current`
+RETURNING record_id, tenant_id, family_group_code, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamilyGroup
 	err = r.queryRow(ctx, tx, query,
@@ -308,11 +331,13 @@ RETURNING record_id, tenant_id, family_group_code, name, description, status, ef
 		effectiveDate,
 		tenantID,
 		recordID,
+		req.NameI18n,
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
 		&entry.Code,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -371,10 +396,15 @@ func (r *JobCatalogRepository) InsertFamilyGroupVersion(ctx context.Context, tx
 	today := time.Now().UTC().Truncate(24 * time.Hour)
 	isCurrent := !effectiveDate.After(today)
 
+	names, err := r.latestNameI18n(ctx, tx, "job_family_groups", "family_group_code", tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `INSERT INTO job_family_groups (
-tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,NULL,$7,NOW(),NOW())
-RETURNING record_id, tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current`
+tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,NULL,$7,NOW(),NOW(),$8)
+RETURNING record_id, tenant_id, family_group_code, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamilyGroup
 	err = r.queryRow(ctx, tx, query,
@@ -385,11 +415,13 @@ RETURNING record_id, tenant_id, family_group_code, name, description, status, ef
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		names.Merge(req.NameI18n),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
 		&entry.Code,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -416,7 +448,7 @@ RETURNING record_id, tenant_id, family_group_code, name, description, status, ef
 // Job family operations
 
 func (r *JobCatalogRepository) GetCurrentJobFamily(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code string) (*types.JobFamily, error) {
-	query := `SELECT record_id, tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, family_code, family_group_code, parent_record_id, name, name_i18n, description, status, effective_date, end_date, is_current
 FROM job_families WHERE tenant_id = $1 AND family_code = $2 AND is_current = true LIMIT 1`
 
 	var entry types.JobFamily
@@ -427,6 +459,7 @@ FROM job_families WHERE tenant_id = $1 AND family_code = $2 AND is_current = tru
 		&entry.FamilyGroupCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -453,9 +486,9 @@ func (r *JobCatalogRepository) InsertJobFamily(ctx context.Context, tx *sql.Tx,
 	isCurrent := !effectiveDate.After(today)
 
 	query := `INSERT INTO job_families (
-tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,NULL,$9,NOW(),NOW())
-RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current`
+tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,NULL,$9,NOW(),NOW(),$10)
+RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamily
 	err = r.queryRow(ctx, tx, query,
@@ -468,6 +501,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		req.NameI18n.Merge(nil),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -475,6 +509,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		&entry.FamilyGroupCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -513,9 +548,10 @@ SET family_group_code = $1,
     description = $4,
     status = $5,
     effective_date = $6,
+    name_i18n = $9,
     updated_at = NOW()
 WHERE tenant_id = $7 AND record_id = $8
-RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current`
+RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamily
 	err = r.queryRow(ctx, tx, query,
@@ -527,6 +563,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		effectiveDate,
 		tenantID,
 		recordID,
+		req.NameI18n,
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -534,6 +571,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		&entry.FamilyGroupCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -569,6 +607,11 @@ func (r *JobCatalogRepository) InsertJobFamilyVersion(ctx context.Context, tx *s
 	today := time.Now().UTC().Truncate(24 * time.Hour)
 	isCurrent := !effectiveDate.After(today)
 
+	names, err := r.latestNameI18n(ctx, tx, "job_families", "family_code", tenantID, code)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `WITH latest AS (
 	SELECT record_id, family_group_code, parent_record_id
 	FROM job_families
@@ -577,7 +620,7 @@ func (r *JobCatalogRepository) InsertJobFamilyVersion(ctx context.Context, tx *s
 	LIMIT 1
 )
 INSERT INTO job_families (
-	tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current, created_at, updated_at
+	tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
 )
 SELECT
 	$1,
@@ -591,10 +634,11 @@ SELECT
 	NULL,
 	$8,
 	NOW(),
-	NOW()
+	NOW(),
+	$9
 FROM latest
 WHERE latest.record_id = $3
-RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current`
+RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id, name, name_i18n, description, status, effective_date, end_date, is_current`
 
 	var entry types.JobFamily
 	err = r.queryRow(ctx, tx, query,
@@ -606,6 +650,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		names.Merge(req.NameI18n),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -613,6 +658,7 @@ RETURNING record_id, tenant_id, family_code, family_group_code, parent_record_id
 		&entry.FamilyGroupCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -671,7 +717,7 @@ func (r *JobCatalogRepository) recalculateJobFamilyTimeline(ctx context.Context,
 // Job role operations
 
 func (r *JobCatalogRepository) GetCurrentJobRole(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code string) (*types.JobRole, error) {
-	query := `SELECT record_id, tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, role_code, family_code, parent_record_id, name, name_i18n, description, competency_model, status, effective_date, end_date, is_current
 FROM job_roles WHERE tenant_id = $1 AND role_code = $2 AND is_current = true LIMIT 1`
 
 	var entry types.JobRole
@@ -682,6 +728,7 @@ FROM job_roles WHERE tenant_id = $1 AND role_code = $2 AND is_current = true LIM
 		&entry.FamilyCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Competency,
 		&entry.Status,
@@ -714,9 +761,9 @@ func (r *JobCatalogRepository) InsertJobRole(ctx context.Context, tx *sql.Tx, te
 	}
 
 	query := `INSERT INTO job_roles (
-tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NULL,$10,NOW(),NOW())
-RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current`
+tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NULL,$10,NOW(),NOW(),$11)
+RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, name_i18n, description, competency_model, status, effective_date, end_date, is_current`
 
 	var entry types.JobRole
 	err = r.queryRow(ctx, tx, query,
@@ -730,6 +777,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		req.NameI18n.Merge(nil),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -737,6 +785,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		&entry.FamilyCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Competency,
 		&entry.Status,
@@ -776,9 +825,10 @@ SET family_code = $1,
     description = $4,
     status = $5,
     effective_date = $6,
+    name_i18n = $9,
     updated_at = NOW()
 WHERE tenant_id = $7 AND record_id = $8
-RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current`
+RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, name_i18n, description, competency_model, status, effective_date, end_date, is_current`
 
 	var entry types.JobRole
 	err = r.queryRow(ctx, tx, query,
@@ -790,6 +840,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		effectiveDate,
 		tenantID,
 		recordID,
+		req.NameI18n,
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -797,6 +848,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		&entry.FamilyCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Competency,
 		&entry.Status,
@@ -833,9 +885,10 @@ func (r *JobCatalogRepository) InsertJobRoleVersion(ctx context.Context, tx *sql
 	today := time.Now().UTC().Truncate(24 * time.Hour)
 	isCurrent := !effectiveDate.After(today)
 
-	familyCodeQuery := `SELECT family_code FROM job_roles WHERE tenant_id = $1 AND role_code = $2 ORDER BY effective_date DESC LIMIT 1`
+	familyCodeQuery := `SELECT family_code, name_i18n FROM job_roles WHERE tenant_id = $1 AND role_code = $2 ORDER BY effective_date DESC LIMIT 1`
 	var familyCode string
-	if err := r.queryRow(ctx, tx, familyCodeQuery, tenantID, code).Scan(&familyCode); err != nil {
+	var names types.LocalizedNames
+	if err := r.queryRow(ctx, tx, familyCodeQuery, tenantID, code).Scan(&familyCode, &names); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("job role not found for code %s", code)
 		}
@@ -843,9 +896,9 @@ func (r *JobCatalogRepository) InsertJobRoleVersion(ctx context.Context, tx *sql
 	}
 
 	query := `INSERT INTO job_roles (
-tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NULL,$10,NOW(),NOW())
-RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current`
+tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NULL,$10,NOW(),NOW(),$11)
+RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name, name_i18n, description, competency_model, status, effective_date, end_date, is_current`
 
 	var entry types.JobRole
 	err = r.queryRow(ctx, tx, query,
@@ -859,6 +912,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		names.Merge(req.NameI18n),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -866,6 +920,7 @@ RETURNING record_id, tenant_id, role_code, family_code, parent_record_id, name,
 		&entry.FamilyCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Competency,
 		&entry.Status,
@@ -920,7 +975,7 @@ func (r *JobCatalogRepository) recalculateJobRoleTimeline(ctx context.Context, t
 // Job level operations
 
 func (r *JobCatalogRepository) GetCurrentJobLevel(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code string) (*types.JobLevel, error) {
-	query := `SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current
 FROM job_levels WHERE tenant_id = $1 AND level_code = $2 AND is_current = true LIMIT 1`
 
 	var entry types.JobLevel
@@ -932,6 +987,7 @@ FROM job_levels WHERE tenant_id = $1 AND level_code = $2 AND is_current = true L
 		&entry.ParentRecord,
 		&entry.LevelRank,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.SalaryBand,
 		&entry.Status,
@@ -964,9 +1020,9 @@ func (r *JobCatalogRepository) InsertJobLevel(ctx context.Context, tx *sql.Tx, t
 	}
 
 	query := `INSERT INTO job_levels (
-tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW())
-RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current`
+tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW(),$12)
+RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current`
 
 	var entry types.JobLevel
 	err = r.queryRow(ctx, tx, query,
@@ -981,6 +1037,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		req.Status,
 		effectiveDate,
 		isCurrent,
+		req.NameI18n.Merge(nil),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -989,6 +1046,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		&entry.ParentRecord,
 		&entry.LevelRank,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.SalaryBand,
 		&entry.Status,
@@ -1029,9 +1087,10 @@ SET role_code = $1,
     description = $5,
     status = $6,
     effective_date = $7,
+    name_i18n = $10,
     updated_at = NOW()
 WHERE tenant_id = $8 AND record_id = $9
-RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current`
+RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current`
 
 	var entry types.JobLevel
 	err = r.queryRow(ctx, tx, query,
@@ -1044,6 +1103,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		effectiveDate,
 		tenantID,
 		recordID,
+		req.NameI18n,
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -1052,6 +1112,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		&entry.ParentRecord,
 		&entry.LevelRank,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.SalaryBand,
 		&entry.Status,
@@ -1114,9 +1175,9 @@ func (r *JobCatalogRepository) InsertJobLevelVersion(ctx context.Context, tx *sq
 	}
 
 	query := `INSERT INTO job_levels (
-tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW())
-RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current`
+tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW(),$12)
+RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current`
 
 	var entry types.JobLevel
 	err = r.queryRow(ctx, tx, query,
@@ -1131,6 +1192,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		req.Status,
 		effectiveDate,
 		false,
+		parent.NameI18n.Merge(req.NameI18n),
 	).Scan(
 		&entry.RecordID,
 		&entry.TenantID,
@@ -1139,6 +1201,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 		&entry.ParentRecord,
 		&entry.LevelRank,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.SalaryBand,
 		&entry.Status,
@@ -1193,7 +1256,7 @@ func (r *JobCatalogRepository) recalculateJobLevelTimeline(ctx context.Context,
 // Lookup helpers
 
 func (r *JobCatalogRepository) GetFamilyGroupByRecordID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID) (*types.JobFamilyGroup, error) {
-	query := `SELECT record_id, tenant_id, family_group_code, name, description, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, family_group_code, name, name_i18n, description, status, effective_date, end_date, is_current
 FROM job_family_groups WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	var entry types.JobFamilyGroup
 	err := r.queryRow(ctx, tx, query, tenantID, recordID).Scan(
@@ -1201,6 +1264,7 @@ FROM job_family_groups WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 		&entry.TenantID,
 		&entry.Code,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -1217,7 +1281,7 @@ FROM job_family_groups WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 }
 
 func (r *JobCatalogRepository) GetJobFamilyByRecordID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID) (*types.JobFamily, error) {
-	query := `SELECT record_id, tenant_id, family_code, family_group_code, parent_record_id, name, description, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, family_code, family_group_code, parent_record_id, name, name_i18n, description, status, effective_date, end_date, is_current
 FROM job_families WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	var entry types.JobFamily
 	err := r.queryRow(ctx, tx, query, tenantID, recordID).Scan(
@@ -1227,6 +1291,7 @@ FROM job_families WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 		&entry.FamilyGroupCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Status,
 		&entry.EffectiveDate,
@@ -1243,7 +1308,7 @@ FROM job_families WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 }
 
 func (r *JobCatalogRepository) GetJobRoleByRecordID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID) (*types.JobRole, error) {
-	query := `SELECT record_id, tenant_id, role_code, family_code, parent_record_id, name, description, competency_model, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, role_code, family_code, parent_record_id, name, name_i18n, description, competency_model, status, effective_date, end_date, is_current
 FROM job_roles WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	var entry types.JobRole
 	err := r.queryRow(ctx, tx, query, tenantID, recordID).Scan(
@@ -1253,6 +1318,7 @@ FROM job_roles WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 		&entry.FamilyCode,
 		&entry.ParentRecord,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.Competency,
 		&entry.Status,
@@ -1270,7 +1336,7 @@ FROM job_roles WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 }
 
 func (r *JobCatalogRepository) GetJobLevelByRecordID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID) (*types.JobLevel, error) {
-	query := `SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current
+	query := `SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current
 FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	var entry types.JobLevel
 	err := r.queryRow(ctx, tx, query, tenantID, recordID).Scan(
@@ -1281,6 +1347,7 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 		&entry.ParentRecord,
 		&entry.LevelRank,
 		&entry.Name,
+		&entry.NameI18n,
 		&entry.Description,
 		&entry.SalaryBand,
 		&entry.Status,
@@ -1296,3 +1363,171 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	}
 	return &entry, nil
 }
+
+// Retirement helpers
+
+type jobCatalogTable struct {
+	table      string
+	codeColumn string
+}
+
+// jobCatalogTables 各层级对应的表与编码列
+var jobCatalogTables = map[string]jobCatalogTable{
+	types.JobCatalogLevelFamilyGroup: {table: "job_family_groups", codeColumn: "family_group_code"},
+	types.JobCatalogLevelFamily:      {table: "job_families", codeColumn: "family_code"},
+	types.JobCatalogLevelRole:        {table: "job_roles", codeColumn: "role_code"},
+	types.JobCatalogLevelLevel:       {table: "job_levels", codeColumn: "level_code"},
+}
+
+type jobCatalogChild struct {
+	level        string
+	table        string
+	codeColumn   string
+	parentColumn string
+}
+
+// jobCatalogChildren 各层级的直接下级（parentColumn 为下级表中引用上级编码的列）
+var jobCatalogChildren = map[string]jobCatalogChild{
+	types.JobCatalogLevelFamilyGroup: {level: types.JobCatalogLevelFamily, table: "job_families", codeColumn: "family_code", parentColumn: "family_group_code"},
+	types.JobCatalogLevelFamily:      {level: types.JobCatalogLevelRole, table: "job_roles", codeColumn: "role_code", parentColumn: "family_code"},
+	types.JobCatalogLevelRole:        {level: types.JobCatalogLevelLevel, table: "job_levels", codeColumn: "level_code", parentColumn: "role_code"},
+}
+
+// GetStatusAsOf 返回条目在指定日期生效版本的状态；该日期尚无生效版本时返回空字符串。
+func (r *JobCatalogRepository) GetStatusAsOf(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string, asOf time.Time) (string, error) {
+	meta, ok := jobCatalogTables[level]
+	if !ok {
+		return "", fmt.Errorf("unsupported job catalog level: %s", level)
+	}
+	query := fmt.Sprintf(`SELECT status FROM %s WHERE tenant_id = $1 AND %s = $2 AND effective_date <= $3 ORDER BY effective_date DESC LIMIT 1`, meta.table, meta.codeColumn)
+	var status string
+	if err := r.queryRow(ctx, tx, query, tenantID, strings.ToUpper(strings.TrimSpace(code)), asOf).Scan(&status); err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to load %s status: %w", meta.table, err)
+	}
+	return status, nil
+}
+
+// GetLatestRecordID 返回条目最新（生效日期最晚）版本的记录ID，条目不存在时返回 uuid.Nil。
+// 新增版本时以该记录作为版本链的 parentRecordId（见 JC-SEQUENCE 规则）。
+func (r *JobCatalogRepository) GetLatestRecordID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string) (uuid.UUID, error) {
+	meta, ok := jobCatalogTables[level]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("unsupported job catalog level: %s", level)
+	}
+	query := fmt.Sprintf(`SELECT record_id FROM %s WHERE tenant_id = $1 AND %s = $2 ORDER BY effective_date DESC LIMIT 1`, meta.table, meta.codeColumn)
+	var recordID uuid.UUID
+	if err := r.queryRow(ctx, tx, query, tenantID, strings.ToUpper(strings.TrimSpace(code))).Scan(&recordID); err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, fmt.Errorf("failed to load latest %s record: %w", meta.table, err)
+	}
+	return recordID, nil
+}
+
+// ListActiveChildren 返回在指定日期及之后仍有启用版本的直接下级条目（每个编码取最早一条）。
+func (r *JobCatalogRepository) ListActiveChildren(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string, asOf time.Time) ([]types.JobCatalogImpactedChild, error) {
+	child, ok := jobCatalogChildren[level]
+	if !ok {
+		return []types.JobCatalogImpactedChild{}, nil
+	}
+	query := fmt.Sprintf(`SELECT DISTINCT ON (%[2]s) %[2]s, name, status, effective_date
+FROM %[1]s
+WHERE tenant_id = $1 AND %[3]s = $2 AND status = $4 AND (end_date IS NULL OR end_date >= $3)
+ORDER BY %[2]s, effective_date`, child.table, child.codeColumn, child.parentColumn)
+	rows, err := r.queryRows(ctx, tx, query, tenantID, strings.ToUpper(strings.TrimSpace(code)), asOf, types.JobCatalogStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s children: %w", child.table, err)
+	}
+	defer rows.Close()
+
+	children := make([]types.JobCatalogImpactedChild, 0)
+	for rows.Next() {
+		item := types.JobCatalogImpactedChild{Level: child.level}
+		if err := rows.Scan(&item.Code, &item.Name, &item.Status, &item.EffectiveDate); err != nil {
+			return nil, fmt.Errorf("failed to scan %s child: %w", child.table, err)
+		}
+		children = append(children, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s children iteration error: %w", child.table, err)
+	}
+	return children, nil
+}
+
+// Import/export helpers
+
+// JobCatalogSnapshotRow 职位目录条目的单个版本（导出与导入比对使用）。
+// ParentCode 为上级编码（职类为空）；Attributes 为职务胜任力模型或职级薪酬带宽。
+type JobCatalogSnapshotRow struct {
+	Level         string
+	Code          string
+	ParentCode    string
+	RecordID      uuid.UUID
+	LevelRank     string
+	Name          string
+	NameI18n      types.LocalizedNames
+	Description   sql.NullString
+	Status        string
+	EffectiveDate time.Time
+	Attributes    []byte
+}
+
+// ListCatalogSnapshot 返回租户职位目录全部版本，按层级（职类 → 职级）、编码与生效日期排序。
+func (r *JobCatalogRepository) ListCatalogSnapshot(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID) ([]JobCatalogSnapshotRow, error) {
+	query := `SELECT level, code, parent_code, record_id, level_rank, name, name_i18n, description, status, effective_date, attributes
+FROM (
+	SELECT 1 AS depth, $2::text AS level, family_group_code AS code, '' AS parent_code, record_id, '' AS level_rank, name, name_i18n, description, status, effective_date, NULL::jsonb AS attributes
+	FROM job_family_groups WHERE tenant_id = $1
+	UNION ALL
+	SELECT 2, $3::text, family_code, family_group_code, record_id, '', name, name_i18n, description, status, effective_date, NULL::jsonb
+	FROM job_families WHERE tenant_id = $1
+	UNION ALL
+	SELECT 3, $4::text, role_code, family_code, record_id, '', name, name_i18n, description, status, effective_date, competency_model
+	FROM job_roles WHERE tenant_id = $1
+	UNION ALL
+	SELECT 4, $5::text, level_code, role_code, record_id, level_rank, name, name_i18n, description, status, effective_date, salary_band
+	FROM job_levels WHERE tenant_id = $1
+) catalog
+ORDER BY depth, code, effective_date`
+	rows, err := r.queryRows(ctx, tx, query, tenantID,
+		types.JobCatalogLevelFamilyGroup, types.JobCatalogLevelFamily, types.JobCatalogLevelRole, types.JobCatalogLevelLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job catalog snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	snapshot := make([]JobCatalogSnapshotRow, 0)
+	for rows.Next() {
+		var row JobCatalogSnapshotRow
+		if err := rows.Scan(&row.Level, &row.Code, &row.ParentCode, &row.RecordID, &row.LevelRank, &row.Name, &row.NameI18n,
+			&row.Description, &row.Status, &row.EffectiveDate, &row.Attributes); err != nil {
+			return nil, fmt.Errorf("failed to scan job catalog snapshot: %w", err)
+		}
+		snapshot = append(snapshot, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("job catalog snapshot iteration error: %w", err)
+	}
+	return snapshot, nil
+}
+
+// GetRecordIDAsOf 返回条目在指定日期生效版本的记录ID，该日期尚无生效版本时返回 uuid.Nil。
+func (r *JobCatalogRepository) GetRecordIDAsOf(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string, asOf time.Time) (uuid.UUID, error) {
+	meta, ok := jobCatalogTables[level]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("unsupported job catalog level: %s", level)
+	}
+	query := fmt.Sprintf(`SELECT record_id FROM %s WHERE tenant_id = $1 AND %s = $2 AND effective_date <= $3 ORDER BY effective_date DESC LIMIT 1`, meta.table, meta.codeColumn)
+	var recordID uuid.UUID
+	if err := r.queryRow(ctx, tx, query, tenantID, strings.ToUpper(strings.TrimSpace(code)), asOf).Scan(&recordID); err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, fmt.Errorf("failed to load %s record as of date: %w", meta.table, err)
+	}
+	return recordID, nil
+}
@@ -30,7 +30,7 @@ func TestInsertJobLevelVersionCopiesParentMetadata(t *testing.T) {
 	roleRecord := uuid.New()
 	parentRows := sqlmock.NewRows([]string{
 		"record_id", "tenant_id", "level_code", "role_code", "parent_record_id",
-		"level_rank", "name", "description", "salary_band", "status",
+		"level_rank", "name", "name_i18n", "description", "salary_band", "status",
 		"effective_date", "end_date", "is_current",
 	}).AddRow(
 		parentRecord,
@@ -40,6 +40,7 @@ func TestInsertJobLevelVersionCopiesParentMetadata(t *testing.T) {
 		roleRecord,
 		parentLevelRank,
 		"Parent Level",
+		[]byte(`{"en":"Parent Level","ja":"親レベル"}`),
 		nil,
 		parentSalary,
 		"ACTIVE",
@@ -48,7 +49,7 @@ func TestInsertJobLevelVersionCopiesParentMetadata(t *testing.T) {
 		false,
 	)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current
 FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 		WithArgs(tenantID, parentRecord).
 		WillReturnRows(parentRows)
@@ -58,6 +59,7 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 	description := "new version"
 	req := &types.JobCatalogVersionRequest{
 		Name:           "Latest Level",
+		NameI18n:       types.LocalizedNames{"en": "Latest Level", "ja": ""},
 		Status:         "ACTIVE",
 		EffectiveDate:  effectiveDate,
 		Description:    &description,
@@ -66,7 +68,7 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 
 	insertRows := sqlmock.NewRows([]string{
 		"record_id", "tenant_id", "level_code", "role_code", "parent_record_id",
-		"level_rank", "name", "description", "salary_band", "status",
+		"level_rank", "name", "name_i18n", "description", "salary_band", "status",
 		"effective_date", "end_date", "is_current",
 	}).AddRow(
 		newRecord,
@@ -76,6 +78,7 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 		roleRecord,
 		parentLevelRank,
 		req.Name,
+		[]byte(`{"en":"Latest Level"}`),
 		description,
 		parentSalary,
 		"ACTIVE",
@@ -85,9 +88,9 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO job_levels (
-tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW())
-RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current`)).
+tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current, created_at, updated_at, name_i18n
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NULL,$11,NOW(),NOW(),$12)
+RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current`)).
 		WithArgs(
 			tenantID,
 			strings.ToUpper(levelCode),
@@ -100,6 +103,7 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 			req.Status,
 			sqlmock.AnyArg(),
 			false,
+			`{"en":"Latest Level"}`,
 		).
 		WillReturnRows(insertRows)
 
@@ -126,6 +130,9 @@ RETURNING record_id, tenant_id, level_code, role_code, parent_record_id, level_r
 	if entity.RoleCode != parentRole {
 		t.Fatalf("expected role code %s, got %s", parentRole, entity.RoleCode)
 	}
+	if _, ok := entity.NameI18n["ja"]; ok || entity.NameI18n["en"] != req.Name {
+		t.Fatalf("expected inherited names merged with patch, got %#v", entity.NameI18n)
+	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Fatalf("unmet sql expectations: %v", err)
@@ -158,7 +165,7 @@ func TestInsertJobLevelVersionDetectsParentMismatch(t *testing.T) {
 
 	parentRows := sqlmock.NewRows([]string{
 		"record_id", "tenant_id", "level_code", "role_code", "parent_record_id",
-		"level_rank", "name", "description", "salary_band", "status",
+		"level_rank", "name", "name_i18n", "description", "salary_band", "status",
 		"effective_date", "end_date", "is_current",
 	}).AddRow(
 		parentRecord,
@@ -168,6 +175,7 @@ func TestInsertJobLevelVersionDetectsParentMismatch(t *testing.T) {
 		uuid.New(),
 		"9",
 		"Parent",
+		[]byte(`{}`),
 		nil,
 		[]byte("{}"),
 		"ACTIVE",
@@ -176,7 +184,7 @@ func TestInsertJobLevelVersionDetectsParentMismatch(t *testing.T) {
 		true,
 	)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, description, salary_band, status, effective_date, end_date, is_current
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id, tenant_id, level_code, role_code, parent_record_id, level_rank, name, name_i18n, description, salary_band, status, effective_date, end_date, is_current
 FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 		WithArgs(tenantID, parentRecord).
 		WillReturnRows(parentRows)
@@ -197,3 +205,173 @@ FROM job_levels WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`)).
 		t.Fatalf("unmet sql expectations: %v", err)
 	}
 }
+
+func TestJobCatalogGetStatusAsOf(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewJobCatalogRepository(db, testLogger())
+	tenantID := uuid.New()
+	asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM job_roles WHERE tenant_id = $1 AND role_code = $2 AND effective_date <= $3 ORDER BY effective_date DESC LIMIT 1`)).
+		WithArgs(tenantID, "JR-OLD", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("INACTIVE"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM job_levels`)).
+		WithArgs(tenantID, "JL-NEW", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}))
+
+	status, err := repo.GetStatusAsOf(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelRole, " jr-old ", asOf)
+	if err != nil || status != "INACTIVE" {
+		t.Fatalf("expected INACTIVE, got %q (%v)", status, err)
+	}
+	status, err = repo.GetStatusAsOf(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelLevel, "JL-NEW", asOf)
+	if err != nil || status != "" {
+		t.Fatalf("expected empty status before first version, got %q (%v)", status, err)
+	}
+	if _, err := repo.GetStatusAsOf(ctxWithTimeout(), nil, tenantID, "UNKNOWN", "X", asOf); err == nil {
+		t.Fatalf("expected unsupported level error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestJobCatalogGetLatestRecordID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewJobCatalogRepository(db, testLogger())
+	tenantID := uuid.New()
+	latest := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id FROM job_families WHERE tenant_id = $1 AND family_code = $2 ORDER BY effective_date DESC LIMIT 1`)).
+		WithArgs(tenantID, "PROF-IT").
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}).AddRow(latest))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id FROM job_levels`)).
+		WithArgs(tenantID, "P9").
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}))
+
+	recordID, err := repo.GetLatestRecordID(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelFamily, "prof-it")
+	if err != nil || recordID != latest {
+		t.Fatalf("expected latest record %s, got %s (%v)", latest, recordID, err)
+	}
+	recordID, err = repo.GetLatestRecordID(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelLevel, "P9")
+	if err != nil || recordID != uuid.Nil {
+		t.Fatalf("expected nil record for missing entry, got %s (%v)", recordID, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestJobCatalogListActiveChildren(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewJobCatalogRepository(db, testLogger())
+	tenantID := uuid.New()
+	asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT ON (level_code) level_code, name, status, effective_date
+FROM job_levels
+WHERE tenant_id = $1 AND role_code = $2 AND status = $4 AND (end_date IS NULL OR end_date >= $3)`)).
+		WithArgs(tenantID, "JR-OLD", asOf, "ACTIVE").
+		WillReturnRows(sqlmock.NewRows([]string{"level_code", "name", "status", "effective_date"}).
+			AddRow("JL-1", "Junior", "ACTIVE", asOf.AddDate(-1, 0, 0)))
+
+	children, err := repo.ListActiveChildren(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelRole, "JR-OLD", asOf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(children) != 1 || children[0].Level != types.JobCatalogLevelLevel || children[0].Code != "JL-1" {
+		t.Fatalf("unexpected children: %#v", children)
+	}
+
+	leaf, err := repo.ListActiveChildren(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelLevel, "JL-1", asOf)
+	if err != nil || len(leaf) != 0 {
+		t.Fatalf("expected job levels to have no children, got %#v (%v)", leaf, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestJobCatalogListCatalogSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewJobCatalogRepository(db, testLogger())
+	tenantID := uuid.New()
+	effective := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"level", "code", "parent_code", "record_id", "level_rank", "name", "name_i18n", "description", "status", "effective_date", "attributes"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY depth, code, effective_date`)).
+		WithArgs(tenantID, types.JobCatalogLevelFamilyGroup, types.JobCatalogLevelFamily, types.JobCatalogLevelRole, types.JobCatalogLevelLevel).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(types.JobCatalogLevelFamilyGroup, "PROF", "", uuid.New(), "", "Professional", []byte(`{"en-US":"Professional"}`), nil, "ACTIVE", effective, nil).
+			AddRow(types.JobCatalogLevelLevel, "P1", "PROF-IT-DEV", uuid.New(), "1", "Junior", []byte(`{}`), "entry", "ACTIVE", effective, []byte(`{"min":1000}`)))
+
+	rows, err := repo.ListCatalogSnapshot(ctxWithTimeout(), nil, tenantID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 || rows[0].NameI18n["en-US"] != "Professional" {
+		t.Fatalf("unexpected snapshot: %#v", rows)
+	}
+	if rows[1].ParentCode != "PROF-IT-DEV" || rows[1].LevelRank != "1" || !rows[1].Description.Valid || string(rows[1].Attributes) != `{"min":1000}` {
+		t.Fatalf("unexpected level row: %#v", rows[1])
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestJobCatalogGetRecordIDAsOf(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewJobCatalogRepository(db, testLogger())
+	tenantID := uuid.New()
+	asOf := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	recordID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id FROM job_roles WHERE tenant_id = $1 AND role_code = $2 AND effective_date <= $3 ORDER BY effective_date DESC LIMIT 1`)).
+		WithArgs(tenantID, "PROF-IT-DEV", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}).AddRow(recordID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id FROM job_family_groups`)).
+		WithArgs(tenantID, "PROF", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}))
+
+	got, err := repo.GetRecordIDAsOf(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelRole, "prof-it-dev", asOf)
+	if err != nil || got != recordID {
+		t.Fatalf("expected %s, got %s (%v)", recordID, got, err)
+	}
+	got, err = repo.GetRecordIDAsOf(ctxWithTimeout(), nil, tenantID, types.JobCatalogLevelFamilyGroup, "PROF", asOf)
+	if err != nil || got != uuid.Nil {
+		t.Fatalf("expected nil record before first version, got %s (%v)", got, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
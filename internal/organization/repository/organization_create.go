@@ -37,7 +37,7 @@ func (r *OrganizationRepository) Create(ctx context.Context, org *types.Organiza
 		return nil, fmt.Errorf("无效的租户ID: %w", err)
 	}
 
-	fields, err := r.ComputeHierarchyForNew(ctx, tenantUUID, org.Code, org.ParentCode, org.Name)
+	fields, err := r.ComputeHierarchyForNew(ctx, tenantUUID, org.Code, org.ParentCode, org.Name, org.NameI18n)
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +45,16 @@ func (r *OrganizationRepository) Create(ctx context.Context, org *types.Organiza
 	org.Level = fields.Level
 	org.CodePath = fields.CodePath
 	org.NamePath = fields.NamePath
+	org.NamePathI18n = fields.NamePathI18n
 
 	query := `
         INSERT INTO organization_units (
             tenant_id, code, parent_code, name, unit_type, status, 
             level, code_path, name_path, sort_order, description, created_at, updated_at,
-            effective_date, end_date, change_reason, is_current
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+            effective_date, end_date, change_reason, is_current, custom_fields,
+            name_i18n, name_path_i18n
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+            jsonb_strip_nulls($18::jsonb), $19, $20)
         RETURNING record_id, created_at, updated_at
     `
 
@@ -85,6 +88,9 @@ func (r *OrganizationRepository) Create(ctx context.Context, org *types.Organiza
 		org.EndDate,
 		org.ChangeReason,
 		isCurrent,
+		org.CustomFields,
+		org.NameI18n,
+		org.NamePathI18n,
 	).Scan(&org.RecordID, &createdAt, &updatedAt)
 
 	if err != nil {
@@ -128,8 +134,10 @@ func (r *OrganizationRepository) CreateInTransaction(ctx context.Context, tx *sq
         INSERT INTO organization_units (
             tenant_id, code, parent_code, name, unit_type, status,
             level, code_path, name_path, sort_order, description, created_at, updated_at,
-            effective_date, end_date, change_reason, is_current
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+            effective_date, end_date, change_reason, is_current, custom_fields,
+            name_i18n, name_path_i18n
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+            jsonb_strip_nulls($18::jsonb), $19, $20)
         RETURNING record_id, created_at, updated_at
     `
 
@@ -161,6 +169,9 @@ func (r *OrganizationRepository) CreateInTransaction(ctx context.Context, tx *sq
 		org.EndDate,
 		org.ChangeReason,
 		org.IsCurrent,
+		org.CustomFields,
+		org.NameI18n,
+		org.NamePathI18n,
 	).Scan(&org.RecordID, &createdAt, &updatedAt)
 
 	if err != nil {
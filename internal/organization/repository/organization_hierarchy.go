@@ -14,10 +14,24 @@ import (
 type hierarchyFields struct {
 	CodePath string
 	NamePath string
+	// NamePathI18n 按语言计算的名称路径
+	NamePathI18n types.LocalizedNames
+	// NameI18n 计算所依据的多语言名称（更新时为合并后的结果）
+	NameI18n types.LocalizedNames
 	Level    int
 	oldLevel int
 }
 
+// hierarchyOverrides 更新组织时参与层级重算的提交值
+type hierarchyOverrides struct {
+	// ParentProvided 为 true 时使用 ParentCode，否则沿用当前上级
+	ParentProvided bool
+	ParentCode     *string
+	Name           *string
+	// NameI18n 与当前多语言名称合并，值为空字符串表示移除
+	NameI18n types.LocalizedNames
+}
+
 func ensureJoinedPath(base, segment string) string {
 	base = strings.TrimSpace(base)
 	segment = strings.TrimSpace(segment)
@@ -29,20 +43,35 @@ func ensureJoinedPath(base, segment string) string {
 	return base + "/" + segment
 }
 
-func (r *OrganizationRepository) recalculateSelfHierarchy(ctx context.Context, tenantID uuid.UUID, code string, recordID *string, parentCode *string, overrideName *string) (*hierarchyFields, error) {
+// localizedNamePath 按语言计算名称路径：语言集合为上级路径与本级名称已配置语言的并集，
+// 每种语言下上级路径与本级名称分别按 Lookup 规则取值，未配置时回落默认名称。
+func localizedNamePath(parentPath string, parentPaths types.LocalizedNames, name string, names types.LocalizedNames) types.LocalizedNames {
+	paths := types.LocalizedNames{}
+	for _, locale := range append(parentPaths.Locales(), names.Locales()...) {
+		if _, done := paths[locale]; done {
+			continue
+		}
+		paths[locale] = ensureJoinedPath(parentPaths.Lookup(locale, parentPath), names.Lookup(locale, name))
+	}
+	return paths
+}
+
+func (r *OrganizationRepository) recalculateSelfHierarchy(ctx context.Context, tenantID uuid.UUID, code string, recordID *string, overrides hierarchyOverrides) (*hierarchyFields, error) {
 	var (
-		resolvedCode string
-		currentName  string
-		currentLevel int
+		resolvedCode  string
+		currentName   string
+		currentLevel  int
+		currentParent sql.NullString
+		currentNames  types.LocalizedNames
 	)
 
 	if recordID != nil {
 		err := r.db.QueryRowContext(ctx, `
-			SELECT code, name, level
+			SELECT code, name, level, parent_code, name_i18n
 			FROM organization_units
 			WHERE tenant_id = $1 AND record_id = $2 AND status <> 'DELETED'
 			LIMIT 1
-		`, tenantID.String(), *recordID).Scan(&resolvedCode, &currentName, &currentLevel)
+		`, tenantID.String(), *recordID).Scan(&resolvedCode, &currentName, &currentLevel, &currentParent, &currentNames)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, fmt.Errorf("记录不存在: %s", *recordID)
@@ -52,11 +81,11 @@ func (r *OrganizationRepository) recalculateSelfHierarchy(ctx context.Context, t
 	} else {
 		resolvedCode = code
 		err := r.db.QueryRowContext(ctx, `
-			SELECT name, level
+			SELECT name, level, parent_code, name_i18n
 			FROM organization_units
 			WHERE tenant_id = $1 AND code = $2 AND is_current = true AND status <> 'DELETED'
 			LIMIT 1
-		`, tenantID.String(), code).Scan(&currentName, &currentLevel)
+		`, tenantID.String(), code).Scan(&currentName, &currentLevel, &currentParent, &currentNames)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				return nil, fmt.Errorf("组织不存在或已删除不可修改: %s", code)
@@ -66,15 +95,19 @@ func (r *OrganizationRepository) recalculateSelfHierarchy(ctx context.Context, t
 	}
 
 	finalName := currentName
-	if overrideName != nil {
-		finalName = strings.TrimSpace(*overrideName)
+	if overrides.Name != nil {
+		finalName = strings.TrimSpace(*overrides.Name)
+	}
+	parentCode := overrides.ParentCode
+	if !overrides.ParentProvided && currentParent.Valid {
+		parentCode = &currentParent.String
 	}
 
 	if resolvedCode == "" {
 		resolvedCode = code
 	}
 
-	fields, err := r.calculateHierarchyFields(ctx, tenantID, resolvedCode, parentCode, finalName)
+	fields, err := r.calculateHierarchyFields(ctx, tenantID, resolvedCode, parentCode, finalName, currentNames.Merge(overrides.NameI18n))
 	if err != nil {
 		return nil, err
 	}
@@ -84,18 +117,19 @@ func (r *OrganizationRepository) recalculateSelfHierarchy(ctx context.Context, t
 	return fields, nil
 }
 
-func (r *OrganizationRepository) calculateHierarchyFields(ctx context.Context, tenantID uuid.UUID, code string, parentCode *string, finalName string) (*hierarchyFields, error) {
+func (r *OrganizationRepository) calculateHierarchyFields(ctx context.Context, tenantID uuid.UUID, code string, parentCode *string, finalName string, names types.LocalizedNames) (*hierarchyFields, error) {
 	finalName = strings.TrimSpace(finalName)
 	if finalName == "" {
 		return nil, fmt.Errorf("组织名称不能为空")
 	}
 
-	fields := &hierarchyFields{}
+	fields := &hierarchyFields{NameI18n: names}
 
 	if parentCode == nil {
 		fields.Level = 1
 		fields.CodePath = ensureJoinedPath("", code)
 		fields.NamePath = ensureJoinedPath("", finalName)
+		fields.NamePathI18n = localizedNamePath("", nil, finalName, names)
 		return fields, nil
 	}
 
@@ -104,19 +138,22 @@ func (r *OrganizationRepository) calculateHierarchyFields(ctx context.Context, t
 		fields.Level = 1
 		fields.CodePath = ensureJoinedPath("", code)
 		fields.NamePath = ensureJoinedPath("", finalName)
+		fields.NamePathI18n = localizedNamePath("", nil, finalName, names)
 		return fields, nil
 	}
 
 	var parentCodePath, parentNamePath string
+	var parentNamePaths types.LocalizedNames
 	var parentLevel int
 	err := r.db.QueryRowContext(ctx, `
 		SELECT COALESCE(NULLIF(code_path, ''), '/' || code),
 		       COALESCE(NULLIF(name_path, ''), '/' || name),
+		       name_path_i18n,
 		       level
 		FROM organization_units
 		WHERE tenant_id = $1 AND code = $2 AND is_current = true AND status <> 'DELETED'
 		LIMIT 1
-	`, tenantID.String(), trimmedParent).Scan(&parentCodePath, &parentNamePath, &parentLevel)
+	`, tenantID.String(), trimmedParent).Scan(&parentCodePath, &parentNamePath, &parentNamePaths, &parentLevel)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("父组织不存在: %s", trimmedParent)
@@ -127,6 +164,7 @@ func (r *OrganizationRepository) calculateHierarchyFields(ctx context.Context, t
 	fields.Level = parentLevel + 1
 	fields.CodePath = ensureJoinedPath(parentCodePath, code)
 	fields.NamePath = ensureJoinedPath(parentNamePath, finalName)
+	fields.NamePathI18n = localizedNamePath(parentNamePath, parentNamePaths, finalName, names)
 
 	if fields.Level > types.OrganizationLevelMax {
 		return nil, fmt.Errorf("层级超过系统允许的最大深度 (%d)", types.OrganizationLevelMax)
@@ -135,7 +173,7 @@ func (r *OrganizationRepository) calculateHierarchyFields(ctx context.Context, t
 	return fields, nil
 }
 
-// ComputeHierarchyForNew 计算新建或新版本的层级字段（path/codePath/namePath/level）
-func (r *OrganizationRepository) ComputeHierarchyForNew(ctx context.Context, tenantID uuid.UUID, code string, parentCode *string, name string) (*hierarchyFields, error) {
-	return r.calculateHierarchyFields(ctx, tenantID, strings.TrimSpace(code), parentCode, name)
+// ComputeHierarchyForNew 计算新建或新版本的层级字段（path/codePath/namePath/level），names 为该版本的多语言名称
+func (r *OrganizationRepository) ComputeHierarchyForNew(ctx context.Context, tenantID uuid.UUID, code string, parentCode *string, name string, names types.LocalizedNames) (*hierarchyFields, error) {
+	return r.calculateHierarchyFields(ctx, tenantID, strings.TrimSpace(code), parentCode, name, names)
 }
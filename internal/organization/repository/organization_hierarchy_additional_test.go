@@ -15,7 +15,7 @@ func TestComputeHierarchyForNew_Root(t *testing.T) {
 	defer db.Close()
 	repo := NewOrganizationRepository(db, nil)
 	tenant := uuid.New()
-	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", nil, "技术部")
+	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", nil, "技术部", nil)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -35,10 +35,10 @@ func TestComputeHierarchyForNew_DepthExceeded(t *testing.T) {
 	mock.ExpectQuery("FROM organization_units").
 		WithArgs(tenant.String(), parent).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"code_path", "name_path", "level",
-		}).AddRow("/1000000", "/集团", types.OrganizationLevelMax))
+			"code_path", "name_path", "name_path_i18n", "level",
+		}).AddRow("/1000000", "/集团", []byte(`{}`), types.OrganizationLevelMax))
 
-	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", &parent, "技术部")
+	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", &parent, "技术部", nil)
 	if err == nil || fields != nil {
 		t.Fatalf("expected depth exceeded error")
 	}
@@ -56,8 +56,44 @@ func TestComputeHierarchyForNew_ParentNotFound(t *testing.T) {
 		WithArgs(tenant.String(), parent).
 		WillReturnError(sql.ErrNoRows)
 
-	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", &parent, "技术部")
+	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", &parent, "技术部", nil)
 	if err == nil || fields != nil {
 		t.Fatalf("expected error for missing parent")
 	}
 }
+
+func TestComputeHierarchyForNew_LocalizedNamePath(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewOrganizationRepository(db, nil)
+	tenant := uuid.New()
+	parent := "1000000"
+
+	mock.ExpectQuery("FROM organization_units").
+		WithArgs(tenant.String(), parent).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"code_path", "name_path", "name_path_i18n", "level",
+		}).AddRow("/1000000", "/集团", []byte(`{"en":"/Group","ja":"/グループ"}`), 1))
+
+	names := types.LocalizedNames{"en-US": "Engineering", "ja": "技術部"}
+	fields, err := repo.ComputeHierarchyForNew(context.Background(), tenant, "1000008", &parent, "技术部", names)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	expected := types.LocalizedNames{
+		"en":    "/Group/技术部",
+		"en-US": "/Group/Engineering",
+		"ja":    "/グループ/技術部",
+	}
+	if len(fields.NamePathI18n) != len(expected) {
+		t.Fatalf("unexpected localized paths: %#v", fields.NamePathI18n)
+	}
+	for locale, path := range expected {
+		if fields.NamePathI18n[locale] != path {
+			t.Fatalf("locale %s: expected %q, got %q", locale, path, fields.NamePathI18n[locale])
+		}
+	}
+	if fields.NamePath != "/集团/技术部" {
+		t.Fatalf("default name path changed: %q", fields.NamePath)
+	}
+}
@@ -13,7 +13,7 @@ func (r *OrganizationRepository) GetByCode(ctx context.Context, tenantID uuid.UU
 	query := `
         SELECT record_id, tenant_id, code, parent_code, name, unit_type, status,
                level, code_path, name_path, sort_order, description, created_at, updated_at,
-               effective_date, end_date, change_reason
+               effective_date, end_date, change_reason, custom_fields, name_i18n, name_path_i18n
         FROM organization_units 
         WHERE tenant_id = $1 AND code = $2 AND is_current = true
         LIMIT 1
@@ -28,7 +28,7 @@ func (r *OrganizationRepository) GetByCode(ctx context.Context, tenantID uuid.UU
 		&org.RecordID, &org.TenantID, &org.Code, &parentCode, &org.Name,
 		&org.UnitType, &org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder,
 		&org.Description, &org.CreatedAt, &org.UpdatedAt,
-		&effectiveDate, &endDate, &changeReason,
+		&effectiveDate, &endDate, &changeReason, &org.CustomFields, &org.NameI18n, &org.NamePathI18n,
 	)
 
 	if err != nil {
@@ -58,7 +58,7 @@ func (r *OrganizationRepository) GetByRecordId(ctx context.Context, tenantID uui
 	query := `
         SELECT record_id, tenant_id, code, parent_code, name, unit_type, status,
                level, code_path, name_path, sort_order, description, created_at, updated_at,
-               effective_date, end_date, change_reason
+               effective_date, end_date, change_reason, custom_fields, name_i18n, name_path_i18n
         FROM organization_units
         WHERE tenant_id = $1 AND record_id = $2
         LIMIT 1
@@ -73,7 +73,7 @@ func (r *OrganizationRepository) GetByRecordId(ctx context.Context, tenantID uui
 		&org.RecordID, &org.TenantID, &org.Code, &parentCode, &org.Name,
 		&org.UnitType, &org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder,
 		&org.Description, &org.CreatedAt, &org.UpdatedAt,
-		&effectiveDate, &endDate, &changeReason,
+		&effectiveDate, &endDate, &changeReason, &org.CustomFields, &org.NameI18n, &org.NamePathI18n,
 	)
 
 	if err != nil {
@@ -103,7 +103,7 @@ func (r *OrganizationRepository) ListVersionsByCode(ctx context.Context, tenantI
 	query := `
         SELECT record_id, tenant_id, code, parent_code, name, unit_type, status,
                level, code_path, name_path, sort_order, description, created_at, updated_at,
-               effective_date, end_date, change_reason
+               effective_date, end_date, change_reason, custom_fields, name_i18n, name_path_i18n
         FROM organization_units
         WHERE tenant_id = $1 AND code = $2
           AND status <> 'DELETED'
@@ -127,7 +127,7 @@ func (r *OrganizationRepository) ListVersionsByCode(ctx context.Context, tenantI
 			&org.RecordID, &org.TenantID, &org.Code, &parentCode, &org.Name,
 			&org.UnitType, &org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder,
 			&org.Description, &org.CreatedAt, &org.UpdatedAt,
-			&effectiveDate, &endDate, &changeReason,
+			&effectiveDate, &endDate, &changeReason, &org.CustomFields, &org.NameI18n, &org.NamePathI18n,
 		); err != nil {
 			return nil, fmt.Errorf("扫描组织版本失败: %w", err)
 		}
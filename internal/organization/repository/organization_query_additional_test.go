@@ -25,11 +25,13 @@ func TestOrganizationRepository_GetByCode_Success(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"record_id", "tenant_id", "code", "parent_code", "name", "unit_type", "status",
 		"level", "code_path", "name_path", "sort_order", "description", "created_at", "updated_at",
-		"effective_date", "end_date", "change_reason",
+		"effective_date", "end_date", "change_reason", "custom_fields", "name_i18n", "name_path_i18n",
 	}).AddRow(
 		"rec-1", tenant.String(), "1000008", sql.NullString{String: "1000000", Valid: true}, "技术部",
 		"DEPARTMENT", "ACTIVE", 2, "/1000000/1000008", "/集团/技术部", 0, "desc", now, now,
 		sql.NullTime{Time: now, Valid: true}, sql.NullTime{Valid: false}, sql.NullString{String: "创建", Valid: true},
+		[]byte(`{"costCenter":{"type":"STRING","value":"CC-01"}}`),
+		[]byte(`{"en":"Engineering"}`), []byte(`{"en":"/Group/Engineering"}`),
 	)
 
 	mock.ExpectQuery("FROM organization_units").
@@ -46,6 +48,12 @@ func TestOrganizationRepository_GetByCode_Success(t *testing.T) {
 	if got.EffectiveDate == nil {
 		t.Fatalf("expected effectiveDate set")
 	}
+	if cf := got.CustomFields["costCenter"]; cf == nil || cf.Type != "STRING" || cf.Value != "CC-01" {
+		t.Fatalf("unexpected custom fields: %#v", got.CustomFields)
+	}
+	if got.NameI18n["en"] != "Engineering" || got.NamePathI18n["en"] != "/Group/Engineering" {
+		t.Fatalf("unexpected localized names: %#v %#v", got.NameI18n, got.NamePathI18n)
+	}
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Fatalf("unmet expectations: %v", err)
 	}
@@ -76,11 +84,12 @@ func TestOrganizationRepository_GetByRecordId_Success(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"record_id", "tenant_id", "code", "parent_code", "name", "unit_type", "status",
 		"level", "code_path", "name_path", "sort_order", "description", "created_at", "updated_at",
-		"effective_date", "end_date", "change_reason",
+		"effective_date", "end_date", "change_reason", "custom_fields", "name_i18n", "name_path_i18n",
 	}).AddRow(
 		"rec-1", tenant.String(), "1000008", sql.NullString{Valid: false}, "技术部",
 		"DEPARTMENT", "ACTIVE", 2, "/1000008", "/技术部", 0, "desc", now, now,
 		sql.NullTime{Valid: false}, sql.NullTime{Valid: false}, sql.NullString{Valid: false},
+		nil, nil, nil,
 	)
 
 	mock.ExpectQuery("FROM organization_units").
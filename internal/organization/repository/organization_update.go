@@ -44,23 +44,32 @@ func (r *OrganizationRepository) Update(ctx context.Context, tenantID uuid.UUID,
 		addAssignment("description", *req.Description)
 	}
 
-	if req.ParentCode != nil {
-		normalizedParent := utils.NormalizeParentCodePointer(req.ParentCode)
-		req.ParentCode = normalizedParent
+	// 上级或多语言名称变化时重算层级字段，多语言名称按合并后的结果写入
+	if req.ParentCode != nil || len(req.NameI18n) > 0 {
+		overrides := hierarchyOverrides{Name: nameOverride, NameI18n: req.NameI18n}
+		if req.ParentCode != nil {
+			overrides.ParentProvided = true
+			overrides.ParentCode = utils.NormalizeParentCodePointer(req.ParentCode)
+			req.ParentCode = overrides.ParentCode
+		}
 
-		fields, err := r.recalculateSelfHierarchy(ctx, tenantID, code, nil, normalizedParent, nameOverride)
+		fields, err := r.recalculateSelfHierarchy(ctx, tenantID, code, nil, overrides)
 		if err != nil {
 			return nil, err
 		}
 
-		if normalizedParent != nil {
-			addAssignment("parent_code", *normalizedParent)
-		} else {
-			addAssignment("parent_code", nil)
+		if overrides.ParentProvided {
+			if overrides.ParentCode != nil {
+				addAssignment("parent_code", *overrides.ParentCode)
+			} else {
+				addAssignment("parent_code", nil)
+			}
 		}
 		addAssignment("level", fields.Level)
 		addAssignment("code_path", fields.CodePath)
 		addAssignment("name_path", fields.NamePath)
+		addAssignment("name_i18n", fields.NameI18n)
+		addAssignment("name_path_i18n", fields.NamePathI18n)
 	}
 
 	if req.EffectiveDate != nil {
@@ -75,6 +84,13 @@ func (r *OrganizationRepository) Update(ctx context.Context, tenantID uuid.UUID,
 		addAssignment("change_reason", *req.ChangeReason)
 	}
 
+	if len(req.CustomFields) > 0 {
+		// 仅合并提交的字段，值为 null 的键被移除
+		setParts = append(setParts, "custom_fields = jsonb_strip_nulls(custom_fields || $"+strconv.Itoa(argIndex)+"::jsonb)")
+		args = append(args, req.CustomFields)
+		argIndex++
+	}
+
 	if len(setParts) == 0 {
 		return nil, fmt.Errorf("无字段需要更新，操作被忽略")
 	}
@@ -88,14 +104,15 @@ WHERE tenant_id = $1 AND code = $2
   AND status <> 'DELETED'
 RETURNING record_id, tenant_id, code, parent_code, name, unit_type, status,
           level, code_path, name_path, sort_order, description, created_at, updated_at,
-          effective_date, end_date, change_reason`, setClause)
+          effective_date, end_date, change_reason, custom_fields, name_i18n, name_path_i18n`, setClause)
 
 	var org types.Organization
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(
 		&org.RecordID, &org.TenantID, &org.Code, &org.ParentCode, &org.Name,
 		&org.UnitType, &org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder,
 		&org.Description, &org.CreatedAt, &org.UpdatedAt,
-		&org.EffectiveDate, &org.EndDate, &org.ChangeReason,
+		&org.EffectiveDate, &org.EndDate, &org.ChangeReason, &org.CustomFields,
+		&org.NameI18n, &org.NamePathI18n,
 	)
 
 	if err != nil {
@@ -144,23 +161,32 @@ func (r *OrganizationRepository) UpdateByRecordId(ctx context.Context, tenantID
 		addAssignment("description", *req.Description)
 	}
 
-	if req.ParentCode != nil {
-		normalizedParent := utils.NormalizeParentCodePointer(req.ParentCode)
-		req.ParentCode = normalizedParent
+	// 上级或多语言名称变化时重算层级字段，多语言名称按合并后的结果写入
+	if req.ParentCode != nil || len(req.NameI18n) > 0 {
+		overrides := hierarchyOverrides{Name: nameOverride, NameI18n: req.NameI18n}
+		if req.ParentCode != nil {
+			overrides.ParentProvided = true
+			overrides.ParentCode = utils.NormalizeParentCodePointer(req.ParentCode)
+			req.ParentCode = overrides.ParentCode
+		}
 
-		fields, err := r.recalculateSelfHierarchy(ctx, tenantID, "", &recordId, normalizedParent, nameOverride)
+		fields, err := r.recalculateSelfHierarchy(ctx, tenantID, "", &recordId, overrides)
 		if err != nil {
 			return nil, err
 		}
 
-		if normalizedParent != nil {
-			addAssignment("parent_code", *normalizedParent)
-		} else {
-			addAssignment("parent_code", nil)
+		if overrides.ParentProvided {
+			if overrides.ParentCode != nil {
+				addAssignment("parent_code", *overrides.ParentCode)
+			} else {
+				addAssignment("parent_code", nil)
+			}
 		}
 		addAssignment("level", fields.Level)
 		addAssignment("code_path", fields.CodePath)
 		addAssignment("name_path", fields.NamePath)
+		addAssignment("name_i18n", fields.NameI18n)
+		addAssignment("name_path_i18n", fields.NamePathI18n)
 	}
 
 	if req.EffectiveDate != nil {
@@ -175,6 +201,13 @@ func (r *OrganizationRepository) UpdateByRecordId(ctx context.Context, tenantID
 		addAssignment("change_reason", *req.ChangeReason)
 	}
 
+	if len(req.CustomFields) > 0 {
+		// 仅合并提交的字段，值为 null 的键被移除
+		setParts = append(setParts, "custom_fields = jsonb_strip_nulls(custom_fields || $"+strconv.Itoa(argIndex)+"::jsonb)")
+		args = append(args, req.CustomFields)
+		argIndex++
+	}
+
 	if len(setParts) == 0 {
 		return nil, fmt.Errorf("无字段需要更新，操作被忽略")
 	}
@@ -188,14 +221,15 @@ WHERE tenant_id = $1 AND record_id = $2
   AND status <> 'DELETED'
 RETURNING record_id, tenant_id, code, parent_code, name, unit_type, status,
           level, code_path, name_path, sort_order, description, created_at, updated_at,
-          effective_date, end_date, change_reason`, setClause)
+          effective_date, end_date, change_reason, custom_fields, name_i18n, name_path_i18n`, setClause)
 
 	var org types.Organization
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(
 		&org.RecordID, &org.TenantID, &org.Code, &org.ParentCode, &org.Name,
 		&org.UnitType, &org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder,
 		&org.Description, &org.CreatedAt, &org.UpdatedAt,
-		&org.EffectiveDate, &org.EndDate, &org.ChangeReason,
+		&org.EffectiveDate, &org.EndDate, &org.ChangeReason, &org.CustomFields,
+		&org.NameI18n, &org.NamePathI18n,
 	)
 
 	if err != nil {
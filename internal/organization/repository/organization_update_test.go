@@ -78,7 +78,7 @@ func TestOrganizationRepositoryUpdate_Success(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{
 			"record_id", "tenant_id", "code", "parent_code", "name", "unit_type", "status",
 			"level", "code_path", "name_path", "sort_order", "description", "created_at", "updated_at",
-			"effective_date", "end_date", "change_reason",
+			"effective_date", "end_date", "change_reason", "custom_fields", "name_i18n", "name_path_i18n",
 		}).AddRow(
 			uuid.NewString(),
 			tenantID.String(),
@@ -97,6 +97,9 @@ func TestOrganizationRepositoryUpdate_Success(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			[]byte(`{}`),
+			[]byte(`{}`),
+			[]byte(`{}`),
 		))
 
 	entity, err := repo.Update(context.Background(), tenantID, code, req)
@@ -145,13 +148,15 @@ func TestOrganizationRepositoryUpdateByRecordID_WithParent(t *testing.T) {
 		ParentCode: &parent,
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT code, name, level FROM organization_units")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT code, name, level, parent_code, name_i18n FROM organization_units")).
 		WithArgs(tenantID.String(), recordID).
-		WillReturnRows(sqlmock.NewRows([]string{"code", "name", "level"}).AddRow("2000001", "Old Branch", 2))
+		WillReturnRows(sqlmock.NewRows([]string{"code", "name", "level", "parent_code", "name_i18n"}).
+			AddRow("2000001", "Old Branch", 2, nil, []byte(`{"en":"Old Branch EN"}`)))
 
 	mock.ExpectQuery("SELECT COALESCE\\(NULLIF\\(code_path").
 		WithArgs(tenantID.String(), "1000000").
-		WillReturnRows(sqlmock.NewRows([]string{"code_path", "name_path", "level"}).AddRow("/1000000", "/集团", 1))
+		WillReturnRows(sqlmock.NewRows([]string{"code_path", "name_path", "name_path_i18n", "level"}).
+			AddRow("/1000000", "/集团", []byte(`{"en":"/Group"}`), 1))
 
 	mock.ExpectQuery(regexp.QuoteMeta("UPDATE organization_units")).
 		WithArgs(
@@ -163,12 +168,14 @@ func TestOrganizationRepositoryUpdateByRecordID_WithParent(t *testing.T) {
 			2,
 			"/1000000/2000001",
 			"/集团/Branch Node",
+			types.LocalizedNames{"en": "Old Branch EN"},
+			types.LocalizedNames{"en": "/Group/Old Branch EN"},
 			sqlmock.AnyArg(),
 		).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"record_id", "tenant_id", "code", "parent_code", "name", "unit_type", "status",
 			"level", "code_path", "name_path", "sort_order", "description", "created_at", "updated_at",
-			"effective_date", "end_date", "change_reason",
+			"effective_date", "end_date", "change_reason", "custom_fields", "name_i18n", "name_path_i18n",
 		}).AddRow(
 			recordID,
 			tenantID.String(),
@@ -187,6 +194,9 @@ func TestOrganizationRepositoryUpdateByRecordID_WithParent(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			[]byte(`{}`),
+			[]byte(`{}`),
+			[]byte(`{}`),
 		))
 
 	entity, err := repo.UpdateByRecordId(context.Background(), tenantID, recordID, req)
@@ -214,7 +224,7 @@ func TestOrganizationRepositoryUpdateByRecordID_RecordMissing(t *testing.T) {
 		ParentCode: &parent,
 	}
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT code, name, level FROM organization_units")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT code, name, level, parent_code, name_i18n FROM organization_units")).
 		WithArgs(tenantID.String(), recordID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -515,6 +515,60 @@ LIMIT $3`
 	return result, nil
 }
 
+// ListEmployeeAssignments 列出员工在全部职位上尚未结束（PENDING/ACTIVE）的任职，附带职位当前所属组织。
+func (r *PositionAssignmentRepository) ListEmployeeAssignments(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, employeeID uuid.UUID) ([]types.EmployeeAssignment, error) {
+	query := `SELECT pa.assignment_id, pa.tenant_id, pa.position_code, pa.position_record_id, pa.employee_id, pa.employee_name, pa.employee_number,
+pa.assignment_type, pa.assignment_status, pa.fte, pa.effective_date, pa.end_date, pa.acting_until, pa.auto_revert, pa.reminder_sent_at, pa.is_current, pa.notes, pa.created_at, pa.updated_at,
+COALESCE(p.organization_code, '')
+FROM position_assignments pa
+LEFT JOIN positions p ON p.tenant_id = pa.tenant_id AND p.code = pa.position_code AND p.is_current = true
+WHERE pa.tenant_id = $1 AND pa.employee_id = $2
+  AND pa.assignment_status IN ('PENDING', 'ACTIVE')
+ORDER BY pa.effective_date, pa.created_at`
+
+	rows, err := r.queryRows(ctx, tx, query, tenantID, employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employee assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var result []types.EmployeeAssignment
+	for rows.Next() {
+		var entity types.EmployeeAssignment
+		if err := rows.Scan(
+			&entity.AssignmentID,
+			&entity.TenantID,
+			&entity.PositionCode,
+			&entity.PositionRecordID,
+			&entity.EmployeeID,
+			&entity.EmployeeName,
+			&entity.EmployeeNumber,
+			&entity.AssignmentType,
+			&entity.AssignmentStatus,
+			&entity.FTE,
+			&entity.EffectiveDate,
+			&entity.EndDate,
+			&entity.ActingUntil,
+			&entity.AutoRevert,
+			&entity.ReminderSentAt,
+			&entity.IsCurrent,
+			&entity.Notes,
+			&entity.CreatedAt,
+			&entity.UpdatedAt,
+			&entity.OrganizationCode,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan employee assignment row: %w", err)
+		}
+		result = append(result, entity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("employee assignment iteration error: %w", err)
+	}
+
+	return result, nil
+}
+
 func (r *PositionAssignmentRepository) SumActiveFTE(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, positionCode string) (float64, error) {
 	query := `SELECT COALESCE(SUM(fte), 0)
 FROM position_assignments
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
@@ -55,7 +56,7 @@ func (r *PositionRepository) GetCurrentPosition(ctx context.Context, tx *sql.Tx,
 job_family_code, job_family_name, job_family_record_id, job_role_code, job_role_name, job_role_record_id,
 job_level_code, job_level_name, job_level_record_id, organization_code, organization_name, position_type, status, employment_type,
 	headcount_capacity, headcount_in_use, grade_level, cost_center_code,
-reports_to_position_code, profile, effective_date, end_date, is_current, created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason
+reports_to_position_code, profile, effective_date, end_date, is_current, created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason, custom_fields, title_i18n
 FROM positions WHERE tenant_id = $1 AND code = $2 AND is_current = true LIMIT 1`
 
 	var entity types.Position
@@ -99,6 +100,8 @@ FROM positions WHERE tenant_id = $1 AND code = $2 AND is_current = true LIMIT 1`
 		&entity.OperatedByID,
 		&entity.OperatedByName,
 		&entity.OperationReason,
+		&entity.CustomFields,
+		&entity.TitleI18n,
 	)
 
 	if err != nil {
@@ -121,7 +124,7 @@ job_level_code, job_level_name, job_level_record_id,
 organization_code, organization_name, position_type, status, employment_type,
 headcount_capacity, headcount_in_use, grade_level, cost_center_code,
 reports_to_position_code, profile, effective_date, end_date, is_current,
-created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason)
+created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason, custom_fields, title_i18n)
 VALUES (
 $1,$2,$3,$4,$5,
 $6,$7,$8,
@@ -131,7 +134,7 @@ $15,$16,$17,
 $18,$19,$20,$21,$22,
 $23,$24,$25,$26,
 $27,$28,$29,$30,$31,
-NOW(),NOW(),NULL,$32,$33,$34,$35)
+NOW(),NOW(),NULL,$32,$33,$34,$35,jsonb_strip_nulls($36::jsonb),$37)
 RETURNING record_id, created_at, updated_at`
 
 	var profilePayload interface{}
@@ -233,6 +236,8 @@ RETURNING record_id, created_at, updated_at`
 		entity.OperatedByID,
 		entity.OperatedByName,
 		operationReason,
+		entity.CustomFields,
+		entity.TitleI18n,
 	).Scan(&entity.RecordID, &entity.CreatedAt, &entity.UpdatedAt)
 
 	if err != nil {
@@ -405,6 +410,8 @@ operated_by_id = $24,
 operated_by_name = $25,
 operation_reason = $26,
 status = $27,
+custom_fields = jsonb_strip_nulls($30::jsonb),
+title_i18n = $31,
 updated_at = NOW()
 WHERE tenant_id = $28 AND record_id = $29
 RETURNING updated_at`
@@ -464,6 +471,8 @@ RETURNING updated_at`
 		entity.Status,
 		entity.TenantID,
 		entity.RecordID,
+		entity.CustomFields,
+		entity.TitleI18n,
 	).Scan(&entity.UpdatedAt)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
@@ -515,7 +524,7 @@ func (r *PositionRepository) GetPositionByRecordID(ctx context.Context, tx *sql.
 job_family_code, job_family_name, job_family_record_id, job_role_code, job_role_name, job_role_record_id,
 job_level_code, job_level_name, job_level_record_id, organization_code, organization_name, position_type, status, employment_type,
 headcount_capacity, headcount_in_use, grade_level, cost_center_code,
-reports_to_position_code, profile, effective_date, end_date, is_current, created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason
+reports_to_position_code, profile, effective_date, end_date, is_current, created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason, custom_fields, title_i18n
 FROM positions WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 
 	var entity types.Position
@@ -559,6 +568,8 @@ FROM positions WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 		&entity.OperatedByID,
 		&entity.OperatedByName,
 		&entity.OperationReason,
+		&entity.CustomFields,
+		&entity.TitleI18n,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -569,6 +580,91 @@ FROM positions WHERE tenant_id = $1 AND record_id = $2 LIMIT 1`
 	return &entity, nil
 }
 
+// positionJobCatalogColumns 职位表中各职位目录层级的引用列
+var positionJobCatalogColumns = map[string]string{
+	types.JobCatalogLevelFamilyGroup: "job_family_group_code",
+	types.JobCatalogLevelFamily:      "job_family_code",
+	types.JobCatalogLevelRole:        "job_role_code",
+	types.JobCatalogLevelLevel:       "job_level_code",
+}
+
+// ListVersionsByJobCatalog 返回在指定日期及之后仍有效、且引用指定职位目录条目的职位版本（已删除版本除外），按职位编码与生效日期排序。
+func (r *PositionRepository) ListVersionsByJobCatalog(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string, from time.Time) ([]types.Position, error) {
+	column, ok := positionJobCatalogColumns[level]
+	if !ok {
+		return nil, fmt.Errorf("unsupported job catalog level: %s", level)
+	}
+	query := fmt.Sprintf(`SELECT record_id, tenant_id, code, title, job_profile_code, job_profile_name, job_family_group_code, job_family_group_name, job_family_group_record_id,
+job_family_code, job_family_name, job_family_record_id, job_role_code, job_role_name, job_role_record_id,
+job_level_code, job_level_name, job_level_record_id, organization_code, organization_name, position_type, status, employment_type,
+headcount_capacity, headcount_in_use, grade_level, cost_center_code,
+reports_to_position_code, profile, effective_date, end_date, is_current, created_at, updated_at, deleted_at, operation_type, operated_by_id, operated_by_name, operation_reason, custom_fields, title_i18n
+FROM positions
+WHERE tenant_id = $1 AND %s = $2 AND deleted_at IS NULL AND status <> 'DELETED' AND (end_date IS NULL OR end_date >= $3)
+ORDER BY code, effective_date`, column)
+
+	rows, err := r.queryRows(ctx, tx, query, tenantID, code, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query positions by job catalog: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]types.Position, 0)
+	for rows.Next() {
+		var entity types.Position
+		if err := rows.Scan(
+			&entity.RecordID,
+			&entity.TenantID,
+			&entity.Code,
+			&entity.Title,
+			&entity.JobProfileCode,
+			&entity.JobProfileName,
+			&entity.JobFamilyGroupCode,
+			&entity.JobFamilyGroupName,
+			&entity.JobFamilyGroupRecord,
+			&entity.JobFamilyCode,
+			&entity.JobFamilyName,
+			&entity.JobFamilyRecord,
+			&entity.JobRoleCode,
+			&entity.JobRoleName,
+			&entity.JobRoleRecord,
+			&entity.JobLevelCode,
+			&entity.JobLevelName,
+			&entity.JobLevelRecord,
+			&entity.OrganizationCode,
+			&entity.OrganizationName,
+			&entity.PositionType,
+			&entity.Status,
+			&entity.EmploymentType,
+			&entity.HeadcountCapacity,
+			&entity.HeadcountInUse,
+			&entity.GradeLevel,
+			&entity.CostCenterCode,
+			&entity.ReportsToPosition,
+			&entity.Profile,
+			&entity.EffectiveDate,
+			&entity.EndDate,
+			&entity.IsCurrent,
+			&entity.CreatedAt,
+			&entity.UpdatedAt,
+			&entity.DeletedAt,
+			&entity.OperationType,
+			&entity.OperatedByID,
+			&entity.OperatedByName,
+			&entity.OperationReason,
+			&entity.CustomFields,
+			&entity.TitleI18n,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan position version: %w", err)
+		}
+		versions = append(versions, entity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("position version iteration error: %w", err)
+	}
+	return versions, nil
+}
+
 // GenerateCode 生成职位编码
 func (r *PositionRepository) GenerateCode(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID) (string, error) {
 	const minCode = 1000000
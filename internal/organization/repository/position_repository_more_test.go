@@ -34,7 +34,7 @@ func TestPositionRepository_GetCurrentPosition(t *testing.T) {
 		"job_family_code", "job_family_name", "job_family_record_id", "job_role_code", "job_role_name", "job_role_record_id",
 		"job_level_code", "job_level_name", "job_level_record_id", "organization_code", "organization_name", "position_type", "status", "employment_type",
 		"headcount_capacity", "headcount_in_use", "grade_level", "cost_center_code", "reports_to_position_code", "profile", "effective_date", "end_date", "is_current",
-		"created_at", "updated_at", "deleted_at", "operation_type", "operated_by_id", "operated_by_name", "operation_reason",
+		"created_at", "updated_at", "deleted_at", "operation_type", "operated_by_id", "operated_by_name", "operation_reason", "custom_fields", "title_i18n",
 	}
 
 	rows := sqlmock.NewRows(columns).AddRow(
@@ -43,6 +43,7 @@ func TestPositionRepository_GetCurrentPosition(t *testing.T) {
 		"L1", "Level", uuid.New(), "ORG001", sql.NullString{String: "Org", Valid: true}, "FULLTIME", "ACTIVE", "PERM", 2.0, 1.0,
 		sql.NullString{String: "G7", Valid: true}, sql.NullString{String: "CC", Valid: true}, sql.NullString{String: "PARENT", Valid: true}, []byte(`{"profile":true}`),
 		now, sql.NullTime{}, true, now, now, sql.NullTime{}, "CREATE", uuid.New(), "operator", sql.NullString{},
+		[]byte(`{"location":{"type":"STRING","value":"Shanghai"}}`), []byte(`{"en-US":"Software Engineer"}`),
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT record_id, tenant_id, code")).
@@ -56,6 +57,15 @@ func TestPositionRepository_GetCurrentPosition(t *testing.T) {
 	if entity == nil || entity.Code != "POS001" {
 		t.Fatalf("expected entity POS001, got %#v", entity)
 	}
+	if cf := entity.CustomFields["location"]; cf == nil || cf.Value != "Shanghai" {
+		t.Fatalf("expected custom field location, got %#v", entity.CustomFields)
+	}
+	if got := entity.TitleI18n.Lookup("en", entity.Title); got != "Engineer" {
+		t.Fatalf("expected bare en to fall back to default title, got %q", got)
+	}
+	if got := entity.TitleI18n.Lookup("en-US", entity.Title); got != "Software Engineer" {
+		t.Fatalf("expected en-US title, got %q", got)
+	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Fatalf("unmet expectations: %v", err)
@@ -118,3 +128,45 @@ func TestPositionRepository_BeginTxUsesSerializable(t *testing.T) {
 		t.Fatalf("BeginTx error: %v", err)
 	}
 }
+
+func TestPositionRepository_ListVersionsByJobCatalog(t *testing.T) {
+	repo, mock, cleanup := newPositionRepository(t)
+	defer cleanup()
+
+	tenant := uuid.New()
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{
+		"record_id", "tenant_id", "code", "title", "job_profile_code", "job_profile_name", "job_family_group_code", "job_family_group_name", "job_family_group_record_id",
+		"job_family_code", "job_family_name", "job_family_record_id", "job_role_code", "job_role_name", "job_role_record_id",
+		"job_level_code", "job_level_name", "job_level_record_id", "organization_code", "organization_name", "position_type", "status", "employment_type",
+		"headcount_capacity", "headcount_in_use", "grade_level", "cost_center_code", "reports_to_position_code", "profile", "effective_date", "end_date", "is_current",
+		"created_at", "updated_at", "deleted_at", "operation_type", "operated_by_id", "operated_by_name", "operation_reason", "custom_fields", "title_i18n",
+	}
+	rows := sqlmock.NewRows(columns).AddRow(
+		uuid.New(), tenant, "P1000001", "Engineer", sql.NullString{}, sql.NullString{},
+		"G1", "Group", uuid.New(), "F1", "Family", uuid.New(), "JR-OLD", "Role", uuid.New(),
+		"L1", "Level", uuid.New(), "1000000", sql.NullString{}, "REGULAR", "FILLED", "FULL_TIME", 1.0, 1.0,
+		sql.NullString{}, sql.NullString{}, sql.NullString{}, []byte(`{}`),
+		from.AddDate(-1, 0, 0), sql.NullTime{}, true, from, from, sql.NullTime{}, "CREATE", uuid.New(), "operator", sql.NullString{},
+		[]byte(`{}`), []byte(`{}`),
+	)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE tenant_id = $1 AND job_role_code = $2 AND deleted_at IS NULL AND status <> 'DELETED' AND (end_date IS NULL OR end_date >= $3)")).
+		WithArgs(tenant, "JR-OLD", from).
+		WillReturnRows(rows)
+
+	versions, err := repo.ListVersionsByJobCatalog(context.Background(), nil, tenant, types.JobCatalogLevelRole, "JR-OLD", from)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 1 || versions[0].Code != "P1000001" || versions[0].JobRoleCode != "JR-OLD" {
+		t.Fatalf("unexpected versions: %#v", versions)
+	}
+	if _, err := repo.ListVersionsByJobCatalog(context.Background(), nil, tenant, "UNKNOWN", "X", from); err == nil {
+		t.Fatalf("expected unsupported level error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
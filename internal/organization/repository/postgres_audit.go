@@ -587,6 +587,42 @@ func (r *PostgreSQLRepository) registerValidationSuccess() {
 	}
 }
 
+// GetAuditArchiveCoverage 查询时间范围内与之重叠的审计归档；无归档时返回 nil。
+// 已归档记录不再出现在 GetAuditHistory 结果中，调用方据此提示通过导出接口获取。
+func (r *PostgreSQLRepository) GetAuditArchiveCoverage(ctx context.Context, tenantId uuid.UUID, startDate, endDate *string) (*dto.AuditArchiveNotice, error) {
+	query := `
+		SELECT COUNT(*), MIN(from_ts), MAX(to_ts)
+		FROM audit_archives
+		WHERE tenant_id = $1::uuid`
+	args := []interface{}{tenantId}
+	if startDate != nil && strings.TrimSpace(*startDate) != "" {
+		args = append(args, *startDate)
+		query += fmt.Sprintf(" AND to_ts >= $%d::timestamp", len(args))
+	}
+	if endDate != nil && strings.TrimSpace(*endDate) != "" {
+		args = append(args, *endDate)
+		query += fmt.Sprintf(" AND from_ts <= $%d::timestamp", len(args))
+	}
+
+	var count int
+	var from, to sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count, &from, &to); err != nil {
+		r.loggerFor("audit.archiveCoverage", pkglogger.Fields{"tenantId": tenantId.String()}).
+			WithFields(pkglogger.Fields{"error": err}).Warn("audit archive coverage query failed")
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	return &dto.AuditArchiveNotice{
+		Archived:       true,
+		ArchiveCount:   count,
+		ArchivedFrom:   from.Time.UTC().Format(time.RFC3339),
+		ArchivedBefore: to.Time.UTC().Format(time.RFC3339),
+		ExportPath:     "/api/v1/audit/export?includeArchived=true",
+	}, nil
+}
+
 // 单条审计记录查询 - v4.6.0
 func (r *PostgreSQLRepository) GetAuditLog(ctx context.Context, auditId string) (*dto.AuditRecordData, error) {
 	start := time.Now()
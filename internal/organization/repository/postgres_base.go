@@ -1,8 +1,7 @@
 package repository
 
 import (
-	"database/sql"
-
+	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/redis/go-redis/v9"
 )
@@ -16,14 +15,14 @@ type AuditHistoryConfig struct {
 
 // PostgreSQLRepository 提供查询服务的数据访问能力
 type PostgreSQLRepository struct {
-	db                     *sql.DB
+	db                     database.Queryer
 	redisClient            *redis.Client
 	logger                 pkglogger.Logger
 	auditConfig            AuditHistoryConfig
 	validationFailureCount int32
 }
 
-func NewPostgreSQLRepository(db *sql.DB, redisClient *redis.Client, logger pkglogger.Logger, auditConfig AuditHistoryConfig) *PostgreSQLRepository {
+func NewPostgreSQLRepository(db database.Queryer, redisClient *redis.Client, logger pkglogger.Logger, auditConfig AuditHistoryConfig) *PostgreSQLRepository {
 	if logger == nil {
 		logger = pkglogger.NewNoopLogger()
 	}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/dto"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// GetCompensationGrades 查询指定日期生效的职等版本；版本结束日期由下一版本生效日期推导。
+func (r *PostgreSQLRepository) GetCompensationGrades(ctx context.Context, tenantID uuid.UUID, filter dto.CompensationGradeFilter) ([]dto.CompensationGrade, error) {
+	args := []interface{}{tenantID.String()}
+	asOfExpr := "CURRENT_DATE"
+	if filter.AsOfDate != nil && strings.TrimSpace(*filter.AsOfDate) != "" {
+		args = append(args, strings.TrimSpace(*filter.AsOfDate))
+		asOfExpr = fmt.Sprintf("$%d::date", len(args))
+	}
+	whereParts := []string{
+		fmt.Sprintf("effective_date <= %s", asOfExpr),
+		fmt.Sprintf("(end_date IS NULL OR end_date >= %s)", asOfExpr),
+	}
+	if !filter.IncludeInactive {
+		whereParts = append(whereParts, "status = 'ACTIVE'")
+	}
+	if filter.JobLevelCode != nil && strings.TrimSpace(*filter.JobLevelCode) != "" {
+		args = append(args, strings.TrimSpace(*filter.JobLevelCode))
+		whereParts = append(whereParts, fmt.Sprintf("$%d = ANY(job_level_codes)", len(args)))
+	}
+	if filter.GradeCode != nil && strings.TrimSpace(*filter.GradeCode) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.GradeCode)))
+		whereParts = append(whereParts, fmt.Sprintf("grade_code = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+SELECT
+    record_id::text,
+    grade_code,
+    name,
+    description,
+    status,
+    job_level_codes,
+    pay_ranges,
+    effective_date,
+    end_date,
+    (effective_date <= CURRENT_DATE AND (end_date IS NULL OR end_date >= CURRENT_DATE)) AS is_current
+FROM (
+    SELECT g.*,
+           (LEAD(effective_date) OVER (PARTITION BY grade_code ORDER BY effective_date) - 1) AS end_date
+    FROM compensation_grades g
+    WHERE tenant_id = $1
+) versions
+WHERE %s
+ORDER BY grade_code
+`, strings.Join(whereParts, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query compensation grades: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.CompensationGrade, 0)
+	for rows.Next() {
+		var (
+			item          dto.CompensationGrade
+			desc          sql.NullString
+			effectiveDate time.Time
+			endDate       sql.NullTime
+		)
+		if err := rows.Scan(
+			&item.RecordIDField,
+			&item.CodeField,
+			&item.NameField,
+			&desc,
+			&item.StatusField,
+			pq.Array(&item.JobLevelCodesField),
+			&item.PayRangesField,
+			&effectiveDate,
+			&endDate,
+			&item.IsCurrentField,
+		); err != nil {
+			return nil, fmt.Errorf("scan compensation grade: %w", err)
+		}
+		if desc.Valid {
+			item.DescriptionField = &desc.String
+		}
+		if item.JobLevelCodesField == nil {
+			item.JobLevelCodesField = []string{}
+		}
+		item.EffectiveDateField = dto.Date(effectiveDate.Format("2006-01-02"))
+		if endDate.Valid {
+			end := dto.Date(endDate.Time.Format("2006-01-02"))
+			item.EndDateField = &end
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/dto"
+	"github.com/google/uuid"
+)
+
+// GetCostCenters 查询指定日期生效的成本中心版本；版本结束日期由下一版本生效日期推导。
+func (r *PostgreSQLRepository) GetCostCenters(ctx context.Context, tenantID uuid.UUID, filter dto.CostCenterFilter) ([]dto.CostCenter, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String()}, filter.AsOfDate)
+	whereParts := []string{
+		fmt.Sprintf("effective_date <= %s", asOfExpr),
+		fmt.Sprintf("(end_date IS NULL OR end_date >= %s)", asOfExpr),
+	}
+	if !filter.IncludeInactive {
+		whereParts = append(whereParts, "status = 'ACTIVE'")
+	}
+	if filter.LegalEntityCode != nil && strings.TrimSpace(*filter.LegalEntityCode) != "" {
+		args = append(args, strings.TrimSpace(*filter.LegalEntityCode))
+		whereParts = append(whereParts, fmt.Sprintf("legal_entity_code = $%d", len(args)))
+	}
+	if filter.ParentCode != nil && strings.TrimSpace(*filter.ParentCode) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.ParentCode)))
+		whereParts = append(whereParts, fmt.Sprintf("parent_code = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+SELECT
+    record_id::text,
+    code,
+    name,
+    description,
+    status,
+    parent_code,
+    legal_entity_code,
+    effective_date,
+    end_date,
+    (effective_date <= CURRENT_DATE AND (end_date IS NULL OR end_date >= CURRENT_DATE)) AS is_current
+FROM (
+    SELECT c.*,
+           (LEAD(effective_date) OVER (PARTITION BY code ORDER BY effective_date) - 1) AS end_date
+    FROM cost_centers c
+    WHERE tenant_id = $1
+) versions
+WHERE %s
+ORDER BY legal_entity_code, code
+`, strings.Join(whereParts, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query cost centers: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.CostCenter, 0)
+	for rows.Next() {
+		var (
+			item          dto.CostCenter
+			desc, parent  sql.NullString
+			effectiveDate time.Time
+			endDate       sql.NullTime
+		)
+		if err := rows.Scan(
+			&item.RecordIDField,
+			&item.CodeField,
+			&item.NameField,
+			&desc,
+			&item.StatusField,
+			&parent,
+			&item.LegalEntityCodeField,
+			&effectiveDate,
+			&endDate,
+			&item.IsCurrentField,
+		); err != nil {
+			return nil, fmt.Errorf("scan cost center: %w", err)
+		}
+		if desc.Valid {
+			item.DescriptionField = &desc.String
+		}
+		if parent.Valid {
+			item.ParentCodeField = &parent.String
+		}
+		item.EffectiveDateField = dto.Date(effectiveDate.Format("2006-01-02"))
+		if endDate.Valid {
+			end := dto.Date(endDate.Time.Format("2006-01-02"))
+			item.EndDateField = &end
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// GetPositionCostAllocations 查询职位在指定日期生效的成本分摊方案；未设置分摊时返回职位 costCenterCode 承担 100%。
+func (r *PostgreSQLRepository) GetPositionCostAllocations(ctx context.Context, tenantID uuid.UUID, positionCode string, asOfDate *string) ([]dto.CostAllocation, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String(), positionCode}, asOfDate)
+	query := fmt.Sprintf(`
+WITH latest AS (
+    SELECT MAX(effective_date) AS effective_date
+    FROM position_cost_allocations
+    WHERE tenant_id = $1 AND position_code = $2 AND effective_date <= %[1]s
+),
+allocations AS (
+    SELECT a.cost_center_code, a.percentage, a.effective_date
+    FROM position_cost_allocations a
+    JOIN latest ON a.effective_date = latest.effective_date
+    WHERE a.tenant_id = $1 AND a.position_code = $2
+    UNION ALL
+    SELECT p.cost_center_code, 100, NULL::date
+    FROM positions p
+    WHERE p.tenant_id = $1 AND p.code = $2 AND p.is_current = true AND p.deleted_at IS NULL
+      AND p.cost_center_code IS NOT NULL
+      AND NOT EXISTS (SELECT 1 FROM latest WHERE latest.effective_date IS NOT NULL)
+)
+SELECT al.cost_center_code, cc.name, al.percentage, al.effective_date
+FROM allocations al
+LEFT JOIN LATERAL (
+    SELECT c.name
+    FROM cost_centers c
+    WHERE c.tenant_id = $1 AND c.code = al.cost_center_code AND c.effective_date <= %[1]s
+    ORDER BY c.effective_date DESC
+    LIMIT 1
+) cc ON true
+ORDER BY al.percentage DESC, al.cost_center_code
+`, asOfExpr)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query position cost allocations: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.CostAllocation, 0)
+	for rows.Next() {
+		var (
+			item          dto.CostAllocation
+			name          sql.NullString
+			effectiveDate sql.NullTime
+		)
+		if err := rows.Scan(&item.CostCenterCodeField, &name, &item.PercentageField, &effectiveDate); err != nil {
+			return nil, fmt.Errorf("scan position cost allocation: %w", err)
+		}
+		if name.Valid {
+			item.CostCenterNameField = &name.String
+		}
+		if effectiveDate.Valid {
+			date := dto.Date(effectiveDate.Time.Format("2006-01-02"))
+			item.EffectiveDateField = &date
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// GetCostCenterHeadcount 按成本中心汇总当前职位的编制、占用与在岗 FTE；
+// 职位在查询日期有分摊方案时按比例折算，否则由职位 costCenterCode 全额承担。
+func (r *PostgreSQLRepository) GetCostCenterHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, legalEntityCode *string) ([]dto.CostCenterHeadcount, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String()}, asOfDate)
+	legalEntityFilter := ""
+	if legalEntityCode != nil && strings.TrimSpace(*legalEntityCode) != "" {
+		args = append(args, strings.TrimSpace(*legalEntityCode))
+		legalEntityFilter = fmt.Sprintf("WHERE cc.legal_entity_code = $%d", len(args))
+	}
+
+	query := fmt.Sprintf(`
+WITH pos AS (
+    SELECT p.code, p.cost_center_code, p.headcount_capacity, p.headcount_in_use
+    FROM positions p
+    WHERE p.tenant_id = $1 AND p.is_current = true AND p.status <> 'DELETED' AND p.deleted_at IS NULL
+),
+latest AS (
+    SELECT position_code, MAX(effective_date) AS effective_date
+    FROM position_cost_allocations
+    WHERE tenant_id = $1 AND effective_date <= %[1]s
+    GROUP BY position_code
+),
+shares AS (
+    SELECT a.position_code, a.cost_center_code, a.percentage / 100.0 AS share
+    FROM position_cost_allocations a
+    JOIN latest ON latest.position_code = a.position_code AND latest.effective_date = a.effective_date
+    WHERE a.tenant_id = $1
+    UNION ALL
+    SELECT pos.code, pos.cost_center_code, 1.0
+    FROM pos
+    WHERE pos.cost_center_code IS NOT NULL
+      AND NOT EXISTS (SELECT 1 FROM latest WHERE latest.position_code = pos.code)
+),
+fte AS (
+    SELECT position_code, SUM(fte) AS fte
+    FROM position_assignments
+    WHERE tenant_id = $1 AND assignment_status = 'ACTIVE'
+      AND effective_date <= %[1]s AND (end_date IS NULL OR end_date > %[1]s)
+    GROUP BY position_code
+),
+cc AS (
+    SELECT DISTINCT ON (code) code, name, legal_entity_code
+    FROM cost_centers
+    WHERE tenant_id = $1 AND effective_date <= %[1]s
+    ORDER BY code, effective_date DESC
+)
+SELECT
+    s.cost_center_code,
+    cc.name,
+    cc.legal_entity_code,
+    COUNT(DISTINCT s.position_code) AS position_count,
+    COALESCE(SUM(pos.headcount_capacity * s.share), 0) AS capacity,
+    COALESCE(SUM(pos.headcount_in_use * s.share), 0) AS in_use,
+    COALESCE(SUM(COALESCE(fte.fte, 0) * s.share), 0) AS assigned_fte
+FROM shares s
+JOIN pos ON pos.code = s.position_code
+LEFT JOIN fte ON fte.position_code = s.position_code
+LEFT JOIN cc ON cc.code = s.cost_center_code
+%[2]s
+GROUP BY s.cost_center_code, cc.name, cc.legal_entity_code
+ORDER BY s.cost_center_code
+`, asOfExpr, legalEntityFilter)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query cost center headcount: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.CostCenterHeadcount, 0)
+	for rows.Next() {
+		var (
+			item              dto.CostCenterHeadcount
+			name, legalEntity sql.NullString
+		)
+		if err := rows.Scan(
+			&item.CostCenterCodeField,
+			&name,
+			&legalEntity,
+			&item.PositionCountField,
+			&item.HeadcountCapacityField,
+			&item.HeadcountInUseField,
+			&item.AssignedFteField,
+		); err != nil {
+			return nil, fmt.Errorf("scan cost center headcount: %w", err)
+		}
+		if name.Valid {
+			item.CostCenterNameField = &name.String
+		}
+		if legalEntity.Valid {
+			item.LegalEntityCodeField = &legalEntity.String
+		}
+		item.AvailableHeadcountField = item.HeadcountCapacityField - item.HeadcountInUseField
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
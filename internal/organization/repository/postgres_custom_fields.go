@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/dto"
+)
+
+// buildCustomFieldConditions 将自定义字段过滤条件转换为针对 custom_fields JSONB 列的 SQL 片段。
+// 值存储为 {key: {type, value}}；比较运算在过滤值为数值时按 numeric 比较（仅匹配数值型存储值），否则按文本比较（DATE 为 ISO 格式，可直接比较）。
+func buildCustomFieldConditions(column string, filters []dto.CustomFieldFilter, args []interface{}, argIndex int) ([]string, []interface{}, int, error) {
+	conditions := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		key := strings.TrimSpace(filter.Key)
+		if key == "" {
+			return nil, nil, 0, fmt.Errorf("custom field filter key is required")
+		}
+		operator := strings.ToUpper(strings.TrimSpace(filter.Operator))
+		if operator == "" {
+			operator = dto.CustomFieldOpEquals
+		}
+
+		keyParam := argIndex
+		args = append(args, key)
+		argIndex++
+
+		if operator == dto.CustomFieldOpExists {
+			conditions = append(conditions, fmt.Sprintf("%s ? $%d", column, keyParam))
+			continue
+		}
+		if filter.Value == nil {
+			return nil, nil, 0, fmt.Errorf("custom field filter %q: value is required for operator %s", key, operator)
+		}
+		value := strings.TrimSpace(*filter.Value)
+		textExpr := fmt.Sprintf("(%s -> $%d ->> 'value')", column, keyParam)
+
+		switch operator {
+		case dto.CustomFieldOpEquals:
+			conditions = append(conditions, fmt.Sprintf("%s = $%d", textExpr, argIndex))
+			args = append(args, value)
+		case dto.CustomFieldOpNotEquals:
+			conditions = append(conditions, fmt.Sprintf("%s IS DISTINCT FROM $%d", textExpr, argIndex))
+			args = append(args, value)
+		case dto.CustomFieldOpContains:
+			conditions = append(conditions, fmt.Sprintf("%s ILIKE $%d", textExpr, argIndex))
+			args = append(args, "%"+value+"%")
+		case dto.CustomFieldOpGreaterThan, dto.CustomFieldOpGreaterOrEq, dto.CustomFieldOpLessThan, dto.CustomFieldOpLessOrEq:
+			sqlOp := map[string]string{
+				dto.CustomFieldOpGreaterThan: ">",
+				dto.CustomFieldOpGreaterOrEq: ">=",
+				dto.CustomFieldOpLessThan:    "<",
+				dto.CustomFieldOpLessOrEq:    "<=",
+			}[operator]
+			if filter.IsNumeric() {
+				conditions = append(conditions, fmt.Sprintf("CASE WHEN jsonb_typeof(%s -> $%d -> 'value') = 'number' THEN %s::numeric %s $%d::numeric ELSE FALSE END",
+					column, keyParam, textExpr, sqlOp, argIndex))
+			} else {
+				conditions = append(conditions, fmt.Sprintf("%s %s $%d", textExpr, sqlOp, argIndex))
+			}
+			args = append(args, value)
+		default:
+			return nil, nil, 0, fmt.Errorf("custom field filter %q: unsupported operator %s", key, operator)
+		}
+		argIndex++
+	}
+	return conditions, args, argIndex, nil
+}
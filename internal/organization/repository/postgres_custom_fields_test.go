@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"cube-castle/internal/organization/dto"
+)
+
+func TestBuildCustomFieldConditions(t *testing.T) {
+	budget, openedOn := "100", "2025-01-01"
+	filters := []dto.CustomFieldFilter{
+		{Key: "headcountBudget", Operator: dto.CustomFieldOpGreaterOrEq, Value: &budget},
+		{Key: "openedOn", Operator: dto.CustomFieldOpLessThan, Value: &openedOn},
+		{Key: "taxId", Operator: dto.CustomFieldOpExists},
+	}
+
+	conditions, args, next, err := buildCustomFieldConditions("p.custom_fields", filters, []interface{}{"tenant"}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(conditions) != 3 || next != 7 || len(args) != 6 {
+		t.Fatalf("unexpected result: conditions=%v args=%v next=%d", conditions, args, next)
+	}
+	if !strings.Contains(conditions[0], "::numeric >= $3::numeric") {
+		t.Fatalf("expected numeric comparison, got %s", conditions[0])
+	}
+	if conditions[1] != "(p.custom_fields -> $4 ->> 'value') < $5" {
+		t.Fatalf("expected text comparison, got %s", conditions[1])
+	}
+	if conditions[2] != "p.custom_fields ? $6" {
+		t.Fatalf("expected key existence check, got %s", conditions[2])
+	}
+
+	if _, _, _, err := buildCustomFieldConditions("p.custom_fields", []dto.CustomFieldFilter{{Key: "x", Operator: "LIKE", Value: &budget}}, nil, 1); err == nil {
+		t.Fatalf("expected unsupported operator error")
+	}
+	if _, _, _, err := buildCustomFieldConditions("p.custom_fields", []dto.CustomFieldFilter{{Key: "x"}}, nil, 1); err == nil {
+		t.Fatalf("expected missing value error")
+	}
+}
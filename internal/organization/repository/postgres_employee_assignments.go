@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/dto"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// GetEmployeeAssignmentViolations 列出指定日期（默认当天）违反员工跨职位约束的任职：
+// FTE 合计超过上限、同时存在多个 PRIMARY、同一组织内存在多个 ACTING。
+// 仅统计 PENDING/ACTIVE 任职，任职在 end_date/acting_until 当日起不再生效；组织取该日期生效的职位版本。
+func (r *PostgreSQLRepository) GetEmployeeAssignmentViolations(ctx context.Context, tenantID uuid.UUID, asOfDate *string) ([]dto.EmployeeAssignmentViolation, error) {
+	asOf := time.Now().UTC().Format("2006-01-02")
+	if asOfDate != nil && strings.TrimSpace(*asOfDate) != "" {
+		asOf = strings.TrimSpace(*asOfDate)
+	}
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String(), types.EmployeeMaxTotalFTE}, &asOf)
+
+	query := fmt.Sprintf(`
+WITH pos AS (
+    SELECT DISTINCT ON (code) code, organization_code
+    FROM positions
+    WHERE tenant_id = $1 AND effective_date <= %[1]s AND deleted_at IS NULL
+    ORDER BY code, effective_date DESC
+),
+eff AS (
+    SELECT pa.assignment_id, pa.employee_id, pa.employee_name, pa.position_code, pa.assignment_type, pa.fte, pos.organization_code
+    FROM position_assignments pa
+    LEFT JOIN pos ON pos.code = pa.position_code
+    WHERE pa.tenant_id = $1
+      AND pa.assignment_status IN ('PENDING', 'ACTIVE')
+      AND pa.effective_date <= %[1]s
+      AND (pa.end_date IS NULL OR pa.end_date > %[1]s)
+      AND (pa.acting_until IS NULL OR pa.acting_until > %[1]s)
+)
+SELECT 'EMPLOYEE_FTE_EXCEEDED' AS rule, employee_id, MAX(employee_name), NULL::text AS organization_code, SUM(fte),
+       array_agg(assignment_id::text ORDER BY position_code), array_agg(position_code ORDER BY position_code)
+FROM eff
+GROUP BY employee_id
+HAVING SUM(fte) > $2 + 1e-9
+UNION ALL
+SELECT 'EMPLOYEE_PRIMARY_CONFLICT', employee_id, MAX(employee_name), NULL::text, SUM(fte),
+       array_agg(assignment_id::text ORDER BY position_code), array_agg(position_code ORDER BY position_code)
+FROM eff
+WHERE assignment_type = 'PRIMARY'
+GROUP BY employee_id
+HAVING COUNT(*) > 1
+UNION ALL
+SELECT 'EMPLOYEE_ACTING_OVERLAP', employee_id, MAX(employee_name), organization_code, SUM(fte),
+       array_agg(assignment_id::text ORDER BY position_code), array_agg(position_code ORDER BY position_code)
+FROM eff
+WHERE assignment_type = 'ACTING'
+GROUP BY employee_id, organization_code
+HAVING COUNT(*) > 1
+ORDER BY 3, 2, 1, 4
+`, asOfExpr)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query employee assignment violations: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.EmployeeAssignmentViolation, 0)
+	for rows.Next() {
+		var (
+			item             dto.EmployeeAssignmentViolation
+			organizationCode sql.NullString
+		)
+		if err := rows.Scan(
+			&item.RuleField,
+			&item.EmployeeIDField,
+			&item.EmployeeNameField,
+			&organizationCode,
+			&item.TotalFteField,
+			pq.Array(&item.AssignmentIDsField),
+			pq.Array(&item.PositionCodesField),
+		); err != nil {
+			return nil, fmt.Errorf("scan employee assignment violation: %w", err)
+		}
+		item.OrganizationCodeField = nullStringPtr(organizationCode)
+		item.MaxFteField = types.EmployeeMaxTotalFTE
+		item.AsOfDateField = asOf
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestGetEmployeeAssignmentViolations(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPostgreSQLRepository(db, nil, nil, AuditHistoryConfig{})
+	tenant := uuid.New()
+	employee := uuid.NewString()
+	asOf := "2025-11-06"
+
+	mock.ExpectQuery("FROM position_assignments pa").
+		WithArgs(tenant.String(), 1.0, asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"rule", "employee_id", "employee_name", "organization_code", "sum", "assignment_ids", "position_codes"}).
+			AddRow("EMPLOYEE_FTE_EXCEEDED", employee, "Jane", nil, 1.5, pq.StringArray{"a1", "a2"}, pq.StringArray{"P1000001", "P1000002"}).
+			AddRow("EMPLOYEE_ACTING_OVERLAP", employee, "Jane", "1000001", 0.4, pq.StringArray{"a3", "a4"}, pq.StringArray{"P1000003", "P1000004"}))
+
+	violations, err := repo.GetEmployeeAssignmentViolations(context.Background(), tenant, &asOf)
+	if err != nil {
+		t.Fatalf("GetEmployeeAssignmentViolations err: %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %#v", violations)
+	}
+	fte, acting := violations[0], violations[1]
+	if fte.Rule() != "EMPLOYEE_FTE_EXCEEDED" || fte.TotalFte() != 1.5 || fte.MaxFte() != 1.0 || fte.OrganizationCode() != nil || fte.AsOfDate() != asOf || len(fte.PositionCodes()) != 2 {
+		t.Fatalf("unexpected FTE violation: %#v", fte)
+	}
+	if acting.Rule() != "EMPLOYEE_ACTING_OVERLAP" || acting.OrganizationCode() == nil || *acting.OrganizationCode() != "1000001" || string(acting.EmployeeId()) != employee {
+		t.Fatalf("unexpected acting violation: %#v", acting)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestGetEmployeeAssignmentViolationsSkipsEndedAssignments(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPostgreSQLRepository(db, nil, nil, AuditHistoryConfig{})
+	tenant := uuid.New()
+	asOf := "2025-11-06"
+
+	mock.ExpectQuery(regexp.QuoteMeta("AND pa.assignment_status IN ('PENDING', 'ACTIVE')")).
+		WithArgs(tenant.String(), 1.0, asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"rule", "employee_id", "employee_name", "organization_code", "sum", "assignment_ids", "position_codes"}))
+
+	violations, err := repo.GetEmployeeAssignmentViolations(context.Background(), tenant, &asOf)
+	if err != nil {
+		t.Fatalf("GetEmployeeAssignmentViolations err: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations, got %#v", violations)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -39,6 +39,7 @@ SELECT DISTINCT ON (family_group_code)
     tenant_id::text,
     family_group_code,
     name,
+    name_i18n,
     description,
     status,
     effective_date,
@@ -68,6 +69,7 @@ ORDER BY family_group_code, effective_date DESC, created_at DESC
 			&item.TenantIDField,
 			&item.CodeField,
 			&item.NameField,
+			&item.NameI18nField,
 			&desc,
 			&item.StatusField,
 			&item.EffectiveDateField,
@@ -118,6 +120,7 @@ SELECT DISTINCT ON (family_code)
     tenant_id::text,
     family_code,
     name,
+    name_i18n,
     description,
     status,
     effective_date,
@@ -148,6 +151,7 @@ ORDER BY family_code, effective_date DESC, created_at DESC
 			&item.TenantIDField,
 			&item.CodeField,
 			&item.NameField,
+			&item.NameI18nField,
 			&desc,
 			&item.StatusField,
 			&item.EffectiveDateField,
@@ -199,6 +203,7 @@ SELECT DISTINCT ON (role_code)
     tenant_id::text,
     role_code,
     name,
+    name_i18n,
     description,
     status,
     effective_date,
@@ -229,6 +234,7 @@ ORDER BY role_code, effective_date DESC, created_at DESC
 			&item.TenantIDField,
 			&item.CodeField,
 			&item.NameField,
+			&item.NameI18nField,
 			&desc,
 			&item.StatusField,
 			&item.EffectiveDateField,
@@ -280,6 +286,7 @@ SELECT DISTINCT ON (level_code)
     tenant_id::text,
     level_code,
     name,
+    name_i18n,
     description,
     status,
     effective_date,
@@ -311,6 +318,7 @@ ORDER BY level_code, effective_date DESC, created_at DESC
 			&item.TenantIDField,
 			&item.CodeField,
 			&item.NameField,
+			&item.NameI18nField,
 			&desc,
 			&item.StatusField,
 			&item.EffectiveDateField,
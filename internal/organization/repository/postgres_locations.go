@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	locationSourcePosition     = "POSITION"
+	locationSourceOrganization = "ORGANIZATION_UNIT"
+)
+
+// organizationLocationSQL 组织单元在 asOfExpr 日期的默认地点编码（最近一条分配，清除时为 NULL）
+func organizationLocationSQL(tenantExpr, codeExpr, asOfExpr string) string {
+	return fmt.Sprintf(`(
+        SELECT la.location_code FROM location_assignments la
+        WHERE la.tenant_id = %[1]s AND la.subject_type = 'ORGANIZATION_UNIT' AND la.subject_code = %[2]s
+          AND la.effective_date <= %[3]s
+        ORDER BY la.effective_date DESC LIMIT 1
+    )`, tenantExpr, codeExpr, asOfExpr)
+}
+
+// positionLocationSQL 职位在 asOfExpr 日期的生效地点编码：职位覆盖优先，未覆盖或已清除时继承所属组织单元默认地点
+func positionLocationSQL(tenantExpr, alias, asOfExpr string) string {
+	return fmt.Sprintf(`COALESCE((
+        SELECT la.location_code FROM location_assignments la
+        WHERE la.tenant_id = %[1]s AND la.subject_type = 'POSITION' AND la.subject_code = %[2]s.code
+          AND la.effective_date <= %[3]s
+        ORDER BY la.effective_date DESC LIMIT 1
+    ), %[4]s)`, tenantExpr, alias, asOfExpr, organizationLocationSQL(tenantExpr, alias+".organization_code", asOfExpr))
+}
+
+// locationMatchSQL 判断地点编码表达式等于 codeParam 或位于以其为根的区域之下（按 asOfExpr 日期的区域层级）
+func locationMatchSQL(locationExpr, tenantExpr, codeParam, asOfExpr string) string {
+	return fmt.Sprintf(`%[1]s IN (
+        WITH RECURSIVE loc AS (
+            SELECT DISTINCT ON (code) code, parent_code
+            FROM work_locations
+            WHERE tenant_id = %[2]s AND effective_date <= %[4]s
+            ORDER BY code, effective_date DESC
+        ), tree AS (
+            SELECT %[3]s::varchar AS code
+            UNION
+            SELECT loc.code FROM loc JOIN tree ON loc.parent_code = tree.code
+        )
+        SELECT code FROM tree
+    )`, locationExpr, tenantExpr, codeParam, asOfExpr)
+}
+
+// GetLocations 查询指定日期生效的地点版本；版本结束日期由下一版本生效日期推导。
+func (r *PostgreSQLRepository) GetLocations(ctx context.Context, tenantID uuid.UUID, filter dto.LocationFilter) ([]dto.Location, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String()}, filter.AsOfDate)
+	whereParts := []string{
+		fmt.Sprintf("effective_date <= %s", asOfExpr),
+		fmt.Sprintf("(end_date IS NULL OR end_date >= %s)", asOfExpr),
+	}
+	if !filter.IncludeInactive {
+		whereParts = append(whereParts, "status = 'ACTIVE'")
+	}
+	if filter.Type != nil && strings.TrimSpace(*filter.Type) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.Type)))
+		whereParts = append(whereParts, fmt.Sprintf("location_type = $%d", len(args)))
+	}
+	if filter.ParentCode != nil && strings.TrimSpace(*filter.ParentCode) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.ParentCode)))
+		whereParts = append(whereParts, fmt.Sprintf("parent_code = $%d", len(args)))
+	}
+	if filter.CountryCode != nil && strings.TrimSpace(*filter.CountryCode) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.CountryCode)))
+		whereParts = append(whereParts, fmt.Sprintf("country_code = $%d", len(args)))
+	}
+	return r.queryLocations(ctx, args, whereParts)
+}
+
+func (r *PostgreSQLRepository) queryLocations(ctx context.Context, args []interface{}, whereParts []string) ([]dto.Location, error) {
+	query := fmt.Sprintf(`
+SELECT
+    record_id::text,
+    code,
+    name,
+    location_type,
+    status,
+    parent_code,
+    country_code,
+    time_zone,
+    address_line1,
+    address_line2,
+    city,
+    state_province,
+    postal_code,
+    effective_date,
+    end_date,
+    (effective_date <= CURRENT_DATE AND (end_date IS NULL OR end_date >= CURRENT_DATE)) AS is_current
+FROM (
+    SELECT l.*,
+           (LEAD(effective_date) OVER (PARTITION BY code ORDER BY effective_date) - 1) AS end_date
+    FROM work_locations l
+    WHERE tenant_id = $1
+) versions
+WHERE %s
+ORDER BY location_type, code
+`, strings.Join(whereParts, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query locations: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.Location, 0)
+	for rows.Next() {
+		var (
+			item                           dto.Location
+			parent, country, timeZone      sql.NullString
+			line1, line2, city, state, zip sql.NullString
+			effectiveDate                  time.Time
+			endDate                        sql.NullTime
+		)
+		if err := rows.Scan(
+			&item.RecordIDField,
+			&item.CodeField,
+			&item.NameField,
+			&item.TypeField,
+			&item.StatusField,
+			&parent,
+			&country,
+			&timeZone,
+			&line1,
+			&line2,
+			&city,
+			&state,
+			&zip,
+			&effectiveDate,
+			&endDate,
+			&item.IsCurrentField,
+		); err != nil {
+			return nil, fmt.Errorf("scan location: %w", err)
+		}
+		item.ParentCodeField = nullStringPtr(parent)
+		item.CountryCodeField = nullStringPtr(country)
+		item.TimeZoneField = nullStringPtr(timeZone)
+		if line1.Valid || line2.Valid || city.Valid || state.Valid || zip.Valid {
+			item.AddressField = &dto.LocationAddress{
+				Line1Field:         nullStringPtr(line1),
+				Line2Field:         nullStringPtr(line2),
+				CityField:          nullStringPtr(city),
+				StateProvinceField: nullStringPtr(state),
+				PostalCodeField:    nullStringPtr(zip),
+			}
+		}
+		item.EffectiveDateField = dto.Date(effectiveDate.Format("2006-01-02"))
+		if endDate.Valid {
+			end := dto.Date(endDate.Time.Format("2006-01-02"))
+			item.EndDateField = &end
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// GetEffectiveLocation 查询组织单元或职位在指定日期的生效地点；职位未覆盖时继承所属组织单元默认地点，均未设置时返回 nil。
+func (r *PostgreSQLRepository) GetEffectiveLocation(ctx context.Context, tenantID uuid.UUID, subjectType, code string, asOfDate *string) (*dto.EffectiveLocation, error) {
+	subjectCode := code
+	if subjectType == locationSourcePosition {
+		locationCode, effectiveDate, err := r.latestLocationAssignment(ctx, tenantID, locationSourcePosition, code, asOfDate)
+		if err != nil {
+			return nil, err
+		}
+		if locationCode != "" {
+			return r.effectiveLocation(ctx, tenantID, locationCode, locationSourcePosition, effectiveDate, asOfDate)
+		}
+		err = r.db.QueryRowContext(ctx, `
+SELECT organization_code FROM positions
+WHERE tenant_id = $1 AND code = $2 AND is_current = true AND deleted_at IS NULL`, tenantID.String(), code).Scan(&subjectCode)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("query position organization: %w", err)
+		}
+	}
+
+	locationCode, effectiveDate, err := r.latestLocationAssignment(ctx, tenantID, locationSourceOrganization, subjectCode, asOfDate)
+	if err != nil || locationCode == "" {
+		return nil, err
+	}
+	return r.effectiveLocation(ctx, tenantID, locationCode, locationSourceOrganization, effectiveDate, asOfDate)
+}
+
+// latestLocationAssignment 返回对象在查询日期最近一条分配的地点编码与生效日期；无分配或已清除时编码为空
+func (r *PostgreSQLRepository) latestLocationAssignment(ctx context.Context, tenantID uuid.UUID, subjectType, code string, asOfDate *string) (string, time.Time, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String(), subjectType, code}, asOfDate)
+	query := fmt.Sprintf(`
+SELECT location_code, effective_date
+FROM location_assignments
+WHERE tenant_id = $1 AND subject_type = $2 AND subject_code = $3 AND effective_date <= %s
+ORDER BY effective_date DESC
+LIMIT 1
+`, asOfExpr)
+
+	var (
+		locationCode  sql.NullString
+		effectiveDate time.Time
+	)
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&locationCode, &effectiveDate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", time.Time{}, nil
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("query location assignment: %w", err)
+	}
+	return locationCode.String, effectiveDate, nil
+}
+
+func (r *PostgreSQLRepository) effectiveLocation(ctx context.Context, tenantID uuid.UUID, locationCode, source string, effectiveDate time.Time, asOfDate *string) (*dto.EffectiveLocation, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String(), locationCode}, asOfDate)
+	locations, err := r.queryLocations(ctx, args, []string{
+		"code = $2",
+		fmt.Sprintf("effective_date <= %s", asOfExpr),
+		fmt.Sprintf("(end_date IS NULL OR end_date >= %s)", asOfExpr),
+	})
+	if err != nil || len(locations) == 0 {
+		return nil, err
+	}
+	return &dto.EffectiveLocation{
+		LocationField:      locations[0],
+		SourceField:        source,
+		EffectiveDateField: dto.Date(effectiveDate.Format("2006-01-02")),
+	}, nil
+}
+
+// GetLocationHeadcount 按生效地点汇总当前职位的编制、占用与在岗 FTE；
+// locationCode 为区域时汇总其下全部地点，未分配地点的职位不计入。
+func (r *PostgreSQLRepository) GetLocationHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, locationCode *string) ([]dto.LocationHeadcount, error) {
+	args, asOfExpr := asOfDateArg([]interface{}{tenantID.String()}, asOfDate)
+	locationFilter := ""
+	if locationCode != nil && strings.TrimSpace(*locationCode) != "" {
+		args = append(args, strings.ToUpper(strings.TrimSpace(*locationCode)))
+		locationFilter = "AND " + locationMatchSQL("pos.location_code", "$1", fmt.Sprintf("$%d", len(args)), asOfExpr)
+	}
+
+	query := fmt.Sprintf(`
+WITH pos AS (
+    SELECT p.code, p.headcount_capacity, p.headcount_in_use, %[2]s AS location_code
+    FROM positions p
+    WHERE p.tenant_id = $1 AND p.is_current = true AND p.status <> 'DELETED' AND p.deleted_at IS NULL
+),
+fte AS (
+    SELECT position_code, SUM(fte) AS fte
+    FROM position_assignments
+    WHERE tenant_id = $1 AND assignment_status = 'ACTIVE'
+      AND effective_date <= %[1]s AND (end_date IS NULL OR end_date > %[1]s)
+    GROUP BY position_code
+),
+loc AS (
+    SELECT DISTINCT ON (code) code, name, country_code, time_zone
+    FROM work_locations
+    WHERE tenant_id = $1 AND effective_date <= %[1]s
+    ORDER BY code, effective_date DESC
+)
+SELECT
+    pos.location_code,
+    loc.name,
+    loc.country_code,
+    loc.time_zone,
+    COUNT(*) AS position_count,
+    COALESCE(SUM(pos.headcount_capacity), 0) AS capacity,
+    COALESCE(SUM(pos.headcount_in_use), 0) AS in_use,
+    COALESCE(SUM(fte.fte), 0) AS assigned_fte
+FROM pos
+LEFT JOIN fte ON fte.position_code = pos.code
+LEFT JOIN loc ON loc.code = pos.location_code
+WHERE pos.location_code IS NOT NULL %[3]s
+GROUP BY pos.location_code, loc.name, loc.country_code, loc.time_zone
+ORDER BY pos.location_code
+`, asOfExpr, positionLocationSQL("$1", "p", asOfExpr), locationFilter)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query location headcount: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]dto.LocationHeadcount, 0)
+	for rows.Next() {
+		var (
+			item                    dto.LocationHeadcount
+			name, country, timeZone sql.NullString
+		)
+		if err := rows.Scan(
+			&item.LocationCodeField,
+			&name,
+			&country,
+			&timeZone,
+			&item.PositionCountField,
+			&item.HeadcountCapacityField,
+			&item.HeadcountInUseField,
+			&item.AssignedFteField,
+		); err != nil {
+			return nil, fmt.Errorf("scan location headcount: %w", err)
+		}
+		item.LocationNameField = nullStringPtr(name)
+		item.CountryCodeField = nullStringPtr(country)
+		item.TimeZoneField = nullStringPtr(timeZone)
+		item.AvailableHeadcountField = item.HeadcountCapacityField - item.HeadcountInUseField
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func TestLocationSQLHelpers(t *testing.T) {
+	expr := positionLocationSQL("$1", "p", "CURRENT_DATE")
+	if !strings.HasPrefix(expr, "COALESCE(") ||
+		!strings.Contains(expr, "la.subject_type = 'POSITION' AND la.subject_code = p.code") ||
+		!strings.Contains(expr, "la.subject_type = 'ORGANIZATION_UNIT' AND la.subject_code = p.organization_code") {
+		t.Fatalf("position override must fall back to the organization default: %s", expr)
+	}
+	match := locationMatchSQL("loc_code", "$1", "$4", "$3::date")
+	if !strings.HasPrefix(match, "loc_code IN (") || !strings.Contains(match, "WITH RECURSIVE") ||
+		!strings.Contains(match, "SELECT $4::varchar AS code") || !strings.Contains(match, "effective_date <= $3::date") {
+		t.Fatalf("unexpected location match condition: %s", match)
+	}
+}
+
+func TestGetEffectiveLocation_PositionInheritsOrganizationDefault(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPostgreSQLRepository(db, nil, nil, AuditHistoryConfig{})
+	tenant := uuid.New()
+	assigned := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// 职位覆盖已清除
+	mock.ExpectQuery("FROM location_assignments").
+		WithArgs(tenant.String(), "POSITION", "P1000001").
+		WillReturnRows(sqlmock.NewRows([]string{"location_code", "effective_date"}).AddRow(nil, assigned))
+	mock.ExpectQuery("SELECT organization_code FROM positions").
+		WithArgs(tenant.String(), "P1000001").
+		WillReturnRows(sqlmock.NewRows([]string{"organization_code"}).AddRow("1000001"))
+	mock.ExpectQuery("FROM location_assignments").
+		WithArgs(tenant.String(), "ORGANIZATION_UNIT", "1000001").
+		WillReturnRows(sqlmock.NewRows([]string{"location_code", "effective_date"}).AddRow("BER-01", assigned))
+	mock.ExpectQuery("FROM work_locations").
+		WithArgs(tenant.String(), "BER-01").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"record_id", "code", "name", "location_type", "status", "parent_code", "country_code", "time_zone",
+			"address_line1", "address_line2", "city", "state_province", "postal_code", "effective_date", "end_date", "is_current",
+		}).AddRow(uuid.NewString(), "BER-01", "Berlin", "SITE", "ACTIVE", "EMEA", "DE", "Europe/Berlin",
+			nil, nil, "Berlin", nil, nil, assigned, nil, true))
+
+	loc, err := repo.GetEffectiveLocation(context.Background(), tenant, "POSITION", "P1000001", nil)
+	if err != nil {
+		t.Fatalf("GetEffectiveLocation err: %v", err)
+	}
+	if loc == nil || loc.Source() != "ORGANIZATION_UNIT" || loc.Location().Code() != "BER-01" || loc.EffectiveDate() != "2025-03-01" {
+		t.Fatalf("unexpected effective location: %#v", loc)
+	}
+	if addr := loc.Location().Address(); addr == nil || addr.City() == nil || *addr.City() != "Berlin" || addr.Line1() != nil {
+		t.Fatalf("unexpected address: %#v", addr)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -20,7 +20,8 @@ func (r *PostgreSQLRepository) GetOrganization(ctx context.Context, tenantID uui
                COALESCE(name_path, '/' || name) AS name_path,
                sort_order, description, profile, created_at, updated_at,
                effective_date, end_date, is_current, change_reason,
-               deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason
+               deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+               custom_fields, name_i18n, name_path_i18n
         FROM organization_units 
         WHERE tenant_id = $1 AND code = $2 AND is_current = true AND status <> 'DELETED'
         LIMIT 1`
@@ -40,6 +41,7 @@ func (r *PostgreSQLRepository) GetOrganization(ctx context.Context, tenantID uui
 		&org.EffectiveDateField, &org.EndDateField, &org.IsCurrentField,
 		&org.ChangeReasonField, &org.DeletedAtField, &org.DeletedByField, &org.DeletionReasonField,
 		&org.SuspendedAtField, &org.SuspendedByField, &org.SuspensionReasonField,
+		&org.CustomFieldsField, &org.NameI18nField, &org.NamePathI18nField,
 	)
 
 	if err != nil {
@@ -72,6 +74,7 @@ func (r *PostgreSQLRepository) GetOrganizationAtDate(ctx context.Context, tenant
                 sort_order, description, profile, created_at, updated_at,
                 effective_date, end_date, is_current, change_reason,
                 deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+                custom_fields, name_i18n, name_path_i18n,
                 LEAD(effective_date) OVER (PARTITION BY tenant_id, code ORDER BY effective_date) AS next_effective
             FROM organization_units 
             WHERE tenant_id = $1 AND code = $2 
@@ -83,14 +86,16 @@ func (r *PostgreSQLRepository) GetOrganizationAtDate(ctx context.Context, tenant
                 effective_date,
                 COALESCE(end_date, (next_effective - INTERVAL '1 day')::date) AS computed_end_date,
                 is_current, change_reason,
-                deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason
+                deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+                custom_fields, name_i18n, name_path_i18n
             FROM hist
         )
         SELECT 
             record_id, tenant_id, code, parent_code, name, unit_type, status,
             level, code_path, name_path, sort_order, description, profile, created_at, updated_at,
                effective_date, computed_end_date AS end_date, is_current, change_reason,
-            deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason
+            deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+            custom_fields, name_i18n, name_path_i18n
         FROM proj
         WHERE effective_date <= $3::date 
           AND (computed_end_date IS NULL OR computed_end_date >= $3::date)
@@ -114,6 +119,7 @@ func (r *PostgreSQLRepository) GetOrganizationAtDate(ctx context.Context, tenant
 		&org.EffectiveDateField, &org.EndDateField, &org.IsCurrentField, &isTemporal,
 		&org.ChangeReasonField, &org.DeletedAtField, &org.DeletedByField, &org.DeletionReasonField,
 		&org.SuspendedAtField, &org.SuspendedByField, &org.SuspensionReasonField,
+		&org.CustomFieldsField, &org.NameI18nField, &org.NamePathI18nField,
 	)
 
 	if err != nil {
@@ -144,6 +150,7 @@ func (r *PostgreSQLRepository) GetOrganizationHistory(ctx context.Context, tenan
                 sort_order, description, profile, created_at, updated_at,
                 effective_date, end_date, is_current, is_temporal, change_reason,
                 deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+                custom_fields, name_i18n, name_path_i18n,
                 LEAD(effective_date) OVER (PARTITION BY tenant_id, code ORDER BY effective_date) AS next_effective
             FROM organization_units 
             WHERE tenant_id = $1 AND code = $2 
@@ -155,14 +162,16 @@ func (r *PostgreSQLRepository) GetOrganizationHistory(ctx context.Context, tenan
                 effective_date,
                 COALESCE(end_date, (next_effective - INTERVAL '1 day')::date) AS computed_end_date,
                 is_current, is_temporal, change_reason,
-                deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason
+                deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+                custom_fields, name_i18n, name_path_i18n
             FROM hist
         )
         SELECT 
             record_id, tenant_id, code, parent_code, name, unit_type, status,
             level, code_path, name_path, sort_order, description, profile, created_at, updated_at,
             effective_date, computed_end_date AS end_date, is_current, is_temporal, change_reason,
-            deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason
+            deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+            custom_fields, name_i18n, name_path_i18n
         FROM proj
         WHERE effective_date <= $4::date
           AND (computed_end_date IS NULL OR computed_end_date >= $3::date)
@@ -192,6 +201,7 @@ func (r *PostgreSQLRepository) GetOrganizationHistory(ctx context.Context, tenan
 			&org.EffectiveDateField, &org.EndDateField, &org.IsCurrentField, new(bool),
 			&org.ChangeReasonField, &org.DeletedAtField, &org.DeletedByField, &org.DeletionReasonField,
 			&org.SuspendedAtField, &org.SuspendedByField, &org.SuspensionReasonField,
+			&org.CustomFieldsField, &org.NameI18nField, &org.NamePathI18nField,
 		)
 		if err != nil {
 			log.WithFields(pkglogger.Fields{"error": err}).Error("organization history scan failed")
@@ -227,7 +237,7 @@ func (r *PostgreSQLRepository) GetOrganizationVersions(ctx context.Context, tena
 		       sort_order, description, profile, created_at, updated_at,
 	           effective_date, end_date, is_current, change_reason,
 	           deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
-	           hierarchy_depth
+	           hierarchy_depth, custom_fields, name_i18n, name_path_i18n
 		FROM organization_units
 		WHERE tenant_id = $1 AND code = $2`
 
@@ -258,7 +268,7 @@ func (r *PostgreSQLRepository) GetOrganizationVersions(ctx context.Context, tena
 			&org.EffectiveDateField, &org.EndDateField, &org.IsCurrentField,
 			&org.ChangeReasonField, &org.DeletedAtField, &org.DeletedByField, &org.DeletionReasonField,
 			&org.SuspendedAtField, &org.SuspendedByField, &org.SuspensionReasonField,
-			&org.HierarchyDepthField,
+			&org.HierarchyDepthField, &org.CustomFieldsField, &org.NameI18nField, &org.NamePathI18nField,
 		)
 		if err != nil {
 			log.WithFields(pkglogger.Fields{"error": err}).Error("organization versions scan failed")
@@ -132,6 +132,7 @@ latest_versions AS (
         level, sort_order, description, profile, created_at, updated_at,
         effective_date, end_date, is_current, change_reason,
         deleted_at, deleted_by, deletion_reason, suspended_at, suspended_by, suspension_reason,
+        custom_fields, name_i18n, name_path_i18n,
         COALESCE(code_path, '/' || code) AS code_path,
         COALESCE(name_path, '/' || name) AS name_path
     FROM organization_units
@@ -151,7 +152,7 @@ SELECT lv.record_id, lv.tenant_id, lv.code, lv.parent_code, lv.name, lv.unit_typ
        lv.level, lv.code_path, lv.name_path, lv.sort_order, lv.description, lv.profile, lv.created_at, lv.updated_at,
        lv.effective_date, lv.end_date, lv.is_current, lv.change_reason,
        lv.deleted_at, lv.deleted_by, lv.deletion_reason, lv.suspended_at, lv.suspended_by, lv.suspension_reason,
-       COALESCE(child_stats.child_count, 0) AS children_count
+       COALESCE(child_stats.child_count, 0) AS children_count, lv.custom_fields, lv.name_i18n, lv.name_path_i18n
 FROM latest_versions lv
 LEFT JOIN parent_path pp ON TRUE
 LEFT JOIN LATERAL (
@@ -231,6 +232,28 @@ WHERE 1=1`
 		argIndex++
 	}
 
+	if filter != nil && len(filter.CustomFields) > 0 {
+		conditions, nextArgs, nextIndex, err := buildCustomFieldConditions("lv.custom_fields", filter.CustomFields, args, argIndex)
+		if err != nil {
+			log.WithFields(pkglogger.Fields{"error": err}).Warn("invalid custom field filter")
+			return nil, err
+		}
+		for _, condition := range conditions {
+			whereConditions += " AND " + condition
+		}
+		args, argIndex = nextArgs, nextIndex
+		logFields["customFieldFilters"] = len(filter.CustomFields)
+	}
+
+	if filter != nil && filter.LocationCode != nil && strings.TrimSpace(*filter.LocationCode) != "" {
+		asOfExpr := "COALESCE($2::date, CURRENT_DATE)"
+		locationExpr := organizationLocationSQL("lv.tenant_id", "lv.code", asOfExpr)
+		whereConditions += " AND " + locationMatchSQL(locationExpr, "$1", fmt.Sprintf("$%d", argIndex), asOfExpr)
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.LocationCode)))
+		argIndex++
+		logFields["locationCode"] = strings.ToUpper(strings.TrimSpace(*filter.LocationCode))
+	}
+
 	countQuery := cte + countSelect + whereConditions
 	countArgs := append([]interface{}{}, args...)
 
@@ -261,6 +284,7 @@ WHERE 1=1`
 			&org.EffectiveDateField, &org.EndDateField, &org.IsCurrentField,
 			&org.ChangeReasonField, &org.DeletedAtField, &org.DeletedByField, &org.DeletionReasonField,
 			&org.SuspendedAtField, &org.SuspendedByField, &org.SuspensionReasonField, &org.ChildrenCountField,
+			&org.CustomFieldsField, &org.NameI18nField, &org.NamePathI18nField,
 		); err != nil {
 			log.WithFields(pkglogger.Fields{"error": err}).Error("organization list scan failed")
 			return nil, err
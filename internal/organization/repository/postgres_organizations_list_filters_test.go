@@ -29,6 +29,7 @@ func TestPostgreSQLRepository_GetOrganizations_FilterBranches(t *testing.T) {
 	exclDesc := "1000000"
 	includeCodes := []string{"1000001", "1000002"}
 	excludeCodes := []string{"1000099"}
+	costCenter := "CC"
 
 	filter := &dto.OrganizationFilter{
 		Status:                   &status,
@@ -40,6 +41,10 @@ func TestPostgreSQLRepository_GetOrganizations_FilterBranches(t *testing.T) {
 		Codes:                    &includeCodes,
 		ExcludeCodes:             &excludeCodes,
 		IncludeDisabledAncestors: true,
+		CustomFields: []dto.CustomFieldFilter{
+			{Key: "costCenter", Operator: dto.CustomFieldOpContains, Value: &costCenter},
+			{Key: "legalEntity", Operator: dto.CustomFieldOpExists},
+		},
 	}
 
 	// Count query
@@ -70,21 +75,21 @@ func TestPostgreSQLRepository_GetOrganizations_FilterBranches(t *testing.T) {
 		"description", "profile", "created_at", "updated_at",
 		"effective_date", "end_date", "is_current",
 		"change_reason", "deleted_at", "deleted_by", "deletion_reason",
-		"suspended_at", "suspended_by", "suspension_reason", "children_count",
+		"suspended_at", "suspended_by", "suspension_reason", "children_count", "custom_fields", "name_i18n", "name_path_i18n",
 	}).AddRow(
 		"rec-1", tenantID, "1000001", parentCode, "技术一部",
 		unitType, status, 2, "/1000001", "/技术一部", sortOrder,
 		desc, profile, now, now,
 		eff, endDate, isCurrent,
 		changeReason, deletedAt, deletedBy, deletionReason,
-		suspendAt, suspendBy, suspendReason, 0,
+		suspendAt, suspendBy, suspendReason, 0, []byte(`{"costCenter":{"type":"STRING","value":"CC-01"}}`), []byte(`{"en":"Engineering"}`), []byte(`{"en":"/Engineering"}`),
 	).AddRow(
 		"rec-2", tenantID, "1000002", parentCode, "技术二部",
 		unitType, status, 2, "/1000002", "/技术二部", sortOrder,
 		desc, profile, now, now,
 		eff, endDate, isCurrent,
 		changeReason, deletedAt, deletedBy, deletionReason,
-		suspendAt, suspendBy, suspendReason, 0,
+		suspendAt, suspendBy, suspendReason, 0, []byte(`{}`), nil, nil,
 	)
 	mock.ExpectQuery("WITH parent_path").
 		WillReturnRows(rows)
@@ -99,6 +104,12 @@ func TestPostgreSQLRepository_GetOrganizations_FilterBranches(t *testing.T) {
 	if got.DataField[0].CodeField != "1000001" || got.DataField[1].CodeField != "1000002" {
 		t.Fatalf("unexpected codes order: %v", got.DataField)
 	}
+	if fields := got.DataField[0].CustomFields(); len(fields) != 1 || fields[0].Key() != "costCenter" || *fields[0].StringValue() != "CC-01" {
+		t.Fatalf("unexpected custom fields: %#v", fields)
+	}
+	if fields := got.DataField[1].CustomFields(); len(fields) != 0 {
+		t.Fatalf("expected no custom fields, got %#v", fields)
+	}
 
 	if e := mock.ExpectationsWereMet(); e != nil {
 		t.Fatalf("unmet expectations: %v", e)
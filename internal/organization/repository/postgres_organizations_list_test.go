@@ -61,14 +61,14 @@ func TestPostgreSQLRepository_GetOrganizations_Minimal(t *testing.T) {
 		"description", "profile", "created_at", "updated_at",
 		"effective_date", "end_date", "is_current",
 		"change_reason", "deleted_at", "deleted_by", "deletion_reason",
-		"suspended_at", "suspended_by", "suspension_reason", "children_count",
+		"suspended_at", "suspended_by", "suspension_reason", "children_count", "custom_fields", "name_i18n", "name_path_i18n",
 	}).AddRow(
 		recordID, tenantID, code, parentCode, name,
 		unitType, status, level, codePath, namePath, sortOrder,
 		desc, profile, created, updated,
 		eff, endDate, isCurrent,
 		changeReason, deletedAt, deletedBy, deletionReason,
-		suspendAt, suspendBy, suspendReason, childrenCount,
+		suspendAt, suspendBy, suspendReason, childrenCount, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("WITH parent_path").
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/dto"
+	"cube-castle/internal/organization/lifecycle"
+	"github.com/google/uuid"
+)
+
+// GetPositionAllowedTransitions 按租户生效的状态机列出职位当前状态下可手动执行的事件；
+// 守卫条件不满足的事件以 allowed=false 返回并附带未满足的守卫，职位不存在时返回空列表
+func (r *PostgreSQLRepository) GetPositionAllowedTransitions(ctx context.Context, tenantID uuid.UUID, code string) ([]dto.PositionTransition, error) {
+	var facts lifecycle.Facts
+	err := r.db.QueryRowContext(ctx, `
+        SELECT p.status, p.headcount_capacity, COALESCE((
+            SELECT SUM(pa.fte) FROM position_assignments pa
+            WHERE pa.tenant_id = p.tenant_id AND pa.position_code = p.code
+              AND pa.assignment_status = 'ACTIVE' AND pa.is_current = true
+        ), 0)
+        FROM positions p
+        WHERE p.tenant_id = $1 AND p.code = $2 AND p.is_current = true AND p.deleted_at IS NULL`,
+		tenantID.String(), strings.TrimSpace(code)).Scan(&facts.Status, &facts.HeadcountCapacity, &facts.ActiveFTE)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []dto.PositionTransition{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load position lifecycle facts: %w", err)
+	}
+
+	overrides, err := lifecycle.QueryTransitions(ctx, r.db, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	decisions := lifecycle.NewMachine(lifecycle.Effective(overrides)).Available(lifecycle.EntityPosition, facts)
+
+	transitions := make([]dto.PositionTransition, 0, len(decisions))
+	for _, d := range decisions {
+		transitions = append(transitions, dto.PositionTransition{
+			EventField:        d.Transition.Event,
+			FromStatusField:   strings.ToUpper(strings.TrimSpace(facts.Status)),
+			ToStatusField:     d.Transition.ToStatus,
+			AllowedField:      d.Allowed,
+			GuardsField:       append([]string{}, d.Transition.Guards...),
+			FailedGuardsField: d.FailedGuards,
+			SideEffectsField:  append([]string{}, d.Transition.SideEffects...),
+			BuiltInField:      d.Transition.BuiltIn,
+		})
+	}
+	return transitions, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestGetPositionAllowedTransitions_AppliesGuardsAndOverrides(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPostgreSQLRepository(db, nil, nil, AuditHistoryConfig{})
+	tenant := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("FROM positions p").
+		WithArgs(tenant.String(), "P1000001").
+		WillReturnRows(sqlmock.NewRows([]string{"status", "headcount_capacity", "active_fte"}).AddRow("FILLED", 1.0, 1.0))
+	mock.ExpectQuery("FROM position_lifecycle_transitions").
+		WithArgs(tenant).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "tenant_id", "entity", "event", "from_statuses", "to_status", "guards", "side_effects",
+			"is_enabled", "updated_by", "created_at", "updated_at",
+		}).
+			AddRow(uuid.New(), tenant, "POSITION", "DELETE", pq.StringArray{"FILLED"}, "DELETED", pq.StringArray{}, pq.StringArray{"RECALCULATE_TIMELINE"}, false, "", now, now).
+			AddRow(uuid.New(), tenant, "POSITION", "FREEZE", pq.StringArray{"FILLED"}, "INACTIVE", pq.StringArray{}, pq.StringArray{"END_ACTIVE_ASSIGNMENTS"}, true, "", now, now))
+
+	transitions, err := repo.GetPositionAllowedTransitions(context.Background(), tenant, "P1000001")
+	if err != nil {
+		t.Fatalf("GetPositionAllowedTransitions err: %v", err)
+	}
+	if len(transitions) != 2 {
+		t.Fatalf("expected FREEZE and SUSPEND, got %#v", transitions)
+	}
+	freeze, suspend := transitions[0], transitions[1]
+	if freeze.Event() != "FREEZE" || !freeze.Allowed() || freeze.BuiltIn() || freeze.SideEffects()[0] != "END_ACTIVE_ASSIGNMENTS" {
+		t.Fatalf("unexpected custom transition: %#v", freeze)
+	}
+	if suspend.Event() != "SUSPEND" || suspend.Allowed() || suspend.FromStatus() != "FILLED" || suspend.FailedGuards()[0] != "NO_ACTIVE_ASSIGNMENTS" {
+		t.Fatalf("expected suspend to be blocked by guard: %#v", suspend)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestGetPositionAllowedTransitions_MissingPosition(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPostgreSQLRepository(db, nil, nil, AuditHistoryConfig{})
+	tenant := uuid.New()
+
+	mock.ExpectQuery("FROM positions p").
+		WithArgs(tenant.String(), "P9999999").
+		WillReturnError(sql.ErrNoRows)
+
+	transitions, err := repo.GetPositionAllowedTransitions(context.Background(), tenant, "P9999999")
+	if err != nil || len(transitions) != 0 {
+		t.Fatalf("expected empty transitions, got %#v %v", transitions, err)
+	}
+}
@@ -109,6 +109,22 @@ func (r *PostgreSQLRepository) GetPositions(ctx context.Context, tenantID uuid.U
 		}
 	}
 
+	if filter != nil && len(filter.CustomFields) > 0 {
+		conditions, nextArgs, nextIndex, err := buildCustomFieldConditions("p.custom_fields", filter.CustomFields, args, argIndex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom field filter: %w", err)
+		}
+		whereParts = append(whereParts, conditions...)
+		args, argIndex = nextArgs, nextIndex
+	}
+
+	if filter != nil && filter.LocationCode != nil && strings.TrimSpace(*filter.LocationCode) != "" {
+		locationExpr := positionLocationSQL("p.tenant_id", "p", "CURRENT_DATE")
+		whereParts = append(whereParts, locationMatchSQL(locationExpr, "$1", fmt.Sprintf("$%d", argIndex), "CURRENT_DATE"))
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.LocationCode)))
+		argIndex++
+	}
+
 	whereClause := ""
 	if len(whereParts) > 0 {
 		whereClause = "WHERE " + strings.Join(whereParts, " AND ")
@@ -180,7 +196,9 @@ SELECT
     p.job_family_name,
     p.job_role_name,
     p.job_level_name,
-    p.organization_name
+    p.organization_name,
+    p.custom_fields,
+    p.title_i18n
 FROM positions p
 %s
 %s
@@ -274,7 +292,9 @@ SELECT
     p.job_family_name,
     p.job_role_name,
     p.job_level_name,
-    p.organization_name
+    p.organization_name,
+    p.custom_fields,
+    p.title_i18n
 FROM positions p
 %s
 ORDER BY p.effective_date DESC, p.created_at DESC
@@ -466,7 +486,9 @@ SELECT
     p.job_family_name,
     p.job_role_name,
     p.job_level_name,
-    p.organization_name
+    p.organization_name,
+    p.custom_fields,
+    p.title_i18n
 FROM positions p
 JOIN organization_units ou ON ou.tenant_id = p.tenant_id AND ou.code = p.organization_code AND ou.is_current = true
 CROSS JOIN org_scope scope
@@ -560,7 +582,9 @@ SELECT
     p.job_family_name,
     p.job_role_name,
     p.job_level_name,
-    p.organization_name
+    p.organization_name,
+    p.custom_fields,
+    p.title_i18n
 FROM positions p
 %s
 ORDER BY p.code
@@ -794,7 +818,9 @@ SELECT
     p.job_family_name,
     p.job_role_name,
     p.job_level_name,
-    p.organization_name
+    p.organization_name,
+    p.custom_fields,
+    p.title_i18n
 FROM positions p
 %s
 ORDER BY p.effective_date DESC, p.created_at DESC
@@ -862,11 +888,6 @@ func (r *PostgreSQLRepository) GetVacantPositionConnection(ctx context.Context,
 		}
 	}
 
-	whereClause := ""
-	if len(whereParts) > 0 {
-		whereClause = "WHERE " + strings.Join(whereParts, " AND ")
-	}
-
 	asOfDate := time.Now().UTC().Format("2006-01-02")
 	if filter != nil && filter.AsOfDate != nil && strings.TrimSpace(*filter.AsOfDate) != "" {
 		asOfDate = strings.TrimSpace(*filter.AsOfDate)
@@ -874,6 +895,18 @@ func (r *PostgreSQLRepository) GetVacantPositionConnection(ctx context.Context,
 	args = append(args, asOfDate)
 	asOfIdx := len(args)
 
+	if filter != nil && filter.LocationCode != nil && strings.TrimSpace(*filter.LocationCode) != "" {
+		asOfExpr := fmt.Sprintf("$%d::date", asOfIdx)
+		args = append(args, strings.ToUpper(strings.TrimSpace(*filter.LocationCode)))
+		locationExpr := positionLocationSQL("p.tenant_id", "p", asOfExpr)
+		whereParts = append(whereParts, locationMatchSQL(locationExpr, "$1", fmt.Sprintf("$%d", len(args)), asOfExpr))
+	}
+
+	whereClause := ""
+	if len(whereParts) > 0 {
+		whereClause = "WHERE " + strings.Join(whereParts, " AND ")
+	}
+
 	minVacantIdx := 0
 	if filter != nil && filter.MinimumVacantDays != nil && *filter.MinimumVacantDays >= 0 {
 		args = append(args, *filter.MinimumVacantDays)
@@ -1595,6 +1628,8 @@ func scanPosition(scanner rowScanner) (*dto.Position, error) {
 		isCurrent     bool
 		createdAt     time.Time
 		updatedAt     time.Time
+		customFields  dto.CustomFieldList
+		titleI18n     dto.LocalizedTextList
 	)
 
 	if err := scanner.Scan(
@@ -1626,6 +1661,8 @@ func scanPosition(scanner rowScanner) (*dto.Position, error) {
 		&jobRoleName,
 		&jobLevelName,
 		&organizationName,
+		&customFields,
+		&titleI18n,
 	); err != nil {
 		return nil, err
 	}
@@ -1651,6 +1688,8 @@ func scanPosition(scanner rowScanner) (*dto.Position, error) {
 		IsCurrentField:          isCurrent,
 		CreatedAtField:          createdAt,
 		UpdatedAtField:          updatedAt,
+		CustomFieldsField:       customFields,
+		TitleI18nField:          titleI18n,
 	}
 
 	if jobProfileCode.Valid {
@@ -30,7 +30,7 @@ func TestGetPositions_MinimalSuccess(t *testing.T) {
 		"headcount_capacity", "headcount_in_use", "reports_to_position_code", "status",
 		"effective_date", "end_date", "is_current", "created_at", "updated_at",
 		"job_family_group_name", "job_family_name", "job_role_name", "job_level_name",
-		"organization_name",
+		"organization_name", "custom_fields", "title_i18n",
 	}
 	row := sqlmock.NewRows(cols).AddRow(
 		"rec-1", tenant.String(), "P10001", "研发工程师",
@@ -40,7 +40,8 @@ func TestGetPositions_MinimalSuccess(t *testing.T) {
 		1, 0, nil, "ACTIVE",
 		now, nil, true, now, now,
 		nil, nil, nil, nil,
-		"集团",
+		"集团", []byte(`{"headcountBudget":{"type":"NUMBER","value":3},"remote":{"type":"BOOLEAN","value":true}}`),
+		[]byte(`{"en":"R&D Engineer","zh-Hant":"研發工程師"}`),
 	)
 	mock.ExpectQuery("SELECT p.record_id").WillReturnRows(row)
 
@@ -54,4 +55,15 @@ func TestGetPositions_MinimalSuccess(t *testing.T) {
 	if conn.DataField[0].CodeField != "P10001" || conn.DataField[0].OrganizationCodeField != "1000000" {
 		t.Fatalf("unexpected node: %#v", conn.DataField[0])
 	}
+	fields := conn.DataField[0].CustomFields()
+	if len(fields) != 2 || fields[0].Key() != "headcountBudget" || *fields[0].NumberValue() != 3 || !*fields[1].BooleanValue() {
+		t.Fatalf("unexpected custom fields: %#v", fields)
+	}
+	titles := conn.DataField[0].TitleI18nField
+	if got := titles.Resolve([]string{"zh-Hant-TW"}, "研发工程师"); got != "研發工程師" {
+		t.Fatalf("expected zh-Hant title via truncation fallback, got %q", got)
+	}
+	if got := titles.Resolve([]string{"fr"}, "研发工程师"); got != "研发工程师" {
+		t.Fatalf("expected default title for unconfigured locale, got %q", got)
+	}
 }
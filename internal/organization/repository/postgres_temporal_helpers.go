@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// asOfDateArg 追加查询日期参数并返回其 SQL 表达式；为空时使用 CURRENT_DATE
+func asOfDateArg(args []interface{}, asOfDate *string) ([]interface{}, string) {
+	if asOfDate != nil && strings.TrimSpace(*asOfDate) != "" {
+		args = append(args, strings.TrimSpace(*asOfDate))
+		return args, fmt.Sprintf("$%d::date", len(args))
+	}
+	return args, "CURRENT_DATE"
+}
@@ -9,14 +9,20 @@ import (
 )
 
 func (tm *TemporalTimelineManager) DeleteVersion(ctx context.Context, tenantID uuid.UUID, recordID uuid.UUID) (*[]TimelineVersion, error) {
-	tx, err := tm.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	tm.logger.Infof("删除版本: RecordID=%s", recordID)
+
+	timeline, err := runTimelineTx(ctx, tm, "timeline.delete", func(ctx context.Context, tx *sql.Tx) (*[]TimelineVersion, error) {
+		return tm.deleteVersionInTx(ctx, tx, tenantID, recordID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
+		return nil, err
 	}
-	defer tx.Rollback()
 
-	tm.logger.Infof("删除版本: RecordID=%s", recordID)
+	tm.logger.Infof("版本删除成功，剩余版本: %d", len(*timeline))
+	return timeline, nil
+}
 
+func (tm *TemporalTimelineManager) deleteVersionInTx(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID) (*[]TimelineVersion, error) {
 	var code string
 	versionQuery := `
 		SELECT code FROM organization_units 
@@ -40,11 +46,5 @@ func (tm *TemporalTimelineManager) DeleteVersion(ctx context.Context, tenantID u
 	if err != nil {
 		return nil, fmt.Errorf("全链重算失败: %w", err)
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("提交事务失败: %w", err)
-	}
-
-	tm.logger.Infof("版本删除成功，剩余版本: %d", len(*timeline))
 	return timeline, nil
 }
@@ -11,12 +11,18 @@ import (
 )
 
 func (tm *TemporalTimelineManager) InsertVersion(ctx context.Context, org *types.Organization) (*TimelineVersion, error) {
-	tx, err := tm.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	version, err := runTimelineTx(ctx, tm, "timeline.insert", func(ctx context.Context, tx *sql.Tx) (*TimelineVersion, error) {
+		return tm.insertVersionInTx(ctx, tx, org)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
+		return nil, err
 	}
-	defer tx.Rollback()
 
+	tm.logger.Infof("版本插入成功: RecordID=%s", version.RecordID)
+	return version, nil
+}
+
+func (tm *TemporalTimelineManager) insertVersionInTx(ctx context.Context, tx *sql.Tx, org *types.Organization) (*TimelineVersion, error) {
 	tenantID, err := uuid.Parse(org.TenantID)
 	if err != nil {
 		return nil, fmt.Errorf("无效的租户ID: %w", err)
@@ -56,12 +62,19 @@ func (tm *TemporalTimelineManager) InsertVersion(ctx context.Context, org *types
 		}
 	}
 
+	// 自定义字段继承前一版本的取值，再合并本次提交的字段（null 表示移除）
 	insertQuery := `
 	INSERT INTO organization_units (
 		tenant_id, code, parent_code, name, unit_type, status,
 		level, code_path, name_path, sort_order, description, effective_date,
-		is_current, change_reason, created_at, updated_at
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, false, $13, NOW(), NOW())
+		is_current, change_reason, created_at, updated_at, name_i18n, name_path_i18n, custom_fields
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, false, $13, NOW(), NOW(), $15, $16,
+		jsonb_strip_nulls(COALESCE((
+			SELECT prev.custom_fields FROM organization_units prev
+			WHERE prev.tenant_id = $1 AND prev.code = $2 AND prev.status != 'DELETED' AND prev.effective_date < $12
+			ORDER BY prev.effective_date DESC
+			LIMIT 1
+		), '{}'::jsonb) || $14::jsonb))
 	RETURNING record_id, created_at`
 
 	var newRecordID uuid.UUID
@@ -70,7 +83,7 @@ func (tm *TemporalTimelineManager) InsertVersion(ctx context.Context, org *types
 	if err := tx.QueryRowContext(ctx, insertQuery,
 		tenantID, org.Code, org.ParentCode, org.Name, org.UnitType, "ACTIVE",
 		org.Level, org.CodePath, org.NamePath, org.SortOrder, org.Description, effectiveDate,
-		org.ChangeReason,
+		org.ChangeReason, org.CustomFields, org.NameI18n, org.NamePathI18n,
 	).Scan(&newRecordID, &createdAt); err != nil {
 		return nil, fmt.Errorf("插入新版本失败: %w", err)
 	}
@@ -79,11 +92,6 @@ func (tm *TemporalTimelineManager) InsertVersion(ctx context.Context, org *types
 		return nil, fmt.Errorf("全链重算失败: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("提交事务失败: %w", err)
-	}
-
-	tm.logger.Infof("版本插入成功: RecordID=%s", newRecordID)
 	return &TimelineVersion{
 		RecordID:   newRecordID,
 		Code:       org.Code,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -41,24 +42,35 @@ type TimelineVersion struct {
 	UpdatedAt     time.Time  `json:"updatedAt"`
 }
 
-func (tm *TemporalTimelineManager) RecalculateTimeline(ctx context.Context, tenantID uuid.UUID, code string) (*[]TimelineVersion, error) {
-	tx, err := tm.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
-	if err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
-	}
-	defer tx.Rollback()
+// timelineTxOptions 时间轴写事务使用 READ COMMITTED；并发编辑同一组织的死锁/序列化失败自动重试。
+func timelineTxOptions(operation string) database.TxOptions {
+	opts := database.DefaultTxOptions()
+	opts.Isolation = sql.LevelReadCommitted
+	opts.Operation = operation
+	return opts
+}
 
+// runTimelineTx 在时间轴事务中执行回调，冲突重试时整体重新执行。
+func runTimelineTx[T any](ctx context.Context, tm *TemporalTimelineManager, operation string, fn func(ctx context.Context, tx *sql.Tx) (T, error)) (T, error) {
+	var result T
+	err := database.RunTx(ctx, tm.db, timelineTxOptions(operation), func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		result, err = fn(ctx, tx)
+		return err
+	})
+	return result, err
+}
+
+func (tm *TemporalTimelineManager) RecalculateTimeline(ctx context.Context, tenantID uuid.UUID, code string) (*[]TimelineVersion, error) {
 	tm.logger.Infof("开始全链重算: tenant=%s, code=%s", tenantID, code)
 
-	versions, err := tm.RecalculateTimelineInTx(ctx, tx, tenantID, code)
+	versions, err := runTimelineTx(ctx, tm, "timeline.recalculate", func(ctx context.Context, tx *sql.Tx) (*[]TimelineVersion, error) {
+		return tm.RecalculateTimelineInTx(ctx, tx, tenantID, code)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("提交事务失败: %w", err)
-	}
-
 	tm.logger.Infof("全链重算完成: %s, 版本数=%d", code, len(*versions))
 	return versions, nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"cube-castle/internal/types"
 	"github.com/google/uuid"
 )
 
@@ -18,12 +19,12 @@ func (tm *TemporalTimelineManager) ActivateOrganization(ctx context.Context, ten
 }
 
 func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context, tenantID uuid.UUID, code, newStatus, operationType string, effectiveDate time.Time, operationReason string) (*[]TimelineVersion, error) {
-	tx, err := tm.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
-	if err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
-	}
-	defer tx.Rollback()
+	return runTimelineTx(ctx, tm, "timeline.change_status", func(ctx context.Context, tx *sql.Tx) (*[]TimelineVersion, error) {
+		return tm.changeOrganizationStatusInTx(ctx, tx, tenantID, code, newStatus, operationType, effectiveDate, operationReason)
+	})
+}
 
+func (tm *TemporalTimelineManager) changeOrganizationStatusInTx(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code, newStatus, operationType string, effectiveDate time.Time, operationReason string) (*[]TimelineVersion, error) {
 	tm.logger.Infof("开始%s组织: Code=%s, 生效日期=%s, 新状态=%s", operationType, code, effectiveDate.Format("2006-01-02"), newStatus)
 
 	var currentOrg struct {
@@ -44,12 +45,15 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 		ChangeReason  *string
 		CreatedAt     time.Time
 		UpdatedAt     time.Time
+		CustomFields  types.CustomFieldValues
+		NameI18n      types.LocalizedNames
+		NamePathI18n  types.LocalizedNames
 	}
 
 	row := tx.QueryRowContext(ctx, `
 	SELECT record_id, tenant_id, code, parent_code, name, unit_type, status, level,
 	       code_path, name_path, sort_order, description, effective_date, is_current, change_reason,
-	       created_at, updated_at
+	       created_at, updated_at, custom_fields, name_i18n, name_path_i18n
 		FROM organization_units 
 		WHERE tenant_id = $1 AND code = $2 AND is_current = true 
 		  AND status != 'DELETED'
@@ -61,6 +65,7 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 		&currentOrg.NamePath, &currentOrg.SortOrder,
 		&currentOrg.Description, &currentOrg.EffectiveDate, &currentOrg.IsCurrent,
 		&currentOrg.ChangeReason, &currentOrg.CreatedAt, &currentOrg.UpdatedAt,
+		&currentOrg.CustomFields, &currentOrg.NameI18n, &currentOrg.NamePathI18n,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("组织不存在或无当前版本: %s", code)
@@ -104,10 +109,6 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 			return nil, fmt.Errorf("时间轴重算失败: %w", err)
 		}
 
-		if err := tx.Commit(); err != nil {
-			return nil, fmt.Errorf("事务提交失败: %w", err)
-		}
-
 		action := "暂停"
 		if operationType == "REACTIVATE" {
 			action = "激活"
@@ -128,10 +129,10 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 		INSERT INTO organization_units (
 			record_id, tenant_id, code, parent_code, name, unit_type, status,
 			level, code_path, name_path, sort_order, description, effective_date, end_date,
-			is_current, change_reason, created_at, updated_at
+			is_current, change_reason, created_at, updated_at, custom_fields, name_i18n, name_path_i18n
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULL,
-			false, $14, $15, $16
+			false, $14, $15, $16, $17, $18, $19
 		)`,
 		newRecordID,
 		currentOrg.TenantID,
@@ -149,6 +150,9 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 		operationReason,
 		nowUTC,
 		nowUTC,
+		currentOrg.CustomFields,
+		currentOrg.NameI18n,
+		currentOrg.NamePathI18n,
 	); err != nil {
 		return nil, fmt.Errorf("插入%s版本失败: %w", operationType, err)
 	}
@@ -158,10 +162,6 @@ func (tm *TemporalTimelineManager) changeOrganizationStatus(ctx context.Context,
 		return nil, fmt.Errorf("时间轴重算失败: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("事务提交失败: %w", err)
-	}
-
 	action := "暂停"
 	if operationType == "REACTIVATE" {
 		action = "激活"
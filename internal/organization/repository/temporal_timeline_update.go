@@ -11,18 +11,25 @@ import (
 )
 
 func (tm *TemporalTimelineManager) UpdateVersionEffectiveDate(ctx context.Context, tenantID uuid.UUID, recordID uuid.UUID, newEffectiveDate time.Time, operationReason string) (*[]TimelineVersion, error) {
-	tx, err := tm.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	tm.logger.Infof("开始修改版本生效日期: RecordID=%s, 新日期=%s", recordID.String(), newEffectiveDate.Format("2006-01-02"))
+
+	timeline, err := runTimelineTx(ctx, tm, "timeline.update_effective_date", func(ctx context.Context, tx *sql.Tx) (*[]TimelineVersion, error) {
+		return tm.updateVersionEffectiveDateInTx(ctx, tx, tenantID, recordID, newEffectiveDate, operationReason)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("开始事务失败: %w", err)
+		return nil, err
 	}
-	defer tx.Rollback()
 
-	tm.logger.Infof("开始修改版本生效日期: RecordID=%s, 新日期=%s", recordID.String(), newEffectiveDate.Format("2006-01-02"))
+	tm.logger.Infof("版本生效日期修改成功: %s → %s", recordID.String(), newEffectiveDate.Format("2006-01-02"))
+	return timeline, nil
+}
 
+func (tm *TemporalTimelineManager) updateVersionEffectiveDateInTx(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, recordID uuid.UUID, newEffectiveDate time.Time, operationReason string) (*[]TimelineVersion, error) {
 	var org types.Organization
 	row := tx.QueryRowContext(ctx, `
 	SELECT tenant_id, code, parent_code, name, unit_type, status, level, code_path, name_path, sort_order,
-	       description, effective_date, is_current, change_reason, created_at, updated_at
+	       description, effective_date, is_current, change_reason, created_at, updated_at, custom_fields,
+	       name_i18n, name_path_i18n
 	FROM organization_units 
 	WHERE record_id = $1 AND status != 'DELETED'
 	FOR UPDATE`, recordID)
@@ -31,7 +38,8 @@ func (tm *TemporalTimelineManager) UpdateVersionEffectiveDate(ctx context.Contex
 		&org.TenantID, &org.Code, &org.ParentCode, &org.Name, &org.UnitType,
 		&org.Status, &org.Level, &org.CodePath, &org.NamePath, &org.SortOrder, &org.Description,
 		&org.EffectiveDate, &org.IsCurrent, &org.ChangeReason,
-		&org.CreatedAt, &org.UpdatedAt,
+		&org.CreatedAt, &org.UpdatedAt, &org.CustomFields,
+		&org.NameI18n, &org.NamePathI18n,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("版本不存在或已被删除: %s", recordID.String())
@@ -77,13 +85,13 @@ func (tm *TemporalTimelineManager) UpdateVersionEffectiveDate(ctx context.Contex
 		INSERT INTO organization_units (
 			record_id, tenant_id, code, parent_code, name, unit_type, status,
 			level, code_path, name_path, sort_order, description, effective_date, end_date,
-			is_current, change_reason, created_at, updated_at
+			is_current, change_reason, created_at, updated_at, custom_fields, name_i18n, name_path_i18n
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULL,
-			false, $14, $15, $15
+			false, $14, $15, $15, $16, $17, $18
 		)`, newRecordID, org.TenantID, org.Code, org.ParentCode, org.Name, org.UnitType,
 		org.Status, org.Level, org.CodePath, org.NamePath, org.SortOrder, org.Description,
-		newEffectiveDate, operationReason, now); err != nil {
+		newEffectiveDate, operationReason, now, org.CustomFields, org.NameI18n, org.NamePathI18n); err != nil {
 		return nil, fmt.Errorf("插入新版本失败: %w", err)
 	}
 
@@ -91,11 +99,5 @@ func (tm *TemporalTimelineManager) UpdateVersionEffectiveDate(ctx context.Contex
 	if err != nil {
 		return nil, fmt.Errorf("时间轴重算失败: %w", err)
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("事务提交失败: %w", err)
-	}
-
-	tm.logger.Infof("版本生效日期修改成功: %s → %s", recordID.String(), newEffectiveDate.Format("2006-01-02"))
 	return timeline, nil
 }
@@ -0,0 +1,39 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+
+	"cube-castle/internal/organization/dto"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// CompensationGrades 查询指定日期生效的职等及薪酬区间；职位与职级上的职等字段同样经此授权（compensation:read）
+func (r *Resolver) CompensationGrades(ctx context.Context, args struct {
+	JobLevelCode    *string
+	GradeCode       *string
+	IncludeInactive *bool
+	AsOfDate        *string
+}) ([]dto.CompensationGrade, error) {
+	log := r.loggerFor("compensation", "grades", pkglogger.Fields{
+		"jobLevelCode": args.JobLevelCode,
+		"gradeCode":    args.GradeCode,
+	})
+	if err := r.authorize(ctx, "compensationGrades", log); err != nil {
+		return nil, err
+	}
+	filter := dto.CompensationGradeFilter{
+		JobLevelCode: args.JobLevelCode,
+		GradeCode:    args.GradeCode,
+		AsOfDate:     args.AsOfDate,
+	}
+	if args.IncludeInactive != nil {
+		filter.IncludeInactive = *args.IncludeInactive
+	}
+	if filter.GradeCode != nil && strings.TrimSpace(*filter.GradeCode) == "" {
+		return []dto.CompensationGrade{}, nil
+	}
+	log.WithFields(pkglogger.Fields{"includeInactive": filter.IncludeInactive, "asOfDate": args.AsOfDate}).Info("查询职等")
+
+	return r.repo.GetCompensationGrades(ctx, r.resolveTenant(ctx, log), filter)
+}
@@ -0,0 +1,71 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+
+	"cube-castle/internal/organization/dto"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// CostCenters 查询指定日期生效的成本中心，可按法人实体与上级成本中心过滤（cost-center:read）
+func (r *Resolver) CostCenters(ctx context.Context, args struct {
+	LegalEntityCode *string
+	ParentCode      *string
+	IncludeInactive *bool
+	AsOfDate        *string
+}) ([]dto.CostCenter, error) {
+	log := r.loggerFor("costCenter", "list", pkglogger.Fields{
+		"legalEntityCode": args.LegalEntityCode,
+		"parentCode":      args.ParentCode,
+	})
+	if err := r.authorize(ctx, "costCenters", log); err != nil {
+		return nil, err
+	}
+	filter := dto.CostCenterFilter{
+		LegalEntityCode: args.LegalEntityCode,
+		ParentCode:      args.ParentCode,
+		AsOfDate:        args.AsOfDate,
+	}
+	if args.IncludeInactive != nil {
+		filter.IncludeInactive = *args.IncludeInactive
+	}
+	log.WithFields(pkglogger.Fields{"includeInactive": filter.IncludeInactive, "asOfDate": args.AsOfDate}).Info("查询成本中心")
+
+	return r.repo.GetCostCenters(ctx, r.resolveTenant(ctx, log), filter)
+}
+
+// PositionCostAllocations 查询职位在指定日期的成本分摊；职位字段与成本中心列表共用 costCenters 授权
+func (r *Resolver) PositionCostAllocations(ctx context.Context, args struct {
+	PositionCode string
+	AsOfDate     *string
+}) ([]dto.CostAllocation, error) {
+	log := r.loggerFor("costCenter", "positionAllocations", pkglogger.Fields{
+		"positionCode": args.PositionCode,
+		"asOfDate":     args.AsOfDate,
+	})
+	if err := r.authorize(ctx, "costCenters", log); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(args.PositionCode) == "" {
+		return []dto.CostAllocation{}, nil
+	}
+	return r.repo.GetPositionCostAllocations(ctx, r.resolveTenant(ctx, log), args.PositionCode, args.AsOfDate)
+}
+
+// CostCenterHeadcountStats 按成本中心汇总编制、占用与在岗 FTE，分摊职位按比例折算
+func (r *Resolver) CostCenterHeadcountStats(ctx context.Context, args struct {
+	AsOfDate        *string
+	LegalEntityCode *string
+}) ([]dto.CostCenterHeadcount, error) {
+	log := r.loggerFor("costCenter", "headcountStats", pkglogger.Fields{
+		"asOfDate":        args.AsOfDate,
+		"legalEntityCode": args.LegalEntityCode,
+	})
+	if err := r.authorize(ctx, "costCenterHeadcountStats", log); err != nil {
+		return nil, err
+	}
+	log.Info("查询成本中心编制统计")
+
+	return r.repo.GetCostCenterHeadcount(ctx, r.resolveTenant(ctx, log), args.AsOfDate, args.LegalEntityCode)
+}
@@ -0,0 +1,22 @@
+package resolver
+
+import (
+	"context"
+
+	"cube-castle/internal/organization/dto"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// EmployeeAssignmentViolations 列出指定日期（默认当天）违反员工跨职位任职约束的员工：
+// FTE 合计超限、同时存在多个 PRIMARY、同一组织内 ACTING 重叠
+func (r *Resolver) EmployeeAssignmentViolations(ctx context.Context, args struct {
+	AsOfDate *string
+}) ([]dto.EmployeeAssignmentViolation, error) {
+	log := r.loggerFor("assignments", "employeeViolations", pkglogger.Fields{"asOfDate": args.AsOfDate})
+	if err := r.authorize(ctx, "employeeAssignmentViolations", log); err != nil {
+		return nil, err
+	}
+	log.Info("查询员工跨职位任职违规")
+
+	return r.repo.GetEmployeeAssignmentViolations(ctx, r.resolveTenant(ctx, log), args.AsOfDate)
+}
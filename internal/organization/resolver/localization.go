@@ -0,0 +1,92 @@
+package resolver
+
+import (
+	"context"
+
+	"cube-castle/internal/organization/dto"
+	"cube-castle/internal/types"
+)
+
+// 本地化：按请求偏好语言（Accept-Language 或 locale 参数）替换默认名称，
+// 未配置的语言回落到默认名称；nameI18n/titleI18n 始终返回全部语言供客户端自行选择。
+
+func localizeOrganizations(ctx context.Context, orgs []dto.Organization) {
+	locales := types.LocalesFromContext(ctx)
+	if len(locales) == 0 {
+		return
+	}
+	for i := range orgs {
+		localizeOrganizationWith(locales, &orgs[i])
+	}
+}
+
+func localizeOrganization(ctx context.Context, org *dto.Organization) {
+	if org == nil {
+		return
+	}
+	if locales := types.LocalesFromContext(ctx); len(locales) > 0 {
+		localizeOrganizationWith(locales, org)
+	}
+}
+
+func localizeOrganizationWith(locales []string, org *dto.Organization) {
+	org.NameField = org.NameI18nField.Resolve(locales, org.NameField)
+	org.NamePathField = org.NamePathI18nField.Resolve(locales, org.NamePathField)
+}
+
+func localizePositions(ctx context.Context, positions []dto.Position) {
+	locales := types.LocalesFromContext(ctx)
+	if len(locales) == 0 {
+		return
+	}
+	for i := range positions {
+		positions[i].TitleField = positions[i].TitleI18nField.Resolve(locales, positions[i].TitleField)
+	}
+}
+
+func localizePosition(ctx context.Context, position *dto.Position) {
+	if position == nil {
+		return
+	}
+	if locales := types.LocalesFromContext(ctx); len(locales) > 0 {
+		position.TitleField = position.TitleI18nField.Resolve(locales, position.TitleField)
+	}
+}
+
+func localizePositionConnection(ctx context.Context, conn *dto.PositionConnection) {
+	if conn == nil {
+		return
+	}
+	localizePositions(ctx, conn.DataField)
+	for i := range conn.EdgesField {
+		localizePosition(ctx, &conn.EdgesField[i].NodeField)
+	}
+}
+
+func localizeJobFamilyGroups(ctx context.Context, items []dto.JobFamilyGroup) {
+	locales := types.LocalesFromContext(ctx)
+	for i := range items {
+		items[i].NameField = items[i].NameI18nField.Resolve(locales, items[i].NameField)
+	}
+}
+
+func localizeJobFamilies(ctx context.Context, items []dto.JobFamily) {
+	locales := types.LocalesFromContext(ctx)
+	for i := range items {
+		items[i].NameField = items[i].NameI18nField.Resolve(locales, items[i].NameField)
+	}
+}
+
+func localizeJobRoles(ctx context.Context, items []dto.JobRole) {
+	locales := types.LocalesFromContext(ctx)
+	for i := range items {
+		items[i].NameField = items[i].NameI18nField.Resolve(locales, items[i].NameField)
+	}
+}
+
+func localizeJobLevels(ctx context.Context, items []dto.JobLevel) {
+	locales := types.LocalesFromContext(ctx)
+	for i := range items {
+		items[i].NameField = items[i].NameI18nField.Resolve(locales, items[i].NameField)
+	}
+}
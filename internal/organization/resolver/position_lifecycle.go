@@ -0,0 +1,27 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+
+	"cube-castle/internal/organization/dto"
+	pkglogger "cube-castle/pkg/logger"
+)
+
+// PositionAllowedTransitions 按租户生命周期规则列出职位当前状态下可手动执行的事件；
+// 守卫不满足的事件同样返回（allowed=false），供界面禁用操作并提示原因
+func (r *Resolver) PositionAllowedTransitions(ctx context.Context, args struct {
+	Code string
+}) ([]dto.PositionTransition, error) {
+	log := r.loggerFor("position", "allowedTransitions", pkglogger.Fields{"code": args.Code})
+	if err := r.authorize(ctx, "positionAllowedTransitions", log); err != nil {
+		return nil, err
+	}
+	code := strings.ToUpper(strings.TrimSpace(args.Code))
+	if code == "" {
+		return []dto.PositionTransition{}, nil
+	}
+	log.Info("查询职位可执行的生命周期事件")
+
+	return r.repo.GetPositionAllowedTransitions(ctx, r.resolveTenant(ctx, log), code)
+}
@@ -9,6 +9,7 @@ import (
 
 	"cube-castle/internal/auth"
 	"cube-castle/internal/organization/dto"
+	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
 	sharedconfig "cube-castle/shared/config"
 	"github.com/google/uuid"
@@ -43,6 +44,15 @@ type stubRepository struct {
 	assignmentAuditFn                func(ctx context.Context, tenantID uuid.UUID, positionCode string, assignmentID *string, dateRange *dto.DateRangeInput, pagination *dto.PaginationInput) (*dto.PositionAssignmentAuditConnection, error)
 	assignmentHistoryFn              func(ctx context.Context, tenantID uuid.UUID, positionCode string, filter *dto.PositionAssignmentFilterInput, pagination *dto.PaginationInput, sorting []dto.PositionAssignmentSortInput) (*dto.PositionAssignmentConnection, error)
 	assignmentStatsFn                func(ctx context.Context, tenantID uuid.UUID, positionCode string, organizationCode string) (*dto.AssignmentStats, error)
+	gradesFn                         func(ctx context.Context, tenantID uuid.UUID, filter dto.CompensationGradeFilter) ([]dto.CompensationGrade, error)
+	costCentersFn                    func(ctx context.Context, tenantID uuid.UUID, filter dto.CostCenterFilter) ([]dto.CostCenter, error)
+	costAllocationsFn                func(ctx context.Context, tenantID uuid.UUID, positionCode string, asOfDate *string) ([]dto.CostAllocation, error)
+	costCenterHeadcountFn            func(ctx context.Context, tenantID uuid.UUID, asOfDate *string, legalEntityCode *string) ([]dto.CostCenterHeadcount, error)
+	locationsFn                      func(ctx context.Context, tenantID uuid.UUID, filter dto.LocationFilter) ([]dto.Location, error)
+	effectiveLocationFn              func(ctx context.Context, tenantID uuid.UUID, subjectType, code string, asOfDate *string) (*dto.EffectiveLocation, error)
+	locationHeadcountFn              func(ctx context.Context, tenantID uuid.UUID, asOfDate *string, locationCode *string) ([]dto.LocationHeadcount, error)
+	allowedTransitionsFn             func(ctx context.Context, tenantID uuid.UUID, code string) ([]dto.PositionTransition, error)
+	employeeViolationsFn             func(ctx context.Context, tenantID uuid.UUID, asOfDate *string) ([]dto.EmployeeAssignmentViolation, error)
 	capturedSorting                  []dto.PositionSortInput
 	capturedFilter                   *dto.PositionFilterInput
 	capturedPagination               *dto.PaginationInput
@@ -214,6 +224,78 @@ func (s *stubRepository) GetJobLevels(_ context.Context, _ uuid.UUID, _ string,
 	panic("GetJobLevels not expected")
 }
 
+func (s *stubRepository) GetCompensationGrades(ctx context.Context, tenantID uuid.UUID, filter dto.CompensationGradeFilter) ([]dto.CompensationGrade, error) {
+	if s.gradesFn == nil {
+		panic("GetCompensationGrades not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.gradesFn(ctx, tenantID, filter)
+}
+
+func (s *stubRepository) GetCostCenters(ctx context.Context, tenantID uuid.UUID, filter dto.CostCenterFilter) ([]dto.CostCenter, error) {
+	if s.costCentersFn == nil {
+		panic("GetCostCenters not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.costCentersFn(ctx, tenantID, filter)
+}
+
+func (s *stubRepository) GetPositionCostAllocations(ctx context.Context, tenantID uuid.UUID, positionCode string, asOfDate *string) ([]dto.CostAllocation, error) {
+	if s.costAllocationsFn == nil {
+		panic("GetPositionCostAllocations not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.costAllocationsFn(ctx, tenantID, positionCode, asOfDate)
+}
+
+func (s *stubRepository) GetCostCenterHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, legalEntityCode *string) ([]dto.CostCenterHeadcount, error) {
+	if s.costCenterHeadcountFn == nil {
+		panic("GetCostCenterHeadcount not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.costCenterHeadcountFn(ctx, tenantID, asOfDate, legalEntityCode)
+}
+
+func (s *stubRepository) GetLocations(ctx context.Context, tenantID uuid.UUID, filter dto.LocationFilter) ([]dto.Location, error) {
+	if s.locationsFn == nil {
+		panic("GetLocations not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.locationsFn(ctx, tenantID, filter)
+}
+
+func (s *stubRepository) GetEffectiveLocation(ctx context.Context, tenantID uuid.UUID, subjectType, code string, asOfDate *string) (*dto.EffectiveLocation, error) {
+	if s.effectiveLocationFn == nil {
+		panic("GetEffectiveLocation not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.effectiveLocationFn(ctx, tenantID, subjectType, code, asOfDate)
+}
+
+func (s *stubRepository) GetLocationHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, locationCode *string) ([]dto.LocationHeadcount, error) {
+	if s.locationHeadcountFn == nil {
+		panic("GetLocationHeadcount not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.locationHeadcountFn(ctx, tenantID, asOfDate, locationCode)
+}
+
+func (s *stubRepository) GetPositionAllowedTransitions(ctx context.Context, tenantID uuid.UUID, code string) ([]dto.PositionTransition, error) {
+	if s.allowedTransitionsFn == nil {
+		panic("GetPositionAllowedTransitions not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.allowedTransitionsFn(ctx, tenantID, code)
+}
+
+func (s *stubRepository) GetEmployeeAssignmentViolations(ctx context.Context, tenantID uuid.UUID, asOfDate *string) ([]dto.EmployeeAssignmentViolation, error) {
+	if s.employeeViolationsFn == nil {
+		panic("GetEmployeeAssignmentViolations not expected")
+	}
+	s.capturedTenant = tenantID
+	return s.employeeViolationsFn(ctx, tenantID, asOfDate)
+}
+
 func (s *stubRepository) GetAuditHistory(_ context.Context, _ uuid.UUID, _ string, _ *string, _ *string, _ *string, _ *string, _ int) ([]dto.AuditRecordData, error) {
 	panic("GetAuditHistory not expected")
 }
@@ -693,6 +775,43 @@ func TestResolver_Position_ForwardsAsOfDate(t *testing.T) {
 	}
 }
 
+func TestResolver_Position_LocalizesTitle(t *testing.T) {
+	titles := dto.LocalizedTextList{{LocaleField: "en", ValueField: "Engineer"}, {LocaleField: "ja", ValueField: "エンジニア"}}
+	repo := &stubRepository{
+		positionByCodeFn: func(_ context.Context, _ uuid.UUID, code string, _ *string) (*dto.Position, error) {
+			return &dto.Position{CodeField: code, TitleField: "工程师", TitleI18nField: titles}, nil
+		},
+	}
+	resolver := NewResolver(repo, newTestLogger(), &stubPermissionChecker{allow: true})
+	args := struct {
+		Code     string
+		AsOfDate *string
+	}{Code: "P1000001"}
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "no preference", ctx: context.Background(), want: "工程师"},
+		{name: "accept-language fallback to base language", ctx: types.WithLocales(context.Background(), types.ParseAcceptLanguage("fr;q=0.9, en-GB")), want: "Engineer"},
+		{name: "explicit locale wins", ctx: types.WithPreferredLocale(types.WithLocales(context.Background(), []string{"en"}), "ja"), want: "エンジニア"},
+		{name: "unconfigured locale", ctx: types.WithLocales(context.Background(), []string{"de"}), want: "工程师"},
+	}
+	for _, tc := range cases {
+		position, err := resolver.Position(tc.ctx, args)
+		if err != nil {
+			t.Fatalf("%s: Position returned error: %v", tc.name, err)
+		}
+		if position.Title() != tc.want {
+			t.Fatalf("%s: expected title %q, got %q", tc.name, tc.want, position.Title())
+		}
+		if len(position.TitleI18n()) != 2 {
+			t.Fatalf("%s: expected all localized titles to be returned, got %#v", tc.name, position.TitleI18n())
+		}
+	}
+}
+
 func TestResolver_PositionTimeline_ForwardsDateRange(t *testing.T) {
 	start := "2025-01-01"
 	end := "2025-12-31"
@@ -792,3 +911,256 @@ func TestResolver_PositionVersions_IncludeDeletedFlag(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestResolver_CompensationGrades_ForwardsFilter(t *testing.T) {
+	levelCode := "P1"
+	asOf := "2025-06-01"
+	includeInactive := true
+	var captured dto.CompensationGradeFilter
+	repo := &stubRepository{
+		gradesFn: func(_ context.Context, _ uuid.UUID, filter dto.CompensationGradeFilter) ([]dto.CompensationGrade, error) {
+			captured = filter
+			return []dto.CompensationGrade{{CodeField: "G5", PayRangesField: dto.PayRangeList{{CurrencyField: "CNY", RegionField: "GLOBAL", MinField: 100, MidField: 150, MaxField: 200}}}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	grades, err := resolver.CompensationGrades(context.Background(), struct {
+		JobLevelCode    *string
+		GradeCode       *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{JobLevelCode: &levelCode, IncludeInactive: &includeInactive, AsOfDate: &asOf})
+	if err != nil {
+		t.Fatalf("CompensationGrades returned error: %v", err)
+	}
+	if perm.lastQuery != "compensationGrades" {
+		t.Fatalf("expected permission check for compensationGrades, got %s", perm.lastQuery)
+	}
+	if captured.JobLevelCode == nil || *captured.JobLevelCode != levelCode || !captured.IncludeInactive || captured.AsOfDate == nil || *captured.AsOfDate != asOf {
+		t.Fatalf("filter not forwarded: %#v", captured)
+	}
+	if repo.capturedTenant != sharedconfig.DefaultTenantID {
+		t.Fatalf("expected tenant %s, got %s", sharedconfig.DefaultTenantID, repo.capturedTenant)
+	}
+	if len(grades) != 1 || grades[0].Code() != "G5" || grades[0].PayRanges()[0].Mid() != 150 {
+		t.Fatalf("unexpected grades: %#v", grades)
+	}
+}
+
+func TestResolver_CompensationGrades_PermissionDenied(t *testing.T) {
+	repo := &stubRepository{}
+	resolver := NewResolver(repo, newTestLogger(), &stubPermissionChecker{allow: false})
+
+	_, err := resolver.CompensationGrades(context.Background(), struct {
+		JobLevelCode    *string
+		GradeCode       *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{})
+	if err == nil || err.Error() != "INSUFFICIENT_PERMISSIONS" {
+		t.Fatalf("expected INSUFFICIENT_PERMISSIONS, got %v", err)
+	}
+}
+
+func TestResolver_CostCenters_ForwardsFilter(t *testing.T) {
+	legalEntity := "1000000"
+	asOf := "2025-06-01"
+	var captured dto.CostCenterFilter
+	repo := &stubRepository{
+		costCentersFn: func(_ context.Context, _ uuid.UUID, filter dto.CostCenterFilter) ([]dto.CostCenter, error) {
+			captured = filter
+			return []dto.CostCenter{{CodeField: "CC-A", LegalEntityCodeField: legalEntity}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	centers, err := resolver.CostCenters(context.Background(), struct {
+		LegalEntityCode *string
+		ParentCode      *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{LegalEntityCode: &legalEntity, AsOfDate: &asOf})
+	if err != nil {
+		t.Fatalf("CostCenters returned error: %v", err)
+	}
+	if perm.lastQuery != "costCenters" {
+		t.Fatalf("expected permission check for costCenters, got %s", perm.lastQuery)
+	}
+	if captured.LegalEntityCode == nil || *captured.LegalEntityCode != legalEntity || captured.IncludeInactive || captured.AsOfDate == nil || *captured.AsOfDate != asOf {
+		t.Fatalf("filter not forwarded: %#v", captured)
+	}
+	if len(centers) != 1 || centers[0].Code() != "CC-A" {
+		t.Fatalf("unexpected cost centers: %#v", centers)
+	}
+}
+
+func TestResolver_PositionCostAllocations(t *testing.T) {
+	var capturedCode string
+	repo := &stubRepository{
+		costAllocationsFn: func(_ context.Context, _ uuid.UUID, positionCode string, _ *string) ([]dto.CostAllocation, error) {
+			capturedCode = positionCode
+			return []dto.CostAllocation{{CostCenterCodeField: "CC-A", PercentageField: 60}, {CostCenterCodeField: "CC-B", PercentageField: 40}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	allocations, err := resolver.PositionCostAllocations(context.Background(), struct {
+		PositionCode string
+		AsOfDate     *string
+	}{PositionCode: "P1000001"})
+	if err != nil {
+		t.Fatalf("PositionCostAllocations returned error: %v", err)
+	}
+	if perm.lastQuery != "costCenters" || capturedCode != "P1000001" {
+		t.Fatalf("unexpected permission %s or position %s", perm.lastQuery, capturedCode)
+	}
+	if len(allocations) != 2 || allocations[0].Percentage()+allocations[1].Percentage() != 100 {
+		t.Fatalf("unexpected allocations: %#v", allocations)
+	}
+}
+
+func TestResolver_CostCenterHeadcountStats_PermissionDenied(t *testing.T) {
+	repo := &stubRepository{}
+	perm := &stubPermissionChecker{allow: false}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	_, err := resolver.CostCenterHeadcountStats(context.Background(), struct {
+		AsOfDate        *string
+		LegalEntityCode *string
+	}{})
+	if err == nil || err.Error() != "INSUFFICIENT_PERMISSIONS" {
+		t.Fatalf("expected INSUFFICIENT_PERMISSIONS, got %v", err)
+	}
+	if perm.lastQuery != "costCenterHeadcountStats" {
+		t.Fatalf("expected permission check for costCenterHeadcountStats, got %s", perm.lastQuery)
+	}
+}
+
+func TestResolver_Locations_ForwardsFilter(t *testing.T) {
+	siteType := "SITE"
+	country := "DE"
+	var captured dto.LocationFilter
+	repo := &stubRepository{
+		locationsFn: func(_ context.Context, _ uuid.UUID, filter dto.LocationFilter) ([]dto.Location, error) {
+			captured = filter
+			return []dto.Location{{CodeField: "BER-01", TypeField: siteType}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	includeInactive := true
+	locations, err := resolver.Locations(context.Background(), struct {
+		Type            *string
+		ParentCode      *string
+		CountryCode     *string
+		IncludeInactive *bool
+		AsOfDate        *string
+	}{Type: &siteType, CountryCode: &country, IncludeInactive: &includeInactive})
+	if err != nil {
+		t.Fatalf("Locations returned error: %v", err)
+	}
+	if perm.lastQuery != "locations" {
+		t.Fatalf("expected permission check for locations, got %s", perm.lastQuery)
+	}
+	if captured.Type == nil || *captured.Type != siteType || captured.CountryCode == nil || *captured.CountryCode != country || !captured.IncludeInactive {
+		t.Fatalf("filter not forwarded: %#v", captured)
+	}
+	if len(locations) != 1 || locations[0].Code() != "BER-01" {
+		t.Fatalf("unexpected locations: %#v", locations)
+	}
+}
+
+func TestResolver_PositionLocation(t *testing.T) {
+	var capturedType, capturedCode string
+	repo := &stubRepository{
+		effectiveLocationFn: func(_ context.Context, _ uuid.UUID, subjectType, code string, _ *string) (*dto.EffectiveLocation, error) {
+			capturedType, capturedCode = subjectType, code
+			return &dto.EffectiveLocation{LocationField: dto.Location{CodeField: "BER-01"}, SourceField: "ORGANIZATION_UNIT"}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	loc, err := resolver.PositionLocation(context.Background(), struct {
+		PositionCode string
+		AsOfDate     *string
+	}{PositionCode: "P1000001"})
+	if err != nil {
+		t.Fatalf("PositionLocation returned error: %v", err)
+	}
+	if perm.lastQuery != "locations" || capturedType != "POSITION" || capturedCode != "P1000001" {
+		t.Fatalf("unexpected permission %s or subject %s/%s", perm.lastQuery, capturedType, capturedCode)
+	}
+	if loc == nil || loc.Location().Code() != "BER-01" || loc.Source() != "ORGANIZATION_UNIT" {
+		t.Fatalf("unexpected location: %#v", loc)
+	}
+}
+
+func TestResolver_LocationHeadcountStats_PermissionDenied(t *testing.T) {
+	repo := &stubRepository{}
+	perm := &stubPermissionChecker{allow: false}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	_, err := resolver.LocationHeadcountStats(context.Background(), struct {
+		AsOfDate     *string
+		LocationCode *string
+	}{})
+	if err == nil || err.Error() != "INSUFFICIENT_PERMISSIONS" {
+		t.Fatalf("expected INSUFFICIENT_PERMISSIONS, got %v", err)
+	}
+	if perm.lastQuery != "locationHeadcountStats" {
+		t.Fatalf("expected permission check for locationHeadcountStats, got %s", perm.lastQuery)
+	}
+}
+
+func TestResolver_PositionAllowedTransitions(t *testing.T) {
+	var capturedCode string
+	repo := &stubRepository{
+		allowedTransitionsFn: func(_ context.Context, _ uuid.UUID, code string) ([]dto.PositionTransition, error) {
+			capturedCode = code
+			return []dto.PositionTransition{{EventField: "SUSPEND", FromStatusField: "FILLED", FailedGuardsField: []string{"NO_ACTIVE_ASSIGNMENTS"}}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	transitions, err := resolver.PositionAllowedTransitions(context.Background(), struct{ Code string }{Code: " p1000001 "})
+	if err != nil {
+		t.Fatalf("PositionAllowedTransitions returned error: %v", err)
+	}
+	if perm.lastQuery != "positionAllowedTransitions" || capturedCode != "P1000001" {
+		t.Fatalf("unexpected permission %s or code %s", perm.lastQuery, capturedCode)
+	}
+	if len(transitions) != 1 || transitions[0].Allowed() || transitions[0].FailedGuards()[0] != "NO_ACTIVE_ASSIGNMENTS" {
+		t.Fatalf("unexpected transitions: %#v", transitions)
+	}
+}
+
+func TestResolver_EmployeeAssignmentViolations(t *testing.T) {
+	var capturedAsOf *string
+	repo := &stubRepository{
+		employeeViolationsFn: func(_ context.Context, _ uuid.UUID, asOfDate *string) ([]dto.EmployeeAssignmentViolation, error) {
+			capturedAsOf = asOfDate
+			return []dto.EmployeeAssignmentViolation{{RuleField: "EMPLOYEE_PRIMARY_CONFLICT", PositionCodesField: []string{"P1000001", "P1000002"}}}, nil
+		},
+	}
+	perm := &stubPermissionChecker{allow: true}
+	resolver := NewResolver(repo, newTestLogger(), perm)
+
+	asOf := "2025-11-06"
+	violations, err := resolver.EmployeeAssignmentViolations(context.Background(), struct{ AsOfDate *string }{AsOfDate: &asOf})
+	if err != nil {
+		t.Fatalf("EmployeeAssignmentViolations returned error: %v", err)
+	}
+	if perm.lastQuery != "employeeAssignmentViolations" || capturedAsOf == nil || *capturedAsOf != asOf {
+		t.Fatalf("unexpected permission %s or asOfDate %v", perm.lastQuery, capturedAsOf)
+	}
+	if len(violations) != 1 || violations[0].Rule() != "EMPLOYEE_PRIMARY_CONFLICT" {
+		t.Fatalf("unexpected violations: %#v", violations)
+	}
+}
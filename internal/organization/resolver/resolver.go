@@ -30,10 +30,19 @@ type QueryRepository interface {
 	GetVacantPositionConnection(ctx context.Context, tenantID uuid.UUID, filter *dto.VacantPositionFilterInput, pagination *dto.PaginationInput, sorting []dto.VacantPositionSortInput) (*dto.VacantPositionConnection, error)
 	GetPositionTransfers(ctx context.Context, tenantID uuid.UUID, positionCode *string, organizationCode *string, pagination *dto.PaginationInput) (*dto.PositionTransferConnection, error)
 	GetPositionHeadcountStats(ctx context.Context, tenantID uuid.UUID, organizationCode string, includeSubordinates bool) (*dto.HeadcountStats, error)
+	GetPositionAllowedTransitions(ctx context.Context, tenantID uuid.UUID, code string) ([]dto.PositionTransition, error)
 	GetJobFamilyGroups(ctx context.Context, tenantID uuid.UUID, includeInactive bool, asOfDate *string) ([]dto.JobFamilyGroup, error)
 	GetJobFamilies(ctx context.Context, tenantID uuid.UUID, groupCode string, includeInactive bool, asOfDate *string) ([]dto.JobFamily, error)
 	GetJobRoles(ctx context.Context, tenantID uuid.UUID, familyCode string, includeInactive bool, asOfDate *string) ([]dto.JobRole, error)
 	GetJobLevels(ctx context.Context, tenantID uuid.UUID, roleCode string, includeInactive bool, asOfDate *string) ([]dto.JobLevel, error)
+	GetCompensationGrades(ctx context.Context, tenantID uuid.UUID, filter dto.CompensationGradeFilter) ([]dto.CompensationGrade, error)
+	GetCostCenters(ctx context.Context, tenantID uuid.UUID, filter dto.CostCenterFilter) ([]dto.CostCenter, error)
+	GetPositionCostAllocations(ctx context.Context, tenantID uuid.UUID, positionCode string, asOfDate *string) ([]dto.CostAllocation, error)
+	GetCostCenterHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, legalEntityCode *string) ([]dto.CostCenterHeadcount, error)
+	GetLocations(ctx context.Context, tenantID uuid.UUID, filter dto.LocationFilter) ([]dto.Location, error)
+	GetEffectiveLocation(ctx context.Context, tenantID uuid.UUID, subjectType, code string, asOfDate *string) (*dto.EffectiveLocation, error)
+	GetLocationHeadcount(ctx context.Context, tenantID uuid.UUID, asOfDate *string, locationCode *string) ([]dto.LocationHeadcount, error)
+	GetEmployeeAssignmentViolations(ctx context.Context, tenantID uuid.UUID, asOfDate *string) ([]dto.EmployeeAssignmentViolation, error)
 	GetAuditHistory(ctx context.Context, tenantID uuid.UUID, recordID string, startDate, endDate, operation, userID *string, limit int) ([]dto.AuditRecordData, error)
 	GetAuditLog(ctx context.Context, auditID string) (*dto.AuditRecordData, error)
 	GetAssignmentHistory(ctx context.Context, tenantID uuid.UUID, positionCode string, filter *dto.PositionAssignmentFilterInput, pagination *dto.PaginationInput, sorting []dto.PositionAssignmentSortInput) (*dto.PositionAssignmentConnection, error)
@@ -118,7 +127,11 @@ func (r *Resolver) Organizations(ctx context.Context, args struct {
 		log.WithFields(pkglogger.Fields{"pagination": args.Pagination}).Info("附带分页参数")
 	}
 
-	return r.repo.GetOrganizations(ctx, sharedconfig.DefaultTenantID, args.Filter, args.Pagination)
+	conn, err := r.repo.GetOrganizations(ctx, sharedconfig.DefaultTenantID, args.Filter, args.Pagination)
+	if conn != nil {
+		localizeOrganizations(ctx, conn.DataField)
+	}
+	return conn, err
 }
 
 // 单个组织查询
@@ -134,7 +147,9 @@ func (r *Resolver) Organization(ctx context.Context, args struct {
 		return nil, err
 	}
 	log.Info("查询单个组织")
-	return r.repo.GetOrganization(ctx, sharedconfig.DefaultTenantID, args.Code)
+	org, err := r.repo.GetOrganization(ctx, sharedconfig.DefaultTenantID, args.Code)
+	localizeOrganization(ctx, org)
+	return org, err
 }
 
 // 时态查询 - 时间点
@@ -151,7 +166,9 @@ func (r *Resolver) OrganizationAtDate(ctx context.Context, args struct {
 		return nil, err
 	}
 	log.Info("执行组织时态查询")
-	return r.repo.GetOrganizationAtDate(ctx, sharedconfig.DefaultTenantID, args.Code, args.Date)
+	org, err := r.repo.GetOrganizationAtDate(ctx, sharedconfig.DefaultTenantID, args.Code, args.Date)
+	localizeOrganization(ctx, org)
+	return org, err
 }
 
 // 时态查询 - 历史范围
@@ -170,7 +187,9 @@ func (r *Resolver) OrganizationHistory(ctx context.Context, args struct {
 		return nil, err
 	}
 	log.Info("执行组织历史查询")
-	return r.repo.GetOrganizationHistory(ctx, sharedconfig.DefaultTenantID, args.Code, args.FromDate, args.ToDate)
+	orgs, err := r.repo.GetOrganizationHistory(ctx, sharedconfig.DefaultTenantID, args.Code, args.FromDate, args.ToDate)
+	localizeOrganizations(ctx, orgs)
+	return orgs, err
 }
 
 // 组织版本查询 - 按计划实现，支持includeDeleted参数
@@ -191,7 +210,9 @@ func (r *Resolver) OrganizationVersions(ctx context.Context, args struct {
 		return nil, err
 	}
 	log.Info("执行组织版本查询")
-	return r.repo.GetOrganizationVersions(ctx, sharedconfig.DefaultTenantID, args.Code, includeDeleted)
+	orgs, err := r.repo.GetOrganizationVersions(ctx, sharedconfig.DefaultTenantID, args.Code, includeDeleted)
+	localizeOrganizations(ctx, orgs)
+	return orgs, err
 }
 
 // 组织统计 (camelCase方法名)
@@ -330,7 +351,9 @@ func (r *Resolver) Positions(ctx context.Context, args struct {
 		"sortCount":  len(sorting),
 	}).Info("查询职位列表")
 
-	return r.repo.GetPositions(ctx, sharedconfig.DefaultTenantID, args.Filter, args.Pagination, sorting)
+	conn, err := r.repo.GetPositions(ctx, sharedconfig.DefaultTenantID, args.Filter, args.Pagination, sorting)
+	localizePositionConnection(ctx, conn)
+	return conn, err
 }
 
 // Position 查询单个职位
@@ -348,7 +371,9 @@ func (r *Resolver) Position(ctx context.Context, args struct {
 	}
 	log.Info("查询职位详情")
 
-	return r.repo.GetPositionByCode(ctx, sharedconfig.DefaultTenantID, args.Code, args.AsOfDate)
+	position, err := r.repo.GetPositionByCode(ctx, sharedconfig.DefaultTenantID, args.Code, args.AsOfDate)
+	localizePosition(ctx, position)
+	return position, err
 }
 
 // PositionAssignments 查询职位任职记录
@@ -554,7 +579,9 @@ func (r *Resolver) PositionVersions(ctx context.Context, args struct {
 	}
 	log.Info("查询职位版本列表")
 
-	return r.repo.GetPositionVersions(ctx, sharedconfig.DefaultTenantID, args.Code, includeDeleted)
+	positions, err := r.repo.GetPositionVersions(ctx, sharedconfig.DefaultTenantID, args.Code, includeDeleted)
+	localizePositions(ctx, positions)
+	return positions, err
 }
 
 // VacantPositions 查询空缺职位
@@ -670,7 +697,9 @@ func (r *Resolver) JobFamilyGroups(ctx context.Context, args struct {
 	}
 	log.WithFields(pkglogger.Fields{"includeInactive": includeInactive, "asOfDate": args.AsOfDate}).Info("查询职类")
 
-	return r.repo.GetJobFamilyGroups(ctx, sharedconfig.DefaultTenantID, includeInactive, args.AsOfDate)
+	groups, err := r.repo.GetJobFamilyGroups(ctx, sharedconfig.DefaultTenantID, includeInactive, args.AsOfDate)
+	localizeJobFamilyGroups(ctx, groups)
+	return groups, err
 }
 
 // JobFamilies 查询职种
@@ -689,7 +718,9 @@ func (r *Resolver) JobFamilies(ctx context.Context, args struct {
 	}
 	log.WithFields(pkglogger.Fields{"includeInactive": includeInactive, "asOfDate": args.AsOfDate}).Info("查询职种")
 
-	return r.repo.GetJobFamilies(ctx, sharedconfig.DefaultTenantID, args.GroupCode, includeInactive, args.AsOfDate)
+	families, err := r.repo.GetJobFamilies(ctx, sharedconfig.DefaultTenantID, args.GroupCode, includeInactive, args.AsOfDate)
+	localizeJobFamilies(ctx, families)
+	return families, err
 }
 
 // JobRoles 查询职务
@@ -708,7 +739,9 @@ func (r *Resolver) JobRoles(ctx context.Context, args struct {
 	}
 	log.WithFields(pkglogger.Fields{"includeInactive": includeInactive, "asOfDate": args.AsOfDate}).Info("查询职务")
 
-	return r.repo.GetJobRoles(ctx, sharedconfig.DefaultTenantID, args.FamilyCode, includeInactive, args.AsOfDate)
+	roles, err := r.repo.GetJobRoles(ctx, sharedconfig.DefaultTenantID, args.FamilyCode, includeInactive, args.AsOfDate)
+	localizeJobRoles(ctx, roles)
+	return roles, err
 }
 
 // JobLevels 查询职级
@@ -727,7 +760,9 @@ func (r *Resolver) JobLevels(ctx context.Context, args struct {
 	}
 	log.WithFields(pkglogger.Fields{"includeInactive": includeInactive, "asOfDate": args.AsOfDate}).Info("查询职级")
 
-	return r.repo.GetJobLevels(ctx, sharedconfig.DefaultTenantID, args.RoleCode, includeInactive, args.AsOfDate)
+	levels, err := r.repo.GetJobLevels(ctx, sharedconfig.DefaultTenantID, args.RoleCode, includeInactive, args.AsOfDate)
+	localizeJobLevels(ctx, levels)
+	return levels, err
 }
 
 // 审计历史查询 - v4.6.0 基于record_id
@@ -778,6 +813,30 @@ func (r *Resolver) AuditHistory(ctx context.Context, args struct {
 	return r.repo.GetAuditHistory(ctx, tenantUUID, args.RecordId, args.StartDate, args.EndDate, args.Operation, args.UserId, int(limit))
 }
 
+// AuditArchiveCoverageProvider 可选能力：查询审计归档覆盖范围
+type AuditArchiveCoverageProvider interface {
+	GetAuditArchiveCoverage(ctx context.Context, tenantID uuid.UUID, startDate, endDate *string) (*dto.AuditArchiveNotice, error)
+}
+
+// AuditHistoryArchiveNotice 查询范围内存在已归档记录时返回提示；仓储不支持或查询失败时返回 nil，不影响主查询
+func (r *Resolver) AuditHistoryArchiveNotice(ctx context.Context, startDate, endDate *string) *dto.AuditArchiveNotice {
+	provider, ok := r.repo.(AuditArchiveCoverageProvider)
+	if !ok {
+		return nil
+	}
+	tenantUUID, err := uuid.Parse(auth.GetTenantID(ctx))
+	if err != nil {
+		return nil
+	}
+	notice, err := provider.GetAuditArchiveCoverage(ctx, tenantUUID, startDate, endDate)
+	if err != nil {
+		r.loggerFor("audit", "archiveNotice", pkglogger.Fields{"tenantId": tenantUUID.String()}).
+			WithFields(pkglogger.Fields{"error": err}).Warn("审计归档覆盖查询失败，忽略归档提示")
+		return nil
+	}
+	return notice
+}
+
 // 单条审计记录查询 - v4.6.0
 func (r *Resolver) AuditLog(ctx context.Context, args struct {
 	AuditId string
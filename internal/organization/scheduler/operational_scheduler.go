@@ -9,9 +9,12 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	configpkg "cube-castle/internal/config"
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/notification"
 	"cube-castle/internal/organization/service"
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
@@ -24,6 +27,9 @@ type OperationalScheduler struct {
 	logger          pkglogger.Logger
 	monitor         *TemporalMonitor
 	positions       *service.PositionService
+	auditChain      *auditpkg.ChainService
+	auditArchive    *auditpkg.ArchiveService
+	notifications   *notification.Service
 	scriptsPath     string
 	config          *configpkg.SchedulerConfig
 	tasks           map[string]*ScheduledTask
@@ -32,7 +38,9 @@ type OperationalScheduler struct {
 	running         bool
 	monitorEnabled  bool
 	monitorInterval time.Duration
-	mu              sync.RWMutex
+	// paused 运行时开关：暂停时不再按 cron 派发任务（手动触发不受影响）
+	paused atomic.Bool
+	mu     sync.RWMutex
 }
 
 // ScheduledTask 描述单个任务的运行时状态。
@@ -72,6 +80,9 @@ func NewOperationalScheduler(
 	baseLogger pkglogger.Logger,
 	monitor *TemporalMonitor,
 	positions *service.PositionService,
+	auditChain *auditpkg.ChainService,
+	auditArchive *auditpkg.ArchiveService,
+	notifications *notification.Service,
 	cfg *configpkg.SchedulerConfig,
 ) *OperationalScheduler {
 	logger := scopedLogger(baseLogger, "operationalScheduler", nil)
@@ -102,6 +113,9 @@ func NewOperationalScheduler(
 		logger:          logger,
 		monitor:         monitor,
 		positions:       positions,
+		auditChain:      auditChain,
+		auditArchive:    auditArchive,
+		notifications:   notifications,
 		scriptsPath:     scriptsPath,
 		config:          cfg,
 		tasks:           taskMap,
@@ -229,6 +243,21 @@ func (s *OperationalScheduler) Stop() {
 	s.logger.Info("运维任务调度器已停止")
 }
 
+// SetPaused 暂停或恢复定时任务派发。
+func (s *OperationalScheduler) SetPaused(paused bool) {
+	if s == nil {
+		return
+	}
+	if s.paused.Swap(paused) != paused {
+		s.logger.WithFields(pkglogger.Fields{"paused": paused}).Info("运维任务调度暂停状态变更")
+	}
+}
+
+// IsPaused 返回定时任务派发是否已暂停。
+func (s *OperationalScheduler) IsPaused() bool {
+	return s != nil && s.paused.Load()
+}
+
 func (s *OperationalScheduler) schedulingLoop(ctx context.Context) {
 	ticker := time.NewTicker(s.tickInterval)
 	defer ticker.Stop()
@@ -242,6 +271,9 @@ func (s *OperationalScheduler) schedulingLoop(ctx context.Context) {
 			s.logger.Info("收到停止信号，结束调度循环")
 			return
 		case now := <-ticker.C:
+			if s.paused.Load() {
+				continue
+			}
 			for _, task := range s.tasks {
 				task.mu.Lock()
 				if !task.Enabled {
@@ -286,6 +318,18 @@ func (s *OperationalScheduler) executeTask(ctx context.Context, task *ScheduledT
 	switch task.Name {
 	case "acting_assignment_auto_revert":
 		err = s.runActingAssignmentAutoRevert(ctx)
+	case "audit_chain_checkpoint":
+		err = s.runAuditChainCheckpoint(ctx)
+	case "audit_chain_verify":
+		err = s.runAuditChainVerify(ctx)
+	case "audit_retention_archive":
+		err = s.runAuditRetentionArchive(ctx)
+	case "notification_acting_reminders":
+		err = s.runNotificationScan(ctx, "ACTING-REMINDER", s.notifications.ScanActingExpiring)
+	case "notification_delivery_retry":
+		err = s.runNotificationScan(ctx, "DELIVERY-RETRY", s.notifications.DeliverPending)
+	case "notification_vacancy_scan":
+		err = s.runNotificationScan(ctx, "VACANCY", s.notifications.ScanVacantPositions)
 	case "system_monitoring":
 		err = s.executeMonitoring(ctx)
 	default:
@@ -463,3 +507,64 @@ func (s *OperationalScheduler) runActingAssignmentAutoRevert(ctx context.Context
 
 	return nil
 }
+
+func (s *OperationalScheduler) runAuditChainCheckpoint(ctx context.Context) error {
+	if s.auditChain == nil {
+		return fmt.Errorf("audit chain service 未配置")
+	}
+	created, err := s.auditChain.CheckpointAllTenants(ctx)
+	if err != nil {
+		return err
+	}
+	s.logger.Infof("[AUDIT-CHAIN] 已签发 %d 个审计链检查点", created)
+	return nil
+}
+
+func (s *OperationalScheduler) runAuditChainVerify(ctx context.Context) error {
+	if s.auditChain == nil {
+		return fmt.Errorf("audit chain service 未配置")
+	}
+	reports, err := s.auditChain.VerifyAllTenants(ctx)
+	if err != nil {
+		return err
+	}
+	var broken []string
+	for _, report := range reports {
+		if !report.Valid {
+			broken = append(broken, fmt.Sprintf("%s@%d(%s)", report.TenantID, report.FirstBroken.Seq, report.FirstBroken.Reason))
+		}
+	}
+	if len(broken) > 0 {
+		return fmt.Errorf("审计哈希链断裂: %s", strings.Join(broken, ", "))
+	}
+	s.logger.Infof("[AUDIT-CHAIN] %d 个租户审计链校验通过", len(reports))
+	return nil
+}
+
+func (s *OperationalScheduler) runAuditRetentionArchive(ctx context.Context) error {
+	if s.auditArchive == nil {
+		return fmt.Errorf("audit archive service 未配置")
+	}
+	results, err := s.auditArchive.RunRetention(ctx)
+	if err != nil {
+		return err
+	}
+	records := 0
+	for _, result := range results {
+		records += result.Records
+	}
+	s.logger.Infof("[AUDIT-ARCHIVE] %d 个租户执行保留策略，归档 %d 条审计记录", len(results), records)
+	return nil
+}
+
+func (s *OperationalScheduler) runNotificationScan(ctx context.Context, label string, run func(context.Context) (int, error)) error {
+	if s.notifications == nil {
+		return fmt.Errorf("notification service 未配置")
+	}
+	count, err := run(ctx)
+	if err != nil {
+		return err
+	}
+	s.logger.Infof("[NOTIFICATION][%s] 本轮处理 %d 条通知投递", label, count)
+	return nil
+}
@@ -47,7 +47,7 @@ func TestOperationalScheduler_RunTaskExecutesScript(t *testing.T) {
 	}
 
 	logger := pkglogger.NewLogger(pkglogger.WithWriter(io.Discard))
-	s := NewOperationalScheduler(db, logger, nil, nil, cfg)
+	s := NewOperationalScheduler(db, logger, nil, nil, nil, nil, nil, cfg)
 
 	mock.ExpectExec("SELECT 1;").WillReturnResult(sqlmock.NewResult(0, 0))
 
@@ -96,7 +96,7 @@ func TestOperationalScheduler_RunTaskDisabledScheduler(t *testing.T) {
 	}
 
 	logger := pkglogger.NewLogger(pkglogger.WithWriter(io.Discard))
-	s := NewOperationalScheduler(db, logger, nil, nil, cfg)
+	s := NewOperationalScheduler(db, logger, nil, nil, nil, nil, nil, cfg)
 
 	if err := s.RunTask(context.Background(), "noop"); err == nil {
 		t.Fatalf("expected scheduler disabled error")
@@ -119,7 +119,7 @@ func TestOperationalScheduler_RunTaskUnknown(t *testing.T) {
 	}
 
 	logger := pkglogger.NewLogger(pkglogger.WithWriter(io.Discard))
-	s := NewOperationalScheduler(db, logger, nil, nil, cfg)
+	s := NewOperationalScheduler(db, logger, nil, nil, nil, nil, nil, cfg)
 
 	if err := s.RunTask(context.Background(), "missing_task"); err == nil {
 		t.Fatalf("expected error for unknown task")
@@ -38,17 +38,19 @@ func NewOrganizationTemporalService(db *sql.DB, baseLogger pkglogger.Logger) *Or
 
 // TemporalCreateVersionRequest 创建版本请求
 type TemporalCreateVersionRequest struct {
-	TenantID        string    `json:"tenantId" validate:"required,uuid"`
-	Code            string    `json:"code" validate:"required,max=10"`
-	Name            string    `json:"name" validate:"required,max=255"`
-	UnitType        string    `json:"unitType" validate:"required"`
-	Status          string    `json:"status" validate:"required"`
-	ParentCode      *string   `json:"parentCode,omitempty"`
-	Level           int       `json:"level" validate:"min=1,max=17"`
-	SortOrder       int       `json:"sortOrder"`
-	Description     string    `json:"description,omitempty"`
-	EffectiveDate   time.Time `json:"effectiveDate" validate:"required"`
-	OperationReason string    `json:"operationReason" validate:"omitempty,max=500"`
+	TenantID    string  `json:"tenantId" validate:"required,uuid"`
+	Code        string  `json:"code" validate:"required,max=10"`
+	Name        string  `json:"name" validate:"required,max=255"`
+	UnitType    string  `json:"unitType" validate:"required"`
+	Status      string  `json:"status" validate:"required"`
+	ParentCode  *string `json:"parentCode,omitempty"`
+	Level       int     `json:"level" validate:"min=1,max=17"`
+	SortOrder   int     `json:"sortOrder"`
+	Description string  `json:"description,omitempty"`
+	// NameI18n 该版本的多语言名称
+	NameI18n        types.LocalizedNames `json:"nameI18n,omitempty"`
+	EffectiveDate   time.Time            `json:"effectiveDate" validate:"required"`
+	OperationReason string               `json:"operationReason" validate:"omitempty,max=500"`
 }
 
 // TemporalUpdateVersionRequest 更新版本请求
@@ -119,6 +121,7 @@ func (s *OrganizationTemporalService) CreateVersion(ctx context.Context, req *Te
 		ParentCode:    normalizedParent,
 		SortOrder:     req.SortOrder,
 		Description:   req.Description,
+		NameI18n:      req.NameI18n,
 		EffectiveDate: types.NewDateFromTime(req.EffectiveDate),
 		ChangeReason: func() *string {
 			if normalizedReason == "" {
@@ -129,13 +132,14 @@ func (s *OrganizationTemporalService) CreateVersion(ctx context.Context, req *Te
 		}(),
 	}
 
-	fields, calcErr := s.orgRepo.ComputeHierarchyForNew(ctx, tenantID, req.Code, normalizedParent, req.Name)
+	fields, calcErr := s.orgRepo.ComputeHierarchyForNew(ctx, tenantID, req.Code, normalizedParent, req.Name, req.NameI18n)
 	if calcErr != nil {
 		return nil, fmt.Errorf("计算组织层级失败: %w", calcErr)
 	}
 	org.Level = fields.Level
 	org.CodePath = fields.CodePath
 	org.NamePath = fields.NamePath
+	org.NamePathI18n = fields.NamePathI18n
 
 	// 1. 执行时态操作
 	result, err = s.timelineManager.InsertVersion(ctx, org)
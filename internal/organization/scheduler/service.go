@@ -5,6 +5,8 @@ import (
 	"database/sql"
 
 	configpkg "cube-castle/internal/config"
+	auditpkg "cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/notification"
 	"cube-castle/internal/organization/repository"
 	servicepkg "cube-castle/internal/organization/service"
 	pkglogger "cube-castle/pkg/logger"
@@ -26,6 +28,9 @@ type Dependencies struct {
 	Logger                 pkglogger.Logger
 	OrganizationRepository *repository.OrganizationRepository
 	PositionService        *servicepkg.PositionService
+	AuditChain             *auditpkg.ChainService
+	AuditArchive           *auditpkg.ArchiveService
+	Notifications          *notification.Service
 	Config                 *configpkg.SchedulerConfig
 }
 
@@ -43,7 +48,19 @@ func NewService(deps Dependencies) *Service {
 
 	temporal := NewTemporalService(deps.DB, logger, deps.OrganizationRepository)
 	monitor := NewTemporalMonitor(deps.DB, logger)
-	operational := NewOperationalScheduler(deps.DB, logger, monitor, deps.PositionService, cfg)
+	if len(cfg.Monitor.AlertRules) > 0 {
+		rules := make([]AlertRule, 0, len(cfg.Monitor.AlertRules))
+		for _, rule := range cfg.Monitor.AlertRules {
+			rules = append(rules, AlertRule{
+				Name:        rule.Name,
+				Description: rule.Description,
+				Threshold:   rule.Threshold,
+				AlertLevel:  rule.Level,
+			})
+		}
+		monitor.SetAlertRules(rules)
+	}
+	operational := NewOperationalScheduler(deps.DB, logger, monitor, deps.PositionService, deps.AuditChain, deps.AuditArchive, deps.Notifications, cfg)
 	orgTemporal := NewOrganizationTemporalService(deps.DB, logger)
 
 	return &Service{
@@ -14,6 +14,7 @@ import (
 type TemporalMonitor struct {
 	db     *sql.DB
 	logger pkglogger.Logger
+	rules  []AlertRule
 }
 
 // NewTemporalMonitor 创建时态监控服务
@@ -410,6 +411,38 @@ func (m *TemporalMonitor) calculateHealthScore(metrics *MonitoringMetrics) {
 	}
 }
 
+// SetAlertRules 使用配置（scheduler.yaml monitor.alertRules）中的规则替换默认规则
+func (m *TemporalMonitor) SetAlertRules(rules []AlertRule) {
+	m.rules = rules
+}
+
+func (m *TemporalMonitor) alertRules() []AlertRule {
+	if len(m.rules) > 0 {
+		return m.rules
+	}
+	return m.GetDefaultAlertRules()
+}
+
+// AlertMetrics 以 temporal.* 命名导出监控指标，供声明式告警规则引用
+func (m *TemporalMonitor) AlertMetrics(ctx context.Context) (map[string]float64, error) {
+	metrics, err := m.CollectMetrics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect metrics: %w", err)
+	}
+	return map[string]float64{
+		"temporal.total_organizations":       float64(metrics.TotalOrganizations),
+		"temporal.current_records":           float64(metrics.CurrentRecords),
+		"temporal.future_records":            float64(metrics.FutureRecords),
+		"temporal.historical_records":        float64(metrics.HistoricalRecords),
+		"temporal.duplicate_current_records": float64(metrics.DuplicateCurrentCount),
+		"temporal.missing_current_records":   float64(metrics.MissingCurrentCount),
+		"temporal.timeline_overlaps":         float64(metrics.TimelineOverlapCount),
+		"temporal.inconsistent_flags":        float64(metrics.InconsistentFlagCount),
+		"temporal.orphan_records":            float64(metrics.OrphanRecordCount),
+		"temporal.health_score":              metrics.HealthScore,
+	}, nil
+}
+
 // CheckAlerts 检查告警条件
 func (m *TemporalMonitor) CheckAlerts(ctx context.Context) ([]string, error) {
 	metrics, err := m.CollectMetrics(ctx)
@@ -418,7 +451,7 @@ func (m *TemporalMonitor) CheckAlerts(ctx context.Context) ([]string, error) {
 	}
 
 	var alerts []string
-	rules := m.GetDefaultAlertRules()
+	rules := m.alertRules()
 
 	for _, rule := range rules {
 		var currentValue int
@@ -9,6 +9,7 @@ import (
 
 	"cube-castle/internal/organization/repository"
 	"cube-castle/internal/organization/utils"
+	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -638,11 +639,12 @@ type orgSnapshot struct {
 	ParentCode  *string
 	SortOrder   int
 	Description string
+	NameI18n    types.LocalizedNames
 }
 
 func (s *TemporalService) loadLatestSnapshot(tx *sql.Tx, tenantID uuid.UUID, code string) (*orgSnapshot, error) {
 	query := `
-		SELECT name, unit_type, status, parent_code, sort_order, description
+		SELECT name, unit_type, status, parent_code, sort_order, description, name_i18n
 		FROM organization_units
 		WHERE tenant_id = $1 AND code = $2 AND status <> 'DELETED'
 		ORDER BY effective_date DESC
@@ -654,7 +656,7 @@ func (s *TemporalService) loadLatestSnapshot(tx *sql.Tx, tenantID uuid.UUID, cod
 	var sortOrder sql.NullInt64
 	var description sql.NullString
 
-	err := tx.QueryRow(query, tenantID, code).Scan(&snap.Name, &snap.UnitType, &snap.Status, &parent, &sortOrder, &description)
+	err := tx.QueryRow(query, tenantID, code).Scan(&snap.Name, &snap.UnitType, &snap.Status, &parent, &sortOrder, &description, &snap.NameI18n)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -733,7 +735,13 @@ func (s *TemporalService) insertVersion(ctx context.Context, tx *sql.Tx, data *i
 		finalDescription = snapshot.Description
 	}
 
-	fields, calcErr := s.orgRepo.ComputeHierarchyForNew(ctx, data.TenantID, data.Code, parentCode, finalName)
+	// 多语言名称沿用最新版本
+	var nameI18n types.LocalizedNames
+	if snapshot != nil {
+		nameI18n = snapshot.NameI18n
+	}
+
+	fields, calcErr := s.orgRepo.ComputeHierarchyForNew(ctx, data.TenantID, data.Code, parentCode, finalName, nameI18n)
 	if calcErr != nil {
 		return nil, fmt.Errorf("failed to compute hierarchy fields: %w", calcErr)
 	}
@@ -744,8 +752,14 @@ func (s *TemporalService) insertVersion(ctx context.Context, tx *sql.Tx, data *i
 		INSERT INTO organization_units (
 			record_id, tenant_id, code, effective_date, end_date, is_current,
 			status, name, unit_type, parent_code, level, code_path, name_path,
-			sort_order, description, change_reason, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW(), NOW())
+			sort_order, description, change_reason, created_at, updated_at, name_i18n, name_path_i18n, custom_fields
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW(), NOW(), $17, $18,
+			COALESCE((
+				SELECT prev.custom_fields FROM organization_units prev
+				WHERE prev.tenant_id = $2 AND prev.code = $3 AND prev.status <> 'DELETED' AND prev.effective_date < $4
+				ORDER BY prev.effective_date DESC
+				LIMIT 1
+			), '{}'::jsonb))
 	`
 
 	changeReason := strings.TrimSpace(payload.OperationReason)
@@ -753,7 +767,7 @@ func (s *TemporalService) insertVersion(ctx context.Context, tx *sql.Tx, data *i
 	_, err = tx.Exec(query,
 		recordID, data.TenantID, data.Code, data.EffectiveDate, nil, data.IsCurrent,
 		finalStatus, finalName, finalUnitType, parentCode, fields.Level, fields.CodePath, fields.NamePath,
-		finalSortOrder, finalDescription, changeReason,
+		finalSortOrder, finalDescription, changeReason, nameI18n, fields.NamePathI18n,
 	)
 	if err != nil {
 		return nil, err
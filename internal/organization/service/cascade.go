@@ -21,6 +21,9 @@ type CascadeUpdateService struct {
 	shutdown      chan struct{}
 	running       bool
 	mu            sync.RWMutex
+	// resume 非空表示已暂停：工作协程停止取任务，关闭该通道即恢复
+	resume  chan struct{}
+	pauseMu sync.Mutex
 }
 
 // CascadeTask 级联任务
@@ -97,6 +100,32 @@ func (c *CascadeUpdateService) Stop() {
 	c.logger.Info("级联更新服务已停止")
 }
 
+// SetPaused 暂停或恢复任务处理；暂停期间任务在队列中排队（队列满时提交失败）。
+func (c *CascadeUpdateService) SetPaused(paused bool) {
+	c.pauseMu.Lock()
+	defer c.pauseMu.Unlock()
+	switch {
+	case paused && c.resume == nil:
+		c.resume = make(chan struct{})
+		c.logger.Warn("级联更新工作协程已暂停")
+	case !paused && c.resume != nil:
+		close(c.resume)
+		c.resume = nil
+		c.logger.Info("级联更新工作协程已恢复")
+	}
+}
+
+// IsPaused 返回工作协程是否处于暂停状态。
+func (c *CascadeUpdateService) IsPaused() bool {
+	return c.pauseGate() != nil
+}
+
+func (c *CascadeUpdateService) pauseGate() chan struct{} {
+	c.pauseMu.Lock()
+	defer c.pauseMu.Unlock()
+	return c.resume
+}
+
 // worker 工作协程
 func (c *CascadeUpdateService) worker(workerID int) {
 	defer c.wg.Done()
@@ -104,6 +133,14 @@ func (c *CascadeUpdateService) worker(workerID int) {
 	c.logger.Infof("工作协程 %d 已启动", workerID)
 
 	for {
+		if resume := c.pauseGate(); resume != nil {
+			select {
+			case <-resume:
+			case <-c.shutdown:
+				c.logger.Infof("工作协程 %d 退出 (收到停止信号)", workerID)
+				return
+			}
+		}
 		select {
 		case task, ok := <-c.taskQueue:
 			if !ok {
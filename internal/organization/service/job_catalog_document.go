@@ -0,0 +1,321 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"cube-castle/internal/types"
+	"gopkg.in/yaml.v3"
+)
+
+// jobCatalogCSVHeader CSV 交换格式列定义：每行一个版本，层级关系通过 parentCode 表达；
+// nameI18n / competencyModel / salaryBand 为 JSON 对象字符串，条目级属性仅需出现在该条目的任一行。
+var jobCatalogCSVHeader = []string{
+	"level", "code", "parentCode", "effectiveDate", "status", "name", "description",
+	"nameI18n", "levelRank", "competencyModel", "salaryBand",
+}
+
+var jobCatalogCSVRequired = []string{"level", "code", "effectiveDate", "status", "name"}
+
+// jobCatalogParentLevel 各层级的上级层级
+var jobCatalogParentLevel = map[string]string{
+	types.JobCatalogLevelFamily: types.JobCatalogLevelFamilyGroup,
+	types.JobCatalogLevelRole:   types.JobCatalogLevelFamily,
+	types.JobCatalogLevelLevel:  types.JobCatalogLevelRole,
+}
+
+// NormalizeJobCatalogFormat 规范化交换格式名称，不支持时返回空字符串
+func NormalizeJobCatalogFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case types.JobCatalogFormatJSON:
+		return types.JobCatalogFormatJSON
+	case types.JobCatalogFormatYAML, "yml":
+		return types.JobCatalogFormatYAML
+	case types.JobCatalogFormatCSV:
+		return types.JobCatalogFormatCSV
+	default:
+		return ""
+	}
+}
+
+// DecodeJobCatalogDocument 按格式解析职位目录交换文档；格式或内容无效时返回 ErrJobCatalogInvalidInput
+func DecodeJobCatalogDocument(r io.Reader, format string) (*types.JobCatalogDocument, error) {
+	var doc types.JobCatalogDocument
+	switch NormalizeJobCatalogFormat(format) {
+	case types.JobCatalogFormatJSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: invalid json document: %v", ErrJobCatalogInvalidInput, err)
+		}
+	case types.JobCatalogFormatYAML:
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("%w: empty yaml document", ErrJobCatalogInvalidInput)
+			}
+			return nil, fmt.Errorf("%w: invalid yaml document: %v", ErrJobCatalogInvalidInput, err)
+		}
+	case types.JobCatalogFormatCSV:
+		return decodeJobCatalogCSV(r)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrJobCatalogInvalidInput, format)
+	}
+	return &doc, nil
+}
+
+// EncodeJobCatalogDocument 按格式输出职位目录交换文档
+func EncodeJobCatalogDocument(w io.Writer, doc *types.JobCatalogDocument, format string) error {
+	switch NormalizeJobCatalogFormat(format) {
+	case types.JobCatalogFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(doc)
+	case types.JobCatalogFormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case types.JobCatalogFormatCSV:
+		return encodeJobCatalogCSV(w, doc)
+	default:
+		return fmt.Errorf("%w: unsupported format %q", ErrJobCatalogInvalidInput, format)
+	}
+}
+
+func encodeJobCatalogCSV(w io.Writer, doc *types.JobCatalogDocument) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(jobCatalogCSVHeader); err != nil {
+		return err
+	}
+
+	writeEntry := func(level, code, parentCode, levelRank string, attributes map[string]interface{}, versions []types.JobCatalogDocumentVersion) error {
+		for i, version := range versions {
+			nameI18n, err := marshalCSVObject(version.NameI18n)
+			if err != nil {
+				return err
+			}
+			var competency, salaryBand string
+			// 条目级 JSON 属性仅写在首行
+			if i == 0 {
+				encoded, err := marshalCSVObject(attributes)
+				if err != nil {
+					return err
+				}
+				if level == types.JobCatalogLevelRole {
+					competency = encoded
+				} else {
+					salaryBand = encoded
+				}
+			}
+			description := ""
+			if version.Description != nil {
+				description = *version.Description
+			}
+			if err := writer.Write([]string{
+				level, code, parentCode, version.EffectiveDate, version.Status, version.Name, description,
+				nameI18n, levelRank, competency, salaryBand,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, group := range doc.FamilyGroups {
+		if err := writeEntry(types.JobCatalogLevelFamilyGroup, group.Code, "", "", nil, group.Versions); err != nil {
+			return err
+		}
+		for _, family := range group.Families {
+			if err := writeEntry(types.JobCatalogLevelFamily, family.Code, group.Code, "", nil, family.Versions); err != nil {
+				return err
+			}
+			for _, role := range family.Roles {
+				if err := writeEntry(types.JobCatalogLevelRole, role.Code, family.Code, "", role.CompetencyModel, role.Versions); err != nil {
+					return err
+				}
+				for _, level := range role.Levels {
+					if err := writeEntry(types.JobCatalogLevelLevel, level.Code, role.Code, level.LevelRank, level.SalaryBand, level.Versions); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func marshalCSVObject[V any](value map[string]V) (string, error) {
+	if len(value) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// catalogDocumentEntry 按 (层级, 编码) 聚合的扁平条目，用于组装层级文档（CSV 解析与导出共用）
+type catalogDocumentEntry struct {
+	level      string
+	code       string
+	parentCode string
+	levelRank  string
+	attributes map[string]interface{}
+	versions   []types.JobCatalogDocumentVersion
+}
+
+func decodeJobCatalogCSV(r io.Reader) (*types.JobCatalogDocument, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: empty csv document", ErrJobCatalogInvalidInput)
+		}
+		return nil, fmt.Errorf("%w: invalid csv header: %v", ErrJobCatalogInvalidInput, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range jobCatalogCSVRequired {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: csv header missing column %q", ErrJobCatalogInvalidInput, name)
+		}
+	}
+
+	var (
+		order   []*catalogDocumentEntry
+		entries = map[string]*catalogDocumentEntry{}
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid csv row: %v", ErrJobCatalogInvalidInput, err)
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if idx, ok := columns[name]; ok && idx < len(record) {
+				return strings.TrimSpace(record[idx])
+			}
+			return ""
+		}
+
+		level := strings.ToUpper(field("level"))
+		code := strings.ToUpper(field("code"))
+		parentCode := strings.ToUpper(field("parentCode"))
+		if level != types.JobCatalogLevelFamilyGroup && jobCatalogParentLevel[level] == "" {
+			return nil, fmt.Errorf("%w: csv line %d: unsupported level %q", ErrJobCatalogInvalidInput, line, level)
+		}
+
+		key := level + "/" + code
+		entry, ok := entries[key]
+		if !ok {
+			entry = &catalogDocumentEntry{level: level, code: code, parentCode: parentCode}
+			entries[key] = entry
+			order = append(order, entry)
+		} else if entry.parentCode != parentCode {
+			return nil, fmt.Errorf("%w: csv line %d: %s has conflicting parentCode %q and %q", ErrJobCatalogInvalidInput, line, code, entry.parentCode, parentCode)
+		}
+
+		version := types.JobCatalogDocumentVersion{
+			EffectiveDate: field("effectiveDate"),
+			Name:          field("name"),
+			Status:        field("status"),
+		}
+		if description := field("description"); description != "" {
+			version.Description = &description
+		}
+		if raw := field("nameI18n"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &version.NameI18n); err != nil {
+				return nil, fmt.Errorf("%w: csv line %d: invalid nameI18n: %v", ErrJobCatalogInvalidInput, line, err)
+			}
+		}
+		entry.versions = append(entry.versions, version)
+
+		if rank := field("levelRank"); rank != "" && entry.levelRank == "" {
+			entry.levelRank = rank
+		}
+		attributeColumn := ""
+		switch level {
+		case types.JobCatalogLevelRole:
+			attributeColumn = "competencyModel"
+		case types.JobCatalogLevelLevel:
+			attributeColumn = "salaryBand"
+		}
+		if raw := field(attributeColumn); raw != "" && entry.attributes == nil {
+			if err := json.Unmarshal([]byte(raw), &entry.attributes); err != nil {
+				return nil, fmt.Errorf("%w: csv line %d: invalid %s: %v", ErrJobCatalogInvalidInput, line, attributeColumn, err)
+			}
+		}
+	}
+
+	return assembleJobCatalogDocument(order, entries)
+}
+
+// assembleJobCatalogDocument 按 parentCode 将扁平条目组装为层级文档，上级条目必须出现在文档中
+func assembleJobCatalogDocument(order []*catalogDocumentEntry, entries map[string]*catalogDocumentEntry) (*types.JobCatalogDocument, error) {
+	doc := &types.JobCatalogDocument{FormatVersion: types.JobCatalogDocumentFormatVersion}
+	groups := map[string]int{}
+	families := map[string][2]int{}
+	roles := map[string][3]int{}
+
+	parentOf := func(entry *catalogDocumentEntry) error {
+		parentLevel := jobCatalogParentLevel[entry.level]
+		if entry.parentCode == "" {
+			return fmt.Errorf("%w: %s %s requires parentCode", ErrJobCatalogInvalidInput, entry.level, entry.code)
+		}
+		if _, ok := entries[parentLevel+"/"+entry.parentCode]; !ok {
+			return fmt.Errorf("%w: parent %s %s of %s not found in document", ErrJobCatalogInvalidInput, parentLevel, entry.parentCode, entry.code)
+		}
+		return nil
+	}
+
+	// 按层级依次挂载，保证上级先于下级（行顺序不受限制）
+	for _, level := range []string{types.JobCatalogLevelFamilyGroup, types.JobCatalogLevelFamily, types.JobCatalogLevelRole, types.JobCatalogLevelLevel} {
+		for _, entry := range order {
+			if entry.level != level {
+				continue
+			}
+			if level != types.JobCatalogLevelFamilyGroup {
+				if err := parentOf(entry); err != nil {
+					return nil, err
+				}
+			}
+			switch level {
+			case types.JobCatalogLevelFamilyGroup:
+				groups[entry.code] = len(doc.FamilyGroups)
+				doc.FamilyGroups = append(doc.FamilyGroups, types.JobCatalogDocumentGroup{Code: entry.code, Versions: entry.versions})
+			case types.JobCatalogLevelFamily:
+				g := groups[entry.parentCode]
+				group := &doc.FamilyGroups[g]
+				families[entry.code] = [2]int{g, len(group.Families)}
+				group.Families = append(group.Families, types.JobCatalogDocumentFamily{Code: entry.code, Versions: entry.versions})
+			case types.JobCatalogLevelRole:
+				idx := families[entry.parentCode]
+				family := &doc.FamilyGroups[idx[0]].Families[idx[1]]
+				roles[entry.code] = [3]int{idx[0], idx[1], len(family.Roles)}
+				family.Roles = append(family.Roles, types.JobCatalogDocumentRole{Code: entry.code, CompetencyModel: entry.attributes, Versions: entry.versions})
+			case types.JobCatalogLevelLevel:
+				idx := roles[entry.parentCode]
+				role := &doc.FamilyGroups[idx[0]].Families[idx[1]].Roles[idx[2]]
+				role.Levels = append(role.Levels, types.JobCatalogDocumentLevel{Code: entry.code, LevelRank: entry.levelRank, SalaryBand: entry.attributes, Versions: entry.versions})
+			}
+		}
+	}
+	return doc, nil
+}
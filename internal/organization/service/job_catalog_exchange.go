@@ -0,0 +1,594 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/events"
+	"cube-castle/internal/organization/repository"
+	validator "cube-castle/internal/organization/validator"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+// jobCatalogImportMaxVersions 单次导入允许的最大版本数，避免超大事务
+const jobCatalogImportMaxVersions = 5000
+
+// jobCatalogImportEntry 规范化后的文档条目，按上级先于下级的顺序排列
+type jobCatalogImportEntry struct {
+	level      string
+	code       string
+	parentCode string
+	levelRank  string
+	attributes map[string]interface{}
+	versions   []jobCatalogImportVersion
+}
+
+type jobCatalogImportVersion struct {
+	effectiveDate time.Time
+	types.JobCatalogDocumentVersion
+}
+
+// jobCatalogImportStep 导入差异中的单个版本及其待执行动作
+type jobCatalogImportStep struct {
+	entry   *jobCatalogImportEntry
+	version *jobCatalogImportVersion
+	change  types.JobCatalogImportChange
+}
+
+// ExportJobCatalog 导出租户职位目录的全部版本；条目的上级编码与职务/职级的附加属性取自最新版本。
+func (s *JobCatalogService) ExportJobCatalog(ctx context.Context, tenantID uuid.UUID) (*types.JobCatalogDocument, error) {
+	rows, err := s.repo.ListCatalogSnapshot(ctx, nil, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return buildJobCatalogDocument(rows)
+}
+
+// buildJobCatalogDocument 将目录快照（按层级、编码、生效日期排序）组装为交换文档
+func buildJobCatalogDocument(rows []repository.JobCatalogSnapshotRow) (*types.JobCatalogDocument, error) {
+	var (
+		order   []*catalogDocumentEntry
+		entries = map[string]*catalogDocumentEntry{}
+	)
+	for _, row := range rows {
+		key := row.Level + "/" + row.Code
+		entry, ok := entries[key]
+		if !ok {
+			entry = &catalogDocumentEntry{level: row.Level, code: row.Code}
+			entries[key] = entry
+			order = append(order, entry)
+		}
+		entry.parentCode = row.ParentCode
+		entry.levelRank = row.LevelRank
+		entry.attributes = nil
+		if len(row.Attributes) > 0 {
+			var attributes map[string]interface{}
+			if err := json.Unmarshal(row.Attributes, &attributes); err != nil {
+				return nil, fmt.Errorf("invalid %s attributes for %s: %w", row.Level, row.Code, err)
+			}
+			if len(attributes) > 0 {
+				entry.attributes = attributes
+			}
+		}
+
+		version := types.JobCatalogDocumentVersion{
+			EffectiveDate: row.EffectiveDate.Format(jobCatalogDateLayout),
+			Name:          row.Name,
+			Status:        row.Status,
+		}
+		if len(row.NameI18n) > 0 {
+			version.NameI18n = row.NameI18n
+		}
+		if row.Description.Valid {
+			description := row.Description.String
+			version.Description = &description
+		}
+		entry.versions = append(entry.versions, version)
+	}
+
+	doc, err := assembleJobCatalogDocument(order, entries)
+	if err != nil {
+		return nil, err
+	}
+	if doc.FamilyGroups == nil {
+		doc.FamilyGroups = []types.JobCatalogDocumentGroup{}
+	}
+	return doc, nil
+}
+
+// ImportJobCatalog 导入职位目录交换文档：先与租户现有目录比对生成逐版本差异，非 DryRun 时在单个事务内
+// 通过 Insert* / Insert*Version 写入新增条目与版本。导入为增量合并，文档未出现的条目保持不变；
+// 同一生效日期的版本内容不一致或上级编码不一致视为冲突，存在冲突时拒绝写入。
+func (s *JobCatalogService) ImportJobCatalog(ctx context.Context, tenantID uuid.UUID, doc *types.JobCatalogDocument, dryRun bool, operator types.OperatedByInfo) (*types.JobCatalogImportResult, error) {
+	operation := "ImportJobCatalog"
+	entries, err := normalizeJobCatalogDocument(operation, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *types.JobCatalogImportResult
+	err = s.repo.WithTx(ctx, operation, func(ctx context.Context, tx *sql.Tx) error {
+		snapshot, err := s.repo.ListCatalogSnapshot(ctx, tx, tenantID)
+		if err != nil {
+			return err
+		}
+		steps, summary := planJobCatalogImport(entries, snapshot)
+
+		attempt := &types.JobCatalogImportResult{DryRun: dryRun, Summary: summary}
+		if !dryRun {
+			if summary.Conflicts > 0 {
+				return newImportConflictError(operation, steps)
+			}
+			for _, step := range steps {
+				if err := s.applyImportStep(ctx, tx, tenantID, step, operation, operator); err != nil {
+					return err
+				}
+			}
+		}
+
+		attempt.Changes = make([]types.JobCatalogImportChange, 0, len(steps))
+		for _, step := range steps {
+			attempt.Changes = append(attempt.Changes, step.change)
+		}
+		result = attempt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CloneJobCatalog 将源租户的职位目录（含全部版本）导入目标租户，差异与冲突规则同 ImportJobCatalog。
+func (s *JobCatalogService) CloneJobCatalog(ctx context.Context, sourceTenantID, targetTenantID uuid.UUID, dryRun bool, operator types.OperatedByInfo) (*types.JobCatalogImportResult, error) {
+	if sourceTenantID == uuid.Nil || sourceTenantID == targetTenantID {
+		return nil, fmt.Errorf("%w: source tenant must differ from target tenant", ErrJobCatalogInvalidInput)
+	}
+	doc, err := s.ExportJobCatalog(ctx, sourceTenantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.FamilyGroups) == 0 {
+		return nil, ErrJobCatalogNotFound
+	}
+	result, err := s.ImportJobCatalog(ctx, targetTenantID, doc, dryRun, operator)
+	if err != nil {
+		return nil, err
+	}
+	result.SourceTenantID = &sourceTenantID
+	return result, nil
+}
+
+// normalizeJobCatalogDocument 校验并规范化交换文档（编码大写、状态大写、版本按生效日期排序），
+// 返回上级先于下级排列的条目；存在问题时汇总为校验失败错误。
+func normalizeJobCatalogDocument(operation string, doc *types.JobCatalogDocument) ([]*jobCatalogImportEntry, error) {
+	if doc == nil {
+		return nil, ErrJobCatalogInvalidInput
+	}
+	result := validator.NewValidationResult()
+	fail := func(field, message string, value interface{}) {
+		result.Errors = append(result.Errors, validator.ValidationError{
+			Code:     "JOB_CATALOG_DOCUMENT_INVALID",
+			Message:  message,
+			Field:    field,
+			Value:    value,
+			Severity: string(validator.SeverityHigh),
+			Context:  map[string]interface{}{"ruleId": "JC-IMPORT-DOCUMENT"},
+		})
+	}
+
+	if version := strings.TrimSpace(doc.FormatVersion); version != "" && version != types.JobCatalogDocumentFormatVersion {
+		fail("formatVersion", fmt.Sprintf("unsupported formatVersion, expected %s", types.JobCatalogDocumentFormatVersion), doc.FormatVersion)
+	}
+
+	var (
+		entries       []*jobCatalogImportEntry
+		seen          = map[string]string{}
+		totalVersions int
+	)
+	addEntry := func(path, level, code string, parent *jobCatalogImportEntry, versions []types.JobCatalogDocumentVersion) *jobCatalogImportEntry {
+		entry := &jobCatalogImportEntry{level: level, code: strings.ToUpper(strings.TrimSpace(code))}
+		if parent != nil {
+			entry.parentCode = parent.code
+		}
+		if entry.code == "" {
+			fail(path+".code", "code is required", code)
+		} else if previous, ok := seen[level+"/"+entry.code]; ok {
+			fail(path+".code", fmt.Sprintf("duplicate %s code, already defined at %s", level, previous), entry.code)
+		} else {
+			seen[level+"/"+entry.code] = path
+		}
+		if len(versions) == 0 {
+			fail(path+".versions", "at least one version is required", nil)
+		}
+
+		dates := map[string]bool{}
+		for i, raw := range versions {
+			field := fmt.Sprintf("%s.versions[%d]", path, i)
+			version := jobCatalogImportVersion{JobCatalogDocumentVersion: raw}
+			version.EffectiveDate = strings.TrimSpace(raw.EffectiveDate)
+			version.Name = strings.TrimSpace(raw.Name)
+			version.Status = strings.ToUpper(strings.TrimSpace(raw.Status))
+			if raw.Description != nil {
+				if description := strings.TrimSpace(*raw.Description); description != "" {
+					version.Description = &description
+				} else {
+					version.Description = nil
+				}
+			}
+
+			effectiveDate, err := time.Parse(jobCatalogDateLayout, version.EffectiveDate)
+			if err != nil {
+				fail(field+".effectiveDate", "effectiveDate must use YYYY-MM-DD", raw.EffectiveDate)
+			} else if dates[version.EffectiveDate] {
+				fail(field+".effectiveDate", "duplicate effectiveDate within entry", raw.EffectiveDate)
+			}
+			dates[version.EffectiveDate] = true
+			version.effectiveDate = effectiveDate
+			if version.Name == "" {
+				fail(field+".name", "name is required", raw.Name)
+			}
+			if version.Status != types.JobCatalogStatusActive && version.Status != types.JobCatalogStatusInactive {
+				fail(field+".status", "status must be ACTIVE or INACTIVE", raw.Status)
+			}
+			if names, err := version.NameI18n.Normalize(); err != nil {
+				fail(field+".nameI18n", err.Error(), raw.NameI18n)
+			} else {
+				version.NameI18n = names
+			}
+			entry.versions = append(entry.versions, version)
+		}
+		sort.SliceStable(entry.versions, func(i, j int) bool {
+			return entry.versions[i].effectiveDate.Before(entry.versions[j].effectiveDate)
+		})
+
+		// 条目首个版本不得早于上级的首个版本，保证写入时能解析到上级记录
+		if parent != nil && len(parent.versions) > 0 && len(entry.versions) > 0 &&
+			entry.versions[0].effectiveDate.Before(parent.versions[0].effectiveDate) {
+			fail(path+".versions", fmt.Sprintf("first version precedes the first version of parent %s", parent.code), entry.versions[0].EffectiveDate)
+		}
+		totalVersions += len(entry.versions)
+		entries = append(entries, entry)
+		return entry
+	}
+
+	for gi, group := range doc.FamilyGroups {
+		groupPath := fmt.Sprintf("familyGroups[%d]", gi)
+		groupEntry := addEntry(groupPath, types.JobCatalogLevelFamilyGroup, group.Code, nil, group.Versions)
+		for fi, family := range group.Families {
+			familyPath := fmt.Sprintf("%s.families[%d]", groupPath, fi)
+			familyEntry := addEntry(familyPath, types.JobCatalogLevelFamily, family.Code, groupEntry, family.Versions)
+			for ri, role := range family.Roles {
+				rolePath := fmt.Sprintf("%s.roles[%d]", familyPath, ri)
+				roleEntry := addEntry(rolePath, types.JobCatalogLevelRole, role.Code, familyEntry, role.Versions)
+				roleEntry.attributes = role.CompetencyModel
+				for li, level := range role.Levels {
+					levelPath := fmt.Sprintf("%s.levels[%d]", rolePath, li)
+					levelEntry := addEntry(levelPath, types.JobCatalogLevelLevel, level.Code, roleEntry, level.Versions)
+					levelEntry.levelRank = strings.TrimSpace(level.LevelRank)
+					levelEntry.attributes = level.SalaryBand
+					if levelEntry.levelRank == "" {
+						fail(levelPath+".levelRank", "levelRank is required", level.LevelRank)
+					}
+				}
+			}
+		}
+	}
+	if len(entries) == 0 {
+		fail("familyGroups", "document contains no job catalog entries", nil)
+	}
+	if totalVersions > jobCatalogImportMaxVersions {
+		fail("familyGroups", fmt.Sprintf("document contains %d versions, limit is %d", totalVersions, jobCatalogImportMaxVersions), totalVersions)
+	}
+
+	if len(result.Errors) > 0 {
+		result.Valid = false
+		result.Context["operation"] = operation
+		result.Context["errorCount"] = len(result.Errors)
+		return nil, validator.NewValidationFailedError(operation, result)
+	}
+	return entries, nil
+}
+
+type existingCatalogEntry struct {
+	parentCode string
+	versions   map[string]repository.JobCatalogSnapshotRow
+}
+
+// planJobCatalogImport 逐版本比对文档与租户现有目录，生成差异步骤与统计
+func planJobCatalogImport(entries []*jobCatalogImportEntry, snapshot []repository.JobCatalogSnapshotRow) ([]*jobCatalogImportStep, types.JobCatalogImportSummary) {
+	existing := map[string]*existingCatalogEntry{}
+	for _, row := range snapshot {
+		key := row.Level + "/" + row.Code
+		entry, ok := existing[key]
+		if !ok {
+			entry = &existingCatalogEntry{versions: map[string]repository.JobCatalogSnapshotRow{}}
+			existing[key] = entry
+		}
+		// 快照按生效日期升序，最终保留最新版本的上级编码
+		entry.parentCode = row.ParentCode
+		entry.versions[row.EffectiveDate.Format(jobCatalogDateLayout)] = row
+	}
+
+	var (
+		steps   []*jobCatalogImportStep
+		summary types.JobCatalogImportSummary
+	)
+	for _, entry := range entries {
+		current := existing[entry.level+"/"+entry.code]
+		for i := range entry.versions {
+			version := &entry.versions[i]
+			step := &jobCatalogImportStep{
+				entry:   entry,
+				version: version,
+				change: types.JobCatalogImportChange{
+					Level:         entry.level,
+					Code:          entry.code,
+					ParentCode:    entry.parentCode,
+					EffectiveDate: version.EffectiveDate,
+				},
+			}
+			switch {
+			case current == nil && i == 0:
+				step.change.Action = types.JobCatalogImportCreate
+				summary.Created++
+			case current == nil:
+				step.change.Action = types.JobCatalogImportCreateVersion
+				summary.VersionsCreated++
+			case current.parentCode != entry.parentCode:
+				step.change.Action = types.JobCatalogImportConflict
+				step.change.Reason = fmt.Sprintf("existing entry belongs to parent %s", current.parentCode)
+				summary.Conflicts++
+			default:
+				row, ok := current.versions[version.EffectiveDate]
+				switch {
+				case !ok:
+					step.change.Action = types.JobCatalogImportCreateVersion
+					summary.VersionsCreated++
+				case sameCatalogVersion(row, version):
+					recordID := row.RecordID
+					step.change.Action = types.JobCatalogImportUnchanged
+					step.change.RecordID = &recordID
+					summary.Unchanged++
+				default:
+					recordID := row.RecordID
+					step.change.Action = types.JobCatalogImportConflict
+					step.change.RecordID = &recordID
+					step.change.Reason = "existing version on the same effective date differs"
+					summary.Conflicts++
+				}
+			}
+			steps = append(steps, step)
+		}
+	}
+	return steps, summary
+}
+
+// sameCatalogVersion 判断现有版本与文档版本是否一致；文档未提供的多语言名称不参与比较
+func sameCatalogVersion(row repository.JobCatalogSnapshotRow, version *jobCatalogImportVersion) bool {
+	if row.Name != version.Name || !strings.EqualFold(row.Status, version.Status) {
+		return false
+	}
+	existingDescription := ""
+	if row.Description.Valid {
+		existingDescription = strings.TrimSpace(row.Description.String)
+	}
+	description := ""
+	if version.Description != nil {
+		description = *version.Description
+	}
+	if existingDescription != description {
+		return false
+	}
+	for locale, name := range version.NameI18n {
+		if row.NameI18n[locale] != name {
+			return false
+		}
+	}
+	return true
+}
+
+func newImportConflictError(operation string, steps []*jobCatalogImportStep) error {
+	conflicts := make([]types.JobCatalogImportChange, 0)
+	for _, step := range steps {
+		if step.change.Action == types.JobCatalogImportConflict {
+			conflicts = append(conflicts, step.change)
+		}
+	}
+	result := validator.NewValidationResult()
+	result.Valid = false
+	result.Context["operation"] = operation
+	result.Errors = append(result.Errors, validator.ValidationError{
+		Code:     "JOB_CATALOG_IMPORT_CONFLICT",
+		Message:  fmt.Sprintf("%d job catalog versions conflict with existing data", len(conflicts)),
+		Severity: string(validator.SeverityHigh),
+		Context: map[string]interface{}{
+			"ruleId":    "JC-IMPORT-CONFLICT",
+			"conflicts": conflicts,
+		},
+	})
+	return validator.NewValidationFailedError(operation, result)
+}
+
+// applyImportStep 写入单个差异步骤并记录审计；UNCHANGED 步骤直接跳过
+func (s *JobCatalogService) applyImportStep(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, step *jobCatalogImportStep, operation string, operator types.OperatedByInfo) error {
+	var (
+		recordID uuid.UUID
+		err      error
+	)
+	switch step.change.Action {
+	case types.JobCatalogImportCreate:
+		recordID, err = s.createImportedEntry(ctx, tx, tenantID, step, operation)
+	case types.JobCatalogImportCreateVersion:
+		recordID, err = s.insertImportedVersion(ctx, tx, tenantID, step, operation)
+	default:
+		return nil
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return fmt.Errorf("%w: %s %s on %s", ErrJobCatalogConflict, step.entry.level, step.entry.code, step.version.EffectiveDate)
+		}
+		return err
+	}
+	step.change.RecordID = &recordID
+
+	after := map[string]interface{}{
+		"code":        step.entry.code,
+		"level":       step.entry.level,
+		"effectiveAt": step.version.EffectiveDate,
+		"importType":  step.change.Action,
+	}
+	if step.entry.parentCode != "" {
+		after["parentCode"] = step.entry.parentCode
+	}
+	return s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, operation, recordID, after)
+}
+
+func (s *JobCatalogService) createImportedEntry(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, step *jobCatalogImportStep, operation string) (uuid.UUID, error) {
+	entry, version := step.entry, step.version
+	var parentRecord uuid.UUID
+	if entry.level != types.JobCatalogLevelFamilyGroup {
+		var err error
+		if parentRecord, err = s.importParentRecord(ctx, tx, tenantID, entry, version.effectiveDate); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	switch entry.level {
+	case types.JobCatalogLevelFamilyGroup:
+		created, err := s.repo.InsertFamilyGroup(ctx, tx, tenantID, &types.CreateJobFamilyGroupRequest{
+			Code:          entry.code,
+			Name:          version.Name,
+			NameI18n:      version.NameI18n,
+			Description:   version.Description,
+			Status:        version.Status,
+			EffectiveDate: version.EffectiveDate,
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	case types.JobCatalogLevelFamily:
+		created, err := s.repo.InsertJobFamily(ctx, tx, tenantID, parentRecord, &types.CreateJobFamilyRequest{
+			Code:               entry.code,
+			JobFamilyGroupCode: entry.parentCode,
+			Name:               version.Name,
+			NameI18n:           version.NameI18n,
+			Description:        version.Description,
+			Status:             version.Status,
+			EffectiveDate:      version.EffectiveDate,
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	case types.JobCatalogLevelRole:
+		created, err := s.repo.InsertJobRole(ctx, tx, tenantID, parentRecord, &types.CreateJobRoleRequest{
+			Code:            entry.code,
+			JobFamilyCode:   entry.parentCode,
+			Name:            version.Name,
+			NameI18n:        version.NameI18n,
+			Description:     version.Description,
+			Status:          version.Status,
+			EffectiveDate:   version.EffectiveDate,
+			CompetencyModel: entry.attributes,
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	default:
+		created, err := s.repo.InsertJobLevel(ctx, tx, tenantID, parentRecord, &types.CreateJobLevelRequest{
+			Code:          entry.code,
+			JobRoleCode:   entry.parentCode,
+			Name:          version.Name,
+			NameI18n:      version.NameI18n,
+			Description:   version.Description,
+			Status:        version.Status,
+			LevelRank:     entry.levelRank,
+			EffectiveDate: version.EffectiveDate,
+			SalaryBand:    entry.attributes,
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, operation, created, map[string]interface{}{"imported": true}); err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	}
+}
+
+func (s *JobCatalogService) insertImportedVersion(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, step *jobCatalogImportStep, operation string) (uuid.UUID, error) {
+	entry, version := step.entry, step.version
+	req := &types.JobCatalogVersionRequest{
+		Name:          version.Name,
+		NameI18n:      version.NameI18n,
+		Status:        version.Status,
+		EffectiveDate: version.EffectiveDate,
+		Description:   version.Description,
+	}
+
+	switch entry.level {
+	case types.JobCatalogLevelFamilyGroup:
+		created, err := s.repo.InsertFamilyGroupVersion(ctx, tx, tenantID, entry.code, req)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	case types.JobCatalogLevelFamily:
+		latest, err := s.repo.GetLatestRecordID(ctx, tx, tenantID, entry.level, entry.code)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		created, err := s.repo.InsertJobFamilyVersion(ctx, tx, tenantID, entry.code, latest, req)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	case types.JobCatalogLevelRole:
+		// 职务版本的 parent_record_id 引用所属职种在该日期生效的记录
+		parentRecord, err := s.importParentRecord(ctx, tx, tenantID, entry, version.effectiveDate)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		created, err := s.repo.InsertJobRoleVersion(ctx, tx, tenantID, entry.code, parentRecord, req)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	default:
+		latest, err := s.repo.GetLatestRecordID(ctx, tx, tenantID, entry.level, entry.code)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		created, err := s.repo.InsertJobLevelVersion(ctx, tx, tenantID, entry.code, latest, req)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, operation, created, map[string]interface{}{"imported": true}); err != nil {
+			return uuid.Nil, err
+		}
+		return created.RecordID, nil
+	}
+}
+
+// importParentRecord 解析上级条目在指定日期生效的记录
+func (s *JobCatalogService) importParentRecord(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, entry *jobCatalogImportEntry, asOf time.Time) (uuid.UUID, error) {
+	parentLevel := jobCatalogParentLevel[entry.level]
+	recordID, err := s.repo.GetRecordIDAsOf(ctx, tx, tenantID, parentLevel, entry.parentCode, asOf)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if recordID == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("%w: %s %s is not effective on %s", ErrJobCatalogParentMissing, parentLevel, entry.parentCode, asOf.Format(jobCatalogDateLayout))
+	}
+	return recordID, nil
+}
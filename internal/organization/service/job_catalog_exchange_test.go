@@ -0,0 +1,221 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"cube-castle/internal/organization/repository"
+	validator "cube-castle/internal/organization/validator"
+	"cube-castle/internal/types"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func sampleJobCatalogDocument() *types.JobCatalogDocument {
+	description := "Software engineering"
+	return &types.JobCatalogDocument{
+		FormatVersion: types.JobCatalogDocumentFormatVersion,
+		FamilyGroups: []types.JobCatalogDocumentGroup{{
+			Code: "PROF",
+			Versions: []types.JobCatalogDocumentVersion{
+				{EffectiveDate: "2025-01-01", Name: "Professional", Status: "ACTIVE", NameI18n: types.LocalizedNames{"zh-CN": "专业类"}},
+			},
+			Families: []types.JobCatalogDocumentFamily{{
+				Code:     "PROF-IT",
+				Versions: []types.JobCatalogDocumentVersion{{EffectiveDate: "2025-01-01", Name: "IT", Status: "ACTIVE"}},
+				Roles: []types.JobCatalogDocumentRole{{
+					Code:            "PROF-IT-DEV",
+					CompetencyModel: map[string]interface{}{"core": "coding"},
+					Versions: []types.JobCatalogDocumentVersion{
+						{EffectiveDate: "2025-01-01", Name: "Developer", Status: "ACTIVE", Description: &description},
+						{EffectiveDate: "2025-07-01", Name: "Software Developer", Status: "ACTIVE"},
+					},
+					Levels: []types.JobCatalogDocumentLevel{{
+						Code:       "P1",
+						LevelRank:  "1",
+						SalaryBand: map[string]interface{}{"currency": "CNY"},
+						Versions:   []types.JobCatalogDocumentVersion{{EffectiveDate: "2025-02-01", Name: "Junior", Status: "ACTIVE"}},
+					}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestJobCatalogDocumentCSVRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeJobCatalogDocument(&buf, sampleJobCatalogDocument(), "csv"); err != nil {
+		t.Fatalf("encode csv: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 6 {
+		t.Fatalf("expected header plus 5 version rows, got %d lines:\n%s", lines, buf.String())
+	}
+
+	doc, err := DecodeJobCatalogDocument(&buf, "csv")
+	if err != nil {
+		t.Fatalf("decode csv: %v", err)
+	}
+	if len(doc.FamilyGroups) != 1 || doc.FamilyGroups[0].Versions[0].NameI18n["zh-CN"] != "专业类" {
+		t.Fatalf("unexpected groups: %#v", doc.FamilyGroups)
+	}
+	role := doc.FamilyGroups[0].Families[0].Roles[0]
+	if len(role.Versions) != 2 || role.Versions[0].Description == nil || role.CompetencyModel["core"] != "coding" {
+		t.Fatalf("unexpected role: %#v", role)
+	}
+	level := role.Levels[0]
+	if level.Code != "P1" || level.LevelRank != "1" || level.SalaryBand["currency"] != "CNY" {
+		t.Fatalf("unexpected level: %#v", level)
+	}
+}
+
+func TestDecodeJobCatalogDocumentFormats(t *testing.T) {
+	yamlDoc := `formatVersion: "1"
+familyGroups:
+  - code: prof
+    versions:
+      - effectiveDate: 2025-01-01
+        name: Professional
+        status: ACTIVE
+`
+	doc, err := DecodeJobCatalogDocument(strings.NewReader(yamlDoc), "yml")
+	if err != nil {
+		t.Fatalf("decode yaml: %v", err)
+	}
+	if doc.FamilyGroups[0].Versions[0].EffectiveDate != "2025-01-01" {
+		t.Fatalf("expected literal date, got %q", doc.FamilyGroups[0].Versions[0].EffectiveDate)
+	}
+
+	if _, err := DecodeJobCatalogDocument(strings.NewReader(`{"familyGroups":[],"unknown":1}`), "json"); !errors.Is(err, ErrJobCatalogInvalidInput) {
+		t.Fatalf("expected unknown json field to be rejected, got %v", err)
+	}
+	orphan := "level,code,parentCode,effectiveDate,status,name\nJOB_FAMILY,PROF-IT,PROF,2025-01-01,ACTIVE,IT\n"
+	if _, err := DecodeJobCatalogDocument(strings.NewReader(orphan), "csv"); !errors.Is(err, ErrJobCatalogInvalidInput) {
+		t.Fatalf("expected missing csv parent to be rejected, got %v", err)
+	}
+}
+
+func TestNormalizeJobCatalogDocumentReportsErrors(t *testing.T) {
+	doc := sampleJobCatalogDocument()
+	role := &doc.FamilyGroups[0].Families[0].Roles[0]
+	role.Versions[1].Status = "retired"
+	role.Levels[0].LevelRank = ""
+	role.Levels = append(role.Levels, types.JobCatalogDocumentLevel{
+		Code:      "p1",
+		LevelRank: "2",
+		Versions:  []types.JobCatalogDocumentVersion{{EffectiveDate: "2024-12-01", Name: "Dup", Status: "ACTIVE"}},
+	})
+
+	_, err := normalizeJobCatalogDocument("ImportJobCatalog", doc)
+	var validationErr *validator.ValidationFailedError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	fields := map[string]bool{}
+	for _, item := range validationErr.Result().Errors {
+		fields[item.Field] = true
+	}
+	for _, field := range []string{
+		"familyGroups[0].families[0].roles[0].versions[1].status",
+		"familyGroups[0].families[0].roles[0].levels[0].levelRank",
+		"familyGroups[0].families[0].roles[0].levels[1].code",
+		"familyGroups[0].families[0].roles[0].levels[1].versions",
+	} {
+		if !fields[field] {
+			t.Fatalf("expected error on %s, got %#v", field, validationErr.Result().Errors)
+		}
+	}
+}
+
+func TestPlanJobCatalogImportClassifiesVersions(t *testing.T) {
+	entries, err := normalizeJobCatalogDocument("ImportJobCatalog", sampleJobCatalogDocument())
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := []repository.JobCatalogSnapshotRow{
+		{Level: types.JobCatalogLevelFamilyGroup, Code: "PROF", RecordID: uuid.New(), Name: "Professional", NameI18n: types.LocalizedNames{"zh-CN": "专业类", "en-US": "Professional"}, Status: "ACTIVE", EffectiveDate: jan},
+		{Level: types.JobCatalogLevelFamily, Code: "PROF-IT", ParentCode: "PROF", RecordID: uuid.New(), Name: "Information Technology", Status: "ACTIVE", EffectiveDate: jan},
+		{Level: types.JobCatalogLevelRole, Code: "PROF-IT-DEV", ParentCode: "PROF-IT", RecordID: uuid.New(), Name: "Developer", Description: sql.NullString{String: "Software engineering", Valid: true}, Status: "ACTIVE", EffectiveDate: jan},
+		{Level: types.JobCatalogLevelLevel, Code: "P1", ParentCode: "OTHER-ROLE", RecordID: uuid.New(), Name: "Junior", Status: "ACTIVE", EffectiveDate: jan},
+	}
+
+	steps, summary := planJobCatalogImport(entries, snapshot)
+	actions := make([]string, 0, len(steps))
+	for _, step := range steps {
+		actions = append(actions, step.entry.code+"@"+step.change.EffectiveDate+"="+step.change.Action)
+	}
+	expected := []string{
+		"PROF@2025-01-01=UNCHANGED",
+		"PROF-IT@2025-01-01=CONFLICT",
+		"PROF-IT-DEV@2025-01-01=UNCHANGED",
+		"PROF-IT-DEV@2025-07-01=CREATE_VERSION",
+		"P1@2025-02-01=CONFLICT",
+	}
+	if strings.Join(actions, ",") != strings.Join(expected, ",") {
+		t.Fatalf("unexpected plan:\n got %v\nwant %v", actions, expected)
+	}
+	if summary != (types.JobCatalogImportSummary{VersionsCreated: 1, Unchanged: 2, Conflicts: 2}) {
+		t.Fatalf("unexpected summary: %#v", summary)
+	}
+
+	steps, summary = planJobCatalogImport(entries, nil)
+	if summary.Created != 4 || summary.VersionsCreated != 1 || steps[0].change.Action != types.JobCatalogImportCreate {
+		t.Fatalf("expected full creation plan for empty tenant, got %#v", summary)
+	}
+}
+
+func TestImportJobCatalogRejectsConflictsAndSupportsDryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	logger := pkglogger.NewNoopLogger()
+	svc := &JobCatalogService{repo: repository.NewJobCatalogRepository(db, logger), logger: logger}
+	tenantID := uuid.New()
+	columns := []string{"level", "code", "parent_code", "record_id", "level_rank", "name", "name_i18n", "description", "status", "effective_date", "attributes"}
+	snapshotRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(columns).
+			AddRow(types.JobCatalogLevelFamilyGroup, "PROF", "", uuid.New(), "", "Professional Track", []byte(`{}`), nil, "ACTIVE", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY depth, code, effective_date`)).WillReturnRows(snapshotRows())
+	mock.ExpectCommit()
+	result, err := svc.ImportJobCatalog(context.Background(), tenantID, sampleJobCatalogDocument(), true, types.OperatedByInfo{})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !result.DryRun || result.Summary.Conflicts != 1 || result.Summary.Created != 3 || len(result.Changes) != 5 {
+		t.Fatalf("unexpected dry run result: %#v", result)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY depth, code, effective_date`)).WillReturnRows(snapshotRows())
+	mock.ExpectRollback()
+	_, err = svc.ImportJobCatalog(context.Background(), tenantID, sampleJobCatalogDocument(), false, types.OperatedByInfo{})
+	var validationErr *validator.ValidationFailedError
+	if !errors.As(err, &validationErr) || validationErr.Result().Errors[0].Code != "JOB_CATALOG_IMPORT_CONFLICT" {
+		t.Fatalf("expected import conflict, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCloneJobCatalogRejectsSameTenant(t *testing.T) {
+	svc := &JobCatalogService{logger: pkglogger.NewNoopLogger()}
+	tenantID := uuid.New()
+	if _, err := svc.CloneJobCatalog(context.Background(), tenantID, tenantID, true, types.OperatedByInfo{}); !errors.Is(err, ErrJobCatalogInvalidInput) {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/audit"
+	"cube-castle/internal/organization/events"
+	validator "cube-castle/internal/organization/validator"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobCatalogAlreadyRetired     = errors.New("job catalog entry already retired")
+	ErrJobCatalogReplacementInvalid = errors.New("job catalog replacement invalid")
+)
+
+// retiredCatalogEntry 停用前生效的目录条目，用于派生 INACTIVE 版本
+type retiredCatalogEntry struct {
+	parentRecord uuid.UUID
+	name         string
+	description  sql.NullString
+}
+
+// RetireJobCatalog 停用职位目录条目：在停用日插入 INACTIVE 版本，并返回受影响职位与下级条目的影响报告。
+// DryRun 时仅返回影响报告；仍有启用的下级条目时拒绝停用；指定替代条目时在同一事务内迁移受影响职位。
+func (s *JobCatalogService) RetireJobCatalog(ctx context.Context, tenantID uuid.UUID, level, code string, req *types.RetireJobCatalogRequest, operator types.OperatedByInfo) (*types.JobCatalogRetirementResult, error) {
+	operation := "RetireJobCatalog"
+	normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+	if normalizedCode == "" || req == nil {
+		return nil, ErrJobCatalogInvalidInput
+	}
+	effectiveDate, err := time.Parse(jobCatalogDateLayout, strings.TrimSpace(req.EffectiveDate))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid effectiveDate", ErrJobCatalogInvalidInput)
+	}
+	if s.positions == nil {
+		return nil, errors.New("position service not configured for job catalog retirement")
+	}
+	replacementCode := normalizedOptionalCode(req.ReplacementCode)
+	replacementLevelCode := normalizedOptionalCode(req.ReplacementLevelCode)
+
+	var result *types.JobCatalogRetirementResult
+	err = s.repo.WithTx(ctx, operation, func(ctx context.Context, tx *sql.Tx) error {
+		current, err := s.currentCatalogEntry(ctx, tx, tenantID, level, normalizedCode)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return ErrJobCatalogNotFound
+		}
+
+		status, err := s.repo.GetStatusAsOf(ctx, tx, tenantID, level, normalizedCode, effectiveDate)
+		if err != nil {
+			return err
+		}
+		switch {
+		case status == "":
+			return fmt.Errorf("%w: %s is not effective on %s", ErrJobCatalogInvalidInput, normalizedCode, req.EffectiveDate)
+		case strings.EqualFold(status, types.JobCatalogStatusInactive):
+			return ErrJobCatalogAlreadyRetired
+		}
+
+		children, err := s.repo.ListActiveChildren(ctx, tx, tenantID, level, normalizedCode, effectiveDate)
+		if err != nil {
+			return err
+		}
+		versions, err := s.positions.positions.ListVersionsByJobCatalog(ctx, tx, tenantID, level, normalizedCode, effectiveDate)
+		if err != nil {
+			return err
+		}
+
+		var target *jobCatalogSnapshot
+		if replacementCode != "" || replacementLevelCode != "" {
+			target, err = s.resolveReplacement(ctx, tx, tenantID, level, normalizedCode, replacementCode, replacementLevelCode, effectiveDate)
+			if err != nil {
+				return err
+			}
+		}
+
+		attempt := &types.JobCatalogRetirementResult{
+			Level:             level,
+			Code:              normalizedCode,
+			EffectiveDate:     effectiveDate.Format(jobCatalogDateLayout),
+			DryRun:            req.DryRun,
+			ImpactedPositions: impactedPositions(versions),
+			ActiveChildren:    children,
+			RemappedPositions: []types.JobCatalogRemappedPosition{},
+		}
+		if target != nil {
+			attempt.ReplacementCode = &replacementCode
+			levelCode := target.level.Code
+			attempt.ReplacementLevelCode = &levelCode
+		}
+		if req.DryRun {
+			result = attempt
+			return nil
+		}
+		if len(children) > 0 {
+			return newActiveChildrenError(operation, level, normalizedCode, children)
+		}
+
+		recordID, err := s.insertRetiredVersion(ctx, tx, tenantID, level, normalizedCode, current, effectiveDate, operation)
+		if err != nil {
+			return err
+		}
+		attempt.RecordID = &recordID
+
+		if target != nil && len(versions) > 0 {
+			remapped, err := s.positions.remapJobCatalogReferences(ctx, tx, tenantID, versions, target, effectiveDate, req.OperationReason, operator)
+			if err != nil {
+				return err
+			}
+			attempt.RemappedPositions = remapped
+		}
+
+		after := map[string]interface{}{
+			"code":              normalizedCode,
+			"level":             level,
+			"status":            types.JobCatalogStatusInactive,
+			"effectiveAt":       attempt.EffectiveDate,
+			"operationReason":   strings.TrimSpace(req.OperationReason),
+			"impactedPositions": len(attempt.ImpactedPositions),
+			"remappedPositions": len(attempt.RemappedPositions),
+		}
+		if target != nil {
+			after["replacementCode"] = replacementCode
+			after["replacementLevelCode"] = target.level.Code
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeSuspend, operation, recordID, after); err != nil {
+			return err
+		}
+
+		result = attempt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *JobCatalogService) currentCatalogEntry(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string) (*retiredCatalogEntry, error) {
+	switch level {
+	case types.JobCatalogLevelFamilyGroup:
+		entry, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, code)
+		if err != nil || entry == nil {
+			return nil, err
+		}
+		return &retiredCatalogEntry{name: entry.Name, description: entry.Description}, nil
+	case types.JobCatalogLevelFamily:
+		entry, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, code)
+		if err != nil || entry == nil {
+			return nil, err
+		}
+		return &retiredCatalogEntry{name: entry.Name, description: entry.Description}, nil
+	case types.JobCatalogLevelRole:
+		entry, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, code)
+		if err != nil || entry == nil {
+			return nil, err
+		}
+		return &retiredCatalogEntry{parentRecord: entry.ParentRecord, name: entry.Name, description: entry.Description}, nil
+	case types.JobCatalogLevelLevel:
+		entry, err := s.repo.GetCurrentJobLevel(ctx, tx, tenantID, code)
+		if err != nil || entry == nil {
+			return nil, err
+		}
+		return &retiredCatalogEntry{name: entry.Name, description: entry.Description}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported level %s", ErrJobCatalogInvalidInput, level)
+	}
+}
+
+func (s *JobCatalogService) insertRetiredVersion(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code string, current *retiredCatalogEntry, effectiveDate time.Time, operation string) (uuid.UUID, error) {
+	versionReq := &types.JobCatalogVersionRequest{
+		Name:          current.name,
+		Status:        types.JobCatalogStatusInactive,
+		EffectiveDate: effectiveDate.Format(jobCatalogDateLayout),
+	}
+	if current.description.Valid {
+		description := current.description.String
+		versionReq.Description = &description
+	}
+
+	var (
+		recordID uuid.UUID
+		err      error
+	)
+	switch level {
+	case types.JobCatalogLevelFamilyGroup:
+		var entry *types.JobFamilyGroup
+		if entry, err = s.repo.InsertFamilyGroupVersion(ctx, tx, tenantID, code, versionReq); err == nil {
+			recordID = entry.RecordID
+		}
+	case types.JobCatalogLevelFamily:
+		// 职种版本须关联最新的职种版本记录，职类引用沿用该记录
+		var latest uuid.UUID
+		if latest, err = s.repo.GetLatestRecordID(ctx, tx, tenantID, level, code); err == nil {
+			var entry *types.JobFamily
+			if entry, err = s.repo.InsertJobFamilyVersion(ctx, tx, tenantID, code, latest, versionReq); err == nil {
+				recordID = entry.RecordID
+			}
+		}
+	case types.JobCatalogLevelRole:
+		var entry *types.JobRole
+		if entry, err = s.repo.InsertJobRoleVersion(ctx, tx, tenantID, code, current.parentRecord, versionReq); err == nil {
+			recordID = entry.RecordID
+		}
+	case types.JobCatalogLevelLevel:
+		// 职级版本以最新版本记录（可能是已排期的未来版本）作为 parentRecordId，职务引用沿用该记录（见 InsertJobLevelVersion）
+		var latest uuid.UUID
+		if latest, err = s.repo.GetLatestRecordID(ctx, tx, tenantID, level, code); err == nil {
+			var entry *types.JobLevel
+			if entry, err = s.repo.InsertJobLevelVersion(ctx, tx, tenantID, code, latest, versionReq); err == nil {
+				recordID = entry.RecordID
+				err = s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, operation, entry, map[string]interface{}{
+					"retired": true,
+				})
+			}
+		}
+	default:
+		err = fmt.Errorf("%w: unsupported level %s", ErrJobCatalogInvalidInput, level)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return uuid.Nil, ErrJobCatalogConflict
+		}
+		return uuid.Nil, err
+	}
+	return recordID, nil
+}
+
+// resolveReplacement 解析替代条目并返回完整的目录路径；替代路径上的条目须在停用日处于启用状态
+func (s *JobCatalogService) resolveReplacement(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, level, code, replacementCode, replacementLevelCode string, asOf time.Time) (*jobCatalogSnapshot, error) {
+	if replacementCode == "" {
+		return nil, fmt.Errorf("%w: replacementCode is required", ErrJobCatalogReplacementInvalid)
+	}
+	if replacementCode == code {
+		return nil, fmt.Errorf("%w: replacement must differ from retired entry", ErrJobCatalogReplacementInvalid)
+	}
+	levelCode := replacementLevelCode
+	if level == types.JobCatalogLevelLevel {
+		if levelCode != "" && levelCode != replacementCode {
+			return nil, fmt.Errorf("%w: replacementLevelCode must match replacementCode", ErrJobCatalogReplacementInvalid)
+		}
+		levelCode = replacementCode
+	}
+	if levelCode == "" {
+		return nil, fmt.Errorf("%w: replacementLevelCode is required", ErrJobCatalogReplacementInvalid)
+	}
+
+	target, err := s.positions.resolveJobCatalogByLevel(ctx, tx, tenantID, levelCode)
+	if err != nil {
+		if errors.Is(err, ErrJobCatalogNotFound) || errors.Is(err, ErrJobCatalogMismatch) {
+			return nil, fmt.Errorf("%w: %v", ErrJobCatalogReplacementInvalid, err)
+		}
+		return nil, err
+	}
+	path := []types.JobCatalogReference{
+		{Level: types.JobCatalogLevelFamilyGroup, Code: target.group.Code},
+		{Level: types.JobCatalogLevelFamily, Code: target.family.Code},
+		{Level: types.JobCatalogLevelRole, Code: target.role.Code},
+		{Level: types.JobCatalogLevelLevel, Code: target.level.Code},
+	}
+	for _, ref := range path {
+		if ref.Level == level && ref.Code != replacementCode {
+			return nil, fmt.Errorf("%w: level %s does not belong to %s", ErrJobCatalogReplacementInvalid, levelCode, replacementCode)
+		}
+		status, err := s.repo.GetStatusAsOf(ctx, tx, tenantID, ref.Level, ref.Code, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(status, types.JobCatalogStatusActive) {
+			return nil, fmt.Errorf("%w: %s is not active on %s", ErrJobCatalogReplacementInvalid, ref.Code, asOf.Format(jobCatalogDateLayout))
+		}
+	}
+	return target, nil
+}
+
+func newActiveChildrenError(operation, level, code string, children []types.JobCatalogImpactedChild) error {
+	result := validator.NewValidationResult()
+	result.Valid = false
+	result.Context["operation"] = operation
+	result.Context["catalogCode"] = code
+	result.Errors = append(result.Errors, validator.ValidationError{
+		Code:     "JOB_CATALOG_HAS_ACTIVE_CHILDREN",
+		Message:  fmt.Sprintf("Job catalog entry %s still has %d active child entries", code, len(children)),
+		Field:    "code",
+		Value:    code,
+		Severity: string(validator.SeverityHigh),
+		Context: map[string]interface{}{
+			"ruleId":         "JC-RETIRE-CHILDREN",
+			"level":          level,
+			"activeChildren": children,
+		},
+	})
+	return validator.NewValidationFailedError(operation, result)
+}
+
+func impactedPositions(versions []types.Position) []types.JobCatalogImpactedPosition {
+	impacted := make([]types.JobCatalogImpactedPosition, 0, len(versions))
+	for _, version := range versions {
+		item := types.JobCatalogImpactedPosition{
+			Code:             version.Code,
+			RecordID:         version.RecordID,
+			Title:            version.Title,
+			OrganizationCode: version.OrganizationCode,
+			Status:           version.Status,
+			JobLevelCode:     version.JobLevelCode,
+			EffectiveDate:    version.EffectiveDate,
+			IsCurrent:        version.IsCurrent,
+		}
+		if version.EndDate.Valid {
+			end := version.EndDate.Time
+			item.EndDate = &end
+		}
+		impacted = append(impacted, item)
+	}
+	return impacted
+}
+
+func normalizedOptionalCode(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(*value))
+}
+
+// resolveJobCatalogByLevel 由职级向上解析当前的职务、职种与职类
+func (s *PositionService) resolveJobCatalogByLevel(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, levelCode string) (*jobCatalogSnapshot, error) {
+	level, err := s.jobCatalog.GetCurrentJobLevel(ctx, tx, tenantID, levelCode)
+	if err != nil {
+		return nil, err
+	}
+	if level == nil {
+		return nil, ErrJobCatalogNotFound
+	}
+	role, err := s.jobCatalog.GetCurrentJobRole(ctx, tx, tenantID, level.RoleCode)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, ErrJobCatalogNotFound
+	}
+	family, err := s.jobCatalog.GetCurrentJobFamily(ctx, tx, tenantID, role.FamilyCode)
+	if err != nil {
+		return nil, err
+	}
+	if family == nil {
+		return nil, ErrJobCatalogNotFound
+	}
+	return s.resolveJobCatalog(ctx, tx, tenantID, family.FamilyGroupCode, nil, family.Code, nil, role.Code, nil, level.Code, nil)
+}
+
+// remapJobCatalogReferences 将引用停用条目的职位版本迁移到替代条目，须在调用方事务中执行：
+// 跨越停用日的版本在停用日新增版本，停用日及之后生效的计划版本直接更新目录引用，历史版本保持不变。
+func (s *PositionService) remapJobCatalogReferences(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, versions []types.Position, target *jobCatalogSnapshot, effectiveDate time.Time, reason string, operator types.OperatedByInfo) ([]types.JobCatalogRemappedPosition, error) {
+	const operation = "RemapJobCatalog"
+	opID, opName := resolveOperator(operator)
+	trimmedReason := strings.TrimSpace(reason)
+
+	remapped := make([]types.JobCatalogRemappedPosition, 0, len(versions))
+	touched := make([]string, 0)
+	seen := make(map[string]bool)
+	for i := range versions {
+		source := versions[i]
+		entity := source
+		applyJobCatalogSnapshot(&entity, target)
+		entity.OperatedByID = opID
+		entity.OperatedByName = opName
+		entity.OperationReason = toNullString(&trimmedReason)
+
+		mode := types.JobCatalogRemapFutureVersion
+		eventType := audit.EventTypeUpdate
+		if source.EffectiveDate.Before(effectiveDate) {
+			mode = types.JobCatalogRemapNewVersion
+			eventType = audit.EventTypeCreate
+			entity.EffectiveDate = effectiveDate
+			entity.EndDate = sql.NullTime{}
+			entity.IsCurrent = false
+			entity.OperationType = "CREATE_VERSION"
+			if _, err := s.positions.InsertPositionVersion(ctx, tx, &entity); err != nil {
+				if strings.Contains(err.Error(), "already exists") {
+					return nil, ErrPositionVersionExists
+				}
+				return nil, err
+			}
+		} else {
+			entity.OperationType = "UPDATE"
+			if _, err := s.positions.UpdatePositionDetails(ctx, tx, &entity); err != nil {
+				return nil, err
+			}
+		}
+
+		after := map[string]interface{}{
+			"code":               entity.Code,
+			"effectiveDate":      entity.EffectiveDate.Format("2006-01-02"),
+			"sourceRecordId":     source.RecordID.String(),
+			"jobFamilyGroupCode": entity.JobFamilyGroupCode,
+			"jobFamilyCode":      entity.JobFamilyCode,
+			"jobRoleCode":        entity.JobRoleCode,
+			"jobLevelCode":       entity.JobLevelCode,
+		}
+		if err := s.logPositionEvent(ctx, tx, operator, tenantID, eventType, operation, entity.RecordID, after); err != nil {
+			return nil, err
+		}
+		if err := s.publishPositionEvent(ctx, tx, tenantID, events.EventPositionUpdated, operation, &entity, map[string]interface{}{
+			"remapMode":      mode,
+			"sourceRecordId": source.RecordID.String(),
+		}); err != nil {
+			return nil, err
+		}
+
+		remapped = append(remapped, types.JobCatalogRemappedPosition{
+			Code:           entity.Code,
+			SourceRecordID: source.RecordID,
+			RecordID:       entity.RecordID,
+			EffectiveDate:  entity.EffectiveDate,
+			Mode:           mode,
+		})
+		if !seen[entity.Code] {
+			seen[entity.Code] = true
+			touched = append(touched, entity.Code)
+		}
+	}
+
+	for _, code := range touched {
+		if err := s.positions.RecalculatePositionTimeline(ctx, tx, tenantID, code); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrPositionTimelineUpdate, err)
+		}
+	}
+	return remapped, nil
+}
+
+func applyJobCatalogSnapshot(entity *types.Position, catalog *jobCatalogSnapshot) {
+	entity.JobFamilyGroupCode = catalog.group.Code
+	entity.JobFamilyGroupName = catalog.group.Name
+	entity.JobFamilyGroupRecord = catalog.group.RecordID
+	entity.JobFamilyCode = catalog.family.Code
+	entity.JobFamilyName = catalog.family.Name
+	entity.JobFamilyRecord = catalog.family.RecordID
+	entity.JobRoleCode = catalog.role.Code
+	entity.JobRoleName = catalog.role.Name
+	entity.JobRoleRecord = catalog.role.RecordID
+	entity.JobLevelCode = catalog.level.Code
+	entity.JobLevelName = catalog.level.Name
+	entity.JobLevelRecord = catalog.level.RecordID
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"cube-castle/internal/organization/repository"
+	validator "cube-castle/internal/organization/validator"
+	"cube-castle/internal/types"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func TestEnsureJobCatalogActiveRejectsNewlyReferencedRetiredEntries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	svc := &PositionService{
+		jobCatalog: repository.NewJobCatalogRepository(db, pkglogger.NewNoopLogger()),
+		logger:     pkglogger.NewNoopLogger(),
+	}
+	tenantID := uuid.New()
+	effective := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	entity := &types.Position{
+		Code:               "P1000001",
+		JobFamilyGroupCode: "G1",
+		JobFamilyCode:      "F1",
+		JobRoleCode:        "JR-OLD",
+		JobLevelCode:       "JL-1",
+		EffectiveDate:      effective,
+	}
+	previous := &types.Position{JobFamilyGroupCode: "G1", JobFamilyCode: "F1", JobRoleCode: "JR-PREV", JobLevelCode: "JL-1"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM job_roles`)).
+		WithArgs(tenantID, "JR-OLD", effective).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("INACTIVE"))
+
+	err = svc.ensureJobCatalogActive(context.Background(), nil, tenantID, "CreatePositionVersion", entity, previous)
+	var validationErr *validator.ValidationFailedError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	result := validationErr.Result()
+	if len(result.Errors) != 1 || result.Errors[0].Code != "JOB_CATALOG_RETIRED" || result.Errors[0].Field != "jobRoleCode" {
+		t.Fatalf("unexpected validation errors: %#v", result.Errors)
+	}
+
+	// 与原版本相同的引用不再校验，存量职位仍可维护
+	if err := svc.ensureJobCatalogActive(context.Background(), nil, tenantID, "ReplacePosition", entity, &types.Position{
+		JobFamilyGroupCode: "G1", JobFamilyCode: "F1", JobRoleCode: "JR-OLD", JobLevelCode: "JL-1",
+	}); err != nil {
+		t.Fatalf("expected unchanged references to pass, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestResolveReplacementValidatesInput(t *testing.T) {
+	svc := &JobCatalogService{logger: pkglogger.NewNoopLogger(), positions: &PositionService{}}
+	asOf := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name                   string
+		level, code            string
+		replacement, levelCode string
+	}{
+		{name: "missing replacement", level: types.JobCatalogLevelRole, code: "JR-OLD", levelCode: "JL-2"},
+		{name: "same as retired", level: types.JobCatalogLevelRole, code: "JR-OLD", replacement: "JR-OLD", levelCode: "JL-2"},
+		{name: "role requires level", level: types.JobCatalogLevelRole, code: "JR-OLD", replacement: "JR-NEW"},
+		{name: "level code mismatch", level: types.JobCatalogLevelLevel, code: "JL-1", replacement: "JL-2", levelCode: "JL-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.resolveReplacement(context.Background(), nil, uuid.New(), tc.level, tc.code, tc.replacement, tc.levelCode, asOf)
+			if !errors.Is(err, ErrJobCatalogReplacementInvalid) {
+				t.Fatalf("expected ErrJobCatalogReplacementInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRetireJobCatalogDryRunReportsImpact(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	logger := pkglogger.NewNoopLogger()
+	jobCatalogRepo := repository.NewJobCatalogRepository(db, logger)
+	svc := &JobCatalogService{
+		repo:   jobCatalogRepo,
+		logger: logger,
+		positions: &PositionService{
+			positions:  repository.NewPositionRepository(db, logger),
+			jobCatalog: jobCatalogRepo,
+			logger:     logger,
+		},
+	}
+	tenantID := uuid.New()
+	asOf := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM job_roles WHERE tenant_id = $1 AND role_code = $2 AND is_current = true`)).
+		WithArgs(tenantID, "JR-OLD").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"record_id", "tenant_id", "role_code", "family_code", "parent_record_id", "name", "name_i18n", "description", "competency_model", "status", "effective_date", "end_date", "is_current",
+		}).AddRow(uuid.New(), tenantID, "JR-OLD", "F1", uuid.New(), "Legacy Role", []byte(`{}`), nil, []byte(`{}`), "ACTIVE", asOf.AddDate(-1, 0, 0), nil, true))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT status FROM job_roles`)).
+		WithArgs(tenantID, "JR-OLD", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("ACTIVE"))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM job_levels`)).
+		WithArgs(tenantID, "JR-OLD", asOf, "ACTIVE").
+		WillReturnRows(sqlmock.NewRows([]string{"level_code", "name", "status", "effective_date"}).
+			AddRow("JL-1", "Junior", "ACTIVE", asOf.AddDate(-1, 0, 0)))
+	mock.ExpectQuery(regexp.QuoteMeta(`AND job_role_code = $2`)).
+		WithArgs(tenantID, "JR-OLD", asOf).
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}))
+	mock.ExpectCommit()
+
+	result, err := svc.RetireJobCatalog(context.Background(), tenantID, types.JobCatalogLevelRole, "jr-old", &types.RetireJobCatalogRequest{
+		EffectiveDate: "2025-07-01",
+		DryRun:        true,
+	}, types.OperatedByInfo{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.DryRun || result.RecordID != nil {
+		t.Fatalf("expected dry run without new version, got %#v", result)
+	}
+	if len(result.ActiveChildren) != 1 || result.ActiveChildren[0].Code != "JL-1" {
+		t.Fatalf("expected active child JL-1, got %#v", result.ActiveChildren)
+	}
+	if len(result.ImpactedPositions) != 0 || len(result.RemappedPositions) != 0 {
+		t.Fatalf("expected no impacted positions, got %#v", result)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestInsertRetiredJobLevelVersionChainsFromScheduledVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	logger := pkglogger.NewNoopLogger()
+	svc := &JobCatalogService{repo: repository.NewJobCatalogRepository(db, logger), logger: logger}
+	tenantID := uuid.New()
+	scheduledRecord := uuid.New()
+	newRoleRecord := uuid.New()
+	scheduledAt := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	retireAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	levelColumns := []string{
+		"record_id", "tenant_id", "level_code", "role_code", "parent_record_id", "level_rank", "name", "name_i18n", "description", "salary_band", "status", "effective_date", "end_date", "is_current",
+	}
+
+	mock.ExpectBegin()
+	// 已排期的未来版本改挂到新职务，停用版本须沿用最新版本的职务引用而非当前版本
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id FROM job_levels WHERE tenant_id = $1 AND level_code = $2 ORDER BY effective_date DESC LIMIT 1`)).
+		WithArgs(tenantID, "JL-1").
+		WillReturnRows(sqlmock.NewRows([]string{"record_id"}).AddRow(scheduledRecord))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM job_levels WHERE tenant_id = $1 AND record_id = $2`)).
+		WithArgs(tenantID, scheduledRecord).
+		WillReturnRows(sqlmock.NewRows(levelColumns).
+			AddRow(scheduledRecord, tenantID, "JL-1", "JR-NEW", newRoleRecord, "S1", "Junior", []byte(`{}`), nil, nil, "ACTIVE", scheduledAt, nil, false))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO job_levels`)).
+		WithArgs(tenantID, "JL-1", "JR-NEW", newRoleRecord, "S1", "Junior", nil, nil, types.JobCatalogStatusInactive, retireAt, false, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(levelColumns).
+			AddRow(uuid.New(), tenantID, "JL-1", "JR-NEW", newRoleRecord, "S1", "Junior", []byte(`{}`), nil, nil, types.JobCatalogStatusInactive, retireAt, nil, false))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT record_id, effective_date, end_date, is_current FROM job_levels`)).
+		WithArgs(tenantID, "JL-1").
+		WillReturnRows(sqlmock.NewRows([]string{"record_id", "effective_date", "end_date", "is_current"}))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	recordID, err := svc.insertRetiredVersion(context.Background(), tx, tenantID, types.JobCatalogLevelLevel, "JL-1", &retiredCatalogEntry{name: "Junior"}, retireAt, "RetireJobCatalog")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recordID == uuid.Nil {
+		t.Fatal("expected retired version record id")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
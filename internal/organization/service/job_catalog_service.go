@@ -16,6 +16,7 @@ import (
 	"cube-castle/internal/types"
 	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
@@ -35,15 +36,17 @@ type JobCatalogService struct {
 	auditLogger *audit.AuditLogger
 	logger      pkglogger.Logger
 	outboxRepo  database.OutboxRepository
+	positions   *PositionService
 }
 
-func NewJobCatalogService(repo *repository.JobCatalogRepository, validatorService validator.JobCatalogValidationService, auditLogger *audit.AuditLogger, baseLogger pkglogger.Logger, outboxRepo database.OutboxRepository) *JobCatalogService {
+func NewJobCatalogService(repo *repository.JobCatalogRepository, validatorService validator.JobCatalogValidationService, positions *PositionService, auditLogger *audit.AuditLogger, baseLogger pkglogger.Logger, outboxRepo database.OutboxRepository) *JobCatalogService {
 	return &JobCatalogService{
 		repo:        repo,
 		validator:   validatorService,
 		auditLogger: auditLogger,
 		logger:      scopedLogger(baseLogger, "jobCatalog", nil),
 		outboxRepo:  outboxRepo,
+		positions:   positions,
 	}
 }
 
@@ -93,6 +96,25 @@ func (s *JobCatalogService) fallbackValidationError(operation, code string, resu
 	return validator.NewValidationFailedError(operation, result)
 }
 
+// normalizeCatalogNames 规范化职位目录多语言名称（保留空值以便合并时移除对应语言），无效时返回校验失败错误
+func normalizeCatalogNames(operation string, names *types.LocalizedNames) error {
+	normalized, err := names.Normalize()
+	if err != nil {
+		result := validator.NewValidationResult()
+		result.Valid = false
+		result.Errors = append(result.Errors, validator.ValidationError{
+			Code:     "JOB_CATALOG_NAME_I18N_INVALID",
+			Message:  err.Error(),
+			Field:    "nameI18n",
+			Value:    *names,
+			Severity: string(validator.SeverityHigh),
+		})
+		return validator.NewValidationFailedError(operation, result)
+	}
+	*names = normalized
+	return nil
+}
+
 func (s *JobCatalogService) translateJobCatalogError(ctx context.Context, tenantID uuid.UUID, code string, operation string, req *types.JobCatalogVersionRequest, err error) error {
 	if err == nil {
 		return nil
@@ -231,26 +253,28 @@ func (s *JobCatalogService) translateJobCatalogError(ctx context.Context, tenant
 }
 
 func (s *JobCatalogService) CreateJobFamilyGroup(ctx context.Context, tenantID uuid.UUID, req *types.CreateJobFamilyGroupRequest, operator types.OperatedByInfo) (*types.JobFamilyGroup, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	entity, err := s.repo.InsertFamilyGroup(ctx, tx, tenantID, req)
-	if err != nil {
+	if err := normalizeCatalogNames("CreateJobFamilyGroup", &req.NameI18n); err != nil {
 		return nil, err
 	}
+	var entity *types.JobFamilyGroup
+	err := s.repo.WithTx(ctx, "CreateJobFamilyGroup", func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		entity, err = s.repo.InsertFamilyGroup(ctx, tx, tenantID, req)
+		if err != nil {
+			return err
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyGroup", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyGroup", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -258,31 +282,33 @@ func (s *JobCatalogService) CreateJobFamilyGroup(ctx context.Context, tenantID u
 }
 
 func (s *JobCatalogService) CreateJobFamilyGroupVersion(ctx context.Context, tenantID uuid.UUID, code string, req *types.JobCatalogVersionRequest, operator types.OperatedByInfo) (*types.JobFamilyGroup, error) {
+	if err := normalizeCatalogNames("CreateJobFamilyGroupVersion", &req.NameI18n); err != nil {
+		return nil, err
+	}
 	if err := s.validate("CreateJobFamilyGroupVersion", func(v validator.JobCatalogValidationService) *validator.ValidationResult {
 		return v.ValidateCreateFamilyGroupVersion(ctx, tenantID, code, req)
 	}); err != nil {
 		return nil, err
 	}
 
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
+	var entity *types.JobFamilyGroup
+	err := s.repo.WithTx(ctx, "CreateJobFamilyGroupVersion", func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		entity, err = s.repo.InsertFamilyGroupVersion(ctx, tx, tenantID, code, req)
+		if err != nil {
+			return s.translateJobCatalogError(ctx, tenantID, code, "CreateJobFamilyGroupVersion", req, err)
+		}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyGroupVersion", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	entity, err := s.repo.InsertFamilyGroupVersion(ctx, tx, tenantID, code, req)
+		return nil
+	})
 	if err != nil {
-		return nil, s.translateJobCatalogError(ctx, tenantID, code, "CreateJobFamilyGroupVersion", req, err)
-	}
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyGroupVersion", entity.RecordID, after); err != nil {
-		return nil, err
-	}
-
-	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -290,35 +316,36 @@ func (s *JobCatalogService) CreateJobFamilyGroupVersion(ctx context.Context, ten
 }
 
 func (s *JobCatalogService) CreateJobFamily(ctx context.Context, tenantID uuid.UUID, req *types.CreateJobFamilyRequest, operator types.OperatedByInfo) (*types.JobFamily, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
+	if err := normalizeCatalogNames("CreateJobFamily", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	defer tx.Rollback()
-
-	parent, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, req.JobFamilyGroupCode)
-	if err != nil {
-		return nil, err
-	}
-	if parent == nil {
-		return nil, ErrJobCatalogParentMissing
-	}
+	var entity *types.JobFamily
+	err := s.repo.WithTx(ctx, "CreateJobFamily", func(ctx context.Context, tx *sql.Tx) error {
+		parent, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, req.JobFamilyGroupCode)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return ErrJobCatalogParentMissing
+		}
 
-	entity, err := s.repo.InsertJobFamily(ctx, tx, tenantID, parent.RecordID, req)
-	if err != nil {
-		return nil, err
-	}
+		entity, err = s.repo.InsertJobFamily(ctx, tx, tenantID, parent.RecordID, req)
+		if err != nil {
+			return err
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"groupCode":   entity.FamilyGroupCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamily", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"groupCode":   entity.FamilyGroupCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamily", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -326,6 +353,9 @@ func (s *JobCatalogService) CreateJobFamily(ctx context.Context, tenantID uuid.U
 }
 
 func (s *JobCatalogService) CreateJobFamilyVersion(ctx context.Context, tenantID uuid.UUID, code string, req *types.JobCatalogVersionRequest, operator types.OperatedByInfo) (*types.JobFamily, error) {
+	if err := normalizeCatalogNames("CreateJobFamilyVersion", &req.NameI18n); err != nil {
+		return nil, err
+	}
 	if req.ParentRecordID == nil {
 		return nil, ErrJobCatalogInvalidInput
 	}
@@ -340,27 +370,26 @@ func (s *JobCatalogService) CreateJobFamilyVersion(ctx context.Context, tenantID
 		return nil, err
 	}
 
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	entity, err := s.repo.InsertJobFamilyVersion(ctx, tx, tenantID, code, parentUUID, req)
-	if err != nil {
-		return nil, s.translateJobCatalogError(ctx, tenantID, code, "CreateJobFamilyVersion", req, err)
-	}
+	var entity *types.JobFamily
+	err := s.repo.WithTx(ctx, "CreateJobFamilyVersion", func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		entity, err = s.repo.InsertJobFamilyVersion(ctx, tx, tenantID, code, parentUUID, req)
+		if err != nil {
+			return s.translateJobCatalogError(ctx, tenantID, code, "CreateJobFamilyVersion", req, err)
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"groupCode":   entity.FamilyGroupCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyVersion", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"groupCode":   entity.FamilyGroupCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobFamilyVersion", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -368,35 +397,36 @@ func (s *JobCatalogService) CreateJobFamilyVersion(ctx context.Context, tenantID
 }
 
 func (s *JobCatalogService) CreateJobRole(ctx context.Context, tenantID uuid.UUID, req *types.CreateJobRoleRequest, operator types.OperatedByInfo) (*types.JobRole, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	parent, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, req.JobFamilyCode)
-	if err != nil {
+	if err := normalizeCatalogNames("CreateJobRole", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	if parent == nil {
-		return nil, ErrJobCatalogParentMissing
-	}
+	var entity *types.JobRole
+	err := s.repo.WithTx(ctx, "CreateJobRole", func(ctx context.Context, tx *sql.Tx) error {
+		parent, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, req.JobFamilyCode)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return ErrJobCatalogParentMissing
+		}
 
-	entity, err := s.repo.InsertJobRole(ctx, tx, tenantID, parent.RecordID, req)
-	if err != nil {
-		return nil, err
-	}
+		entity, err = s.repo.InsertJobRole(ctx, tx, tenantID, parent.RecordID, req)
+		if err != nil {
+			return err
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"familyCode":  entity.FamilyCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobRole", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"familyCode":  entity.FamilyCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobRole", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -404,6 +434,9 @@ func (s *JobCatalogService) CreateJobRole(ctx context.Context, tenantID uuid.UUI
 }
 
 func (s *JobCatalogService) CreateJobRoleVersion(ctx context.Context, tenantID uuid.UUID, code string, req *types.JobCatalogVersionRequest, operator types.OperatedByInfo) (*types.JobRole, error) {
+	if err := normalizeCatalogNames("CreateJobRoleVersion", &req.NameI18n); err != nil {
+		return nil, err
+	}
 	if req.ParentRecordID == nil {
 		return nil, ErrJobCatalogInvalidInput
 	}
@@ -418,27 +451,26 @@ func (s *JobCatalogService) CreateJobRoleVersion(ctx context.Context, tenantID u
 		return nil, err
 	}
 
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	entity, err := s.repo.InsertJobRoleVersion(ctx, tx, tenantID, code, parentUUID, req)
-	if err != nil {
-		return nil, s.translateJobCatalogError(ctx, tenantID, code, "CreateJobRoleVersion", req, err)
-	}
+	var entity *types.JobRole
+	err := s.repo.WithTx(ctx, "CreateJobRoleVersion", func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		entity, err = s.repo.InsertJobRoleVersion(ctx, tx, tenantID, code, parentUUID, req)
+		if err != nil {
+			return s.translateJobCatalogError(ctx, tenantID, code, "CreateJobRoleVersion", req, err)
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"familyCode":  entity.FamilyCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobRoleVersion", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"familyCode":  entity.FamilyCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobRoleVersion", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -446,39 +478,40 @@ func (s *JobCatalogService) CreateJobRoleVersion(ctx context.Context, tenantID u
 }
 
 func (s *JobCatalogService) CreateJobLevel(ctx context.Context, tenantID uuid.UUID, req *types.CreateJobLevelRequest, operator types.OperatedByInfo) (*types.JobLevel, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	parent, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, req.JobRoleCode)
-	if err != nil {
+	if err := normalizeCatalogNames("CreateJobLevel", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	if parent == nil {
-		return nil, ErrJobCatalogParentMissing
-	}
+	var entity *types.JobLevel
+	err := s.repo.WithTx(ctx, "CreateJobLevel", func(ctx context.Context, tx *sql.Tx) error {
+		parent, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, req.JobRoleCode)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return ErrJobCatalogParentMissing
+		}
 
-	entity, err := s.repo.InsertJobLevel(ctx, tx, tenantID, parent.RecordID, req)
-	if err != nil {
-		return nil, err
-	}
+		entity, err = s.repo.InsertJobLevel(ctx, tx, tenantID, parent.RecordID, req)
+		if err != nil {
+			return err
+		}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"roleCode":    entity.RoleCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobLevel", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"roleCode":    entity.RoleCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobLevel", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, "CreateJobLevel", entity, nil); err != nil {
-		return nil, err
-	}
+		if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, "CreateJobLevel", entity, nil); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -486,6 +519,9 @@ func (s *JobCatalogService) CreateJobLevel(ctx context.Context, tenantID uuid.UU
 }
 
 func (s *JobCatalogService) CreateJobLevelVersion(ctx context.Context, tenantID uuid.UUID, code string, req *types.JobCatalogVersionRequest, operator types.OperatedByInfo) (*types.JobLevel, error) {
+	if err := normalizeCatalogNames("CreateJobLevelVersion", &req.NameI18n); err != nil {
+		return nil, err
+	}
 	if req.ParentRecordID == nil {
 		return nil, ErrJobCatalogInvalidInput
 	}
@@ -500,36 +536,35 @@ func (s *JobCatalogService) CreateJobLevelVersion(ctx context.Context, tenantID
 		return nil, err
 	}
 
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	entity, err := s.repo.InsertJobLevelVersion(ctx, tx, tenantID, code, parentUUID, req)
-	if err != nil {
-		lower := strings.ToLower(err.Error())
-		translated := s.translateJobCatalogError(ctx, tenantID, code, "CreateJobLevelVersion", req, err)
-		if strings.Contains(lower, "already exists for effective date") {
-			s.publishJobLevelConflictEvent(ctx, tenantID, code, "CreateJobLevelVersion", req, err.Error())
+	var entity *types.JobLevel
+	err := s.repo.WithTx(ctx, "CreateJobLevelVersion", func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		entity, err = s.repo.InsertJobLevelVersion(ctx, tx, tenantID, code, parentUUID, req)
+		if err != nil {
+			lower := strings.ToLower(err.Error())
+			translated := s.translateJobCatalogError(ctx, tenantID, code, "CreateJobLevelVersion", req, err)
+			if strings.Contains(lower, "already exists for effective date") {
+				s.publishJobLevelConflictEvent(ctx, tenantID, code, "CreateJobLevelVersion", req, err.Error())
+			}
+			return translated
 		}
-		return nil, translated
-	}
 
-	after := map[string]interface{}{
-		"code":        entity.Code,
-		"roleCode":    entity.RoleCode,
-		"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobLevelVersion", entity.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        entity.Code,
+			"roleCode":    entity.RoleCode,
+			"effectiveAt": entity.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeCreate, "CreateJobLevelVersion", entity.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, "CreateJobLevelVersion", entity, nil); err != nil {
-		return nil, err
-	}
+		if err := s.publishJobLevelEvent(ctx, tx, tenantID, events.EventJobLevelVersionCreated, "CreateJobLevelVersion", entity, nil); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -537,40 +572,42 @@ func (s *JobCatalogService) CreateJobLevelVersion(ctx context.Context, tenantID
 }
 
 func (s *JobCatalogService) UpdateJobFamilyGroup(ctx context.Context, tenantID uuid.UUID, code string, req *types.UpdateJobFamilyGroupRequest, ifMatch *string, operator types.OperatedByInfo) (*types.JobFamilyGroup, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	normalizedCode := strings.ToUpper(strings.TrimSpace(code))
-	current, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, normalizedCode)
-	if err != nil {
+	if err := normalizeCatalogNames("UpdateJobFamilyGroup", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	if current == nil {
-		return nil, ErrJobCatalogNotFound
-	}
+	var updated *types.JobFamilyGroup
+	err := s.repo.WithTx(ctx, "UpdateJobFamilyGroup", func(ctx context.Context, tx *sql.Tx) error {
+		normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+		current, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, normalizedCode)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return ErrJobCatalogNotFound
+		}
 
-	if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
-		return nil, ErrJobCatalogPreconditionFailed
-	}
+		if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
+			return ErrJobCatalogPreconditionFailed
+		}
 
-	updated, err := s.repo.UpdateFamilyGroup(ctx, tx, tenantID, normalizedCode, current.RecordID, req)
-	if err != nil {
-		return nil, s.mapUpdateError(err)
-	}
+		req.NameI18n = current.NameI18n.Merge(req.NameI18n)
+		updated, err = s.repo.UpdateFamilyGroup(ctx, tx, tenantID, normalizedCode, current.RecordID, req)
+		if err != nil {
+			return s.mapUpdateError(err)
+		}
 
-	after := map[string]interface{}{
-		"code":        updated.Code,
-		"status":      updated.Status,
-		"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobFamilyGroup", updated.RecordID, after); err != nil {
-		return nil, err
-	}
+		after := map[string]interface{}{
+			"code":        updated.Code,
+			"status":      updated.Status,
+			"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobFamilyGroup", updated.RecordID, after); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -578,58 +615,60 @@ func (s *JobCatalogService) UpdateJobFamilyGroup(ctx context.Context, tenantID u
 }
 
 func (s *JobCatalogService) UpdateJobFamily(ctx context.Context, tenantID uuid.UUID, code string, req *types.UpdateJobFamilyRequest, ifMatch *string, operator types.OperatedByInfo) (*types.JobFamily, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	normalizedCode := strings.ToUpper(strings.TrimSpace(code))
-	current, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, normalizedCode)
-	if err != nil {
+	if err := normalizeCatalogNames("UpdateJobFamily", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	if current == nil {
-		return nil, ErrJobCatalogNotFound
-	}
+	var updated *types.JobFamily
+	err := s.repo.WithTx(ctx, "UpdateJobFamily", func(ctx context.Context, tx *sql.Tx) error {
+		normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+		current, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, normalizedCode)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return ErrJobCatalogNotFound
+		}
 
-	if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
-		return nil, ErrJobCatalogPreconditionFailed
-	}
+		if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
+			return ErrJobCatalogPreconditionFailed
+		}
 
-	groupCode := current.FamilyGroupCode
-	parentRecord := current.ParentRecord
-	if req.JobFamilyGroupCode != nil {
-		normalizedGroup := strings.ToUpper(strings.TrimSpace(*req.JobFamilyGroupCode))
-		if normalizedGroup == "" {
-			return nil, ErrJobCatalogInvalidInput
+		groupCode := current.FamilyGroupCode
+		parentRecord := current.ParentRecord
+		if req.JobFamilyGroupCode != nil {
+			normalizedGroup := strings.ToUpper(strings.TrimSpace(*req.JobFamilyGroupCode))
+			if normalizedGroup == "" {
+				return ErrJobCatalogInvalidInput
+			}
+			group, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, normalizedGroup)
+			if err != nil {
+				return err
+			}
+			if group == nil {
+				return ErrJobCatalogParentMissing
+			}
+			groupCode = group.Code
+			parentRecord = group.RecordID
 		}
-		group, err := s.repo.GetCurrentFamilyGroup(ctx, tx, tenantID, normalizedGroup)
+
+		req.NameI18n = current.NameI18n.Merge(req.NameI18n)
+		updated, err = s.repo.UpdateJobFamily(ctx, tx, tenantID, normalizedCode, current.RecordID, groupCode, parentRecord, req)
 		if err != nil {
-			return nil, err
+			return s.mapUpdateError(err)
 		}
-		if group == nil {
-			return nil, ErrJobCatalogParentMissing
+
+		after := map[string]interface{}{
+			"code":        updated.Code,
+			"groupCode":   updated.FamilyGroupCode,
+			"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobFamily", updated.RecordID, after); err != nil {
+			return err
 		}
-		groupCode = group.Code
-		parentRecord = group.RecordID
-	}
 
-	updated, err := s.repo.UpdateJobFamily(ctx, tx, tenantID, normalizedCode, current.RecordID, groupCode, parentRecord, req)
+		return nil
+	})
 	if err != nil {
-		return nil, s.mapUpdateError(err)
-	}
-
-	after := map[string]interface{}{
-		"code":        updated.Code,
-		"groupCode":   updated.FamilyGroupCode,
-		"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobFamily", updated.RecordID, after); err != nil {
-		return nil, err
-	}
-
-	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -637,58 +676,60 @@ func (s *JobCatalogService) UpdateJobFamily(ctx context.Context, tenantID uuid.U
 }
 
 func (s *JobCatalogService) UpdateJobRole(ctx context.Context, tenantID uuid.UUID, code string, req *types.UpdateJobRoleRequest, ifMatch *string, operator types.OperatedByInfo) (*types.JobRole, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	normalizedCode := strings.ToUpper(strings.TrimSpace(code))
-	current, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, normalizedCode)
-	if err != nil {
+	if err := normalizeCatalogNames("UpdateJobRole", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	if current == nil {
-		return nil, ErrJobCatalogNotFound
-	}
+	var updated *types.JobRole
+	err := s.repo.WithTx(ctx, "UpdateJobRole", func(ctx context.Context, tx *sql.Tx) error {
+		normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+		current, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, normalizedCode)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return ErrJobCatalogNotFound
+		}
 
-	if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
-		return nil, ErrJobCatalogPreconditionFailed
-	}
+		if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
+			return ErrJobCatalogPreconditionFailed
+		}
 
-	familyCode := current.FamilyCode
-	parentRecord := current.ParentRecord
-	if req.JobFamilyCode != nil {
-		normalizedFamily := strings.ToUpper(strings.TrimSpace(*req.JobFamilyCode))
-		if normalizedFamily == "" {
-			return nil, ErrJobCatalogInvalidInput
+		familyCode := current.FamilyCode
+		parentRecord := current.ParentRecord
+		if req.JobFamilyCode != nil {
+			normalizedFamily := strings.ToUpper(strings.TrimSpace(*req.JobFamilyCode))
+			if normalizedFamily == "" {
+				return ErrJobCatalogInvalidInput
+			}
+			family, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, normalizedFamily)
+			if err != nil {
+				return err
+			}
+			if family == nil {
+				return ErrJobCatalogParentMissing
+			}
+			familyCode = family.Code
+			parentRecord = family.RecordID
 		}
-		family, err := s.repo.GetCurrentJobFamily(ctx, tx, tenantID, normalizedFamily)
+
+		req.NameI18n = current.NameI18n.Merge(req.NameI18n)
+		updated, err = s.repo.UpdateJobRole(ctx, tx, tenantID, normalizedCode, current.RecordID, familyCode, parentRecord, req)
 		if err != nil {
-			return nil, err
+			return s.mapUpdateError(err)
+		}
+
+		after := map[string]interface{}{
+			"code":        updated.Code,
+			"familyCode":  updated.FamilyCode,
+			"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
 		}
-		if family == nil {
-			return nil, ErrJobCatalogParentMissing
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobRole", updated.RecordID, after); err != nil {
+			return err
 		}
-		familyCode = family.Code
-		parentRecord = family.RecordID
-	}
 
-	updated, err := s.repo.UpdateJobRole(ctx, tx, tenantID, normalizedCode, current.RecordID, familyCode, parentRecord, req)
+		return nil
+	})
 	if err != nil {
-		return nil, s.mapUpdateError(err)
-	}
-
-	after := map[string]interface{}{
-		"code":        updated.Code,
-		"familyCode":  updated.FamilyCode,
-		"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobRole", updated.RecordID, after); err != nil {
-		return nil, err
-	}
-
-	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -696,66 +737,68 @@ func (s *JobCatalogService) UpdateJobRole(ctx context.Context, tenantID uuid.UUI
 }
 
 func (s *JobCatalogService) UpdateJobLevel(ctx context.Context, tenantID uuid.UUID, code string, req *types.UpdateJobLevelRequest, ifMatch *string, operator types.OperatedByInfo) (*types.JobLevel, error) {
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
+	if err := normalizeCatalogNames("UpdateJobLevel", &req.NameI18n); err != nil {
 		return nil, err
 	}
-	defer tx.Rollback()
+	var updated *types.JobLevel
+	err := s.repo.WithTx(ctx, "UpdateJobLevel", func(ctx context.Context, tx *sql.Tx) error {
+		normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+		current, err := s.repo.GetCurrentJobLevel(ctx, tx, tenantID, normalizedCode)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return ErrJobCatalogNotFound
+		}
 
-	normalizedCode := strings.ToUpper(strings.TrimSpace(code))
-	current, err := s.repo.GetCurrentJobLevel(ctx, tx, tenantID, normalizedCode)
-	if err != nil {
-		return nil, err
-	}
-	if current == nil {
-		return nil, ErrJobCatalogNotFound
-	}
+		if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
+			return ErrJobCatalogPreconditionFailed
+		}
 
-	if ifMatch != nil && current.RecordID.String() != strings.TrimSpace(*ifMatch) {
-		return nil, ErrJobCatalogPreconditionFailed
-	}
+		roleCode := current.RoleCode
+		parentRecord := current.ParentRecord
+		if req.JobRoleCode != nil {
+			normalizedRole := strings.ToUpper(strings.TrimSpace(*req.JobRoleCode))
+			if normalizedRole == "" {
+				return ErrJobCatalogInvalidInput
+			}
+			role, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, normalizedRole)
+			if err != nil {
+				return err
+			}
+			if role == nil {
+				return ErrJobCatalogParentMissing
+			}
+			roleCode = role.Code
+			parentRecord = role.RecordID
+		}
 
-	roleCode := current.RoleCode
-	parentRecord := current.ParentRecord
-	if req.JobRoleCode != nil {
-		normalizedRole := strings.ToUpper(strings.TrimSpace(*req.JobRoleCode))
-		if normalizedRole == "" {
-			return nil, ErrJobCatalogInvalidInput
+		levelRank := current.LevelRank
+		if req.LevelRank != nil {
+			if *req.LevelRank < 1 {
+				return ErrJobCatalogInvalidInput
+			}
+			levelRank = strconv.Itoa(*req.LevelRank)
 		}
-		role, err := s.repo.GetCurrentJobRole(ctx, tx, tenantID, normalizedRole)
+
+		req.NameI18n = current.NameI18n.Merge(req.NameI18n)
+		updated, err = s.repo.UpdateJobLevel(ctx, tx, tenantID, normalizedCode, current.RecordID, roleCode, parentRecord, levelRank, req)
 		if err != nil {
-			return nil, err
+			return s.mapUpdateError(err)
 		}
-		if role == nil {
-			return nil, ErrJobCatalogParentMissing
-		}
-		roleCode = role.Code
-		parentRecord = role.RecordID
-	}
 
-	levelRank := current.LevelRank
-	if req.LevelRank != nil {
-		if *req.LevelRank < 1 {
-			return nil, ErrJobCatalogInvalidInput
+		after := map[string]interface{}{
+			"code":        updated.Code,
+			"roleCode":    updated.RoleCode,
+			"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
+		}
+		if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobLevel", updated.RecordID, after); err != nil {
+			return err
 		}
-		levelRank = strconv.Itoa(*req.LevelRank)
-	}
 
-	updated, err := s.repo.UpdateJobLevel(ctx, tx, tenantID, normalizedCode, current.RecordID, roleCode, parentRecord, levelRank, req)
+		return nil
+	})
 	if err != nil {
-		return nil, s.mapUpdateError(err)
-	}
-
-	after := map[string]interface{}{
-		"code":        updated.Code,
-		"roleCode":    updated.RoleCode,
-		"effectiveAt": updated.EffectiveDate.Format("2006-01-02"),
-	}
-	if err := s.logCatalogEvent(ctx, tx, tenantID, operator, audit.EventTypeUpdate, "UpdateJobLevel", updated.RecordID, after); err != nil {
-		return nil, err
-	}
-
-	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -903,7 +946,12 @@ func (s *JobCatalogService) saveOutboxEvent(ctx context.Context, tx *sql.Tx, evt
 	if err := s.outboxRepo.Save(ctx, database.WrapSQLTx(newTx), evt); err != nil {
 		return err
 	}
-	return newTx.Commit()
+	if err := newTx.Commit(); err != nil {
+		return err
+	}
+	// 独立事务已提交，不随外层事务回滚；外层遇到冲突时不得重复执行
+	database.MarkSideEffect(ctx)
+	return nil
 }
 
 func (s *JobCatalogService) newEventContext(ctx context.Context, tenantID uuid.UUID, operation string) events.Context {
@@ -913,6 +961,7 @@ func (s *JobCatalogService) newEventContext(ctx context.Context, tenantID uuid.U
 		CorrelationID: orgmiddleware.GetCorrelationID(ctx),
 		Operation:     operation,
 		Source:        events.DefaultSourceCommand,
+		Headers:       tracing.InjectMap(ctx),
 	}
 }
 
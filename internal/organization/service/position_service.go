@@ -11,12 +11,14 @@ import (
 
 	"cube-castle/internal/organization/audit"
 	"cube-castle/internal/organization/events"
+	"cube-castle/internal/organization/lifecycle"
 	orgmiddleware "cube-castle/internal/organization/middleware"
 	"cube-castle/internal/organization/repository"
 	validator "cube-castle/internal/organization/validator"
 	"cube-castle/internal/types"
 	"cube-castle/pkg/database"
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
 	"github.com/google/uuid"
 )
 
@@ -29,9 +31,11 @@ var (
 	ErrPositionTimelineUpdate = errors.New("failed to update position timeline")
 	ErrVersionConflict        = errors.New("version conflict")
 	ErrInvalidTransition      = errors.New("invalid position transition")
+	ErrTransitionGuardFailed  = errors.New("position transition guard not satisfied")
 	ErrInvalidHeadcount       = errors.New("invalid headcount change")
 	ErrAssignmentNotFound     = errors.New("position assignment not found")
 	ErrInvalidAssignmentState = errors.New("position assignment state invalid")
+	ErrInvalidTitleI18n       = errors.New("invalid localized position title")
 )
 
 type PositionService struct {
@@ -44,6 +48,7 @@ type PositionService struct {
 	positionValidator   validator.PositionValidationService
 	assignmentValidator validator.AssignmentValidationService
 	outboxRepo          database.OutboxRepository
+	lifecycle           lifecycle.Source
 }
 
 func NewPositionService(positions *repository.PositionRepository, assignments *repository.PositionAssignmentRepository, jobCatalog *repository.JobCatalogRepository, orgRepo *repository.OrganizationRepository, positionValidator validator.PositionValidationService, assignmentValidator validator.AssignmentValidationService, auditLogger *audit.AuditLogger, baseLogger pkglogger.Logger, outboxRepo database.OutboxRepository) *PositionService {
@@ -233,6 +238,9 @@ func (s *PositionService) CreatePosition(ctx context.Context, tenantID uuid.UUID
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureJobCatalogActive(ctx, tx, tenantID, "CreatePosition", entity, nil); err != nil {
+		return nil, err
+	}
 
 	entity, err = s.positions.InsertPositionVersion(ctx, tx, entity)
 	if err != nil {
@@ -312,10 +320,15 @@ func (s *PositionService) ReplacePosition(ctx context.Context, tenantID uuid.UUI
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureJobCatalogActive(ctx, tx, tenantID, "ReplacePosition", updateEntity, current); err != nil {
+		return nil, err
+	}
 	updateEntity.RecordID = current.RecordID
 	updateEntity.HeadcountInUse = current.HeadcountInUse
 	updateEntity.IsCurrent = current.IsCurrent
 	updateEntity.CreatedAt = current.CreatedAt
+	updateEntity.CustomFields = current.CustomFields.Merge(req.CustomFields)
+	updateEntity.TitleI18n = current.TitleI18n.Merge(req.TitleI18n)
 	updateEntity.OperationType = "UPDATE"
 
 	if _, err := s.positions.UpdatePositionDetails(ctx, tx, updateEntity); err != nil {
@@ -357,6 +370,12 @@ func (s *PositionService) CreatePositionVersion(ctx context.Context, tenantID uu
 		return nil, ErrPositionNotFound
 	}
 
+	if err := s.validatePosition("CreatePositionVersion", func(v validator.PositionValidationService) *validator.ValidationResult {
+		return v.ValidateCreateVersion(ctx, tenantID, code, req)
+	}); err != nil {
+		return nil, err
+	}
+
 	org, err := s.orgRepo.GetByCode(ctx, tenantID, current.OrganizationCode)
 	if err != nil {
 		return nil, err
@@ -405,8 +424,14 @@ func (s *PositionService) CreatePositionVersion(ctx context.Context, tenantID uu
 		headcountInUsePtr = &existing
 	}
 
+	titleI18n, err := normalizeTitleI18n(req.TitleI18n)
+	if err != nil {
+		return nil, err
+	}
+
 	versionSource := &types.PositionRequest{
 		Title:                  req.Title,
+		TitleI18n:              current.TitleI18n.Merge(titleI18n),
 		JobProfileCode:         jobProfileCode,
 		JobProfileName:         jobProfileName,
 		JobFamilyGroupCode:     req.JobFamilyGroupCode,
@@ -426,6 +451,7 @@ func (s *PositionService) CreatePositionVersion(ctx context.Context, tenantID uu
 		CostCenterCode:         costCenter,
 		ReportsToPositionCode:  reportsTo,
 		Profile:                req.Profile,
+		CustomFields:           current.CustomFields.Merge(req.CustomFields),
 		EffectiveDate:          req.EffectiveDate,
 		OperationReason:        req.OperationReason,
 	}
@@ -434,6 +460,9 @@ func (s *PositionService) CreatePositionVersion(ctx context.Context, tenantID uu
 	if err != nil {
 		return nil, err
 	}
+	if err := s.ensureJobCatalogActive(ctx, tx, tenantID, "CreatePositionVersion", entity, current); err != nil {
+		return nil, err
+	}
 	entity.OperationType = "CREATE_VERSION"
 	// 新增版本在插入前统一设置为非当前版本，待时间线重算后再确定 current 标记，避免违反唯一约束
 	entity.IsCurrent = false
@@ -785,8 +814,8 @@ func (s *PositionService) UpdateAssignmentRecord(ctx context.Context, tenantID u
 	if assignment == nil || assignment.PositionCode != current.Code {
 		return nil, ErrAssignmentNotFound
 	}
-	if strings.EqualFold(assignment.AssignmentStatus, "ENDED") {
-		return nil, s.newAssignmentStateError("UpdateAssignment", assignment)
+	if err := s.checkAssignmentTransition(ctx, tenantID, lifecycle.EventUpdate, "UpdateAssignment", assignment); err != nil {
+		return nil, err
 	}
 
 	if err := s.validateAssignment("UpdateAssignment", func(v validator.AssignmentValidationService) *validator.ValidationResult {
@@ -807,6 +836,8 @@ func (s *PositionService) UpdateAssignmentRecord(ctx context.Context, tenantID u
 	}
 
 	if req.ActingUntil != nil {
+		// 代理截止日变更后重新计算到期提醒
+		updateParams.ClearReminderSent = true
 		trimmed := strings.TrimSpace(*req.ActingUntil)
 		if trimmed == "" {
 			updateParams.ClearActingUntil = true
@@ -1049,8 +1080,8 @@ func (s *PositionService) vacatePosition(ctx context.Context, tenantID uuid.UUID
 		return nil, ErrAssignmentNotFound
 	}
 
-	if strings.EqualFold(assignment.AssignmentStatus, "ENDED") {
-		return nil, s.newAssignmentStateError("VacatePosition", assignment)
+	if err := s.checkAssignmentTransition(ctx, tenantID, lifecycle.EventClose, "VacatePosition", assignment); err != nil {
+		return nil, err
 	}
 
 	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
@@ -1236,35 +1267,55 @@ func (s *PositionService) ApplyEvent(ctx context.Context, tenantID uuid.UUID, co
 		}
 	}
 
-	eventType := strings.ToUpper(strings.TrimSpace(req.EventType))
+	machine, err := s.lifecycleMachine(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	activeFTE, err := s.assignments.SumActiveFTE(ctx, tx, tenantID, target.Code)
+	if err != nil {
+		return nil, err
+	}
+	transition, err := machine.Check(lifecycle.EntityPosition, req.EventType, lifecycle.Facts{
+		Status:            target.Status,
+		ActiveFTE:         activeFTE,
+		HeadcountCapacity: target.HeadcountCapacity,
+	})
+	switch {
+	case errors.Is(err, lifecycle.ErrGuardFailed):
+		return nil, fmt.Errorf("%w: %v", ErrTransitionGuardFailed, err)
+	case err != nil:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransition, err)
+	case transition.System:
+		return nil, fmt.Errorf("%w: %s is triggered by assignment operations", ErrInvalidTransition, transition.Event)
+	}
+
+	eventType := transition.Event
 	opID, opName := resolveOperator(operator)
 
-	switch eventType {
-	case "SUSPEND", "INACTIVE":
-		payload := map[string]interface{}{
-			"event":         eventType,
-			"effectiveDate": req.EffectiveDate,
+	if transition.HasSideEffect(lifecycle.EffectEndActiveAssignments) {
+		if err := s.endActiveAssignments(ctx, tx, tenantID, target, req, opID, opName); err != nil {
+			return nil, err
 		}
-		if err := s.positions.UpdatePositionStatus(ctx, tx, tenantID, target.RecordID, "INACTIVE", payload, "SUSPEND", opName, opID, stringPointer(req.OperationReason)); err != nil {
+	}
+
+	if transition.ToStatus == lifecycle.PositionDeleted {
+		if err := s.positions.DeletePositionVersion(ctx, tx, tenantID, target.RecordID, opID, opName, stringPointer(req.OperationReason)); err != nil {
 			return nil, err
 		}
-	case "REACTIVATE", "ACTIVATE":
+	} else {
 		payload := map[string]interface{}{
 			"event":         eventType,
 			"effectiveDate": req.EffectiveDate,
 		}
-		if err := s.positions.UpdatePositionStatus(ctx, tx, tenantID, target.RecordID, "ACTIVE", payload, "REACTIVATE", opName, opID, stringPointer(req.OperationReason)); err != nil {
-			return nil, err
-		}
-	case "DELETE":
-		if err := s.positions.DeletePositionVersion(ctx, tx, tenantID, target.RecordID, opID, opName, stringPointer(req.OperationReason)); err != nil {
+		if err := s.positions.UpdatePositionStatus(ctx, tx, tenantID, target.RecordID, transition.ToStatus, payload, positionEventOperation(transition), opName, opID, stringPointer(req.OperationReason)); err != nil {
 			return nil, err
 		}
+	}
+
+	if transition.HasSideEffect(lifecycle.EffectRecalculateTimeline) {
 		if err := s.positions.RecalculatePositionTimeline(ctx, tx, tenantID, target.Code); err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrPositionTimelineUpdate, err)
 		}
-	default:
-		return nil, ErrInvalidTransition
 	}
 
 	updated, err := s.positions.GetCurrentPosition(ctx, tx, tenantID, target.Code)
@@ -1297,6 +1348,74 @@ func (s *PositionService) ApplyEvent(ctx context.Context, tenantID uuid.UUID, co
 	return s.toPositionResponse(updated, nil), nil
 }
 
+// SetLifecycle 注入租户生命周期状态机来源；未注入时使用内置迁移规则
+func (s *PositionService) SetLifecycle(source lifecycle.Source) {
+	s.lifecycle = source
+}
+
+func (s *PositionService) lifecycleMachine(ctx context.Context, tenantID uuid.UUID) (*lifecycle.Machine, error) {
+	if s.lifecycle == nil {
+		return lifecycle.Default(), nil
+	}
+	machine, err := s.lifecycle.Machine(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("load position lifecycle: %w", err)
+	}
+	return machine, nil
+}
+
+// checkAssignmentTransition 按状态机校验任职系统事件（UPDATE/CLOSE）能否从当前任职状态执行
+func (s *PositionService) checkAssignmentTransition(ctx context.Context, tenantID uuid.UUID, event, operation string, assignment *types.PositionAssignment) error {
+	machine, err := s.lifecycleMachine(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if _, err := machine.Check(lifecycle.EntityAssignment, event, lifecycle.Facts{Status: assignment.AssignmentStatus}); err != nil {
+		return s.newAssignmentStateError(operation, assignment)
+	}
+	return nil
+}
+
+// endActiveAssignments 执行 END_ACTIVE_ASSIGNMENTS 副作用：以事件生效日期结束职位全部生效任职并归零占编
+func (s *PositionService) endActiveAssignments(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, target *types.Position, req *types.PositionEventRequest, opID uuid.UUID, opName string) error {
+	endDate, err := time.Parse("2006-01-02", strings.TrimSpace(req.EffectiveDate))
+	if err != nil {
+		return fmt.Errorf("invalid effectiveDate: %w", err)
+	}
+	assignments, err := s.assignments.ListByPosition(ctx, tx, tenantID, target.Code)
+	if err != nil {
+		return err
+	}
+	for _, assignment := range assignments {
+		if !assignment.IsCurrent || !strings.EqualFold(assignment.AssignmentStatus, lifecycle.AssignmentActive) {
+			continue
+		}
+		if endDate.Before(assignment.EffectiveDate) {
+			return fmt.Errorf("effectiveDate cannot be earlier than assignment %s effective date", assignment.AssignmentID)
+		}
+		if err := s.assignments.CloseAssignment(ctx, tx, tenantID, assignment.AssignmentID, endDate, nil); err != nil {
+			return err
+		}
+	}
+	activeFTE, err := s.assignments.SumActiveFTE(ctx, tx, tenantID, target.Code)
+	if err != nil {
+		return err
+	}
+	return s.positions.UpdatePositionHeadcount(ctx, tx, tenantID, target.RecordID, activeFTE, target.Status, "VACATE", opName, opID, stringPointer(req.OperationReason))
+}
+
+// positionEventOperation 映射职位事件的操作类型，停用/启用沿用既有 SUSPEND/REACTIVATE 审计语义
+func positionEventOperation(transition *lifecycle.Transition) string {
+	switch transition.ToStatus {
+	case lifecycle.PositionInactive:
+		return "SUSPEND"
+	case lifecycle.PositionActive:
+		return "REACTIVATE"
+	default:
+		return transition.Event
+	}
+}
+
 func (s *PositionService) resolveJobCatalog(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, groupCode string, groupRecord *string, familyCode string, familyRecord *string, roleCode string, roleRecord *string, levelCode string, levelRecord *string) (*jobCatalogSnapshot, error) {
 	group, err := s.lookupFamilyGroup(ctx, tx, tenantID, groupCode, groupRecord)
 	if err != nil {
@@ -1419,6 +1538,71 @@ func (s *PositionService) lookupJobLevel(ctx context.Context, tx *sql.Tx, tenant
 	return s.jobCatalog.GetCurrentJobLevel(ctx, tx, tenantID, code)
 }
 
+// ensureJobCatalogActive 校验职位引用的目录条目在职位生效日未停用；previous 非空时仅校验相对原版本新引入的引用，
+// 以便仍引用已停用条目的存量职位可以继续维护。
+func (s *PositionService) ensureJobCatalogActive(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, operation string, entity *types.Position, previous *types.Position) error {
+	if s.jobCatalog == nil || entity == nil {
+		return nil
+	}
+	refs := []struct {
+		level, field, code, previous string
+	}{
+		{types.JobCatalogLevelFamilyGroup, "jobFamilyGroupCode", entity.JobFamilyGroupCode, ""},
+		{types.JobCatalogLevelFamily, "jobFamilyCode", entity.JobFamilyCode, ""},
+		{types.JobCatalogLevelRole, "jobRoleCode", entity.JobRoleCode, ""},
+		{types.JobCatalogLevelLevel, "jobLevelCode", entity.JobLevelCode, ""},
+	}
+	if previous != nil {
+		refs[0].previous = previous.JobFamilyGroupCode
+		refs[1].previous = previous.JobFamilyCode
+		refs[2].previous = previous.JobRoleCode
+		refs[3].previous = previous.JobLevelCode
+	}
+
+	asOf := entity.EffectiveDate.Format("2006-01-02")
+	result := validator.NewValidationResult()
+	for _, ref := range refs {
+		if ref.code == "" || strings.EqualFold(ref.code, ref.previous) {
+			continue
+		}
+		status, err := s.jobCatalog.GetStatusAsOf(ctx, tx, tenantID, ref.level, ref.code, entity.EffectiveDate)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(status, types.JobCatalogStatusInactive) {
+			continue
+		}
+		result.Valid = false
+		result.Errors = append(result.Errors, validator.ValidationError{
+			Code:     "JOB_CATALOG_RETIRED",
+			Message:  fmt.Sprintf("Job catalog entry %s is retired as of %s", ref.code, asOf),
+			Field:    ref.field,
+			Value:    ref.code,
+			Severity: string(validator.SeverityHigh),
+			Context: map[string]interface{}{
+				"ruleId":        "POS-JOB-CATALOG-RETIRED",
+				"level":         ref.level,
+				"catalogCode":   ref.code,
+				"effectiveDate": asOf,
+			},
+		})
+	}
+	if result.Valid {
+		return nil
+	}
+	result.Context["operation"] = operation
+	return validator.NewValidationFailedError(operation, result)
+}
+
+// normalizeTitleI18n 规范化多语言职位名称，保留空值以便合并时移除对应语言。
+func normalizeTitleI18n(names types.LocalizedNames) (types.LocalizedNames, error) {
+	normalized, err := names.Normalize()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTitleI18n, err)
+	}
+	return normalized, nil
+}
+
 func (s *PositionService) buildPositionEntity(tenantID uuid.UUID, code string, req *types.PositionRequest, catalog *jobCatalogSnapshot, org *types.Organization, operator types.OperatedByInfo, _ bool) (*types.Position, error) {
 	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
 	if err != nil {
@@ -1436,6 +1620,12 @@ func (s *PositionService) buildPositionEntity(tenantID uuid.UUID, code string, r
 		profileBytes = []byte("{}")
 	}
 
+	titleI18n, err := normalizeTitleI18n(req.TitleI18n)
+	if err != nil {
+		return nil, err
+	}
+	req.TitleI18n = titleI18n
+
 	opID, opName := resolveOperator(operator)
 	status := "PLANNED"
 	if req.Status != nil && strings.TrimSpace(*req.Status) != "" {
@@ -1461,6 +1651,7 @@ func (s *PositionService) buildPositionEntity(tenantID uuid.UUID, code string, r
 		TenantID:             tenantID,
 		Code:                 code,
 		Title:                strings.TrimSpace(req.Title),
+		TitleI18n:            req.TitleI18n.Merge(nil),
 		JobProfileCode:       toNullString(req.JobProfileCode),
 		JobProfileName:       toNullString(req.JobProfileName),
 		JobFamilyGroupCode:   catalog.group.Code,
@@ -1486,6 +1677,7 @@ func (s *PositionService) buildPositionEntity(tenantID uuid.UUID, code string, r
 		CostCenterCode:       toNullString(req.CostCenterCode),
 		ReportsToPosition:    toNullString(req.ReportsToPositionCode),
 		Profile:              profileBytes,
+		CustomFields:         req.CustomFields,
 		EffectiveDate:        effectiveDate,
 		EndDate:              sql.NullTime{Valid: false},
 		IsCurrent:            isCurrent,
@@ -1550,6 +1742,7 @@ func (s *PositionService) toPositionResponse(entity *types.Position, assignments
 	response := &types.PositionResponse{
 		Code:                  entity.Code,
 		Title:                 entity.Title,
+		TitleI18n:             entity.TitleI18n,
 		JobProfileCode:        jobProfileCode,
 		JobProfileName:        jobProfileName,
 		JobFamilyGroupCode:    entity.JobFamilyGroupCode,
@@ -1571,6 +1764,7 @@ func (s *PositionService) toPositionResponse(entity *types.Position, assignments
 		GradeLevel:            gradeLevel,
 		CostCenterCode:        costCenter,
 		ReportsToPositionCode: reportsTo,
+		CustomFields:          entity.CustomFields,
 		EffectiveDate:         entity.EffectiveDate,
 		EndDate:               endDate,
 		IsCurrent:             entity.IsCurrent,
@@ -1797,6 +1991,7 @@ func (s *PositionService) newEventContext(ctx context.Context, tenantID uuid.UUI
 		CorrelationID: orgmiddleware.GetCorrelationID(ctx),
 		Operation:     operation,
 		Source:        events.DefaultSourceCommand,
+		Headers:       tracing.InjectMap(ctx),
 	}
 }
 
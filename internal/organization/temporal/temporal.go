@@ -0,0 +1,95 @@
+// Package temporal 提供按编码与生效日期版本化的主数据（职等、成本中心、地点等）共用的时间轴推导与存储辅助。
+package temporal
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// DateLayout 生效日期与结束日期的格式（YYYY-MM-DD）
+const DateLayout = "2006-01-02"
+
+// Version 约束版本化记录的指针类型：提供编码、生效日期与结束日期，并可写回推导出的区间
+type Version[T any] interface {
+	*T
+	VersionCode() string
+	VersionEffectiveDate() string
+	VersionEndDate() *string
+	// SetVersionSpan 写回推导出的结束日期与当前标记
+	SetVersionSpan(endDate *string, isCurrent bool)
+}
+
+// Timeline 按编码与生效日期排序版本并推导结束日期与当前标记，today 为判定当前版本的日期
+func Timeline[T any, P Version[T]](versions []T, today time.Time) []T {
+	slices.SortFunc(versions, func(a, b T) int {
+		if c := strings.Compare(P(&a).VersionCode(), P(&b).VersionCode()); c != 0 {
+			return c
+		}
+		return strings.Compare(P(&a).VersionEffectiveDate(), P(&b).VersionEffectiveDate())
+	})
+	todayStr := today.Format(DateLayout)
+	for i := range versions {
+		v := P(&versions[i])
+		var endDate *string
+		if i+1 < len(versions) {
+			next := P(&versions[i+1])
+			if next.VersionCode() == v.VersionCode() {
+				if nextDate, err := time.Parse(DateLayout, next.VersionEffectiveDate()); err == nil {
+					end := nextDate.AddDate(0, 0, -1).Format(DateLayout)
+					endDate = &end
+				}
+			}
+		}
+		v.SetVersionSpan(endDate, covers(v.VersionEffectiveDate(), endDate, todayStr))
+	}
+	return versions
+}
+
+// AsOf 从已按 Timeline 处理的版本中选出各编码在指定日期生效的版本
+func AsOf[T any, P Version[T]](versions []T, date string) []T {
+	out := make([]T, 0, len(versions))
+	for i := range versions {
+		v := P(&versions[i])
+		if covers(v.VersionEffectiveDate(), v.VersionEndDate(), date) {
+			out = append(out, versions[i])
+		}
+	}
+	return out
+}
+
+func covers(effectiveDate string, endDate *string, date string) bool {
+	return effectiveDate <= date && (endDate == nil || *endDate >= date)
+}
+
+// CollectRows 逐行扫描查询结果并关闭 rows
+func CollectRows[T any](rows *sql.Rows, scan func(row interface{ Scan(...any) error }) (*T, error)) ([]T, error) {
+	defer rows.Close()
+	var out []T
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *item)
+	}
+	return out, rows.Err()
+}
+
+// IsVersionConflict 判断写入错误是否为同编码同生效日期的唯一约束冲突
+func IsVersionConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// RequireAffected 在更新或删除未命中任何行时返回 notFound
+func RequireAffected(res sql.Result, notFound error) error {
+	if n, _ := res.RowsAffected(); n == 0 {
+		return notFound
+	}
+	return nil
+}
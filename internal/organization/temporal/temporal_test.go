@@ -0,0 +1,83 @@
+package temporal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+)
+
+type record struct {
+	ID            int
+	Code          string
+	EffectiveDate string
+	EndDate       *string
+	IsCurrent     bool
+}
+
+func (r *record) VersionCode() string          { return r.Code }
+func (r *record) VersionEffectiveDate() string { return r.EffectiveDate }
+func (r *record) VersionEndDate() *string      { return r.EndDate }
+func (r *record) SetVersionSpan(endDate *string, isCurrent bool) {
+	r.EndDate = endDate
+	r.IsCurrent = isCurrent
+}
+
+func TestTimelineAndAsOf(t *testing.T) {
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	stale := "2000-01-01"
+	versions := Timeline([]record{
+		{Code: "B", EffectiveDate: "2026-01-01"},
+		{Code: "A", EffectiveDate: "2024-01-01", EndDate: &stale},
+		{Code: "B", EffectiveDate: "2025-01-01"},
+	}, today)
+
+	if versions[0].Code != "A" || versions[0].EndDate != nil || !versions[0].IsCurrent {
+		t.Fatalf("expected stale end date cleared on the last A version: %#v", versions[0])
+	}
+	if versions[1].EndDate == nil || *versions[1].EndDate != "2025-12-31" || !versions[1].IsCurrent {
+		t.Fatalf("expected current B version to end before the next one: %#v", versions[1])
+	}
+	if versions[2].IsCurrent {
+		t.Fatalf("future version must not be current: %#v", versions[2])
+	}
+
+	if got := AsOf(versions, "2024-06-01"); len(got) != 1 || got[0].Code != "A" {
+		t.Fatalf("unexpected versions as of 2024-06-01: %#v", got)
+	}
+	if got := AsOf(versions, "2026-02-01"); len(got) != 2 || got[1].EffectiveDate != "2026-01-01" {
+		t.Fatalf("unexpected versions as of 2026-02-01: %#v", got)
+	}
+}
+
+func TestCollectRows(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(1, "A").AddRow(2, "B"))
+
+	rows, err := db.Query("SELECT id, code FROM versions")
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	got, err := CollectRows(rows, func(row interface{ Scan(...any) error }) (*record, error) {
+		var r record
+		return &r, row.Scan(&r.ID, &r.Code)
+	})
+	if err != nil || len(got) != 2 || got[1].Code != "B" {
+		t.Fatalf("unexpected rows %#v, err %v", got, err)
+	}
+}
+
+func TestIsVersionConflict(t *testing.T) {
+	if !IsVersionConflict(errors.Join(errors.New("insert"), &pq.Error{Code: "23505"})) {
+		t.Fatal("expected unique violation to be a version conflict")
+	}
+	if IsVersionConflict(&pq.Error{Code: "23503"}) || IsVersionConflict(errors.New("boom")) {
+		t.Fatal("expected other errors not to be version conflicts")
+	}
+}
@@ -0,0 +1,139 @@
+package unittype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Service 单元类型管理，同时作为校验链的类型来源
+type Service struct {
+	store  Store
+	logger pkglogger.Logger
+	now    func() time.Time
+}
+
+// NewService 创建单元类型服务
+func NewService(store Store, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	return &Service{
+		store: store,
+		now:   time.Now,
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "unitType",
+			"module":    "command",
+		}),
+	}
+}
+
+// ListUnitTypes 返回租户生效的单元类型（内置类型与租户定义合并，含已停用）
+func (s *Service) ListUnitTypes(ctx context.Context, tenantID uuid.UUID) ([]Definition, error) {
+	stored, err := s.store.ListDefinitions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return Effective(stored), nil
+}
+
+// GetUnitType 按编码读取生效的单元类型
+func (s *Service) GetUnitType(ctx context.Context, tenantID uuid.UUID, code string) (*Definition, error) {
+	defs, err := s.ListUnitTypes(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	def := Find(defs, code)
+	if def == nil {
+		return nil, ErrNotFound
+	}
+	return def, nil
+}
+
+// CreateUnitType 创建租户单元类型；内置类型的规则调整应通过 UpdateUnitType 覆盖
+func (s *Service) CreateUnitType(ctx context.Context, d Definition) (*Definition, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	defs, err := s.ListUnitTypes(ctx, d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if Find(defs, d.Code) != nil {
+		return nil, ErrDuplicateCode
+	}
+	if err := checkParentTypes(defs, d); err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	d.ID = uuid.New()
+	d.BuiltIn = false
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	if err := s.store.SaveDefinition(ctx, &d); err != nil {
+		return nil, err
+	}
+	s.logger.WithFields(pkglogger.Fields{"tenantId": d.TenantID, "unitType": d.Code}).Info("organization unit type created")
+	return &d, nil
+}
+
+// UpdateUnitType 更新名称、描述、上级类型组合、根节点许可、最大层级与启用状态；
+// 首次更新内置类型时为租户创建覆盖记录
+func (s *Service) UpdateUnitType(ctx context.Context, d Definition) (*Definition, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	defs, err := s.ListUnitTypes(ctx, d.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	existing := Find(defs, d.Code)
+	if existing == nil {
+		return nil, ErrNotFound
+	}
+	if err := checkParentTypes(defs, d); err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	d.BuiltIn = existing.BuiltIn
+	if existing.ID == uuid.Nil {
+		d.ID = uuid.New()
+		d.CreatedAt = now
+	} else {
+		d.ID = existing.ID
+		d.CreatedBy = existing.CreatedBy
+		d.CreatedAt = existing.CreatedAt
+	}
+	d.UpdatedAt = now
+	if err := s.store.SaveDefinition(ctx, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeleteUnitType 删除租户定义：内置类型的覆盖记录删除后恢复默认规则；自定义类型仍被组织使用时拒绝删除
+func (s *Service) DeleteUnitType(ctx context.Context, tenantID uuid.UUID, code string) error {
+	if !IsBuiltin(code) {
+		count, err := s.store.CountUsage(ctx, tenantID, code)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: %d organization unit versions use %s", ErrInUse, count, code)
+		}
+	}
+	return s.store.DeleteDefinition(ctx, tenantID, code)
+}
+
+func checkParentTypes(defs []Definition, d Definition) error {
+	for _, parent := range d.AllowedParentTypes {
+		if parent != d.Code && Find(defs, parent) == nil {
+			return errors.Join(ErrInvalidDefinition, fmt.Errorf("unknown allowed parent type %q", parent))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package unittype
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的单元类型存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建单元类型存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const definitionColumns = `id, tenant_id, code, name, COALESCE(description, ''), allowed_parent_types, allow_root,
+	max_depth, is_active, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanDefinition(row interface{ Scan(...any) error }) (*Definition, error) {
+	var (
+		d        Definition
+		maxDepth sql.NullInt64
+	)
+	if err := row.Scan(&d.ID, &d.TenantID, &d.Code, &d.Name, &d.Description, pq.Array(&d.AllowedParentTypes), &d.AllowRoot,
+		&maxDepth, &d.Active, &d.CreatedBy, &d.CreatedAt, &d.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if maxDepth.Valid {
+		depth := int(maxDepth.Int64)
+		d.MaxDepth = &depth
+	}
+	if d.AllowedParentTypes == nil {
+		d.AllowedParentTypes = []string{}
+	}
+	d.CreatedAt = d.CreatedAt.UTC()
+	d.UpdatedAt = d.UpdatedAt.UTC()
+	return &d, nil
+}
+
+// ListDefinitions 列出租户自定义（含覆盖内置）的单元类型
+func (s *SQLStore) ListDefinitions(ctx context.Context, tenantID uuid.UUID) ([]Definition, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+definitionColumns+`
+	FROM organization_unit_types
+	WHERE tenant_id = $1
+	ORDER BY code`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list organization unit types: %w", err)
+	}
+	defer rows.Close()
+	var defs []Definition
+	for rows.Next() {
+		d, err := scanDefinition(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan organization unit type: %w", err)
+		}
+		defs = append(defs, *d)
+	}
+	return defs, rows.Err()
+}
+
+// SaveDefinition 创建或更新单元类型
+func (s *SQLStore) SaveDefinition(ctx context.Context, d *Definition) error {
+	allowedParents := d.AllowedParentTypes
+	if allowedParents == nil {
+		allowedParents = []string{}
+	}
+	var maxDepth sql.NullInt64
+	if d.MaxDepth != nil {
+		maxDepth = sql.NullInt64{Int64: int64(*d.MaxDepth), Valid: true}
+	}
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO organization_unit_types
+		(id, tenant_id, code, name, description, allowed_parent_types, allow_root, max_depth, is_active,
+		 created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, NULLIF($10, ''), $11, $12)
+	ON CONFLICT (id) DO UPDATE SET
+		name = EXCLUDED.name,
+		description = EXCLUDED.description,
+		allowed_parent_types = EXCLUDED.allowed_parent_types,
+		allow_root = EXCLUDED.allow_root,
+		max_depth = EXCLUDED.max_depth,
+		is_active = EXCLUDED.is_active,
+		updated_at = EXCLUDED.updated_at
+	WHERE organization_unit_types.tenant_id = EXCLUDED.tenant_id`,
+		d.ID, d.TenantID, d.Code, d.Name, d.Description, pq.Array(allowedParents), d.AllowRoot, maxDepth, d.Active,
+		d.CreatedBy, d.CreatedAt, d.UpdatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDuplicateCode
+		}
+		return fmt.Errorf("save organization unit type: %w", err)
+	}
+	return nil
+}
+
+// DeleteDefinition 删除租户定义
+func (s *SQLStore) DeleteDefinition(ctx context.Context, tenantID uuid.UUID, code string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM organization_unit_types WHERE tenant_id = $1 AND code = $2`, tenantID, code)
+	if err != nil {
+		return fmt.Errorf("delete organization unit type: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// CountUsage 统计使用该类型且未删除的组织单元版本数
+func (s *SQLStore) CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `
+	SELECT COUNT(*) FROM organization_units
+	WHERE tenant_id = $1 AND unit_type = $2 AND status <> 'DELETED'`, tenantID, code).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count organization unit type usage: %w", err)
+	}
+	return count, nil
+}
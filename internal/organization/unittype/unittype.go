@@ -0,0 +1,165 @@
+// Package unittype 实现租户可配置的组织单元类型：类型定义管理，以及上下级类型组合与每类型最大层级规则。
+package unittype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+// MaxDepthLimit 单元类型可配置的最大层级上限，与组织层级上限一致
+const MaxDepthLimit = types.OrganizationLevelMax
+
+var (
+	// ErrInvalidDefinition 类型定义参数不合法
+	ErrInvalidDefinition = errors.New("invalid organization unit type")
+	// ErrNotFound 类型定义不存在
+	ErrNotFound = errors.New("organization unit type not found")
+	// ErrDuplicateCode 同一租户下类型编码重复
+	ErrDuplicateCode = errors.New("organization unit type code already exists")
+	// ErrInUse 类型仍被组织单元使用
+	ErrInUse = errors.New("organization unit type is in use")
+
+	codePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,63}$`)
+)
+
+// Definition 组织单元类型定义
+type Definition struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	TenantID    uuid.UUID `json:"tenantId,omitempty"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	// AllowedParentTypes 允许的上级单元类型，为空表示不限制
+	AllowedParentTypes []string `json:"allowedParentTypes"`
+	// AllowRoot 是否允许作为根组织（无上级）
+	AllowRoot bool `json:"allowRoot"`
+	// MaxDepth 该类型单元允许的最大层级（根为 1），为空表示仅受全局层级上限约束
+	MaxDepth *int `json:"maxDepth,omitempty"`
+	Active   bool `json:"active"`
+	// BuiltIn 内置类型（未被租户覆盖时不落库）
+	BuiltIn   bool      `json:"builtIn"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Builtins 返回内置单元类型，保持与原有枚举值兼容且不附加任何组合限制
+func Builtins() []Definition {
+	builtins := []struct {
+		code types.UnitType
+		name string
+	}{
+		{types.UnitTypeDepartment, "部门"},
+		{types.UnitTypeOrganizationUnit, "组织单元"},
+		{types.UnitTypeCompany, "公司"},
+		{types.UnitTypeProjectTeam, "项目团队"},
+	}
+	defs := make([]Definition, 0, len(builtins))
+	for _, b := range builtins {
+		defs = append(defs, Definition{
+			Code:               string(b.code),
+			Name:               b.name,
+			AllowedParentTypes: []string{},
+			AllowRoot:          true,
+			Active:             true,
+			BuiltIn:            true,
+		})
+	}
+	return defs
+}
+
+// IsBuiltin 判断编码是否为内置类型
+func IsBuiltin(code string) bool {
+	for _, b := range Builtins() {
+		if b.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Effective 合并内置类型与租户定义：同编码的租户定义覆盖内置规则，结果按编码排序
+func Effective(stored []Definition) []Definition {
+	merged := make([]Definition, 0, len(stored)+4)
+	for _, b := range Builtins() {
+		if idx := slices.IndexFunc(stored, func(d Definition) bool { return d.Code == b.Code }); idx >= 0 {
+			override := stored[idx]
+			override.BuiltIn = true
+			merged = append(merged, override)
+			continue
+		}
+		merged = append(merged, b)
+	}
+	for _, d := range stored {
+		if !IsBuiltin(d.Code) {
+			merged = append(merged, d)
+		}
+	}
+	slices.SortFunc(merged, func(a, b Definition) int { return strings.Compare(a.Code, b.Code) })
+	return merged
+}
+
+// Find 按编码查找类型定义
+func Find(defs []Definition, code string) *Definition {
+	for i := range defs {
+		if defs[i].Code == code {
+			return &defs[i]
+		}
+	}
+	return nil
+}
+
+// ValidCode 判断编码是否符合单元类型编码格式
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Validate 校验类型定义
+func (d Definition) Validate() error {
+	if !ValidCode(d.Code) {
+		return errors.Join(ErrInvalidDefinition, errors.New("code must be 2-64 uppercase letters, digits or underscores starting with a letter"))
+	}
+	if strings.TrimSpace(d.Name) == "" {
+		return errors.Join(ErrInvalidDefinition, errors.New("name is required"))
+	}
+	if d.MaxDepth != nil && (*d.MaxDepth < 1 || *d.MaxDepth > MaxDepthLimit) {
+		return errors.Join(ErrInvalidDefinition, fmt.Errorf("maxDepth must be between 1 and %d", MaxDepthLimit))
+	}
+	for i, parent := range d.AllowedParentTypes {
+		if !ValidCode(parent) || slices.Contains(d.AllowedParentTypes[:i], parent) {
+			return errors.Join(ErrInvalidDefinition, fmt.Errorf("invalid or duplicate allowed parent type %q", parent))
+		}
+	}
+	if !d.AllowRoot && d.MaxDepth != nil && *d.MaxDepth == 1 {
+		return errors.Join(ErrInvalidDefinition, errors.New("a type limited to depth 1 must be allowed as root"))
+	}
+	return nil
+}
+
+// AllowsParent 判断该类型能否挂在指定类型的上级之下
+func (d Definition) AllowsParent(parentType string) bool {
+	return len(d.AllowedParentTypes) == 0 || slices.Contains(d.AllowedParentTypes, parentType)
+}
+
+// Source 按租户提供生效的单元类型（含内置类型）
+type Source interface {
+	ListUnitTypes(ctx context.Context, tenantID uuid.UUID) ([]Definition, error)
+}
+
+// Store 单元类型存储
+type Store interface {
+	ListDefinitions(ctx context.Context, tenantID uuid.UUID) ([]Definition, error)
+	// SaveDefinition 创建或更新定义；编码冲突时返回 ErrDuplicateCode
+	SaveDefinition(ctx context.Context, def *Definition) error
+	DeleteDefinition(ctx context.Context, tenantID uuid.UUID, code string) error
+	// CountUsage 统计使用该类型且未删除的组织单元版本数
+	CountUsage(ctx context.Context, tenantID uuid.UUID, code string) (int, error)
+}
@@ -0,0 +1,122 @@
+package unittype
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type memoryStore struct {
+	defs  map[string]Definition
+	usage map[string]int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{defs: map[string]Definition{}, usage: map[string]int{}}
+}
+
+func (s *memoryStore) ListDefinitions(context.Context, uuid.UUID) ([]Definition, error) {
+	out := make([]Definition, 0, len(s.defs))
+	for _, d := range s.defs {
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) SaveDefinition(_ context.Context, d *Definition) error {
+	s.defs[d.Code] = *d
+	return nil
+}
+
+func (s *memoryStore) DeleteDefinition(_ context.Context, _ uuid.UUID, code string) error {
+	if _, ok := s.defs[code]; !ok {
+		return ErrNotFound
+	}
+	delete(s.defs, code)
+	return nil
+}
+
+func (s *memoryStore) CountUsage(_ context.Context, _ uuid.UUID, code string) (int, error) {
+	return s.usage[code], nil
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestEffectiveMergesBuiltinsAndOverrides(t *testing.T) {
+	defs := Effective([]Definition{
+		{Code: "DEPARTMENT", Name: "Dept", AllowedParentTypes: []string{"COMPANY"}, Active: true},
+		{Code: "BRANCH", Name: "Branch", Active: true},
+	})
+	if len(defs) != 5 || defs[0].Code != "BRANCH" {
+		t.Fatalf("unexpected effective types: %#v", defs)
+	}
+	dept := Find(defs, "DEPARTMENT")
+	if dept == nil || !dept.BuiltIn || dept.AllowsParent("PROJECT_TEAM") || !dept.AllowsParent("COMPANY") {
+		t.Fatalf("expected builtin override to apply: %#v", dept)
+	}
+	if company := Find(defs, "COMPANY"); company == nil || !company.AllowRoot || !company.AllowsParent("BRANCH") {
+		t.Fatalf("expected untouched builtin to stay unrestricted: %#v", company)
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	cases := []struct {
+		name string
+		def  Definition
+		ok   bool
+	}{
+		{name: "valid", def: Definition{Code: "COST_CENTER_GROUP", Name: "Cost Center Group", AllowedParentTypes: []string{"COMPANY"}, MaxDepth: intPtr(4)}, ok: true},
+		{name: "lowercase code", def: Definition{Code: "branch", Name: "Branch"}},
+		{name: "missing name", def: Definition{Code: "BRANCH"}},
+		{name: "depth out of range", def: Definition{Code: "BRANCH", Name: "Branch", MaxDepth: intPtr(MaxDepthLimit + 1)}},
+		{name: "duplicate parent", def: Definition{Code: "BRANCH", Name: "Branch", AllowedParentTypes: []string{"REGION", "REGION"}}},
+		{name: "root only but not root", def: Definition{Code: "BRANCH", Name: "Branch", MaxDepth: intPtr(1)}},
+	}
+	for _, tc := range cases {
+		err := tc.def.Validate()
+		if tc.ok != (err == nil) {
+			t.Fatalf("%s: unexpected result %v", tc.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidDefinition) {
+			t.Fatalf("%s: expected ErrInvalidDefinition, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestServiceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	tenant := uuid.New()
+	store := newMemoryStore()
+	svc := NewService(store, nil)
+
+	if _, err := svc.CreateUnitType(ctx, Definition{TenantID: tenant, Code: "DEPARTMENT", Name: "Dept"}); !errors.Is(err, ErrDuplicateCode) {
+		t.Fatalf("expected builtin code to be reserved, got %v", err)
+	}
+	if _, err := svc.CreateUnitType(ctx, Definition{TenantID: tenant, Code: "BRANCH", Name: "Branch", AllowedParentTypes: []string{"REGION"}}); !errors.Is(err, ErrInvalidDefinition) {
+		t.Fatalf("expected unknown parent type to be rejected, got %v", err)
+	}
+
+	region, err := svc.CreateUnitType(ctx, Definition{TenantID: tenant, Code: "REGION", Name: "Region", AllowedParentTypes: []string{"COMPANY"}, Active: true})
+	if err != nil || region.ID == uuid.Nil || region.BuiltIn {
+		t.Fatalf("create region failed: %v %#v", err, region)
+	}
+
+	dept, err := svc.UpdateUnitType(ctx, Definition{TenantID: tenant, Code: "DEPARTMENT", Name: "Dept", AllowedParentTypes: []string{"REGION"}, Active: true})
+	if err != nil || !dept.BuiltIn || dept.ID == uuid.Nil {
+		t.Fatalf("expected builtin override row: %v %#v", err, dept)
+	}
+
+	store.usage["REGION"] = 2
+	if err := svc.DeleteUnitType(ctx, tenant, "REGION"); !errors.Is(err, ErrInUse) {
+		t.Fatalf("expected in-use type deletion to fail, got %v", err)
+	}
+	if err := svc.DeleteUnitType(ctx, tenant, "DEPARTMENT"); err != nil {
+		t.Fatalf("reset builtin failed: %v", err)
+	}
+	restored, err := svc.GetUnitType(ctx, tenant, "DEPARTMENT")
+	if err != nil || len(restored.AllowedParentTypes) != 0 || restored.ID != uuid.Nil {
+		t.Fatalf("expected builtin defaults restored: %v %#v", err, restored)
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -32,8 +33,15 @@ var (
 	auditWritesTotal        *prometheus.CounterVec
 	httpRequestsTotal       *prometheus.CounterVec
 	outboxDispatchTotal     *prometheus.CounterVec
+
+	httpRequestDuration      *prometheus.HistogramVec
+	graphqlOperationDuration *prometheus.HistogramVec
 )
 
+// LatencyBuckets 请求耗时直方图桶（秒），覆盖 schema 文档公布的 50ms/150ms/200ms 等延迟目标；
+// SLO 延迟阈值按这些桶边界计算。
+var LatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.15, 0.2, 0.3, 0.5, 1, 2.5, 5, 10}
+
 func ensureRegistered() {
 	registerOnce.Do(func() {
 		temporalOperationsTotal = prometheus.NewCounterVec(
@@ -68,7 +76,26 @@ func ensureRegistered() {
 			[]string{"result", "event_type"},
 		)
 
-		prometheus.MustRegister(temporalOperationsTotal, auditWritesTotal, httpRequestsTotal, outboxDispatchTotal)
+		httpRequestDuration = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "http_request_duration_seconds",
+				Help:    "HTTP request latency grouped by method, route template, and status code.",
+				Buckets: LatencyBuckets,
+			},
+			[]string{"method", "route", "status"},
+		)
+
+		graphqlOperationDuration = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "graphql_operation_duration_seconds",
+				Help:    "GraphQL operation latency grouped by operation and outcome.",
+				Buckets: LatencyBuckets,
+			},
+			[]string{"operation", "status"},
+		)
+
+		prometheus.MustRegister(temporalOperationsTotal, auditWritesTotal, httpRequestsTotal, outboxDispatchTotal,
+			httpRequestDuration, graphqlOperationDuration)
 	})
 }
 
@@ -104,6 +131,24 @@ func RecordHTTPRequest(method, route string, statusCode int) {
 	httpRequestsTotal.WithLabelValues(method, route, code).Inc()
 }
 
+// ObserveHTTPRequestDuration 记录 HTTP 请求耗时，供 SLO 计算延迟达标率与可用性。
+func ObserveHTTPRequestDuration(method, route string, statusCode int, duration time.Duration) {
+	ensureRegistered()
+
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+}
+
+// ObserveGraphQLOperation 记录 GraphQL 操作耗时；响应包含错误时 status 为 error。
+func ObserveGraphQLOperation(operation string, failed bool, duration time.Duration) {
+	ensureRegistered()
+
+	status := StatusSuccess
+	if failed {
+		status = StatusError
+	}
+	graphqlOperationDuration.WithLabelValues(operation, status).Observe(duration.Seconds())
+}
+
 // RecordOutboxDispatch 记录 outbox 中继的派发结果。
 func RecordOutboxDispatch(result, eventType string) {
 	ensureRegistered()
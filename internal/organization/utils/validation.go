@@ -6,18 +6,13 @@ import (
 	"strings"
 	"time"
 
+	"cube-castle/internal/organization/unittype"
 	"cube-castle/internal/types"
 )
 
 var (
 	organizationCodeRegex       = regexp.MustCompile(types.OrganizationCodePattern)
 	organizationParentCodeRegex = regexp.MustCompile(types.OrganizationParentCodePattern)
-	validUnitTypes              = map[types.UnitType]struct{}{
-		types.UnitTypeDepartment:       {},
-		types.UnitTypeOrganizationUnit: {},
-		types.UnitTypeCompany:          {},
-		types.UnitTypeProjectTeam:      {},
-	}
 )
 
 // ValidateOrganizationCode 验证组织代码格式
@@ -91,7 +86,8 @@ func ValidateCreateVersionRequest(req *types.CreateVersionRequest) error {
 		return fmt.Errorf("组织类型不能为空")
 	}
 
-	if _, ok := validUnitTypes[types.UnitType(req.UnitType)]; !ok {
+	// 类型是否存在及上下级组合由租户单元类型配置（ORG-UNIT-TYPE 规则）校验，此处仅校验编码格式
+	if !unittype.ValidCode(req.UnitType) {
 		return fmt.Errorf("无效的组织类型: %s", req.UnitType)
 	}
 
@@ -111,6 +107,15 @@ func ValidateCreateVersionRequest(req *types.CreateVersionRequest) error {
 		return fmt.Errorf("描述长度不能超过1000个字符")
 	}
 
+	// 多语言名称：规范化语言标签，空值表示移除前一版本中的该语言
+	if len(req.NameI18n) > 0 {
+		names, err := req.NameI18n.Normalize()
+		if err != nil {
+			return err
+		}
+		req.NameI18n = names
+	}
+
 	// 5. 排序顺序验证（如果提供）
 	if req.SortOrder != nil {
 		if *req.SortOrder < 0 {
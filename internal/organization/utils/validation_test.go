@@ -60,7 +60,7 @@ func TestValidateCreateVersionRequest_Errors(t *testing.T) {
 	{
 		req := &types.CreateVersionRequest{
 			Name:          "部门",
-			UnitType:      "INVALID",
+			UnitType:      "invalid-type",
 			EffectiveDate: "2025-11-15",
 		}
 		if err := ValidateCreateVersionRequest(req); err == nil {
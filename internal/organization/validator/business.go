@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"cube-castle/internal/organization/customfield"
 	"cube-castle/internal/organization/repository"
+	"cube-castle/internal/organization/unittype"
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
@@ -17,6 +19,8 @@ import (
 type BusinessRuleValidator struct {
 	hierarchyRepo hierarchyRepository
 	orgRepo       organizationRepository
+	customFields  customfield.DefinitionSource
+	unitTypes     unittype.Source
 	logger        pkglogger.Logger
 }
 
@@ -312,6 +316,25 @@ func (v *BusinessRuleValidator) validateTemporalData(effectiveDate, endDate *typ
 	return nil
 }
 
+// normalizeLocalizedNames 规范化多语言名称的语言标签并校验名称长度，无效时记录错误并返回 nil。
+func normalizeLocalizedNames(field string, names types.LocalizedNames, result *ValidationResult) types.LocalizedNames {
+	if len(names) == 0 {
+		return names
+	}
+	normalized, err := names.Normalize()
+	if err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Code:     "ORG_NAME_I18N_INVALID",
+			Message:  err.Error(),
+			Field:    field,
+			Value:    names,
+			Severity: string(SeverityHigh),
+		})
+		return nil
+	}
+	return normalized
+}
+
 func (v *BusinessRuleValidator) validateCreateRequestBasics(req *types.CreateOrganizationRequest, result *ValidationResult) {
 	req.Name = strings.TrimSpace(req.Name)
 	if req.Name == "" {
@@ -340,6 +363,9 @@ func (v *BusinessRuleValidator) validateCreateRequestBasics(req *types.CreateOrg
 		}
 	}
 
+	// 新建时忽略空值语言
+	req.NameI18n = normalizeLocalizedNames("nameI18n", req.NameI18n, result).Merge(nil)
+
 	unitType := strings.TrimSpace(req.UnitType)
 	if unitType == "" {
 		result.Errors = append(result.Errors, ValidationError{
@@ -350,7 +376,7 @@ func (v *BusinessRuleValidator) validateCreateRequestBasics(req *types.CreateOrg
 		})
 	} else {
 		unitTypeUpper := strings.ToUpper(unitType)
-		if _, ok := validUnitTypes[unitTypeUpper]; !ok {
+		if !v.isKnownUnitType(unitTypeUpper) {
 			result.Errors = append(result.Errors, ValidationError{
 				Code:     "ORG_UNIT_TYPE_INVALID",
 				Message:  fmt.Sprintf("无效的组织类型: %s", unitType),
@@ -450,6 +476,8 @@ func (v *BusinessRuleValidator) validateUpdateRequestBasics(req *types.UpdateOrg
 		}
 	}
 
+	req.NameI18n = normalizeLocalizedNames("nameI18n", req.NameI18n, result)
+
 	if req.UnitType != nil {
 		trimmed := strings.TrimSpace(*req.UnitType)
 		if trimmed == "" {
@@ -461,7 +489,7 @@ func (v *BusinessRuleValidator) validateUpdateRequestBasics(req *types.UpdateOrg
 			})
 		} else {
 			unitTypeUpper := strings.ToUpper(trimmed)
-			if _, ok := validUnitTypes[unitTypeUpper]; !ok {
+			if !v.isKnownUnitType(unitTypeUpper) {
 				result.Errors = append(result.Errors, ValidationError{
 					Code:     "ORG_UNIT_TYPE_INVALID",
 					Message:  fmt.Sprintf("无效的组织类型: %s", trimmed),
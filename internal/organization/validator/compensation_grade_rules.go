@@ -0,0 +1,127 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/compensation"
+	"github.com/google/uuid"
+)
+
+// CompensationGradeAware 可注入职等来源的职位验证器。
+type CompensationGradeAware interface {
+	SetCompensationGrades(source compensation.Source)
+}
+
+// SetCompensationGrades 注入职等来源；未注入时 gradeLevel 保持自由文本不做校验。
+func (s *positionAssignmentValidationService) SetCompensationGrades(source compensation.Source) {
+	s.grades = source
+}
+
+// gradeCheck 描述一次职位 gradeLevel 校验的输入。
+type gradeCheck struct {
+	TenantID      uuid.UUID
+	JobLevelCode  string
+	GradeLevel    string
+	EffectiveDate string
+}
+
+func extractGradeCheck(subject interface{}) *gradeCheck {
+	var (
+		tenantID                        uuid.UUID
+		levelCode, grade, effectiveDate string
+	)
+	switch sub := subject.(type) {
+	case *positionCreateSubject:
+		if sub.Request == nil || sub.Request.GradeLevel == nil {
+			return nil
+		}
+		tenantID, levelCode, grade, effectiveDate = sub.TenantID, sub.Request.JobLevelCode, *sub.Request.GradeLevel, sub.Request.EffectiveDate
+	case *positionUpdateSubject:
+		if sub.Request == nil || sub.Request.GradeLevel == nil {
+			return nil
+		}
+		tenantID, levelCode, grade, effectiveDate = sub.TenantID, sub.Request.JobLevelCode, *sub.Request.GradeLevel, sub.Request.EffectiveDate
+	case *positionVersionSubject:
+		if sub.Request == nil || sub.Request.GradeLevel == nil {
+			return nil
+		}
+		tenantID, levelCode, grade, effectiveDate = sub.TenantID, sub.Request.JobLevelCode, *sub.Request.GradeLevel, sub.Request.EffectiveDate
+	default:
+		return nil
+	}
+	grade = strings.ToUpper(strings.TrimSpace(grade))
+	if tenantID == uuid.Nil || grade == "" {
+		return nil
+	}
+	return &gradeCheck{
+		TenantID:      tenantID,
+		JobLevelCode:  strings.ToUpper(strings.TrimSpace(levelCode)),
+		GradeLevel:    grade,
+		EffectiveDate: strings.TrimSpace(effectiveDate),
+	}
+}
+
+// newPosGradeRule 校验职位 gradeLevel 属于其职级允许的职等；租户尚未配置职等结构时保持自由文本兼容。
+func (s *positionAssignmentValidationService) newPosGradeRule() RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		if s.grades == nil {
+			return nil, nil
+		}
+		check := extractGradeCheck(subject)
+		if check == nil {
+			return nil, nil
+		}
+
+		grades, err := s.grades.ListGrades(ctx, check.TenantID, compensation.ListFilter{
+			AsOfDate:        check.EffectiveDate,
+			IncludeInactive: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("pos-grade: load compensation grades failed: %w", err)
+		}
+		if len(grades) == 0 {
+			return nil, nil
+		}
+
+		allowed := make([]string, 0)
+		var matched *compensation.Grade
+		for i := range grades {
+			if grades[i].Status == compensation.StatusActive && grades[i].AllowsJobLevel(check.JobLevelCode) {
+				allowed = append(allowed, grades[i].Code)
+			}
+			if grades[i].Code == check.GradeLevel {
+				matched = &grades[i]
+			}
+		}
+
+		var code, message string
+		switch {
+		case matched == nil:
+			code, message = "COMPENSATION_GRADE_NOT_FOUND", fmt.Sprintf("grade %s is not defined as of %s", check.GradeLevel, check.EffectiveDate)
+		case matched.Status != compensation.StatusActive:
+			code, message = "COMPENSATION_GRADE_INACTIVE", fmt.Sprintf("grade %s is inactive as of %s", check.GradeLevel, check.EffectiveDate)
+		case !matched.AllowsJobLevel(check.JobLevelCode):
+			code, message = "COMPENSATION_GRADE_NOT_ALLOWED", fmt.Sprintf("grade %s is not allowed for job level %s", check.GradeLevel, check.JobLevelCode)
+		default:
+			return &RuleOutcome{Context: map[string]interface{}{"gradeLevel": check.GradeLevel}}, nil
+		}
+
+		return &RuleOutcome{
+			Errors: []ValidationError{{
+				Code:     code,
+				Message:  message,
+				Field:    "gradeLevel",
+				Value:    check.GradeLevel,
+				Severity: string(SeverityHigh),
+				Context: map[string]interface{}{
+					"ruleId":        "POS-GRADE",
+					"jobLevelCode":  check.JobLevelCode,
+					"effectiveDate": check.EffectiveDate,
+					"allowedGrades": allowed,
+				},
+			}},
+		}, nil
+	}
+}
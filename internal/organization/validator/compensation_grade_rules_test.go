@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"cube-castle/internal/organization/compensation"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+type stubGradeSource struct {
+	grades []compensation.Grade
+}
+
+func (s stubGradeSource) ListGrades(_ context.Context, _ uuid.UUID, filter compensation.ListFilter) ([]compensation.Grade, error) {
+	out := make([]compensation.Grade, 0)
+	for _, g := range s.grades {
+		if g.EffectiveDate <= filter.AsOfDate {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+func gradeValidator(source compensation.Source) PositionValidationService {
+	posValidator, _ := buildDefaultValidationService()
+	if source != nil {
+		posValidator.(CompensationGradeAware).SetCompensationGrades(source)
+	}
+	return posValidator
+}
+
+func gradedPositionRequest(grade string) *types.PositionRequest {
+	return &types.PositionRequest{
+		Title:              "HR Manager",
+		JobFamilyGroupCode: "OPER",
+		JobFamilyCode:      "OPER-HR",
+		JobRoleCode:        "OPER-HR-SUP",
+		JobLevelCode:       "P1",
+		OrganizationCode:   "1000001",
+		PositionType:       "REGULAR",
+		EmploymentType:     "FULL_TIME",
+		GradeLevel:         pointerString(grade),
+		HeadcountCapacity:  1,
+		EffectiveDate:      "2025-11-06",
+		OperationReason:    "New headcount",
+	}
+}
+
+func TestValidateCreatePosition_PosGrade(t *testing.T) {
+	source := stubGradeSource{grades: []compensation.Grade{
+		{Code: "G5", Status: compensation.StatusActive, JobLevelCodes: []string{"P1"}, EffectiveDate: "2025-01-01"},
+		{Code: "G6", Status: compensation.StatusActive, JobLevelCodes: []string{"P2"}, EffectiveDate: "2025-01-01"},
+		{Code: "G7", Status: compensation.StatusInactive, JobLevelCodes: []string{"P1"}, EffectiveDate: "2025-01-01"},
+	}}
+
+	cases := []struct {
+		grade string
+		code  string
+	}{
+		{grade: "g5"},
+		{grade: "G6", code: "COMPENSATION_GRADE_NOT_ALLOWED"},
+		{grade: "G7", code: "COMPENSATION_GRADE_INACTIVE"},
+		{grade: "G9", code: "COMPENSATION_GRADE_NOT_FOUND"},
+	}
+	for _, tc := range cases {
+		result := gradeValidator(source).ValidateCreatePosition(context.Background(), uuid.New(), gradedPositionRequest(tc.grade))
+		if tc.code == "" {
+			if !result.Valid {
+				t.Fatalf("%s: expected validation to pass, got %#v", tc.grade, result.Errors)
+			}
+			continue
+		}
+		if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != tc.code || result.Errors[0].Field != "gradeLevel" {
+			t.Fatalf("%s: expected %s, got %#v", tc.grade, tc.code, result.Errors)
+		}
+		if allowed, _ := result.Errors[0].Context["allowedGrades"].([]string); len(allowed) != 1 || allowed[0] != "G5" {
+			t.Fatalf("%s: expected allowed grades [G5], got %#v", tc.grade, result.Errors[0].Context)
+		}
+	}
+}
+
+func TestValidateCreatePosition_PosGradeFreeTextWithoutGrades(t *testing.T) {
+	req := gradedPositionRequest("Senior")
+	if result := gradeValidator(nil).ValidateCreatePosition(context.Background(), uuid.New(), req); !result.Valid {
+		t.Fatalf("expected free-text grade without source to pass, got %#v", result.Errors)
+	}
+	future := stubGradeSource{grades: []compensation.Grade{
+		{Code: "G5", Status: compensation.StatusActive, JobLevelCodes: []string{"P1"}, EffectiveDate: "2026-01-01"},
+	}}
+	if result := gradeValidator(future).ValidateCreatePosition(context.Background(), uuid.New(), req); !result.Valid {
+		t.Fatalf("expected free-text grade before grades take effect to pass, got %#v", result.Errors)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // RuleSeverity 标准化严重级别枚举，保持与审计/错误码对齐。
@@ -139,6 +141,9 @@ func (c *ValidationChain) Register(rule *Rule) error {
 // Execute 依次执行规则并聚合结果，支持短路控制。
 func (c *ValidationChain) Execute(ctx context.Context, subject interface{}) *ValidationResult {
 	overallStart := time.Now()
+	ctx, chainSpan := tracing.Start(ctx, "validator", "validation.chain",
+		attribute.String("validation.operation", c.operation))
+	defer chainSpan.End()
 	result := NewValidationResult()
 	for k, v := range c.baseContext {
 		result.Context[k] = v
@@ -158,7 +163,7 @@ func (c *ValidationChain) Execute(ctx context.Context, subject interface{}) *Val
 		}
 
 		start := time.Now()
-		outcome, err := rule.Handler(ctx, subject)
+		outcome, err := c.runRule(ctx, rule, subject)
 		duration := time.Since(start)
 
 		c.logger.WithFields(pkglogger.Fields{
@@ -237,10 +242,32 @@ func (c *ValidationChain) Execute(ctx context.Context, subject interface{}) *Val
 		outcomeLabel = chainOutcomeLabelFailed
 	}
 	observeChainMetrics(operation, outcomeLabel, time.Since(overallStart))
+	chainSpan.SetAttributes(
+		attribute.String("validation.outcome", outcomeLabel),
+		attribute.Int("validation.errors", len(result.Errors)),
+		attribute.Int("validation.warnings", len(result.Warnings)),
+	)
 
 	return result
 }
 
+// runRule 在独立 span 中执行单条规则，便于拆分各规则耗时。
+func (c *ValidationChain) runRule(ctx context.Context, rule *Rule, subject interface{}) (*RuleOutcome, error) {
+	ctx, span := tracing.Start(ctx, "validator", "validation.rule "+rule.ID,
+		attribute.String("validation.rule_id", rule.ID),
+		attribute.String("validation.severity", string(rule.Severity)),
+	)
+	outcome, err := rule.Handler(ctx, subject)
+	if outcome != nil {
+		span.SetAttributes(
+			attribute.Int("validation.errors", len(outcome.Errors)),
+			attribute.Int("validation.warnings", len(outcome.Warnings)),
+		)
+	}
+	tracing.End(span, err)
+	return outcome, err
+}
+
 // SeverityToHTTPStatus 将严重级别映射到 HTTP 状态码，用于统一错误转换。
 func SeverityToHTTPStatus(severity string) int {
 	switch strings.ToUpper(strings.TrimSpace(severity)) {
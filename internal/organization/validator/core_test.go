@@ -8,6 +8,10 @@ import (
 	"testing"
 
 	pkglogger "cube-castle/pkg/logger"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestValidatorCoreSmoke(t *testing.T) {
@@ -220,3 +224,43 @@ func TestValidationChainRegisterNilRule(t *testing.T) {
 		t.Fatalf("expected error when registering nil rule")
 	}
 }
+
+func TestValidationChainRecordsRuleSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	chain := NewValidationChain(pkglogger.NewLogger(pkglogger.WithWriter(io.Discard)), WithOperationLabel("CreatePosition"))
+	for i, id := range []string{"POS-ORG", "POS-HEADCOUNT"} {
+		if err := chain.Register(&Rule{
+			ID:       id,
+			Priority: 10 * (i + 1),
+			Severity: SeverityHigh,
+			Handler: func(ctx context.Context, _ interface{}) (*RuleOutcome, error) {
+				if !trace.SpanContextFromContext(ctx).IsValid() {
+					t.Fatalf("rule handler should receive a traced context")
+				}
+				return nil, nil
+			},
+		}); err != nil {
+			t.Fatalf("register rule %s failed: %v", id, err)
+		}
+	}
+
+	chain.Execute(context.Background(), nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 2 rule spans and 1 chain span, got %d", len(spans))
+	}
+	chainSpan := spans[2]
+	if chainSpan.Name() != "validation.chain" {
+		t.Fatalf("expected chain span last, got %s", chainSpan.Name())
+	}
+	for i, name := range []string{"validation.rule POS-ORG", "validation.rule POS-HEADCOUNT"} {
+		if spans[i].Name() != name || spans[i].Parent().SpanID() != chainSpan.SpanContext().SpanID() {
+			t.Fatalf("unexpected rule span %d: %s", i, spans[i].Name())
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/costcenter"
+	"github.com/google/uuid"
+)
+
+// CostCenterAware 可注入成本中心来源的职位验证器。
+type CostCenterAware interface {
+	SetCostCenters(source costcenter.Source)
+}
+
+// SetCostCenters 注入成本中心来源；未注入时 costCenterCode 保持自由文本不做校验。
+func (s *positionAssignmentValidationService) SetCostCenters(source costcenter.Source) {
+	s.costCenters = source
+}
+
+// costCenterCheck 描述一次职位 costCenterCode 校验的输入。
+type costCenterCheck struct {
+	TenantID       uuid.UUID
+	CostCenterCode string
+	EffectiveDate  string
+}
+
+func extractCostCenterCheck(subject interface{}) *costCenterCheck {
+	var (
+		tenantID            uuid.UUID
+		code, effectiveDate string
+	)
+	switch sub := subject.(type) {
+	case *positionCreateSubject:
+		if sub.Request == nil || sub.Request.CostCenterCode == nil {
+			return nil
+		}
+		tenantID, code, effectiveDate = sub.TenantID, *sub.Request.CostCenterCode, sub.Request.EffectiveDate
+	case *positionUpdateSubject:
+		if sub.Request == nil || sub.Request.CostCenterCode == nil {
+			return nil
+		}
+		tenantID, code, effectiveDate = sub.TenantID, *sub.Request.CostCenterCode, sub.Request.EffectiveDate
+	case *positionVersionSubject:
+		if sub.Request == nil || sub.Request.CostCenterCode == nil {
+			return nil
+		}
+		tenantID, code, effectiveDate = sub.TenantID, *sub.Request.CostCenterCode, sub.Request.EffectiveDate
+	default:
+		return nil
+	}
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if tenantID == uuid.Nil || code == "" {
+		return nil
+	}
+	return &costCenterCheck{
+		TenantID:       tenantID,
+		CostCenterCode: code,
+		EffectiveDate:  strings.TrimSpace(effectiveDate),
+	}
+}
+
+// newPosCostCenterRule 校验职位 costCenterCode 引用在生效日期存在且启用的成本中心；租户尚未建立成本中心主数据时保持自由文本兼容。
+func (s *positionAssignmentValidationService) newPosCostCenterRule() RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		if s.costCenters == nil {
+			return nil, nil
+		}
+		check := extractCostCenterCheck(subject)
+		if check == nil {
+			return nil, nil
+		}
+
+		centers, err := s.costCenters.ListCostCenters(ctx, check.TenantID, costcenter.ListFilter{
+			AsOfDate:        check.EffectiveDate,
+			IncludeInactive: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("pos-cost-center: load cost centers failed: %w", err)
+		}
+		if len(centers) == 0 {
+			return nil, nil
+		}
+
+		var matched *costcenter.CostCenter
+		for i := range centers {
+			if centers[i].Code == check.CostCenterCode {
+				matched = &centers[i]
+				break
+			}
+		}
+
+		var code, message string
+		switch {
+		case matched == nil:
+			code, message = "COST_CENTER_NOT_FOUND", fmt.Sprintf("cost center %s is not defined as of %s", check.CostCenterCode, check.EffectiveDate)
+		case matched.Status != costcenter.StatusActive:
+			code, message = "COST_CENTER_INACTIVE", fmt.Sprintf("cost center %s is inactive as of %s", check.CostCenterCode, check.EffectiveDate)
+		default:
+			return &RuleOutcome{Context: map[string]interface{}{
+				"costCenterCode":  check.CostCenterCode,
+				"legalEntityCode": matched.LegalEntityCode,
+			}}, nil
+		}
+
+		return &RuleOutcome{
+			Errors: []ValidationError{{
+				Code:     code,
+				Message:  message,
+				Field:    "costCenterCode",
+				Value:    check.CostCenterCode,
+				Severity: string(SeverityHigh),
+				Context: map[string]interface{}{
+					"ruleId":        "POS-COST-CENTER",
+					"effectiveDate": check.EffectiveDate,
+				},
+			}},
+		}, nil
+	}
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"cube-castle/internal/organization/costcenter"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+type stubCostCenterSource struct {
+	centers []costcenter.CostCenter
+}
+
+func (s stubCostCenterSource) ListCostCenters(_ context.Context, _ uuid.UUID, filter costcenter.ListFilter) ([]costcenter.CostCenter, error) {
+	out := make([]costcenter.CostCenter, 0)
+	for _, c := range s.centers {
+		if c.EffectiveDate <= filter.AsOfDate {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func costCenterValidator(source costcenter.Source) PositionValidationService {
+	posValidator, _ := buildDefaultValidationService()
+	if source != nil {
+		posValidator.(CostCenterAware).SetCostCenters(source)
+	}
+	return posValidator
+}
+
+func costCenterPositionRequest(costCenterCode string) *types.PositionRequest {
+	return &types.PositionRequest{
+		Title:              "HR Manager",
+		JobFamilyGroupCode: "OPER",
+		JobFamilyCode:      "OPER-HR",
+		JobRoleCode:        "OPER-HR-SUP",
+		JobLevelCode:       "P1",
+		OrganizationCode:   "1000001",
+		PositionType:       "REGULAR",
+		EmploymentType:     "FULL_TIME",
+		CostCenterCode:     pointerString(costCenterCode),
+		HeadcountCapacity:  1,
+		EffectiveDate:      "2025-11-06",
+		OperationReason:    "New headcount",
+	}
+}
+
+func TestValidateCreatePosition_PosCostCenter(t *testing.T) {
+	source := stubCostCenterSource{centers: []costcenter.CostCenter{
+		{Code: "CC-A", Status: costcenter.StatusActive, LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"},
+		{Code: "CC-B", Status: costcenter.StatusInactive, LegalEntityCode: "1000000", EffectiveDate: "2025-01-01"},
+	}}
+
+	cases := []struct {
+		costCenter string
+		code       string
+	}{
+		{costCenter: "cc-a"},
+		{costCenter: "CC-B", code: "COST_CENTER_INACTIVE"},
+		{costCenter: "CC-Z", code: "COST_CENTER_NOT_FOUND"},
+	}
+	for _, tc := range cases {
+		result := costCenterValidator(source).ValidateCreatePosition(context.Background(), uuid.New(), costCenterPositionRequest(tc.costCenter))
+		if tc.code == "" {
+			if !result.Valid {
+				t.Fatalf("%s: expected validation to pass, got %#v", tc.costCenter, result.Errors)
+			}
+			continue
+		}
+		if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != tc.code || result.Errors[0].Field != "costCenterCode" {
+			t.Fatalf("%s: expected %s, got %#v", tc.costCenter, tc.code, result.Errors)
+		}
+	}
+}
+
+func TestValidateCreatePosition_PosCostCenterFreeTextWithoutMasterData(t *testing.T) {
+	req := costCenterPositionRequest("LEGACY-01")
+	if result := costCenterValidator(nil).ValidateCreatePosition(context.Background(), uuid.New(), req); !result.Valid {
+		t.Fatalf("expected free-text cost center without source to pass, got %#v", result.Errors)
+	}
+	future := stubCostCenterSource{centers: []costcenter.CostCenter{
+		{Code: "CC-A", Status: costcenter.StatusActive, LegalEntityCode: "1000000", EffectiveDate: "2026-01-01"},
+	}}
+	if result := costCenterValidator(future).ValidateCreatePosition(context.Background(), uuid.New(), req); !result.Valid {
+		t.Fatalf("expected free-text cost center before master data takes effect to pass, got %#v", result.Errors)
+	}
+}
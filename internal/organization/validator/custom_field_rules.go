@@ -0,0 +1,194 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/customfield"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+// CustomFieldAware 可注入自定义字段定义来源的验证器。
+type CustomFieldAware interface {
+	SetCustomFieldDefinitions(source customfield.DefinitionSource)
+}
+
+// customFieldCheck 描述一次自定义字段校验的输入。
+type customFieldCheck struct {
+	TenantID   uuid.UUID
+	EntityType string
+	// Subtype 组织的 UnitType 或职位的 PositionType，用于匹配定义的适用范围
+	Subtype string
+	// Patch 本次提交的字段值，校验通过后就地规范化
+	Patch types.CustomFieldValues
+	// Current 已存储的字段值（新增版本/替换时用于合并后检查必填）
+	Current types.CustomFieldValues
+	// RequireAll 为 true 时在 Current 合并 Patch 后检查全部必填字段；否则仅禁止清空必填字段
+	RequireAll bool
+}
+
+// SetCustomFieldDefinitions 注入自定义字段定义来源；未注入时跳过自定义字段校验。
+func (v *BusinessRuleValidator) SetCustomFieldDefinitions(source customfield.DefinitionSource) {
+	v.customFields = source
+}
+
+// SetCustomFieldDefinitions 注入自定义字段定义来源；未注入时跳过自定义字段校验。
+func (s *positionAssignmentValidationService) SetCustomFieldDefinitions(source customfield.DefinitionSource) {
+	s.customFields = source
+}
+
+func (v *BusinessRuleValidator) newOrgCustomFieldRule() RuleHandler {
+	return newCustomFieldRule(v.customFields, "ORG-CUSTOM-FIELDS", func(_ context.Context, subject interface{}) (*customFieldCheck, error) {
+		switch s := subject.(type) {
+		case *organizationCreateSubject:
+			if s.Request == nil {
+				return nil, nil
+			}
+			return &customFieldCheck{
+				TenantID:   s.TenantID,
+				EntityType: types.CustomFieldEntityOrganization,
+				Subtype:    strings.ToUpper(strings.TrimSpace(s.Request.UnitType)),
+				Patch:      s.Request.CustomFields,
+				RequireAll: true,
+			}, nil
+		case *organizationUpdateSubject:
+			if s.Request == nil {
+				return nil, nil
+			}
+			subtype := ""
+			if s.Request.UnitType != nil {
+				subtype = *s.Request.UnitType
+			} else if s.Existing != nil {
+				subtype = s.Existing.UnitType
+			}
+			return &customFieldCheck{
+				TenantID:   s.TenantID,
+				EntityType: types.CustomFieldEntityOrganization,
+				Subtype:    strings.ToUpper(strings.TrimSpace(subtype)),
+				Patch:      s.Request.CustomFields,
+			}, nil
+		case *organizationVersionSubject:
+			return &customFieldCheck{
+				TenantID:   s.TenantID,
+				EntityType: types.CustomFieldEntityOrganization,
+				Subtype:    s.UnitType,
+				Patch:      s.CustomFields,
+			}, nil
+		}
+		return nil, nil
+	})
+}
+
+func (s *positionAssignmentValidationService) newPosCustomFieldRule() RuleHandler {
+	return newCustomFieldRule(s.customFields, "POS-CUSTOM-FIELDS", func(ctx context.Context, subject interface{}) (*customFieldCheck, error) {
+		var (
+			tenantID uuid.UUID
+			code     string
+			subtype  string
+			patch    types.CustomFieldValues
+		)
+		switch subj := subject.(type) {
+		case *positionCreateSubject:
+			if subj.Request == nil {
+				return nil, nil
+			}
+			return &customFieldCheck{
+				TenantID:   subj.TenantID,
+				EntityType: types.CustomFieldEntityPosition,
+				Subtype:    strings.ToUpper(strings.TrimSpace(subj.Request.PositionType)),
+				Patch:      subj.Request.CustomFields,
+				RequireAll: true,
+			}, nil
+		case *positionUpdateSubject:
+			if subj.Request == nil {
+				return nil, nil
+			}
+			tenantID, code = subj.TenantID, subj.Code
+			subtype = subj.Request.PositionType
+			patch = subj.Request.CustomFields
+		case *positionVersionSubject:
+			if subj.Request == nil {
+				return nil, nil
+			}
+			tenantID, code = subj.TenantID, subj.Code
+			if subj.Request.PositionType != nil {
+				subtype = *subj.Request.PositionType
+			}
+			patch = subj.Request.CustomFields
+		default:
+			return nil, nil
+		}
+
+		var current types.CustomFieldValues
+		if s.positionRepo != nil && code != "" {
+			position, err := s.positionRepo.GetCurrentPosition(ctx, nil, tenantID, code)
+			if err != nil {
+				return nil, fmt.Errorf("pos-custom-fields: fetch position %s failed: %w", code, err)
+			}
+			if position != nil {
+				current = position.CustomFields
+				if strings.TrimSpace(subtype) == "" {
+					subtype = position.PositionType
+				}
+			}
+		}
+		return &customFieldCheck{
+			TenantID:   tenantID,
+			EntityType: types.CustomFieldEntityPosition,
+			Subtype:    strings.ToUpper(strings.TrimSpace(subtype)),
+			Patch:      patch,
+			Current:    current,
+			RequireAll: true,
+		}, nil
+	})
+}
+
+// newCustomFieldRule 按字段定义校验并规范化提交值，违规项以 customFields.<key> 作为字段路径返回。
+func newCustomFieldRule(source customfield.DefinitionSource, ruleID string, resolve func(ctx context.Context, subject interface{}) (*customFieldCheck, error)) RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		if source == nil {
+			return nil, nil
+		}
+		check, err := resolve(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		if check == nil || check.TenantID == uuid.Nil || (len(check.Patch) == 0 && !check.RequireAll) {
+			return nil, nil
+		}
+
+		defs, err := source.ListDefinitions(ctx, check.TenantID, check.EntityType)
+		if err != nil {
+			return nil, fmt.Errorf("%s: load custom field definitions failed: %w", strings.ToLower(ruleID), err)
+		}
+
+		violations := customfield.Normalize(defs, check.Subtype, check.Patch)
+		if check.RequireAll {
+			violations = append(violations, customfield.MissingRequired(defs, check.Subtype, check.Current.Merge(check.Patch))...)
+		} else {
+			violations = append(violations, customfield.ClearedRequired(defs, check.Subtype, check.Patch)...)
+		}
+		if len(violations) == 0 {
+			return nil, nil
+		}
+
+		outcome := &RuleOutcome{}
+		for _, violation := range violations {
+			outcome.Errors = append(outcome.Errors, ValidationError{
+				Code:     violation.Code,
+				Message:  violation.Message,
+				Field:    "customFields." + violation.Key,
+				Value:    violation.Value,
+				Severity: string(SeverityHigh),
+				Context: map[string]interface{}{
+					"ruleId":     ruleID,
+					"entityType": check.EntityType,
+					"fieldKey":   violation.Key,
+				},
+			})
+		}
+		return outcome, nil
+	}
+}
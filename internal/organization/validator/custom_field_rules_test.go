@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"cube-castle/internal/organization/customfield"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+type stubCustomFieldSource struct {
+	defs []customfield.Definition
+}
+
+func (s stubCustomFieldSource) ListDefinitions(_ context.Context, _ uuid.UUID, entityType string) ([]customfield.Definition, error) {
+	var out []customfield.Definition
+	for _, d := range s.defs {
+		if d.EntityType == entityType {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func orgCustomFieldSource() stubCustomFieldSource {
+	return stubCustomFieldSource{defs: []customfield.Definition{
+		{EntityType: types.CustomFieldEntityOrganization, Key: "taxId", DataType: types.CustomFieldTypeString, Required: true, AppliesTo: []string{"COMPANY"}},
+		{EntityType: types.CustomFieldEntityOrganization, Key: "headcountBudget", DataType: types.CustomFieldTypeNumber},
+	}}
+}
+
+func TestOrganizationCreateCustomFieldsRequired(t *testing.T) {
+	validator := newTestValidator(&stubHierarchy{})
+	validator.SetCustomFieldDefinitions(orgCustomFieldSource())
+
+	req := &types.CreateOrganizationRequest{
+		Name:         "Holding",
+		UnitType:     "COMPANY",
+		CustomFields: types.CustomFieldValues{"headcountBudget": {Value: "abc"}},
+	}
+
+	result := validator.ValidateOrganizationCreation(context.Background(), req, uuid.New())
+	if result.Valid {
+		t.Fatalf("expected custom field validation to fail")
+	}
+	fields := map[string]string{}
+	for _, err := range result.Errors {
+		fields[err.Field] = err.Code
+	}
+	if fields["customFields.taxId"] != customfield.CodeRequired || fields["customFields.headcountBudget"] != customfield.CodeTypeMismatch {
+		t.Fatalf("unexpected errors: %#v", result.Errors)
+	}
+}
+
+func TestOrganizationCreateCustomFieldsNormalized(t *testing.T) {
+	validator := newTestValidator(&stubHierarchy{})
+	validator.SetCustomFieldDefinitions(orgCustomFieldSource())
+
+	req := &types.CreateOrganizationRequest{
+		Name:         "Platform",
+		UnitType:     "DEPARTMENT",
+		CustomFields: types.CustomFieldValues{"headcountBudget": {Value: "42"}},
+	}
+
+	result := validator.ValidateOrganizationCreation(context.Background(), req, uuid.New())
+	if !result.Valid {
+		t.Fatalf("expected validation to pass: %#v", result.Errors)
+	}
+	if got := req.CustomFields["headcountBudget"]; got.Type != types.CustomFieldTypeNumber || got.Value != 42.0 {
+		t.Fatalf("expected normalized number, got %#v", got)
+	}
+}
+
+func TestOrganizationVersionCustomFieldsCannotClearRequired(t *testing.T) {
+	validator := newTestValidator(&stubHierarchy{})
+	validator.SetCustomFieldDefinitions(orgCustomFieldSource())
+
+	result := validator.ValidateOrganizationVersion(context.Background(), uuid.New(), nil, "COMPANY", nil, types.CustomFieldValues{"taxId": nil})
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Field != "customFields.taxId" {
+		t.Fatalf("expected clearing required field to fail, got %#v", result.Errors)
+	}
+}
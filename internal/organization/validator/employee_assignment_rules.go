@@ -0,0 +1,298 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+// employeeAssignmentSpan 员工任职在时间轴上的区间 [Start, End)，End 为空表示无固定结束日期。
+// 结束日期与代理截止日均视为不再生效的首日，与 VacatePosition/自动回退的生效日语义一致。
+type employeeAssignmentSpan struct {
+	AssignmentID     uuid.UUID
+	PositionCode     string
+	OrganizationCode string
+	AssignmentType   string
+	FTE              float64
+	Start            time.Time
+	End              *time.Time
+}
+
+func (a employeeAssignmentSpan) activeOn(day time.Time) bool {
+	return !day.Before(a.Start) && (a.End == nil || day.Before(*a.End))
+}
+
+func (a employeeAssignmentSpan) overlaps(b employeeAssignmentSpan) bool {
+	return (b.End == nil || a.Start.Before(*b.End)) && (a.End == nil || b.Start.Before(*a.End))
+}
+
+// employeeAssignmentCandidate 待校验的新建/变更任职及需要校验的员工级约束。
+type employeeAssignmentCandidate struct {
+	EmployeeID   uuid.UUID
+	Span         employeeAssignmentSpan
+	CheckFTE     bool
+	CheckPrimary bool
+	CheckActing  bool
+}
+
+// newCrossEmployeeRule 校验员工跨职位约束：任一日期 FTE 合计不超过上限、同一时间仅一个 PRIMARY、同一组织内 ACTING 不重叠。
+func (s *positionAssignmentValidationService) newCrossEmployeeRule() RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		candidate := s.extractEmployeeCandidate(subject)
+		if candidate == nil {
+			return nil, nil
+		}
+		existing, err := s.assignmentRepo.ListEmployeeAssignments(ctx, nil, s.extractTenant(subject), candidate.EmployeeID)
+		if err != nil {
+			return nil, fmt.Errorf("cross-employee: load employee assignments failed: %w", err)
+		}
+
+		spans := make([]employeeAssignmentSpan, 0, len(existing))
+		for _, assignment := range existing {
+			if assignment.AssignmentID == candidate.Span.AssignmentID {
+				continue
+			}
+			spans = append(spans, employeeSpanFromAssignment(assignment))
+		}
+
+		var errs []ValidationError
+		if candidate.CheckFTE {
+			if violation := employeeFTEViolation(candidate, spans); violation != nil {
+				errs = append(errs, *violation)
+			}
+		}
+		if candidate.CheckPrimary {
+			if violation := employeeTypeOverlapViolation(candidate, spans, "PRIMARY", false); violation != nil {
+				errs = append(errs, *violation)
+			}
+		}
+		if candidate.CheckActing {
+			if violation := employeeTypeOverlapViolation(candidate, spans, "ACTING", true); violation != nil {
+				errs = append(errs, *violation)
+			}
+		}
+		if len(errs) > 0 {
+			return &RuleOutcome{Errors: errs}, nil
+		}
+		return &RuleOutcome{Context: map[string]interface{}{
+			"employeeId":          candidate.EmployeeID.String(),
+			"employeeAssignments": len(spans),
+		}}, nil
+	}
+}
+
+// extractEmployeeCandidate 从校验主体构建员工级候选任职；日期或员工无法解析时返回 nil，由请求格式校验处理。
+func (s *positionAssignmentValidationService) extractEmployeeCandidate(subject interface{}) *employeeAssignmentCandidate {
+	var (
+		employeeID, assignmentType, effectiveDate string
+		endDate                                   *string
+		position                                  *types.Position
+		fte                                       float64
+	)
+	switch sub := subject.(type) {
+	case *positionFillSubject:
+		employeeID, assignmentType, effectiveDate = sub.Request.EmployeeID, sub.Request.AssignmentType, sub.Request.EffectiveDate
+		endDate, position, fte = sub.Request.AnticipatedEndDate, sub.Position, sub.RequestedFTE
+	case *assignmentCreateSubject:
+		employeeID, assignmentType, effectiveDate = sub.Request.EmployeeID, sub.Request.AssignmentType, sub.Request.EffectiveDate
+		endDate, position, fte = sub.Request.ActingUntil, sub.Position, sub.RequestedFTE
+	case *assignmentUpdateSubject:
+		return s.extractEmployeeUpdateCandidate(sub)
+	default:
+		return nil
+	}
+
+	employee, err := uuid.Parse(strings.TrimSpace(employeeID))
+	if err != nil || position == nil {
+		return nil
+	}
+	start, err := time.Parse("2006-01-02", strings.TrimSpace(effectiveDate))
+	if err != nil {
+		return nil
+	}
+	span := employeeAssignmentSpan{
+		PositionCode:     position.Code,
+		OrganizationCode: strings.TrimSpace(position.OrganizationCode),
+		AssignmentType:   normalizeAssignmentType(assignmentType),
+		FTE:              fte,
+		Start:            start,
+	}
+	if endDate != nil && strings.TrimSpace(*endDate) != "" {
+		end, err := time.Parse("2006-01-02", strings.TrimSpace(*endDate))
+		if err != nil {
+			return nil
+		}
+		span.End = &end
+	}
+	return &employeeAssignmentCandidate{
+		EmployeeID:   employee,
+		Span:         span,
+		CheckFTE:     true,
+		CheckPrimary: span.AssignmentType == "PRIMARY",
+		CheckActing:  span.AssignmentType == "ACTING",
+	}
+}
+
+// extractEmployeeUpdateCandidate 更新任职时仅校验本次变更涉及的约束：FTE 上调时校验合计，调整代理截止日时校验 ACTING 重叠。
+func (s *positionAssignmentValidationService) extractEmployeeUpdateCandidate(sub *assignmentUpdateSubject) *employeeAssignmentCandidate {
+	if sub.Assignment == nil || sub.AssignmentStatus == "ENDED" {
+		return nil
+	}
+	organizationCode := ""
+	if sub.Position != nil {
+		organizationCode = sub.Position.OrganizationCode
+	}
+	span := employeeSpanFromAssignment(types.EmployeeAssignment{
+		PositionAssignment: *sub.Assignment,
+		OrganizationCode:   organizationCode,
+	})
+	span.AssignmentID = sub.AssignmentID
+	span.FTE = sub.RequestedFTE
+
+	checkActing := false
+	if sub.Request.ActingUntil != nil && span.AssignmentType == "ACTING" {
+		span.End = nil
+		if value := strings.TrimSpace(*sub.Request.ActingUntil); value != "" {
+			end, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return nil
+			}
+			span.End = &end
+		}
+		if sub.Assignment.EndDate.Valid && (span.End == nil || sub.Assignment.EndDate.Time.Before(*span.End)) {
+			end := sub.Assignment.EndDate.Time
+			span.End = &end
+		}
+		checkActing = true
+	}
+	checkFTE := sub.RequestedFTE > sub.OriginalFTE+1e-9
+	if !checkFTE && !checkActing {
+		return nil
+	}
+	return &employeeAssignmentCandidate{
+		EmployeeID:  sub.Assignment.EmployeeID,
+		Span:        span,
+		CheckFTE:    checkFTE,
+		CheckActing: checkActing,
+	}
+}
+
+// employeeSpanFromAssignment 将已有任职转换为时间区间，结束日期取 end_date 与 acting_until 中较早者。
+func employeeSpanFromAssignment(assignment types.EmployeeAssignment) employeeAssignmentSpan {
+	span := employeeAssignmentSpan{
+		AssignmentID:     assignment.AssignmentID,
+		PositionCode:     assignment.PositionCode,
+		OrganizationCode: strings.TrimSpace(assignment.OrganizationCode),
+		AssignmentType:   normalizeAssignmentType(assignment.AssignmentType),
+		FTE:              assignment.FTE,
+		Start:            assignment.EffectiveDate,
+	}
+	for _, end := range []struct {
+		valid bool
+		value time.Time
+	}{
+		{assignment.EndDate.Valid, assignment.EndDate.Time},
+		{assignment.ActingUntil.Valid, assignment.ActingUntil.Time},
+	} {
+		if end.valid && (span.End == nil || end.value.Before(*span.End)) {
+			value := end.value
+			span.End = &value
+		}
+	}
+	return span
+}
+
+func normalizeAssignmentType(value string) string {
+	assignmentType := strings.ToUpper(strings.TrimSpace(value))
+	if assignmentType == "" {
+		return "PRIMARY"
+	}
+	return assignmentType
+}
+
+// employeeFTEViolation 在候选任职区间内的每个变化点（候选生效日及其后其他任职的生效日）计算员工 FTE 合计，
+// 返回合计最高且超过上限的日期对应的错误。
+func employeeFTEViolation(candidate *employeeAssignmentCandidate, spans []employeeAssignmentSpan) *ValidationError {
+	checkpoints := []time.Time{candidate.Span.Start}
+	for _, span := range spans {
+		if candidate.Span.activeOn(span.Start) {
+			checkpoints = append(checkpoints, span.Start)
+		}
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].Before(checkpoints[j]) })
+
+	var (
+		worstDay       time.Time
+		worstTotal     float64
+		worstPositions []string
+	)
+	for _, day := range checkpoints {
+		total := candidate.Span.FTE
+		positions := []string{candidate.Span.PositionCode}
+		for _, span := range spans {
+			if span.activeOn(day) {
+				total += span.FTE
+				positions = append(positions, span.PositionCode)
+			}
+		}
+		if total > worstTotal+1e-9 {
+			worstDay, worstTotal, worstPositions = day, total, positions
+		}
+	}
+	if worstTotal <= types.EmployeeMaxTotalFTE+1e-9 {
+		return nil
+	}
+	return &ValidationError{
+		Code:     "EMPLOYEE_FTE_EXCEEDED",
+		Message:  fmt.Sprintf("Employee total FTE %.2f on %s exceeds limit %.2f", worstTotal, worstDay.Format("2006-01-02"), types.EmployeeMaxTotalFTE),
+		Field:    "fte",
+		Value:    candidate.Span.FTE,
+		Severity: string(SeverityHigh),
+		Context: map[string]interface{}{
+			"ruleId":        "CROSS-EMPLOYEE",
+			"employeeId":    candidate.EmployeeID.String(),
+			"asOfDate":      worstDay.Format("2006-01-02"),
+			"totalFTE":      worstTotal,
+			"maxFTE":        types.EmployeeMaxTotalFTE,
+			"positionCodes": worstPositions,
+		},
+	}
+}
+
+// employeeTypeOverlapViolation 检查候选任职与同类型任职的时间重叠；sameOrganization 为 true 时仅比较同一组织内的任职。
+func employeeTypeOverlapViolation(candidate *employeeAssignmentCandidate, spans []employeeAssignmentSpan, assignmentType string, sameOrganization bool) *ValidationError {
+	for _, span := range spans {
+		if span.AssignmentType != assignmentType || !span.overlaps(candidate.Span) {
+			continue
+		}
+		if sameOrganization && !strings.EqualFold(span.OrganizationCode, candidate.Span.OrganizationCode) {
+			continue
+		}
+		code, message := "EMPLOYEE_PRIMARY_CONFLICT", fmt.Sprintf("Employee already holds PRIMARY assignment on position %s in the same period", span.PositionCode)
+		if assignmentType == "ACTING" {
+			code, message = "EMPLOYEE_ACTING_OVERLAP", fmt.Sprintf("Employee already holds ACTING assignment on position %s in organization %s in the same period", span.PositionCode, span.OrganizationCode)
+		}
+		return &ValidationError{
+			Code:     code,
+			Message:  message,
+			Field:    "assignmentType",
+			Value:    assignmentType,
+			Severity: string(SeverityHigh),
+			Context: map[string]interface{}{
+				"ruleId":                 "CROSS-EMPLOYEE",
+				"employeeId":             candidate.EmployeeID.String(),
+				"conflictAssignmentId":   span.AssignmentID.String(),
+				"conflictPositionCode":   span.PositionCode,
+				"conflictEffectiveDate":  span.Start.Format("2006-01-02"),
+				"organizationCode":       span.OrganizationCode,
+				"requestedEffectiveDate": candidate.Span.Start.Format("2006-01-02"),
+			},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package validator
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+func employeeAssignment(position, org, assignmentType string, fte float64, start string, end *string) types.EmployeeAssignment {
+	effective, _ := time.Parse("2006-01-02", start)
+	assignment := types.EmployeeAssignment{
+		PositionAssignment: types.PositionAssignment{
+			AssignmentID:     uuid.New(),
+			PositionCode:     position,
+			AssignmentType:   assignmentType,
+			AssignmentStatus: "ACTIVE",
+			FTE:              fte,
+			EffectiveDate:    effective,
+		},
+		OrganizationCode: org,
+	}
+	if end != nil {
+		endDate, _ := time.Parse("2006-01-02", *end)
+		assignment.EndDate = sql.NullTime{Time: endDate, Valid: true}
+	}
+	return assignment
+}
+
+func employeeValidator(existing []types.EmployeeAssignment) AssignmentValidationService {
+	_, assignValidator := NewPositionAssignmentValidationService(
+		activeOrgRepoStub(),
+		activeJobCatalogStub(),
+		&StubPositionRepository{
+			GetCurrentPositionFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID, code string) (*types.Position, error) {
+				return &types.Position{Code: code, OrganizationCode: "1000001", Status: "ACTIVE", HeadcountCapacity: 2}, nil
+			},
+		},
+		&StubAssignmentRepository{
+			GetByIDFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID, assignmentID uuid.UUID) (*types.PositionAssignment, error) {
+				for _, item := range existing {
+					if item.AssignmentID == assignmentID {
+						assignment := item.PositionAssignment
+						return &assignment, nil
+					}
+				}
+				return nil, nil
+			},
+			ListEmployeeAssignmentsFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID, _ uuid.UUID) ([]types.EmployeeAssignment, error) {
+				return existing, nil
+			},
+		},
+		testValidatorLogger(),
+	)
+	return assignValidator
+}
+
+func TestCrossEmployeeRule_CreateAssignment(t *testing.T) {
+	cases := []struct {
+		name           string
+		existing       []types.EmployeeAssignment
+		assignmentType string
+		fte            float64
+		effectiveDate  string
+		actingUntil    *string
+		codes          []string
+	}{
+		{
+			name:           "fte within limit",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "PRIMARY", 0.5, "2025-01-01", nil)},
+			assignmentType: "SECONDARY",
+			fte:            0.5,
+			effectiveDate:  "2025-11-06",
+		},
+		{
+			name:           "fte exceeded",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "PRIMARY", 0.8, "2025-01-01", nil)},
+			assignmentType: "SECONDARY",
+			fte:            0.5,
+			effectiveDate:  "2025-11-06",
+			codes:          []string{"EMPLOYEE_FTE_EXCEEDED"},
+		},
+		{
+			name:           "future assignment pushes total over limit",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "SECONDARY", 0.6, "2026-03-01", nil)},
+			assignmentType: "SECONDARY",
+			fte:            0.5,
+			effectiveDate:  "2025-11-06",
+			codes:          []string{"EMPLOYEE_FTE_EXCEEDED"},
+		},
+		{
+			name:           "ended before start",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "PRIMARY", 1, "2025-01-01", pointerString("2025-11-06"))},
+			assignmentType: "PRIMARY",
+			fte:            1,
+			effectiveDate:  "2025-11-06",
+		},
+		{
+			name:           "second primary",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "PRIMARY", 0.5, "2025-01-01", nil)},
+			assignmentType: "PRIMARY",
+			fte:            0.5,
+			effectiveDate:  "2025-11-06",
+			codes:          []string{"EMPLOYEE_PRIMARY_CONFLICT"},
+		},
+		{
+			name:           "acting overlap in same organization",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000001", "ACTING", 0.2, "2025-10-01", nil)},
+			assignmentType: "ACTING",
+			fte:            0.2,
+			effectiveDate:  "2025-11-06",
+			actingUntil:    pointerString("2025-12-31"),
+			codes:          []string{"EMPLOYEE_ACTING_OVERLAP"},
+		},
+		{
+			name:           "acting in another organization",
+			existing:       []types.EmployeeAssignment{employeeAssignment("P1000002", "1000002", "ACTING", 0.2, "2025-10-01", nil)},
+			assignmentType: "ACTING",
+			fte:            0.2,
+			effectiveDate:  "2025-11-06",
+			actingUntil:    pointerString("2025-12-31"),
+		},
+		{
+			name: "primary conflict and fte exceeded",
+			existing: []types.EmployeeAssignment{
+				employeeAssignment("P1000002", "1000002", "PRIMARY", 1, "2025-01-01", nil),
+			},
+			assignmentType: "PRIMARY",
+			fte:            1,
+			effectiveDate:  "2025-11-06",
+			codes:          []string{"EMPLOYEE_FTE_EXCEEDED", "EMPLOYEE_PRIMARY_CONFLICT"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fte := tc.fte
+			result := employeeValidator(tc.existing).ValidateCreateAssignment(context.Background(), uuid.New(), "P1000001", &types.CreateAssignmentRequest{
+				EmployeeID:      uuid.NewString(),
+				EmployeeName:    "Jane",
+				AssignmentType:  tc.assignmentType,
+				FTE:             &fte,
+				EffectiveDate:   tc.effectiveDate,
+				ActingUntil:     tc.actingUntil,
+				OperationReason: "staffing",
+			})
+			if len(tc.codes) == 0 {
+				if !result.Valid {
+					t.Fatalf("expected assignment to pass, got %#v", result.Errors)
+				}
+				return
+			}
+			if result.Valid || len(result.Errors) != len(tc.codes) {
+				t.Fatalf("expected %v, got %#v", tc.codes, result.Errors)
+			}
+			for i, code := range tc.codes {
+				if result.Errors[i].Code != code || result.Errors[i].Context["ruleId"] != "CROSS-EMPLOYEE" {
+					t.Fatalf("expected %s at %d, got %#v", code, i, result.Errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCrossEmployeeRule_UpdateAssignment(t *testing.T) {
+	current := employeeAssignment("P1000001", "1000001", "PRIMARY", 0.5, "2025-01-01", nil)
+	other := employeeAssignment("P1000002", "1000002", "SECONDARY", 0.5, "2025-01-01", nil)
+	validator := employeeValidator([]types.EmployeeAssignment{current, other})
+
+	increase := 0.8
+	result := validator.ValidateUpdateAssignment(context.Background(), uuid.New(), "P1000001", current.AssignmentID, &types.UpdateAssignmentRequest{
+		FTE:             &increase,
+		OperationReason: "increase",
+	})
+	if result.Valid || result.Errors[0].Code != "EMPLOYEE_FTE_EXCEEDED" {
+		t.Fatalf("expected FTE increase to exceed employee limit, got %#v", result.Errors)
+	}
+
+	same := 0.5
+	result = validator.ValidateUpdateAssignment(context.Background(), uuid.New(), "P1000001", current.AssignmentID, &types.UpdateAssignmentRequest{
+		FTE:             &same,
+		OperationReason: "notes only",
+	})
+	if !result.Valid {
+		t.Fatalf("expected unchanged FTE to skip employee checks, got %#v", result.Errors)
+	}
+}
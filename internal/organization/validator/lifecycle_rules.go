@@ -0,0 +1,154 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/lifecycle"
+	"cube-castle/internal/types"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// LifecycleAware 可注入租户生命周期状态机来源的职位/任职验证器。
+type LifecycleAware interface {
+	SetLifecycle(source lifecycle.Source)
+}
+
+// SetLifecycle 注入状态机来源；未注入时使用内置迁移规则。
+func (s *positionAssignmentValidationService) SetLifecycle(source lifecycle.Source) {
+	s.lifecycle = source
+}
+
+func (s *positionAssignmentValidationService) lifecycleMachine(ctx context.Context, tenantID uuid.UUID) (*lifecycle.Machine, error) {
+	if s.lifecycle == nil {
+		return lifecycle.Default(), nil
+	}
+	return s.lifecycle.Machine(ctx, tenantID)
+}
+
+// positionEventSubject 职位事件校验主体。
+type positionEventSubject struct {
+	TenantID   uuid.UUID
+	Position   *types.Position
+	CurrentFTE float64
+	Request    *types.PositionEventRequest
+}
+
+// ValidateApplyEvent 按租户状态机校验职位事件；指定 recordId 的历史版本事件由命令服务按目标版本判定。
+func (s *positionAssignmentValidationService) ValidateApplyEvent(ctx context.Context, tenantID uuid.UUID, code string, req *types.PositionEventRequest) *ValidationResult {
+	if req == nil || strings.TrimSpace(req.EventType) == "" || (req.RecordID != nil && strings.TrimSpace(*req.RecordID) != "") {
+		return NewValidationResult()
+	}
+
+	position, err := s.positionRepo.GetCurrentPosition(ctx, nil, tenantID, strings.TrimSpace(code))
+	if err != nil {
+		s.logger.WithFields(pkglogger.Fields{"error": err}).Error("load position failed for ApplyPositionEvent")
+		return NewValidationResult()
+	}
+	if position == nil {
+		return NewValidationResult()
+	}
+	currentFTE, err := s.assignmentRepo.SumActiveFTE(ctx, nil, tenantID, position.Code)
+	if err != nil {
+		s.logger.WithFields(pkglogger.Fields{"error": err}).Error("sum active FTE failed for ApplyPositionEvent")
+		return NewValidationResult()
+	}
+
+	subject := &positionEventSubject{
+		TenantID:   tenantID,
+		Position:   position,
+		CurrentFTE: currentFTE,
+		Request:    req,
+	}
+
+	chain := NewValidationChain(
+		s.logger,
+		WithOperationLabel("ApplyPositionEvent"),
+		WithBaseContext(map[string]interface{}{
+			"operation":    "ApplyPositionEvent",
+			"positionCode": position.Code,
+			"tenantId":     tenantID.String(),
+		}),
+	)
+	_ = chain.Register(&Rule{
+		ID:           "POS-LIFECYCLE",
+		Priority:     5,
+		Severity:     SeverityHigh,
+		ShortCircuit: true,
+		Handler:      s.newPosLifecycleRule(),
+	})
+	return chain.Execute(ctx, subject)
+}
+
+// newPosLifecycleRule 校验职位事件在当前状态下是否允许，以及守卫条件（如停用前不得存在生效任职）是否满足。
+func (s *positionAssignmentValidationService) newPosLifecycleRule() RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		sub, ok := subject.(*positionEventSubject)
+		if !ok || sub.Position == nil {
+			return nil, nil
+		}
+		machine, err := s.lifecycleMachine(ctx, sub.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("pos-lifecycle: load lifecycle failed: %w", err)
+		}
+
+		status := strings.ToUpper(strings.TrimSpace(sub.Position.Status))
+		event := lifecycle.NormalizeEvent(sub.Request.EventType)
+		transition, err := machine.Check(lifecycle.EntityPosition, event, lifecycle.Facts{
+			Status:            status,
+			ActiveFTE:         sub.CurrentFTE,
+			HeadcountCapacity: sub.Position.HeadcountCapacity,
+		})
+		if err == nil && !transition.System {
+			return &RuleOutcome{Context: map[string]interface{}{
+				"event":      transition.Event,
+				"fromStatus": status,
+				"toStatus":   transition.ToStatus,
+			}}, nil
+		}
+
+		code, failedGuards := "POS_TRANSITION_NOT_ALLOWED", []string{}
+		if errors.Is(err, lifecycle.ErrGuardFailed) {
+			code = "POS_TRANSITION_GUARD_FAILED"
+			failedGuards = transition.Evaluate(lifecycle.Facts{
+				Status:            status,
+				ActiveFTE:         sub.CurrentFTE,
+				HeadcountCapacity: sub.Position.HeadcountCapacity,
+			}).FailedGuards
+		}
+		message := fmt.Sprintf("Position %s status %s does not allow event %s", sub.Position.Code, status, event)
+		if err != nil {
+			message = err.Error()
+		}
+		return &RuleOutcome{
+			Errors: []ValidationError{{
+				Code:     code,
+				Message:  message,
+				Field:    "eventType",
+				Value:    event,
+				Severity: string(SeverityHigh),
+				Context: map[string]interface{}{
+					"ruleId":       "POS-LIFECYCLE",
+					"positionCode": sub.Position.Code,
+					"fromStatus":   status,
+					"failedGuards": failedGuards,
+					"currentFTE":   sub.CurrentFTE,
+				},
+			}},
+		}, nil
+	}
+}
+
+// lifecycleStateViolation 按状态机判断任职相关系统事件（FILL/UPDATE/CLOSE）是否被当前状态禁止；
+// 状态机加载失败时返回错误，由校验链按规则执行错误处理。
+func (s *positionAssignmentValidationService) lifecycleStateViolation(ctx context.Context, tenantID uuid.UUID, entity, event string, facts lifecycle.Facts) (bool, error) {
+	machine, err := s.lifecycleMachine(ctx, tenantID)
+	if err != nil {
+		return false, fmt.Errorf("assign-state: load lifecycle failed: %w", err)
+	}
+	_, err = machine.Check(entity, event, facts)
+	return err != nil, nil
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"cube-castle/internal/organization/lifecycle"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+type stubLifecycleSource struct {
+	overrides []lifecycle.Transition
+}
+
+func (s stubLifecycleSource) Machine(context.Context, uuid.UUID) (*lifecycle.Machine, error) {
+	return lifecycle.NewMachine(lifecycle.Effective(s.overrides)), nil
+}
+
+func lifecycleValidator(status string, activeFTE float64, source lifecycle.Source) (PositionValidationService, AssignmentValidationService) {
+	posValidator, assignValidator := NewPositionAssignmentValidationService(
+		activeOrgRepoStub(),
+		activeJobCatalogStub(),
+		&StubPositionRepository{
+			GetCurrentPositionFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID, code string) (*types.Position, error) {
+				return &types.Position{Code: code, OrganizationCode: "1000001", Status: status, HeadcountCapacity: 2}, nil
+			},
+		},
+		&StubAssignmentRepository{
+			SumActiveFTEFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID, _ string) (float64, error) {
+				return activeFTE, nil
+			},
+		},
+		testValidatorLogger(),
+	)
+	if source != nil {
+		posValidator.(LifecycleAware).SetLifecycle(source)
+	}
+	return posValidator, assignValidator
+}
+
+func TestValidateApplyEvent_PosLifecycle(t *testing.T) {
+	cases := []struct {
+		name      string
+		status    string
+		activeFTE float64
+		event     string
+		code      string
+	}{
+		{name: "suspend vacant", status: "VACANT", event: "SUSPEND"},
+		{name: "legacy alias", status: "ACTIVE", event: "inactive"},
+		{name: "suspend with active assignments", status: "FILLED", activeFTE: 2, event: "SUSPEND", code: "POS_TRANSITION_GUARD_FAILED"},
+		{name: "reactivate active", status: "ACTIVE", event: "REACTIVATE", code: "POS_TRANSITION_NOT_ALLOWED"},
+		{name: "system event", status: "ACTIVE", event: "FILL", code: "POS_TRANSITION_NOT_ALLOWED"},
+		{name: "unknown event", status: "ACTIVE", event: "ARCHIVE", code: "POS_TRANSITION_NOT_ALLOWED"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			posValidator, _ := lifecycleValidator(tc.status, tc.activeFTE, nil)
+			result := posValidator.ValidateApplyEvent(context.Background(), uuid.New(), "P1000001", &types.PositionEventRequest{
+				EventType:       tc.event,
+				EffectiveDate:   "2025-11-06",
+				OperationReason: "lifecycle",
+			})
+			if tc.code == "" {
+				if !result.Valid {
+					t.Fatalf("expected event to pass, got %#v", result.Errors)
+				}
+				return
+			}
+			if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != tc.code || result.Errors[0].Field != "eventType" {
+				t.Fatalf("expected %s, got %#v", tc.code, result.Errors)
+			}
+		})
+	}
+}
+
+func TestValidateApplyEvent_TenantOverride(t *testing.T) {
+	source := stubLifecycleSource{overrides: []lifecycle.Transition{{
+		Entity:       lifecycle.EntityPosition,
+		Event:        lifecycle.EventSuspend,
+		FromStatuses: []string{lifecycle.PositionFilled},
+		ToStatus:     lifecycle.PositionInactive,
+		SideEffects:  []string{lifecycle.EffectEndActiveAssignments},
+		Enabled:      true,
+	}}}
+	posValidator, _ := lifecycleValidator("FILLED", 2, source)
+	result := posValidator.ValidateApplyEvent(context.Background(), uuid.New(), "P1000001", &types.PositionEventRequest{EventType: "SUSPEND"})
+	if !result.Valid {
+		t.Fatalf("expected tenant override without guard to allow suspend, got %#v", result.Errors)
+	}
+}
+
+func TestAssignStateRule_UsesLifecycle(t *testing.T) {
+	_, assignValidator := lifecycleValidator("INACTIVE", 0, nil)
+	result := assignValidator.ValidateCreateAssignment(context.Background(), uuid.New(), "P1000001", &types.CreateAssignmentRequest{
+		EmployeeID:      uuid.NewString(),
+		EmployeeName:    "Jane",
+		AssignmentType:  "PRIMARY",
+		EffectiveDate:   "2025-11-06",
+		OperationReason: "hire",
+	})
+	if result.Valid || result.Errors[0].Code != "ASSIGN_INVALID_STATE" {
+		t.Fatalf("expected inactive position to reject assignment, got %#v", result.Errors)
+	}
+
+	source := stubLifecycleSource{overrides: []lifecycle.Transition{{
+		Entity:       lifecycle.EntityPosition,
+		Event:        lifecycle.EventFill,
+		FromStatuses: []string{lifecycle.PositionActive, lifecycle.PositionVacant},
+		Enabled:      true,
+	}}}
+	_, assignValidator = lifecycleValidator("PLANNED", 0, source)
+	result = assignValidator.ValidateCreateAssignment(context.Background(), uuid.New(), "P1000001", &types.CreateAssignmentRequest{
+		EmployeeID:      uuid.NewString(),
+		EmployeeName:    "Jane",
+		AssignmentType:  "PRIMARY",
+		EffectiveDate:   "2025-11-06",
+		OperationReason: "hire",
+	})
+	if result.Valid || result.Errors[0].Code != "ASSIGN_INVALID_STATE" {
+		t.Fatalf("expected tenant override to reject filling planned position, got %#v", result.Errors)
+	}
+}
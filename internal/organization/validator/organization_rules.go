@@ -60,6 +60,25 @@ func (v *BusinessRuleValidator) buildOrganizationCreateChain(req *types.CreateOr
 		}
 	}
 
+	if v.unitTypes != nil {
+		chain.Register(&Rule{
+			ID:           "ORG-UNIT-TYPE",
+			Priority:     12,
+			Severity:     SeverityHigh,
+			ShortCircuit: true,
+			Handler:      v.newOrgUnitTypeRule(),
+		})
+	}
+
+	if v.customFields != nil {
+		chain.Register(&Rule{
+			ID:       "ORG-CUSTOM-FIELDS",
+			Priority: 40,
+			Severity: SeverityHigh,
+			Handler:  v.newOrgCustomFieldRule(),
+		})
+	}
+
 	return chain
 }
 
@@ -123,6 +142,25 @@ func (v *BusinessRuleValidator) buildOrganizationUpdateChain(existing *types.Org
 		}
 	}
 
+	if v.unitTypes != nil && req != nil && (req.UnitType != nil || req.ParentCode != nil) {
+		chain.Register(&Rule{
+			ID:           "ORG-UNIT-TYPE",
+			Priority:     22,
+			Severity:     SeverityHigh,
+			ShortCircuit: true,
+			Handler:      v.newOrgUnitTypeRule(),
+		})
+	}
+
+	if v.customFields != nil && req != nil && len(req.CustomFields) > 0 {
+		chain.Register(&Rule{
+			ID:       "ORG-CUSTOM-FIELDS",
+			Priority: 40,
+			Severity: SeverityHigh,
+			Handler:  v.newOrgCustomFieldRule(),
+		})
+	}
+
 	return chain
 }
 
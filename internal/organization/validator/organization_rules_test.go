@@ -19,6 +19,7 @@ type stubHierarchy struct {
 	depths    map[string]int
 	ancestors map[string][]repository.OrganizationNode
 	temporal  map[string]map[string]*repository.OrganizationNode
+	children  map[string][]repository.OrganizationNode
 }
 
 func (s *stubHierarchy) GetOrganization(_ context.Context, code string, _ uuid.UUID) (*types.Organization, error) {
@@ -42,8 +43,8 @@ func (s *stubHierarchy) GetAncestorChain(_ context.Context, code string, _ uuid.
 	return nil, nil
 }
 
-func (s *stubHierarchy) GetDirectChildren(_ context.Context, _ string, _ uuid.UUID) ([]repository.OrganizationNode, error) {
-	return nil, nil
+func (s *stubHierarchy) GetDirectChildren(_ context.Context, code string, _ uuid.UUID) ([]repository.OrganizationNode, error) {
+	return s.children[code], nil
 }
 
 func (s *stubHierarchy) GetOrganizationAtDate(_ context.Context, code string, _ uuid.UUID, ts time.Time) (*repository.OrganizationNode, error) {
@@ -6,6 +6,10 @@ import (
 	"fmt"
 	"strings"
 
+	"cube-castle/internal/organization/compensation"
+	"cube-castle/internal/organization/costcenter"
+	"cube-castle/internal/organization/customfield"
+	"cube-castle/internal/organization/lifecycle"
 	"cube-castle/internal/types"
 	pkglogger "cube-castle/pkg/logger"
 	"github.com/google/uuid"
@@ -28,6 +32,7 @@ type positionRepository interface {
 type positionAssignmentRepository interface {
 	SumActiveFTE(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, positionCode string) (float64, error)
 	GetByID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, assignmentID uuid.UUID) (*types.PositionAssignment, error)
+	ListEmployeeAssignments(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, employeeID uuid.UUID) ([]types.EmployeeAssignment, error)
 }
 
 // positionAssignmentValidationService 实现职位与任职验证器接口。
@@ -36,6 +41,10 @@ type positionAssignmentValidationService struct {
 	jobCatalogRepo jobCatalogRepository
 	positionRepo   positionRepository
 	assignmentRepo positionAssignmentRepository
+	customFields   customfield.DefinitionSource
+	grades         compensation.Source
+	costCenters    costcenter.Source
+	lifecycle      lifecycle.Source
 	logger         pkglogger.Logger
 }
 
@@ -149,6 +158,33 @@ func (s *positionAssignmentValidationService) ValidateCreateVersion(ctx context.
 		Handler:      s.newPosOrgRule(),
 	})
 
+	if s.grades != nil {
+		chain.Register(&Rule{
+			ID:       "POS-GRADE",
+			Priority: 25,
+			Severity: SeverityHigh,
+			Handler:  s.newPosGradeRule(),
+		})
+	}
+
+	if s.costCenters != nil {
+		chain.Register(&Rule{
+			ID:       "POS-COST-CENTER",
+			Priority: 26,
+			Severity: SeverityHigh,
+			Handler:  s.newPosCostCenterRule(),
+		})
+	}
+
+	if s.customFields != nil {
+		chain.Register(&Rule{
+			ID:       "POS-CUSTOM-FIELDS",
+			Priority: 30,
+			Severity: SeverityHigh,
+			Handler:  s.newPosCustomFieldRule(),
+		})
+	}
+
 	result := chain.Execute(ctx, subject)
 	return result
 }
@@ -238,11 +274,6 @@ func (s *positionAssignmentValidationService) ValidateTransferPosition(ctx conte
 	return chain.Execute(ctx, subject)
 }
 
-// ValidateApplyEvent 校验职位事件请求。
-func (s *positionAssignmentValidationService) ValidateApplyEvent(_ context.Context, _ uuid.UUID, _ string, _ *types.PositionEventRequest) *ValidationResult {
-	return NewValidationResult()
-}
-
 // ValidateCreateAssignment 校验创建任职请求（Position Assignment API）。
 func (s *positionAssignmentValidationService) ValidateCreateAssignment(ctx context.Context, tenantID uuid.UUID, positionCode string, req *types.CreateAssignmentRequest) *ValidationResult {
 	if req == nil {
@@ -404,6 +435,33 @@ func (s *positionAssignmentValidationService) registerPositionCreateRules(chain
 		Severity: SeverityMedium,
 		Handler:  s.newPosJobCatalogRule(),
 	})
+
+	if s.grades != nil {
+		_ = chain.Register(&Rule{
+			ID:       "POS-GRADE",
+			Priority: 25,
+			Severity: SeverityHigh,
+			Handler:  s.newPosGradeRule(),
+		})
+	}
+
+	if s.costCenters != nil {
+		_ = chain.Register(&Rule{
+			ID:       "POS-COST-CENTER",
+			Priority: 26,
+			Severity: SeverityHigh,
+			Handler:  s.newPosCostCenterRule(),
+		})
+	}
+
+	if s.customFields != nil {
+		_ = chain.Register(&Rule{
+			ID:       "POS-CUSTOM-FIELDS",
+			Priority: 30,
+			Severity: SeverityHigh,
+			Handler:  s.newPosCustomFieldRule(),
+		})
+	}
 }
 
 func (s *positionAssignmentValidationService) registerAssignmentCreationRules(chain *ValidationChain) {
@@ -438,6 +496,13 @@ func (s *positionAssignmentValidationService) registerAssignmentCreationRules(ch
 		ShortCircuit: true,
 		Handler:      s.newPosHeadcountRule(),
 	})
+
+	_ = chain.Register(&Rule{
+		ID:       "CROSS-EMPLOYEE",
+		Priority: 25,
+		Severity: SeverityHigh,
+		Handler:  s.newCrossEmployeeRule(),
+	})
 }
 
 func (s *positionAssignmentValidationService) registerAssignmentUpdateRules(chain *ValidationChain) {
@@ -464,6 +529,13 @@ func (s *positionAssignmentValidationService) registerAssignmentUpdateRules(chai
 		ShortCircuit: true,
 		Handler:      s.newPosHeadcountRule(),
 	})
+
+	_ = chain.Register(&Rule{
+		ID:       "CROSS-EMPLOYEE",
+		Priority: 25,
+		Severity: SeverityHigh,
+		Handler:  s.newCrossEmployeeRule(),
+	})
 }
 
 type positionCreateSubject struct {
@@ -663,28 +735,36 @@ return func(_ context.Context, subject interface{}) (*RuleOutcome, error) {
 }
 
 func (s *positionAssignmentValidationService) newAssignStateRule() RuleHandler {
-return func(_ context.Context, subject interface{}) (*RuleOutcome, error) {
+return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
 		operation := s.extractOperation(subject)
+		var (
+			tenantID      uuid.UUID
+			entity, event string
+			facts         lifecycle.Facts
+		)
 		switch sub := subject.(type) {
 		case *positionFillSubject:
-			if isInactivePositionStatus(sub.Position.Status) {
-				return assignStateViolation(sub.Position.Status, operation), nil
-			}
+			tenantID, entity, event = sub.TenantID, lifecycle.EntityPosition, lifecycle.EventFill
+			facts = lifecycle.Facts{Status: sub.Position.Status, ActiveFTE: sub.CurrentFTE, HeadcountCapacity: sub.Position.HeadcountCapacity}
 		case *assignmentCreateSubject:
-			if isInactivePositionStatus(sub.Position.Status) {
-				return assignStateViolation(sub.Position.Status, operation), nil
-			}
+			tenantID, entity, event = sub.TenantID, lifecycle.EntityPosition, lifecycle.EventFill
+			facts = lifecycle.Facts{Status: sub.Position.Status, ActiveFTE: sub.CurrentFTE, HeadcountCapacity: sub.Position.HeadcountCapacity}
 		case *assignmentUpdateSubject:
-			if strings.EqualFold(sub.AssignmentStatus, "ENDED") {
-				return assignStateViolation(sub.AssignmentStatus, operation), nil
-			}
+			tenantID, entity, event = sub.TenantID, lifecycle.EntityAssignment, lifecycle.EventUpdate
+			facts = lifecycle.Facts{Status: sub.AssignmentStatus}
 		case *assignmentCloseSubject:
-			if !strings.EqualFold(sub.Assignment.AssignmentStatus, "ACTIVE") {
-				return assignStateViolation(sub.Assignment.AssignmentStatus, operation), nil
-			}
+			tenantID, entity, event = sub.TenantID, lifecycle.EntityAssignment, lifecycle.EventClose
+			facts = lifecycle.Facts{Status: sub.Assignment.AssignmentStatus}
 		default:
 			return nil, nil
 		}
+		violated, err := s.lifecycleStateViolation(ctx, tenantID, entity, event, facts)
+		if err != nil {
+			return nil, err
+		}
+		if violated {
+			return assignStateViolation(facts.Status, operation), nil
+		}
 		return nil, nil
 	}
 }
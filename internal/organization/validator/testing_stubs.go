@@ -139,6 +139,8 @@ func (s *StubOrganizationRepository) GetByCode(ctx context.Context, tenantID uui
 type StubAssignmentRepository struct {
 	GetByIDFn      func(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, assignmentID uuid.UUID) (*types.PositionAssignment, error)
 	SumActiveFTEFn func(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, positionCode string) (float64, error)
+
+	ListEmployeeAssignmentsFn func(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, employeeID uuid.UUID) ([]types.EmployeeAssignment, error)
 }
 
 func (s *StubAssignmentRepository) GetByID(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, assignmentID uuid.UUID) (*types.PositionAssignment, error) {
@@ -155,6 +157,13 @@ func (s *StubAssignmentRepository) SumActiveFTE(ctx context.Context, tx *sql.Tx,
 	return 0, nil
 }
 
+func (s *StubAssignmentRepository) ListEmployeeAssignments(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, employeeID uuid.UUID) ([]types.EmployeeAssignment, error) {
+	if s.ListEmployeeAssignmentsFn != nil {
+		return s.ListEmployeeAssignmentsFn(ctx, tx, tenantID, employeeID)
+	}
+	return nil, nil
+}
+
 type StubPositionRepository struct {
 	GetCurrentPositionFn func(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, code string) (*types.Position, error)
 }
@@ -0,0 +1,237 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cube-castle/internal/organization/unittype"
+	"cube-castle/internal/organization/utils"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+// UnitTypeAware 可注入单元类型来源的验证器。
+type UnitTypeAware interface {
+	SetUnitTypeSource(source unittype.Source)
+}
+
+// organizationVersionSubject 新增时态版本的校验对象（类型与上级取自提交值或前一版本）。
+type organizationVersionSubject struct {
+	TenantID     uuid.UUID
+	Existing     *types.Organization
+	UnitType     string
+	ParentCode   *string
+	CustomFields types.CustomFieldValues
+}
+
+// unitTypeCheck 描述一次单元类型组合校验的输入。
+type unitTypeCheck struct {
+	TenantID uuid.UUID
+	// Code 已存在组织的编码，新建时为空
+	Code     string
+	UnitType string
+	// ParentCode 目标上级，为空表示根组织
+	ParentCode string
+	// CheckChildren 类型发生变化时检查直接下级是否仍允许挂在新类型之下
+	CheckChildren bool
+}
+
+// SetUnitTypeSource 注入单元类型来源；未注入时仅按内置类型校验且不检查类型组合。
+func (v *BusinessRuleValidator) SetUnitTypeSource(source unittype.Source) {
+	v.unitTypes = source
+}
+
+// isKnownUnitType 未注入类型来源时按内置类型校验，注入后由 ORG-UNIT-TYPE 规则按租户配置校验。
+func (v *BusinessRuleValidator) isKnownUnitType(unitType string) bool {
+	if v.unitTypes != nil {
+		return true
+	}
+	_, ok := validUnitTypes[unitType]
+	return ok
+}
+
+// ValidateOrganizationVersion 校验组织新增时态版本：单元类型组合规则与提交的自定义字段（值继承自前一版本，仅检查提交的键）。
+func (v *BusinessRuleValidator) ValidateOrganizationVersion(ctx context.Context, tenantID uuid.UUID, existing *types.Organization, unitType string, parentCode *string, patch types.CustomFieldValues) *ValidationResult {
+	chain := NewValidationChain(
+		v.logger,
+		WithOperationLabel("CreateOrganizationVersion"),
+		WithBaseContext(map[string]interface{}{"operation": "CreateOrganizationVersion"}),
+	)
+	if v.unitTypes != nil {
+		chain.Register(&Rule{
+			ID:           "ORG-UNIT-TYPE",
+			Priority:     5,
+			Severity:     SeverityHigh,
+			ShortCircuit: true,
+			Handler:      v.newOrgUnitTypeRule(),
+		})
+	}
+	if v.customFields != nil && len(patch) > 0 {
+		chain.Register(&Rule{
+			ID:       "ORG-CUSTOM-FIELDS",
+			Priority: 10,
+			Severity: SeverityHigh,
+			Handler:  v.newOrgCustomFieldRule(),
+		})
+	}
+	result := chain.Execute(ctx, &organizationVersionSubject{
+		TenantID:     tenantID,
+		Existing:     existing,
+		UnitType:     strings.ToUpper(strings.TrimSpace(unitType)),
+		ParentCode:   parentCode,
+		CustomFields: patch,
+	})
+	result.Valid = len(result.Errors) == 0
+	return result
+}
+
+func (v *BusinessRuleValidator) newOrgUnitTypeRule() RuleHandler {
+	return func(ctx context.Context, subject interface{}) (*RuleOutcome, error) {
+		var check unitTypeCheck
+		switch s := subject.(type) {
+		case *organizationCreateSubject:
+			if s.Request == nil {
+				return nil, nil
+			}
+			check = unitTypeCheck{
+				TenantID:   s.TenantID,
+				UnitType:   s.Request.UnitType,
+				ParentCode: derefParentCode(s.Request.ParentCode),
+			}
+		case *organizationUpdateSubject:
+			if s.Request == nil || s.Existing == nil {
+				return nil, nil
+			}
+			check = unitTypeCheck{
+				TenantID:   s.TenantID,
+				Code:       s.Code,
+				UnitType:   s.Existing.UnitType,
+				ParentCode: derefParentCode(s.Existing.ParentCode),
+			}
+			if s.Request.UnitType != nil {
+				check.UnitType = *s.Request.UnitType
+				check.CheckChildren = !strings.EqualFold(*s.Request.UnitType, s.Existing.UnitType)
+			}
+			if s.Request.ParentCode != nil {
+				check.ParentCode = derefParentCode(s.Request.ParentCode)
+			}
+		case *organizationVersionSubject:
+			check = unitTypeCheck{
+				TenantID:   s.TenantID,
+				UnitType:   s.UnitType,
+				ParentCode: derefParentCode(s.ParentCode),
+			}
+			if s.Existing != nil {
+				check.Code = s.Existing.Code
+				check.CheckChildren = !strings.EqualFold(s.UnitType, s.Existing.UnitType)
+			}
+		default:
+			return nil, fmt.Errorf("ORG-UNIT-TYPE rule: unsupported subject type %T", subject)
+		}
+		check.UnitType = strings.ToUpper(strings.TrimSpace(check.UnitType))
+		if check.UnitType == "" {
+			return nil, nil
+		}
+		return v.evaluateUnitType(ctx, check)
+	}
+}
+
+func (v *BusinessRuleValidator) evaluateUnitType(ctx context.Context, check unitTypeCheck) (*RuleOutcome, error) {
+	defs, err := v.unitTypes.ListUnitTypes(ctx, check.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("org-unit-type: load unit types failed: %w", err)
+	}
+
+	def := unittype.Find(defs, check.UnitType)
+	if def == nil || !def.Active {
+		return unitTypeOutcome("ORG_UNIT_TYPE_INVALID", fmt.Sprintf("无效或已停用的组织类型: %s", check.UnitType), "unitType", check.UnitType, nil), nil
+	}
+
+	depth := 1
+	if check.ParentCode == "" {
+		if !def.AllowRoot {
+			return unitTypeOutcome("ORG_UNIT_TYPE_ROOT_NOT_ALLOWED", fmt.Sprintf("组织类型 %s 不能作为根组织", def.Code), "parentCode", nil, map[string]interface{}{
+				"unitType":           def.Code,
+				"allowedParentTypes": def.AllowedParentTypes,
+			}), nil
+		}
+	} else {
+		parent, err := v.hierarchyRepo.GetOrganization(ctx, check.ParentCode, check.TenantID)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), "not found") {
+				// 上级不存在由 ORG-DEPTH / ORG-TEMPORAL 规则报告
+				return nil, nil
+			}
+			return nil, fmt.Errorf("org-unit-type: fetch parent %s failed: %w", check.ParentCode, err)
+		}
+		if parent == nil {
+			return nil, nil
+		}
+		if !def.AllowsParent(parent.UnitType) {
+			return unitTypeOutcome("ORG_UNIT_TYPE_PARENT_NOT_ALLOWED", fmt.Sprintf("组织类型 %s 不能挂在 %s 类型的上级组织之下", def.Code, parent.UnitType), "parentCode", check.ParentCode, map[string]interface{}{
+				"unitType":           def.Code,
+				"parentType":         parent.UnitType,
+				"allowedParentTypes": def.AllowedParentTypes,
+			}), nil
+		}
+		depth = parent.Level + 1
+	}
+
+	if def.MaxDepth != nil && depth > *def.MaxDepth {
+		return unitTypeOutcome("ORG_UNIT_TYPE_DEPTH_EXCEEDED", fmt.Sprintf("组织类型 %s 最多允许 %d 级，目标层级为 %d", def.Code, *def.MaxDepth, depth), "parentCode", check.ParentCode, map[string]interface{}{
+			"unitType":       def.Code,
+			"maxDepth":       *def.MaxDepth,
+			"attemptedDepth": depth,
+		}), nil
+	}
+
+	if check.CheckChildren && check.Code != "" {
+		children, err := v.hierarchyRepo.GetDirectChildren(ctx, check.Code, check.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("org-unit-type: fetch children of %s failed: %w", check.Code, err)
+		}
+		for _, child := range children {
+			if strings.EqualFold(child.Status, "DELETED") {
+				continue
+			}
+			childDef := unittype.Find(defs, child.UnitType)
+			if childDef != nil && !childDef.AllowsParent(def.Code) {
+				return unitTypeOutcome("ORG_UNIT_TYPE_CHILD_CONFLICT", fmt.Sprintf("下级组织 %s（%s）不允许挂在 %s 类型之下", child.Code, child.UnitType, def.Code), "unitType", def.Code, map[string]interface{}{
+					"childCode": child.Code,
+					"childType": child.UnitType,
+				}), nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func unitTypeOutcome(code, message, field string, value interface{}, extra map[string]interface{}) *RuleOutcome {
+	ctx := map[string]interface{}{"ruleId": "ORG-UNIT-TYPE"}
+	for k, val := range extra {
+		ctx[k] = val
+	}
+	return &RuleOutcome{
+		Errors: []ValidationError{{
+			Code:     code,
+			Message:  message,
+			Field:    field,
+			Value:    value,
+			Severity: string(SeverityHigh),
+			Context:  ctx,
+		}},
+	}
+}
+
+func derefParentCode(parentCode *string) string {
+	if parentCode == nil {
+		return ""
+	}
+	trimmed := strings.TrimSpace(*parentCode)
+	if utils.IsRootParentCode(trimmed) {
+		return ""
+	}
+	return trimmed
+}
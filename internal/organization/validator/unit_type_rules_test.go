@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"cube-castle/internal/organization/repository"
+	"cube-castle/internal/organization/unittype"
+	"cube-castle/internal/types"
+	"github.com/google/uuid"
+)
+
+type stubUnitTypeSource struct {
+	defs []unittype.Definition
+}
+
+func (s stubUnitTypeSource) ListUnitTypes(context.Context, uuid.UUID) ([]unittype.Definition, error) {
+	return unittype.Effective(s.defs), nil
+}
+
+func regionBranchSource() stubUnitTypeSource {
+	branchDepth := 3
+	return stubUnitTypeSource{defs: []unittype.Definition{
+		{Code: "REGION", Name: "Region", AllowedParentTypes: []string{"COMPANY"}, Active: true},
+		{Code: "BRANCH", Name: "Branch", AllowedParentTypes: []string{"REGION"}, MaxDepth: &branchDepth, Active: true},
+		{Code: "LEGACY", Name: "Legacy", AllowRoot: true, Active: false},
+	}}
+}
+
+func firstErrorCode(result *ValidationResult) string {
+	if len(result.Errors) == 0 {
+		return ""
+	}
+	return result.Errors[0].Code
+}
+
+func TestOrganizationCreateUnitTypeRules(t *testing.T) {
+	h := &stubHierarchy{
+		orgs: map[string]*types.Organization{
+			"1000001": {Code: "1000001", UnitType: "COMPANY", Level: 1, Status: "ACTIVE"},
+			"1000002": {Code: "1000002", UnitType: "REGION", Level: 2, Status: "ACTIVE"},
+			"1000003": {Code: "1000003", UnitType: "REGION", Level: 3, Status: "ACTIVE"},
+		},
+		depths: map[string]int{"1000001": 1, "1000002": 2, "1000003": 3},
+	}
+
+	cases := []struct {
+		name     string
+		unitType string
+		parent   *string
+		want     string
+	}{
+		{name: "branch under region", unitType: "branch", parent: strPtr("1000002")},
+		{name: "builtin keeps working", unitType: "DEPARTMENT", parent: strPtr("1000002")},
+		{name: "unknown type", unitType: "DIVISION", want: "ORG_UNIT_TYPE_INVALID"},
+		{name: "inactive type", unitType: "LEGACY", want: "ORG_UNIT_TYPE_INVALID"},
+		{name: "branch under company", unitType: "BRANCH", parent: strPtr("1000001"), want: "ORG_UNIT_TYPE_PARENT_NOT_ALLOWED"},
+		{name: "region as root", unitType: "REGION", want: "ORG_UNIT_TYPE_ROOT_NOT_ALLOWED"},
+		{name: "branch too deep", unitType: "BRANCH", parent: strPtr("1000003"), want: "ORG_UNIT_TYPE_DEPTH_EXCEEDED"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(h)
+			validator.SetUnitTypeSource(regionBranchSource())
+
+			req := &types.CreateOrganizationRequest{Name: "Unit", UnitType: tc.unitType, ParentCode: tc.parent}
+			result := validator.ValidateOrganizationCreation(context.Background(), req, uuid.New())
+			if got := firstErrorCode(result); got != tc.want {
+				t.Fatalf("expected %q, got %#v", tc.want, result.Errors)
+			}
+		})
+	}
+}
+
+func TestOrganizationUpdateUnitTypeChildConflict(t *testing.T) {
+	h := &stubHierarchy{
+		orgs: map[string]*types.Organization{
+			"1000001": {Code: "1000001", UnitType: "COMPANY", Level: 1, Status: "ACTIVE"},
+			"1000002": {Code: "1000002", UnitType: "REGION", ParentCode: strPtr("1000001"), Level: 2, Status: "ACTIVE"},
+		},
+		children: map[string][]repository.OrganizationNode{
+			"1000002": {{Code: "1000003", UnitType: "BRANCH", Status: "ACTIVE"}},
+		},
+	}
+	validator := newTestValidator(h)
+	validator.SetUnitTypeSource(regionBranchSource())
+
+	result := validator.ValidateOrganizationUpdate(context.Background(), "1000002", &types.UpdateOrganizationRequest{UnitType: strPtr("DEPARTMENT")}, uuid.New())
+	if got := firstErrorCode(result); got != "ORG_UNIT_TYPE_CHILD_CONFLICT" {
+		t.Fatalf("expected child conflict, got %#v", result.Errors)
+	}
+
+	result = validator.ValidateOrganizationUpdate(context.Background(), "1000002", &types.UpdateOrganizationRequest{Name: strPtr("Renamed")}, uuid.New())
+	if !result.Valid {
+		t.Fatalf("expected update without type or parent change to skip unit type rule: %#v", result.Errors)
+	}
+}
+
+func TestOrganizationVersionUnitTypeRules(t *testing.T) {
+	h := &stubHierarchy{
+		orgs: map[string]*types.Organization{
+			"1000001": {Code: "1000001", UnitType: "COMPANY", Level: 1, Status: "ACTIVE"},
+		},
+	}
+	validator := newTestValidator(h)
+	validator.SetUnitTypeSource(regionBranchSource())
+	existing := &types.Organization{Code: "1000009", UnitType: "REGION", ParentCode: strPtr("1000001")}
+
+	result := validator.ValidateOrganizationVersion(context.Background(), uuid.New(), existing, "BRANCH", existing.ParentCode, nil)
+	if got := firstErrorCode(result); got != "ORG_UNIT_TYPE_PARENT_NOT_ALLOWED" {
+		t.Fatalf("expected parent type violation, got %#v", result.Errors)
+	}
+
+	result = validator.ValidateOrganizationVersion(context.Background(), uuid.New(), existing, "region", existing.ParentCode, nil)
+	if !result.Valid {
+		t.Fatalf("expected version to pass: %#v", result.Errors)
+	}
+}
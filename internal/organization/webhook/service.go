@@ -0,0 +1,556 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"cube-castle/internal/organization/events"
+	"cube-castle/pkg/eventbus"
+	pkglogger "cube-castle/pkg/logger"
+	"cube-castle/pkg/tracing"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrWorkerAlreadyRunning 投递循环已启动
+	ErrWorkerAlreadyRunning = errors.New("webhook worker already running")
+	// ErrWorkerNotRunning 投递循环未启动
+	ErrWorkerNotRunning = errors.New("webhook worker not running")
+)
+
+// Service 端点管理、事件入队与投递
+type Service struct {
+	store        Store
+	client       *http.Client
+	cfg          Config
+	logger       pkglogger.Logger
+	now          func() time.Time
+	retryBackoff time.Duration
+	kick         chan struct{}
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewService 创建 webhook 服务；client 为空时使用 NewClient(cfg)
+func NewService(store Store, cfg Config, client *http.Client, baseLogger pkglogger.Logger) *Service {
+	if baseLogger == nil {
+		baseLogger = pkglogger.NewNoopLogger()
+	}
+	cfg = cfg.normalized()
+	if client == nil {
+		client = NewClient(cfg)
+	}
+	return &Service{
+		store:        store,
+		client:       client,
+		cfg:          cfg,
+		now:          time.Now,
+		retryBackoff: defaultRetryBackoff,
+		kick:         make(chan struct{}, 1),
+		logger: baseLogger.WithFields(pkglogger.Fields{
+			"component": "webhook",
+			"module":    "command",
+		}),
+	}
+}
+
+// NewClient 创建出站投递客户端：按 cfg.Timeout 超时、不跟随重定向，且除非 cfg.AllowPrivateTargets，
+// 拨号前校验解析后的目标 IP。业务通知的 webhook 渠道复用该客户端。
+func NewClient(cfg Config) *http.Client {
+	cfg = cfg.normalized()
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: newTransport(cfg),
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// newTransport 投递用 Transport：不走环境代理，拨号时在 DNS 解析之后检查实际连接的 IP，避免 DNS 重绑定绕过端点校验
+func newTransport(cfg Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	dialer := &net.Dialer{Timeout: cfg.Timeout, KeepAlive: 30 * time.Second}
+	if !cfg.AllowPrivateTargets {
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || blockedIP(ip) {
+				return fmt.Errorf("%w: %s", ErrBlockedTarget, host)
+			}
+			return nil
+		}
+	}
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+func masked(e *Endpoint) *Endpoint {
+	e.Secret = ""
+	return e
+}
+
+// ListEndpoints 列出租户端点（不含密钥）
+func (s *Service) ListEndpoints(ctx context.Context, tenantID uuid.UUID) ([]Endpoint, error) {
+	endpoints, err := s.store.ListEndpoints(ctx, tenantID, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range endpoints {
+		masked(&endpoints[i])
+	}
+	return endpoints, nil
+}
+
+// GetEndpoint 读取单个端点（不含密钥）
+func (s *Service) GetEndpoint(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	e, err := s.store.GetEndpoint(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return masked(e), nil
+}
+
+// CreateEndpoint 校验并创建端点，返回值携带新生成的签名密钥
+func (s *Service) CreateEndpoint(ctx context.Context, e Endpoint) (*Endpoint, error) {
+	if err := e.Validate(s.cfg); err != nil {
+		return nil, err
+	}
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC().Truncate(time.Microsecond)
+	e.ID = uuid.New()
+	e.Secret = secret
+	e.CreatedAt = now
+	e.UpdatedAt = now
+	if err := s.store.SaveEndpoint(ctx, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// UpdateEndpoint 更新端点的 URL、事件过滤、描述与启用状态（密钥与创建信息保持不变）
+func (s *Service) UpdateEndpoint(ctx context.Context, e Endpoint) (*Endpoint, error) {
+	existing, err := s.store.GetEndpoint(ctx, e.TenantID, e.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.Validate(s.cfg); err != nil {
+		return nil, err
+	}
+	e.Secret = existing.Secret
+	e.CreatedBy = existing.CreatedBy
+	e.CreatedAt = existing.CreatedAt
+	e.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.store.SaveEndpoint(ctx, &e); err != nil {
+		return nil, err
+	}
+	return masked(&e), nil
+}
+
+// RotateSecret 为端点生成新密钥，返回值携带新密钥；旧密钥立即失效
+func (s *Service) RotateSecret(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	e, err := s.store.GetEndpoint(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Secret, err = GenerateSecret(); err != nil {
+		return nil, err
+	}
+	e.UpdatedAt = s.now().UTC().Truncate(time.Microsecond)
+	if err := s.store.SaveEndpoint(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// DeleteEndpoint 删除端点
+func (s *Service) DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error {
+	return s.store.DeleteEndpoint(ctx, tenantID, id)
+}
+
+// ListDeliveries 列出投递日志
+func (s *Service) ListDeliveries(ctx context.Context, tenantID uuid.UUID, filter DeliveryFilter) ([]Delivery, error) {
+	return s.store.ListDeliveries(ctx, tenantID, filter)
+}
+
+// GetDelivery 读取投递记录及逐次尝试日志
+func (s *Service) GetDelivery(ctx context.Context, tenantID, id uuid.UUID) (*Delivery, error) {
+	return s.store.GetDelivery(ctx, tenantID, id)
+}
+
+// Subscribe 在事件总线上为全部 outbox 事件类型注册入队处理器
+func (s *Service) Subscribe(bus eventbus.EventBus) error {
+	for _, eventType := range events.PublishedEventTypes {
+		if err := bus.Subscribe(eventType, s.HandleEvent); err != nil {
+			return fmt.Errorf("subscribe %s: %w", eventType, err)
+		}
+	}
+	return nil
+}
+
+type payloadEvent interface {
+	Payload() json.RawMessage
+}
+
+// envelope 推送给接收方的请求体
+type envelope struct {
+	ID            string                     `json:"id"`
+	Type          string                     `json:"type"`
+	TenantID      string                     `json:"tenantId"`
+	AggregateType string                     `json:"aggregateType,omitempty"`
+	AggregateID   string                     `json:"aggregateId,omitempty"`
+	OccurredAt    string                     `json:"occurredAt"`
+	Data          map[string]json.RawMessage `json:"data"`
+}
+
+// HandleEvent 将 outbox 事件写入每个匹配端点的投递队列；只入队不发送，避免慢端点阻塞 outbox 派发
+func (s *Service) HandleEvent(ctx context.Context, event eventbus.Event) error {
+	pe, ok := event.(payloadEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event payload type %T", event)
+	}
+	raw := pe.Payload()
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("decode %s payload: %w", event.EventType(), err)
+	}
+	var tenant string
+	_ = json.Unmarshal(data["tenantId"], &tenant)
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		return fmt.Errorf("invalid tenantId in %s: %w", event.EventType(), err)
+	}
+
+	endpoints, err := s.store.ListEndpoints(ctx, tenantID, true)
+	if err != nil {
+		return err
+	}
+	var matched []Endpoint
+	for _, e := range endpoints {
+		if e.Accepts(event.EventType()) {
+			matched = append(matched, e)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	env := envelope{
+		ID:          eventID(event, raw),
+		Type:        event.EventType(),
+		TenantID:    tenantID.String(),
+		AggregateID: event.AggregateID(),
+		Data:        data,
+	}
+	if typed, ok := event.(interface{ AggregateType() string }); ok {
+		env.AggregateType = typed.AggregateType()
+	}
+	_ = json.Unmarshal(data["occurredAt"], &env.OccurredAt)
+	if env.OccurredAt == "" {
+		env.OccurredAt = s.now().UTC().Format(time.RFC3339Nano)
+	}
+	delete(data, tracing.PayloadHeadersKey)
+	body, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("marshal webhook envelope: %w", err)
+	}
+
+	created := 0
+	for _, e := range matched {
+		claimed, err := s.enqueue(ctx, e, env.ID, env.Type, body, s.now().UTC())
+		if err != nil {
+			return err
+		}
+		if claimed != nil {
+			created++
+		}
+	}
+	if created > 0 {
+		s.wake()
+	}
+	return nil
+}
+
+// eventID 优先使用 outbox event_id；缺失时按事件内容生成稳定 ID，保证重复发布仍可去重
+func eventID(event eventbus.Event, payload []byte) string {
+	if withID, ok := event.(interface{ EventID() string }); ok && withID.EventID() != "" {
+		return withID.EventID()
+	}
+	sum := sha256.New()
+	sum.Write([]byte(event.EventType()))
+	sum.Write([]byte{0})
+	sum.Write([]byte(event.AggregateID()))
+	sum.Write([]byte{0})
+	sum.Write(payload)
+	return "sha256-" + hex.EncodeToString(sum.Sum(nil))[:32]
+}
+
+func (s *Service) enqueue(ctx context.Context, e Endpoint, eventID, eventType string, body []byte, nextAttemptAt time.Time) (*Delivery, error) {
+	now := s.now().UTC()
+	d := &Delivery{
+		ID:            uuid.New(),
+		TenantID:      e.TenantID,
+		EndpointID:    e.ID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       body,
+		Status:        DeliveryPending,
+		NextAttemptAt: nextAttemptAt,
+		CreatedAt:     now,
+	}
+	claimed, err := s.store.ClaimDelivery(ctx, d)
+	if err != nil || !claimed {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *Service) wake() {
+	select {
+	case s.kick <- struct{}{}:
+	default:
+	}
+}
+
+// SendTestEvent 向端点同步发送一条 webhook.test 事件并返回投递结果（端点停用时同样发送）
+func (s *Service) SendTestEvent(ctx context.Context, tenantID, endpointID uuid.UUID) (*Delivery, error) {
+	e, err := s.store.GetEndpoint(ctx, tenantID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	id := "test-" + uuid.NewString()
+	now := s.now().UTC()
+	body, err := json.Marshal(envelope{
+		ID:         id,
+		Type:       EventTest,
+		TenantID:   tenantID.String(),
+		OccurredAt: now.Format(time.RFC3339Nano),
+		Data: map[string]json.RawMessage{
+			"endpointId": json.RawMessage(`"` + endpointID.String() + `"`),
+			"message":    json.RawMessage(`"Cube Castle webhook test"`),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal webhook test event: %w", err)
+	}
+	// 同步发送：入队时即持有租约，避免后台循环同时领取
+	d, err := s.enqueue(ctx, *e, id, EventTest, body, now.Add(deliveryLease))
+	if err != nil {
+		return nil, err
+	}
+	s.attempt(ctx, d, e, true)
+	return d, nil
+}
+
+// Redeliver 立即重新发送一条投递（不论当前状态），结果计入尝试日志
+func (s *Service) Redeliver(ctx context.Context, tenantID, deliveryID uuid.UUID) (*Delivery, error) {
+	d, err := s.store.GetDelivery(ctx, tenantID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.store.GetEndpoint(ctx, tenantID, d.EndpointID)
+	if err != nil {
+		return nil, err
+	}
+	s.attempt(ctx, d, e, true)
+	return d, nil
+}
+
+// DeliverDue 投递到期的待发送与失败记录，返回本轮处理数
+func (s *Service) DeliverDue(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	due, err := s.store.LeaseDueDeliveries(ctx, now, now.Add(deliveryLease), deliverBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	endpoints := make(map[uuid.UUID]*Endpoint)
+	for i, d := range due {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		e, ok := endpoints[d.EndpointID]
+		if !ok {
+			e, err = s.store.GetEndpoint(ctx, d.TenantID, d.EndpointID)
+			if err != nil && !errors.Is(err, ErrEndpointNotFound) {
+				return i, err
+			}
+			endpoints[d.EndpointID] = e
+		}
+		if e == nil || !e.Enabled {
+			s.abandon(ctx, d, "endpoint disabled or removed")
+			continue
+		}
+		s.attempt(ctx, d, e, false)
+	}
+	return len(due), nil
+}
+
+// abandon 端点已停用时直接将投递标记为 dead，重新启用后可通过手动重投补发
+func (s *Service) abandon(ctx context.Context, d *Delivery, reason string) {
+	d.Status = DeliveryDead
+	d.LastError = reason
+	if err := s.store.RecordAttempt(ctx, d, Attempt{Attempt: d.Attempts, Error: reason, AttemptedAt: s.now().UTC()}); err != nil {
+		s.logger.WithFields(pkglogger.Fields{"deliveryId": d.ID.String(), "error": err}).Error("mark webhook delivery dead failed")
+	}
+}
+
+// attempt 签名并发送一次，记录尝试日志；失败按指数退避安排重试，达到上限后标记为 dead
+func (s *Service) attempt(ctx context.Context, d *Delivery, e *Endpoint, manual bool) {
+	logger := s.logger.WithFields(pkglogger.Fields{
+		"deliveryId": d.ID.String(),
+		"endpointId": e.ID.String(),
+		"tenantId":   d.TenantID.String(),
+		"eventType":  d.EventType,
+		"manual":     manual,
+	})
+	started := s.now()
+	code, respBody, err := s.post(ctx, d, e)
+	now := s.now().UTC()
+
+	d.Attempts++
+	result := Attempt{
+		Attempt:      d.Attempts,
+		Manual:       manual,
+		ResponseBody: respBody,
+		DurationMs:   now.Sub(started).Milliseconds(),
+		AttemptedAt:  now,
+	}
+	if code > 0 {
+		result.ResponseCode = &code
+		d.LastResponseCode = &code
+	}
+	if err == nil {
+		d.Status = DeliverySucceeded
+		d.LastError = ""
+		d.DeliveredAt = &now
+	} else {
+		result.Error = err.Error()
+		d.LastError = err.Error()
+		d.Status = DeliveryFailed
+		if d.Attempts >= s.cfg.MaxAttempts {
+			d.Status = DeliveryDead
+		}
+		d.NextAttemptAt = now.Add(s.backoff(d.Attempts))
+	}
+	d.AttemptLog = append(d.AttemptLog, result)
+	if recordErr := s.store.RecordAttempt(ctx, d, result); recordErr != nil {
+		logger.WithFields(pkglogger.Fields{"error": recordErr}).Error("record webhook attempt failed")
+	}
+	if err != nil {
+		logger.WithFields(pkglogger.Fields{"error": err, "attempts": d.Attempts, "status": d.Status}).Warn("webhook delivery failed")
+	}
+}
+
+func (s *Service) post(ctx context.Context, d *Delivery, e *Endpoint) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, "", fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Cube-Castle-Webhooks/1.0")
+	req.Header.Set("Idempotency-Key", d.ID.String())
+	req.Header.Set(EventHeader, d.EventType)
+	req.Header.Set(DeliveryHeader, d.ID.String())
+	req.Header.Set(SignatureHeader, Sign(e.Secret, s.now(), d.Payload))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	body := strings.ToValidUTF8(string(snippet), "")
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, body, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, body, nil
+}
+
+func (s *Service) backoff(attempts int) time.Duration {
+	backoff := s.retryBackoff
+	for i := 1; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// Start 启动后台投递循环：按 PollInterval 轮询，新事件入队时立即唤醒
+func (s *Service) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return ErrWorkerAlreadyRunning
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.wg.Add(1)
+	go s.loop(runCtx)
+	s.logger.Info("webhook delivery worker started")
+	return nil
+}
+
+// Stop 停止投递循环并等待进行中的投递结束
+func (s *Service) Stop() error {
+	s.mu.Lock()
+	if s.cancel == nil {
+		s.mu.Unlock()
+		return ErrWorkerNotRunning
+	}
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+
+	cancel()
+	s.wg.Wait()
+	s.logger.Info("webhook delivery worker stopped")
+	return nil
+}
+
+func (s *Service) loop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.kick:
+		}
+		for {
+			processed, err := s.DeliverDue(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.WithFields(pkglogger.Fields{"error": err}).Error("webhook delivery batch failed")
+				}
+				break
+			}
+			if processed < deliverBatchSize {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cube-castle/internal/organization/events"
+	"cube-castle/pkg/eventbus"
+	"github.com/google/uuid"
+)
+
+type memStore struct {
+	endpoints  []Endpoint
+	deliveries []*Delivery
+	attempts   map[uuid.UUID][]Attempt
+}
+
+func (m *memStore) ListEndpoints(_ context.Context, tenantID uuid.UUID, enabledOnly bool) ([]Endpoint, error) {
+	var out []Endpoint
+	for _, e := range m.endpoints {
+		if e.TenantID == tenantID && (!enabledOnly || e.Enabled) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) GetEndpoint(_ context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	for _, e := range m.endpoints {
+		if e.TenantID == tenantID && e.ID == id {
+			copied := e
+			return &copied, nil
+		}
+	}
+	return nil, ErrEndpointNotFound
+}
+
+func (m *memStore) SaveEndpoint(_ context.Context, e *Endpoint) error {
+	for i := range m.endpoints {
+		if m.endpoints[i].ID == e.ID {
+			m.endpoints[i] = *e
+			return nil
+		}
+	}
+	m.endpoints = append(m.endpoints, *e)
+	return nil
+}
+
+func (m *memStore) DeleteEndpoint(_ context.Context, tenantID, id uuid.UUID) error {
+	for i := range m.endpoints {
+		if m.endpoints[i].TenantID == tenantID && m.endpoints[i].ID == id {
+			m.endpoints = append(m.endpoints[:i], m.endpoints[i+1:]...)
+			return nil
+		}
+	}
+	return ErrEndpointNotFound
+}
+
+func (m *memStore) ClaimDelivery(_ context.Context, d *Delivery) (bool, error) {
+	for _, existing := range m.deliveries {
+		if existing.EndpointID == d.EndpointID && existing.EventID == d.EventID {
+			return false, nil
+		}
+	}
+	copied := *d
+	m.deliveries = append(m.deliveries, &copied)
+	return true, nil
+}
+
+func (m *memStore) LeaseDueDeliveries(_ context.Context, now, leaseUntil time.Time, limit int) ([]*Delivery, error) {
+	var out []*Delivery
+	for _, d := range m.deliveries {
+		if (d.Status == DeliveryPending || d.Status == DeliveryFailed) && !d.NextAttemptAt.After(now) && len(out) < limit {
+			d.NextAttemptAt = leaseUntil
+			copied := *d
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) GetDelivery(_ context.Context, tenantID, id uuid.UUID) (*Delivery, error) {
+	for _, d := range m.deliveries {
+		if d.TenantID == tenantID && d.ID == id {
+			copied := *d
+			copied.AttemptLog = append([]Attempt(nil), m.attempts[id]...)
+			return &copied, nil
+		}
+	}
+	return nil, ErrDeliveryNotFound
+}
+
+func (m *memStore) ListDeliveries(_ context.Context, tenantID uuid.UUID, filter DeliveryFilter) ([]Delivery, error) {
+	var out []Delivery
+	for _, d := range m.deliveries {
+		if d.TenantID == tenantID && (filter.EndpointID == nil || d.EndpointID == *filter.EndpointID) && (filter.Status == "" || d.Status == filter.Status) {
+			out = append(out, *d)
+		}
+	}
+	return out, nil
+}
+
+func (m *memStore) RecordAttempt(_ context.Context, d *Delivery, a Attempt) error {
+	if m.attempts == nil {
+		m.attempts = map[uuid.UUID][]Attempt{}
+	}
+	m.attempts[d.ID] = append(m.attempts[d.ID], a)
+	for _, stored := range m.deliveries {
+		if stored.ID == d.ID {
+			stored.Status = d.Status
+			stored.Attempts = d.Attempts
+			stored.NextAttemptAt = d.NextAttemptAt
+			stored.LastResponseCode = d.LastResponseCode
+			stored.LastError = d.LastError
+			if d.DeliveredAt != nil {
+				stored.DeliveredAt = d.DeliveredAt
+			}
+		}
+	}
+	return nil
+}
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	status []int
+	got    []received
+}
+
+// newReceiver 按 statuses 顺序返回响应码，用尽后返回 200
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+	rc := &receiver{status: statuses}
+	rc.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rc.mu.Lock()
+		rc.got = append(rc.got, received{header: r.Header.Clone(), body: body})
+		code := http.StatusOK
+		if len(rc.status) > 0 {
+			code, rc.status = rc.status[0], rc.status[1:]
+		}
+		rc.mu.Unlock()
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(`{"ok":` + map[bool]string{true: "true", false: "false"}[code < 300] + `}`))
+	}))
+	t.Cleanup(rc.server.Close)
+	return rc
+}
+
+func (rc *receiver) requests() []received {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]received(nil), rc.got...)
+}
+
+var testTenant = uuid.MustParse("3b99930c-4dc6-4cc9-8e4d-7d960a931cb9")
+
+func newTestService(t *testing.T, store *memStore, rc *receiver) (*Service, *time.Time) {
+	t.Helper()
+	now := time.Now().UTC().Truncate(time.Second)
+	var client *http.Client
+	if rc != nil {
+		client = rc.server.Client()
+	}
+	svc := NewService(store, Config{MaxAttempts: 3, AllowPrivateTargets: true}, client, nil)
+	svc.now = func() time.Time { return now }
+	return svc, &now
+}
+
+func createEndpoint(t *testing.T, svc *Service, url string, eventTypes ...string) *Endpoint {
+	t.Helper()
+	e, err := svc.CreateEndpoint(context.Background(), Endpoint{TenantID: testTenant, URL: url, EventTypes: eventTypes, Enabled: true})
+	if err != nil {
+		t.Fatalf("CreateEndpoint: %v", err)
+	}
+	return e
+}
+
+func outboxEvent(t *testing.T, eventID, eventType string, tenantID uuid.UUID) eventbus.GenericJSONEvent {
+	t.Helper()
+	payload, _ := json.Marshal(map[string]interface{}{
+		"tenantId":     tenantID.String(),
+		"positionCode": "P1000001",
+		"occurredAt":   "2025-11-26T08:00:00Z",
+		"headers":      map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
+	})
+	return eventbus.NewGenericJSONEvent(eventType, "P1000001", "position", payload).WithEventID(eventID)
+}
+
+func TestEndpointValidateAndAccepts(t *testing.T) {
+	valid := Endpoint{URL: "https://hooks.example.com/hrms", EventTypes: []string{"assignment.*", events.EventPositionCreated}}
+	if err := valid.Validate(Config{}); err != nil {
+		t.Fatalf("expected valid endpoint, got %v", err)
+	}
+	for name, e := range map[string]Endpoint{
+		"http without opt-in": {URL: "http://hooks.example.com", EventTypes: []string{"*"}},
+		"relative url":        {URL: "/hooks", EventTypes: []string{"*"}},
+		"no event types":      {URL: "https://hooks.example.com"},
+		"unknown event":       {URL: "https://hooks.example.com", EventTypes: []string{"employee.created"}},
+		"unknown prefix":      {URL: "https://hooks.example.com", EventTypes: []string{"employee.*"}},
+	} {
+		if err := e.Validate(Config{}); !errors.Is(err, ErrInvalidEndpoint) {
+			t.Errorf("%s: expected ErrInvalidEndpoint, got %v", name, err)
+		}
+	}
+	if err := (Endpoint{URL: "http://hooks.example.com/hook", EventTypes: []string{"*"}}).Validate(Config{AllowHTTP: true}); err != nil {
+		t.Fatalf("http must be allowed when opted in, got %v", err)
+	}
+	if err := (Endpoint{URL: "http://localhost:9000/hook", EventTypes: []string{"*"}}).Validate(Config{AllowHTTP: true, AllowPrivateTargets: true}); err != nil {
+		t.Fatalf("private targets must be allowed when opted in, got %v", err)
+	}
+
+	if !valid.Accepts(events.EventAssignmentFilled) || !valid.Accepts(events.EventPositionCreated) {
+		t.Fatalf("expected prefix and exact filters to match")
+	}
+	if valid.Accepts(events.EventPositionUpdated) || valid.Accepts("assignmentx.filled") {
+		t.Fatalf("unexpected match")
+	}
+	if !(Endpoint{EventTypes: []string{"*"}}).Accepts(events.EventOrganizationSuspended) {
+		t.Fatalf("wildcard must match every event")
+	}
+}
+
+func TestEndpointValidateRejectsPrivateTargets(t *testing.T) {
+	for _, target := range []string{
+		"https://localhost/hook",
+		"https://api.localhost/hook",
+		"https://127.0.0.1/hook",
+		"https://10.0.0.5/hook",
+		"https://172.16.3.4/hook",
+		"https://192.168.1.10/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://metadata.google.internal/computeMetadata/v1",
+		"https://100.64.0.1/hook",
+		"https://0.0.0.0/hook",
+		"https://[::1]/hook",
+		"https://[fd00:ec2::254]/hook",
+		"https://[::ffff:127.0.0.1]/hook",
+	} {
+		err := (Endpoint{URL: target, EventTypes: []string{"*"}}).Validate(Config{})
+		if !errors.Is(err, ErrInvalidEndpoint) || !errors.Is(err, ErrBlockedTarget) {
+			t.Errorf("%s: expected blocked target, got %v", target, err)
+		}
+	}
+	if err := (Endpoint{URL: "https://8.8.8.8/hook", EventTypes: []string{"*"}}).Validate(Config{}); err != nil {
+		t.Fatalf("public address must be accepted, got %v", err)
+	}
+}
+
+func TestDeliveryDialRejectsResolvedPrivateAddress(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte("internal secret"))
+	}))
+	t.Cleanup(server.Close)
+
+	store := &memStore{}
+	svc := NewService(store, Config{AllowHTTP: true, MaxAttempts: 3}, nil, nil)
+	// 模拟 DNS 重绑定：域名在创建时通过校验，投递时解析到回环地址
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	endpoint := Endpoint{ID: uuid.New(), TenantID: testTenant, URL: "http://localhost:" + port + "/hook", EventTypes: []string{"*"}, Enabled: true}
+	store.endpoints = append(store.endpoints, endpoint)
+
+	d, err := svc.SendTestEvent(context.Background(), testTenant, endpoint.ID)
+	if err != nil {
+		t.Fatalf("SendTestEvent: %v", err)
+	}
+	if atomic.LoadInt32(&hits) != 0 {
+		t.Fatalf("expected dial to be blocked before reaching the target")
+	}
+	if d.Status != DeliveryFailed || !strings.Contains(d.LastError, ErrBlockedTarget.Error()) {
+		t.Fatalf("expected blocked target failure, got status=%s err=%q", d.Status, d.LastError)
+	}
+	if len(d.AttemptLog) != 1 || d.AttemptLog[0].ResponseBody != "" {
+		t.Fatalf("expected no response body recorded, got %+v", d.AttemptLog)
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1764144000, 0)
+	body := []byte(`{"id":"evt-1"}`)
+	header := Sign("whsec_test", now, body)
+	if !strings.HasPrefix(header, "t=1764144000,v1=") {
+		t.Fatalf("unexpected signature header %q", header)
+	}
+	if err := VerifySignature("whsec_test", header, body, now.Add(time.Minute)); err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+	for name, check := range map[string]error{
+		"tampered body": VerifySignature("whsec_test", header, []byte(`{"id":"evt-2"}`), now),
+		"wrong secret":  VerifySignature("whsec_other", header, body, now),
+		"replayed":      VerifySignature("whsec_test", header, body, now.Add(10*time.Minute)),
+		"malformed":     VerifySignature("whsec_test", "v1=abc", body, now),
+	} {
+		if !errors.Is(check, ErrInvalidSignature) {
+			t.Errorf("%s: expected ErrInvalidSignature, got %v", name, check)
+		}
+	}
+}
+
+func TestEndpointSecretIsOnlyReturnedOnCreateAndRotate(t *testing.T) {
+	store := &memStore{}
+	svc, _ := newTestService(t, store, nil)
+	created := createEndpoint(t, svc, "https://hooks.example.com", "*")
+	if !strings.HasPrefix(created.Secret, secretPrefix) {
+		t.Fatalf("expected generated secret, got %q", created.Secret)
+	}
+	listed, _ := svc.ListEndpoints(context.Background(), testTenant)
+	if len(listed) != 1 || listed[0].Secret != "" {
+		t.Fatalf("list must not expose secrets: %+v", listed)
+	}
+	updated, err := svc.UpdateEndpoint(context.Background(), Endpoint{ID: created.ID, TenantID: testTenant, URL: "https://hooks.example.com/v2", EventTypes: []string{"position.*"}})
+	if err != nil || updated.Secret != "" || store.endpoints[0].Secret != created.Secret {
+		t.Fatalf("update must keep the stored secret without returning it: %+v err=%v", updated, err)
+	}
+	rotated, err := svc.RotateSecret(context.Background(), testTenant, created.ID)
+	if err != nil || rotated.Secret == created.Secret || store.endpoints[0].Secret != rotated.Secret {
+		t.Fatalf("rotate must replace the secret: %+v err=%v", rotated, err)
+	}
+}
+
+func TestHandleEventEnqueuesMatchingEndpointsOnce(t *testing.T) {
+	store := &memStore{}
+	svc, _ := newTestService(t, store, nil)
+	positions := createEndpoint(t, svc, "https://a.example.com", "position.*")
+	createEndpoint(t, svc, "https://b.example.com", events.EventAssignmentFilled)
+	disabled := createEndpoint(t, svc, "https://c.example.com", "*")
+	store.endpoints[2].Enabled = false
+	otherTenant := uuid.New()
+	store.endpoints = append(store.endpoints, Endpoint{ID: uuid.New(), TenantID: otherTenant, URL: "https://d.example.com", EventTypes: []string{"*"}, Enabled: true})
+
+	bus := eventbus.NewMemoryEventBus(nil, nil)
+	if err := svc.Subscribe(bus); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	event := outboxEvent(t, "evt-1", events.EventPositionCreated, testTenant)
+	for i := 0; i < 2; i++ {
+		if err := bus.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	if len(store.deliveries) != 1 {
+		t.Fatalf("expected a single delivery, got %d", len(store.deliveries))
+	}
+	d := store.deliveries[0]
+	if d.EndpointID != positions.ID || d.EndpointID == disabled.ID || d.EventID != "evt-1" || d.Status != DeliveryPending {
+		t.Fatalf("unexpected delivery %+v", d)
+	}
+	var env map[string]interface{}
+	if err := json.Unmarshal(d.Payload, &env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	data := env["data"].(map[string]interface{})
+	if env["id"] != "evt-1" || env["type"] != events.EventPositionCreated || env["aggregateType"] != "position" || env["occurredAt"] != "2025-11-26T08:00:00Z" {
+		t.Fatalf("unexpected envelope %v", env)
+	}
+	if _, leaked := data["headers"]; leaked || data["positionCode"] != "P1000001" {
+		t.Fatalf("trace headers must be stripped from data: %v", data)
+	}
+}
+
+func TestDeliverDueSignsRetriesAndDies(t *testing.T) {
+	rc := newReceiver(t, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable)
+	store := &memStore{}
+	svc, now := newTestService(t, store, rc)
+	endpoint := createEndpoint(t, svc, rc.server.URL, "*")
+	if err := svc.HandleEvent(context.Background(), outboxEvent(t, "evt-1", events.EventAssignmentFilled, testTenant)); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		if processed, err := svc.DeliverDue(context.Background()); err != nil || processed != 1 {
+			t.Fatalf("attempt %d: processed=%d err=%v", attempt, processed, err)
+		}
+		d := store.deliveries[0]
+		if d.Attempts != attempt {
+			t.Fatalf("expected %d attempts, got %d", attempt, d.Attempts)
+		}
+		if attempt < 3 {
+			if d.Status != DeliveryFailed || !d.NextAttemptAt.Equal(now.Add(svc.backoff(attempt))) {
+				t.Fatalf("attempt %d: unexpected retry schedule %+v", attempt, d)
+			}
+			if processed, _ := svc.DeliverDue(context.Background()); processed != 0 {
+				t.Fatalf("delivery must wait for its backoff")
+			}
+			*now = d.NextAttemptAt
+		}
+	}
+	d := store.deliveries[0]
+	if d.Status != DeliveryDead || d.LastResponseCode == nil || *d.LastResponseCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected dead delivery with last response 503, got %+v", d)
+	}
+	log := store.attempts[d.ID]
+	if len(log) != 3 || *log[0].ResponseCode != 500 || log[0].ResponseBody != `{"ok":false}` {
+		t.Fatalf("unexpected attempt log %+v", log)
+	}
+
+	reqs := rc.requests()
+	if len(reqs) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(reqs))
+	}
+	for _, req := range reqs {
+		if err := VerifySignature(endpoint.Secret, req.header.Get(SignatureHeader), req.body, *now); err != nil {
+			t.Fatalf("receiver could not verify signature: %v", err)
+		}
+		if req.header.Get("Idempotency-Key") != d.ID.String() || req.header.Get(EventHeader) != events.EventAssignmentFilled {
+			t.Fatalf("unexpected headers %v", req.header)
+		}
+	}
+
+	// 手动重投不受重试上限约束
+	redelivered, err := svc.Redeliver(context.Background(), testTenant, d.ID)
+	if err != nil {
+		t.Fatalf("Redeliver: %v", err)
+	}
+	if redelivered.Status != DeliverySucceeded || redelivered.DeliveredAt == nil || len(redelivered.AttemptLog) != 4 || !redelivered.AttemptLog[3].Manual {
+		t.Fatalf("unexpected redelivery result %+v", redelivered)
+	}
+	if string(rc.requests()[3].body) != string(reqs[0].body) {
+		t.Fatalf("redelivery must resend the original payload")
+	}
+}
+
+func TestSendTestEventIsSynchronous(t *testing.T) {
+	rc := newReceiver(t, http.StatusNoContent)
+	store := &memStore{}
+	svc, _ := newTestService(t, store, rc)
+	endpoint := createEndpoint(t, svc, rc.server.URL, events.EventAssignmentFilled)
+
+	d, err := svc.SendTestEvent(context.Background(), testTenant, endpoint.ID)
+	if err != nil {
+		t.Fatalf("SendTestEvent: %v", err)
+	}
+	if d.Status != DeliverySucceeded || d.EventType != EventTest || *d.LastResponseCode != http.StatusNoContent || !strings.HasPrefix(d.EventID, "test-") {
+		t.Fatalf("unexpected test delivery %+v", d)
+	}
+	// 测试事件已同步发送，后台循环不应重复领取
+	if processed, _ := svc.DeliverDue(context.Background()); processed != 0 {
+		t.Fatalf("test delivery must not be picked up again, got %d", processed)
+	}
+	if _, err := svc.SendTestEvent(context.Background(), testTenant, uuid.New()); !errors.Is(err, ErrEndpointNotFound) {
+		t.Fatalf("expected ErrEndpointNotFound, got %v", err)
+	}
+}
+
+func TestDeliverDueAbandonsDisabledEndpoint(t *testing.T) {
+	rc := newReceiver(t)
+	store := &memStore{}
+	svc, _ := newTestService(t, store, rc)
+	createEndpoint(t, svc, rc.server.URL, "*")
+	if err := svc.HandleEvent(context.Background(), outboxEvent(t, "evt-1", events.EventPositionUpdated, testTenant)); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	store.endpoints[0].Enabled = false
+
+	if _, err := svc.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+	if store.deliveries[0].Status != DeliveryDead || len(rc.requests()) != 0 {
+		t.Fatalf("expected dead delivery without HTTP call, got %+v", store.deliveries[0])
+	}
+}
+
+func TestWorkerDeliversAfterEnqueue(t *testing.T) {
+	rc := newReceiver(t)
+	store := &memStore{}
+	svc, _ := newTestService(t, store, rc)
+	svc.cfg.PollInterval = time.Hour
+	createEndpoint(t, svc, rc.server.URL, "*")
+
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := svc.Start(context.Background()); !errors.Is(err, ErrWorkerAlreadyRunning) {
+		t.Fatalf("expected ErrWorkerAlreadyRunning, got %v", err)
+	}
+	if err := svc.HandleEvent(context.Background(), outboxEvent(t, "evt-1", events.EventPositionUpdated, testTenant)); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rc.requests()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := svc.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if len(rc.requests()) != 1 {
+		t.Fatalf("expected the worker to deliver immediately, got %d requests", len(rc.requests()))
+	}
+}
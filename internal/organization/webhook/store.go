@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SQLStore 基于 PostgreSQL 的 webhook 存储
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore 创建 webhook 存储
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+const endpointColumns = `id, tenant_id, url, secret, event_types, COALESCE(description, ''),
+	enabled, COALESCE(created_by, ''), created_at, updated_at`
+
+func scanEndpoint(row interface{ Scan(...any) error }) (*Endpoint, error) {
+	var e Endpoint
+	if err := row.Scan(&e.ID, &e.TenantID, &e.URL, &e.Secret, pq.Array(&e.EventTypes), &e.Description,
+		&e.Enabled, &e.CreatedBy, &e.CreatedAt, &e.UpdatedAt); err != nil {
+		return nil, err
+	}
+	e.CreatedAt = e.CreatedAt.UTC()
+	e.UpdatedAt = e.UpdatedAt.UTC()
+	return &e, nil
+}
+
+// ListEndpoints 列出租户端点
+func (s *SQLStore) ListEndpoints(ctx context.Context, tenantID uuid.UUID, enabledOnly bool) ([]Endpoint, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+endpointColumns+`
+	FROM webhook_endpoints
+	WHERE tenant_id = $1 AND (NOT $2 OR enabled)
+	ORDER BY created_at, id`, tenantID, enabledOnly)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+	var endpoints []Endpoint
+	for rows.Next() {
+		e, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, *e)
+	}
+	return endpoints, rows.Err()
+}
+
+// GetEndpoint 读取单个端点
+func (s *SQLStore) GetEndpoint(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error) {
+	e, err := scanEndpoint(s.db.QueryRowContext(ctx, `
+	SELECT `+endpointColumns+`
+	FROM webhook_endpoints WHERE tenant_id = $1 AND id = $2`, tenantID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEndpointNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load webhook endpoint: %w", err)
+	}
+	return e, nil
+}
+
+// SaveEndpoint 创建或更新端点
+func (s *SQLStore) SaveEndpoint(ctx context.Context, e *Endpoint) error {
+	_, err := s.db.ExecContext(ctx, `
+	INSERT INTO webhook_endpoints
+		(id, tenant_id, url, secret, event_types, description, enabled, created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, NULLIF($8, ''), $9, $10)
+	ON CONFLICT (id) DO UPDATE SET
+		url = EXCLUDED.url,
+		secret = EXCLUDED.secret,
+		event_types = EXCLUDED.event_types,
+		description = EXCLUDED.description,
+		enabled = EXCLUDED.enabled,
+		updated_at = EXCLUDED.updated_at
+	WHERE webhook_endpoints.tenant_id = EXCLUDED.tenant_id`,
+		e.ID, e.TenantID, e.URL, e.Secret, pq.Array(e.EventTypes), e.Description,
+		e.Enabled, e.CreatedBy, e.CreatedAt, e.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// DeleteEndpoint 删除端点（投递日志随之级联删除）
+func (s *SQLStore) DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM webhook_endpoints WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		return fmt.Errorf("delete webhook endpoint: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrEndpointNotFound
+	}
+	return nil
+}
+
+// ClaimDelivery 插入投递记录，依赖 (endpoint_id, event_id) 唯一约束保证 outbox 重发时不重复投递
+func (s *SQLStore) ClaimDelivery(ctx context.Context, d *Delivery) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `
+	INSERT INTO webhook_deliveries
+		(id, tenant_id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, 0, $8, $9)
+	ON CONFLICT (endpoint_id, event_id) DO NOTHING`,
+		d.ID, d.TenantID, d.EndpointID, d.EventID, d.EventType, []byte(d.Payload), d.Status, d.NextAttemptAt, d.CreatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("insert webhook delivery: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+const deliveryColumns = `id, tenant_id, endpoint_id, event_id, event_type, payload, status, attempts,
+	next_attempt_at, last_response_code, COALESCE(last_error, ''), created_at, delivered_at`
+
+func scanDelivery(row interface{ Scan(...any) error }) (*Delivery, error) {
+	var (
+		d       Delivery
+		payload []byte
+		code    sql.NullInt32
+	)
+	if err := row.Scan(&d.ID, &d.TenantID, &d.EndpointID, &d.EventID, &d.EventType, &payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &code, &d.LastError, &d.CreatedAt, &d.DeliveredAt); err != nil {
+		return nil, err
+	}
+	d.Payload = payload
+	if code.Valid {
+		c := int(code.Int32)
+		d.LastResponseCode = &c
+	}
+	d.NextAttemptAt = d.NextAttemptAt.UTC()
+	d.CreatedAt = d.CreatedAt.UTC()
+	return &d, nil
+}
+
+// LeaseDueDeliveries 以 SKIP LOCKED 领取到期投递并顺延下次尝试时间，进程中断时租约到期后自动重新领取
+func (s *SQLStore) LeaseDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*Delivery, error) {
+	rows, err := s.db.QueryContext(ctx, `
+	UPDATE webhook_deliveries SET next_attempt_at = $2
+	WHERE id IN (
+		SELECT id FROM webhook_deliveries
+		WHERE status IN ('pending', 'failed') AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING `+deliveryColumns, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("lease webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+	var due []*Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		due = append(due, d)
+	}
+	return due, rows.Err()
+}
+
+// GetDelivery 读取投递记录及其尝试日志
+func (s *SQLStore) GetDelivery(ctx context.Context, tenantID, id uuid.UUID) (*Delivery, error) {
+	d, err := scanDelivery(s.db.QueryRowContext(ctx, `
+	SELECT `+deliveryColumns+`
+	FROM webhook_deliveries WHERE tenant_id = $1 AND id = $2`, tenantID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load webhook delivery: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT attempt, manual, response_code, COALESCE(response_body, ''), COALESCE(error, ''), duration_ms, attempted_at
+	FROM webhook_delivery_attempts WHERE delivery_id = $1
+	ORDER BY attempt, id`, id)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook delivery attempts: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			a    Attempt
+			code sql.NullInt32
+		)
+		if err := rows.Scan(&a.Attempt, &a.Manual, &code, &a.ResponseBody, &a.Error, &a.DurationMs, &a.AttemptedAt); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery attempt: %w", err)
+		}
+		if code.Valid {
+			c := int(code.Int32)
+			a.ResponseCode = &c
+		}
+		a.AttemptedAt = a.AttemptedAt.UTC()
+		d.AttemptLog = append(d.AttemptLog, a)
+	}
+	return d, rows.Err()
+}
+
+// ListDeliveries 按时间倒序列出投递记录（不含尝试日志）
+func (s *SQLStore) ListDeliveries(ctx context.Context, tenantID uuid.UUID, filter DeliveryFilter) ([]Delivery, error) {
+	var endpointID interface{}
+	if filter.EndpointID != nil {
+		endpointID = *filter.EndpointID
+	}
+	rows, err := s.db.QueryContext(ctx, `
+	SELECT `+deliveryColumns+`
+	FROM webhook_deliveries
+	WHERE tenant_id = $1
+	  AND ($2::uuid IS NULL OR endpoint_id = $2)
+	  AND ($3 = '' OR status = $3)
+	ORDER BY created_at DESC, id
+	LIMIT $4`, tenantID, endpointID, filter.Status, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+	var deliveries []Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, *d)
+	}
+	return deliveries, rows.Err()
+}
+
+// RecordAttempt 在同一事务内写入尝试日志并更新投递状态
+func (s *SQLStore) RecordAttempt(ctx context.Context, d *Delivery, a Attempt) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin webhook attempt tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO webhook_delivery_attempts
+		(delivery_id, attempt, manual, response_code, response_body, error, duration_ms, attempted_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), $7, $8)`,
+		d.ID, a.Attempt, a.Manual, a.ResponseCode, a.ResponseBody, a.Error, a.DurationMs, a.AttemptedAt,
+	); err != nil {
+		return fmt.Errorf("insert webhook attempt: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE webhook_deliveries
+	SET status = $2, attempts = $3, next_attempt_at = $4, last_response_code = $5,
+		last_error = NULLIF($6, ''), delivered_at = COALESCE($7, delivered_at)
+	WHERE id = $1`,
+		d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.LastResponseCode, d.LastError, d.DeliveredAt,
+	); err != nil {
+		return fmt.Errorf("update webhook delivery: %w", err)
+	}
+	return tx.Commit()
+}
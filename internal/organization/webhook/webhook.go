@@ -0,0 +1,312 @@
+// Package webhook 实现租户出站 webhook：订阅 outbox 事件，按端点过滤后以 HMAC-SHA256 签名推送并记录投递日志。
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cube-castle/internal/organization/events"
+	"github.com/google/uuid"
+)
+
+const (
+	// EventTest 手动发送的测试事件类型
+	EventTest = "webhook.test"
+
+	// SignatureHeader 签名头，格式 t=<unix 秒>,v1=<hex(HMAC-SHA256(secret, "<t>.<body>"))>
+	SignatureHeader = "X-Cube-Castle-Signature"
+	// EventHeader 事件类型头
+	EventHeader = "X-Cube-Castle-Event"
+	// DeliveryHeader 投递 ID 头
+	DeliveryHeader = "X-Cube-Castle-Delivery"
+
+	// DeliveryPending 等待首次投递
+	DeliveryPending = "pending"
+	// DeliverySucceeded 接收方返回 2xx
+	DeliverySucceeded = "succeeded"
+	// DeliveryFailed 投递失败，等待退避重试
+	DeliveryFailed = "failed"
+	// DeliveryDead 重试耗尽
+	DeliveryDead = "dead"
+)
+
+const (
+	defaultMaxAttempts  = 8
+	defaultRetryBackoff = 30 * time.Second
+	maxRetryBackoff     = time.Hour
+	defaultTimeout      = 10 * time.Second
+	defaultPollInterval = 5 * time.Second
+	deliveryLease       = 2 * time.Minute
+	deliverBatchSize    = 50
+	maxResponseBody     = 2 << 10
+	secretPrefix        = "whsec_"
+	signatureTolerance  = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidEndpoint 端点参数不合法
+	ErrInvalidEndpoint = errors.New("invalid webhook endpoint")
+	// ErrEndpointNotFound 端点不存在
+	ErrEndpointNotFound = errors.New("webhook endpoint not found")
+	// ErrDeliveryNotFound 投递记录不存在
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrInvalidSignature 签名头缺失、过期或不匹配
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrBlockedTarget 端点指向回环、私有、链路本地或云元数据地址
+	ErrBlockedTarget = errors.New("webhook target address is not allowed")
+)
+
+// Config 投递参数
+type Config struct {
+	// AllowHTTP 允许非 TLS 的 http:// 端点（仅用于开发环境）
+	AllowHTTP bool
+	// AllowPrivateTargets 允许回环/私有网段端点（仅用于开发与测试环境）
+	AllowPrivateTargets bool
+	Timeout             time.Duration
+	MaxAttempts         int
+	PollInterval        time.Duration
+}
+
+// LoadConfigFromEnv 从 WEBHOOK_* 环境变量读取配置，未设置项使用默认值
+func LoadConfigFromEnv() Config {
+	cfg := Config{
+		AllowHTTP:           os.Getenv("WEBHOOK_ALLOW_HTTP") == "true",
+		AllowPrivateTargets: os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS") == "true",
+	}
+	if timeout, err := time.ParseDuration(os.Getenv("WEBHOOK_TIMEOUT")); err == nil {
+		cfg.Timeout = timeout
+	}
+	if attempts, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS")); err == nil {
+		cfg.MaxAttempts = attempts
+	}
+	if interval, err := time.ParseDuration(os.Getenv("WEBHOOK_POLL_INTERVAL")); err == nil {
+		cfg.PollInterval = interval
+	}
+	return cfg
+}
+
+func (c Config) normalized() Config {
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultPollInterval
+	}
+	return c
+}
+
+// Endpoint 租户配置的 webhook 端点
+type Endpoint struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenantId"`
+	URL      string    `json:"url"`
+	// Secret 仅在创建与轮换时返回，其余响应中置空
+	Secret string `json:"secret,omitempty"`
+	// EventTypes 事件类型过滤：精确类型、前缀通配（assignment.*）或 * 表示全部
+	EventTypes  []string  `json:"eventTypes"`
+	Description string    `json:"description,omitempty"`
+	Enabled     bool      `json:"enabled"`
+	CreatedBy   string    `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Accepts 判断端点是否订阅给定事件类型
+func (e Endpoint) Accepts(eventType string) bool {
+	for _, filter := range e.EventTypes {
+		switch {
+		case filter == "*", filter == eventType:
+			return true
+		case strings.HasSuffix(filter, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(filter, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 校验端点参数；cfg.AllowHTTP 为 false 时仅接受 https，cfg.AllowPrivateTargets 为 false 时拒绝内网地址。
+// 此处仅能拦截字面量地址，域名解析后的地址由投递客户端的拨号检查兜底（防 DNS 重绑定）。
+func (e Endpoint) Validate(cfg Config) error {
+	u, err := url.Parse(strings.TrimSpace(e.URL))
+	if err != nil || u.Host == "" {
+		return errors.Join(ErrInvalidEndpoint, errors.New("url must be an absolute http(s) URL"))
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !cfg.AllowHTTP {
+			return errors.Join(ErrInvalidEndpoint, errors.New("url must use https"))
+		}
+	default:
+		return errors.Join(ErrInvalidEndpoint, errors.New("url must be an absolute http(s) URL"))
+	}
+	if !cfg.AllowPrivateTargets {
+		if err := CheckTargetHost(u.Hostname()); err != nil {
+			return errors.Join(ErrInvalidEndpoint, err)
+		}
+	}
+	if len(e.EventTypes) == 0 {
+		return errors.Join(ErrInvalidEndpoint, errors.New("eventTypes is required"))
+	}
+	for _, filter := range e.EventTypes {
+		if !validFilter(filter) {
+			return errors.Join(ErrInvalidEndpoint, fmt.Errorf("unsupported event type %q", filter))
+		}
+	}
+	return nil
+}
+
+// blockedHostnames 常见云厂商元数据服务域名
+var blockedHostnames = []string{"metadata", "metadata.google.internal", "metadata.goog"}
+
+// carrierGradeNAT 100.64.0.0/10 共享地址空间，net.IP.IsPrivate 不覆盖
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// CheckTargetHost 拒绝 localhost、元数据域名以及落在受限网段的 IP 字面量
+func CheckTargetHost(host string) error {
+	name := strings.TrimSuffix(strings.ToLower(host), ".")
+	if name == "localhost" || strings.HasSuffix(name, ".localhost") || slices.Contains(blockedHostnames, name) {
+		return fmt.Errorf("%w: %s", ErrBlockedTarget, host)
+	}
+	if ip := net.ParseIP(name); ip != nil && blockedIP(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedTarget, host)
+	}
+	return nil
+}
+
+// blockedIP 回环、私有（RFC1918 / ULA）、链路本地（含 169.254.169.254 元数据地址）、未指定、组播与 CGNAT 地址
+func blockedIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || carrierGradeNAT.Contains(ip)
+}
+
+func validFilter(filter string) bool {
+	if filter == "*" || slices.Contains(events.PublishedEventTypes, filter) {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(filter, ".*"); ok && prefix != "" {
+		for _, eventType := range events.PublishedEventTypes {
+			if strings.HasPrefix(eventType, prefix+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Delivery 一次事件到一个端点的投递记录
+type Delivery struct {
+	ID               uuid.UUID       `json:"id"`
+	TenantID         uuid.UUID       `json:"tenantId"`
+	EndpointID       uuid.UUID       `json:"endpointId"`
+	EventID          string          `json:"eventId"`
+	EventType        string          `json:"eventType"`
+	Payload          json.RawMessage `json:"payload"`
+	Status           string          `json:"status"`
+	Attempts         int             `json:"attempts"`
+	NextAttemptAt    time.Time       `json:"nextAttemptAt"`
+	LastResponseCode *int            `json:"lastResponseCode,omitempty"`
+	LastError        string          `json:"lastError,omitempty"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	DeliveredAt      *time.Time      `json:"deliveredAt,omitempty"`
+	AttemptLog       []Attempt       `json:"attemptLog,omitempty"`
+}
+
+// Attempt 单次 HTTP 尝试的结果
+type Attempt struct {
+	Attempt      int       `json:"attempt"`
+	Manual       bool      `json:"manual"`
+	ResponseCode *int      `json:"responseCode,omitempty"`
+	ResponseBody string    `json:"responseBody,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	DurationMs   int64     `json:"durationMs"`
+	AttemptedAt  time.Time `json:"attemptedAt"`
+}
+
+// DeliveryFilter 投递日志查询条件
+type DeliveryFilter struct {
+	EndpointID *uuid.UUID
+	Status     string
+	Limit      int
+}
+
+// Store webhook 持久化
+type Store interface {
+	ListEndpoints(ctx context.Context, tenantID uuid.UUID, enabledOnly bool) ([]Endpoint, error)
+	GetEndpoint(ctx context.Context, tenantID, id uuid.UUID) (*Endpoint, error)
+	SaveEndpoint(ctx context.Context, endpoint *Endpoint) error
+	DeleteEndpoint(ctx context.Context, tenantID, id uuid.UUID) error
+
+	// ClaimDelivery 按 (端点, 事件 ID) 插入投递记录；已存在时返回 false
+	ClaimDelivery(ctx context.Context, delivery *Delivery) (bool, error)
+	// LeaseDueDeliveries 取出到期投递并顺延 next_attempt_at 至 leaseUntil，避免多实例重复投递
+	LeaseDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*Delivery, error)
+	GetDelivery(ctx context.Context, tenantID, id uuid.UUID) (*Delivery, error)
+	ListDeliveries(ctx context.Context, tenantID uuid.UUID, filter DeliveryFilter) ([]Delivery, error)
+	// RecordAttempt 写入尝试日志并更新投递状态
+	RecordAttempt(ctx context.Context, delivery *Delivery, attempt Attempt) error
+}
+
+// GenerateSecret 生成 32 字节随机签名密钥
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
+
+// Sign 计算签名头取值；接收方以相同密钥对 "<t>.<原始请求体>" 做 HMAC-SHA256 校验
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + computeMAC(secret, t, body)
+}
+
+func computeMAC(secret, t string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature 校验签名头，时间戳与 now 相差超过 5 分钟视为重放
+func VerifySignature(secret, header string, body []byte, now time.Time) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	ts, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return ErrInvalidSignature
+	}
+	if skew := now.Sub(time.Unix(ts, 0)); skew > signatureTolerance || skew < -signatureTolerance {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(v1), []byte(computeMAC(secret, t, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
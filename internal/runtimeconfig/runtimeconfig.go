@@ -0,0 +1,602 @@
+// Package runtimeconfig 提供无需重启即可调整的运行时配置：按组件日志级别、功能开关、
+// 限流参数与缓存 TTL。覆盖项写入 Redis 并通过 pub/sub 广播，所有副本收敛到同一版本。
+package runtimeconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cube-castle/internal/organization/middleware"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// StateKey Redis 中保存当前覆盖项的键
+	StateKey = "runtime-config:state"
+	// Channel 变更广播频道
+	Channel = "runtime-config:changes"
+
+	defaultResyncInterval = 30 * time.Second
+	maxApplyRetries       = 10
+)
+
+var (
+	// ErrInvalidChange 变更内容校验失败。
+	ErrInvalidChange = errors.New("invalid runtime config change")
+	// ErrConcurrentChange 多次重试后仍与其他副本的写入冲突。
+	ErrConcurrentChange = errors.New("runtime config changed concurrently")
+)
+
+// RateLimitOverrides 限流参数覆盖，零值表示沿用启动配置。
+type RateLimitOverrides struct {
+	RequestsPerMinute int    `json:"requestsPerMinute,omitempty"`
+	BurstSize         int    `json:"burstSize,omitempty"`
+	BlockDuration     string `json:"blockDuration,omitempty"`
+}
+
+// Overrides 相对启动配置的全部覆盖项，是持久化与广播的单位。
+type Overrides struct {
+	Version   int64              `json:"version"`
+	UpdatedAt time.Time          `json:"updatedAt,omitempty"`
+	UpdatedBy string             `json:"updatedBy,omitempty"`
+	LogLevels map[string]string  `json:"logLevels,omitempty"`
+	Features  map[string]bool    `json:"features,omitempty"`
+	RateLimit RateLimitOverrides `json:"rateLimit"`
+	CacheTTLs map[string]string  `json:"cacheTtls,omitempty"`
+}
+
+// RateLimitChange 限流参数变更：nil 保持不变，零值恢复启动配置。
+type RateLimitChange struct {
+	RequestsPerMinute *int    `json:"requestsPerMinute,omitempty"`
+	BurstSize         *int    `json:"burstSize,omitempty"`
+	BlockDuration     *string `json:"blockDuration,omitempty"`
+}
+
+// Change 一次增量变更（PATCH 语义）。
+//   - LogLevels：组件/模块名 → 级别，键 "default" 调整基础级别，空串清除覆盖
+//   - Features：功能名 → 开关，null 恢复默认
+//   - CacheTTLs：缓存名 → Go duration，空串恢复默认
+//   - Reset：先清除全部覆盖再应用本次变更
+type Change struct {
+	LogLevels map[string]string `json:"logLevels,omitempty"`
+	Features  map[string]*bool  `json:"features,omitempty"`
+	RateLimit *RateLimitChange  `json:"rateLimit,omitempty"`
+	CacheTTLs map[string]string `json:"cacheTtls,omitempty"`
+	Reset     bool              `json:"reset,omitempty"`
+}
+
+// FeatureState 功能开关状态。
+type FeatureState struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Default     bool   `json:"default"`
+}
+
+// RateLimitState 当前生效的限流参数。
+type RateLimitState struct {
+	RequestsPerMinute int    `json:"requestsPerMinute"`
+	BurstSize         int    `json:"burstSize"`
+	BlockDuration     string `json:"blockDuration"`
+}
+
+// CacheTTLState 缓存 TTL 状态。
+type CacheTTLState struct {
+	Name    string `json:"name"`
+	TTL     string `json:"ttl"`
+	Default string `json:"default"`
+}
+
+// LogLevelState 日志级别状态。
+type LogLevelState struct {
+	Default   string            `json:"default"`
+	Overrides map[string]string `json:"overrides"`
+}
+
+// Snapshot 当前生效配置与覆盖项。
+type Snapshot struct {
+	InstanceID  string          `json:"instanceId"`
+	Propagation string          `json:"propagation"`
+	Overrides   Overrides       `json:"overrides"`
+	LogLevels   LogLevelState   `json:"logLevels"`
+	Features    []FeatureState  `json:"features"`
+	RateLimit   *RateLimitState `json:"rateLimit,omitempty"`
+	CacheTTLs   []CacheTTLState `json:"cacheTtls"`
+}
+
+// RateLimitTarget 可在运行时更新配置的限流器。
+type RateLimitTarget interface {
+	Config() *middleware.RateLimitConfig
+	UpdateConfig(config *middleware.RateLimitConfig)
+}
+
+type feature struct {
+	description string
+	def         bool
+	current     bool
+	apply       func(enabled bool)
+}
+
+type cacheTTL struct {
+	def     time.Duration
+	current time.Duration
+	set     func(time.Duration)
+}
+
+type changeMessage struct {
+	InstanceID string `json:"instanceId"`
+	Version    int64  `json:"version"`
+}
+
+// Manager 持有运行时可调整项的注册表与当前覆盖项。
+type Manager struct {
+	instanceID string
+	redis      *redis.Client
+	logger     pkglogger.Logger
+	levels     *pkglogger.LevelController
+	baseLevel  pkglogger.Level
+
+	mu            sync.Mutex
+	overrides     Overrides
+	features      map[string]*feature
+	rateLimit     RateLimitTarget
+	rateLimitBase middleware.RateLimitConfig
+	caches        map[string]*cacheTTL
+
+	resyncInterval time.Duration
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+// NewManager 创建运行时配置管理器；redisClient 为空时仅作用于本进程。
+func NewManager(levels *pkglogger.LevelController, redisClient *redis.Client, logger pkglogger.Logger) *Manager {
+	if logger == nil {
+		logger = pkglogger.NewNoopLogger()
+	}
+	if levels == nil {
+		levels = pkglogger.NewLevelController()
+	}
+	return &Manager{
+		instanceID:     uuid.NewString(),
+		redis:          redisClient,
+		logger:         logger.WithFields(pkglogger.Fields{"component": "runtime-config"}),
+		levels:         levels,
+		baseLevel:      levels.BaseLevel(),
+		features:       make(map[string]*feature),
+		caches:         make(map[string]*cacheTTL),
+		resyncInterval: defaultResyncInterval,
+	}
+}
+
+// RegisterFeature 注册功能开关；enabled 为启动时状态，apply 在状态变化时调用。
+func (m *Manager) RegisterFeature(name, description string, enabled bool, apply func(enabled bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.features[name] = &feature{description: description, def: enabled, current: enabled, apply: apply}
+}
+
+// RegisterRateLimit 注册限流器，以其当前配置作为默认值。
+func (m *Manager) RegisterRateLimit(target RateLimitTarget) {
+	if target == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rateLimit = target
+	if cfg := target.Config(); cfg != nil {
+		m.rateLimitBase = *cfg
+	}
+}
+
+// RegisterCacheTTL 注册可调整 TTL 的缓存。
+func (m *Manager) RegisterCacheTTL(name string, current time.Duration, set func(time.Duration)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.caches[name] = &cacheTTL{def: current, current: current, set: set}
+}
+
+// Snapshot 返回当前状态。
+func (m *Manager) Snapshot() Snapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.snapshotLocked()
+}
+
+func (m *Manager) snapshotLocked() Snapshot {
+	snap := Snapshot{
+		InstanceID:  m.instanceID,
+		Propagation: "local",
+		Overrides:   cloneOverrides(m.overrides),
+		LogLevels: LogLevelState{
+			Default:   m.levels.BaseLevel().String(),
+			Overrides: m.levels.Overrides(),
+		},
+		Features:  make([]FeatureState, 0, len(m.features)),
+		CacheTTLs: make([]CacheTTLState, 0, len(m.caches)),
+	}
+	if m.redis != nil {
+		snap.Propagation = "redis"
+	}
+	for _, name := range sortedKeys(m.features) {
+		f := m.features[name]
+		snap.Features = append(snap.Features, FeatureState{Name: name, Description: f.description, Enabled: f.current, Default: f.def})
+	}
+	if m.rateLimit != nil {
+		if cfg := m.rateLimit.Config(); cfg != nil {
+			snap.RateLimit = &RateLimitState{
+				RequestsPerMinute: cfg.RequestsPerMinute,
+				BurstSize:         cfg.BurstSize,
+				BlockDuration:     cfg.BlockDuration.String(),
+			}
+		}
+	}
+	for _, name := range sortedKeys(m.caches) {
+		c := m.caches[name]
+		snap.CacheTTLs = append(snap.CacheTTLs, CacheTTLState{Name: name, TTL: c.current.String(), Default: c.def.String()})
+	}
+	return snap
+}
+
+// Apply 校验并应用变更：先持久化到 Redis，再在本地生效并广播给其他副本。
+func (m *Manager) Apply(ctx context.Context, change Change, actor string) (Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.validateLocked(change); err != nil {
+		return Snapshot{}, err
+	}
+
+	next := nextOverrides(m.overrides, change, actor)
+	if m.redis != nil {
+		persisted, err := m.persist(ctx, change, actor)
+		if err != nil {
+			return Snapshot{}, err
+		}
+		next = persisted
+	}
+	m.applyLocked(next)
+
+	if m.redis != nil {
+		msg, _ := json.Marshal(changeMessage{InstanceID: m.instanceID, Version: next.Version})
+		if err := m.redis.Publish(ctx, Channel, msg).Err(); err != nil {
+			// 其他副本会在下次周期同步时收敛
+			m.logger.WithFields(pkglogger.Fields{"error": err}).Warn("publish runtime config change failed")
+		}
+	}
+	m.logger.WithFields(pkglogger.Fields{"version": next.Version, "actor": actor}).Info("runtime config updated")
+	return m.snapshotLocked(), nil
+}
+
+// persist 以 WATCH/MULTI 乐观锁写入 Redis：以存储中的最新版本为基线合并变更，
+// 若读取后其他副本已提交新版本则事务失败并基于新版本重试，保证任何一次写入都不会被覆盖。
+func (m *Manager) persist(ctx context.Context, change Change, actor string) (Overrides, error) {
+	for attempt := 0; attempt < maxApplyRetries; attempt++ {
+		var next Overrides
+		err := m.redis.Watch(ctx, func(tx *redis.Tx) error {
+			base := m.overrides
+			stored, err := m.load(ctx, tx)
+			if err != nil {
+				return err
+			}
+			if stored != nil && stored.Version > base.Version {
+				base = *stored
+			}
+			next = nextOverrides(base, change, actor)
+			payload, err := json.Marshal(next)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, StateKey, payload, 0)
+				return nil
+			})
+			return err
+		}, StateKey)
+		if err == nil {
+			return next, nil
+		}
+		if !errors.Is(err, redis.TxFailedErr) {
+			return Overrides{}, fmt.Errorf("persist runtime config: %w", err)
+		}
+		m.logger.WithFields(pkglogger.Fields{"attempt": attempt + 1}).Debug("runtime config write conflict, retrying")
+	}
+	return Overrides{}, ErrConcurrentChange
+}
+
+func nextOverrides(base Overrides, change Change, actor string) Overrides {
+	next := merge(base, change)
+	next.Version = base.Version + 1
+	next.UpdatedAt = time.Now().UTC()
+	next.UpdatedBy = actor
+	return next
+}
+
+func (m *Manager) validateLocked(change Change) error {
+	var errs []string
+	for name, level := range change.LogLevels {
+		if strings.TrimSpace(name) == "" {
+			errs = append(errs, "logLevels: component name is required")
+			continue
+		}
+		if level == "" {
+			continue
+		}
+		if _, err := pkglogger.ParseLevel(level); err != nil {
+			errs = append(errs, fmt.Sprintf("logLevels.%s: unsupported level %q", name, level))
+		}
+	}
+	for name := range change.Features {
+		if _, ok := m.features[name]; !ok {
+			errs = append(errs, fmt.Sprintf("features.%s: unknown feature", name))
+		}
+	}
+	if rl := change.RateLimit; rl != nil {
+		if m.rateLimit == nil {
+			errs = append(errs, "rateLimit: rate limiter not available")
+		}
+		if rl.RequestsPerMinute != nil && *rl.RequestsPerMinute < 0 {
+			errs = append(errs, "rateLimit.requestsPerMinute must be >= 0")
+		}
+		if rl.BurstSize != nil && *rl.BurstSize < 0 {
+			errs = append(errs, "rateLimit.burstSize must be >= 0")
+		}
+		if rl.BlockDuration != nil && *rl.BlockDuration != "" {
+			if d, err := time.ParseDuration(*rl.BlockDuration); err != nil || d <= 0 {
+				errs = append(errs, "rateLimit.blockDuration must be a positive duration")
+			}
+		}
+	}
+	for name, ttl := range change.CacheTTLs {
+		if _, ok := m.caches[name]; !ok {
+			errs = append(errs, fmt.Sprintf("cacheTtls.%s: unknown cache", name))
+			continue
+		}
+		if ttl == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(ttl); err != nil || d <= 0 {
+			errs = append(errs, fmt.Sprintf("cacheTtls.%s must be a positive duration", name))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%w: %s", ErrInvalidChange, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func merge(base Overrides, change Change) Overrides {
+	next := cloneOverrides(base)
+	if change.Reset {
+		next = Overrides{Version: base.Version}
+	}
+	for name, level := range change.LogLevels {
+		name = strings.TrimSpace(name)
+		if level == "" {
+			delete(next.LogLevels, name)
+			continue
+		}
+		if next.LogLevels == nil {
+			next.LogLevels = map[string]string{}
+		}
+		parsed, _ := pkglogger.ParseLevel(level)
+		next.LogLevels[name] = parsed.String()
+	}
+	for name, enabled := range change.Features {
+		if enabled == nil {
+			delete(next.Features, name)
+			continue
+		}
+		if next.Features == nil {
+			next.Features = map[string]bool{}
+		}
+		next.Features[name] = *enabled
+	}
+	if rl := change.RateLimit; rl != nil {
+		if rl.RequestsPerMinute != nil {
+			next.RateLimit.RequestsPerMinute = *rl.RequestsPerMinute
+		}
+		if rl.BurstSize != nil {
+			next.RateLimit.BurstSize = *rl.BurstSize
+		}
+		if rl.BlockDuration != nil {
+			next.RateLimit.BlockDuration = *rl.BlockDuration
+		}
+	}
+	for name, ttl := range change.CacheTTLs {
+		if ttl == "" {
+			delete(next.CacheTTLs, name)
+			continue
+		}
+		if next.CacheTTLs == nil {
+			next.CacheTTLs = map[string]string{}
+		}
+		next.CacheTTLs[name] = ttl
+	}
+	return next
+}
+
+// applyLocked 使本地状态与覆盖项一致；覆盖项中未注册的名称（来自其他版本副本）被忽略。
+func (m *Manager) applyLocked(o Overrides) {
+	base := m.baseLevel
+	levels := make(map[string]pkglogger.Level, len(o.LogLevels))
+	for name, text := range o.LogLevels {
+		lvl, err := pkglogger.ParseLevel(text)
+		if err != nil {
+			continue
+		}
+		if name == pkglogger.DefaultLevelKey {
+			base = lvl
+			continue
+		}
+		levels[name] = lvl
+	}
+	m.levels.SetBaseLevel(base)
+	m.levels.ReplaceOverrides(levels)
+
+	for name, f := range m.features {
+		enabled := f.def
+		if v, ok := o.Features[name]; ok {
+			enabled = v
+		}
+		if enabled != f.current {
+			if f.apply != nil {
+				f.apply(enabled)
+			}
+			f.current = enabled
+			m.logger.WithFields(pkglogger.Fields{"feature": name, "enabled": enabled}).Info("feature toggled")
+		}
+	}
+
+	if m.rateLimit != nil {
+		cfg := m.rateLimitBase
+		cfg.WhitelistIPs = append([]string(nil), m.rateLimitBase.WhitelistIPs...)
+		if o.RateLimit.RequestsPerMinute > 0 {
+			cfg.RequestsPerMinute = o.RateLimit.RequestsPerMinute
+		}
+		if o.RateLimit.BurstSize > 0 {
+			cfg.BurstSize = o.RateLimit.BurstSize
+		}
+		if d, err := time.ParseDuration(o.RateLimit.BlockDuration); err == nil && d > 0 {
+			cfg.BlockDuration = d
+		}
+		if cur := m.rateLimit.Config(); cur == nil || cur.RequestsPerMinute != cfg.RequestsPerMinute || cur.BurstSize != cfg.BurstSize || cur.BlockDuration != cfg.BlockDuration {
+			m.rateLimit.UpdateConfig(&cfg)
+		}
+	}
+
+	for name, c := range m.caches {
+		ttl := c.def
+		if d, err := time.ParseDuration(o.CacheTTLs[name]); err == nil && d > 0 {
+			ttl = d
+		}
+		if ttl != c.current {
+			if c.set != nil {
+				c.set(ttl)
+			}
+			c.current = ttl
+		}
+	}
+	m.overrides = cloneOverrides(o)
+}
+
+func (m *Manager) load(ctx context.Context, client redis.Cmdable) (*Overrides, error) {
+	data, err := client.Get(ctx, StateKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load runtime config: %w", err)
+	}
+	var o Overrides
+	if err := json.Unmarshal(data, &o); err != nil {
+		return nil, fmt.Errorf("decode runtime config: %w", err)
+	}
+	return &o, nil
+}
+
+// Sync 从 Redis 读取最新覆盖项，版本更新时在本地生效。
+func (m *Manager) Sync(ctx context.Context) error {
+	if m.redis == nil {
+		return nil
+	}
+	stored, err := m.load(ctx, m.redis)
+	if err != nil || stored == nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored.Version <= m.overrides.Version {
+		return nil
+	}
+	m.applyLocked(*stored)
+	m.logger.WithFields(pkglogger.Fields{"version": stored.Version, "updatedBy": stored.UpdatedBy}).Info("runtime config synchronized")
+	return nil
+}
+
+// Start 加载已持久化的覆盖项并订阅变更广播；另以固定周期同步以弥补丢失的消息。
+func (m *Manager) Start(ctx context.Context) {
+	if m.redis == nil {
+		return
+	}
+	if err := m.Sync(ctx); err != nil {
+		m.logger.WithFields(pkglogger.Fields{"error": err}).Warn("initial runtime config sync failed")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	sub := m.redis.Subscribe(ctx, Channel)
+	go func() {
+		defer close(m.done)
+		defer sub.Close()
+		ticker := time.NewTicker(m.resyncInterval)
+		defer ticker.Stop()
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var change changeMessage
+				if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil || change.InstanceID == m.instanceID {
+					continue
+				}
+				if err := m.Sync(ctx); err != nil {
+					m.logger.WithFields(pkglogger.Fields{"error": err}).Warn("runtime config sync failed")
+				}
+			case <-ticker.C:
+				if err := m.Sync(ctx); err != nil {
+					m.logger.WithFields(pkglogger.Fields{"error": err}).Warn("periodic runtime config sync failed")
+				}
+			}
+		}
+	}()
+}
+
+// Stop 停止订阅。
+func (m *Manager) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+}
+
+func cloneOverrides(o Overrides) Overrides {
+	dup := o
+	dup.LogLevels = cloneMap(o.LogLevels)
+	dup.Features = cloneMap(o.Features)
+	dup.CacheTTLs = cloneMap(o.CacheTTLs)
+	return dup
+}
+
+func cloneMap[V any](src map[string]V) map[string]V {
+	if src == nil {
+		return nil
+	}
+	dup := make(map[string]V, len(src))
+	for k, v := range src {
+		dup[k] = v
+	}
+	return dup
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
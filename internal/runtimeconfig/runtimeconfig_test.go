@@ -0,0 +1,217 @@
+package runtimeconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"cube-castle/internal/organization/middleware"
+	pkglogger "cube-castle/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+type fakeRateLimiter struct {
+	cfg     middleware.RateLimitConfig
+	updates int
+}
+
+func (f *fakeRateLimiter) Config() *middleware.RateLimitConfig {
+	cfg := f.cfg
+	return &cfg
+}
+
+func (f *fakeRateLimiter) UpdateConfig(cfg *middleware.RateLimitConfig) {
+	f.cfg = *cfg
+	f.updates++
+}
+
+type replica struct {
+	mgr       *Manager
+	levels    *pkglogger.LevelController
+	scheduler bool
+	limiter   *fakeRateLimiter
+	ttl       time.Duration
+}
+
+func newReplica(t *testing.T, client *redis.Client) *replica {
+	t.Helper()
+	r := &replica{
+		levels:    pkglogger.NewLevelController(),
+		scheduler: true,
+		limiter:   &fakeRateLimiter{cfg: middleware.RateLimitConfig{RequestsPerMinute: 100, BurstSize: 10, BlockDuration: time.Minute}},
+		ttl:       2 * time.Minute,
+	}
+	r.levels.SetBaseLevel(pkglogger.LevelInfo)
+	r.mgr = NewManager(r.levels, client, nil)
+	r.mgr.RegisterFeature("scheduler", "定时任务", true, func(enabled bool) { r.scheduler = enabled })
+	r.mgr.RegisterRateLimit(r.limiter)
+	r.mgr.RegisterCacheTTL("assignment-stats", r.ttl, func(d time.Duration) { r.ttl = d })
+	return r
+}
+
+func boolPtr(v bool) *bool    { return &v }
+func intPtr(v int) *int       { return &v }
+func strPtr(v string) *string { return &v }
+func newClient(t *testing.T) *redis.Client {
+	t.Helper()
+	srv := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestManagerAppliesAndRevertsChanges(t *testing.T) {
+	r := newReplica(t, nil)
+	ctx := context.Background()
+
+	snap, err := r.mgr.Apply(ctx, Change{
+		LogLevels: map[string]string{"outbox-dispatcher": "debug", "default": "warn"},
+		Features:  map[string]*bool{"scheduler": boolPtr(false)},
+		RateLimit: &RateLimitChange{RequestsPerMinute: intPtr(300), BlockDuration: strPtr("30s")},
+		CacheTTLs: map[string]string{"assignment-stats": "5m"},
+	}, "ops-user")
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if snap.Overrides.Version != 1 || snap.Overrides.UpdatedBy != "ops-user" || snap.Propagation != "local" {
+		t.Fatalf("unexpected overrides metadata: %+v", snap.Overrides)
+	}
+	if r.scheduler {
+		t.Fatal("expected scheduler feature to be disabled")
+	}
+	if r.levels.BaseLevel() != pkglogger.LevelWarn || r.levels.Overrides()["outbox-dispatcher"] != "DEBUG" {
+		t.Fatalf("unexpected levels: base=%s overrides=%v", r.levels.BaseLevel(), r.levels.Overrides())
+	}
+	if r.limiter.cfg.RequestsPerMinute != 300 || r.limiter.cfg.BurstSize != 10 || r.limiter.cfg.BlockDuration != 30*time.Second {
+		t.Fatalf("unexpected rate limit config: %+v", r.limiter.cfg)
+	}
+	if r.ttl != 5*time.Minute {
+		t.Fatalf("expected cache ttl 5m, got %s", r.ttl)
+	}
+
+	_, err = r.mgr.Apply(ctx, Change{
+		LogLevels: map[string]string{"outbox-dispatcher": ""},
+		Features:  map[string]*bool{"scheduler": nil},
+		RateLimit: &RateLimitChange{RequestsPerMinute: intPtr(0)},
+		CacheTTLs: map[string]string{"assignment-stats": ""},
+	}, "ops-user")
+	if err != nil {
+		t.Fatalf("revert: %v", err)
+	}
+	if !r.scheduler || len(r.levels.Overrides()) != 0 || r.limiter.cfg.RequestsPerMinute != 100 || r.ttl != 2*time.Minute {
+		t.Fatalf("expected overrides reverted: scheduler=%v levels=%v rate=%+v ttl=%s", r.scheduler, r.levels.Overrides(), r.limiter.cfg, r.ttl)
+	}
+	// 基础级别覆盖仍保留，reset 后恢复启动值
+	if _, err := r.mgr.Apply(ctx, Change{Reset: true}, "ops-user"); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if r.levels.BaseLevel() != pkglogger.LevelInfo {
+		t.Fatalf("expected base level restored, got %s", r.levels.BaseLevel())
+	}
+}
+
+func TestManagerRejectsInvalidChange(t *testing.T) {
+	r := newReplica(t, nil)
+	_, err := r.mgr.Apply(context.Background(), Change{
+		LogLevels: map[string]string{"handler": "verbose"},
+		Features:  map[string]*bool{"unknown": boolPtr(true)},
+		CacheTTLs: map[string]string{"assignment-stats": "-1s"},
+	}, "ops-user")
+	if !errors.Is(err, ErrInvalidChange) {
+		t.Fatalf("expected ErrInvalidChange, got %v", err)
+	}
+	if r.mgr.Snapshot().Overrides.Version != 0 {
+		t.Fatal("invalid change must not be applied")
+	}
+}
+
+func TestManagerPropagatesAcrossReplicas(t *testing.T) {
+	client := newClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := newReplica(t, client)
+	b := newReplica(t, client)
+	b.mgr.Start(ctx)
+	defer b.mgr.Stop()
+
+	if _, err := a.mgr.Apply(ctx, Change{
+		LogLevels: map[string]string{"handler": "debug"},
+		Features:  map[string]*bool{"scheduler": boolPtr(false)},
+	}, "ops-user"); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for b.mgr.Snapshot().Overrides.Version != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("replica did not receive change")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if b.levels.Overrides()["handler"] != "DEBUG" {
+		t.Fatalf("expected handler override on replica, got %v", b.levels.Overrides())
+	}
+
+	// 新启动的副本从 Redis 加载已持久化的覆盖项
+	c := newReplica(t, client)
+	c.mgr.Start(ctx)
+	defer c.mgr.Stop()
+	if c.mgr.Snapshot().Overrides.Version != 1 {
+		t.Fatalf("expected new replica to load version 1, got %d", c.mgr.Snapshot().Overrides.Version)
+	}
+
+	// 以存储中的最新版本为基线合并，不丢失其他副本的变更
+	if _, err := c.mgr.Apply(ctx, Change{CacheTTLs: map[string]string{"assignment-stats": "10m"}}, "ops-user"); err != nil {
+		t.Fatalf("apply on replica c: %v", err)
+	}
+	snap, err := a.mgr.Apply(ctx, Change{RateLimit: &RateLimitChange{BurstSize: intPtr(20)}}, "ops-user")
+	if err != nil {
+		t.Fatalf("apply on replica a: %v", err)
+	}
+	if snap.Overrides.Version != 3 || snap.Overrides.CacheTTLs["assignment-stats"] != "10m" || snap.Overrides.Features["scheduler"] {
+		t.Fatalf("expected merged overrides at version 3, got %+v", snap.Overrides)
+	}
+	if a.ttl != 10*time.Minute {
+		t.Fatalf("expected replica a to apply merged cache ttl, got %s", a.ttl)
+	}
+}
+
+func TestManagerConcurrentAppliesDoNotLoseUpdates(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	a := newReplica(t, client)
+	b := newReplica(t, client)
+
+	const perReplica = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*perReplica)
+	for name, r := range map[string]*replica{"a": a, "b": b} {
+		wg.Add(1)
+		go func(name string, r *replica) {
+			defer wg.Done()
+			for i := 0; i < perReplica; i++ {
+				if _, err := r.mgr.Apply(ctx, Change{LogLevels: map[string]string{fmt.Sprintf("%s-%d", name, i): "debug"}}, "ops-"+name); err != nil {
+					errs <- err
+				}
+			}
+		}(name, r)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("apply: %v", err)
+	}
+
+	stored, err := a.mgr.load(ctx, client)
+	if err != nil || stored == nil {
+		t.Fatalf("load stored overrides: %v", err)
+	}
+	if stored.Version != 2*perReplica || len(stored.LogLevels) != 2*perReplica {
+		t.Fatalf("expected %d versions and overrides, got version=%d overrides=%d", 2*perReplica, stored.Version, len(stored.LogLevels))
+	}
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// 自定义字段实体类型
+const (
+	CustomFieldEntityOrganization = "ORGANIZATION"
+	CustomFieldEntityPosition     = "POSITION"
+)
+
+// 自定义字段数据类型
+const (
+	CustomFieldTypeString  = "STRING"
+	CustomFieldTypeNumber  = "NUMBER"
+	CustomFieldTypeBoolean = "BOOLEAN"
+	CustomFieldTypeDate    = "DATE"
+	CustomFieldTypeEnum    = "ENUM"
+)
+
+// CustomFieldValue 自定义字段值，连同数据类型随所在时态版本一并存储（custom_fields JSONB）。
+type CustomFieldValue struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// UnmarshalJSON 兼容请求中的简写形式（直接给出标量值）与存储中的 {type, value} 形式。
+func (v *CustomFieldValue) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &envelope); err != nil {
+			return err
+		}
+		if raw, ok := envelope["value"]; ok {
+			var fieldType string
+			if rawType, ok := envelope["type"]; ok {
+				if err := json.Unmarshal(rawType, &fieldType); err != nil {
+					return fmt.Errorf("custom field type: %w", err)
+				}
+			}
+			v.Type = fieldType
+			return json.Unmarshal(raw, &v.Value)
+		}
+	}
+	v.Type = ""
+	return json.Unmarshal(trimmed, &v.Value)
+}
+
+// CustomFieldValues 自定义字段键值集合；写入请求中值为 null 表示移除该字段。
+type CustomFieldValues map[string]*CustomFieldValue
+
+// Value 实现 driver.Valuer，nil 集合写入为空对象。
+func (v CustomFieldValues) Value() (driver.Value, error) {
+	if v == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan 实现 sql.Scanner。
+func (v *CustomFieldValues) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case nil:
+		*v = CustomFieldValues{}
+		return nil
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return fmt.Errorf("unsupported custom_fields type %T", src)
+	}
+	values := CustomFieldValues{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &values); err != nil {
+			return fmt.Errorf("decode custom_fields: %w", err)
+		}
+	}
+	*v = values
+	return nil
+}
+
+// Merge 以 patch 覆盖当前值并移除 patch 中为 null 的字段，返回新集合。
+func (v CustomFieldValues) Merge(patch CustomFieldValues) CustomFieldValues {
+	merged := make(CustomFieldValues, len(v)+len(patch))
+	for key, value := range v {
+		if value != nil {
+			merged[key] = value
+		}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 多语言名称限制
+const (
+	LocalizedNameMaxLocales = 20
+	LocalizedNameMaxLength  = 255
+)
+
+var localeTagPattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// NormalizeLocale 规范化 BCP 47 语言标签：语言小写、地区大写、书写系统首字母大写（如 zh-Hant-TW）。
+// 标签格式无效时返回 false。
+func NormalizeLocale(tag string) (string, bool) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if len(tag) > 35 || !localeTagPattern.MatchString(tag) {
+		return "", false
+	}
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch part := parts[i]; {
+		case len(part) == 2:
+			parts[i] = strings.ToUpper(part)
+		case len(part) == 4 && !strings.ContainsAny(part, "0123456789"):
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+	return strings.Join(parts, "-"), true
+}
+
+// LocalizedNames 多语言名称 {locale: name}，随所在时态版本存储（*_i18n JSONB）；
+// 默认语言名称仍由 name/title 列承载，未配置的语言回落到默认名称。
+// 写入请求中值为空字符串表示移除该语言。
+type LocalizedNames map[string]string
+
+// Normalize 规范化语言标签并去除名称首尾空白，保留空值（表示移除）。
+func (n LocalizedNames) Normalize() (LocalizedNames, error) {
+	if n == nil {
+		return nil, nil
+	}
+	normalized := make(LocalizedNames, len(n))
+	for tag, name := range n {
+		locale, ok := NormalizeLocale(tag)
+		if !ok {
+			return nil, fmt.Errorf("无效的语言标签: %s", tag)
+		}
+		name = strings.TrimSpace(name)
+		if len([]rune(name)) > LocalizedNameMaxLength {
+			return nil, fmt.Errorf("语言 %s 的名称长度不能超过%d个字符", locale, LocalizedNameMaxLength)
+		}
+		normalized[locale] = name
+	}
+	if len(normalized) > LocalizedNameMaxLocales {
+		return nil, fmt.Errorf("多语言名称最多支持%d种语言", LocalizedNameMaxLocales)
+	}
+	return normalized, nil
+}
+
+// Merge 以 patch 覆盖当前值并移除 patch 中为空的语言，返回新集合。
+func (n LocalizedNames) Merge(patch LocalizedNames) LocalizedNames {
+	merged := make(LocalizedNames, len(n)+len(patch))
+	for locale, name := range n {
+		if name != "" {
+			merged[locale] = name
+		}
+	}
+	for locale, name := range patch {
+		if name == "" {
+			delete(merged, locale)
+			continue
+		}
+		merged[locale] = name
+	}
+	return merged
+}
+
+// Locales 返回已配置名称的语言标签（排序）。
+func (n LocalizedNames) Locales() []string {
+	locales := make([]string, 0, len(n))
+	for locale, name := range n {
+		if name != "" {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Lookup 按 RFC 4647 lookup 规则取单个语言的名称：完整标签 → 逐级截断子标签（zh-Hant-TW → zh-Hant → zh），
+// 均未配置时返回 fallback。
+func (n LocalizedNames) Lookup(locale, fallback string) string {
+	if name, ok := n.lookup(locale); ok {
+		return name
+	}
+	return fallback
+}
+
+// Resolve 按偏好顺序依次查找语言，全部未命中时返回 fallback（默认语言名称）。
+func (n LocalizedNames) Resolve(locales []string, fallback string) string {
+	for _, locale := range locales {
+		if name, ok := n.lookup(locale); ok {
+			return name
+		}
+	}
+	return fallback
+}
+
+func (n LocalizedNames) lookup(locale string) (string, bool) {
+	if len(n) == 0 {
+		return "", false
+	}
+	candidate, ok := NormalizeLocale(locale)
+	for ok && candidate != "" {
+		if name := n[candidate]; name != "" {
+			return name, true
+		}
+		idx := strings.LastIndex(candidate, "-")
+		if idx < 0 {
+			break
+		}
+		candidate = candidate[:idx]
+	}
+	return "", false
+}
+
+// Value 实现 driver.Valuer，nil 集合写入为空对象。
+func (n LocalizedNames) Value() (driver.Value, error) {
+	if n == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(map[string]string(n))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan 实现 sql.Scanner。
+func (n *LocalizedNames) Scan(src interface{}) error {
+	var data []byte
+	switch value := src.(type) {
+	case nil:
+		*n = LocalizedNames{}
+		return nil
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return fmt.Errorf("unsupported localized names type %T", src)
+	}
+	names := LocalizedNames{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &names); err != nil {
+			return fmt.Errorf("decode localized names: %w", err)
+		}
+	}
+	*n = names
+	return nil
+}
+
+// ParseAcceptLanguage 解析 Accept-Language 请求头，按权重降序返回规范化后的语言标签；
+// 忽略通配符、q=0 与无效标签。
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+		order  int
+	}
+	items := make([]weighted, 0, 4)
+	for idx, part := range strings.Split(header, ",") {
+		segments := strings.Split(strings.TrimSpace(part), ";")
+		locale, ok := NormalizeLocale(segments[0])
+		if !ok {
+			continue
+		}
+		q := 1.0
+		for _, param := range segments[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		items = append(items, weighted{locale: locale, q: q, order: idx})
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].q > items[j].q })
+
+	locales := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.locale]; ok {
+			continue
+		}
+		seen[item.locale] = struct{}{}
+		locales = append(locales, item.locale)
+	}
+	return locales
+}
+
+type localeContextKey struct{}
+
+// WithLocales 记录调用方偏好的语言（按优先级），供查询侧选择本地化名称。
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	if len(locales) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, localeContextKey{}, locales)
+}
+
+// WithPreferredLocale 将显式指定的语言置于已有偏好之前；标签无效时忽略。
+func WithPreferredLocale(ctx context.Context, locale string) context.Context {
+	normalized, ok := NormalizeLocale(locale)
+	if !ok {
+		return ctx
+	}
+	existing := LocalesFromContext(ctx)
+	locales := make([]string, 0, len(existing)+1)
+	locales = append(locales, normalized)
+	for _, l := range existing {
+		if l != normalized {
+			locales = append(locales, l)
+		}
+	}
+	return WithLocales(ctx, locales)
+}
+
+// LocalesFromContext 读取偏好语言列表，未设置时返回 nil（使用默认语言名称）。
+func LocalesFromContext(ctx context.Context) []string {
+	if locales, ok := ctx.Value(localeContextKey{}).([]string); ok {
+		return locales
+	}
+	return nil
+}
@@ -17,20 +17,25 @@ var DefaultTenantID = uuid.MustParse(DefaultTenantIDString)
 
 // Organization 组织业务实体
 type Organization struct {
-	RecordID    string    `json:"recordId" db:"record_id"`
-	TenantID    string    `json:"tenantId" db:"tenant_id"`
-	Code        string    `json:"code" db:"code"`
-	ParentCode  *string   `json:"parentCode,omitempty" db:"parent_code"`
-	Name        string    `json:"name" db:"name"`
-	UnitType    string    `json:"unitType" db:"unit_type"`
-	Status      string    `json:"status" db:"status"`
-	Level       int       `json:"level" db:"level"`
-	CodePath    string    `json:"codePath" db:"code_path"`
-	NamePath    string    `json:"namePath" db:"name_path"`
-	SortOrder   int       `json:"sortOrder" db:"sort_order"`
-	Description string    `json:"description" db:"description"`
-	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
-	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+	RecordID    string  `json:"recordId" db:"record_id"`
+	TenantID    string  `json:"tenantId" db:"tenant_id"`
+	Code        string  `json:"code" db:"code"`
+	ParentCode  *string `json:"parentCode,omitempty" db:"parent_code"`
+	Name        string  `json:"name" db:"name"`
+	UnitType    string  `json:"unitType" db:"unit_type"`
+	Status      string  `json:"status" db:"status"`
+	Level       int     `json:"level" db:"level"`
+	CodePath    string  `json:"codePath" db:"code_path"`
+	NamePath    string  `json:"namePath" db:"name_path"`
+	SortOrder   int     `json:"sortOrder" db:"sort_order"`
+	Description string  `json:"description" db:"description"`
+	// CustomFields 租户自定义字段值，随时态版本存储
+	CustomFields CustomFieldValues `json:"customFields,omitempty" db:"custom_fields"`
+	// NameI18n 多语言名称，NamePathI18n 为按语言计算的名称路径
+	NameI18n     LocalizedNames `json:"nameI18n,omitempty" db:"name_i18n"`
+	NamePathI18n LocalizedNames `json:"namePathI18n,omitempty" db:"name_path_i18n"`
+	CreatedAt    time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time      `json:"updatedAt" db:"updated_at"`
 	// 时态管理字段 (使用Date类型)
 	EffectiveDate *Date   `json:"effectiveDate,omitempty" db:"effective_date"`
 	EndDate       *Date   `json:"endDate,omitempty" db:"end_date"`
@@ -46,6 +51,10 @@ type CreateOrganizationRequest struct {
 	ParentCode  *string `json:"parentCode,omitempty"`
 	SortOrder   int     `json:"sortOrder"`
 	Description string  `json:"description"`
+	// CustomFields 租户自定义字段值，按字段定义校验
+	CustomFields CustomFieldValues `json:"customFields,omitempty"`
+	// NameI18n 多语言名称 {locale: name}
+	NameI18n LocalizedNames `json:"nameI18n,omitempty"`
 	// 时态管理字段 (使用Date类型)
 	EffectiveDate *Date  `json:"effectiveDate,omitempty"`
 	EndDate       *Date  `json:"endDate,omitempty"`
@@ -60,6 +69,10 @@ type UpdateOrganizationRequest struct {
 	SortOrder   *int    `json:"sortOrder,omitempty"`
 	Description *string `json:"description,omitempty"`
 	ParentCode  *string `json:"parentCode,omitempty"` // 通过修改parent_code来改变层级
+	// CustomFields 仅合并提交的字段，值为 null 表示移除
+	CustomFields CustomFieldValues `json:"customFields,omitempty"`
+	// NameI18n 仅合并提交的语言，值为空字符串表示移除
+	NameI18n LocalizedNames `json:"nameI18n,omitempty"`
 	// 时态管理字段 (使用Date类型)
 	EffectiveDate *Date   `json:"effectiveDate,omitempty"`
 	EndDate       *Date   `json:"endDate,omitempty"`
@@ -68,18 +81,21 @@ type UpdateOrganizationRequest struct {
 
 // OrganizationResponse 组织响应
 type OrganizationResponse struct {
-	Code        string    `json:"code"`
-	Name        string    `json:"name"`
-	UnitType    string    `json:"unitType"`
-	Status      string    `json:"status"`
-	Level       int       `json:"level"`
-	CodePath    string    `json:"codePath"`
-	NamePath    string    `json:"namePath"`
-	SortOrder   int       `json:"sortOrder"`
-	Description string    `json:"description"`
-	ParentCode  *string   `json:"parentCode,omitempty"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	Code         string            `json:"code"`
+	Name         string            `json:"name"`
+	UnitType     string            `json:"unitType"`
+	Status       string            `json:"status"`
+	Level        int               `json:"level"`
+	CodePath     string            `json:"codePath"`
+	NamePath     string            `json:"namePath"`
+	SortOrder    int               `json:"sortOrder"`
+	Description  string            `json:"description"`
+	ParentCode   *string           `json:"parentCode,omitempty"`
+	CustomFields CustomFieldValues `json:"customFields,omitempty"`
+	NameI18n     LocalizedNames    `json:"nameI18n,omitempty"`
+	NamePathI18n LocalizedNames    `json:"namePathI18n,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
 	// 时态管理字段 (使用Date类型)
 	EffectiveDate *Date   `json:"effectiveDate,omitempty"`
 	EndDate       *Date   `json:"endDate,omitempty"`
@@ -88,15 +104,19 @@ type OrganizationResponse struct {
 
 // CreateVersionRequest 为现有组织创建新时态版本的请求 (基于OpenAPI契约v4.4.0)
 type CreateVersionRequest struct {
-	Name            string  `json:"name" validate:"required,max=255"`
-	UnitType        string  `json:"unitType" validate:"required"`
-	ParentCode      *string `json:"parentCode,omitempty" validate:"omitempty,len=7"`
-	Description     *string `json:"description,omitempty" validate:"omitempty,max=1000"`
-	SortOrder       *int    `json:"sortOrder,omitempty"`
-	Profile         *string `json:"profile,omitempty"` // JSON string
-	EffectiveDate   string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	EndDate         *string `json:"endDate,omitempty" validate:"omitempty,datetime=2006-01-02"`
-	OperationReason string  `json:"operationReason" validate:"omitempty,max=500"`
+	Name        string  `json:"name" validate:"required,max=255"`
+	UnitType    string  `json:"unitType" validate:"required"`
+	ParentCode  *string `json:"parentCode,omitempty" validate:"omitempty,len=7"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SortOrder   *int    `json:"sortOrder,omitempty"`
+	Profile     *string `json:"profile,omitempty"` // JSON string
+	// CustomFields 在前一版本字段值基础上合并，值为 null 表示移除
+	CustomFields CustomFieldValues `json:"customFields,omitempty"`
+	// NameI18n 在前一版本多语言名称基础上合并，值为空字符串表示移除
+	NameI18n        LocalizedNames `json:"nameI18n,omitempty"`
+	EffectiveDate   string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	EndDate         *string        `json:"endDate,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	OperationReason string         `json:"operationReason" validate:"omitempty,max=500"`
 }
 
 // CreateOrganizationVersionRequest 描述旧版组织历史版本的创建请求。
@@ -9,54 +9,58 @@ import (
 
 // PositionRequest 用于创建/更新职位的请求载荷
 type PositionRequest struct {
-	Title                  string   `json:"title" validate:"required,max=120"`
-	JobProfileCode         *string  `json:"jobProfileCode,omitempty" validate:"omitempty,max=64"`
-	JobProfileName         *string  `json:"jobProfileName,omitempty" validate:"omitempty,max=255"`
-	JobFamilyGroupCode     string   `json:"jobFamilyGroupCode" validate:"required"`
-	JobFamilyGroupRecordID *string  `json:"jobFamilyGroupRecordId,omitempty"`
-	JobFamilyCode          string   `json:"jobFamilyCode" validate:"required"`
-	JobFamilyRecordID      *string  `json:"jobFamilyRecordId,omitempty"`
-	JobRoleCode            string   `json:"jobRoleCode" validate:"required"`
-	JobRoleRecordID        *string  `json:"jobRoleRecordId,omitempty"`
-	JobLevelCode           string   `json:"jobLevelCode" validate:"required"`
-	JobLevelRecordID       *string  `json:"jobLevelRecordId,omitempty"`
-	OrganizationCode       string   `json:"organizationCode" validate:"required,len=7"`
-	PositionType           string   `json:"positionType" validate:"required"`
-	Status                 *string  `json:"status,omitempty" validate:"omitempty"`
-	EmploymentType         string   `json:"employmentType" validate:"required"`
-	HeadcountCapacity      float64  `json:"headcountCapacity" validate:"required"`
-	HeadcountInUse         *float64 `json:"headcountInUse,omitempty"`
-	GradeLevel             *string  `json:"gradeLevel,omitempty" validate:"omitempty,max=20"`
-	CostCenterCode         *string  `json:"costCenterCode,omitempty" validate:"omitempty,max=50"`
-	ReportsToPositionCode  *string  `json:"reportsToPositionCode,omitempty" validate:"omitempty,len=8"`
-	Profile                *string  `json:"profile,omitempty"`
-	EffectiveDate          string   `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	OperationReason        string   `json:"operationReason" validate:"required"`
+	Title                  string            `json:"title" validate:"required,max=120"`
+	JobProfileCode         *string           `json:"jobProfileCode,omitempty" validate:"omitempty,max=64"`
+	JobProfileName         *string           `json:"jobProfileName,omitempty" validate:"omitempty,max=255"`
+	JobFamilyGroupCode     string            `json:"jobFamilyGroupCode" validate:"required"`
+	JobFamilyGroupRecordID *string           `json:"jobFamilyGroupRecordId,omitempty"`
+	JobFamilyCode          string            `json:"jobFamilyCode" validate:"required"`
+	JobFamilyRecordID      *string           `json:"jobFamilyRecordId,omitempty"`
+	JobRoleCode            string            `json:"jobRoleCode" validate:"required"`
+	JobRoleRecordID        *string           `json:"jobRoleRecordId,omitempty"`
+	JobLevelCode           string            `json:"jobLevelCode" validate:"required"`
+	JobLevelRecordID       *string           `json:"jobLevelRecordId,omitempty"`
+	OrganizationCode       string            `json:"organizationCode" validate:"required,len=7"`
+	PositionType           string            `json:"positionType" validate:"required"`
+	Status                 *string           `json:"status,omitempty" validate:"omitempty"`
+	EmploymentType         string            `json:"employmentType" validate:"required"`
+	HeadcountCapacity      float64           `json:"headcountCapacity" validate:"required"`
+	HeadcountInUse         *float64          `json:"headcountInUse,omitempty"`
+	GradeLevel             *string           `json:"gradeLevel,omitempty" validate:"omitempty,max=20"`
+	CostCenterCode         *string           `json:"costCenterCode,omitempty" validate:"omitempty,max=50"`
+	ReportsToPositionCode  *string           `json:"reportsToPositionCode,omitempty" validate:"omitempty,len=8"`
+	Profile                *string           `json:"profile,omitempty"`
+	CustomFields           CustomFieldValues `json:"customFields,omitempty"`
+	TitleI18n              LocalizedNames    `json:"titleI18n,omitempty"`
+	EffectiveDate          string            `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	OperationReason        string            `json:"operationReason" validate:"required"`
 }
 
 // PositionVersionRequest 插入职位版本的请求
 type PositionVersionRequest struct {
-	Title                  string   `json:"title" validate:"required,max=120"`
-	JobProfileCode         *string  `json:"jobProfileCode,omitempty" validate:"omitempty,max=64"`
-	JobProfileName         *string  `json:"jobProfileName,omitempty" validate:"omitempty,max=255"`
-	JobFamilyGroupCode     string   `json:"jobFamilyGroupCode" validate:"required"`
-	JobFamilyGroupRecordID *string  `json:"jobFamilyGroupRecordId,omitempty"`
-	JobFamilyCode          string   `json:"jobFamilyCode" validate:"required"`
-	JobFamilyRecordID      *string  `json:"jobFamilyRecordId,omitempty"`
-	JobRoleCode            string   `json:"jobRoleCode" validate:"required"`
-	JobRoleRecordID        *string  `json:"jobRoleRecordId,omitempty"`
-	JobLevelCode           string   `json:"jobLevelCode" validate:"required"`
-	JobLevelRecordID       *string  `json:"jobLevelRecordId,omitempty"`
-	PositionType           *string  `json:"positionType,omitempty"`
-	EmploymentType         *string  `json:"employmentType,omitempty"`
-	HeadcountCapacity      *float64 `json:"headcountCapacity,omitempty"`
-	HeadcountInUse         *float64 `json:"headcountInUse,omitempty"`
-	GradeLevel             *string  `json:"gradeLevel,omitempty"`
-	CostCenterCode         *string  `json:"costCenterCode,omitempty"`
-	ReportsTo              *string  `json:"reportsToPositionCode,omitempty"`
-	Profile                *string  `json:"profile,omitempty"`
-	EffectiveDate          string   `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	OperationReason        string   `json:"operationReason" validate:"required"`
+	Title                  string            `json:"title" validate:"required,max=120"`
+	JobProfileCode         *string           `json:"jobProfileCode,omitempty" validate:"omitempty,max=64"`
+	JobProfileName         *string           `json:"jobProfileName,omitempty" validate:"omitempty,max=255"`
+	JobFamilyGroupCode     string            `json:"jobFamilyGroupCode" validate:"required"`
+	JobFamilyGroupRecordID *string           `json:"jobFamilyGroupRecordId,omitempty"`
+	JobFamilyCode          string            `json:"jobFamilyCode" validate:"required"`
+	JobFamilyRecordID      *string           `json:"jobFamilyRecordId,omitempty"`
+	JobRoleCode            string            `json:"jobRoleCode" validate:"required"`
+	JobRoleRecordID        *string           `json:"jobRoleRecordId,omitempty"`
+	JobLevelCode           string            `json:"jobLevelCode" validate:"required"`
+	JobLevelRecordID       *string           `json:"jobLevelRecordId,omitempty"`
+	PositionType           *string           `json:"positionType,omitempty"`
+	EmploymentType         *string           `json:"employmentType,omitempty"`
+	HeadcountCapacity      *float64          `json:"headcountCapacity,omitempty"`
+	HeadcountInUse         *float64          `json:"headcountInUse,omitempty"`
+	GradeLevel             *string           `json:"gradeLevel,omitempty"`
+	CostCenterCode         *string           `json:"costCenterCode,omitempty"`
+	ReportsTo              *string           `json:"reportsToPositionCode,omitempty"`
+	Profile                *string           `json:"profile,omitempty"`
+	CustomFields           CustomFieldValues `json:"customFields,omitempty"`
+	TitleI18n              LocalizedNames    `json:"titleI18n,omitempty"`
+	EffectiveDate          string            `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	OperationReason        string            `json:"operationReason" validate:"required"`
 }
 
 // PositionEventRequest 职位事件（停用/激活/删除）
@@ -172,21 +176,23 @@ type TransferPositionRequest struct {
 
 // CreateJobFamilyGroupRequest 描述创建职族组的请求。
 type CreateJobFamilyGroupRequest struct {
-	Code          string  `json:"code" validate:"required"`
-	Name          string  `json:"name" validate:"required"`
-	Description   *string `json:"description,omitempty"`
-	Status        string  `json:"status" validate:"required"`
-	EffectiveDate string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Code          string         `json:"code" validate:"required"`
+	Name          string         `json:"name" validate:"required"`
+	NameI18n      LocalizedNames `json:"nameI18n,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	Status        string         `json:"status" validate:"required"`
+	EffectiveDate string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
 }
 
 // CreateJobFamilyRequest 描述创建职族的请求。
 type CreateJobFamilyRequest struct {
-	Code               string  `json:"code" validate:"required"`
-	JobFamilyGroupCode string  `json:"jobFamilyGroupCode" validate:"required"`
-	Name               string  `json:"name" validate:"required"`
-	Description        *string `json:"description,omitempty"`
-	Status             string  `json:"status" validate:"required"`
-	EffectiveDate      string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Code               string         `json:"code" validate:"required"`
+	JobFamilyGroupCode string         `json:"jobFamilyGroupCode" validate:"required"`
+	Name               string         `json:"name" validate:"required"`
+	NameI18n           LocalizedNames `json:"nameI18n,omitempty"`
+	Description        *string        `json:"description,omitempty"`
+	Status             string         `json:"status" validate:"required"`
+	EffectiveDate      string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
 }
 
 // CreateJobRoleRequest 描述创建职级角色的请求。
@@ -194,6 +200,7 @@ type CreateJobRoleRequest struct {
 	Code            string                 `json:"code" validate:"required"`
 	JobFamilyCode   string                 `json:"jobFamilyCode" validate:"required"`
 	Name            string                 `json:"name" validate:"required"`
+	NameI18n        LocalizedNames         `json:"nameI18n,omitempty"`
 	Description     *string                `json:"description,omitempty"`
 	Status          string                 `json:"status" validate:"required"`
 	EffectiveDate   string                 `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
@@ -205,6 +212,7 @@ type CreateJobLevelRequest struct {
 	Code          string                 `json:"code" validate:"required"`
 	JobRoleCode   string                 `json:"jobRoleCode" validate:"required"`
 	Name          string                 `json:"name" validate:"required"`
+	NameI18n      LocalizedNames         `json:"nameI18n,omitempty"`
 	Description   *string                `json:"description,omitempty"`
 	Status        string                 `json:"status" validate:"required"`
 	LevelRank     string                 `json:"levelRank" validate:"required"`
@@ -214,47 +222,225 @@ type CreateJobLevelRequest struct {
 
 // UpdateJobFamilyGroupRequest 描述更新职族组的请求。
 type UpdateJobFamilyGroupRequest struct {
-	Name          string  `json:"name" validate:"required"`
-	Status        string  `json:"status" validate:"required"`
-	EffectiveDate string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	Description   *string `json:"description,omitempty"`
+	Name          string         `json:"name" validate:"required"`
+	NameI18n      LocalizedNames `json:"nameI18n,omitempty"`
+	Status        string         `json:"status" validate:"required"`
+	EffectiveDate string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Description   *string        `json:"description,omitempty"`
 }
 
 // UpdateJobFamilyRequest 描述更新职族的请求。
 type UpdateJobFamilyRequest struct {
-	Name               string  `json:"name" validate:"required"`
-	Status             string  `json:"status" validate:"required"`
-	EffectiveDate      string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	Description        *string `json:"description,omitempty"`
-	JobFamilyGroupCode *string `json:"jobFamilyGroupCode,omitempty"`
+	Name               string         `json:"name" validate:"required"`
+	NameI18n           LocalizedNames `json:"nameI18n,omitempty"`
+	Status             string         `json:"status" validate:"required"`
+	EffectiveDate      string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Description        *string        `json:"description,omitempty"`
+	JobFamilyGroupCode *string        `json:"jobFamilyGroupCode,omitempty"`
 }
 
 // UpdateJobRoleRequest 描述更新职位角色的请求。
 type UpdateJobRoleRequest struct {
-	Name          string  `json:"name" validate:"required"`
-	Status        string  `json:"status" validate:"required"`
-	EffectiveDate string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	Description   *string `json:"description,omitempty"`
-	JobFamilyCode *string `json:"jobFamilyCode,omitempty"`
+	Name          string         `json:"name" validate:"required"`
+	NameI18n      LocalizedNames `json:"nameI18n,omitempty"`
+	Status        string         `json:"status" validate:"required"`
+	EffectiveDate string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Description   *string        `json:"description,omitempty"`
+	JobFamilyCode *string        `json:"jobFamilyCode,omitempty"`
 }
 
 // UpdateJobLevelRequest 描述更新职位级别的请求。
 type UpdateJobLevelRequest struct {
-	Name          string  `json:"name" validate:"required"`
-	Status        string  `json:"status" validate:"required"`
-	EffectiveDate string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	Description   *string `json:"description,omitempty"`
-	JobRoleCode   *string `json:"jobRoleCode,omitempty"`
-	LevelRank     *int    `json:"levelRank,omitempty"`
+	Name          string         `json:"name" validate:"required"`
+	NameI18n      LocalizedNames `json:"nameI18n,omitempty"`
+	Status        string         `json:"status" validate:"required"`
+	EffectiveDate string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Description   *string        `json:"description,omitempty"`
+	JobRoleCode   *string        `json:"jobRoleCode,omitempty"`
+	LevelRank     *int           `json:"levelRank,omitempty"`
 }
 
 // JobCatalogVersionRequest 描述创建职族目录版本的请求。
 type JobCatalogVersionRequest struct {
-	Name           string  `json:"name" validate:"required"`
-	Status         string  `json:"status" validate:"required"`
-	EffectiveDate  string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
-	Description    *string `json:"description,omitempty"`
-	ParentRecordID *string `json:"parentRecordId,omitempty"`
+	Name           string         `json:"name" validate:"required"`
+	NameI18n       LocalizedNames `json:"nameI18n,omitempty"`
+	Status         string         `json:"status" validate:"required"`
+	EffectiveDate  string         `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Description    *string        `json:"description,omitempty"`
+	ParentRecordID *string        `json:"parentRecordId,omitempty"`
+}
+
+// 职位目录层级与状态
+const (
+	JobCatalogLevelFamilyGroup = "JOB_FAMILY_GROUP"
+	JobCatalogLevelFamily      = "JOB_FAMILY"
+	JobCatalogLevelRole        = "JOB_ROLE"
+	JobCatalogLevelLevel       = "JOB_LEVEL"
+
+	JobCatalogStatusActive   = "ACTIVE"
+	JobCatalogStatusInactive = "INACTIVE"
+)
+
+// RetireJobCatalogRequest 描述停用职位目录条目的请求。
+// 指定 ReplacementCode 时将受影响职位迁移到替代条目；停用职类/职种/职务时需同时指定替代职级 ReplacementLevelCode。
+type RetireJobCatalogRequest struct {
+	EffectiveDate        string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	OperationReason      string  `json:"operationReason" validate:"required"`
+	ReplacementCode      *string `json:"replacementCode,omitempty"`
+	ReplacementLevelCode *string `json:"replacementLevelCode,omitempty"`
+	DryRun               bool    `json:"dryRun,omitempty"`
+}
+
+// JobCatalogReference 职位目录条目引用（层级 + 编码）。
+type JobCatalogReference struct {
+	Level string `json:"level"`
+	Code  string `json:"code"`
+}
+
+// JobCatalogImpactedPosition 表示在停用日及之后仍引用该条目的职位版本。
+type JobCatalogImpactedPosition struct {
+	Code             string     `json:"code"`
+	RecordID         uuid.UUID  `json:"recordId"`
+	Title            string     `json:"title"`
+	OrganizationCode string     `json:"organizationCode"`
+	Status           string     `json:"status"`
+	JobLevelCode     string     `json:"jobLevelCode"`
+	EffectiveDate    time.Time  `json:"effectiveDate"`
+	EndDate          *time.Time `json:"endDate,omitempty"`
+	IsCurrent        bool       `json:"isCurrent"`
+}
+
+// JobCatalogImpactedChild 表示在停用日仍处于启用状态的下级目录条目。
+type JobCatalogImpactedChild struct {
+	Level         string    `json:"level"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	Status        string    `json:"status"`
+	EffectiveDate time.Time `json:"effectiveDate"`
+}
+
+// 职位迁移方式
+const (
+	JobCatalogRemapNewVersion    = "NEW_VERSION"
+	JobCatalogRemapFutureVersion = "FUTURE_VERSION_UPDATED"
+)
+
+// JobCatalogRemappedPosition 表示已迁移到替代条目的职位版本。
+type JobCatalogRemappedPosition struct {
+	Code           string    `json:"code"`
+	SourceRecordID uuid.UUID `json:"sourceRecordId"`
+	RecordID       uuid.UUID `json:"recordId"`
+	EffectiveDate  time.Time `json:"effectiveDate"`
+	Mode           string    `json:"mode"`
+}
+
+// JobCatalogRetirementResult 停用结果与影响分析报告。
+type JobCatalogRetirementResult struct {
+	Level                string                       `json:"level"`
+	Code                 string                       `json:"code"`
+	EffectiveDate        string                       `json:"effectiveDate"`
+	DryRun               bool                         `json:"dryRun"`
+	RecordID             *uuid.UUID                   `json:"recordId,omitempty"`
+	ReplacementCode      *string                      `json:"replacementCode,omitempty"`
+	ReplacementLevelCode *string                      `json:"replacementLevelCode,omitempty"`
+	ImpactedPositions    []JobCatalogImpactedPosition `json:"impactedPositions"`
+	ActiveChildren       []JobCatalogImpactedChild    `json:"activeChildren"`
+	RemappedPositions    []JobCatalogRemappedPosition `json:"remappedPositions"`
+}
+
+// 职位目录交换文档格式
+const (
+	JobCatalogDocumentFormatVersion = "1"
+
+	JobCatalogFormatJSON = "json"
+	JobCatalogFormatYAML = "yaml"
+	JobCatalogFormatCSV  = "csv"
+)
+
+// JobCatalogDocument 职位目录交换文档：职类 → 职种 → 职务 → 职级逐层嵌套，每个条目携带按生效日期排列的全部版本。
+type JobCatalogDocument struct {
+	FormatVersion string                    `json:"formatVersion" yaml:"formatVersion"`
+	FamilyGroups  []JobCatalogDocumentGroup `json:"familyGroups" yaml:"familyGroups"`
+}
+
+// JobCatalogDocumentVersion 目录条目的单个时态版本。
+type JobCatalogDocumentVersion struct {
+	EffectiveDate string         `json:"effectiveDate" yaml:"effectiveDate"`
+	Name          string         `json:"name" yaml:"name"`
+	NameI18n      LocalizedNames `json:"nameI18n,omitempty" yaml:"nameI18n,omitempty"`
+	Description   *string        `json:"description,omitempty" yaml:"description,omitempty"`
+	Status        string         `json:"status" yaml:"status"`
+}
+
+// JobCatalogDocumentGroup 交换文档中的职类。
+type JobCatalogDocumentGroup struct {
+	Code     string                      `json:"code" yaml:"code"`
+	Versions []JobCatalogDocumentVersion `json:"versions" yaml:"versions"`
+	Families []JobCatalogDocumentFamily  `json:"families,omitempty" yaml:"families,omitempty"`
+}
+
+// JobCatalogDocumentFamily 交换文档中的职种。
+type JobCatalogDocumentFamily struct {
+	Code     string                      `json:"code" yaml:"code"`
+	Versions []JobCatalogDocumentVersion `json:"versions" yaml:"versions"`
+	Roles    []JobCatalogDocumentRole    `json:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// JobCatalogDocumentRole 交换文档中的职务，胜任力模型仅在创建时使用。
+type JobCatalogDocumentRole struct {
+	Code            string                      `json:"code" yaml:"code"`
+	CompetencyModel map[string]interface{}      `json:"competencyModel,omitempty" yaml:"competencyModel,omitempty"`
+	Versions        []JobCatalogDocumentVersion `json:"versions" yaml:"versions"`
+	Levels          []JobCatalogDocumentLevel   `json:"levels,omitempty" yaml:"levels,omitempty"`
+}
+
+// JobCatalogDocumentLevel 交换文档中的职级，薪酬带宽仅在创建时使用。
+type JobCatalogDocumentLevel struct {
+	Code       string                      `json:"code" yaml:"code"`
+	LevelRank  string                      `json:"levelRank" yaml:"levelRank"`
+	SalaryBand map[string]interface{}      `json:"salaryBand,omitempty" yaml:"salaryBand,omitempty"`
+	Versions   []JobCatalogDocumentVersion `json:"versions" yaml:"versions"`
+}
+
+// 导入差异动作
+const (
+	JobCatalogImportCreate        = "CREATE"
+	JobCatalogImportCreateVersion = "CREATE_VERSION"
+	JobCatalogImportUnchanged     = "UNCHANGED"
+	JobCatalogImportConflict      = "CONFLICT"
+)
+
+// JobCatalogImportChange 导入差异中的单个版本变更。
+type JobCatalogImportChange struct {
+	Level         string     `json:"level"`
+	Code          string     `json:"code"`
+	ParentCode    string     `json:"parentCode,omitempty"`
+	EffectiveDate string     `json:"effectiveDate"`
+	Action        string     `json:"action"`
+	RecordID      *uuid.UUID `json:"recordId,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+}
+
+// JobCatalogImportSummary 导入差异统计。
+type JobCatalogImportSummary struct {
+	Created         int `json:"created"`
+	VersionsCreated int `json:"versionsCreated"`
+	Unchanged       int `json:"unchanged"`
+	Conflicts       int `json:"conflicts"`
+}
+
+// JobCatalogImportResult 导入（或克隆）结果；DryRun 时仅包含差异，不写入数据。
+type JobCatalogImportResult struct {
+	DryRun         bool                     `json:"dryRun"`
+	SourceTenantID *uuid.UUID               `json:"sourceTenantId,omitempty"`
+	Summary        JobCatalogImportSummary  `json:"summary"`
+	Changes        []JobCatalogImportChange `json:"changes"`
+}
+
+// CloneJobCatalogRequest 描述从其他租户克隆职位目录的请求。
+type CloneJobCatalogRequest struct {
+	SourceTenantID string `json:"sourceTenantId" validate:"required,uuid"`
+	DryRun         bool   `json:"dryRun,omitempty"`
 }
 
 // PositionResponse 响应结构
@@ -282,6 +468,8 @@ type PositionResponse struct {
 	GradeLevel            *string                      `json:"gradeLevel,omitempty"`
 	CostCenterCode        *string                      `json:"costCenterCode,omitempty"`
 	ReportsToPositionCode *string                      `json:"reportsToPositionCode,omitempty"`
+	CustomFields          CustomFieldValues            `json:"customFields,omitempty"`
+	TitleI18n             LocalizedNames               `json:"titleI18n,omitempty"`
 	EffectiveDate         time.Time                    `json:"effectiveDate"`
 	EndDate               *time.Time                   `json:"endDate,omitempty"`
 	IsCurrent             bool                         `json:"isCurrent"`
@@ -317,45 +505,47 @@ type PositionAssignmentResponse struct {
 
 // Position 数据实体，用于数据库映射
 type Position struct {
-	RecordID             uuid.UUID      `db:"record_id"`
-	TenantID             uuid.UUID      `db:"tenant_id"`
-	Code                 string         `db:"code"`
-	Title                string         `db:"title"`
-	JobProfileCode       sql.NullString `db:"job_profile_code"`
-	JobProfileName       sql.NullString `db:"job_profile_name"`
-	JobFamilyGroupCode   string         `db:"job_family_group_code"`
-	JobFamilyGroupName   string         `db:"job_family_group_name"`
-	JobFamilyGroupRecord uuid.UUID      `db:"job_family_group_record_id"`
-	JobFamilyCode        string         `db:"job_family_code"`
-	JobFamilyName        string         `db:"job_family_name"`
-	JobFamilyRecord      uuid.UUID      `db:"job_family_record_id"`
-	JobRoleCode          string         `db:"job_role_code"`
-	JobRoleName          string         `db:"job_role_name"`
-	JobRoleRecord        uuid.UUID      `db:"job_role_record_id"`
-	JobLevelCode         string         `db:"job_level_code"`
-	JobLevelName         string         `db:"job_level_name"`
-	JobLevelRecord       uuid.UUID      `db:"job_level_record_id"`
-	OrganizationCode     string         `db:"organization_code"`
-	OrganizationName     sql.NullString `db:"organization_name"`
-	PositionType         string         `db:"position_type"`
-	Status               string         `db:"status"`
-	EmploymentType       string         `db:"employment_type"`
-	HeadcountCapacity    float64        `db:"headcount_capacity"`
-	HeadcountInUse       float64        `db:"headcount_in_use"`
-	GradeLevel           sql.NullString `db:"grade_level"`
-	CostCenterCode       sql.NullString `db:"cost_center_code"`
-	ReportsToPosition    sql.NullString `db:"reports_to_position_code"`
-	Profile              []byte         `db:"profile"`
-	EffectiveDate        time.Time      `db:"effective_date"`
-	EndDate              sql.NullTime   `db:"end_date"`
-	IsCurrent            bool           `db:"is_current"`
-	CreatedAt            time.Time      `db:"created_at"`
-	UpdatedAt            time.Time      `db:"updated_at"`
-	DeletedAt            sql.NullTime   `db:"deleted_at"`
-	OperationType        string         `db:"operation_type"`
-	OperatedByID         uuid.UUID      `db:"operated_by_id"`
-	OperatedByName       string         `db:"operated_by_name"`
-	OperationReason      sql.NullString `db:"operation_reason"`
+	RecordID             uuid.UUID         `db:"record_id"`
+	TenantID             uuid.UUID         `db:"tenant_id"`
+	Code                 string            `db:"code"`
+	Title                string            `db:"title"`
+	JobProfileCode       sql.NullString    `db:"job_profile_code"`
+	JobProfileName       sql.NullString    `db:"job_profile_name"`
+	JobFamilyGroupCode   string            `db:"job_family_group_code"`
+	JobFamilyGroupName   string            `db:"job_family_group_name"`
+	JobFamilyGroupRecord uuid.UUID         `db:"job_family_group_record_id"`
+	JobFamilyCode        string            `db:"job_family_code"`
+	JobFamilyName        string            `db:"job_family_name"`
+	JobFamilyRecord      uuid.UUID         `db:"job_family_record_id"`
+	JobRoleCode          string            `db:"job_role_code"`
+	JobRoleName          string            `db:"job_role_name"`
+	JobRoleRecord        uuid.UUID         `db:"job_role_record_id"`
+	JobLevelCode         string            `db:"job_level_code"`
+	JobLevelName         string            `db:"job_level_name"`
+	JobLevelRecord       uuid.UUID         `db:"job_level_record_id"`
+	OrganizationCode     string            `db:"organization_code"`
+	OrganizationName     sql.NullString    `db:"organization_name"`
+	PositionType         string            `db:"position_type"`
+	Status               string            `db:"status"`
+	EmploymentType       string            `db:"employment_type"`
+	HeadcountCapacity    float64           `db:"headcount_capacity"`
+	HeadcountInUse       float64           `db:"headcount_in_use"`
+	GradeLevel           sql.NullString    `db:"grade_level"`
+	CostCenterCode       sql.NullString    `db:"cost_center_code"`
+	ReportsToPosition    sql.NullString    `db:"reports_to_position_code"`
+	Profile              []byte            `db:"profile"`
+	CustomFields         CustomFieldValues `db:"custom_fields"`
+	TitleI18n            LocalizedNames    `db:"title_i18n"`
+	EffectiveDate        time.Time         `db:"effective_date"`
+	EndDate              sql.NullTime      `db:"end_date"`
+	IsCurrent            bool              `db:"is_current"`
+	CreatedAt            time.Time         `db:"created_at"`
+	UpdatedAt            time.Time         `db:"updated_at"`
+	DeletedAt            sql.NullTime      `db:"deleted_at"`
+	OperationType        string            `db:"operation_type"`
+	OperatedByID         uuid.UUID         `db:"operated_by_id"`
+	OperatedByName       string            `db:"operated_by_name"`
+	OperationReason      sql.NullString    `db:"operation_reason"`
 }
 
 // PositionAssignment 数据实体
@@ -381,6 +571,15 @@ type PositionAssignment struct {
 	UpdatedAt        time.Time      `db:"updated_at"`
 }
 
+// EmployeeAssignment 员工在某职位上的任职及该职位当前所属组织，用于员工级跨职位校验。
+type EmployeeAssignment struct {
+	PositionAssignment
+	OrganizationCode string `db:"organization_code"`
+}
+
+// EmployeeMaxTotalFTE 员工在任一日期跨全部职位的任职 FTE 合计上限。
+const EmployeeMaxTotalFTE = 1.0
+
 // PositionTimelineEntry 用于构建时间线或版本列表
 type PositionTimelineEntry struct {
 	RecordID      uuid.UUID    `db:"record_id"`
@@ -396,6 +595,7 @@ type JobFamilyGroup struct {
 	TenantID      uuid.UUID      `db:"tenant_id"`
 	Code          string         `db:"family_group_code"`
 	Name          string         `db:"name"`
+	NameI18n      LocalizedNames `db:"name_i18n"`
 	Description   sql.NullString `db:"description"`
 	Status        string         `db:"status"`
 	EffectiveDate time.Time      `db:"effective_date"`
@@ -411,6 +611,7 @@ type JobFamily struct {
 	FamilyGroupCode string         `db:"family_group_code"`
 	ParentRecord    uuid.UUID      `db:"parent_record_id"`
 	Name            string         `db:"name"`
+	NameI18n        LocalizedNames `db:"name_i18n"`
 	Description     sql.NullString `db:"description"`
 	Status          string         `db:"status"`
 	EffectiveDate   time.Time      `db:"effective_date"`
@@ -426,6 +627,7 @@ type JobRole struct {
 	FamilyCode    string         `db:"family_code"`
 	ParentRecord  uuid.UUID      `db:"parent_record_id"`
 	Name          string         `db:"name"`
+	NameI18n      LocalizedNames `db:"name_i18n"`
 	Description   sql.NullString `db:"description"`
 	Competency    []byte         `db:"competency_model"`
 	Status        string         `db:"status"`
@@ -443,6 +645,7 @@ type JobLevel struct {
 	ParentRecord  uuid.UUID      `db:"parent_record_id"`
 	LevelRank     string         `db:"level_rank"`
 	Name          string         `db:"name"`
+	NameI18n      LocalizedNames `db:"name_i18n"`
 	Description   sql.NullString `db:"description"`
 	SalaryBand    []byte         `db:"salary_band"`
 	Status        string         `db:"status"`
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"cube-castle/pkg/tracing"
+
 	// Register PostgreSQL driver.
 	_ "github.com/lib/pq"
 )
@@ -32,6 +34,12 @@ type ConnectionConfig struct {
 	ConnMaxIdleTime time.Duration
 	ConnMaxLifetime time.Duration
 	ServiceName     string
+	// ReplicaDSNs 只读副本连接串，配置后可通过 Reads() 将只读查询路由到副本。
+	ReplicaDSNs []string
+	// MaxReplicaLag 副本复制延迟阈值，超过时读请求回落主库。
+	MaxReplicaLag time.Duration
+	// ReplicaCheckInterval 副本健康与延迟探测周期。
+	ReplicaCheckInterval time.Duration
 }
 
 func (c ConnectionConfig) normalize() ConnectionConfig {
@@ -48,13 +56,20 @@ func (c ConnectionConfig) normalize() ConnectionConfig {
 	if config.ConnMaxLifetime <= 0 {
 		config.ConnMaxLifetime = DefaultConnMaxLifetime
 	}
+	if config.MaxReplicaLag <= 0 {
+		config.MaxReplicaLag = DefaultMaxReplicaLag
+	}
+	if config.ReplicaCheckInterval <= 0 {
+		config.ReplicaCheckInterval = DefaultReplicaCheckInterval
+	}
 	return config
 }
 
 // Database 封装 *sql.DB 并提供统一的访问接口。
 type Database struct {
-	db     *sql.DB
-	config ConnectionConfig
+	db       *sql.DB
+	config   ConnectionConfig
+	replicas *replicaSet
 }
 
 // NewDatabase 使用默认参数创建数据库连接。
@@ -91,10 +106,19 @@ func NewDatabaseWithConfig(config ConnectionConfig) (*Database, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &Database{
+	database := &Database{
 		db:     db,
 		config: config,
-	}, nil
+	}
+	if len(config.ReplicaDSNs) > 0 {
+		replicas, err := openReplicas(config)
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+		database.replicas = replicas
+	}
+	return database, nil
 }
 
 // Close 关闭数据库连接。
@@ -102,6 +126,12 @@ func (d *Database) Close() error {
 	if d == nil || d.db == nil {
 		return nil
 	}
+	if d.replicas != nil {
+		if err := d.replicas.close(); err != nil {
+			_ = d.db.Close()
+			return err
+		}
+	}
 	return d.db.Close()
 }
 
@@ -134,9 +164,11 @@ func (d *Database) ExecContext(ctx context.Context, query string, args ...any) (
 	if d == nil || d.db == nil {
 		return nil, ErrDatabaseNotInitialized
 	}
+	ctx, span := startQuerySpan(ctx, query)
 	start := time.Now()
 	result, err := d.db.ExecContext(ctx, query, args...)
 	recordQueryDuration(d.config, query, time.Since(start))
+	tracing.End(span, err)
 	return result, err
 }
 
@@ -145,9 +177,11 @@ func (d *Database) QueryContext(ctx context.Context, query string, args ...any)
 	if d == nil || d.db == nil {
 		return nil, ErrDatabaseNotInitialized
 	}
+	ctx, span := startQuerySpan(ctx, query)
 	start := time.Now()
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	recordQueryDuration(d.config, query, time.Since(start))
+	tracing.End(span, err)
 	return rows, err
 }
 
@@ -156,8 +190,10 @@ func (d *Database) QueryRowContext(ctx context.Context, query string, args ...an
 	if d == nil || d.db == nil {
 		return nil
 	}
+	ctx, span := startQuerySpan(ctx, query)
 	start := time.Now()
 	row := d.db.QueryRowContext(ctx, query, args...)
 	recordQueryDuration(d.config, query, time.Since(start))
+	tracing.End(span, row.Err())
 	return row
 }
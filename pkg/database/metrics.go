@@ -34,9 +34,46 @@ var (
 		[]string{"service", "query_type"},
 	)
 
+	dbReadRoutingTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_read_routing_total",
+			Help: "Read-only queries by routing target (replica or primary)",
+		},
+		[]string{"service", "target"},
+	)
+
+	dbReplicaLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "db_replica_lag_seconds",
+			Help: "Replication lag of read replicas in seconds (-1 when unhealthy)",
+		},
+		[]string{"service", "replica"},
+	)
+
+	dbTxRetriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_tx_retries_total",
+			Help: "Transaction retries caused by serialization failures or deadlocks",
+		},
+		[]string{"operation", "reason"},
+	)
+
+	dbTxRetryGiveUpsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_tx_retry_giveups_total",
+			Help: "Retryable transaction conflicts returned to the caller (attempts exhausted or side effect recorded)",
+		},
+		[]string{"operation", "reason", "cause"},
+	)
+
 	metricsOnce sync.Once
 )
 
+const (
+	readTargetPrimary = "primary"
+	readTargetReplica = "replica"
+)
+
 // RegisterMetrics 将当前包的指标注册到给定的 Registerer。
 func RegisterMetrics(reg prometheus.Registerer) {
 	if reg == nil {
@@ -44,7 +81,7 @@ func RegisterMetrics(reg prometheus.Registerer) {
 	}
 
 	metricsOnce.Do(func() {
-		reg.MustRegister(dbConnectionsInUse, dbConnectionsIdle, dbQueryDuration)
+		reg.MustRegister(dbConnectionsInUse, dbConnectionsIdle, dbQueryDuration, dbReadRoutingTotal, dbReplicaLag, dbTxRetriesTotal, dbTxRetryGiveUpsTotal)
 	})
 }
 
@@ -65,6 +102,18 @@ func recordQueryDuration(config ConnectionConfig, query string, duration time.Du
 	ObserveQueryDuration(resolveServiceLabel(config.ServiceName), extractQueryType(query), duration)
 }
 
+func recordReadRoute(config ConnectionConfig, target string) {
+	dbReadRoutingTotal.WithLabelValues(resolveServiceLabel(config.ServiceName), target).Inc()
+}
+
+func recordTxRetry(operation, reason string) {
+	dbTxRetriesTotal.WithLabelValues(operation, reason).Inc()
+}
+
+func recordTxRetryGiveUp(operation, reason, cause string) {
+	dbTxRetryGiveUpsTotal.WithLabelValues(operation, reason, cause).Inc()
+}
+
 func resolveServiceLabel(names ...string) string {
 	for _, name := range names {
 		if strings.TrimSpace(name) != "" {
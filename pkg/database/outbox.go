@@ -214,3 +214,23 @@ func (r *outboxRepository) IncrementRetryCount(ctx context.Context, eventID stri
 
 	return nil
 }
+
+// OutboxBacklogMetrics 统计未发布事件数量与最早事件的等待秒数，供告警规则引用
+// （outbox.backlog_count / outbox.backlog_age_seconds）。
+func OutboxBacklogMetrics(ctx context.Context, db *sql.DB) (map[string]float64, error) {
+	var (
+		count int64
+		age   float64
+	)
+	if err := db.QueryRowContext(ctx, `
+		SELECT COUNT(*), COALESCE(EXTRACT(EPOCH FROM NOW() - MIN(created_at)), 0)
+		FROM outbox_events
+		WHERE published = FALSE
+	`).Scan(&count, &age); err != nil {
+		return nil, fmt.Errorf("failed to query outbox backlog: %w", err)
+	}
+	return map[string]float64{
+		"outbox.backlog_count":       float64(count),
+		"outbox.backlog_age_seconds": age,
+	}, nil
+}
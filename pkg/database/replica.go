@@ -0,0 +1,402 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cube-castle/pkg/tracing"
+)
+
+const (
+	// DefaultMaxReplicaLag 副本复制延迟超过该值时读请求回落主库。
+	DefaultMaxReplicaLag = 5 * time.Second
+	// DefaultReplicaCheckInterval 副本健康与延迟探测周期。
+	DefaultReplicaCheckInterval = 5 * time.Second
+
+	replicaCheckTimeout = 2 * time.Second
+)
+
+// replicaStatusQuery 探测副本状态；WAL 已全部回放时视为无延迟，避免主库空闲时
+// pg_last_xact_replay_timestamp 停滞被误判为延迟。
+const replicaStatusQuery = `SELECT pg_is_in_recovery(),
+	COALESCE(pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn(), true),
+	pg_last_xact_replay_timestamp()`
+
+// Queryer 只读查询所需的最小接口，*sql.DB 与 ReadRouter 均满足。
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// ReplicaStatus 描述单个只读副本的健康状态。
+type ReplicaStatus struct {
+	Name      string        `json:"name"`
+	Healthy   bool          `json:"healthy"`
+	Lag       time.Duration `json:"lag"`
+	CurrentAt time.Time     `json:"currentAt"`
+	CheckedAt time.Time     `json:"checkedAt"`
+	LastError string        `json:"lastError,omitempty"`
+}
+
+type readContextKey int
+
+const (
+	primaryReadKey readContextKey = iota
+	writeTokenKey
+)
+
+// WithPrimaryRead 强制当前上下文内的读请求走主库。
+func WithPrimaryRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadKey, true)
+}
+
+// WithWriteToken 记录调用方最近一次写入的提交时间。写入后 MaxReplicaLag 内的读请求一律走主库：
+// 副本状态按周期探测、"已追平"只说明已回放全部已接收的 WAL，且令牌时间与探测时间来自不同主机的时钟，
+// 都不足以证明副本已包含该写入；超过该窗口后仍只选用已回放到该时间点的副本，从而保证读到自己的写入。
+func WithWriteToken(ctx context.Context, writtenAt time.Time) context.Context {
+	if writtenAt.IsZero() {
+		return ctx
+	}
+	return context.WithValue(ctx, writeTokenKey, writtenAt)
+}
+
+// FormatWriteToken 将写入时间编码为写入令牌（Unix 毫秒）。
+func FormatWriteToken(writtenAt time.Time) string {
+	return strconv.FormatInt(writtenAt.UnixMilli(), 10)
+}
+
+// ParseWriteToken 解析写入令牌。
+func ParseWriteToken(token string) (time.Time, error) {
+	ms, err := strconv.ParseInt(strings.TrimSpace(token), 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}, fmt.Errorf("invalid write token %q", token)
+	}
+	return time.UnixMilli(ms), nil
+}
+
+type replica struct {
+	name string
+	db   *sql.DB
+
+	mu     sync.RWMutex
+	status ReplicaStatus
+}
+
+func (r *replica) snapshot() ReplicaStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.status
+}
+
+func (r *replica) update(fn func(*ReplicaStatus)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(&r.status)
+}
+
+// usable 副本健康、延迟在阈值内，且已回放到写入令牌对应的时间点。
+func (r *replica) usable(maxLag time.Duration, writtenAt time.Time) bool {
+	s := r.snapshot()
+	if !s.Healthy || s.Lag > maxLag {
+		return false
+	}
+	return writtenAt.IsZero() || !s.CurrentAt.Before(writtenAt)
+}
+
+type replicaSet struct {
+	replicas []*replica
+	maxLag   time.Duration
+	interval time.Duration
+	service  string
+	next     atomic.Uint64
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// openReplicas 打开副本连接池；单个副本不可达不影响启动，由健康探测自动恢复。
+func openReplicas(config ConnectionConfig) (*replicaSet, error) {
+	set := &replicaSet{
+		maxLag:   config.MaxReplicaLag,
+		interval: config.ReplicaCheckInterval,
+		service:  resolveServiceLabel(config.ServiceName),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for i, dsn := range config.ReplicaDSNs {
+		db, err := openDB("postgres", dsn)
+		if err != nil {
+			for _, opened := range set.replicas {
+				_ = opened.db.Close()
+			}
+			return nil, fmt.Errorf("failed to open replica %d: %w", i+1, err)
+		}
+		db.SetMaxOpenConns(config.MaxOpenConns)
+		db.SetMaxIdleConns(config.MaxIdleConns)
+		db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+		db.SetConnMaxLifetime(config.ConnMaxLifetime)
+		set.replicas = append(set.replicas, &replica{
+			name:   fmt.Sprintf("replica-%d", i+1),
+			db:     db,
+			status: ReplicaStatus{Name: fmt.Sprintf("replica-%d", i+1)},
+		})
+	}
+	set.checkAll()
+	go set.monitor()
+	return set, nil
+}
+
+func (s *replicaSet) monitor() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.checkAll()
+		}
+	}
+}
+
+func (s *replicaSet) checkAll() {
+	var wg sync.WaitGroup
+	for _, r := range s.replicas {
+		wg.Add(1)
+		go func(r *replica) {
+			defer wg.Done()
+			s.check(r)
+		}(r)
+	}
+	wg.Wait()
+}
+
+func (s *replicaSet) check(r *replica) {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaCheckTimeout)
+	defer cancel()
+
+	var (
+		inRecovery bool
+		caughtUp   bool
+		replayedAt sql.NullTime
+	)
+	checkedAt := time.Now()
+	err := r.db.QueryRowContext(ctx, replicaStatusQuery).Scan(&inRecovery, &caughtUp, &replayedAt)
+	r.update(func(st *ReplicaStatus) {
+		st.CheckedAt = checkedAt
+		if err != nil {
+			st.Healthy = false
+			st.LastError = err.Error()
+			return
+		}
+		st.Healthy = true
+		st.LastError = ""
+		switch {
+		case !inRecovery || caughtUp:
+			st.CurrentAt = checkedAt
+		case replayedAt.Valid:
+			st.CurrentAt = replayedAt.Time
+		default:
+			// 尚未回放任何事务，延迟未知
+			st.Healthy = false
+			st.LastError = "replica has not replayed any transaction"
+			return
+		}
+		st.Lag = checkedAt.Sub(st.CurrentAt)
+		if st.Lag < 0 {
+			st.Lag = 0
+		}
+	})
+	status := r.snapshot()
+	lag := status.Lag.Seconds()
+	if !status.Healthy {
+		lag = -1
+	}
+	dbReplicaLag.WithLabelValues(s.service, r.name).Set(lag)
+}
+
+// clampWriteToken 将晚于本机当前时间的写入令牌钳制到当前时间：超前不足一个延迟窗口视为时钟偏差；
+// 超前更多的令牌（伪造或时钟严重错误）按无令牌处理，避免读请求被长期钉在主库。
+func (s *replicaSet) clampWriteToken(writtenAt, now time.Time) time.Time {
+	if !writtenAt.After(now) {
+		return writtenAt
+	}
+	if writtenAt.Sub(now) >= s.maxLag {
+		return time.Time{}
+	}
+	return now
+}
+
+// recentWrite 写入令牌（已钳制到当前时间）仍在复制延迟窗口内。
+func (s *replicaSet) recentWrite(writtenAt, now time.Time) bool {
+	return !writtenAt.IsZero() && now.Sub(writtenAt) < s.maxLag
+}
+
+// pick 轮询选择可用副本；没有可用副本或写入令牌仍在延迟窗口内时返回 nil。
+func (s *replicaSet) pick(writtenAt time.Time) *replica {
+	n := len(s.replicas)
+	now := time.Now()
+	writtenAt = s.clampWriteToken(writtenAt, now)
+	if n == 0 || s.recentWrite(writtenAt, now) {
+		return nil
+	}
+	start := s.next.Add(1)
+	for i := 0; i < n; i++ {
+		r := s.replicas[(start+uint64(i))%uint64(n)]
+		if r.usable(s.maxLag, writtenAt) {
+			return r
+		}
+	}
+	return nil
+}
+
+// markUnhealthy 连接级错误时立即摘除副本，等待下一轮探测恢复。
+func (s *replicaSet) markUnhealthy(r *replica, err error) {
+	r.update(func(st *ReplicaStatus) {
+		st.Healthy = false
+		st.LastError = err.Error()
+	})
+	dbReplicaLag.WithLabelValues(s.service, r.name).Set(-1)
+}
+
+func (s *replicaSet) statuses() []ReplicaStatus {
+	out := make([]ReplicaStatus, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		out = append(out, r.snapshot())
+	}
+	return out
+}
+
+func (s *replicaSet) close() error {
+	var errs []error
+	s.once.Do(func() {
+		close(s.stop)
+		<-s.done
+		for _, r := range s.replicas {
+			if err := r.db.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// ReadRouter 将只读查询路由到副本：副本不可用、延迟超限、上下文要求主库读、写入令牌仍在延迟窗口内
+// 或副本尚未回放到写入令牌时间点时回落主库。
+type ReadRouter struct {
+	d *Database
+}
+
+// Reads 返回只读查询路由器；未配置副本时所有读请求走主库。
+func (d *Database) Reads() *ReadRouter {
+	return &ReadRouter{d: d}
+}
+
+// ReplicaStatuses 返回各副本最近一次探测结果。
+func (d *Database) ReplicaStatuses() []ReplicaStatus {
+	if d == nil || d.replicas == nil {
+		return nil
+	}
+	return d.replicas.statuses()
+}
+
+func (rr *ReadRouter) route(ctx context.Context) *replica {
+	d := rr.d
+	if d == nil || d.replicas == nil {
+		return nil
+	}
+	if forced, _ := ctx.Value(primaryReadKey).(bool); forced {
+		return nil
+	}
+	writtenAt, _ := ctx.Value(writeTokenKey).(time.Time)
+	return d.replicas.pick(writtenAt)
+}
+
+// QueryContext 执行只读查询；副本连接失败时自动重试主库。
+func (rr *ReadRouter) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if rr.d == nil || rr.d.db == nil {
+		return nil, ErrDatabaseNotInitialized
+	}
+	if r := rr.route(ctx); r != nil {
+		rows, err := rr.queryReplica(ctx, r, query, args...)
+		if err == nil || !isConnectionError(err) {
+			return rows, err
+		}
+		rr.d.replicas.markUnhealthy(r, err)
+	}
+	recordReadRoute(rr.d.config, readTargetPrimary)
+	return rr.d.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext 执行只读单行查询；副本连接失败时自动重试主库。
+// 未初始化时返回的行在 Scan 时报 ErrDatabaseNotInitialized，而不是 nil。
+func (rr *ReadRouter) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if rr.d == nil || rr.d.db == nil {
+		return uninitializedDB.QueryRowContext(ctx, query, args...)
+	}
+	if r := rr.route(ctx); r != nil {
+		row := rr.queryRowReplica(ctx, r, query, args...)
+		if err := row.Err(); err == nil || !isConnectionError(err) {
+			return row
+		}
+		rr.d.replicas.markUnhealthy(r, row.Err())
+	}
+	recordReadRoute(rr.d.config, readTargetPrimary)
+	return rr.d.QueryRowContext(ctx, query, args...)
+}
+
+// uninitializedDB 任何查询都返回 ErrDatabaseNotInitialized 的连接池；*sql.Row 无法在包外携带错误构造，
+// 借此让未初始化的 ReadRouter.QueryRowContext 与 QueryContext 一样以错误失败。
+var uninitializedDB = sql.OpenDB(uninitializedConnector{})
+
+type uninitializedConnector struct{}
+
+func (uninitializedConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, ErrDatabaseNotInitialized
+}
+
+func (uninitializedConnector) Driver() driver.Driver { return uninitializedDriver{} }
+
+type uninitializedDriver struct{}
+
+func (uninitializedDriver) Open(string) (driver.Conn, error) { return nil, ErrDatabaseNotInitialized }
+
+func (rr *ReadRouter) queryReplica(ctx context.Context, r *replica, query string, args ...any) (*sql.Rows, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	span.SetAttributes(replicaAttribute(r.name))
+	start := time.Now()
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	recordQueryDuration(rr.d.config, query, time.Since(start))
+	recordReadRoute(rr.d.config, readTargetReplica)
+	tracing.End(span, err)
+	return rows, err
+}
+
+func (rr *ReadRouter) queryRowReplica(ctx context.Context, r *replica, query string, args ...any) *sql.Row {
+	ctx, span := startQuerySpan(ctx, query)
+	span.SetAttributes(replicaAttribute(r.name))
+	start := time.Now()
+	row := r.db.QueryRowContext(ctx, query, args...)
+	recordQueryDuration(rr.d.config, query, time.Since(start))
+	recordReadRoute(rr.d.config, readTargetReplica)
+	tracing.End(span, row.Err())
+	return row
+}
+
+func isConnectionError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const replicaStatusPattern = `SELECT pg_is_in_recovery\(\)`
+
+type replicaFixture struct {
+	db      *Database
+	primary sqlmock.Sqlmock
+	replica sqlmock.Sqlmock
+}
+
+// newReplicaDatabase 创建一主一从的 mock 数据库；replicaStatus 为首次探测返回的
+// (in_recovery, caught_up, replay_timestamp)。
+func newReplicaDatabase(t *testing.T, cfg ConnectionConfig, replicaStatus []driver.Value) *replicaFixture {
+	t.Helper()
+
+	primaryDB, primaryMock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	replicaDB, replicaMock, err := sqlmock.New()
+	require.NoError(t, err)
+	primaryMock.ExpectPing()
+	replicaMock.ExpectQuery(replicaStatusPattern).
+		WillReturnRows(sqlmock.NewRows([]string{"in_recovery", "caught_up", "replayed_at"}).AddRow(replicaStatus...))
+
+	pools := []*sql.DB{primaryDB, replicaDB}
+	originalOpen := openDB
+	openDB = func(_ string, _ string) (*sql.DB, error) {
+		db := pools[0]
+		pools = pools[1:]
+		return db, nil
+	}
+	t.Cleanup(func() { openDB = originalOpen })
+
+	cfg.DSN = "postgres://primary"
+	cfg.ReplicaDSNs = []string{"postgres://replica"}
+	cfg.ReplicaCheckInterval = time.Hour
+	db, err := NewDatabaseWithConfig(cfg)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		primaryMock.ExpectClose()
+		replicaMock.ExpectClose()
+		require.NoError(t, db.Close())
+		require.NoError(t, primaryMock.ExpectationsWereMet())
+		require.NoError(t, replicaMock.ExpectationsWereMet())
+	})
+	return &replicaFixture{db: db, primary: primaryMock, replica: replicaMock}
+}
+
+func TestReadRouterPrefersHealthyReplica(t *testing.T) {
+	f := newReplicaDatabase(t, ConnectionConfig{ServiceName: "query-service"}, []driver.Value{true, true, nil})
+
+	statuses := f.db.ReplicaStatuses()
+	require.Len(t, statuses, 1)
+	require.True(t, statuses[0].Healthy)
+	require.Zero(t, statuses[0].Lag)
+
+	f.replica.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(1))
+	rows, err := f.db.Reads().QueryContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	// 强制主库读
+	f.primary.ExpectQuery("SELECT 2").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(2))
+	var v int
+	require.NoError(t, f.db.Reads().QueryRowContext(WithPrimaryRead(context.Background()), "SELECT 2").Scan(&v))
+	require.Equal(t, 2, v)
+
+	// 写入令牌晚于副本回放时间点：读自己的写入走主库
+	f.primary.ExpectQuery("SELECT 3").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(3))
+	ctx := WithWriteToken(context.Background(), time.Now())
+	require.NoError(t, f.db.Reads().QueryRowContext(ctx, "SELECT 3").Scan(&v))
+	require.Equal(t, 3, v)
+
+	// 写入令牌早于副本回放时间点：副本已包含该写入
+	f.replica.ExpectQuery("SELECT 4").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(4))
+	ctx = WithWriteToken(context.Background(), time.Now().Add(-time.Minute))
+	require.NoError(t, f.db.Reads().QueryRowContext(ctx, "SELECT 4").Scan(&v))
+	require.Equal(t, 4, v)
+}
+
+func TestReadRouterRoutesRecentWritesToPrimary(t *testing.T) {
+	f := newReplicaDatabase(t, ConnectionConfig{MaxReplicaLag: 10 * time.Second}, []driver.Value{true, true, nil})
+
+	// 副本探测显示已追平且探测时间晚于写入，但写入仍在延迟窗口内：副本可能尚未接收该 WAL，走主库
+	writtenAt := time.Now().Add(-2 * time.Second)
+	f.db.replicas.replicas[0].update(func(st *ReplicaStatus) { st.CurrentAt = time.Now() })
+	f.primary.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(1))
+	var v int
+	require.NoError(t, f.db.Reads().QueryRowContext(WithWriteToken(context.Background(), writtenAt), "SELECT 1").Scan(&v))
+	require.Equal(t, 1, v)
+
+	// 令牌来自时钟超前的主机
+	f.primary.ExpectQuery("SELECT 2").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(2))
+	require.NoError(t, f.db.Reads().QueryRowContext(WithWriteToken(context.Background(), time.Now().Add(3*time.Second)), "SELECT 2").Scan(&v))
+	require.Equal(t, 2, v)
+
+	// 超出延迟窗口后恢复副本读
+	f.replica.ExpectQuery("SELECT 3").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(3))
+	require.NoError(t, f.db.Reads().QueryRowContext(WithWriteToken(context.Background(), time.Now().Add(-11*time.Second)), "SELECT 3").Scan(&v))
+	require.Equal(t, 3, v)
+	// 远超延迟窗口的未来令牌视为无效，不能把读请求长期钉在主库
+	f.replica.ExpectQuery("SELECT 4").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(4))
+	require.NoError(t, f.db.Reads().QueryRowContext(WithWriteToken(context.Background(), time.Now().Add(24*time.Hour)), "SELECT 4").Scan(&v))
+	require.Equal(t, 4, v)
+}
+
+func TestReadRouterWithoutDatabaseFailsWithError(t *testing.T) {
+	var db *Database
+	_, err := db.Reads().QueryContext(context.Background(), "SELECT 1")
+	require.ErrorIs(t, err, ErrDatabaseNotInitialized)
+
+	row := db.Reads().QueryRowContext(context.Background(), "SELECT 1")
+	require.NotNil(t, row)
+	var v int
+	require.ErrorIs(t, row.Scan(&v), ErrDatabaseNotInitialized)
+}
+
+func TestReadRouterFallsBackOnReplicaLag(t *testing.T) {
+	replayedAt := time.Now().Add(-30 * time.Second)
+	f := newReplicaDatabase(t, ConnectionConfig{MaxReplicaLag: 10 * time.Second}, []driver.Value{true, false, replayedAt})
+
+	status := f.db.ReplicaStatuses()[0]
+	require.True(t, status.Healthy)
+	require.GreaterOrEqual(t, status.Lag, 30*time.Second)
+
+	f.primary.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(1))
+	rows, err := f.db.Reads().QueryContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+}
+
+func TestReadRouterRetriesPrimaryOnReplicaConnectionError(t *testing.T) {
+	f := newReplicaDatabase(t, ConnectionConfig{}, []driver.Value{true, true, nil})
+
+	f.replica.ExpectQuery("SELECT 1").WillReturnError(&net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")})
+	f.primary.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(1))
+	rows, err := f.db.Reads().QueryContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	status := f.db.ReplicaStatuses()[0]
+	require.False(t, status.Healthy)
+	require.NotEmpty(t, status.LastError)
+
+	// 摘除后直接走主库，直到下一轮探测恢复
+	f.primary.ExpectQuery("SELECT 2").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(2))
+	rows, err = f.db.Reads().QueryContext(context.Background(), "SELECT 2")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+}
+
+func TestReadRouterWithoutReplicasUsesPrimary(t *testing.T) {
+	db, mock, cleanup := newMockDatabase(t, ConnectionConfig{DSN: "postgres://test/primary-only"})
+	defer cleanup()
+
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(1))
+	rows, err := db.Reads().QueryContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	require.Nil(t, db.ReplicaStatuses())
+}
+
+func TestWriteTokenRoundTrip(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	parsed, err := ParseWriteToken(FormatWriteToken(now))
+	require.NoError(t, err)
+	require.True(t, parsed.Equal(now))
+
+	_, err = ParseWriteToken("not-a-token")
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"cube-castle/pkg/tracing"
+)
+
+// maxTracedStatementLength 限制写入 span 的 SQL 长度，避免超长语句撑大追踪数据。
+const maxTracedStatementLength = 512
+
+// startQuerySpan 为单条 SQL 开启客户端 span；仅记录语句模板，不记录参数值。
+func startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := extractQueryType(query)
+	name := "db." + operation
+	if operation == "" {
+		name = "db.query"
+	}
+	statement := strings.Join(strings.Fields(query), " ")
+	if len(statement) > maxTracedStatementLength {
+		statement = statement[:maxTracedStatementLength] + "..."
+	}
+	return tracing.Tracer("database").Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", statement),
+		),
+	)
+}
+
+func replicaAttribute(name string) attribute.KeyValue {
+	return attribute.String("db.replica", name)
+}
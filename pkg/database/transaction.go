@@ -4,6 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"cube-castle/pkg/tracing"
 )
 
 // Transaction 定义事务接口。
@@ -28,15 +32,24 @@ func WrapSQLTx(tx *sql.Tx) Transaction {
 }
 
 func (a *txAdapter) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
-	return a.tx.ExecContext(ctx, query, args...)
+	ctx, span := startQuerySpan(ctx, query)
+	result, err := a.tx.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return result, err
 }
 
 func (a *txAdapter) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
-	return a.tx.QueryContext(ctx, query, args...)
+	ctx, span := startQuerySpan(ctx, query)
+	rows, err := a.tx.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
 }
 
 func (a *txAdapter) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
-	return a.tx.QueryRowContext(ctx, query, args...)
+	ctx, span := startQuerySpan(ctx, query)
+	row := a.tx.QueryRowContext(ctx, query, args...)
+	tracing.End(span, row.Err())
+	return row
 }
 
 func (a *txAdapter) Commit() error {
@@ -50,28 +63,30 @@ func (a *txAdapter) Rollback() error {
 // TxFunc 定义事务操作回调。
 type TxFunc func(ctx context.Context, tx Transaction) error
 
-// WithTx 在事务中执行回调，自动提交或回滚。
+// WithTx 在事务中执行回调，自动提交或回滚；序列化失败与死锁按默认策略重试。
 func (d *Database) WithTx(ctx context.Context, fn TxFunc) error {
+	return d.WithTxOptions(ctx, DefaultTxOptions(), fn)
+}
+
+// WithTxOptions 按指定隔离级别与重试策略执行事务回调。
+func (d *Database) WithTxOptions(ctx context.Context, opts TxOptions, fn TxFunc) error {
 	if d == nil || d.db == nil {
 		return ErrDatabaseNotInitialized
 	}
 
-	tx, err := d.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
+	ctx, span := tracing.Start(ctx, "database", "db.transaction")
+	attempts, err := retryTx(ctx, opts, func(ctx context.Context) error {
+		return d.runTx(ctx, opts, fn)
+	})
+	span.SetAttributes(attribute.Int("db.tx.attempts", attempts))
+	tracing.End(span, err)
+	return err
+}
 
-	err = fn(ctx, &txAdapter{tx: tx})
+func (d *Database) runTx(ctx context.Context, opts TxOptions, fn TxFunc) error {
+	tx, err := d.db.BeginTx(ctx, opts.sqlOptions())
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			return fmt.Errorf("operation failed: %w, rollback failed: %v", err, rbErr)
-		}
-		return err
-	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-
-	return nil
+	return finishTx(tx, fn(ctx, &txAdapter{tx: tx}))
 }
@@ -8,6 +8,9 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestWithTxCommit(t *testing.T) {
@@ -28,6 +31,40 @@ func TestWithTxCommit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWithTxRecordsTraceSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	cfg := ConnectionConfig{DSN: "postgres://test/tx-trace"}
+	db, mock, cleanup := newMockDatabase(t, cfg)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO operations").WillReturnError(errors.New("duplicate key"))
+	mock.ExpectRollback()
+
+	err := db.WithTx(context.Background(), func(ctx context.Context, tx Transaction) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO operations(name)\n\tVALUES ($1)", "dup")
+		return err
+	})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	query, txSpan := spans[0], spans[1]
+	require.Equal(t, "db.insert", query.Name())
+	require.Equal(t, "db.transaction", txSpan.Name())
+	require.Equal(t, txSpan.SpanContext().SpanID(), query.Parent().SpanID())
+	require.Equal(t, "Error", query.Status().Code.String())
+	for _, attr := range query.Attributes() {
+		if attr.Key == "db.statement" {
+			require.Equal(t, "INSERT INTO operations(name) VALUES ($1)", attr.Value.AsString())
+		}
+	}
+}
+
 func TestWithTxRollbackOnError(t *testing.T) {
 	cfg := ConnectionConfig{DSN: "postgres://test/tx-rollback"}
 	db, mock, cleanup := newMockDatabase(t, cfg)
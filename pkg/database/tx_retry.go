@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// DefaultTxMaxAttempts 默认事务最大尝试次数（含首次执行）。
+	DefaultTxMaxAttempts = 3
+	// DefaultTxRetryBaseDelay 重试退避基准时长。
+	DefaultTxRetryBaseDelay = 20 * time.Millisecond
+	// DefaultTxRetryMaxDelay 单次重试退避上限。
+	DefaultTxRetryMaxDelay = 500 * time.Millisecond
+)
+
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+
+	retryReasonSerialization = "serialization_failure"
+	retryReasonDeadlock      = "deadlock"
+
+	retryGiveUpExhausted  = "exhausted"
+	retryGiveUpSideEffect = "side_effect"
+)
+
+// TxOptions 定义事务隔离级别与冲突重试策略。
+type TxOptions struct {
+	// Isolation 事务隔离级别，零值使用数据库默认级别（PostgreSQL 为 READ COMMITTED）。
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxAttempts 最大尝试次数（含首次执行），<=0 使用 DefaultTxMaxAttempts，1 表示不重试。
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Operation 指标中的操作名，便于定位冲突热点。
+	Operation string
+}
+
+// DefaultTxOptions 返回默认事务选项。
+func DefaultTxOptions() TxOptions {
+	return TxOptions{
+		MaxAttempts: DefaultTxMaxAttempts,
+		BaseDelay:   DefaultTxRetryBaseDelay,
+		MaxDelay:    DefaultTxRetryMaxDelay,
+	}
+}
+
+func (o TxOptions) normalize() TxOptions {
+	opts := o
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultTxMaxAttempts
+	}
+	if opts.BaseDelay <= 0 {
+		opts.BaseDelay = DefaultTxRetryBaseDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultTxRetryMaxDelay
+	}
+	if opts.MaxDelay < opts.BaseDelay {
+		opts.MaxDelay = opts.BaseDelay
+	}
+	if strings.TrimSpace(opts.Operation) == "" {
+		opts.Operation = "default"
+	}
+	return opts
+}
+
+func (o TxOptions) sqlOptions() *sql.TxOptions {
+	if o.Isolation == sql.LevelDefault && !o.ReadOnly {
+		return nil
+	}
+	return &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly}
+}
+
+// backoff 指数退避加全抖动，避免冲突事务同时重试再次冲突。
+func (o TxOptions) backoff(retry int) time.Duration {
+	ceiling := o.BaseDelay << uint(retry)
+	if ceiling <= 0 || ceiling > o.MaxDelay {
+		ceiling = o.MaxDelay
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// IsRetryableTxError 判断错误是否为可安全重试的事务冲突（SQLSTATE 40001 序列化失败 / 40P01 死锁）。
+func IsRetryableTxError(err error) bool {
+	return txRetryReason(err) != ""
+}
+
+func txRetryReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	var state string
+	var pqErr *pq.Error
+	var stateErr interface{ SQLState() string }
+	switch {
+	case errors.As(err, &pqErr):
+		state = string(pqErr.Code)
+	case errors.As(err, &stateErr):
+		state = stateErr.SQLState()
+	}
+	switch state {
+	case sqlStateSerializationFailure:
+		return retryReasonSerialization
+	case sqlStateDeadlockDetected:
+		return retryReasonDeadlock
+	}
+	return ""
+}
+
+type txAttemptKey struct{}
+
+// txAttempt 记录单次事务尝试中登记的副作用。
+type txAttempt struct {
+	mu         sync.Mutex
+	onCommit   []func()
+	sideEffect bool
+}
+
+func attemptFromContext(ctx context.Context) *txAttempt {
+	attempt, _ := ctx.Value(txAttemptKey{}).(*txAttempt)
+	return attempt
+}
+
+// OnCommit 登记事务提交成功后执行且仅执行一次的副作用（如发送通知、刷新缓存）；
+// 尝试被回滚或重试时登记内容随之丢弃。在受管事务之外调用时立即执行。
+func OnCommit(ctx context.Context, fn func()) {
+	if fn == nil {
+		return
+	}
+	attempt := attemptFromContext(ctx)
+	if attempt == nil {
+		fn()
+		return
+	}
+	attempt.mu.Lock()
+	defer attempt.mu.Unlock()
+	attempt.onCommit = append(attempt.onCommit, fn)
+}
+
+// MarkSideEffect 标记当前尝试已产生无法随事务回滚的副作用（如已调用外部接口），
+// 此后即使遇到可重试冲突也不再重新执行回调，直接返回错误。
+func MarkSideEffect(ctx context.Context) {
+	if attempt := attemptFromContext(ctx); attempt != nil {
+		attempt.mu.Lock()
+		attempt.sideEffect = true
+		attempt.mu.Unlock()
+	}
+}
+
+func (a *txAttempt) committed() {
+	a.mu.Lock()
+	hooks := a.onCommit
+	a.onCommit = nil
+	a.mu.Unlock()
+	for _, fn := range hooks {
+		fn()
+	}
+}
+
+func (a *txAttempt) hasSideEffect() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sideEffect
+}
+
+// retryTx 执行事务尝试，遇到可重试冲突时按退避策略重新执行；返回实际尝试次数。
+func retryTx(ctx context.Context, opts TxOptions, run func(ctx context.Context) error) (int, error) {
+	opts = opts.normalize()
+	for attemptNo := 1; ; attemptNo++ {
+		attempt := &txAttempt{}
+		err := run(context.WithValue(ctx, txAttemptKey{}, attempt))
+		if err == nil {
+			attempt.committed()
+			return attemptNo, nil
+		}
+
+		reason := txRetryReason(err)
+		if reason == "" {
+			return attemptNo, err
+		}
+		if attempt.hasSideEffect() {
+			recordTxRetryGiveUp(opts.Operation, reason, retryGiveUpSideEffect)
+			return attemptNo, err
+		}
+		if attemptNo >= opts.MaxAttempts {
+			recordTxRetryGiveUp(opts.Operation, reason, retryGiveUpExhausted)
+			return attemptNo, fmt.Errorf("transaction failed after %d attempts: %w", attemptNo, err)
+		}
+
+		recordTxRetry(opts.Operation, reason)
+		timer := time.NewTimer(opts.backoff(attemptNo - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attemptNo, err
+		case <-timer.C:
+		}
+	}
+}
+
+// SQLTxFunc 定义基于 *sql.Tx 的事务回调，供直接持有 *sql.DB 的仓储与服务使用。
+// 回调可能因冲突被重复执行，需保持幂等；不可重复的副作用应通过 OnCommit 或 MarkSideEffect 处理。
+type SQLTxFunc func(ctx context.Context, tx *sql.Tx) error
+
+// RunTx 在 *sql.DB 上按给定隔离级别执行事务回调，自动提交或回滚，并对序列化失败与死锁进行有界重试。
+func RunTx(ctx context.Context, db *sql.DB, opts TxOptions, fn SQLTxFunc) error {
+	if db == nil {
+		return ErrDatabaseNotInitialized
+	}
+	_, err := retryTx(ctx, opts, func(ctx context.Context) error {
+		tx, err := db.BeginTx(ctx, opts.sqlOptions())
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		return finishTx(tx, fn(ctx, tx))
+	})
+	return err
+}
+
+func finishTx(tx *sql.Tx, err error) error {
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return fmt.Errorf("operation failed: %w, rollback failed: %v", err, rbErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func fastRetryOptions(operation string) TxOptions {
+	return TxOptions{
+		Isolation:   sql.LevelSerializable,
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    2 * time.Millisecond,
+		Operation:   operation,
+	}
+}
+
+func TestWithTxOptionsRetriesSerializationFailure(t *testing.T) {
+	db, mock, cleanup := newMockDatabase(t, ConnectionConfig{DSN: "postgres://test/tx-retry"})
+	defer cleanup()
+	mock.MatchExpectationsInOrder(true)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE positions").WillReturnError(&pq.Error{Code: "40001"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE positions").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	before := testutil.ToFloat64(dbTxRetriesTotal.WithLabelValues("test.serialization", retryReasonSerialization))
+	committed := 0
+	attempts := 0
+	err := db.WithTxOptions(context.Background(), fastRetryOptions("test.serialization"), func(ctx context.Context, tx Transaction) error {
+		attempts++
+		OnCommit(ctx, func() { committed++ })
+		_, err := tx.ExecContext(ctx, "UPDATE positions SET status = $1", "FILLED")
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, 1, committed, "commit hooks of the failed attempt must be discarded")
+	require.Equal(t, before+1, testutil.ToFloat64(dbTxRetriesTotal.WithLabelValues("test.serialization", retryReasonSerialization)))
+}
+
+func TestRunTxGivesUpAfterMaxAttempts(t *testing.T) {
+	rawDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer rawDB.Close()
+
+	for i := 0; i < 3; i++ {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+	}
+
+	attempts := 0
+	err = RunTx(context.Background(), rawDB, fastRetryOptions("test.deadlock"), func(_ context.Context, _ *sql.Tx) error {
+		attempts++
+		return fmt.Errorf("recalculate timeline: %w", &pq.Error{Code: "40P01"})
+	})
+	require.Error(t, err)
+	require.Equal(t, 3, attempts)
+	require.True(t, IsRetryableTxError(err))
+	require.Contains(t, err.Error(), "after 3 attempts")
+	require.Equal(t, float64(1), testutil.ToFloat64(dbTxRetryGiveUpsTotal.WithLabelValues("test.deadlock", retryReasonDeadlock, retryGiveUpExhausted)))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunTxDoesNotRetryAfterSideEffect(t *testing.T) {
+	rawDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer rawDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	attempts := 0
+	err = RunTx(context.Background(), rawDB, fastRetryOptions("test.side_effect"), func(ctx context.Context, _ *sql.Tx) error {
+		attempts++
+		MarkSideEffect(ctx)
+		return &pq.Error{Code: "40001"}
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunTxDoesNotRetryOtherErrors(t *testing.T) {
+	rawDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer rawDB.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	uniqueViolation := &pq.Error{Code: "23505"}
+	err = RunTx(context.Background(), rawDB, fastRetryOptions("test.unique"), func(_ context.Context, _ *sql.Tx) error {
+		return uniqueViolation
+	})
+	require.ErrorIs(t, err, uniqueViolation)
+	require.False(t, IsRetryableTxError(err))
+	require.False(t, IsRetryableTxError(errors.New("40001")))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOnCommitOutsideTransactionRunsImmediately(t *testing.T) {
+	ran := false
+	OnCommit(context.Background(), func() { ran = true })
+	require.True(t, ran)
+	MarkSideEffect(context.Background())
+}
@@ -4,6 +4,7 @@ import "encoding/json"
 
 // GenericJSONEvent 是一个通用的事件载体，便于 outbox dispatcher 将 JSON 负载映射到事件总线。
 type GenericJSONEvent struct {
+	eventID       string
 	eventType     string
 	aggregateID   string
 	aggregateType string
@@ -20,6 +21,17 @@ func NewGenericJSONEvent(eventType, aggregateID, aggregateType string, payload j
 	}
 }
 
+// WithEventID 返回携带来源事件 ID（如 outbox event_id）的副本，订阅方可据此做幂等。
+func (e GenericJSONEvent) WithEventID(eventID string) GenericJSONEvent {
+	e.eventID = eventID
+	return e
+}
+
+// EventID 返回来源事件 ID，未设置时为空字符串。
+func (e GenericJSONEvent) EventID() string {
+	return e.eventID
+}
+
 // EventType 实现 Event 接口。
 func (e GenericJSONEvent) EventType() string {
 	return e.eventType
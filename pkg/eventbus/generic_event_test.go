@@ -14,6 +14,8 @@ func TestGenericJSONEvent(t *testing.T) {
 	require.Equal(t, "organization.created", event.EventType())
 	require.Equal(t, "agg-1", event.AggregateID())
 	require.Equal(t, "organization", event.AggregateType())
+	require.Empty(t, event.EventID())
+	require.Equal(t, "evt-1", event.WithEventID("evt-1").EventID())
 
 	out := event.Payload()
 	require.JSONEq(t, string(payload), string(out))
@@ -0,0 +1,195 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config describes the sinks, sampling and redaction of a process-wide logger.
+type Config struct {
+	Level Level
+	// File enables a rotating JSON log file in addition to stdout.
+	File FileConfig
+	// Syslog enables forwarding to a syslog daemon.
+	Syslog SyslogConfig
+	// OTLP enables the OTLP/HTTP logs exporter when Endpoint is set.
+	OTLP OTLPConfig
+	// DebugSampling limits repeated DEBUG messages; nil disables sampling.
+	DebugSampling *SamplingPolicy
+	// RedactFields are masked in addition to the DefaultRedactor keys.
+	RedactFields []string
+	// DisableRedaction turns field redaction off entirely (local debugging only).
+	DisableRedaction bool
+}
+
+// FileConfig configures the rotating file sink.
+type FileConfig struct {
+	Path       string
+	MaxSizeMB  int
+	MaxBackups int
+}
+
+// SyslogConfig configures the syslog sink.
+type SyslogConfig struct {
+	Enabled bool
+	// Network/Address are empty for the local daemon, or e.g. udp / host:514.
+	Network string
+	Address string
+	Tag     string
+}
+
+// LoadConfigFromEnv reads LOG_* variables and the OpenTelemetry logs exporter
+// variables (OTEL_LOGS_EXPORTER=otlp, OTEL_EXPORTER_OTLP_LOGS_ENDPOINT or
+// OTEL_EXPORTER_OTLP_ENDPOINT). Unset variables keep the stdout-only default.
+func LoadConfigFromEnv(serviceName string) Config {
+	cfg := Config{
+		Level: LevelInfo,
+		File: FileConfig{
+			Path:       strings.TrimSpace(os.Getenv("LOG_FILE")),
+			MaxSizeMB:  envInt("LOG_FILE_MAX_SIZE_MB", 100),
+			MaxBackups: envInt("LOG_FILE_MAX_BACKUPS", 5),
+		},
+	}
+	if lvl, err := ParseLevel(os.Getenv("LOG_LEVEL")); err == nil {
+		cfg.Level = lvl
+	}
+
+	if target := strings.TrimSpace(os.Getenv("LOG_SYSLOG")); target != "" {
+		cfg.Syslog = SyslogConfig{Enabled: true, Tag: serviceName}
+		if target != "local" {
+			if u, err := url.Parse(target); err == nil && u.Host != "" {
+				cfg.Syslog.Network = u.Scheme
+				cfg.Syslog.Address = u.Host
+			}
+		}
+	}
+
+	if strings.EqualFold(strings.TrimSpace(os.Getenv("OTEL_LOGS_EXPORTER")), "otlp") {
+		insecure, _ := strconv.ParseBool(os.Getenv("OTEL_EXPORTER_OTLP_INSECURE"))
+		endpoint := strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"))
+		signalSpecific := endpoint != ""
+		if !signalSpecific {
+			endpoint = strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+		}
+		if endpoint == "" {
+			endpoint = "localhost:4318"
+		}
+		name := strings.TrimSpace(os.Getenv("OTEL_SERVICE_NAME"))
+		if name == "" {
+			name = serviceName
+		}
+		cfg.OTLP = OTLPConfig{
+			Endpoint:    resolveOTLPLogsURL(endpoint, insecure, signalSpecific),
+			ServiceName: name,
+		}
+	}
+
+	if first := envInt("LOG_DEBUG_SAMPLE_FIRST", 0); first > 0 {
+		cfg.DebugSampling = &SamplingPolicy{
+			First:      first,
+			Thereafter: envInt("LOG_DEBUG_SAMPLE_THEREAFTER", 100),
+			Tick:       time.Second,
+		}
+	}
+
+	for _, f := range strings.Split(os.Getenv("LOG_REDACT_FIELDS"), ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			cfg.RedactFields = append(cfg.RedactFields, f)
+		}
+	}
+	cfg.DisableRedaction, _ = strconv.ParseBool(os.Getenv("LOG_REDACTION_DISABLED"))
+	return cfg
+}
+
+// resolveOTLPLogsURL follows the OpenTelemetry convention: a signal-specific
+// endpoint is used as-is, a base endpoint gets /v1/logs appended, and host:port
+// values get a scheme derived from insecure.
+func resolveOTLPLogsURL(endpoint string, insecure, signalSpecific bool) string {
+	if !strings.Contains(endpoint, "://") {
+		scheme := "https"
+		if insecure {
+			scheme = "http"
+		}
+		endpoint = scheme + "://" + endpoint
+		signalSpecific = false
+	}
+	if signalSpecific {
+		return endpoint
+	}
+	return strings.TrimRight(endpoint, "/") + "/v1/logs"
+}
+
+// NewFromConfig builds a logger writing to stdout plus the configured sinks.
+// opts are applied after the configuration (e.g. to override the level or
+// writer). The returned close function flushes and releases all sinks.
+func NewFromConfig(cfg Config, opts ...Option) (Logger, func() error, error) {
+	var (
+		sinks   []Sink
+		closers []io.Closer
+	)
+	closeAll := func() error {
+		var errs []error
+		for i := len(closers) - 1; i >= 0; i-- {
+			if err := closers[i].Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	if cfg.File.Path != "" {
+		rf, err := NewRotatingFile(cfg.File.Path, cfg.File.MaxSizeMB, cfg.File.MaxBackups)
+		if err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, WriterSink(rf))
+		closers = append(closers, rf)
+	}
+	if cfg.Syslog.Enabled {
+		s, err := NewSyslogSink(cfg.Syslog.Network, cfg.Syslog.Address, cfg.Syslog.Tag)
+		if err != nil {
+			_ = closeAll()
+			return nil, nil, err
+		}
+		sinks = append(sinks, s)
+		closers = append(closers, s)
+	}
+	if cfg.OTLP.Endpoint != "" {
+		s, err := NewOTLPSink(cfg.OTLP)
+		if err != nil {
+			_ = closeAll()
+			return nil, nil, fmt.Errorf("logger: otlp sink: %w", err)
+		}
+		sinks = append(sinks, s)
+		closers = append(closers, s)
+	}
+
+	all := []Option{WithLevel(cfg.Level)}
+	for _, s := range sinks {
+		all = append(all, WithSink(s))
+	}
+	if cfg.DebugSampling != nil {
+		all = append(all, WithSampling(LevelDebug, *cfg.DebugSampling))
+	}
+	switch {
+	case cfg.DisableRedaction:
+		all = append(all, WithRedactor(nil))
+	case len(cfg.RedactFields) > 0:
+		all = append(all, WithRedactor(DefaultRedactor().WithKeys(cfg.RedactFields...)))
+	}
+	all = append(all, opts...)
+	return NewLogger(all...), closeAll, nil
+}
+
+func envInt(key string, fallback int) int {
+	if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv(key))); err == nil {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,131 @@
+package logger
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLevelKey addresses the base level in LevelController snapshots.
+const DefaultLevelKey = "default"
+
+// LevelController holds runtime level overrides shared by every logger derived
+// from the root it is attached to. Overrides are keyed by the "module" or
+// "component" field of the logger (module takes precedence); loggers without
+// a matching override use the base level.
+type LevelController struct {
+	mu        sync.RWMutex
+	base      Level
+	baseSet   bool
+	overrides map[string]Level
+}
+
+// NewLevelController creates a controller; its base level is taken from the
+// first logger it is attached to unless SetBaseLevel was called before.
+func NewLevelController() *LevelController {
+	return &LevelController{overrides: make(map[string]Level)}
+}
+
+// WithLevelController makes the logger consult c for its effective level.
+func WithLevelController(c *LevelController) Option {
+	return func(l *structuredLogger) {
+		l.levels = c
+	}
+}
+
+func (c *LevelController) bind(level Level) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.baseSet {
+		c.base = level
+		c.baseSet = true
+	}
+}
+
+// BaseLevel returns the level applied to loggers without an override.
+func (c *LevelController) BaseLevel() Level {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.base
+}
+
+// SetBaseLevel changes the level applied to loggers without an override.
+func (c *LevelController) SetBaseLevel(level Level) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.base = level
+	c.baseSet = true
+}
+
+// SetLevel overrides the level of a component/module.
+func (c *LevelController) SetLevel(name string, level Level) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == DefaultLevelKey {
+		c.SetBaseLevel(level)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.overrides[name] = level
+}
+
+// ResetLevel removes the override of a component/module.
+func (c *LevelController) ResetLevel(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.overrides, strings.TrimSpace(name))
+}
+
+// ReplaceOverrides atomically replaces all overrides.
+func (c *LevelController) ReplaceOverrides(overrides map[string]Level) {
+	next := make(map[string]Level, len(overrides))
+	for k, v := range overrides {
+		if k = strings.TrimSpace(k); k != "" {
+			next[k] = v
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.overrides = next
+}
+
+// Overrides returns the current overrides as textual levels.
+func (c *LevelController) Overrides() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]string, len(c.overrides))
+	for k, v := range c.overrides {
+		out[k] = v.String()
+	}
+	return out
+}
+
+// Components returns the names that currently have an override, sorted.
+func (c *LevelController) Components() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	names := make([]string, 0, len(c.overrides))
+	for k := range c.overrides {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *LevelController) effective(module, component string) Level {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.overrides) > 0 {
+		if module != "" {
+			if lvl, ok := c.overrides[module]; ok {
+				return lvl
+			}
+		}
+		if component != "" {
+			if lvl, ok := c.overrides[component]; ok {
+				return lvl
+			}
+		}
+	}
+	return c.base
+}
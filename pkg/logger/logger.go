@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Level represents the minimum severity that will be recorded by a Logger.
@@ -71,6 +74,8 @@ type Logger interface {
 	Error(msg string)
 	Errorf(format string, args ...interface{})
 	WithFields(fields Fields) Logger
+	// WithContext attaches trace/span identifiers carried by ctx (if any).
+	WithContext(ctx context.Context) Logger
 }
 
 // Option configures a structured logger instance.
@@ -78,10 +83,14 @@ type Option func(*structuredLogger)
 
 type structuredLogger struct {
 	writer     io.Writer
+	sinks      []Sink
 	level      Level
 	fields     Fields
 	now        func() time.Time
 	callerSkip int
+	sampler    *sampler
+	redactor   *Redactor
+	levels     *LevelController
 	lock       *sync.Mutex
 }
 
@@ -93,11 +102,18 @@ func NewLogger(opts ...Option) Logger {
 		fields:     Fields{},
 		now:        time.Now,
 		callerSkip: 0,
+		redactor:   DefaultRedactor(),
 		lock:       &sync.Mutex{},
 	}
 	for _, opt := range opts {
 		opt(l)
 	}
+	if l.sampler != nil {
+		l.sampler.now = l.now
+	}
+	if l.levels != nil {
+		l.levels.bind(l.level)
+	}
 	return l
 }
 
@@ -126,6 +142,32 @@ func WithWriter(w io.Writer) Option {
 	}
 }
 
+// WithSink adds an additional sink that receives every entry written to the primary writer.
+func WithSink(s Sink) Option {
+	return func(l *structuredLogger) {
+		if s != nil {
+			l.sinks = append(l.sinks, s)
+		}
+	}
+}
+
+// WithSampling limits repeated messages at the given level according to policy.
+func WithSampling(level Level, policy SamplingPolicy) Option {
+	return func(l *structuredLogger) {
+		if l.sampler == nil {
+			l.sampler = newSampler()
+		}
+		l.sampler.policies[level] = policy
+	}
+}
+
+// WithRedactor replaces the default field redaction policy; nil disables redaction.
+func WithRedactor(r *Redactor) Option {
+	return func(l *structuredLogger) {
+		l.redactor = r
+	}
+}
+
 // WithTimestampFunc overrides the timestamp provider (primarily for testing).
 func WithTimestampFunc(fn func() time.Time) Option {
 	return func(l *structuredLogger) {
@@ -189,23 +231,36 @@ func (l *structuredLogger) WithFields(fields Fields) Logger {
 		merged[k] = v
 	}
 
-	return &structuredLogger{
-		writer:     l.writer,
-		level:      l.level,
-		fields:     merged,
-		now:        l.now,
-		callerSkip: l.callerSkip,
-		lock:       l.lock,
+	child := *l
+	child.fields = merged
+	return &child
+}
+
+func (l *structuredLogger) WithContext(ctx context.Context) Logger {
+	if ctx == nil {
+		return l
 	}
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return l
+	}
+	return l.WithFields(Fields{
+		"traceId": sc.TraceID().String(),
+		"spanId":  sc.SpanID().String(),
+	})
 }
 
 func (l *structuredLogger) log(level Level, message string) {
-	if level < l.level || l == nil {
+	if l == nil || level < l.minLevel() {
+		return
+	}
+	if l.sampler != nil && !l.sampler.allow(level, message) {
 		return
 	}
 
+	now := l.now()
 	entry := logEntry{
-		Timestamp: l.now().UTC().Format(time.RFC3339Nano),
+		Timestamp: now.UTC().Format(time.RFC3339Nano),
 		Level:     level.String(),
 		Message:   message,
 		Caller:    l.caller(),
@@ -216,6 +271,9 @@ func (l *structuredLogger) log(level Level, message string) {
 		for k, v := range l.fields {
 			entry.Fields[k] = v
 		}
+		if l.redactor != nil {
+			entry.Fields = l.redactor.Redact(entry.Fields)
+		}
 	}
 
 	payload, err := json.Marshal(entry)
@@ -228,6 +286,31 @@ func (l *structuredLogger) log(level Level, message string) {
 	defer l.lock.Unlock()
 	_, _ = l.writer.Write(payload)
 	_, _ = l.writer.Write([]byte("\n"))
+	if len(l.sinks) == 0 {
+		return
+	}
+	record := Entry{
+		Time:    now,
+		Level:   level,
+		Message: message,
+		Caller:  entry.Caller,
+		Fields:  Fields(entry.Fields),
+		Encoded: payload,
+	}
+	for _, s := range l.sinks {
+		_ = s.WriteEntry(record)
+	}
+}
+
+// minLevel resolves the effective level, honouring runtime overrides for the
+// logger's module/component fields.
+func (l *structuredLogger) minLevel() Level {
+	if l.levels == nil {
+		return l.level
+	}
+	module, _ := l.fields["module"].(string)
+	component, _ := l.fields["component"].(string)
+	return l.levels.effective(module, component)
 }
 
 func (l *structuredLogger) caller() string {
@@ -283,12 +366,13 @@ func NewNoopLogger() Logger {
 	return noopLogger{}
 }
 
-func (noopLogger) Debug(string)                  {}
-func (noopLogger) Debugf(string, ...interface{}) {}
-func (noopLogger) Info(string)                   {}
-func (noopLogger) Infof(string, ...interface{})  {}
-func (noopLogger) Warn(string)                   {}
-func (noopLogger) Warnf(string, ...interface{})  {}
-func (noopLogger) Error(string)                  {}
-func (noopLogger) Errorf(string, ...interface{}) {}
-func (noopLogger) WithFields(Fields) Logger      { return noopLogger{} }
+func (noopLogger) Debug(string)                       {}
+func (noopLogger) Debugf(string, ...interface{})      {}
+func (noopLogger) Info(string)                        {}
+func (noopLogger) Infof(string, ...interface{})       {}
+func (noopLogger) Warn(string)                        {}
+func (noopLogger) Warnf(string, ...interface{})       {}
+func (noopLogger) Error(string)                       {}
+func (noopLogger) Errorf(string, ...interface{})      {}
+func (noopLogger) WithFields(Fields) Logger           { return noopLogger{} }
+func (noopLogger) WithContext(context.Context) Logger { return noopLogger{} }
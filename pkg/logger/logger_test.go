@@ -2,9 +2,12 @@ package logger
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestLoggerWithFields(t *testing.T) {
@@ -110,3 +113,144 @@ func TestParseLevel(t *testing.T) {
 		t.Fatal("expected error for invalid level")
 	}
 }
+
+func TestLoggerRedactsSensitiveFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger(WithWriter(buf), WithTimestampFunc(func() time.Time { return time.Unix(0, 0) }))
+
+	original := Fields{"employee_number": "E1001", "positionCode": "P0001"}
+	logger.WithFields(Fields{
+		"employeeName":  "张三",
+		"csrfToken":     "abc",
+		"auth":          "Bearer eyJhbGciOi",
+		"assignment":    original,
+		"tenantId":      "t-1",
+		"Set-Cookie":    "sid=1",
+		"refresh_token": "r",
+	}).Info("assignment created")
+
+	var entry struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to unmarshal log entry: %v", err)
+	}
+	for _, key := range []string{"employeeName", "csrfToken", "Set-Cookie", "refresh_token"} {
+		if entry.Fields[key] != RedactedValue {
+			t.Fatalf("expected %s to be redacted, got %v", key, entry.Fields[key])
+		}
+	}
+	if entry.Fields["auth"] != "Bearer "+RedactedValue {
+		t.Fatalf("expected bearer credential to be masked, got %v", entry.Fields["auth"])
+	}
+	nested := entry.Fields["assignment"].(map[string]interface{})
+	if nested["employee_number"] != RedactedValue || nested["positionCode"] != "P0001" {
+		t.Fatalf("unexpected nested redaction: %v", nested)
+	}
+	if entry.Fields["tenantId"] != "t-1" {
+		t.Fatalf("expected tenantId to be kept, got %v", entry.Fields["tenantId"])
+	}
+	if original["employee_number"] != "E1001" {
+		t.Fatal("redaction must not mutate caller fields")
+	}
+
+	buf.Reset()
+	NewLogger(WithWriter(buf), WithRedactor(nil)).WithFields(Fields{"employeeName": "张三"}).Info("raw")
+	if !bytes.Contains(buf.Bytes(), []byte("张三")) {
+		t.Fatalf("expected redaction to be disabled, got %s", buf.String())
+	}
+}
+
+func TestLoggerSampling(t *testing.T) {
+	buf := &bytes.Buffer{}
+	now := time.Unix(0, 0)
+	logger := NewLogger(
+		WithWriter(buf),
+		WithLevel(LevelDebug),
+		WithSampling(LevelDebug, SamplingPolicy{First: 2, Thereafter: 3, Tick: time.Second}),
+		WithTimestampFunc(func() time.Time { return now }),
+	)
+
+	for i := 0; i < 8; i++ {
+		logger.WithFields(Fields{"i": i}).Debug("cache probe")
+		logger.Info("not sampled")
+	}
+	// 前 2 条 + 第 5、8 条
+	if got := bytes.Count(buf.Bytes(), []byte("cache probe")); got != 4 {
+		t.Fatalf("expected 4 sampled debug entries, got %d", got)
+	}
+	if got := bytes.Count(buf.Bytes(), []byte("not sampled")); got != 8 {
+		t.Fatalf("expected all info entries, got %d", got)
+	}
+
+	buf.Reset()
+	now = now.Add(time.Second)
+	logger.Debug("cache probe")
+	if buf.Len() == 0 {
+		t.Fatal("expected sampling window to reset after tick")
+	}
+}
+
+func TestLoggerWithContextAddsTraceIDs(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger(WithWriter(buf))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	logger.WithContext(ctx).Info("traced")
+
+	var entry struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to unmarshal log entry: %v", err)
+	}
+	if entry.Fields["traceId"] != traceID.String() || entry.Fields["spanId"] != spanID.String() {
+		t.Fatalf("expected trace fields, got %v", entry.Fields)
+	}
+
+	if logger.WithContext(context.Background()) != logger {
+		t.Fatal("expected context without span to return the same logger")
+	}
+}
+
+func TestLevelControllerOverrides(t *testing.T) {
+	buf := &bytes.Buffer{}
+	levels := NewLevelController()
+	root := NewLogger(WithWriter(buf), WithLevel(LevelInfo), WithLevelController(levels))
+	dispatcher := root.WithFields(Fields{"component": "outbox-dispatcher"})
+	handler := root.WithFields(Fields{"module": "handler", "component": "outbox-dispatcher"})
+
+	dispatcher.Debug("suppressed")
+	if buf.Len() != 0 {
+		t.Fatalf("expected DEBUG to be filtered at base level, got %s", buf.String())
+	}
+
+	levels.SetLevel("outbox-dispatcher", LevelDebug)
+	dispatcher.Debug("enabled")
+	if buf.Len() == 0 {
+		t.Fatal("expected component override to enable DEBUG")
+	}
+
+	buf.Reset()
+	levels.SetLevel("handler", LevelError)
+	handler.Warn("module override wins")
+	if buf.Len() != 0 {
+		t.Fatalf("expected module override to take precedence, got %s", buf.String())
+	}
+
+	levels.ReplaceOverrides(nil)
+	levels.SetLevel(DefaultLevelKey, LevelWarn)
+	root.Info("suppressed by base")
+	dispatcher.Debug("override removed")
+	if buf.Len() != 0 {
+		t.Fatalf("expected base level WARN to filter output, got %s", buf.String())
+	}
+	if levels.BaseLevel() != LevelWarn || len(levels.Components()) != 0 {
+		t.Fatalf("unexpected controller state: base=%s components=%v", levels.BaseLevel(), levels.Components())
+	}
+}
@@ -0,0 +1,282 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultOTLPBatchSize     = 512
+	defaultOTLPQueueSize     = 4096
+	defaultOTLPFlushInterval = 2 * time.Second
+	otlpScopeName            = "cube-castle/pkg/logger"
+)
+
+// OTLPConfig configures the OTLP/HTTP logs exporter.
+type OTLPConfig struct {
+	// Endpoint is the full logs URL, e.g. http://collector:4318/v1/logs.
+	Endpoint      string
+	ServiceName   string
+	Headers       map[string]string
+	BatchSize     int
+	QueueSize     int
+	FlushInterval time.Duration
+	Timeout       time.Duration
+}
+
+// OTLPSink exports entries as OTLP log records (JSON encoding over HTTP) in
+// batches. Entries are queued without blocking the caller; when the queue is
+// full new entries are dropped and counted.
+type OTLPSink struct {
+	cfg     OTLPConfig
+	client  *http.Client
+	queue   chan Entry
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+	dropped atomic.Int64
+}
+
+// NewOTLPSink starts the background exporter.
+func NewOTLPSink(cfg OTLPConfig) (*OTLPSink, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("logger: otlp logs endpoint is required")
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultOTLPBatchSize
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultOTLPQueueSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultOTLPFlushInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	s := &OTLPSink{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		queue:  make(chan Entry, cfg.QueueSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+// WriteEntry implements Sink.
+func (s *OTLPSink) WriteEntry(e Entry) error {
+	select {
+	case s.queue <- e:
+	default:
+		s.dropped.Add(1)
+	}
+	return nil
+}
+
+// Dropped returns the number of entries discarded because the queue was full.
+func (s *OTLPSink) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close flushes queued entries and stops the exporter.
+func (s *OTLPSink) Close() error {
+	s.once.Do(func() { close(s.stop) })
+	<-s.done
+	return nil
+}
+
+func (s *OTLPSink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]Entry, 0, s.cfg.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.export(batch); err != nil {
+			// Export failures must not be logged through the logger itself (recursion).
+			fmt.Fprintf(os.Stderr, "logger: otlp export failed (%d records): %v\n", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case e := <-s.queue:
+			batch = append(batch, e)
+			if len(batch) >= s.cfg.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.stop:
+			for {
+				select {
+				case e := <-s.queue:
+					batch = append(batch, e)
+					if len(batch) >= s.cfg.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *OTLPSink) export(batch []Entry) error {
+	body, err := json.Marshal(s.encode(batch))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("collector responded %s", resp.Status)
+	}
+	return nil
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes,omitempty"`
+	TraceID        string         `json:"traceId,omitempty"`
+	SpanID         string         `json:"spanId,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpPayload struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func (s *OTLPSink) encode(batch []Entry) otlpPayload {
+	rl := otlpResourceLogs{}
+	if s.cfg.ServiceName != "" {
+		rl.Resource.Attributes = []otlpKeyValue{{Key: "service.name", Value: anyValue(s.cfg.ServiceName)}}
+	}
+	sl := otlpScopeLogs{}
+	sl.Scope.Name = otlpScopeName
+	sl.LogRecords = make([]otlpLogRecord, 0, len(batch))
+	for _, e := range batch {
+		rec := otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(e.Time.UnixNano(), 10),
+			SeverityNumber: otlpSeverity(e.Level),
+			SeverityText:   e.Level.String(),
+			Body:           anyValue(e.Message),
+		}
+		if e.Caller != "" {
+			rec.Attributes = append(rec.Attributes, otlpKeyValue{Key: "code.caller", Value: anyValue(e.Caller)})
+		}
+		for k, v := range e.Fields {
+			switch k {
+			case "traceId":
+				rec.TraceID, _ = v.(string)
+			case "spanId":
+				rec.SpanID, _ = v.(string)
+			default:
+				rec.Attributes = append(rec.Attributes, otlpKeyValue{Key: k, Value: anyValue(v)})
+			}
+		}
+		sl.LogRecords = append(sl.LogRecords, rec)
+	}
+	rl.ScopeLogs = []otlpScopeLogs{sl}
+	return otlpPayload{ResourceLogs: []otlpResourceLogs{rl}}
+}
+
+// otlpSeverity maps levels to the OTLP SeverityNumber base values.
+func otlpSeverity(l Level) int {
+	switch l {
+	case LevelDebug:
+		return 5
+	case LevelWarn:
+		return 13
+	case LevelError:
+		return 17
+	default:
+		return 9
+	}
+}
+
+func anyValue(v interface{}) otlpAnyValue {
+	switch val := v.(type) {
+	case string:
+		return otlpAnyValue{StringValue: &val}
+	case bool:
+		return otlpAnyValue{BoolValue: &val}
+	case int:
+		s := strconv.Itoa(val)
+		return otlpAnyValue{IntValue: &s}
+	case int64:
+		s := strconv.FormatInt(val, 10)
+		return otlpAnyValue{IntValue: &s}
+	case float64:
+		return otlpAnyValue{DoubleValue: &val}
+	case error:
+		s := val.Error()
+		return otlpAnyValue{StringValue: &s}
+	case fmt.Stringer:
+		s := val.String()
+		return otlpAnyValue{StringValue: &s}
+	default:
+		var s string
+		if b, err := json.Marshal(val); err == nil {
+			s = string(b)
+		} else {
+			s = fmt.Sprint(val)
+		}
+		return otlpAnyValue{StringValue: &s}
+	}
+}
@@ -0,0 +1,145 @@
+package logger
+
+import (
+	"strings"
+)
+
+// RedactedValue replaces the value of every field matched by a Redactor.
+const RedactedValue = "[REDACTED]"
+
+// defaultRedactedKeys lists PII and credential field names masked by DefaultRedactor.
+var defaultRedactedKeys = []string{
+	"employeeName",
+	"employeeNumber",
+	"password",
+	"authorization",
+	"cookie",
+	"setCookie",
+	"token",
+	"accessToken",
+	"refreshToken",
+	"idToken",
+	"apiKey",
+	"clientSecret",
+	"secret",
+}
+
+// defaultRedactedFragments masks any key containing one of these fragments
+// (e.g. csrfToken, webhookSecret, X-Session-Cookie).
+var defaultRedactedFragments = []string{"token", "password", "secret", "cookie"}
+
+// Redactor masks sensitive field values before entries reach any sink.
+// Keys are matched case-insensitively ignoring '_', '-' and '.', so
+// employeeName, employee_name and Employee-Name are treated alike.
+// Nested maps and slices are walked; the caller's maps are never mutated.
+type Redactor struct {
+	keys      map[string]struct{}
+	fragments []string
+}
+
+// NewRedactor creates a redactor masking exactly the given keys.
+func NewRedactor(keys ...string) *Redactor {
+	r := &Redactor{keys: make(map[string]struct{}, len(keys))}
+	for _, k := range keys {
+		if n := normalizeKey(k); n != "" {
+			r.keys[n] = struct{}{}
+		}
+	}
+	return r
+}
+
+// DefaultRedactor masks employee PII, tokens, cookies and credentials.
+func DefaultRedactor() *Redactor {
+	r := NewRedactor(defaultRedactedKeys...)
+	r.fragments = append(r.fragments, defaultRedactedFragments...)
+	return r
+}
+
+// WithKeys returns a copy of r that additionally masks keys.
+func (r *Redactor) WithKeys(keys ...string) *Redactor {
+	dup := NewRedactor(keys...)
+	for k := range r.keys {
+		dup.keys[k] = struct{}{}
+	}
+	dup.fragments = append(dup.fragments, r.fragments...)
+	return dup
+}
+
+// Matches reports whether the field key is masked.
+func (r *Redactor) Matches(key string) bool {
+	n := normalizeKey(key)
+	if _, ok := r.keys[n]; ok {
+		return true
+	}
+	for _, f := range r.fragments {
+		if strings.Contains(n, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns a copy of fields with sensitive values masked. Bearer
+// credentials are masked wherever they appear as string values.
+func (r *Redactor) Redact(fields map[string]interface{}) map[string]interface{} {
+	if r == nil || len(fields) == 0 {
+		return fields
+	}
+	out := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		if r.Matches(k) {
+			out[k] = RedactedValue
+			continue
+		}
+		out[k] = r.redactValue(v)
+	}
+	return out
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case Fields:
+		return Fields(r.Redact(val))
+	case map[string]interface{}:
+		return r.Redact(val)
+	case map[string]string:
+		out := make(map[string]string, len(val))
+		for k, s := range val {
+			if r.Matches(k) {
+				out[k] = RedactedValue
+			} else {
+				out[k] = redactBearer(s)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = r.redactValue(item)
+		}
+		return out
+	case string:
+		return redactBearer(val)
+	default:
+		return v
+	}
+}
+
+func redactBearer(s string) string {
+	if len(s) > 7 && strings.EqualFold(s[:7], "bearer ") {
+		return s[:7] + RedactedValue
+	}
+	return s
+}
+
+func normalizeKey(key string) string {
+	var b strings.Builder
+	b.Grow(len(key))
+	for _, c := range strings.ToLower(strings.TrimSpace(key)) {
+		if c == '_' || c == '-' || c == '.' {
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+// maxSampledMessages bounds the sampler's per-message counters; once exceeded
+// all counters are reset so unbounded message cardinality cannot leak memory.
+const maxSampledMessages = 4096
+
+// SamplingPolicy limits repeated messages at a level: within each Tick the first
+// First entries with the same message are written, then every Thereafter-th one.
+// Thereafter <= 0 drops all repeats until the next tick.
+type SamplingPolicy struct {
+	First      int
+	Thereafter int
+	Tick       time.Duration
+}
+
+type sampleCounter struct {
+	windowStart time.Time
+	count       int
+}
+
+type sampleKey struct {
+	level   Level
+	message string
+}
+
+// sampler is shared by a logger and all loggers derived via WithFields/WithContext.
+type sampler struct {
+	mu       sync.Mutex
+	policies map[Level]SamplingPolicy
+	counters map[sampleKey]*sampleCounter
+	now      func() time.Time
+}
+
+func newSampler() *sampler {
+	return &sampler{
+		policies: make(map[Level]SamplingPolicy),
+		counters: make(map[sampleKey]*sampleCounter),
+		now:      time.Now,
+	}
+}
+
+func (s *sampler) allow(level Level, message string) bool {
+	policy, ok := s.policies[level]
+	if !ok {
+		return true
+	}
+	tick := policy.Tick
+	if tick <= 0 {
+		tick = time.Second
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	key := sampleKey{level: level, message: message}
+	c := s.counters[key]
+	if c == nil {
+		if len(s.counters) >= maxSampledMessages {
+			s.counters = make(map[sampleKey]*sampleCounter)
+		}
+		c = &sampleCounter{windowStart: now}
+		s.counters[key] = c
+	}
+	if now.Sub(c.windowStart) >= tick {
+		c.windowStart = now
+		c.count = 0
+	}
+	c.count++
+	if c.count <= policy.First {
+		return true
+	}
+	if policy.Thereafter <= 0 {
+		return false
+	}
+	return (c.count-policy.First)%policy.Thereafter == 0
+}
@@ -0,0 +1,179 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Entry is a redacted log record handed to sinks.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Caller  string
+	Fields  Fields
+	// Encoded is the JSON line (without trailing newline) written to the primary writer.
+	Encoded []byte
+}
+
+// Sink receives every entry that passes level filtering, sampling and redaction.
+// Sinks that hold resources should also implement io.Closer.
+type Sink interface {
+	WriteEntry(entry Entry) error
+}
+
+type writerSink struct {
+	w io.Writer
+}
+
+// WriterSink writes JSON lines to w.
+func WriterSink(w io.Writer) Sink {
+	return writerSink{w: w}
+}
+
+func (s writerSink) WriteEntry(e Entry) error {
+	line := make([]byte, 0, len(e.Encoded)+1)
+	line = append(append(line, e.Encoded...), '\n')
+	_, err := s.w.Write(line)
+	return err
+}
+
+func (s writerSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+type levelSink struct {
+	Sink
+	min Level
+}
+
+// LevelSink wraps s so that it only receives entries at or above min.
+func LevelSink(s Sink, min Level) Sink {
+	return levelSink{Sink: s, min: min}
+}
+
+func (s levelSink) WriteEntry(e Entry) error {
+	if e.Level < s.min {
+		return nil
+	}
+	return s.Sink.WriteEntry(e)
+}
+
+func (s levelSink) Close() error {
+	if c, ok := s.Sink.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// RotatingFile is an io.WriteCloser that rotates the file once it reaches
+// MaxSize bytes, keeping at most MaxBackups files named path.1 … path.N
+// (path.1 being the most recent).
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewRotatingFile opens (or creates) path for appending. maxSizeMB <= 0 disables
+// rotation; maxBackups <= 0 discards the rotated file.
+func NewRotatingFile(path string, maxSizeMB, maxBackups int) (*RotatingFile, error) {
+	if path == "" {
+		return nil, errors.New("logger: rotating file path is required")
+	}
+	rf := &RotatingFile{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *RotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(rf.path), 0o755); err != nil {
+		return fmt.Errorf("logger: create log directory: %w", err)
+	}
+	f, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("logger: open log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("logger: stat log file: %w", err)
+	}
+	rf.file = f
+	rf.size = info.Size()
+	return nil
+}
+
+// Write appends p, rotating first if p would push the file past the size limit.
+func (rf *RotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *RotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return fmt.Errorf("logger: close log file: %w", err)
+	}
+	rf.file = nil
+	if rf.maxBackups <= 0 {
+		if err := os.Remove(rf.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("logger: remove log file: %w", err)
+		}
+		return rf.open()
+	}
+	_ = os.Remove(rf.backupName(rf.maxBackups))
+	for i := rf.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(rf.backupName(i), rf.backupName(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("logger: shift log backup: %w", err)
+		}
+	}
+	if err := os.Rename(rf.path, rf.backupName(1)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("logger: rotate log file: %w", err)
+	}
+	return rf.open()
+}
+
+func (rf *RotatingFile) backupName(i int) string {
+	return fmt.Sprintf("%s.%d", rf.path, i)
+}
+
+// Close closes the current file.
+func (rf *RotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
@@ -0,0 +1,141 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLoggerFansOutToSinks(t *testing.T) {
+	primary := &bytes.Buffer{}
+	all := &bytes.Buffer{}
+	errorsOnly := &bytes.Buffer{}
+	logger := NewLogger(
+		WithWriter(primary),
+		WithSink(WriterSink(all)),
+		WithSink(LevelSink(WriterSink(errorsOnly), LevelError)),
+	)
+
+	logger.WithFields(Fields{"token": "secret"}).Info("hello")
+	logger.Error("boom")
+
+	if primary.String() != all.String() {
+		t.Fatalf("expected sinks to receive identical lines:\n%s\n%s", primary.String(), all.String())
+	}
+	if strings.Contains(all.String(), `"secret"`) {
+		t.Fatalf("expected sinks to receive redacted entries, got %s", all.String())
+	}
+	if strings.Count(errorsOnly.String(), "\n") != 1 || !strings.Contains(errorsOnly.String(), "boom") {
+		t.Fatalf("expected only the error entry, got %s", errorsOnly.String())
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "command.log")
+	rf, err := NewRotatingFile(path, 1, 2)
+	if err != nil {
+		t.Fatalf("open rotating file: %v", err)
+	}
+	defer rf.Close()
+
+	chunk := bytes.Repeat([]byte("x"), 600*1024)
+	for i := 0; i < 4; i++ {
+		if _, err := rf.Write(chunk); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+	}
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", name, err)
+		}
+		if info.Size() != int64(len(chunk)) {
+			t.Fatalf("%s size = %d, want %d", name, info.Size(), len(chunk))
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatalf("expected backups beyond MaxBackups to be removed, got %v", err)
+	}
+}
+
+func TestOTLPSinkExportsBatch(t *testing.T) {
+	received := make(chan otlpPayload, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload otlpPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		received <- payload
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink, err := NewOTLPSink(OTLPConfig{Endpoint: srv.URL + "/v1/logs", ServiceName: "command-service", FlushInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("create sink: %v", err)
+	}
+	logger := NewLogger(WithWriter(&bytes.Buffer{}), WithSink(sink))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	logger.WithContext(ctx).WithFields(Fields{"tenantId": "t-1", "attempt": 2}).Warn("retrying")
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("close sink: %v", err)
+	}
+	payload := <-received
+	if len(payload.ResourceLogs) != 1 || len(payload.ResourceLogs[0].ScopeLogs) != 1 {
+		t.Fatalf("unexpected payload shape: %+v", payload)
+	}
+	records := payload.ResourceLogs[0].ScopeLogs[0].LogRecords
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	rec := records[0]
+	if rec.SeverityNumber != 13 || *rec.Body.StringValue != "retrying" {
+		t.Fatalf("unexpected record: %+v", rec)
+	}
+	if rec.TraceID != traceID.String() || rec.SpanID != spanID.String() {
+		t.Fatalf("expected trace correlation, got trace=%s span=%s", rec.TraceID, rec.SpanID)
+	}
+	attrs := map[string]otlpAnyValue{}
+	for _, kv := range rec.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	if v := attrs["tenantId"]; v.StringValue == nil || *v.StringValue != "t-1" {
+		t.Fatalf("expected tenantId attribute, got %+v", attrs)
+	}
+	if v := attrs["attempt"]; v.IntValue == nil || *v.IntValue != "2" {
+		t.Fatalf("expected attempt int attribute, got %+v", attrs)
+	}
+}
+
+func TestResolveOTLPLogsURL(t *testing.T) {
+	cases := []struct {
+		endpoint       string
+		insecure       bool
+		signalSpecific bool
+		want           string
+	}{
+		{"http://collector:4318", false, false, "http://collector:4318/v1/logs"},
+		{"http://collector:4318/custom/logs", false, true, "http://collector:4318/custom/logs"},
+		{"collector:4318", true, true, "http://collector:4318/v1/logs"},
+		{"collector:4318", false, false, "https://collector:4318/v1/logs"},
+	}
+	for _, tc := range cases {
+		if got := resolveOTLPLogsURL(tc.endpoint, tc.insecure, tc.signalSpecific); got != tc.want {
+			t.Fatalf("resolveOTLPLogsURL(%q) = %q, want %q", tc.endpoint, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+//go:build windows || plan9
+
+package logger
+
+import "errors"
+
+// SyslogSink is unavailable on this platform.
+type SyslogSink struct{}
+
+// NewSyslogSink always fails on platforms without log/syslog.
+func NewSyslogSink(network, address, tag string) (*SyslogSink, error) {
+	return nil, errors.New("logger: syslog is not supported on this platform")
+}
+
+// WriteEntry implements Sink.
+func (s *SyslogSink) WriteEntry(Entry) error { return nil }
+
+// Close implements io.Closer.
+func (s *SyslogSink) Close() error { return nil }
@@ -0,0 +1,44 @@
+//go:build !windows && !plan9
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// SyslogSink forwards entries (as JSON) to a syslog daemon, mapping levels to
+// syslog severities.
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogSink dials the syslog daemon. Empty network/address uses the local
+// daemon; otherwise network is "udp" or "tcp" and address is host:port.
+func NewSyslogSink(network, address, tag string) (*SyslogSink, error) {
+	w, err := syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, fmt.Errorf("logger: dial syslog: %w", err)
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+// WriteEntry implements Sink.
+func (s *SyslogSink) WriteEntry(e Entry) error {
+	msg := string(e.Encoded)
+	switch e.Level {
+	case LevelDebug:
+		return s.w.Debug(msg)
+	case LevelWarn:
+		return s.w.Warning(msg)
+	case LevelError:
+		return s.w.Err(msg)
+	default:
+		return s.w.Info(msg)
+	}
+}
+
+// Close closes the syslog connection.
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
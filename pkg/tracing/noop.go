@@ -0,0 +1,21 @@
+package tracing
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NoopExporter 丢弃所有 span，用于测试与未配置 collector 的环境。
+type NoopExporter struct{}
+
+// NewNoopExporter 创建空导出器。
+func NewNoopExporter() *NoopExporter {
+	return &NoopExporter{}
+}
+
+// ExportSpans 丢弃 span。
+func (NoopExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error { return nil }
+
+// Shutdown 无需释放资源。
+func (NoopExporter) Shutdown(context.Context) error { return nil }
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// PayloadHeadersKey 是事件载荷中承载追踪上下文的字段名。
+const PayloadHeadersKey = "headers"
+
+// InjectMap 将当前追踪上下文（traceparent/tracestate/baggage）序列化为字符串映射。
+// 无有效 span 时返回 nil。
+func InjectMap(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ExtractMap 从字符串映射恢复追踪上下文。
+func ExtractMap(ctx context.Context, headers map[string]string) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(headers))
+}
+
+// ContextFromPayload 从 JSON 事件载荷的 headers 字段恢复追踪上下文，解析失败时原样返回。
+func ContextFromPayload(ctx context.Context, payload []byte) context.Context {
+	var envelope struct {
+		Headers map[string]string `json:"headers"`
+	}
+	if len(payload) == 0 || json.Unmarshal(payload, &envelope) != nil {
+		return ctx
+	}
+	return ExtractMap(ctx, envelope.Headers)
+}
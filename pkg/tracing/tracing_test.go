@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func installRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestLoadConfigFromEnvDefaults(t *testing.T) {
+	t.Setenv("OTEL_SERVICE_NAME", "")
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	cfg := LoadConfigFromEnv("hrms-command")
+	if cfg.ServiceName != "hrms-command" || cfg.Exporter != ExporterNone || cfg.SampleRatio != 1 {
+		t.Fatalf("unexpected default config: %+v", cfg)
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4318")
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+	cfg = LoadConfigFromEnv("hrms-command")
+	if cfg.Exporter != ExporterOTLP || cfg.SampleRatio != 0.25 {
+		t.Fatalf("expected otlp exporter with ratio 0.25, got %+v", cfg)
+	}
+}
+
+func TestInitWithNoopExporter(t *testing.T) {
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	shutdown, err := Init(context.Background(), Config{ServiceName: "test", Exporter: ExporterNone, SampleRatio: 1})
+	if err != nil {
+		t.Fatalf("Init returned error: %v", err)
+	}
+	ctx, span := Start(context.Background(), "test", "noop")
+	if TraceID(ctx) == "" {
+		t.Fatalf("expected sampled span to carry a trace id")
+	}
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown returned error: %v", err)
+	}
+
+	if _, err := Init(context.Background(), Config{Exporter: "zipkin"}); err == nil {
+		t.Fatalf("expected unsupported exporter to fail")
+	}
+}
+
+func TestEndRecordsError(t *testing.T) {
+	recorder := installRecorder(t)
+	_, span := Start(context.Background(), "test", "failing")
+	End(span, errors.New("boom"))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Status().Code != codes.Error || len(spans[0].Events()) != 1 {
+		t.Fatalf("expected one errored span with exception event, got %+v", spans)
+	}
+}
+
+func TestPayloadHeadersRoundTrip(t *testing.T) {
+	installRecorder(t)
+	ctx, span := Start(context.Background(), "test", "producer")
+	defer span.End()
+
+	headers := InjectMap(ctx)
+	if headers["traceparent"] == "" {
+		t.Fatalf("expected traceparent header, got %v", headers)
+	}
+	payload := []byte(`{"eventType":"position.created","headers":{"traceparent":"` + headers["traceparent"] + `"}}`)
+	restored := ContextFromPayload(context.Background(), payload)
+	sc := trace.SpanContextFromContext(restored)
+	if !sc.IsRemote() || sc.TraceID() != span.SpanContext().TraceID() {
+		t.Fatalf("expected remote span context with same trace id, got %+v", sc)
+	}
+	if got := ContextFromPayload(context.Background(), []byte("not-json")); trace.SpanContextFromContext(got).IsValid() {
+		t.Fatalf("expected invalid payload to leave context untouched")
+	}
+	if InjectMap(context.Background()) != nil {
+		t.Fatalf("expected nil headers without active span")
+	}
+}
+
+func TestHTTPMiddlewareContinuesIncomingTrace(t *testing.T) {
+	recorder := installRecorder(t)
+	parentCtx, parent := Start(context.Background(), "client", "call")
+	parent.End()
+
+	router := chi.NewRouter()
+	router.Use(HTTPMiddleware("command"))
+	router.Get("/api/v1/positions/{code}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/positions/P1000001", nil)
+	otel.GetTextMapPropagator().Inject(parentCtx, propagation.HeaderCarrier(req.Header))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var server sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.SpanKind() == trace.SpanKindServer {
+			server = s
+		}
+	}
+	if server == nil {
+		t.Fatalf("expected server span to be recorded")
+	}
+	if server.Name() != "GET /api/v1/positions/{code}" {
+		t.Fatalf("expected span named after route pattern, got %q", server.Name())
+	}
+	if server.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("expected server span to continue incoming trace")
+	}
+	if server.Status().Code != codes.Error {
+		t.Fatalf("expected 5xx to mark span as error")
+	}
+	if rec.Header().Get(TraceIDHeader) != parent.SpanContext().TraceID().String() {
+		t.Fatalf("expected trace id response header, got %q", rec.Header().Get(TraceIDHeader))
+	}
+}